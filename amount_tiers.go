@@ -0,0 +1,186 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// tierFeeBase is the base that the fee limits of amount tiers are expressed
+// in.
+const tierFeeBase = 1e6
+
+// ErrFeeTierExceeded is returned when a swap is requested with fee limits that
+// exceed the maximum fee that our amount tiers allow for its amount.
+var ErrFeeTierExceeded = errors.New("swap fee limits exceed amount tier")
+
+// AmountTier holds the swap parameters that we require for swaps of at least
+// an amount. Parameters that are zero are not set by the tier, and are taken
+// from the next largest tier that sets them.
+type AmountTier struct {
+	// MinAmount is the smallest swap amount that the tier applies to.
+	MinAmount btcutil.Amount
+
+	// HtlcConfirmations is the minimum number of confirmations of the
+	// server's htlc that we require before revealing our preimage for
+	// loop out swaps in the tier.
+	HtlcConfirmations uint32
+
+	// SweepConfTarget is the largest confirmation target that loop out
+	// swaps in the tier may sweep with, so that larger swaps can be
+	// settled sooner.
+	SweepConfTarget int32
+
+	// MaxFeePPM is the maximum total of the fee limits that swaps in the
+	// tier may be requested with, expressed as parts per million of the
+	// swap amount.
+	MaxFeePPM uint64
+}
+
+// AmountTiers is a set of tiers that scale the parameters of our swaps with
+// their amount, ordered by ascending amount.
+type AmountTiers []AmountTier
+
+// NewAmountTiers orders the tiers provided by amount, and checks that each
+// amount is only set once.
+func NewAmountTiers(tiers []AmountTier) (AmountTiers, error) {
+	sorted := make(AmountTiers, len(tiers))
+	copy(sorted, tiers)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinAmount < sorted[j].MinAmount
+	})
+
+	for i, tier := range sorted {
+		if i > 0 && tier.MinAmount == sorted[i-1].MinAmount {
+			return nil, fmt.Errorf("duplicate tier for amount %v",
+				tier.MinAmount)
+		}
+
+		if tier.MaxFeePPM > tierFeeBase {
+			return nil, fmt.Errorf("tier for amount %v: max fee "+
+				"ppm %v exceeds %v", tier.MinAmount,
+				tier.MaxFeePPM, uint64(tierFeeBase))
+		}
+	}
+
+	return sorted, nil
+}
+
+// lookup returns the value of the largest tier that the amount provided
+// reaches which sets a non-zero value, or zero if no tier sets a value.
+func (t AmountTiers) lookup(amount btcutil.Amount,
+	value func(AmountTier) uint64) uint64 {
+
+	var result uint64
+	for _, tier := range t {
+		if tier.MinAmount > amount {
+			break
+		}
+
+		if v := value(tier); v != 0 {
+			result = v
+		}
+	}
+
+	return result
+}
+
+// HtlcConfirmations returns the number of confirmations of the server's htlc
+// that we require for a loop out of the amount provided, or zero if no tier
+// requires confirmations for the amount.
+func (t AmountTiers) HtlcConfirmations(amount btcutil.Amount) uint32 {
+	return uint32(t.lookup(amount, func(tier AmountTier) uint64 {
+		return uint64(tier.HtlcConfirmations)
+	}))
+}
+
+// SweepConfTarget returns the largest sweep confirmation target that a loop
+// out of the amount provided may use, or zero if no tier limits it.
+func (t AmountTiers) SweepConfTarget(amount btcutil.Amount) int32 {
+	return int32(t.lookup(amount, func(tier AmountTier) uint64 {
+		return uint64(tier.SweepConfTarget)
+	}))
+}
+
+// MaxFee returns the maximum total of the fee limits that a swap of the amount
+// provided may be requested with. If no tier limits the swap's fees, false is
+// returned.
+func (t AmountTiers) MaxFee(amount btcutil.Amount) (btcutil.Amount, bool) {
+	ppm := t.lookup(amount, func(tier AmountTier) uint64 {
+		return tier.MaxFeePPM
+	})
+	if ppm == 0 {
+		return 0, false
+	}
+
+	return btcutil.Amount(uint64(amount) * ppm / tierFeeBase), true
+}
+
+// ApplyLoopOut returns a copy of the loop out request provided with the
+// confirmation requirements of its amount's tier applied. Requests that ask
+// for fewer htlc confirmations than the tier requires wait for the tier's
+// confirmations, and requests that sweep with a larger confirmation target
+// than the tier allows are swept with the tier's target.
+func (t AmountTiers) ApplyLoopOut(request *OutRequest) *OutRequest {
+	tiered := *request
+
+	// If a htlc confirmation target was not provided, the swap uses the
+	// default number of confirmations, so we compare our tier against
+	// that default.
+	confs := uint32(request.HtlcConfirmations)
+	if confs == 0 {
+		confs = loopdb.DefaultLoopOutHtlcConfirmations
+	}
+
+	tierConfs := t.HtlcConfirmations(request.Amount)
+	if tierConfs > confs {
+		log.Infof("Requiring %v htlc confirmations for swap of %v, "+
+			"requested: %v", tierConfs, request.Amount, confs)
+
+		tiered.HtlcConfirmations = int32(tierConfs)
+	}
+
+	confTarget := t.SweepConfTarget(request.Amount)
+	if confTarget != 0 && request.SweepConfTarget > confTarget {
+		log.Infof("Sweeping swap of %v with confirmation target %v, "+
+			"requested: %v", request.Amount, confTarget,
+			request.SweepConfTarget)
+
+		tiered.SweepConfTarget = confTarget
+	}
+
+	return &tiered
+}
+
+// CheckLoopOutFees checks that the total of the fee limits that a loop out is
+// requested with does not exceed the maximum fee of its amount's tier.
+func (t AmountTiers) CheckLoopOutFees(request *OutRequest) error {
+	fees := request.MaxSwapFee + request.MaxMinerFee +
+		request.MaxSwapRoutingFee + request.MaxPrepayRoutingFee
+
+	return t.checkFees(request.Amount, fees)
+}
+
+// CheckLoopInFees checks that the total of the fee limits that a loop in is
+// requested with does not exceed the maximum fee of its amount's tier.
+func (t AmountTiers) CheckLoopInFees(request *LoopInRequest) error {
+	return t.checkFees(
+		request.Amount, request.MaxSwapFee+request.MaxMinerFee,
+	)
+}
+
+// checkFees checks a total of fee limits against the maximum fee of the
+// amount's tier.
+func (t AmountTiers) checkFees(amount, fees btcutil.Amount) error {
+	maxFee, ok := t.MaxFee(amount)
+	if !ok || fees <= maxFee {
+		return nil
+	}
+
+	return fmt.Errorf("%w: fee limits of %v for swap of %v exceed "+
+		"maximum of %v", ErrFeeTierExceeded, fees, amount, maxFee)
+}
@@ -0,0 +1,135 @@
+package loop
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAmountTiers tests ordering of amount tiers, and lookup of the htlc
+// confirmations that they require for swap amounts.
+func TestAmountTiers(t *testing.T) {
+	_, err := NewAmountTiers([]AmountTier{
+		{MinAmount: 100},
+		{MinAmount: 100},
+	})
+	require.Error(t, err)
+
+	tiers, err := NewAmountTiers([]AmountTier{
+		{
+			MinAmount:         1000000,
+			HtlcConfirmations: 6,
+		},
+		{
+			MinAmount:         100000,
+			HtlcConfirmations: 3,
+		},
+		{
+			// A tier that does not set confirmations does not
+			// override the tier below it.
+			MinAmount: 500000,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(100000), tiers[0].MinAmount)
+
+	tests := []struct {
+		amount btcutil.Amount
+		confs  uint32
+	}{
+		{amount: 99999, confs: 0},
+		{amount: 100000, confs: 3},
+		{amount: 600000, confs: 3},
+		{amount: 1000000, confs: 6},
+		{amount: 5000000, confs: 6},
+	}
+
+	for _, test := range tests {
+		require.Equal(
+			t, test.confs, tiers.HtlcConfirmations(test.amount),
+			"amount: %v", test.amount,
+		)
+	}
+
+	// An empty set of tiers does not require confirmations.
+	var none AmountTiers
+	require.Zero(t, none.HtlcConfirmations(100000))
+}
+
+// TestAmountTiersApply tests applying amount tiers to swap requests.
+func TestAmountTiersApply(t *testing.T) {
+	tiers, err := NewAmountTiers([]AmountTier{
+		{
+			MinAmount:         100000,
+			HtlcConfirmations: 3,
+			SweepConfTarget:   20,
+			MaxFeePPM:         10000,
+		},
+		{
+			MinAmount:       1000000,
+			SweepConfTarget: 6,
+			MaxFeePPM:       5000,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = NewAmountTiers([]AmountTier{
+		{MaxFeePPM: tierFeeBase + 1},
+	})
+	require.Error(t, err)
+
+	// Swaps below our smallest tier are not changed, and their fees are
+	// not limited.
+	request := &OutRequest{
+		Amount:          50000,
+		SweepConfTarget: 100,
+		MaxMinerFee:     50000,
+	}
+	require.Equal(t, request, tiers.ApplyLoopOut(request))
+	require.NoError(t, tiers.CheckLoopOutFees(request))
+
+	_, ok := tiers.MaxFee(request.Amount)
+	require.False(t, ok)
+
+	// Larger swaps wait for more confirmations and sweep sooner, and the
+	// tier's confirmations are compared to the default number if the
+	// request does not set them.
+	request = &OutRequest{
+		Amount:          2000000,
+		SweepConfTarget: 100,
+		MaxSwapFee:      5000,
+		MaxMinerFee:     4000,
+	}
+	tiered := tiers.ApplyLoopOut(request)
+	require.Equal(t, int32(3), tiered.HtlcConfirmations)
+	require.Equal(t, int32(6), tiered.SweepConfTarget)
+
+	// The request that we applied our tiers to is not changed.
+	require.Zero(t, request.HtlcConfirmations)
+	require.Equal(t, int32(100), request.SweepConfTarget)
+
+	// Requests that already exceed the tier's requirements are not
+	// changed.
+	request.HtlcConfirmations = 6
+	request.SweepConfTarget = 2
+	require.Equal(t, request, tiers.ApplyLoopOut(request))
+
+	// Our fee limit of 5000 ppm allows 10000 sat of fees.
+	maxFee, ok := tiers.MaxFee(request.Amount)
+	require.True(t, ok)
+	require.Equal(t, btcutil.Amount(10000), maxFee)
+	require.NoError(t, tiers.CheckLoopOutFees(request))
+
+	request.MaxSwapRoutingFee = 1001
+	require.True(t, errors.Is(
+		tiers.CheckLoopOutFees(request), ErrFeeTierExceeded,
+	))
+
+	require.True(t, errors.Is(tiers.CheckLoopInFees(&LoopInRequest{
+		Amount:      200000,
+		MaxSwapFee:  1500,
+		MaxMinerFee: 1000,
+	}), ErrFeeTierExceeded))
+}
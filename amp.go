@@ -0,0 +1,22 @@
+package loop
+
+import (
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// ampSupported returns true if the invoice provided signals that its
+// recipient accepts AMP payments for it.
+func ampSupported(params *chaincfg.Params, invoice string) (bool, error) {
+	payReq, err := zpay32.Decode(invoice, params)
+	if err != nil {
+		return false, err
+	}
+
+	if payReq.Features == nil {
+		return false, nil
+	}
+
+	return payReq.Features.HasFeature(lnwire.AMPOptional), nil
+}
@@ -0,0 +1,82 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAmpSupported tests detection of the amp feature in invoices.
+func TestAmpSupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		features *lnwire.RawFeatureVector
+		amp      bool
+	}{
+		{
+			name: "no features",
+			amp:  false,
+		},
+		{
+			name: "amp optional",
+			features: lnwire.NewRawFeatureVector(
+				lnwire.AMPOptional,
+			),
+			amp: true,
+		},
+		{
+			name: "amp required",
+			features: lnwire.NewRawFeatureVector(
+				lnwire.AMPRequired,
+			),
+			amp: true,
+		},
+		{
+			name: "other features",
+			features: lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+			),
+			amp: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			options := []func(*zpay32.Invoice){
+				zpay32.Description(prepayInvoiceDesc),
+				zpay32.Amount(lnwire.MilliSatoshi(1000)),
+			}
+
+			if testCase.features != nil {
+				options = append(options, zpay32.Features(
+					lnwire.NewFeatureVector(
+						testCase.features,
+						lnwire.Features,
+					),
+				))
+			}
+
+			req, err := zpay32.NewInvoice(
+				&chaincfg.TestNet3Params, lntypes.Hash{1},
+				testTime, options...,
+			)
+			require.NoError(t, err)
+
+			invoice, err := test.EncodePayReq(req)
+			require.NoError(t, err)
+
+			amp, err := ampSupported(
+				&chaincfg.TestNet3Params, invoice,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.amp, amp)
+		})
+	}
+}
@@ -9,12 +9,15 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweep"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
 )
 
@@ -72,6 +75,46 @@ type Client struct {
 	resumeReady chan struct{}
 	wg          sync.WaitGroup
 
+	// reservations contains the swaps that have been reserved but not
+	// yet confirmed, keyed by swap hash.
+	reservations     map[lntypes.Hash]*pendingReservation
+	reservationsLock sync.Mutex
+
+	// pendingVolume contains the swaps that count towards our volume
+	// limits while they are being created, before they are persisted.
+	pendingVolume map[uint64]swapVolume
+	nextVolumeID  uint64
+	volumeLock    sync.Mutex
+
+	// pendingFees contains the swaps that count towards our fee budgets
+	// while they are being created, before they are persisted. It is
+	// guarded by volumeLock.
+	pendingFees map[uint64]swapFees
+
+	// pendingWalletFunds contains the wallet funds that are reserved for
+	// loop ins while they are being created, before they are persisted.
+	// It is guarded by volumeLock.
+	pendingWalletFunds map[uint64]btcutil.Amount
+
+	// serverConn tracks the state of our connection to the swap server.
+	serverConn *serverConnMonitor
+
+	// validator handles anomalies in the parameters that the server
+	// provides for new swaps, and is shared by all of our swaps so that
+	// the server's node key can be compared across swaps.
+	validator *serverValidator
+
+	// userAgent returns the user agent that we send to the server for
+	// swaps with the initiator provided.
+	userAgent func(initiator string) string
+
+	// subscriptions delivers the updates of our swaps to callers that
+	// wait for swaps to complete.
+	subscriptions *swapSubscriptions
+
+	// sweepPreviews routes requests for sweep previews to our swaps.
+	sweepPreviews *sweepPreviews
+
 	clientConfig
 }
 
@@ -107,6 +150,89 @@ type ClientConfig struct {
 	// for a loop out swap. When greater than one, a multi-part payment may
 	// be attempted.
 	LoopOutMaxParts uint32
+
+	// VolumeLimits caps the amount and number of swaps that may be
+	// initiated over rolling periods. If nil, no limits are enforced.
+	VolumeLimits *VolumeLimits
+
+	// MissionControl is used to import the node pairs that our swap
+	// payments failed to route over into lnd's mission control. If nil,
+	// routing failures are not recorded.
+	MissionControl MissionControl
+
+	// RoutingFailurePeriod is the amount of time that a routing failure
+	// of a swap payment is applied to later swap payments for.
+	RoutingFailurePeriod time.Duration
+
+	// MaxSweepDelay is the maximum random delay that is applied before we
+	// first sweep a loop out htlc. If zero, we sweep without delay.
+	MaxSweepDelay time.Duration
+
+	// MatchWalletSweeps sets the nSequence and nLockTime values of our
+	// sweeps to those used by common wallets.
+	MatchWalletSweeps bool
+
+	// MinSweepOutput is the smallest output value that our sweeps may
+	// create. Sweeps are always held back if their output is dust or
+	// costs more to spend than it is worth at the sweep's fee rate.
+	MinSweepOutput btcutil.Amount
+
+	// HtlcFunder funds loop in htlcs with specific coins from lnd's
+	// wallet. If nil, loop in requests with htlc funding restrictions are
+	// rejected.
+	HtlcFunder HtlcFunder
+
+	// Payer dispatches swap payments that are paid with AMP, carry custom
+	// records or are paced. If nil, all swap payments are regular
+	// payments, and we do not offer to attach custom records to them to
+	// the server.
+	Payer Payer
+
+	// AmpPrepay pays the prepay invoices of loop outs with AMP if the
+	// server signals support for it. It has no effect without a Payer.
+	AmpPrepay bool
+
+	// PaymentPacing limits the size and rate of the shards of loop out
+	// payments. If nil, or without a Payer, shards are not limited.
+	PaymentPacing *PaymentPacing
+
+	// StrictServerValidation fails swaps if any of the parameters that
+	// the server provides for them is unexpected, rather than only
+	// failing swaps whose parameters are unsafe.
+	StrictServerValidation bool
+
+	// AmountTiers scales the confirmation requirements and fee limits of
+	// our swaps with their amount, so that larger swaps may require more
+	// confirmations of the server's htlc, sweep sooner and pay a smaller
+	// portion of their amount in fees. They apply to both manually
+	// requested and automated swaps. If empty, the requested parameters
+	// are used.
+	AmountTiers AmountTiers
+
+	// AgentName is the name of the software that our swaps are attributed
+	// to in the user agent that we send to the server. If empty, the
+	// package level AgentName is used.
+	AgentName string
+
+	// Initiator is added to the user agent of swaps that are requested
+	// without an initiator, so that platforms that embed loop can
+	// attribute all of their swaps.
+	Initiator string
+
+	// ServerDialOptions are additional options that the connection to the
+	// swap server is dialed with, such as interceptors that inject faults
+	// into server calls for testing.
+	ServerDialOptions []grpc.DialOption
+
+	// SecretsDir is the directory of the secret store that the preimages
+	// of our swaps are encrypted and stored in, apart from the rest of
+	// our swap data. If empty, our preimages are stored with our swaps.
+	SecretsDir string
+
+	// SecretsKeyFile is the file that holds the key that our secret store
+	// is encrypted with. It must be set if SecretsDir is set, and must be
+	// outside of SecretsDir.
+	SecretsKeyFile string
 }
 
 // NewClient returns a new instance to initiate swaps with.
@@ -115,6 +241,22 @@ func NewClient(dbDir string, cfg *ClientConfig) (*Client, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
+
+	if cfg.SecretsDir != "" {
+		secrets, err := loopdb.NewBoltSecretStore(
+			cfg.SecretsDir, cfg.SecretsKeyFile,
+		)
+		if err != nil {
+			_ = store.Close()
+			return nil, nil, err
+		}
+
+		if err := store.UseSecretStore(secrets); err != nil {
+			_ = store.Close()
+			return nil, nil, err
+		}
+	}
+
 	lsatStore, err := lsat.NewFileStore(dbDir)
 	if err != nil {
 		return nil, nil, err
@@ -134,12 +276,20 @@ func NewClient(dbDir string, cfg *ClientConfig) (*Client, func(), error) {
 			return time.NewTimer(d).C
 		},
 		LoopOutMaxParts: cfg.LoopOutMaxParts,
+		VolumeLimits:    cfg.VolumeLimits,
+		ServerAddress:   cfg.ServerAddress,
+		AmountTiers:     cfg.AmountTiers,
 	}
 
 	sweeper := &sweep.Sweeper{
-		Lnd: cfg.Lnd,
+		Lnd:            cfg.Lnd,
+		MatchWallet:    cfg.MatchWalletSweeps,
+		MinOutputValue: cfg.MinSweepOutput,
 	}
 
+	subscriptions := newSwapSubscriptions()
+	sweepPreviews := newSweepPreviews()
+
 	executor := newExecutor(&executorConfig{
 		lnd:               cfg.Lnd,
 		store:             store,
@@ -147,6 +297,18 @@ func NewClient(dbDir string, cfg *ClientConfig) (*Client, func(), error) {
 		createExpiryTimer: config.CreateExpiryTimer,
 		loopOutMaxParts:   cfg.LoopOutMaxParts,
 		cancelSwap:        swapServerClient.CancelLoopOutSwap,
+		routingHints: newRoutingHints(
+			cfg.Lnd.NodePubkey, store, cfg.MissionControl,
+			cfg.RoutingFailurePeriod,
+		),
+		sweepDelay:    newSweepDelay(cfg.MaxSweepDelay),
+		htlcFunder:    cfg.HtlcFunder,
+		payer:         cfg.Payer,
+		ampPrepay:     cfg.AmpPrepay,
+		paymentPacing: cfg.PaymentPacing,
+		subscriptions: subscriptions,
+		sweepPreviews: sweepPreviews,
+		server:        swapServerClient,
 	})
 
 	client := &Client{
@@ -156,6 +318,17 @@ func NewClient(dbDir string, cfg *ClientConfig) (*Client, func(), error) {
 		sweeper:      sweeper,
 		executor:     executor,
 		resumeReady:  make(chan struct{}),
+		reservations: make(map[lntypes.Hash]*pendingReservation),
+
+		pendingVolume: make(map[uint64]swapVolume),
+		pendingFees:   make(map[uint64]swapFees),
+		serverConn:    swapServerClient.monitor,
+		validator:     newServerValidator(cfg.StrictServerValidation),
+		userAgent:     swapServerClient.userAgent,
+		subscriptions: subscriptions,
+		sweepPreviews: sweepPreviews,
+
+		pendingWalletFunds: make(map[uint64]btcutil.Amount),
 	}
 
 	cleanup := func() {
@@ -165,18 +338,59 @@ func NewClient(dbDir string, cfg *ClientConfig) (*Client, func(), error) {
 	return client, cleanup, nil
 }
 
+// UserAgent returns the user agent that we send to the server for swaps that
+// are requested without an initiator.
+func (s *Client) UserAgent() string {
+	return s.userAgent("")
+}
+
 // FetchSwaps returns all loop in and out swaps currently in the database.
 func (s *Client) FetchSwaps() ([]*SwapInfo, error) {
-	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	return FetchSwapInfo(s.Store, s.lndServices.ChainParams)
+}
+
+// FetchSwapInfo returns all loop in and out swaps in the store provided. It
+// does not require a running client, so that swaps can be read from a replica
+// of the database.
+func FetchSwapInfo(store loopdb.SwapStore,
+	chainParams *chaincfg.Params) ([]*SwapInfo, error) {
+
+	loopOutSwaps, err := store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	loopInSwaps, err := store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	return swapInfos(loopOutSwaps, loopInSwaps, chainParams)
+}
+
+// FetchPendingSwaps returns the loop in and out swaps in the database that are
+// not in a final state. Swaps in a final state are not read in full, so this
+// is much cheaper than FetchSwaps for nodes with a long swap history.
+func (s *Client) FetchPendingSwaps() ([]*SwapInfo, error) {
+	loopOutSwaps, err := s.Store.FetchPendingLoopOuts()
 	if err != nil {
 		return nil, err
 	}
 
-	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	loopInSwaps, err := s.Store.FetchPendingLoopIns()
 	if err != nil {
 		return nil, err
 	}
 
+	return swapInfos(
+		loopOutSwaps, loopInSwaps, s.lndServices.ChainParams,
+	)
+}
+
+// swapInfos returns the swap info of the loop out and loop in swaps provided.
+func swapInfos(loopOutSwaps []*loopdb.LoopOut, loopInSwaps []*loopdb.LoopIn,
+	chainParams *chaincfg.Params) ([]*SwapInfo, error) {
+
 	swaps := make([]*SwapInfo, 0, len(loopInSwaps)+len(loopOutSwaps))
 
 	for _, swp := range loopOutSwaps {
@@ -184,7 +398,7 @@ func (s *Client) FetchSwaps() ([]*SwapInfo, error) {
 			GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
 			swp.Contract.CltvExpiry, swp.Contract.SenderKey,
 			swp.Contract.ReceiverKey, swp.Hash, swap.HtlcP2WSH,
-			s.lndServices.ChainParams,
+			chainParams,
 		)
 		if err != nil {
 			return nil, err
@@ -197,6 +411,10 @@ func (s *Client) FetchSwaps() ([]*SwapInfo, error) {
 			SwapHash:         swp.Hash,
 			LastUpdate:       swp.LastUpdateTime(),
 			HtlcAddressP2WSH: htlc.Address,
+			OutgoingChanSet:  swp.Contract.OutgoingChanSet,
+			Events:           swp.Events,
+
+			HtlcConfirmations: swp.Contract.HtlcConfirmations,
 		})
 	}
 
@@ -205,7 +423,7 @@ func (s *Client) FetchSwaps() ([]*SwapInfo, error) {
 			GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
 			swp.Contract.CltvExpiry, swp.Contract.SenderKey,
 			swp.Contract.ReceiverKey, swp.Hash, swap.HtlcNP2WSH,
-			s.lndServices.ChainParams,
+			chainParams,
 		)
 		if err != nil {
 			return nil, err
@@ -215,7 +433,7 @@ func (s *Client) FetchSwaps() ([]*SwapInfo, error) {
 			GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
 			swp.Contract.CltvExpiry, swp.Contract.SenderKey,
 			swp.Contract.ReceiverKey, swp.Hash, swap.HtlcP2WSH,
-			s.lndServices.ChainParams,
+			chainParams,
 		)
 		if err != nil {
 			return nil, err
@@ -229,6 +447,9 @@ func (s *Client) FetchSwaps() ([]*SwapInfo, error) {
 			LastUpdate:        swp.LastUpdateTime(),
 			HtlcAddressP2WSH:  htlcP2WSH.Address,
 			HtlcAddressNP2WSH: htlcNP2WSH.Address,
+			ExternalHtlc:      swp.Contract.ExternalHtlc,
+			LastHop:           swp.Contract.LastHop,
+			Events:            swp.Events,
 		})
 	}
 
@@ -256,13 +477,23 @@ func (s *Client) Run(ctx context.Context,
 	defer mainCancel()
 
 	// Query store before starting event loop to prevent new swaps from
-	// being treated as swaps that need to be resumed.
-	pendingLoopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	// being treated as swaps that need to be resumed. Only our pending
+	// swaps are read, so that our swap history does not slow down our
+	// startup.
+	pendingLoopOutSwaps, err := s.Store.FetchPendingLoopOuts()
 	if err != nil {
 		return err
 	}
 
-	pendingLoopInSwaps, err := s.Store.FetchLoopInSwaps()
+	pendingLoopInSwaps, err := s.Store.FetchPendingLoopIns()
+	if err != nil {
+		return err
+	}
+
+	// Swaps that were awaiting approval when we shut down can no longer be
+	// approved, so we read them now to release them, before any new swaps
+	// can await approval.
+	pendingApprovals, err := s.Store.FetchPendingApprovals()
 	if err != nil {
 		return err
 	}
@@ -272,6 +503,11 @@ func (s *Client) Run(ctx context.Context,
 	go func() {
 		defer s.wg.Done()
 
+		err := s.expirePendingApprovals(mainCtx, pendingApprovals)
+		if err != nil {
+			log.Errorf("Could not expire pending approvals: %v", err)
+		}
+
 		s.resumeSwaps(mainCtx, pendingLoopOutSwaps, pendingLoopInSwaps)
 
 		// Signal that new requests can be accepted. Otherwise the new
@@ -310,37 +546,56 @@ func (s *Client) Run(ctx context.Context,
 	return err
 }
 
-// resumeSwaps restarts all pending swaps from the provided list.
-func (s *Client) resumeSwaps(ctx context.Context,
-	loopOutSwaps []*loopdb.LoopOut, loopInSwaps []*loopdb.LoopIn) {
+// resumeSwap loads the swap with the hash provided from the store, so that it
+// can be executed again. Nil is returned if the swap is no longer pending.
+func resumeSwap(ctx context.Context, cfg *swapConfig,
+	hash lntypes.Hash) (genericSwap, error) {
 
-	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+	loopOutSwaps, err := cfg.store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
 
 	for _, pend := range loopOutSwaps {
-		if pend.State().State.Type() != loopdb.StateTypePending {
+		if pend.Hash != hash {
 			continue
 		}
-		swap, err := resumeLoopOutSwap(ctx, swapCfg, pend)
+
+		if pend.State().State.Type() != loopdb.StateTypePending {
+			return nil, nil
+		}
+
+		swap, err := resumeLoopOutSwap(ctx, cfg, pend)
 		if err != nil {
-			log.Errorf("resuming loop out swap: %v", err)
-			continue
+			return nil, err
 		}
 
-		s.executor.initiateSwap(ctx, swap)
+		return swap, nil
+	}
+
+	loopInSwaps, err := cfg.store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
 	}
 
 	for _, pend := range loopInSwaps {
-		if pend.State().State.Type() != loopdb.StateTypePending {
+		if pend.Hash != hash {
 			continue
 		}
-		swap, err := resumeLoopInSwap(ctx, swapCfg, pend)
+
+		if pend.State().State.Type() != loopdb.StateTypePending {
+			return nil, nil
+		}
+
+		swap, err := resumeLoopInSwap(ctx, cfg, pend)
 		if err != nil {
-			log.Errorf("resuming loop in swap: %v", err)
-			continue
+			return nil, err
 		}
 
-		s.executor.initiateSwap(ctx, swap)
+		return swap, nil
 	}
+
+	return nil, fmt.Errorf("swap %v not found", hash)
 }
 
 // LoopOut initiates a loop out swap. It blocks until the swap is initiation
@@ -359,26 +614,33 @@ func (s *Client) LoopOut(globalCtx context.Context,
 		request.Amount, request.DestAddr, request.OutgoingChanSet,
 	)
 
-	if err := s.waitForInitialized(globalCtx); err != nil {
+	initiationHeight, err := s.prepareLoopOut(globalCtx, request)
+	if err != nil {
 		return nil, err
 	}
 
-	// Calculate htlc expiry height.
-	terms, err := s.Server.GetLoopOutTerms(globalCtx)
+	release, err := s.reserveVolume(
+		request.Amount, request.OverrideVolumeLimits,
+	)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
-	initiationHeight := s.executor.height()
-	request.Expiry, err = s.getExpiry(
-		initiationHeight, terms, request.SweepConfTarget,
+	releaseFees, err := s.reserveFees(
+		request.Tenant, request.Label, maxLoopOutFees(request),
 	)
 	if err != nil {
 		return nil, err
 	}
+	defer releaseFees()
 
 	// Create a new swap object for this swap.
 	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+	swapCfg.serverAddress = s.ServerAddress
+	swapCfg.validator = s.validator
+	swapCfg.amountTiers = s.AmountTiers
+	swapCfg.paymentRecords = s.paymentRecordTypes()
 	initResult, err := newLoopOutSwap(
 		globalCtx, swapCfg, initiationHeight, request,
 	)
@@ -396,9 +658,38 @@ func (s *Client) LoopOut(globalCtx context.Context,
 		SwapHash:         swap.hash,
 		HtlcAddressP2WSH: swap.htlc.Address,
 		ServerMessage:    initResult.serverMessage,
+
+		StructuredServerMessage: initResult.structuredMessage,
 	}, nil
 }
 
+// prepareLoopOut waits for the client to be initialized and sets the htlc
+// expiry height of the loop out request provided. The height that the swap is
+// initiated at is returned.
+func (s *Client) prepareLoopOut(ctx context.Context,
+	request *OutRequest) (int32, error) {
+
+	if err := s.waitForInitialized(ctx); err != nil {
+		return 0, err
+	}
+
+	// Calculate htlc expiry height.
+	terms, err := s.Server.GetLoopOutTerms(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	initiationHeight := s.executor.height()
+	request.Expiry, err = s.getExpiry(
+		initiationHeight, terms, request.SweepConfTarget,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return initiationHeight, nil
+}
+
 // getExpiry returns an absolute expiry height based on the sweep confirmation
 // target, constrained by the server terms.
 func (s *Client) getExpiry(height int32, terms *LoopOutTerms,
@@ -517,9 +808,40 @@ func (s *Client) LoopIn(globalCtx context.Context,
 		return nil, err
 	}
 
+	if err := s.validateHtlcFunding(request); err != nil {
+		return nil, err
+	}
+
+	release, err := s.reserveVolume(
+		request.Amount, request.OverrideVolumeLimits,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	releaseFees, err := s.reserveFees(
+		request.Tenant, request.Label, maxLoopInFees(request),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFees()
+
+	releaseFunds, err := s.reserveWalletFunds(
+		globalCtx, loopInWalletFunds(request),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFunds()
+
 	// Create a new swap object for this swap.
 	initiationHeight := s.executor.height()
 	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+	swapCfg.serverAddress = s.ServerAddress
+	swapCfg.validator = s.validator
+	swapCfg.amountTiers = s.AmountTiers
 	initResult, err := newLoopInSwap(
 		globalCtx, swapCfg, initiationHeight, request,
 	)
@@ -537,7 +859,10 @@ func (s *Client) LoopIn(globalCtx context.Context,
 		SwapHash:          swap.hash,
 		HtlcAddressP2WSH:  swap.htlcP2WSH.Address,
 		HtlcAddressNP2WSH: swap.htlcNP2WSH.Address,
+		ExternalHtlc:      request.ExternalHtlc,
 		ServerMessage:     initResult.serverMessage,
+
+		StructuredServerMessage: initResult.structuredMessage,
 	}
 	return swapInfo, nil
 }
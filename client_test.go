@@ -6,9 +6,11 @@ import (
 	"crypto/sha256"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
@@ -76,6 +78,358 @@ func TestSuccess(t *testing.T) {
 	)
 }
 
+// TestExecuteLoopOut tests that executing a loop out blocks until the swap
+// completes, and returns its final state.
+func TestExecuteLoopOut(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	type result struct {
+		info *SwapInfo
+		err  error
+	}
+	resultChan := make(chan result, 1)
+
+	go func() {
+		info, err := ctx.swapClient.ExecuteLoopOut(
+			context.Background(), testRequest,
+		)
+		resultChan <- result{info: info, err: err}
+	}()
+
+	ctx.assertStored()
+
+	// Our swap's first update tells us its hash, which we need to check
+	// the preimage that it sweeps with.
+	var initiated SwapInfo
+	select {
+	case initiated = <-ctx.statusChan:
+	case <-time.After(test.Timeout):
+		t.Fatal("swap not initiated")
+	}
+	require.Equal(t, loopdb.StateInitiated, initiated.State)
+
+	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
+	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
+
+	confIntent := ctx.AssertRegisterConf(false, 1)
+
+	// The swap is still pending, so we must not have a result yet.
+	select {
+	case <-resultChan:
+		t.Fatal("unexpected result for pending swap")
+	default:
+	}
+
+	testSuccess(ctx, testRequest.Amount, initiated.SwapHash,
+		signalPrepaymentResult, signalSwapPaymentResult, false,
+		confIntent, swap.HtlcV2,
+	)
+
+	select {
+	case res := <-resultChan:
+		require.NoError(t, res.err)
+		require.Equal(t, initiated.SwapHash, res.info.SwapHash)
+		require.Equal(t, loopdb.StateSuccess, res.info.State)
+
+	case <-time.After(test.Timeout):
+		t.Fatal("no result for completed swap")
+	}
+}
+
+// TestAmountTierConfirmations tests that loop outs wait for the number of htlc
+// confirmations that our amount tiers require if it exceeds the number that
+// was requested.
+func TestAmountTierConfirmations(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+	ctx.swapClient.AmountTiers = AmountTiers{
+		{
+			MinAmount:         testRequest.Amount,
+			HtlcConfirmations: 3,
+		},
+	}
+
+	req := *testRequest
+	info, err := ctx.swapClient.LoopOut(context.Background(), &req)
+	require.NoError(t, err)
+
+	ctx.assertStored()
+	ctx.assertStatus(loopdb.StateInitiated)
+
+	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
+	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
+
+	confIntent := ctx.AssertRegisterConf(false, 3)
+
+	testSuccess(ctx, testRequest.Amount, info.SwapHash,
+		signalPrepaymentResult, signalSwapPaymentResult, false,
+		confIntent, swap.HtlcV2,
+	)
+}
+
+// TestReservation tests that a reserved swap is only persisted and executed
+// once it is confirmed, and that it may only be confirmed once.
+func TestReservation(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	req := *testRequest
+	reservation, err := ctx.swapClient.ReserveLoopOut(
+		context.Background(), &req,
+	)
+	require.NoError(t, err)
+	require.Equal(t, swap.TypeOut, reservation.SwapType)
+	require.Equal(t, testRequest.Amount, reservation.Amount)
+	require.NotNil(t, reservation.HtlcAddressP2WSH)
+
+	_, err = ctx.swapClient.ConfirmReservation(
+		context.Background(), lntypes.Hash{1},
+	)
+	require.Equal(t, ErrReservationNotFound, err)
+
+	_, err = ctx.swapClient.ConfirmReservation(
+		context.Background(), reservation.SwapHash,
+	)
+	require.NoError(t, err)
+
+	ctx.assertStored()
+	ctx.assertStatus(loopdb.StateInitiated)
+
+	_, err = ctx.swapClient.ConfirmReservation(
+		context.Background(), reservation.SwapHash,
+	)
+	require.Equal(t, ErrReservationNotFound, err)
+
+	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
+	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
+
+	confIntent := ctx.AssertRegisterConf(false, defaultConfirmations)
+
+	testSuccess(ctx, testRequest.Amount, reservation.SwapHash,
+		signalPrepaymentResult, signalSwapPaymentResult, false,
+		confIntent, swap.HtlcV2,
+	)
+}
+
+// TestReservationExpired tests that reservations cannot be confirmed after
+// their expiry.
+func TestReservationExpired(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	req := *testRequest
+	reservation, err := ctx.swapClient.ReserveLoopOut(
+		context.Background(), &req,
+	)
+	require.NoError(t, err)
+
+	ctx.swapClient.reservationsLock.Lock()
+	ctx.swapClient.reservations[reservation.SwapHash].info.Expiry =
+		time.Now().Add(-time.Second)
+	ctx.swapClient.reservationsLock.Unlock()
+
+	_, err = ctx.swapClient.ConfirmReservation(
+		context.Background(), reservation.SwapHash,
+	)
+	require.Equal(t, ErrReservationExpired, err)
+
+	ctx.finish()
+}
+
+// TestApprovedSwap tests that approved swaps are executed and stored with
+// their approval.
+func TestApprovedSwap(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	req := *testRequest
+	reservation, err := ctx.swapClient.RequestLoopOutApproval(
+		context.Background(), &req, "requester", time.Hour,
+	)
+	require.NoError(t, err)
+
+	require.Contains(t, ctx.store.pendingApprovals, reservation.SwapHash)
+	require.Equal(
+		t, "requester",
+		ctx.store.pendingApprovals[reservation.SwapHash].Requester,
+	)
+
+	// The requester of the swap may not approve it, but it can still be
+	// approved by someone else.
+	_, err = ctx.swapClient.ApproveSwap(
+		context.Background(), reservation.SwapHash, "requester",
+	)
+	require.Equal(t, ErrSelfApproval, err)
+
+	_, err = ctx.swapClient.ApproveSwap(
+		context.Background(), reservation.SwapHash, "approver",
+	)
+	require.NoError(t, err)
+	require.Empty(t, ctx.store.pendingApprovals)
+
+	select {
+	case stored := <-ctx.store.loopOutStoreChan:
+		require.NotNil(t, stored.Approval)
+		require.Equal(t, "approver", stored.Approval.Approver)
+		require.Equal(t, "requester", stored.Approval.Requester)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("expected swap to be stored")
+	}
+	ctx.assertStatus(loopdb.StateInitiated)
+
+	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
+	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
+
+	confIntent := ctx.AssertRegisterConf(false, defaultConfirmations)
+
+	testSuccess(ctx, testRequest.Amount, reservation.SwapHash,
+		signalPrepaymentResult, signalSwapPaymentResult, false,
+		confIntent, swap.HtlcV2,
+	)
+}
+
+// TestApproval tests that swaps which require approval can only be approved
+// or denied, and that other reservations cannot be approved.
+func TestApproval(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	req := *testRequest
+	reservation, err := ctx.swapClient.RequestLoopOutApproval(
+		context.Background(), &req, "requester", time.Hour,
+	)
+	require.NoError(t, err)
+	require.True(t, reservation.RequiresApproval)
+
+	require.Equal(
+		t, []*SwapReservation{reservation},
+		ctx.swapClient.PendingApprovals(context.Background()),
+	)
+
+	_, err = ctx.swapClient.ConfirmReservation(
+		context.Background(), reservation.SwapHash,
+	)
+	require.Equal(t, ErrApprovalRequired, err)
+
+	err = ctx.swapClient.DenySwap(
+		context.Background(), reservation.SwapHash, "denier", "reason",
+	)
+	require.NoError(t, err)
+	require.Empty(t, ctx.swapClient.PendingApprovals(context.Background()))
+	require.Empty(t, ctx.store.pendingApprovals)
+
+	// The denial should be stored with the swap's requester.
+	require.Len(t, ctx.store.swapDenials, 1)
+	denial := ctx.store.swapDenials[0]
+	require.Equal(t, reservation.SwapHash, denial.Hash)
+	require.Equal(t, "requester", denial.Requester)
+	require.Equal(t, "denier", denial.Denier)
+	require.Equal(t, "reason", denial.Reason)
+	require.False(t, denial.Expired)
+
+	_, err = ctx.swapClient.ApproveSwap(
+		context.Background(), reservation.SwapHash, "approver",
+	)
+	require.Equal(t, ErrReservationNotFound, err)
+
+	// Reservations that do not require approval cannot be approved.
+	reservation, err = ctx.swapClient.ReserveLoopOut(
+		context.Background(), &req,
+	)
+	require.NoError(t, err)
+
+	_, err = ctx.swapClient.ApproveSwap(
+		context.Background(), reservation.SwapHash, "approver",
+	)
+	require.Equal(t, ErrApprovalRequired, err)
+
+	ctx.finish()
+}
+
+// TestExpirePendingApprovals tests that swaps which were awaiting approval
+// when we shut down are released and recorded as expired, and that the
+// invoices of loop ins that were not approved are cancelled.
+func TestExpirePendingApprovals(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	// Wait for the client to read its swaps on startup before we add to
+	// our store.
+	err := ctx.swapClient.waitForInitialized(context.Background())
+	require.NoError(t, err)
+
+	var (
+		loopOut = &loopdb.PendingApproval{
+			Hash:     lntypes.Hash{1},
+			SwapType: swap.TypeOut,
+		}
+		loopIn = &loopdb.PendingApproval{
+			Hash:     lntypes.Hash{2},
+			SwapType: swap.TypeIn,
+		}
+		approvedLoopIn = &loopdb.PendingApproval{
+			Hash:     lntypes.Hash{3},
+			SwapType: swap.TypeIn,
+		}
+	)
+
+	ctx.store.loopInSwaps[approvedLoopIn.Hash] = &loopdb.LoopInContract{}
+
+	for _, approval := range []*loopdb.PendingApproval{
+		loopOut, loopIn, approvedLoopIn,
+	} {
+		ctx.store.pendingApprovals[approval.Hash] = approval
+	}
+
+	approvals, err := ctx.store.FetchPendingApprovals()
+	require.NoError(t, err)
+
+	err = ctx.swapClient.expirePendingApprovals(
+		context.Background(), approvals,
+	)
+	require.NoError(t, err)
+	require.Empty(t, ctx.store.pendingApprovals)
+
+	// Only the swaps that were not approved should have expired.
+	expired := make(map[lntypes.Hash]bool)
+	for _, denial := range ctx.store.swapDenials {
+		require.True(t, denial.Expired)
+		expired[denial.Hash] = true
+	}
+	require.Equal(
+		t, map[lntypes.Hash]bool{loopOut.Hash: true, loopIn.Hash: true},
+		expired,
+	)
+
+	// Only the invoice of the loop in that was not approved should have
+	// been cancelled.
+	select {
+	case hash := <-ctx.Lnd.FailInvoiceChannel:
+		require.Equal(t, loopIn.Hash, hash)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("expected invoice to be cancelled")
+	}
+
+	select {
+	case hash := <-ctx.Lnd.FailInvoiceChannel:
+		t.Fatalf("unexpected invoice cancelled: %v", hash)
+
+	default:
+	}
+
+	ctx.finish()
+}
+
 // TestFailOffchain tests the handling of swap for which the server failed the
 // payments.
 func TestFailOffchain(t *testing.T) {
@@ -166,35 +520,45 @@ func TestResume(t *testing.T) {
 		t.Run(version.String(), func(t *testing.T) {
 			t.Run("not expired", func(t *testing.T) {
 				testResume(
-					t, defaultConfs, false, false, true,
-					version,
+					t, defaultConfs, false, false, false,
+					true, version,
 				)
 			})
 			t.Run("not expired, custom confirmations",
 				func(t *testing.T) {
 					testResume(
-						t, 3, false, false, true,
-						version,
+						t, 3, false, false, false,
+						true, version,
 					)
 				})
 			t.Run("expired not revealed", func(t *testing.T) {
 				testResume(
 					t, defaultConfs, true, false, false,
-					version,
+					false, version,
 				)
 			})
 			t.Run("expired revealed", func(t *testing.T) {
 				testResume(
-					t, defaultConfs, true, true, true,
-					version,
+					t, defaultConfs, true, true, false,
+					true, version,
+				)
+			})
+			t.Run("revealed, htlc replaced", func(t *testing.T) {
+				testResume(
+					t, defaultConfs, false, true, true,
+					true, version,
 				)
 			})
 		})
 	}
 }
 
+// testResume tests resuming a loop out swap. If htlcReplaced is set, the htlc
+// tx that a swap with a revealed preimage recorded is replaced by a different
+// tx before it confirms.
 func testResume(t *testing.T, confs uint32, expired, preimageRevealed,
-	expectSuccess bool, protocolVersion loopdb.ProtocolVersion) {
+	htlcReplaced, expectSuccess bool,
+	protocolVersion loopdb.ProtocolVersion) {
 
 	defer test.Guard(t)()
 
@@ -231,7 +595,6 @@ func testResume(t *testing.T, confs uint32, expired, preimageRevealed,
 
 	if preimageRevealed {
 		update.State = loopdb.StatePreimageRevealed
-		update.HtlcTxHash = &chainhash.Hash{1, 2, 6}
 	}
 
 	// Create a pending swap with our custom number of confirmations.
@@ -266,6 +629,23 @@ func testResume(t *testing.T, confs uint32, expired, preimageRevealed,
 		pendingSwap.Contract.CltvExpiry = 610
 	}
 
+	scriptVersion := GetHtlcScriptVersion(protocolVersion)
+	htlc, err := swap.NewHtlc(
+		scriptVersion, pendingSwap.Contract.CltvExpiry, senderKey,
+		receiverKey, hash, swap.HtlcP2WSH, &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	// Swaps that revealed their preimage record the htlc tx that they
+	// sweep. Unless it is replaced, this is the tx that we publish below.
+	htlcTxHash := newHtlcTx(htlc.PkScript, amt).TxHash()
+	if preimageRevealed {
+		update.HtlcTxHash = &htlcTxHash
+		if htlcReplaced {
+			update.HtlcTxHash = &chainhash.Hash{1, 2, 6}
+		}
+	}
+
 	ctx := createClientTestContext(t, []*loopdb.LoopOut{pendingSwap})
 
 	if preimageRevealed {
@@ -281,14 +661,17 @@ func testResume(t *testing.T, confs uint32, expired, preimageRevealed,
 	confIntent := ctx.AssertRegisterConf(preimageRevealed, int32(confs))
 
 	// Assert that the loopout htlc equals to the expected one.
-	scriptVersion := GetHtlcScriptVersion(protocolVersion)
-	htlc, err := swap.NewHtlc(
-		scriptVersion, pendingSwap.Contract.CltvExpiry, senderKey,
-		receiverKey, hash, swap.HtlcP2WSH, &chaincfg.TestNet3Params,
-	)
-	require.NoError(t, err)
 	require.Equal(t, htlc.PkScript, confIntent.PkScript)
 
+	// If we recorded the htlc tx, we also watch for any tx that produces
+	// the htlc script output in case it is replaced.
+	if preimageRevealed {
+		replacementIntent := ctx.AssertRegisterConf(
+			false, int32(confs),
+		)
+		require.Equal(t, htlc.PkScript, replacementIntent.PkScript)
+	}
+
 	signalSwapPaymentResult(nil)
 	signalPrepaymentResult(nil)
 
@@ -299,6 +682,24 @@ func testResume(t *testing.T, confs uint32, expired, preimageRevealed,
 		return
 	}
 
+	// If the htlc tx was replaced, the swap records the replacement once
+	// it confirms, and then sweeps it.
+	if htlcReplaced {
+		htlcOutpoint := ctx.publishHtlc(htlc.PkScript, amt)
+		require.Equal(t, htlcTxHash, htlcOutpoint.Hash)
+
+		ctx.assertStatus(loopdb.StatePreimageRevealed)
+		state := <-ctx.store.loopOutUpdateChan
+		require.Equal(t, loopdb.StatePreimageRevealed, state.State)
+		require.Equal(t, &htlcTxHash, state.HtlcTxHash)
+
+		testSweep(
+			ctx, hash, htlc.PkScript, htlcOutpoint, true,
+			func(r error) {}, scriptVersion,
+		)
+		return
+	}
+
 	// Because there is no reliable payment yet, an invoice is assumed to be
 	// paid after resume.
 
@@ -319,7 +720,19 @@ func testSuccess(ctx *testContext, amt btcutil.Amount, hash lntypes.Hash,
 
 	signalPrepaymentResult(nil)
 
-	ctx.AssertRegisterSpendNtfn(confIntent.PkScript)
+	testSweep(
+		ctx, hash, confIntent.PkScript, htlcOutpoint, preimageRevealed,
+		signalSwapPaymentResult, scriptVersion,
+	)
+}
+
+// testSweep tests that a loop out swap sweeps the htlc outpoint provided, and
+// succeeds once its sweep confirms.
+func testSweep(ctx *testContext, hash lntypes.Hash, pkScript []byte,
+	htlcOutpoint wire.OutPoint, preimageRevealed bool,
+	signalSwapPaymentResult func(error), scriptVersion swap.ScriptVersion) {
+
+	ctx.AssertRegisterSpendNtfn(pkScript)
 
 	// Assert that a call to track payment was sent, and respond with status
 	// in flight so that our swap will push its preimage to the server.
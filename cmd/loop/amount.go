@@ -0,0 +1,134 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/urfave/cli"
+)
+
+// amtUnitsUsage describes the units that amounts may be given in, for use in
+// the usage of amount flags.
+const amtUnitsUsage = "(in sat unless a unit is given, e.g. 250ksat, 2.5m " +
+	"or 0.0025btc)"
+
+var (
+	// amtPattern matches an amount: a number, which may have a decimal
+	// point, followed by an optional unit.
+	amtPattern = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-z]*)$`)
+
+	// amtUnits maps the units that amounts may be given in to the number
+	// of millisatoshis in one unit.
+	amtUnits = map[string]int64{
+		"msat":  1,
+		"msats": 1,
+		"sat":   1000,
+		"sats":  1000,
+		"k":     1000 * 1000,
+		"ksat":  1000 * 1000,
+		"ksats": 1000 * 1000,
+		"m":     1000 * 1000 * 1000,
+		"btc":   1000 * btcutil.SatoshiPerBitcoin,
+	}
+
+	// errAmtAmbiguous is returned for decimal amounts without a unit,
+	// which could be meant as either satoshis or bitcoin.
+	errAmtAmbiguous = errors.New("decimal amounts require a unit, " +
+		"e.g. 0.01btc")
+
+	// errAmtFractionalSat is returned for amounts that are not a whole
+	// number of satoshis.
+	errAmtFractionalSat = errors.New("amount is not a whole number of " +
+		"satoshis")
+
+	// errAmtTooLarge is returned for amounts that exceed the bitcoin
+	// supply.
+	errAmtTooLarge = fmt.Errorf("amount exceeds %v",
+		btcutil.Amount(btcutil.MaxSatoshi))
+)
+
+// parseAmt parses an amount in satoshis. A unit may follow the amount,
+// optionally separated by a space, so that the amounts that we print (such as
+// "7262 sat" and "0.001 BTC") can be passed back to us. The units are not case
+// sensitive, and are:
+//   - sat or sats: satoshis, the default for amounts without a unit.
+//   - k, ksat or ksats: thousands of satoshis.
+//   - m: millions of satoshis, so 2.5m is 2500000 sat.
+//   - msat or msats: millisatoshis, which must add up to whole satoshis.
+//   - btc: bitcoin.
+//
+// Amounts without a unit must be whole numbers, since a decimal amount could
+// be meant as either satoshis or bitcoin.
+func parseAmt(text string) (btcutil.Amount, error) {
+	amt, err := parseAmtUnits(text)
+	if err != nil {
+		return 0, fmt.Errorf("%v %q: %w", tr("invalid amt value"),
+			text, err)
+	}
+
+	return amt, nil
+}
+
+// parseAmtUnits parses an amount with an optional unit, as described in
+// parseAmt.
+func parseAmtUnits(text string) (btcutil.Amount, error) {
+	match := amtPattern.FindStringSubmatch(
+		strings.ToLower(strings.TrimSpace(text)),
+	)
+	if match == nil {
+		return 0, errors.New("expected a number followed by an " +
+			"optional unit")
+	}
+
+	number, unit := match[1], match[2]
+
+	msatPerUnit := int64(1000)
+	if unit != "" {
+		var ok bool
+		msatPerUnit, ok = amtUnits[unit]
+		if !ok {
+			return 0, fmt.Errorf("unknown unit %q", unit)
+		}
+	} else if strings.Contains(number, ".") {
+		return 0, errAmtAmbiguous
+	}
+
+	// We parse the number as a rational to convert it to satoshis without
+	// the rounding errors of floating point arithmetic.
+	msat, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, fmt.Errorf("invalid number %q", number)
+	}
+	msat.Mul(msat, new(big.Rat).SetInt64(msatPerUnit))
+
+	sat := new(big.Rat).Quo(msat, big.NewRat(1000, 1))
+	if !sat.IsInt() {
+		return 0, errAmtFractionalSat
+	}
+
+	if sat.Num().Cmp(big.NewInt(btcutil.MaxSatoshi)) > 0 {
+		return 0, errAmtTooLarge
+	}
+
+	return btcutil.Amount(sat.Num().Int64()), nil
+}
+
+// parseAmtFlag parses the amount set for the flag provided, returning zero if
+// the flag is not set.
+func parseAmtFlag(ctx *cli.Context, name string) (btcutil.Amount, error) {
+	if !ctx.IsSet(name) {
+		return 0, nil
+	}
+
+	amt, err := parseAmtUnits(ctx.String(name))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v %q: %w", name,
+			ctx.String(name), err)
+	}
+
+	return amt, nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseAmtUnits tests parsing of amounts with and without units.
+func TestParseAmtUnits(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		amount btcutil.Amount
+		err    error
+	}{
+		{
+			name:   "no unit",
+			text:   "250000",
+			amount: 250000,
+		},
+		{
+			name:   "millions",
+			text:   "2.5m",
+			amount: 2500000,
+		},
+		{
+			name:   "bitcoin",
+			text:   "0.0025btc",
+			amount: 250000,
+		},
+		{
+			name:   "thousands",
+			text:   "250ksat",
+			amount: 250000,
+		},
+		{
+			name:   "whole millisatoshis",
+			text:   "1500000msat",
+			amount: 1500,
+		},
+		{
+			name: "fractional millisatoshis",
+			text: "1500msat",
+			err:  errAmtFractionalSat,
+		},
+		{
+			name: "decimal without unit",
+			text: "1.5",
+			err:  errAmtAmbiguous,
+		},
+		{
+			name: "fractional satoshis",
+			text: "1.5sat",
+			err:  errAmtFractionalSat,
+		},
+		{
+			name: "fractional bitcoin",
+			text: "0.000000001btc",
+			err:  errAmtFractionalSat,
+		},
+		{
+			name:   "maximum",
+			text:   "21000000btc",
+			amount: btcutil.MaxSatoshi,
+		},
+		{
+			name: "exceeds maximum",
+			text: "21000000.00000001btc",
+			err:  errAmtTooLarge,
+		},
+		{
+			name:   "printed satoshis",
+			text:   "7262 sat",
+			amount: 7262,
+		},
+		{
+			name:   "surrounding whitespace",
+			text:   " 7262 sat ",
+			amount: 7262,
+		},
+		{
+			name:   "upper case unit",
+			text:   "0.001 BTC",
+			amount: 100000,
+		},
+		{
+			name:   "mixed case unit",
+			text:   "2.5M",
+			amount: 2500000,
+		},
+		{
+			name:   "mixed case ksat",
+			text:   "10kSat",
+			amount: 10000,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			amount, err := parseAmtUnits(testCase.text)
+			require.True(t, errors.Is(err, testCase.err))
+			require.Equal(t, testCase.amount, amount)
+		})
+	}
+
+	// Amounts that are not a number or have an unknown unit are rejected.
+	for _, text := range []string{"", "abc", "1.5 sats sats", "10bits"} {
+		_, err := parseAmtUnits(text)
+		require.Error(t, err, text)
+	}
+}
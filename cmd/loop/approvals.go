@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/loop/loopclient"
+	"github.com/lightninglabs/loop/loopd"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var approvalsCommand = cli.Command{
+	Name:  "approvals",
+	Usage: "manage swaps that are awaiting approval",
+	Description: "Swaps that exceed the daemon's approval threshold " +
+		"are only executed once they are approved with a macaroon " +
+		"that holds the approvals:write permission, such as the " +
+		"approver.macaroon that loopd creates next to loop.macaroon. " +
+		"Swaps cannot be approved with the macaroon that requested " +
+		"them.",
+	Subcommands: []cli.Command{
+		listApprovalsCommand,
+		approveSwapCommand,
+		denySwapCommand,
+		listDenialsCommand,
+		approvalIdentityCommand,
+	},
+}
+
+var approvalIdentityCommand = cli.Command{
+	Name:  "identity",
+	Usage: "show the identity that approvals are recorded under",
+	Description: "Shows the identity of the macaroon that is used by " +
+		"the cli, which is recorded with the swaps that it " +
+		"requests, approves or denies.",
+	Action: approvalIdentity,
+}
+
+func approvalIdentity(ctx *cli.Context) error {
+	_, macPath, err := extractPathArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	mac, err := loopclient.LoadMacaroon(macPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(loopd.MacaroonIdentity(mac))
+
+	return nil
+}
+
+var listApprovalsCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list the swaps that are awaiting approval",
+	Action: listApprovals,
+}
+
+func listApprovals(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListPendingApprovals(
+		context.Background(), &looprpc.ListPendingApprovalsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var approveSwapCommand = cli.Command{
+	Name:      "approve",
+	Usage:     "execute a swap that is awaiting approval",
+	ArgsUsage: "id",
+	Action:    approveSwap,
+}
+
+func approveSwap(ctx *cli.Context) error {
+	id, err := approvalID(ctx, "approve")
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ApproveSwap(
+		context.Background(), &looprpc.ApproveSwapRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Swap approved\n")
+	fmt.Printf("ID:             %x\n", resp.IdBytes)
+	if !printHtlcAddresses(resp.HtlcAddresses) {
+		fmt.Printf("HTLC address:   %v\n",
+			resp.HtlcAddress) // nolint:staticcheck
+	}
+	fmt.Println()
+	fmt.Println(tr("Run `loop monitor` to monitor progress."))
+
+	return nil
+}
+
+var denySwapCommand = cli.Command{
+	Name:      "deny",
+	Usage:     "cancel a swap that is awaiting approval",
+	ArgsUsage: "id",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "reason",
+			Usage: "the reason for denying the swap, which is " +
+				"recorded with the denial",
+		},
+	},
+	Action: denySwap,
+}
+
+func denySwap(ctx *cli.Context) error {
+	id, err := approvalID(ctx, "deny")
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.DenySwap(
+		context.Background(), &looprpc.DenySwapRequest{
+			Id:     id,
+			Reason: ctx.String("reason"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Swap %x denied\n", id)
+
+	return nil
+}
+
+var listDenialsCommand = cli.Command{
+	Name:   "denials",
+	Usage:  "list the swaps that were denied or expired",
+	Action: listDenials,
+}
+
+func listDenials(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListSwapDenials(
+		context.Background(), &looprpc.ListSwapDenialsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// approvalID reads the swap id argument of an approvals subcommand.
+func approvalID(ctx *cli.Context, command string) ([]byte, error) {
+	if ctx.NArg() != 1 {
+		return nil, cli.ShowCommandHelp(ctx, command)
+	}
+
+	id, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return nil, fmt.Errorf("invalid swap id: %v", err)
+	}
+
+	return id, nil
+}
+
+// printPendingApproval displays a swap that must be approved before it is
+// executed.
+func printPendingApproval(resp *looprpc.SwapResponse) {
+	fmt.Printf("Swap awaiting approval\n")
+	fmt.Printf("ID:             %x\n", resp.IdBytes)
+	fmt.Println()
+	fmt.Printf("The swap exceeds the daemon's approval threshold. Run "+
+		"`loop approvals approve %x` with a different macaroon, "+
+		"such as approver.macaroon, before %v to execute it.\n",
+		resp.IdBytes, time.Unix(resp.ApprovalExpiryUnix, 0).Format(
+			time.RFC3339,
+		))
+}
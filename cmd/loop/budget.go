@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var (
+	budgetCommand = cli.Command{
+		Name:  "budget",
+		Usage: "manage the fee budgets of tenants and labels",
+		Description: "Caps the fees that the swaps of a tenant or " +
+			"label may spend, and reports the costs of swaps " +
+			"aggregated by tenant or label.",
+		Subcommands: []cli.Command{
+			setBudgetCommand, listBudgetsCommand,
+			deleteBudgetCommand, costStatementCommand,
+		},
+	}
+
+	namespaceFlag = cli.StringFlag{
+		Name:  "namespace",
+		Usage: "the kind of name {label, tenant}",
+		Value: "label",
+	}
+
+	setBudgetCommand = cli.Command{
+		Name:      "set",
+		Usage:     "set the fee budget of a tenant or label",
+		ArgsUsage: "name",
+		Description: `
+	Caps the total fees that the swaps of a tenant or label may spend over
+	a rolling period, replacing any existing budget for the same name.
+	Swaps whose maximum fees would exceed the budget are rejected.`,
+		Flags: []cli.Flag{
+			namespaceFlag,
+			cli.StringFlag{
+				Name:  "budget",
+				Usage: "the maximum total fees " + amtUnitsUsage,
+			},
+			cli.DurationFlag{
+				Name: "period",
+				Usage: "the rolling period that the budget is " +
+					"enforced over, if not set all swaps " +
+					"count towards the budget",
+			},
+		},
+		Action: setBudget,
+	}
+
+	listBudgetsCommand = cli.Command{
+		Name:   "list",
+		Usage:  "list all fee budgets and the fees spent from them",
+		Action: listBudgets,
+	}
+
+	deleteBudgetCommand = cli.Command{
+		Name:      "delete",
+		Usage:     "delete the fee budget of a tenant or label",
+		ArgsUsage: "name",
+		Flags: []cli.Flag{
+			namespaceFlag,
+		},
+		Action: deleteBudget,
+	}
+
+	costStatementCommand = cli.Command{
+		Name:  "statement",
+		Usage: "show the costs of swaps by tenant or label",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name: "groupby",
+				Usage: "the kind of name that costs are " +
+					"aggregated by {label, tenant}",
+				Value: "label",
+			},
+			cli.Uint64Flag{
+				Name: "start",
+				Usage: "the unix timestamp from which swaps " +
+					"are included",
+			},
+			cli.Uint64Flag{
+				Name: "end",
+				Usage: "the unix timestamp up to which swaps " +
+					"are included",
+			},
+		},
+		Action: costStatement,
+	}
+)
+
+// parseNamespace parses the budget namespace provided.
+func parseNamespace(namespace string) (looprpc.BudgetNamespace, error) {
+	switch namespace {
+	case "label":
+		return looprpc.BudgetNamespace_BUDGET_NAMESPACE_LABEL, nil
+
+	case "tenant":
+		return looprpc.BudgetNamespace_BUDGET_NAMESPACE_TENANT, nil
+
+	default:
+		return 0, fmt.Errorf("unknown namespace: %v", namespace)
+	}
+}
+
+func setBudget(ctx *cli.Context) error {
+	if ctx.NArg() != 1 || !ctx.IsSet("budget") {
+		return cli.ShowCommandHelp(ctx, "set")
+	}
+
+	namespace, err := parseNamespace(ctx.String("namespace"))
+	if err != nil {
+		return err
+	}
+
+	budgetAmt, err := parseAmtFlag(ctx, "budget")
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	budget := &looprpc.FeeBudget{
+		Namespace: namespace,
+		Name:      ctx.Args().First(),
+		BudgetSat: int64(budgetAmt),
+		PeriodSec: uint64(ctx.Duration("period") / time.Second),
+	}
+
+	_, err = client.SetFeeBudget(
+		context.Background(), &looprpc.SetFeeBudgetRequest{
+			Budget: budget,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Set fee budget for %v %v\n", ctx.String("namespace"),
+		budget.Name)
+
+	return nil
+}
+
+func listBudgets(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListFeeBudgets(
+		context.Background(), &looprpc.ListFeeBudgetsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func deleteBudget(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "delete")
+	}
+
+	namespace, err := parseNamespace(ctx.String("namespace"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.DeleteFeeBudget(
+		context.Background(), &looprpc.DeleteFeeBudgetRequest{
+			Namespace: namespace,
+			Name:      ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted fee budget for %v %v\n", ctx.String("namespace"),
+		ctx.Args().First())
+
+	return nil
+}
+
+func costStatement(ctx *cli.Context) error {
+	groupBy, err := parseNamespace(ctx.String("groupby"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetCostStatement(
+		context.Background(), &looprpc.CostStatementRequest{
+			GroupBy:   groupBy,
+			StartTime: ctx.Uint64("start"),
+			EndTime:   ctx.Uint64("end"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
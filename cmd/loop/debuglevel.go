@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var debugLevelCommand = cli.Command{
+	Name:  "debuglevel",
+	Usage: "set the log levels of loopd's subsystems",
+	Description: "Sets the log level of all of loopd's subsystems, or of " +
+		"individual subsystems, without restarting the daemon.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "show",
+			Usage: "list the subsystems that are available",
+		},
+		cli.StringFlag{
+			Name: "level",
+			Usage: "the level to set for all subsystems {trace, " +
+				"debug, info, warn, error, critical}, or a " +
+				"comma separated list of <subsystem>=<level> " +
+				"pairs to set the levels of individual " +
+				"subsystems",
+		},
+	},
+	Action: debugLevel,
+}
+
+func debugLevel(ctx *cli.Context) error {
+	if !ctx.Bool("show") && !ctx.IsSet("level") {
+		return cli.ShowCommandHelp(ctx, "debuglevel")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.DebugLevel(
+		context.Background(), &looprpc.DebugLevelRequest{
+			Show:      ctx.Bool("show"),
+			LevelSpec: ctx.String("level"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/urfave/cli"
+)
+
+var dispatchRecordsCommand = cli.Command{
+	Name:  "dispatchrecords",
+	Usage: "show the rules that dispatched suggested swaps",
+	Description: "Displays a record of each suggested swap that was " +
+		"dispatched by autoloop or by applying suggestions, linking " +
+		"the swap to the liquidity rules that it was suggested for, " +
+		"the limits that were suggested and the balances of its " +
+		"channels when it was dispatched. This can be used to trace " +
+		"the outcome of a swap back to the rule that caused it.",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "the time from which to show records, " +
+				"expressed as a unix timestamp in seconds",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "the time until which to show records, " +
+				"expressed as a unix timestamp in seconds",
+		},
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "only show the record of the swap with this id",
+		},
+	},
+	Action: dispatchRecords,
+}
+
+func dispatchRecords(ctx *cli.Context) error {
+	req := &looprpc.ListDispatchRecordsRequest{
+		StartTime: ctx.Int64("start"),
+		EndTime:   ctx.Int64("end"),
+	}
+
+	if ctx.IsSet("id") {
+		id := ctx.String("id")
+		if len(id) != hex.EncodedLen(lntypes.HashSize) {
+			return fmt.Errorf("invalid swap ID")
+		}
+
+		idBytes, err := hex.DecodeString(id)
+		if err != nil {
+			return fmt.Errorf("cannot hex decode id: %v", err)
+		}
+		req.Id = idBytes
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	records, err := client.ListDispatchRecords(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(records)
+
+	return nil
+}
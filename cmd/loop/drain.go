@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var drainCommand = cli.Command{
+	Name:      "drain",
+	Usage:     "loop out as much as possible from a channel",
+	ArgsUsage: "chan_id",
+	Description: `
+	Dispatches loop out swaps over a channel, one at a time, until the
+	channel cannot carry another swap, leaving its reserve in the channel.
+	If --close is set, the channel is then closed cooperatively if its
+	local balance has fallen below --close_threshold. Interrupting the
+	command stops further swaps, but a swap in flight continues.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "the address that the swaps are swept to, if " +
+				"not set a new wallet address is used for " +
+				"each swap",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks from the swap " +
+				"initiation height that the on-chain htlc " +
+				"should be swept within",
+		},
+		cli.Uint64Flag{
+			Name: "fee_ppm",
+			Usage: "the portion of each swap's amount that may be " +
+				"spent on fees in parts per million, defaults " +
+				"to 20000 (2%)",
+		},
+		cli.StringFlag{
+			Name: "reserve",
+			Usage: "the local balance to leave in the channel " +
+				amtUnitsUsage + ", defaults to 1% of its " +
+				"capacity",
+		},
+		cli.Uint64Flag{
+			Name:  "max_swaps",
+			Usage: "the maximum number of swaps to dispatch",
+		},
+		cli.BoolFlag{
+			Name:  "close",
+			Usage: "close the channel once it is drained",
+		},
+		cli.StringFlag{
+			Name: "close_threshold",
+			Usage: "the local balance " + amtUnitsUsage + " below " +
+				"which the drained channel is closed",
+		},
+		cli.Uint64Flag{
+			Name: "close_sat_per_vbyte",
+			Usage: "the fee rate of the closing transaction, if " +
+				"not set lnd estimates the fee rate",
+		},
+		labelFlag,
+	},
+	Action: drainChannel,
+}
+
+func drainChannel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "drain")
+	}
+
+	chanID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("error parsing channel id %v",
+			ctx.Args().First())
+	}
+
+	reserve, err := parseAmtFlag(ctx, "reserve")
+	if err != nil {
+		return err
+	}
+
+	closeThreshold, err := parseAmtFlag(ctx, "close_threshold")
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.DrainChannel(
+		context.Background(), &looprpc.DrainChannelRequest{
+			Channel:           chanID,
+			Dest:              ctx.String("addr"),
+			SweepConfTarget:   int32(ctx.Uint64("conf_target")),
+			FeePpm:            ctx.Uint64("fee_ppm"),
+			ReserveSat:        uint64(reserve),
+			MaxSwaps:          uint32(ctx.Uint64("max_swaps")),
+			Close:             ctx.Bool("close"),
+			CloseThresholdSat: uint64(closeThreshold),
+			CloseSatPerVbyte:  ctx.Uint64("close_sat_per_vbyte"),
+			Label:             ctx.String(labelFlag.Name),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("recv: %v", err)
+		}
+
+		printRespJSON(update)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/qrcode"
+)
+
+// primaryHtlcAddress returns the htlc address that an external wallet should
+// pay for the loop in swap provided. Older daemons do not mark their primary
+// address, so we fall back to the nested segwit address that they use for
+// external htlcs.
+func primaryHtlcAddress(resp *looprpc.SwapResponse) string {
+	for _, address := range resp.HtlcAddresses.GetAddresses() {
+		if address.Primary {
+			return address.Address
+		}
+	}
+
+	return resp.HtlcAddressNp2Wsh
+}
+
+// printPaymentRequest displays the address and amount that an external wallet
+// must pay, along with the BIP21 uri that requests the payment and a QR code
+// of the uri that wallets can scan.
+func printPaymentRequest(address string, amt btcutil.Amount, uri string) {
+	fmt.Println()
+	fmt.Printf("Pay exactly %v to %v\n", amt, address)
+	fmt.Printf("Payment uri:  %v\n", uri)
+
+	code, err := qrcode.Encode([]byte(uri))
+	if err != nil {
+		fmt.Printf("Payment uri cannot be displayed as a qr code: %v\n",
+			err)
+
+		return
+	}
+
+	fmt.Println()
+	fmt.Print(code.Terminal())
+}
+
+// watchExternalLoopIn follows the updates of the loop in swap provided until
+// it completes or fails, reporting when its htlc is funded.
+func watchExternalLoopIn(client looprpc.SwapClientClient,
+	swapID []byte) error {
+
+	stream, err := client.Monitor(
+		context.Background(), &looprpc.MonitorRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Printf("Waiting for the htlc to be funded, press ctrl+c to stop " +
+		"watching. The swap continues in the background.\n")
+
+	var funded bool
+	for {
+		swap, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("recv: %v", err)
+		}
+
+		if !bytes.Equal(swap.IdBytes, swapID) {
+			continue
+		}
+
+		// The htlc tx of an external loop in is reported once it
+		// confirms.
+		if swap.HtlcTxid != "" && !funded {
+			funded = true
+
+			fmt.Printf("HTLC funding confirmed in %v, waiting for "+
+				"the server to pay the swap invoice\n",
+				swap.HtlcTxid)
+		}
+
+		switch swap.State {
+		case looprpc.SwapState_SUCCESS:
+			fmt.Printf("Swap completed\n")
+			return nil
+
+		case looprpc.SwapState_FAILED:
+			logSwap(swap)
+			return fmt.Errorf("swap failed: %v", swap.FailureReason)
+		}
+	}
+}
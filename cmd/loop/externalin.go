@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var externalInCommand = cli.Command{
+	Name:      "externalin",
+	Usage:     "perform a loop in that is paid by an external wallet",
+	ArgsUsage: "amt",
+	Description: `
+	Initiates a loop in of the amount in satoshis provided, and prints the
+	htlc address and exact amount that an external wallet must pay, along
+	with a BIP21 payment uri that the wallet may scan. The command then
+	waits until the payment confirms and the swap completes.
+
+	Paying any other amount than the one printed fails the swap.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "max_swap_fee",
+			Usage: "the maximum swap fee " + amtUnitsUsage +
+				", defaults to the server's quoted fee",
+		},
+		cli.BoolFlag{
+			Name: "nested",
+			Usage: "pay to the nested segwit htlc address, for " +
+				"wallets that do not support native segwit",
+		},
+		lastHopFlag,
+		labelFlag,
+	},
+	Action: externalIn,
+}
+
+func externalIn(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "externalin")
+	}
+
+	amt, err := parseAmt(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	maxSwapFee, err := parseAmtFlag(ctx, "max_swap_fee")
+	if err != nil {
+		return err
+	}
+
+	req := &looprpc.ExternalLoopInRequest{
+		Amt:          int64(amt),
+		MaxSwapFee:   int64(maxSwapFee),
+		Label:        ctx.String(labelFlag.Name),
+		Initiator:    defaultInitiator,
+		NestedSegwit: ctx.Bool("nested"),
+	}
+
+	if ctx.IsSet(lastHopFlag.Name) {
+		lastHop, err := route.NewVertexFromStr(
+			ctx.String(lastHopFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+		req.LastHop = lastHop[:]
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.ExternalLoopIn(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("recv: %v", err)
+		}
+
+		switch update.State {
+		case looprpc.ExternalLoopInState_EXTERNAL_AWAITING_PAYMENT:
+			hash, err := lntypes.MakeHash(update.IdBytes)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(tr("Swap initiated"))
+			fmt.Printf("ID:           %v\n", hash)
+			fmt.Printf("Swap fee:     %d sat\n", update.SwapFeeSat)
+
+			printPaymentRequest(
+				update.HtlcAddress, btcutil.Amount(update.Amt),
+				update.PaymentUri,
+			)
+
+			fmt.Println()
+			fmt.Printf("Waiting for payment...\n")
+
+		case looprpc.ExternalLoopInState_EXTERNAL_PAYMENT_CONFIRMED:
+			fmt.Printf("Payment confirmed in %v, waiting for "+
+				"swap to complete...\n", update.HtlcTxid)
+
+		case looprpc.ExternalLoopInState_EXTERNAL_COMPLETE:
+			fmt.Printf("Swap completed\n")
+			return nil
+
+		case looprpc.ExternalLoopInState_EXTERNAL_FAILED:
+			logSwap(update.Swap)
+			return fmt.Errorf("swap failed")
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var feeRecordsCommand = cli.Command{
+	Name:  "feerecords",
+	Usage: "show the chain fee estimates that loopd made decisions with",
+	Description: "Displays the chain fee estimates that loopd observed " +
+		"when it checked its fee limit before suggesting swaps, " +
+		"published loop in htlcs and swept htlcs, along with the fee " +
+		"rates that the resulting transactions confirmed with. This " +
+		"can be used to analyze whether fee limits are set well.",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "the time from which to show records, " +
+				"expressed as a unix timestamp in seconds",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "the time until which to show records, " +
+				"expressed as a unix timestamp in seconds",
+		},
+	},
+	Action: feeRecords,
+}
+
+func feeRecords(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	records, err := client.ListFeeRecords(
+		context.Background(), &looprpc.ListFeeRecordsRequest{
+			StartTime: ctx.Int64("start"),
+			EndTime:   ctx.Int64("end"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(records)
+
+	return nil
+}
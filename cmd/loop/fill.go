@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var fillCommand = cli.Command{
+	Name:      "fill",
+	Usage:     "bring a channel to a target balance split",
+	ArgsUsage: "chan_id | channel_point",
+	Description: `
+	Dispatches swaps over a channel, one at a time, until its local balance
+	is as close to --local_percent of its capacity as swaps can bring it.
+	Loop outs are dispatched while the local balance is above the target,
+	and loop ins through the channel's peer while it is below. If a channel
+	point is provided, the command waits for the channel to open, so that
+	it can be run right after opening a channel. Interrupting the command
+	stops further swaps, but a swap in flight continues.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "local_percent",
+			Usage: "the target local balance as a percentage of " +
+				"the channel's capacity",
+			Value: 50,
+		},
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "the address that loop outs are swept to, if " +
+				"not set a new wallet address is used for " +
+				"each swap",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the confirmation target for the sweeps of " +
+				"loop outs",
+		},
+		cli.Uint64Flag{
+			Name: "htlc_conf_target",
+			Usage: "the confirmation target for the htlcs of " +
+				"loop ins",
+		},
+		cli.Uint64Flag{
+			Name: "fee_ppm",
+			Usage: "the portion of each swap's amount that may be " +
+				"spent on fees in parts per million, defaults " +
+				"to 20000 (2%)",
+		},
+		cli.Uint64Flag{
+			Name:  "max_swaps",
+			Usage: "the maximum number of swaps to dispatch",
+		},
+		labelFlag,
+	},
+	Action: fillChannel,
+}
+
+func fillChannel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "fill")
+	}
+
+	req := &looprpc.FillChannelRequest{
+		LocalPercent:    uint32(ctx.Uint64("local_percent")),
+		Dest:            ctx.String("addr"),
+		SweepConfTarget: int32(ctx.Uint64("conf_target")),
+		HtlcConfTarget:  int32(ctx.Uint64("htlc_conf_target")),
+		FeePpm:          ctx.Uint64("fee_ppm"),
+		MaxSwaps:        uint32(ctx.Uint64("max_swaps")),
+		Label:           ctx.String(labelFlag.Name),
+	}
+
+	// A channel is either identified by its short channel id, or by its
+	// channel point if it is not yet open.
+	chanID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err == nil {
+		req.Channel = chanID
+	} else {
+		req.ChannelPoint = ctx.Args().First()
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.FillChannel(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("recv: %v", err)
+		}
+
+		printRespJSON(update)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var getInfoCommand = cli.Command{
+	Name:  "getinfo",
+	Usage: "show information about loopd",
+	Description: "Displays the version and network of the daemon, and the " +
+		"state of its connection to the swap server along with its " +
+		"most recent state changes.",
+	Action: getInfo,
+}
+
+func getInfo(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetInfo(
+		context.Background(), &looprpc.GetInfoRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/looprpc"
@@ -15,6 +16,76 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+var liquiditySummaryCommand = cli.Command{
+	Name:  "liquiditysummary",
+	Usage: "show a summary of the node's liquidity",
+	Description: "Displays a summary of the node's liquidity, including " +
+		"funds that are in transit due to swaps.",
+	Action: liquiditySummary,
+}
+
+func liquiditySummary(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	summary, err := client.GetLiquiditySummary(
+		context.Background(), &looprpc.LiquiditySummaryRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(summary)
+
+	return nil
+}
+
+var liquiditySnapshotsCommand = cli.Command{
+	Name:  "liquiditysnapshots",
+	Usage: "show historical snapshots of the node's balances",
+	Description: "Displays the snapshots of the node's wallet and " +
+		"channel balances that have been recorded by loopd, so that " +
+		"changes in liquidity can be tracked over time.",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "the time from which to show snapshots, " +
+				"expressed as a unix timestamp in seconds",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "the time until which to show snapshots, " +
+				"expressed as a unix timestamp in seconds",
+		},
+	},
+	Action: liquiditySnapshots,
+}
+
+func liquiditySnapshots(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	snapshots, err := client.ListLiquiditySnapshots(
+		context.Background(), &looprpc.ListLiquiditySnapshotsRequest{
+			StartTime: ctx.Int64("start"),
+			EndTime:   ctx.Int64("end"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(snapshots)
+
+	return nil
+}
+
 var getLiquidityParamsCommand = cli.Command{
 	Name:  "getparams",
 	Usage: "show liquidity manager parameters",
@@ -59,6 +130,15 @@ var setLiquidityRuleCommand = cli.Command{
 			Usage: "the minimum percentage of outbound liquidity " +
 				"that we do not want to drop below.",
 		},
+		cli.Uint64Flag{
+			Name: "success_cooldown",
+			Usage: "the amount of time, in seconds, that " +
+				"should pass after one of the rule's " +
+				"channels was part of a successful " +
+				"automatically dispatched swap before the " +
+				"rule suggests swaps again, set to 0 to " +
+				"disable.",
+		},
 		cli.BoolFlag{
 			Name: "clear",
 			Usage: "remove the rule currently set for the " +
@@ -108,6 +188,7 @@ func setRule(ctx *cli.Context) error {
 	var (
 		inboundSet  = ctx.IsSet("incoming_threshold")
 		outboundSet = ctx.IsSet("outgoing_threshold")
+		cooldownSet = ctx.IsSet("success_cooldown")
 		ruleSet     bool
 		otherRules  []*looprpc.LiquidityRule
 	)
@@ -142,7 +223,7 @@ func setRule(ctx *cli.Context) error {
 				"set at present", chanID)
 		}
 
-		if inboundSet || outboundSet {
+		if inboundSet || outboundSet || cooldownSet {
 			return fmt.Errorf("do not set other flags with clear " +
 				"flag")
 		}
@@ -159,7 +240,7 @@ func setRule(ctx *cli.Context) error {
 
 	// If we are setting a rule for this channel (not clearing it), check
 	// that at least one value is set.
-	if !inboundSet && !outboundSet {
+	if !inboundSet && !outboundSet && !cooldownSet {
 		return fmt.Errorf("provide at least one flag to set rules or " +
 			"use the --clear flag to remove rules")
 	}
@@ -186,6 +267,10 @@ func setRule(ctx *cli.Context) error {
 		)
 	}
 
+	if cooldownSet {
+		newRule.SuccessCooldownSec = ctx.Uint64("success_cooldown")
+	}
+
 	// Just set the rules on our current set of parameters and leave the
 	// other values untouched.
 	otherRules = append(otherRules, newRule)
@@ -234,15 +319,15 @@ var setParamsCommand = cli.Command{
 				"volume that are are willing to pay in " +
 				"routing fees.",
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name: "maxprepay",
-			Usage: "the maximum no-show (prepay) in satoshis that " +
-				"swap suggestions should be limited to.",
+			Usage: "the maximum no-show (prepay) " + amtUnitsUsage +
+				" that swap suggestions should be limited to.",
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name: "maxminer",
-			Usage: "the maximum miner fee in satoshis that swap " +
-				"suggestions should be limited to.",
+			Usage: "the maximum miner fee " + amtUnitsUsage +
+				" that swap suggestions should be limited to.",
 		},
 		cli.IntFlag{
 			Name: "sweepconf",
@@ -263,11 +348,11 @@ var setParamsCommand = cli.Command{
 				"of swaps, limited to the budget set by " +
 				"autobudget",
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name: "autobudget",
-			Usage: "the maximum amount of fees in satoshis that " +
-				"automatically dispatched loop out swaps may " +
-				"spend",
+			Usage: "the maximum amount of fees " + amtUnitsUsage +
+				" that automatically dispatched loop out " +
+				"swaps may spend",
 		},
 		cli.Uint64Flag{
 			Name: "budgetstart",
@@ -275,26 +360,138 @@ var setParamsCommand = cli.Command{
 				"out budget, expressed as a unix timestamp " +
 				"in seconds",
 		},
+		cli.Uint64Flag{
+			Name: "budgetrefresh",
+			Usage: "the interval, in seconds, at which the " +
+				"automated loop out budget is replenished, " +
+				"counted from budgetstart, set to 0 to " +
+				"apply the budget to all swaps since " +
+				"budgetstart",
+		},
 		cli.Uint64Flag{
 			Name: "autoinflight",
 			Usage: "the maximum number of automatically " +
 				"dispatched swaps that we allow to be in " +
 				"flight",
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name: "minamt",
-			Usage: "the minimum amount in satoshis that the " +
-				"autoloop client will dispatch per-swap",
+			Usage: "the minimum amount " + amtUnitsUsage +
+				" that the autoloop client will dispatch " +
+				"per-swap",
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name: "maxamt",
-			Usage: "the maximum amount in satoshis that the " +
-				"autoloop client will dispatch per-swap",
+			Usage: "the maximum amount " + amtUnitsUsage +
+				" that the autoloop client will dispatch " +
+				"per-swap",
+		},
+		cli.StringFlag{
+			Name: "labeltemplate",
+			Usage: "an optional template that is appended to the " +
+				"label of automatically dispatched swaps, " +
+				"supporting the placeholders {type}, {peer}, " +
+				"{peer_alias}, {channels} and {date}, set to " +
+				"an empty string to clear",
+		},
+		cli.StringFlag{
+			Name: "allowpeers",
+			Usage: "a comma separated list of peer pubkeys that " +
+				"liquidity management is restricted to, set " +
+				"to an empty string to allow all peers",
+		},
+		cli.StringFlag{
+			Name: "excludepeers",
+			Usage: "a comma separated list of peer pubkeys whose " +
+				"channels will never be used for swaps, set " +
+				"to an empty string to clear",
+		},
+		cli.Float64Flag{
+			Name: "minuptime",
+			Usage: "the minimum percentage of time that a peer " +
+				"must have been observed online for its " +
+				"channels to be used for swaps, set to 0 to " +
+				"disable",
+		},
+		cli.Uint64Flag{
+			Name: "maxpeerfeeincrease",
+			Usage: "the largest percentage that a peer may have " +
+				"raised the fee it charges to forward over " +
+				"a channel to us by within the last day for " +
+				"the channel to be used for swaps, set to 0 " +
+				"to disable",
+		},
+		cli.Float64Flag{
+			Name: "amountjitter",
+			Usage: "the percentage of a swap's amount, up to 10, " +
+				"that the amounts of automatically " +
+				"dispatched swaps are randomly increased " +
+				"or decreased by, set to 0 to disable",
+		},
+		cli.StringFlag{
+			Name: "unrestrictedmode",
+			Usage: "the way that pending swaps which are not " +
+				"restricted to specific channels or peers " +
+				"affect suggestions: ignore, freeze (no " +
+				"suggestions while they are pending) or " +
+				"reserve (exclude the channels they are most " +
+				"likely to use)",
+		},
+		cli.BoolFlag{
+			Name: "aggregatedeficits",
+			Usage: "set to true to combine swap amounts that are " +
+				"below the server minimum across several " +
+				"of a peer's channels into a single swap",
+		},
+		cli.StringFlag{
+			Name: "priority",
+			Usage: "the order in which budget is allocated to " +
+				"suggestions when it cannot cover all of " +
+				"them: amount, weighted or roundrobin",
+		},
+		cli.StringFlag{
+			Name: "peerweights",
+			Usage: "a comma separated list of pubkey=weight " +
+				"pairs used to order suggestions when " +
+				"weighted priority is set, set to an empty " +
+				"string to clear",
+		},
+		cli.StringFlag{
+			Name: "circular",
+			Usage: "whether suggested loop outs are compared " +
+				"with circular rebalances: disabled, " +
+				"compare (report the cost of a rebalance) " +
+				"or allow (suggest the rebalance when it " +
+				"is cheaper)",
 		},
 	},
 	Action: setParams,
 }
 
+// circularModes maps the circular rebalance modes that can be set on the
+// command line to their rpc values.
+var circularModes = map[string]looprpc.CircularMode{
+	"disabled": looprpc.CircularMode_CIRCULAR_MODE_DISABLED,
+	"compare":  looprpc.CircularMode_CIRCULAR_MODE_COMPARE,
+	"allow":    looprpc.CircularMode_CIRCULAR_MODE_ALLOW,
+}
+
+// unrestrictedModes maps the unrestricted swap modes that can be set on the
+// command line to their rpc values.
+var unrestrictedModes = map[string]looprpc.UnrestrictedSwapMode{
+	"ignore":  looprpc.UnrestrictedSwapMode_UNRESTRICTED_SWAP_MODE_IGNORE,
+	"freeze":  looprpc.UnrestrictedSwapMode_UNRESTRICTED_SWAP_MODE_FREEZE,
+	"reserve": looprpc.UnrestrictedSwapMode_UNRESTRICTED_SWAP_MODE_RESERVE,
+}
+
+// suggestionPriorities maps the suggestion priorities that can be set on the
+// command line to their rpc values.
+var suggestionPriorities = map[string]looprpc.SuggestionPriority{
+	"amount":     looprpc.SuggestionPriority_SUGGESTION_PRIORITY_AMOUNT,
+	"weighted":   looprpc.SuggestionPriority_SUGGESTION_PRIORITY_WEIGHTED,
+	"roundrobin": looprpc.SuggestionPriority_SUGGESTION_PRIORITY_ROUND_ROBIN,
+}
+
 func setParams(ctx *cli.Context) error {
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -370,13 +567,23 @@ func setParams(ctx *cli.Context) error {
 	}
 
 	if ctx.IsSet("maxprepay") {
-		params.MaxPrepaySat = ctx.Uint64("maxprepay")
+		maxPrepay, err := parseAmtFlag(ctx, "maxprepay")
+		if err != nil {
+			return err
+		}
+
+		params.MaxPrepaySat = uint64(maxPrepay)
 		flagSet = true
 		categoriesSet = true
 	}
 
 	if ctx.IsSet("maxminer") {
-		params.MaxMinerFeeSat = ctx.Uint64("maxminer")
+		maxMiner, err := parseAmtFlag(ctx, "maxminer")
+		if err != nil {
+			return err
+		}
+
+		params.MaxMinerFeeSat = uint64(maxMiner)
 		flagSet = true
 		categoriesSet = true
 	}
@@ -397,7 +604,12 @@ func setParams(ctx *cli.Context) error {
 	}
 
 	if ctx.IsSet("autobudget") {
-		params.AutoloopBudgetSat = ctx.Uint64("autobudget")
+		budget, err := parseAmtFlag(ctx, "autobudget")
+		if err != nil {
+			return err
+		}
+
+		params.AutoloopBudgetSat = uint64(budget)
 		flagSet = true
 	}
 
@@ -406,18 +618,125 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("budgetrefresh") {
+		params.AutoloopBudgetRefreshPeriodSec = ctx.Uint64(
+			"budgetrefresh",
+		)
+		flagSet = true
+	}
+
 	if ctx.IsSet("autoinflight") {
 		params.AutoMaxInFlight = ctx.Uint64("autoinflight")
 		flagSet = true
 	}
 
 	if ctx.IsSet("minamt") {
-		params.MinSwapAmount = ctx.Uint64("minamt")
+		minAmt, err := parseAmtFlag(ctx, "minamt")
+		if err != nil {
+			return err
+		}
+
+		params.MinSwapAmount = uint64(minAmt)
 		flagSet = true
 	}
 
 	if ctx.IsSet("maxamt") {
-		params.MaxSwapAmount = ctx.Uint64("maxamt")
+		maxAmt, err := parseAmtFlag(ctx, "maxamt")
+		if err != nil {
+			return err
+		}
+
+		params.MaxSwapAmount = uint64(maxAmt)
+		flagSet = true
+	}
+
+	if ctx.IsSet("labeltemplate") {
+		params.AutoloopLabelTemplate = ctx.String("labeltemplate")
+		flagSet = true
+	}
+
+	if ctx.IsSet("allowpeers") {
+		params.AllowedPeers, err = parsePeerList(
+			ctx.String("allowpeers"),
+		)
+		if err != nil {
+			return err
+		}
+		flagSet = true
+	}
+
+	if ctx.IsSet("excludepeers") {
+		params.ExcludedPeers, err = parsePeerList(
+			ctx.String("excludepeers"),
+		)
+		if err != nil {
+			return err
+		}
+		flagSet = true
+	}
+
+	if ctx.IsSet("minuptime") {
+		params.MinUptimePercent = ctx.Float64("minuptime")
+		flagSet = true
+	}
+
+	if ctx.IsSet("maxpeerfeeincrease") {
+		params.MaxPeerFeeIncreasePercent = ctx.Uint64(
+			"maxpeerfeeincrease",
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("amountjitter") {
+		params.AmountJitterPercent = ctx.Float64("amountjitter")
+		flagSet = true
+	}
+
+	if ctx.IsSet("unrestrictedmode") {
+		mode, ok := unrestrictedModes[ctx.String("unrestrictedmode")]
+		if !ok {
+			return fmt.Errorf("unknown unrestricted mode: %v",
+				ctx.String("unrestrictedmode"))
+		}
+
+		params.UnrestrictedMode = mode
+		flagSet = true
+	}
+
+	if ctx.IsSet("circular") {
+		mode, ok := circularModes[ctx.String("circular")]
+		if !ok {
+			return fmt.Errorf("unknown circular mode: %v",
+				ctx.String("circular"))
+		}
+
+		params.CircularMode = mode
+		flagSet = true
+	}
+
+	if ctx.IsSet("aggregatedeficits") {
+		params.AggregateDeficits = ctx.Bool("aggregatedeficits")
+		flagSet = true
+	}
+
+	if ctx.IsSet("priority") {
+		priority, ok := suggestionPriorities[ctx.String("priority")]
+		if !ok {
+			return fmt.Errorf("unknown priority: %v",
+				ctx.String("priority"))
+		}
+
+		params.Priority = priority
+		flagSet = true
+	}
+
+	if ctx.IsSet("peerweights") {
+		params.PeerWeights, err = parsePeerWeights(
+			ctx.String("peerweights"),
+		)
+		if err != nil {
+			return err
+		}
 		flagSet = true
 	}
 
@@ -473,7 +792,28 @@ var suggestSwapCommand = cli.Command{
 	Usage: "show a list of suggested swaps",
 	Description: "Displays a list of suggested swaps that aim to obtain " +
 		"the liquidity balance as specified by the rules set in " +
-		"the liquidity manager.",
+		"the liquidity manager. Channels and peers can be excluded " +
+		"and the number of swaps capped for a single set of " +
+		"suggestions, without changing the liquidity parameters.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "excludechannels",
+			Usage: "a comma separated list of short channel ids " +
+				"that are not eligible for the suggested " +
+				"swaps",
+		},
+		cli.StringFlag{
+			Name: "excludepeers",
+			Usage: "a comma separated list of peer pubkeys whose " +
+				"channels are not eligible for the suggested " +
+				"swaps",
+		},
+		cli.Uint64Flag{
+			Name: "maxswaps",
+			Usage: "the maximum number of loop outs to suggest, " +
+				"0 to suggest all",
+		},
+	},
 	Action: suggestSwap,
 }
 
@@ -484,9 +824,37 @@ func suggestSwap(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	resp, err := client.SuggestSwaps(
-		context.Background(), &looprpc.SuggestSwapsRequest{},
-	)
+	req := &looprpc.SuggestSwapsRequest{
+		MaxSwaps: uint32(ctx.Uint64("maxswaps")),
+	}
+
+	if ctx.IsSet("excludechannels") {
+		channels := strings.Split(ctx.String("excludechannels"), ",")
+		for _, channel := range channels {
+			chanID, err := strconv.ParseUint(
+				strings.TrimSpace(channel), 10, 64,
+			)
+			if err != nil {
+				return fmt.Errorf("error parsing channel id "+
+					"\"%v\"", channel)
+			}
+
+			req.ExcludeChannels = append(
+				req.ExcludeChannels, chanID,
+			)
+		}
+	}
+
+	if ctx.IsSet("excludepeers") {
+		req.ExcludePeers, err = parsePeerList(
+			ctx.String("excludepeers"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.SuggestSwaps(context.Background(), req)
 	if err == nil {
 		printRespJSON(resp)
 		return nil
@@ -506,3 +874,198 @@ func suggestSwap(ctx *cli.Context) error {
 	return errors.New("no rules set for autolooper, please set rules " +
 		"using the setrule command")
 }
+
+var quoteSuggestionsCommand = cli.Command{
+	Name:  "quotesuggestions",
+	Usage: "quote the currently suggested swaps",
+	Description: "Displays the current quote for each loop out that is " +
+		"suggested, along with the total amount and fees of the " +
+		"suggestions, so that the cost of a batch of swaps can be " +
+		"reviewed before it is dispatched.",
+	Action: quoteSuggestions,
+}
+
+func quoteSuggestions(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.QuoteSuggestions(
+		context.Background(), &looprpc.QuoteSuggestionsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var applySuggestionsCommand = cli.Command{
+	Name:  "applysuggestions",
+	Usage: "dispatch a selection of the suggested swaps",
+	Description: "Dispatches the suggested loop outs that are selected " +
+		"by their index in the output of suggestswaps, or by the " +
+		"channels that they use, with the same parameters that " +
+		"autoloop would use. Suggestions can only be applied while " +
+		"autoloop is disabled.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "indexes",
+			Usage: "a comma separated list of the indexes of the " +
+				"loop outs to dispatch, as listed by " +
+				"suggestswaps",
+		},
+		cli.StringFlag{
+			Name: "channels",
+			Usage: "a comma separated list of short channel ids, " +
+				"every suggested loop out that uses any of " +
+				"them is dispatched",
+		},
+	},
+	Action: applySuggestions,
+}
+
+func applySuggestions(ctx *cli.Context) error {
+	if !ctx.IsSet("indexes") && !ctx.IsSet("channels") {
+		return cli.ShowCommandHelp(ctx, "applysuggestions")
+	}
+
+	req := &looprpc.ApplySuggestionsRequest{}
+
+	if ctx.IsSet("indexes") {
+		for _, index := range strings.Split(ctx.String("indexes"), ",") {
+			i, err := strconv.ParseUint(
+				strings.TrimSpace(index), 10, 32,
+			)
+			if err != nil {
+				return fmt.Errorf("error parsing index "+
+					"\"%v\"", index)
+			}
+
+			req.Indexes = append(req.Indexes, uint32(i))
+		}
+	}
+
+	if ctx.IsSet("channels") {
+		channels := strings.Split(ctx.String("channels"), ",")
+		for _, channel := range channels {
+			chanID, err := strconv.ParseUint(
+				strings.TrimSpace(channel), 10, 64,
+			)
+			if err != nil {
+				return fmt.Errorf("error parsing channel id "+
+					"\"%v\"", channel)
+			}
+
+			req.Channels = append(req.Channels, chanID)
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ApplySuggestions(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var paramAdviceCommand = cli.Command{
+	Name:  "paramadvice",
+	Usage: "show recommended changes to the liquidity parameters",
+	Description: "Displays changes to the liquidity manager's " +
+		"parameters that are recommended based on the outcomes of " +
+		"recent swaps and the chain fee estimates that were " +
+		"recorded. Recommendations are never applied, they can be " +
+		"set with the setparams command.",
+	Action: paramAdvice,
+}
+
+func paramAdvice(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetParameterAdvice(
+		context.Background(), &looprpc.ParameterAdviceRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parsePeerList parses a comma separated list of hex encoded peer pubkeys.
+// An empty string results in an empty list.
+func parsePeerList(peerList string) ([][]byte, error) {
+	var peers [][]byte
+	for _, peerStr := range strings.Split(peerList, ",") {
+		peerStr = strings.TrimSpace(peerStr)
+		if peerStr == "" {
+			continue
+		}
+
+		peer, err := route.NewVertexFromStr(peerStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer %v: %v", peerStr,
+				err)
+		}
+
+		peers = append(peers, peer[:])
+	}
+
+	return peers, nil
+}
+
+// parsePeerWeights parses a comma separated list of pubkey=weight pairs. An
+// empty string results in an empty list.
+func parsePeerWeights(weightList string) ([]*looprpc.PeerWeight, error) {
+	var weights []*looprpc.PeerWeight
+	for _, pair := range strings.Split(weightList, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("peer weight %v should be "+
+				"of the form pubkey=weight", pair)
+		}
+
+		peer, err := route.NewVertexFromStr(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer %v: %v", parts[0],
+				err)
+		}
+
+		weight, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %v: %v",
+				parts[1], err)
+		}
+
+		weights = append(weights, &looprpc.PeerWeight{
+			Pubkey: peer[:],
+			Weight: weight,
+		})
+	}
+
+	return weights, nil
+}
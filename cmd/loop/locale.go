@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+)
+
+// defaultLanguage is the language that our messages are written in, which we
+// fall back to for languages and messages that have no translation.
+const defaultLanguage = "en"
+
+var (
+	// language is the language that our user-facing messages are shown in,
+	// as selected by setLanguage.
+	language = defaultLanguage
+
+	langFlag = cli.StringFlag{
+		Name: "lang",
+		Usage: "the language of the cli's prompts and fee " +
+			"breakdowns, such as de or es. If not set, output " +
+			"is in English regardless of the system locale. " +
+			"JSON output is never translated",
+		EnvVar: "LOOP_LANG",
+	}
+)
+
+// translations maps each language that we support to the translations of our
+// user-facing messages, keyed by their English text. Format strings must keep
+// the verbs of their English text, in the same order.
+var translations = map[string]map[string]string{
+	"de": {
+		"CONTINUE SWAP? (y/n): ": "SWAP FORTSETZEN? (j/n): ",
+		"y":                      "j",
+		"swap canceled":          "Swap abgebrochen",
+		"invalid amt value":      "ungültiger Betrag",
+
+		"Send on-chain:":            "On-chain senden:",
+		"Receive off-chain:":        "Off-chain empfangen:",
+		"Send off-chain:":           "Off-chain senden:",
+		"Receive on-chain:":         "On-chain empfangen:",
+		"Loop service fee:":         "Loop-Servicegebühr:",
+		"Estimated on-chain fee:":   "Geschätzte On-chain-Gebühr:",
+		"Estimated total fee:":      "Geschätzte Gesamtgebühr:",
+		"No show penalty (prepay):": "Vorauszahlung (No-Show-Strafe):",
+		"Conf target:":              "Bestätigungsziel:",
+		"CLTV expiry delta:":        "CLTV-Ablaufdelta:",
+		"Publication deadline:":     "Veröffentlichungsfrist:",
+		"Swap amount:":              "Swap-Betrag:",
+		"Swap fee:":                 "Swap-Gebühr:",
+
+		"Max on-chain fee:": "Max. On-chain-Gebühr:",
+		"Max off-chain swap routing fee:": "Max. Off-chain-" +
+			"Routinggebühr (Swap):",
+		"Max off-chain prepay routing fee:": "Max. Routinggebühr " +
+			"(Vorauszahlung):",
+
+		"Fast swap requested.": "Schneller Swap angefordert.",
+		"Regular swap speed requested, it might take up to %v for " +
+			"the swap to be executed.": "Normale " +
+			"Swap-Geschwindigkeit angefordert, die Ausführung " +
+			"des Swaps kann bis zu %v dauern.",
+		"On-chain fee for external loop in is not included.\n" +
+			"Sufficient fees will need to be paid when " +
+			"constructing the transaction in the external " +
+			"wallet.\n\n": "Die On-chain-Gebühr für externe " +
+			"Loop-ins ist nicht enthalten.\nBeim Erstellen der " +
+			"Transaktion in der externen Wallet müssen " +
+			"ausreichende Gebühren gezahlt werden.\n\n",
+
+		"Swap initiated": "Swap gestartet",
+		"Swap already initiated for request id": "Swap für diese " +
+			"Anfrage-ID bereits gestartet",
+		"Run `loop monitor` to monitor progress.": "Mit `loop " +
+			"monitor` kann der Fortschritt verfolgt werden.",
+		"  Suggested action: %v\n": "  Empfohlene Maßnahme: %v\n",
+	},
+	"es": {
+		"CONTINUE SWAP? (y/n): ": "¿CONTINUAR SWAP? (s/n): ",
+		"y":                      "s",
+		"swap canceled":          "swap cancelado",
+		"invalid amt value":      "valor de importe no válido",
+
+		"Send on-chain:":          "Enviar on-chain:",
+		"Receive off-chain:":      "Recibir off-chain:",
+		"Send off-chain:":         "Enviar off-chain:",
+		"Receive on-chain:":       "Recibir on-chain:",
+		"Loop service fee:":       "Comisión del servicio Loop:",
+		"Estimated on-chain fee:": "Comisión on-chain estimada:",
+		"Estimated total fee:":    "Comisión total estimada:",
+		"No show penalty (prepay):": "Penalización por ausencia " +
+			"(prepago):",
+		"Conf target:":          "Objetivo de confirmación:",
+		"CLTV expiry delta:":    "Delta de expiración CLTV:",
+		"Publication deadline:": "Plazo de publicación:",
+		"Swap amount:":          "Importe del swap:",
+		"Swap fee:":             "Comisión del swap:",
+
+		"Max on-chain fee:": "Comisión on-chain máx.:",
+		"Max off-chain swap routing fee:": "Enrutamiento off-chain " +
+			"máx. (swap):",
+		"Max off-chain prepay routing fee:": "Enrutamiento máx. " +
+			"(prepago):",
+
+		"Fast swap requested.": "Swap rápido solicitado.",
+		"Regular swap speed requested, it might take up to %v for " +
+			"the swap to be executed.": "Velocidad de swap " +
+			"normal solicitada, la ejecución del swap puede " +
+			"tardar hasta %v.",
+		"On-chain fee for external loop in is not included.\n" +
+			"Sufficient fees will need to be paid when " +
+			"constructing the transaction in the external " +
+			"wallet.\n\n": "La comisión on-chain del loop in " +
+			"externo no está incluida.\nSe deberán pagar " +
+			"comisiones suficientes al construir la transacción " +
+			"en la billetera externa.\n\n",
+
+		"Swap initiated": "Swap iniciado",
+		"Swap already initiated for request id": "Swap ya iniciado " +
+			"para el id de solicitud",
+		"Run `loop monitor` to monitor progress.": "Ejecute `loop " +
+			"monitor` para seguir el progreso.",
+		"  Suggested action: %v\n": "  Acción sugerida: %v\n",
+	},
+}
+
+// parseLanguage returns the language of a locale, such as de for de_DE.UTF-8.
+// The C and POSIX locales are English.
+func parseLanguage(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+
+	if i := strings.IndexAny(locale, "_-"); i >= 0 {
+		locale = locale[:i]
+	}
+
+	if locale == "c" || locale == "posix" {
+		return defaultLanguage
+	}
+
+	return locale
+}
+
+// setLanguage selects the language of our messages from the language flag.
+// We don't detect the language from the system locale, so that scripts that
+// parse our English output keep working. We fall back to English if the
+// language has no translations.
+func setLanguage(ctx *cli.Context) {
+	language = defaultLanguage
+
+	lang := parseLanguage(ctx.GlobalString(langFlag.Name))
+	if _, ok := translations[lang]; ok {
+		language = lang
+	}
+}
+
+// tr returns the translation of a message into our language, or the message
+// itself if it has no translation.
+func tr(msg string) string {
+	if translated, ok := translations[language][msg]; ok {
+		return translated
+	}
+
+	return msg
+}
+
+// trf formats a message with the translation of the format provided.
+func trf(format string, args ...interface{}) string {
+	return fmt.Sprintf(tr(format), args...)
+}
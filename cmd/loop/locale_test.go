@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+// TestParseLanguage tests extracting the language from a locale.
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		locale   string
+		language string
+	}{
+		{locale: "de", language: "de"},
+		{locale: "de_DE.UTF-8", language: "de"},
+		{locale: "es-ES", language: "es"},
+		{locale: " ES_mx@euro ", language: "es"},
+		{locale: "C", language: defaultLanguage},
+		{locale: "POSIX", language: defaultLanguage},
+		{locale: "", language: ""},
+	}
+
+	for _, testCase := range tests {
+		require.Equal(
+			t, testCase.language, parseLanguage(testCase.locale),
+			testCase.locale,
+		)
+	}
+}
+
+// TestSetLanguage tests that we only translate our messages when a language
+// is set explicitly, and not based on the system locale.
+func TestSetLanguage(t *testing.T) {
+	defer func() {
+		language = defaultLanguage
+	}()
+
+	prevLang, hadLang := os.LookupEnv("LANG")
+	defer func() {
+		if hadLang {
+			os.Setenv("LANG", prevLang)
+		} else {
+			os.Unsetenv("LANG")
+		}
+	}()
+	require.NoError(t, os.Setenv("LANG", "de_DE.UTF-8"))
+
+	newContext := func(lang string) *cli.Context {
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.String(langFlag.Name, lang, "")
+
+		return cli.NewContext(cli.NewApp(), set, nil)
+	}
+
+	// The system locale alone does not change our language.
+	setLanguage(newContext(""))
+	require.Equal(t, defaultLanguage, language)
+
+	setLanguage(newContext("es_ES.UTF-8"))
+	require.Equal(t, "es", language)
+
+	// Languages without translations fall back to English.
+	setLanguage(newContext("fr"))
+	require.Equal(t, defaultLanguage, language)
+}
+
+// TestTranslate tests translating messages, and falling back to the message
+// itself for unknown messages and languages.
+func TestTranslate(t *testing.T) {
+	defer func() {
+		language = defaultLanguage
+	}()
+
+	language = "de"
+	require.Equal(t, "Swap abgebrochen", tr("swap canceled"))
+	require.Equal(
+		t, "  Empfohlene Maßnahme: wait\n",
+		trf("  Suggested action: %v\n", "wait"),
+	)
+
+	// Messages without a translation are returned as they are.
+	require.Equal(t, "unknown message", tr("unknown message"))
+	require.Equal(t, "unknown 1", trf("unknown %v", 1))
+
+	// Languages that we have no translations for use our messages.
+	language = "fr"
+	require.Equal(t, "swap canceled", tr("swap canceled"))
+	require.Equal(
+		t, "  Suggested action: wait\n",
+		trf("  Suggested action: %v\n", "wait"),
+	)
+}
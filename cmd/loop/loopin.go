@@ -6,6 +6,8 @@ import (
 
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopd"
+	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/urfave/cli"
@@ -32,6 +34,22 @@ var (
 			labels.MaxLength, labels.Reserved),
 	}
 
+	requestIDFlag = cli.StringFlag{
+		Name: "request_id",
+		Usage: fmt.Sprintf("an optional id for this request, "+
+			"limited to %v characters. If a swap has already "+
+			"been created with this id, that swap is returned "+
+			"rather than creating a new one.",
+			loopdb.MaxRequestIDLength),
+	}
+
+	overrideLimitsFlag = cli.BoolFlag{
+		Name: "override_limits",
+		Usage: "initiate the swap even if it exceeds the daemon's " +
+			"daily or weekly swap volume limits, requires a " +
+			"macaroon with the limits:override permission",
+	}
+
 	loopInCommand = cli.Command{
 		Name:      "in",
 		Usage:     "perform an on-chain to off-chain swap (loop in)",
@@ -46,20 +64,48 @@ var (
 
 		The external flag can be set to publish the on chain htlc 
 		independently. Note that this flag cannot be set with the 
-		conf_target flag.
+		conf_target flag. External swaps display a BIP21 payment uri 
+		and QR code for the exact amount that the htlc must be paid, 
+		and wait until the htlc is funded and the swap completes 
+		unless the no_watch flag is set.
+
+		The coins that the htlc is funded with can be restricted to an 
+		lnd wallet account with the funding_account flag, or to 
+		specific outputs with the funding_outpoint flag, so that coins 
+		from separate wallet compartments are not linked by the swap.
 		`,
 		Flags: []cli.Flag{
-			cli.Uint64Flag{
+			cli.StringFlag{
 				Name:  "amt",
-				Usage: "the amount in satoshis to loop in",
+				Usage: "the amount to loop in " + amtUnitsUsage,
 			},
 			cli.BoolFlag{
 				Name:  "external",
 				Usage: "expect htlc to be published externally",
 			},
+			cli.BoolFlag{
+				Name: "no_watch",
+				Usage: "exit after displaying the payment " +
+					"request of an external htlc rather " +
+					"than waiting for the swap to complete",
+			},
 			confTargetFlag,
 			lastHopFlag,
 			labelFlag,
+			requestIDFlag,
+			overrideLimitsFlag,
+			cli.StringFlag{
+				Name: "funding_account",
+				Usage: "the lnd wallet account to fund the " +
+					"htlc from and return change to",
+			},
+			cli.StringSliceFlag{
+				Name: "funding_outpoint",
+				Usage: "a wallet output in the format " +
+					"txid:index that the htlc must be " +
+					"funded with, may be set multiple times",
+			},
+			reserveFlag,
 			verboseFlag,
 		},
 		Action: loopIn,
@@ -103,6 +149,13 @@ func loopIn(ctx *cli.Context) error {
 		return fmt.Errorf("external and conf_target both set")
 	}
 
+	fundingAccount := ctx.String("funding_account")
+	fundingOutpoints := ctx.StringSlice("funding_outpoint")
+	if external && (fundingAccount != "" || len(fundingOutpoints) > 0) {
+		return fmt.Errorf("htlc funding cannot be set for external " +
+			"htlcs")
+	}
+
 	// Validate our label early so that we can fail before getting a quote.
 	label := ctx.String(labelFlag.Name)
 	if err := labels.Validate(label); err != nil {
@@ -162,6 +215,24 @@ func loopIn(ctx *cli.Context) error {
 		Label:          label,
 		Initiator:      defaultInitiator,
 		LastHop:        lastHop,
+		RequestId:      ctx.String(requestIDFlag.Name),
+
+		OverrideVolumeLimits: ctx.Bool(overrideLimitsFlag.Name),
+		FundingAccount:       fundingAccount,
+		FundingOutpoints:     fundingOutpoints,
+	}
+
+	if ctx.Bool(reserveFlag.Name) {
+		reservation, err := client.ReserveLoopIn(
+			context.Background(), req,
+		)
+		if err != nil {
+			return err
+		}
+
+		printReservation(reservation)
+
+		return nil
 	}
 
 	resp, err := client.LoopIn(context.Background(), req)
@@ -169,17 +240,48 @@ func loopIn(ctx *cli.Context) error {
 		return err
 	}
 
-	fmt.Printf("Swap initiated\n")
+	printInResponse(resp, external)
+
+	// External htlcs are paid by another wallet, so we display a payment
+	// request for the htlc and wait for the swap to be funded, unless it
+	// still needs to be approved.
+	if !external || resp.PendingApproval {
+		return nil
+	}
+
+	address := primaryHtlcAddress(resp)
+	printPaymentRequest(address, amt, loopd.BIP21URI(address, amt, label))
+
+	if ctx.Bool("no_watch") {
+		return nil
+	}
+
+	return watchExternalLoopIn(client, resp.IdBytes)
+}
+
+// printInResponse displays the response to a loop in request.
+func printInResponse(resp *looprpc.SwapResponse, external bool) {
+	if resp.PendingApproval {
+		printPendingApproval(resp)
+		return
+	}
+
+	if resp.ExistingSwap {
+		fmt.Println(tr("Swap already initiated for request id"))
+	} else {
+		fmt.Println(tr("Swap initiated"))
+	}
 	fmt.Printf("ID:           %v\n", resp.Id)
-	if external {
-		fmt.Printf("HTLC address (NP2WSH): %v\n", resp.HtlcAddressNp2Wsh)
+	if !printHtlcAddresses(resp.HtlcAddresses) {
+		if external {
+			fmt.Printf("HTLC address (NP2WSH): %v\n",
+				resp.HtlcAddressNp2Wsh)
+		}
+		fmt.Printf("HTLC address (P2WSH): %v\n", resp.HtlcAddressP2Wsh)
 	}
-	fmt.Printf("HTLC address (P2WSH): %v\n", resp.HtlcAddressP2Wsh)
 	if resp.ServerMessage != "" {
 		fmt.Printf("Server message: %v\n", resp.ServerMessage)
 	}
 	fmt.Println()
-	fmt.Printf("Run `loop monitor` to monitor progress.\n")
-
-	return nil
+	fmt.Println(tr("Run `loop monitor` to monitor progress."))
 }
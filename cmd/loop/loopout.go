@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
@@ -23,7 +22,10 @@ var loopOutCommand = cli.Command{
 	Attempts to loop out the target amount into either the backing lnd's
 	wallet, or a targeted address.
 
-	The amount is to be specified in satoshis.
+	The amount is specified in satoshis, or with a unit: sat, ksat (or k),
+	m (millions of satoshis), msat or btc. For example 250000, 250ksat,
+	0.25m and 0.0025btc are the same amount. Decimal amounts require a
+	unit.
 
 	Optionally a BASE58/bech32 encoded bitcoin destination address may be
 	specified. If not specified, a new wallet address will be generated.`,
@@ -39,9 +41,9 @@ var loopOutCommand = cli.Command{
 				"should be sent to, if let blank the funds " +
 				"will go to lnd's wallet",
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name:  "amt",
-			Usage: "the amount in satoshis to loop out",
+			Usage: "the amount to loop out " + amtUnitsUsage,
 		},
 		cli.Uint64Flag{
 			Name: "htlc_confs",
@@ -57,11 +59,11 @@ var loopOutCommand = cli.Command{
 				"should be swept within",
 			Value: uint64(loop.DefaultSweepConfTarget),
 		},
-		cli.Int64Flag{
+		cli.StringFlag{
 			Name: "max_swap_routing_fee",
-			Usage: "the max off-chain swap routing fee in " +
-				"satoshis, if not specified, a default max " +
-				"fee will be used",
+			Usage: "the max off-chain swap routing fee " +
+				amtUnitsUsage + ", if not specified, a " +
+				"default max fee will be used",
 		},
 		cli.BoolFlag{
 			Name: "fast",
@@ -74,6 +76,9 @@ var loopOutCommand = cli.Command{
 				"result in a lower swap fee.",
 		},
 		labelFlag,
+		requestIDFlag,
+		overrideLimitsFlag,
+		reserveFlag,
 		verboseFlag,
 	},
 	Action: loopOut,
@@ -162,9 +167,9 @@ func loopOut(ctx *cli.Context) error {
 	// Show a warning if a slow swap was requested.
 	warning := ""
 	if fast {
-		warning = "Fast swap requested."
+		warning = tr("Fast swap requested.")
 	} else {
-		warning = fmt.Sprintf("Regular swap speed requested, it "+
+		warning = trf("Regular swap speed requested, it "+
 			"might take up to %v for the swap to be executed.",
 			defaultSwapWaitTime)
 	}
@@ -172,9 +177,12 @@ func loopOut(ctx *cli.Context) error {
 	limits := getOutLimits(amt, quote)
 	// If configured, use the specified maximum swap routing fee.
 	if ctx.IsSet("max_swap_routing_fee") {
-		limits.maxSwapRoutingFee = btcutil.Amount(
-			ctx.Int64("max_swap_routing_fee"),
+		limits.maxSwapRoutingFee, err = parseAmtFlag(
+			ctx, "max_swap_routing_fee",
 		)
+		if err != nil {
+			return err
+		}
 	}
 	err = displayOutDetails(
 		limits, warning, quoteReq, quote, ctx.Bool("verbose"),
@@ -183,7 +191,7 @@ func loopOut(ctx *cli.Context) error {
 		return err
 	}
 
-	resp, err := client.LoopOut(context.Background(), &looprpc.LoopOutRequest{
+	req := &looprpc.LoopOutRequest{
 		Amt:                     int64(amt),
 		Dest:                    destAddr,
 		MaxMinerFee:             int64(limits.maxMinerFee),
@@ -197,19 +205,54 @@ func loopOut(ctx *cli.Context) error {
 		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
 		Label:                   label,
 		Initiator:               defaultInitiator,
-	})
+		RequestId:               ctx.String(requestIDFlag.Name),
+
+		OverrideVolumeLimits: ctx.Bool(overrideLimitsFlag.Name),
+	}
+
+	if ctx.Bool(reserveFlag.Name) {
+		reservation, err := client.ReserveLoopOut(
+			context.Background(), req,
+		)
+		if err != nil {
+			return err
+		}
+
+		printReservation(reservation)
+
+		return nil
+	}
+
+	resp, err := client.LoopOut(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Swap initiated\n")
+	printOutResponse(resp)
+
+	return nil
+}
+
+// printOutResponse displays the response to a loop out request.
+func printOutResponse(resp *looprpc.SwapResponse) {
+	if resp.PendingApproval {
+		printPendingApproval(resp)
+		return
+	}
+
+	if resp.ExistingSwap {
+		fmt.Println(tr("Swap already initiated for request id"))
+	} else {
+		fmt.Println(tr("Swap initiated"))
+	}
 	fmt.Printf("ID:             %x\n", resp.IdBytes)
-	fmt.Printf("HTLC address:   %v\n", resp.HtlcAddress) // nolint:staticcheck
+	if !printHtlcAddresses(resp.HtlcAddresses) {
+		fmt.Printf("HTLC address:   %v\n",
+			resp.HtlcAddress) // nolint:staticcheck
+	}
 	if resp.ServerMessage != "" {
 		fmt.Printf("Server message: %v\n", resp.ServerMessage)
 	}
 	fmt.Println()
-	fmt.Printf("Run `loop monitor` to monitor progress.\n")
-
-	return nil
+	fmt.Println(tr("Run `loop monitor` to monitor progress."))
 }
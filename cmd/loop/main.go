@@ -4,50 +4,28 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"time"
 
-	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopclient"
 	"github.com/lightninglabs/loop/loopd"
 	"github.com/lightninglabs/loop/looprpc"
-	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/protobuf-hex-display/json"
 	"github.com/lightninglabs/protobuf-hex-display/jsonpb"
 	"github.com/lightninglabs/protobuf-hex-display/proto"
 	"github.com/lightningnetwork/lnd/lncfg"
-	"github.com/lightningnetwork/lnd/macaroons"
 
 	"github.com/btcsuite/btcutil"
 
 	"github.com/urfave/cli"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-	"gopkg.in/macaroon.v2"
 )
 
 var (
-	// Define route independent max routing fees. We have currently no way
-	// to get a reliable estimate of the routing fees. Best we can do is
-	// the minimum routing fees, which is not very indicative.
-	maxRoutingFeeBase = btcutil.Amount(10)
-
-	maxRoutingFeeRate = int64(20000)
-
 	defaultSwapWaitTime = 30 * time.Minute
 
-	// maxMsgRecvSize is the largest message our client will receive. We
-	// set this to 200MiB atm.
-	maxMsgRecvSize = grpc.MaxCallRecvMsgSize(1 * 1024 * 1024 * 200)
-
-	// defaultMacaroonTimeout is the default macaroon timeout in seconds
-	// that we set when sending it over the line.
-	defaultMacaroonTimeout int64 = 60
-
 	// defaultInitiator is the default value for the "initiator" part of the
 	// user agent string we send when using the command line utility.
 	defaultInitiator = "loop-cli"
@@ -60,7 +38,7 @@ var (
 	networkFlag = cli.StringFlag{
 		Name: "network, n",
 		Usage: "the network loop is running on e.g. mainnet, " +
-			"testnet, etc.",
+			"testnet, signet, etc.",
 		Value: loopd.DefaultNetwork,
 	}
 
@@ -143,18 +121,36 @@ func main() {
 		cli.StringFlag{
 			Name:  "rpcserver",
 			Value: "localhost:11010",
-			Usage: "loopd daemon address host:port",
+			Usage: "loopd daemon address host:port, " +
+				"unix:///path/to/socket or " +
+				"unix-abstract://name",
 		},
 		networkFlag,
 		loopDirFlag,
 		tlsCertFlag,
 		macaroonPathFlag,
+		langFlag,
+	}
+	app.Before = func(ctx *cli.Context) error {
+		setLanguage(ctx)
+		return nil
 	}
 	app.Commands = []cli.Command{
 		loopOutCommand, loopInCommand, termsCommand,
 		monitorCommand, quoteCommand, listAuthCommand,
 		listSwapsCommand, swapInfoCommand, getLiquidityParamsCommand,
 		setLiquidityRuleCommand, suggestSwapCommand, setParamsCommand,
+		quoteSuggestionsCommand, applySuggestionsCommand,
+		paramAdviceCommand, dispatchRecordsCommand,
+		swapProofCommand, redactSwapCommand, statusLinkCommand,
+		previewSweepCommand,
+		liquiditySummaryCommand,
+		liquiditySnapshotsCommand, feeRecordsCommand, debugLevelCommand,
+		captureProfileCommand, confirmSwapCommand, templateCommand,
+		runCommand, approvalsCommand, reloadConfigCommand,
+		getInfoCommand, statsCommand, missionControlCommand,
+		budgetCommand, drainCommand, fillCommand, planCommand,
+		externalInCommand, tenantCommand,
 	}
 
 	err := app.Run(os.Args)
@@ -169,7 +165,7 @@ func getClient(ctx *cli.Context) (looprpc.SwapClientClient, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	conn, err := getClientConn(rpcServer, tlsCertPath, macaroonPath)
+	conn, err := loopclient.Dial(rpcServer, tlsCertPath, macaroonPath)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -179,10 +175,6 @@ func getClient(ctx *cli.Context) (looprpc.SwapClientClient, func(), error) {
 	return loopClient, cleanup, nil
 }
 
-func getMaxRoutingFee(amt btcutil.Amount) btcutil.Amount {
-	return swap.CalcFee(amt, maxRoutingFeeBase, maxRoutingFeeRate)
-}
-
 // extractPathArgs parses the TLS certificate and macaroon paths from the
 // command.
 func extractPathArgs(ctx *cli.Context) (string, string, error) {
@@ -190,7 +182,7 @@ func extractPathArgs(ctx *cli.Context) (string, string, error) {
 	// the correct path to the TLS certificate and macaroon when not
 	// specified.
 	networkStr := strings.ToLower(ctx.GlobalString("network"))
-	_, err := lndclient.Network(networkStr).ChainParams()
+	_, err := loopd.ChainParams(networkStr)
 	if err != nil {
 		return "", "", err
 	}
@@ -229,11 +221,11 @@ type inLimits struct {
 }
 
 func getInLimits(quote *looprpc.InQuoteResponse) *inLimits {
+	limits := loopclient.DefaultInLimits(quote)
+
 	return &inLimits{
-		// Apply a multiplier to the estimated miner fee, to not get
-		// the swap canceled because fees increased in the mean time.
-		maxMinerFee: btcutil.Amount(quote.HtlcPublishFeeSat) * 3,
-		maxSwapFee:  btcutil.Amount(quote.SwapFeeSat),
+		maxMinerFee: limits.MaxMinerFee,
+		maxSwapFee:  limits.MaxSwapFee,
 	}
 }
 
@@ -248,22 +240,14 @@ type outLimits struct {
 func getOutLimits(amt btcutil.Amount,
 	quote *looprpc.OutQuoteResponse) *outLimits {
 
-	maxSwapRoutingFee := getMaxRoutingFee(amt)
-	maxPrepayRoutingFee := getMaxRoutingFee(btcutil.Amount(
-		quote.PrepayAmtSat,
-	))
-	maxPrepayAmt := btcutil.Amount(quote.PrepayAmtSat)
+	limits := loopclient.DefaultOutLimits(amt, quote)
 
 	return &outLimits{
-		maxSwapRoutingFee:   maxSwapRoutingFee,
-		maxPrepayRoutingFee: maxPrepayRoutingFee,
-
-		// Apply a multiplier to the estimated miner fee, to not get
-		// the swap canceled because fees increased in the mean time.
-		maxMinerFee: btcutil.Amount(quote.HtlcSweepFeeSat) * 250,
-
-		maxSwapFee:   btcutil.Amount(quote.SwapFeeSat),
-		maxPrepayAmt: maxPrepayAmt,
+		maxSwapRoutingFee:   limits.MaxSwapRoutingFee,
+		maxPrepayRoutingFee: limits.MaxPrepayRoutingFee,
+		maxMinerFee:         limits.MaxMinerFee,
+		maxSwapFee:          limits.MaxSwapFee,
+		maxPrepayAmt:        limits.MaxPrepayAmt,
 	}
 }
 
@@ -271,23 +255,17 @@ func displayInDetails(req *looprpc.QuoteRequest,
 	resp *looprpc.InQuoteResponse, verbose bool) error {
 
 	if req.ExternalHtlc {
-		fmt.Printf("On-chain fee for external loop in is not " +
+		fmt.Print(tr("On-chain fee for external loop in is not " +
 			"included.\nSufficient fees will need to be paid " +
 			"when constructing the transaction in the external " +
-			"wallet.\n\n")
+			"wallet.\n\n"))
 	}
 
 	printQuoteInResp(req, resp, verbose)
 
-	fmt.Printf("\nCONTINUE SWAP? (y/n): ")
-
-	var answer string
-	fmt.Scanln(&answer)
-	if answer == "y" {
-		return nil
-	}
+	fmt.Println()
 
-	return errors.New("swap canceled")
+	return confirmSwap()
 }
 
 func displayOutDetails(l *outLimits, warning string, req *looprpc.QuoteRequest,
@@ -298,11 +276,12 @@ func displayOutDetails(l *outLimits, warning string, req *looprpc.QuoteRequest,
 	// Display fee limits.
 	if verbose {
 		fmt.Println()
-		fmt.Printf(satAmtFmt, "Max on-chain fee:", l.maxMinerFee)
+		fmt.Printf(satAmtFmt, tr("Max on-chain fee:"), l.maxMinerFee)
 		fmt.Printf(satAmtFmt,
-			"Max off-chain swap routing fee:", l.maxSwapRoutingFee,
+			tr("Max off-chain swap routing fee:"),
+			l.maxSwapRoutingFee,
 		)
-		fmt.Printf(satAmtFmt, "Max off-chain prepay routing fee:",
+		fmt.Printf(satAmtFmt, tr("Max off-chain prepay routing fee:"),
 			l.maxPrepayRoutingFee)
 	}
 
@@ -311,23 +290,21 @@ func displayOutDetails(l *outLimits, warning string, req *looprpc.QuoteRequest,
 		fmt.Printf("\n%s\n\n", warning)
 	}
 
-	fmt.Printf("CONTINUE SWAP? (y/n): ")
+	return confirmSwap()
+}
+
+// confirmSwap asks the user whether to continue with a swap. Besides "y", we
+// accept the answer for yes in the user's language.
+func confirmSwap() error {
+	fmt.Print(tr("CONTINUE SWAP? (y/n): "))
 
 	var answer string
 	fmt.Scanln(&answer)
-	if answer == "y" {
+	if answer == "y" || answer == tr("y") {
 		return nil
 	}
 
-	return errors.New("swap canceled")
-}
-
-func parseAmt(text string) (btcutil.Amount, error) {
-	amtInt64, err := strconv.ParseInt(text, 10, 64)
-	if err != nil {
-		return 0, fmt.Errorf("invalid amt value")
-	}
-	return btcutil.Amount(amtInt64), nil
+	return errors.New(tr("swap canceled"))
 }
 
 func logSwap(swap *looprpc.SwapStatus) {
@@ -337,13 +314,27 @@ func logSwap(swap *looprpc.SwapStatus) {
 		swapState = fmt.Sprintf("%v (%v)", swapState, swap.FailureReason)
 	}
 
-	if swap.Type == looprpc.SwapType_LOOP_OUT {
+	switch {
+	// If the daemon provides all of the swap's htlc addresses, we display
+	// each of them with its output type, marking the primary address.
+	case len(swap.GetHtlcAddresses().GetAddresses()) > 0:
+		fmt.Printf("%v %v %v %v -",
+			time.Unix(0, swap.LastUpdateTime).Format(time.RFC3339),
+			swap.Type, swapState, btcutil.Amount(swap.Amt))
+
+		for _, address := range swap.HtlcAddresses.Addresses {
+			fmt.Printf(" %v: %v", htlcAddressLabel(address),
+				address.Address)
+		}
+
+	case swap.Type == looprpc.SwapType_LOOP_OUT:
 		fmt.Printf("%v %v %v %v - %v",
 			time.Unix(0, swap.LastUpdateTime).Format(time.RFC3339),
 			swap.Type, swapState, btcutil.Amount(swap.Amt),
 			swap.HtlcAddressP2Wsh,
 		)
-	} else {
+
+	default:
 		fmt.Printf("%v %v %v %v -",
 			time.Unix(0, swap.LastUpdateTime).Format(time.RFC3339),
 			swap.Type, swapState, btcutil.Amount(swap.Amt))
@@ -366,74 +357,43 @@ func logSwap(swap *looprpc.SwapStatus) {
 	}
 
 	fmt.Println()
-}
 
-func getClientConn(address, tlsCertPath, macaroonPath string) (*grpc.ClientConn,
-	error) {
-
-	// We always need to send a macaroon.
-	macOption, err := readMacaroon(macaroonPath)
-	if err != nil {
-		return nil, err
-	}
+	// If our swap is slow or stuck, we display why, and what may help it
+	// to progress.
+	if swap.Health == looprpc.SwapHealth_SWAP_HEALTH_SLOW ||
+		swap.Health == looprpc.SwapHealth_SWAP_HEALTH_STUCK {
 
-	opts := []grpc.DialOption{
-		grpc.WithDefaultCallOptions(maxMsgRecvSize),
-		macOption,
-	}
-
-	// TLS cannot be disabled, we'll always have a cert file to read.
-	creds, err := credentials.NewClientTLSFromFile(tlsCertPath, "")
-	if err != nil {
-		return nil, err
+		fmt.Printf("  %v: %v\n", swap.Health, swap.HealthReason)
+		fmt.Print(trf("  Suggested action: %v\n", swap.SuggestedAction))
 	}
+}
 
-	opts = append(opts, grpc.WithTransportCredentials(creds))
+// htlcAddressLabel returns the label that a htlc address is displayed with,
+// which holds its output type and whether it is the swap's primary address.
+func htlcAddressLabel(address *looprpc.HtlcAddress) string {
+	label := strings.TrimPrefix(
+		address.OutputType.String(), "HTLC_OUTPUT_TYPE_",
+	)
 
-	conn, err := grpc.Dial(address, opts...)
-	if err != nil {
-		return nil, fmt.Errorf("unable to connect to RPC server: %v",
-			err)
+	if address.Primary {
+		label += " (primary)"
 	}
 
-	return conn, nil
+	return label
 }
 
-// readMacaroon tries to read the macaroon file at the specified path and create
-// gRPC dial options from it.
-func readMacaroon(macPath string) (grpc.DialOption, error) {
-	// Load the specified macaroon file.
-	macBytes, err := ioutil.ReadFile(macPath)
-	if err != nil {
-		return nil, fmt.Errorf("unable to read macaroon path : %v", err)
+// printHtlcAddresses displays each of the htlc addresses provided on its own
+// line, marking the primary address. False is returned if there are no
+// addresses to display, which is the case for older daemons.
+func printHtlcAddresses(addresses *looprpc.HtlcAddresses) bool {
+	if len(addresses.GetAddresses()) == 0 {
+		return false
 	}
 
-	mac := &macaroon.Macaroon{}
-	if err = mac.UnmarshalBinary(macBytes); err != nil {
-		return nil, fmt.Errorf("unable to decode macaroon: %v", err)
-	}
-
-	macConstraints := []macaroons.Constraint{
-		// We add a time-based constraint to prevent replay of the
-		// macaroon. It's good for 60 seconds by default to make up for
-		// any discrepancy between client and server clocks, but leaking
-		// the macaroon before it becomes invalid makes it possible for
-		// an attacker to reuse the macaroon. In addition, the validity
-		// time of the macaroon is extended by the time the server clock
-		// is behind the client clock, or shortened by the time the
-		// server clock is ahead of the client clock (or invalid
-		// altogether if, in the latter case, this time is more than 60
-		// seconds).
-		macaroons.TimeoutConstraint(defaultMacaroonTimeout),
-	}
-
-	// Apply constraints to the macaroon.
-	constrainedMac, err := macaroons.AddConstraints(mac, macConstraints...)
-	if err != nil {
-		return nil, err
+	for _, address := range addresses.Addresses {
+		fmt.Printf("HTLC address:   %v %v\n", address.Address,
+			htlcAddressLabel(address))
 	}
 
-	// Now we append the macaroon credentials to the dial options.
-	cred := macaroons.NewMacaroonCredential(constrainedMac)
-	return grpc.WithPerRPCCredentials(cred), nil
+	return true
 }
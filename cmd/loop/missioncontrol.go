@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var missionControlCommand = cli.Command{
+	Name:  "missioncontrol",
+	Usage: "manage lnd's mission control state",
+	Description: "The payment attempts made for swaps are recorded in " +
+		"lnd's mission control, which affects the routes that lnd " +
+		"tries for all payments. A snapshot of mission control can be " +
+		"saved before swapping and restored afterwards.",
+	Subcommands: []cli.Command{
+		snapshotMissionControlCommand,
+		resetMissionControlCommand,
+		restoreMissionControlCommand,
+	},
+}
+
+var snapshotMissionControlCommand = cli.Command{
+	Name:  "snapshot",
+	Usage: "save a snapshot of lnd's mission control",
+	Description: "Saves the current state of lnd's mission control, " +
+		"replacing any existing snapshot. A snapshot is also saved " +
+		"before autoloop dispatches a batch of swaps if loopd's " +
+		"missioncontrolbatch option is set.",
+	Action: snapshotMissionControl,
+}
+
+func snapshotMissionControl(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SnapshotMissionControl(
+		context.Background(), &looprpc.SnapshotMissionControlRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var resetMissionControlCommand = cli.Command{
+	Name:   "reset",
+	Usage:  "clear lnd's mission control state",
+	Action: resetMissionControl,
+}
+
+func resetMissionControl(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ResetMissionControl(
+		context.Background(), &looprpc.ResetMissionControlRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var restoreMissionControlCommand = cli.Command{
+	Name:  "restore",
+	Usage: "restore lnd's mission control from the last snapshot",
+	Description: "Clears lnd's mission control state and restores the " +
+		"last snapshot that was saved.",
+	Action: restoreMissionControl,
+}
+
+func restoreMissionControl(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RestoreMissionControl(
+		context.Background(), &looprpc.RestoreMissionControlRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var (
+	planCommand = cli.Command{
+		Name:  "plan",
+		Usage: "split large liquidity changes into sequences of swaps",
+		Description: "Plans split a liquidity change that is too " +
+			"large for a single swap into the sequence of swaps " +
+			"with the lowest quoted fees, and dispatch them over " +
+			"time.",
+		Subcommands: []cli.Command{
+			createPlanCommand, listPlansCommand, resumePlanCommand,
+			pausePlanCommand, cancelPlanCommand,
+		},
+	}
+
+	createPlanCommand = cli.Command{
+		Name:      "create",
+		Usage:     "create a swap plan",
+		ArgsUsage: "amt",
+		Description: `
+	Splits the amount provided into the number of swaps with the lowest
+	quoted fees that keeps them within the server's terms and the fee
+	budget. The swaps are dispatched one at a time, --interval apart. Plans
+	are created paused unless --start is set.`,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "type",
+				Usage: "the type of the plan's swaps {out, in}",
+				Value: "out",
+			},
+			cli.StringFlag{
+				Name: "budget",
+				Usage: "the maximum total fees of the plan's " +
+					"swaps " + amtUnitsUsage,
+			},
+			cli.StringFlag{
+				Name: "channel",
+				Usage: "the comma-separated list of short " +
+					"channel IDs of the channels to loop " +
+					"out",
+			},
+			lastHopFlag,
+			cli.Uint64Flag{
+				Name: "conf_target",
+				Usage: "the confirmation target for the " +
+					"sweeps of loop outs or the htlcs of " +
+					"loop ins",
+			},
+			cli.Uint64Flag{
+				Name: "max_swaps",
+				Usage: "the maximum number of swaps that the " +
+					"amount may be split into",
+			},
+			cli.DurationFlag{
+				Name: "interval",
+				Usage: "the time between the dispatch of " +
+					"consecutive swaps",
+				Value: time.Hour,
+			},
+			cli.Int64Flag{
+				Name: "start_time",
+				Usage: "the unix timestamp at which the first " +
+					"swap may be dispatched",
+			},
+			cli.BoolFlag{
+				Name:  "start",
+				Usage: "start executing the plan immediately",
+			},
+			labelFlag,
+		},
+		Action: createPlan,
+	}
+
+	listPlansCommand = cli.Command{
+		Name:   "list",
+		Usage:  "list all swap plans",
+		Action: listPlans,
+	}
+
+	resumePlanCommand = cli.Command{
+		Name:      "resume",
+		Usage:     "resume a paused or failed swap plan",
+		ArgsUsage: "id",
+		Action: func(ctx *cli.Context) error {
+			return updatePlan(
+				ctx, "resume",
+				looprpc.SwapPlanAction_PLAN_RESUME,
+			)
+		},
+	}
+
+	pausePlanCommand = cli.Command{
+		Name:      "pause",
+		Usage:     "stop dispatching the swaps of a plan",
+		ArgsUsage: "id",
+		Action: func(ctx *cli.Context) error {
+			return updatePlan(
+				ctx, "pause", looprpc.SwapPlanAction_PLAN_PAUSE,
+			)
+		},
+	}
+
+	cancelPlanCommand = cli.Command{
+		Name:      "cancel",
+		Usage:     "cancel a swap plan",
+		ArgsUsage: "id",
+		Action: func(ctx *cli.Context) error {
+			return updatePlan(
+				ctx, "cancel",
+				looprpc.SwapPlanAction_PLAN_CANCEL,
+			)
+		},
+	}
+)
+
+func createPlan(ctx *cli.Context) error {
+	if ctx.NArg() != 1 || !ctx.IsSet("budget") {
+		return cli.ShowCommandHelp(ctx, "create")
+	}
+
+	amt, err := parseAmt(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	budget, err := parseAmtFlag(ctx, "budget")
+	if err != nil {
+		return err
+	}
+
+	req := &looprpc.CreateSwapPlanRequest{
+		Amt:         int64(amt),
+		MaxFeeSat:   int64(budget),
+		ConfTarget:  int32(ctx.Uint64("conf_target")),
+		MaxSwaps:    uint32(ctx.Uint64("max_swaps")),
+		IntervalSec: uint64(ctx.Duration("interval") / time.Second),
+		StartTime:   ctx.Int64("start_time"),
+		Label:       ctx.String(labelFlag.Name),
+		Start:       ctx.Bool("start"),
+	}
+
+	switch ctx.String("type") {
+	case "out":
+		req.Type = looprpc.SwapType_LOOP_OUT
+
+	case "in":
+		req.Type = looprpc.SwapType_LOOP_IN
+
+	default:
+		return fmt.Errorf("unknown swap type: %v", ctx.String("type"))
+	}
+
+	if ctx.IsSet("channel") {
+		chanStrings := strings.Split(ctx.String("channel"), ",")
+		for _, chanString := range chanStrings {
+			chanID, err := strconv.ParseUint(chanString, 10, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing channel id "+
+					"\"%v\"", chanString)
+			}
+			req.OutgoingChanSet = append(
+				req.OutgoingChanSet, chanID,
+			)
+		}
+	}
+
+	if ctx.IsSet(lastHopFlag.Name) {
+		lastHop, err := route.NewVertexFromStr(
+			ctx.String(lastHopFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+		req.LastHop = lastHop[:]
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	plan, err := client.CreateSwapPlan(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(plan)
+
+	return nil
+}
+
+func listPlans(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListSwapPlans(
+		context.Background(), &looprpc.ListSwapPlansRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func updatePlan(ctx *cli.Context, command string,
+	action looprpc.SwapPlanAction) error {
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, command)
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid plan id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	plan, err := client.UpdateSwapPlan(
+		context.Background(), &looprpc.UpdateSwapPlanRequest{
+			Id:     id,
+			Action: action,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(plan)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var captureProfileCommand = cli.Command{
+	Name:  "captureprofile",
+	Usage: "write snapshots of loopd's goroutines and heap to disk",
+	Description: "Captures runtime profiles of loopd and writes them to " +
+		"files in its data directory, which can be used to " +
+		"investigate a hung or misbehaving daemon.",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "type",
+			Usage: "the profile to capture {goroutine, heap}, " +
+				"may be set multiple times; all profiles are " +
+				"captured if unset",
+		},
+	},
+	Action: captureProfile,
+}
+
+func captureProfile(ctx *cli.Context) error {
+	var profileTypes []looprpc.ProfileType
+	for _, name := range ctx.StringSlice("type") {
+		profileType, ok := looprpc.ProfileType_value[strings.ToUpper(
+			name,
+		)]
+		if !ok {
+			return fmt.Errorf("unknown profile type: %v", name)
+		}
+
+		profileTypes = append(
+			profileTypes, looprpc.ProfileType(profileType),
+		)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CaptureProfile(
+		context.Background(), &looprpc.CaptureProfileRequest{
+			ProfileTypes: profileTypes,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -163,33 +163,33 @@ func printQuoteInResp(req *looprpc.QuoteRequest,
 
 	totalFee := resp.HtlcPublishFeeSat + resp.SwapFeeSat
 
-	fmt.Printf(satAmtFmt, "Send on-chain:", req.Amt)
-	fmt.Printf(satAmtFmt, "Receive off-chain:", req.Amt-totalFee)
+	fmt.Printf(satAmtFmt, tr("Send on-chain:"), req.Amt)
+	fmt.Printf(satAmtFmt, tr("Receive off-chain:"), req.Amt-totalFee)
 
 	switch {
 	case req.ExternalHtlc && !verbose:
 		// If it's external then we don't know the miner fee hence the
 		// total cost.
-		fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
+		fmt.Printf(satAmtFmt, tr("Loop service fee:"), resp.SwapFeeSat)
 
 	case req.ExternalHtlc && verbose:
-		fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
+		fmt.Printf(satAmtFmt, tr("Loop service fee:"), resp.SwapFeeSat)
 		fmt.Println()
-		fmt.Printf(blkFmt, "CLTV expiry delta:", resp.CltvDelta)
+		fmt.Printf(blkFmt, tr("CLTV expiry delta:"), resp.CltvDelta)
 
 	case verbose:
 		fmt.Println()
 		fmt.Printf(
-			satAmtFmt, "Estimated on-chain fee:",
+			satAmtFmt, tr("Estimated on-chain fee:"),
 			resp.HtlcPublishFeeSat,
 		)
-		fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
-		fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+		fmt.Printf(satAmtFmt, tr("Loop service fee:"), resp.SwapFeeSat)
+		fmt.Printf(satAmtFmt, tr("Estimated total fee:"), totalFee)
 		fmt.Println()
-		fmt.Printf(blkFmt, "Conf target:", resp.ConfTarget)
-		fmt.Printf(blkFmt, "CLTV expiry delta:", resp.CltvDelta)
+		fmt.Printf(blkFmt, tr("Conf target:"), resp.ConfTarget)
+		fmt.Printf(blkFmt, tr("CLTV expiry delta:"), resp.CltvDelta)
 	default:
-		fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+		fmt.Printf(satAmtFmt, tr("Estimated total fee:"), totalFee)
 	}
 }
 
@@ -198,24 +198,27 @@ func printQuoteOutResp(req *looprpc.QuoteRequest,
 
 	totalFee := resp.HtlcSweepFeeSat + resp.SwapFeeSat
 
-	fmt.Printf(satAmtFmt, "Send off-chain:", req.Amt)
-	fmt.Printf(satAmtFmt, "Receive on-chain:", req.Amt-totalFee)
+	fmt.Printf(satAmtFmt, tr("Send off-chain:"), req.Amt)
+	fmt.Printf(satAmtFmt, tr("Receive on-chain:"), req.Amt-totalFee)
 
 	if !verbose {
-		fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+		fmt.Printf(satAmtFmt, tr("Estimated total fee:"), totalFee)
 		return
 	}
 
 	fmt.Println()
-	fmt.Printf(satAmtFmt, "Estimated on-chain fee:", resp.HtlcSweepFeeSat)
-	fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
-	fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+	fmt.Printf(
+		satAmtFmt, tr("Estimated on-chain fee:"),
+		resp.HtlcSweepFeeSat,
+	)
+	fmt.Printf(satAmtFmt, tr("Loop service fee:"), resp.SwapFeeSat)
+	fmt.Printf(satAmtFmt, tr("Estimated total fee:"), totalFee)
 	fmt.Println()
-	fmt.Printf(satAmtFmt, "No show penalty (prepay):", resp.PrepayAmtSat)
-	fmt.Printf(blkFmt, "Conf target:", resp.ConfTarget)
-	fmt.Printf(blkFmt, "CLTV expiry delta:", resp.CltvDelta)
+	fmt.Printf(satAmtFmt, tr("No show penalty (prepay):"), resp.PrepayAmtSat)
+	fmt.Printf(blkFmt, tr("Conf target:"), resp.ConfTarget)
+	fmt.Printf(blkFmt, tr("CLTV expiry delta:"), resp.CltvDelta)
 	fmt.Printf("%-38s %s\n",
-		"Publication deadline:",
+		tr("Publication deadline:"),
 		time.Unix(int64(req.SwapPublicationDeadline), 0),
 	)
 }
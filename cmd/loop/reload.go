@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var reloadConfigCommand = cli.Command{
+	Name:  "reloadconfig",
+	Usage: "reload loopd's configuration without restarting it",
+	Description: "Reads loopd's configuration file and command line " +
+		"flags again, and applies the options that can be changed " +
+		"without a restart: debuglevel, the limits group and " +
+		"alloweddest. Other options are only applied once loopd is " +
+		"restarted. Sending loopd SIGHUP has the same effect.",
+	Action: reloadConfig,
+}
+
+func reloadConfig(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ReloadConfig(
+		context.Background(), &looprpc.ReloadConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var (
+	reserveFlag = cli.BoolFlag{
+		Name: "reserve",
+		Usage: "set up the swap and show its final fees without " +
+			"executing it. The swap is only executed if it is " +
+			"confirmed with `loop confirmswap` before the " +
+			"reservation expires.",
+	}
+
+	confirmSwapCommand = cli.Command{
+		Name:      "confirmswap",
+		Usage:     "execute a reserved swap",
+		ArgsUsage: "id",
+		Description: "Executes a swap that was reserved with " +
+			"`loop out --reserve` or `loop in --reserve`.",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "id",
+				Usage: "the id of the reserved swap",
+			},
+		},
+		Action: confirmSwap,
+	}
+)
+
+func confirmSwap(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	var idStr string
+	switch {
+	case ctx.IsSet("id"):
+		idStr = ctx.String("id")
+
+	case ctx.NArg() > 0:
+		idStr = args[0]
+
+	default:
+		return cli.ShowCommandHelp(ctx, "confirmswap")
+	}
+
+	id, err := hex.DecodeString(idStr)
+	if err != nil {
+		return fmt.Errorf("invalid swap id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ConfirmReservation(
+		context.Background(), &looprpc.ConfirmReservationRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if resp.ExistingSwap {
+		fmt.Printf("Swap already confirmed\n")
+	} else {
+		fmt.Println(tr("Swap initiated"))
+	}
+	fmt.Printf("ID:             %x\n", resp.IdBytes)
+	if !printHtlcAddresses(resp.HtlcAddresses) {
+		fmt.Printf("HTLC address:   %v\n",
+			resp.HtlcAddress) // nolint:staticcheck
+	}
+	if resp.ServerMessage != "" {
+		fmt.Printf("Server message: %v\n", resp.ServerMessage)
+	}
+	fmt.Println()
+	fmt.Println(tr("Run `loop monitor` to monitor progress."))
+
+	return nil
+}
+
+// printReservation displays the final details of a reserved swap.
+func printReservation(resp *looprpc.SwapReservation) {
+	fmt.Printf("Swap reserved\n")
+	fmt.Printf("ID:             %x\n", resp.Id)
+	fmt.Printf(satAmtFmt, tr("Swap amount:"), btcutil.Amount(resp.Amt))
+	fmt.Printf(satAmtFmt, tr("Swap fee:"), btcutil.Amount(resp.SwapFeeSat))
+	if resp.PrepayAmtSat != 0 {
+		fmt.Printf(satAmtFmt, tr("No show penalty (prepay):"),
+			btcutil.Amount(resp.PrepayAmtSat))
+	}
+	fmt.Printf(satAmtFmt, tr("Max on-chain fee:"),
+		btcutil.Amount(resp.MaxMinerFee))
+
+	if !printHtlcAddresses(resp.HtlcAddresses) {
+		htlcAddress := resp.HtlcAddressP2Wsh
+		if resp.HtlcAddressNp2Wsh != "" {
+			htlcAddress = resp.HtlcAddressNp2Wsh
+		}
+		fmt.Printf("HTLC address:   %v\n", htlcAddress)
+	}
+	fmt.Printf("CLTV expiry:    %v\n", resp.CltvExpiry)
+	if resp.ServerMessage != "" {
+		fmt.Printf("Server message: %v\n", resp.ServerMessage)
+	}
+	fmt.Println()
+
+	expiry := time.Unix(resp.ExpiryUnix, 0).Format(time.RFC3339)
+	if resp.RequiresApproval {
+		fmt.Printf("The swap exceeds the daemon's approval threshold. "+
+			"Run `loop approvals approve %x` before %v to "+
+			"execute it.\n", resp.Id, expiry)
+
+		return
+	}
+
+	fmt.Printf("Run `loop confirmswap %x` before %v to execute the swap.\n",
+		resp.Id, expiry)
+}
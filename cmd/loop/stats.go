@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var statsCommand = cli.Command{
+	Name:  "stats",
+	Usage: "show statistics for completed swaps",
+	Description: "Displays the number of swaps that succeeded and failed, " +
+		"their total cost and the prepays that were kept by the " +
+		"server for failed loop out swaps.",
+	Action: swapStats,
+}
+
+func swapStats(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetSwapStats(
+		context.Background(), &looprpc.SwapStatsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -90,3 +92,204 @@ func swapInfo(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var swapProofCommand = cli.Command{
+	Name:      "swapproof",
+	Usage:     "export the data required to verify a completed swap",
+	ArgsUsage: "id",
+	Description: "Allows the user to export all of the data that is " +
+		"required to independently verify a completed swap on " +
+		"chain and off chain, including its invoices, preimage, " +
+		"htlc script and the transactions published by our wallet",
+	Action: swapProof,
+}
+
+func swapProof(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "swapproof")
+	}
+
+	id := ctx.Args().First()
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetSwapProof(
+		context.Background(), &looprpc.SwapProofRequest{Id: idBytes},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var redactSwapCommand = cli.Command{
+	Name:      "redact",
+	Usage:     "remove identifying metadata from a completed swap",
+	ArgsUsage: "id",
+	Description: "Removes the label, request id and approver identity " +
+		"of a swap in a final state from the database, for " +
+		"operators with data retention policies. The amounts, fees " +
+		"and transactions of the swap are kept, as are labels that " +
+		"loopd set itself",
+	Action: redactSwap,
+}
+
+func redactSwap(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "redact")
+	}
+
+	id := ctx.Args().First()
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.RedactSwap(
+		context.Background(), &looprpc.RedactSwapRequest{Id: idBytes},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Swap %v redacted\n", id)
+
+	return nil
+}
+
+var statusLinkCommand = cli.Command{
+	Name:      "statuslink",
+	Usage:     "create a read-only link to the status of a swap",
+	ArgsUsage: "id",
+	Description: "Creates a signed link that grants read-only access to " +
+		"the status of a single swap over loopd's REST proxy, which " +
+		"may be shared with anyone who should be able to follow the " +
+		"swap. The link expires after the expiry set, and all links " +
+		"are revoked by deleting the statuslink.key file in loopd's " +
+		"data directory and restarting loopd",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name: "expiry",
+			Usage: "the amount of time that the link is valid " +
+				"for, at most 720h",
+			Value: 24 * time.Hour,
+		},
+		cli.StringFlag{
+			Name: "baseurl",
+			Usage: "the url that loopd's REST proxy is reachable " +
+				"at, such as https://loop.example.com:8081, " +
+				"which the link is created for",
+		},
+	},
+	Action: statusLink,
+}
+
+func statusLink(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "statuslink")
+	}
+
+	id := ctx.Args().First()
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	expiry := ctx.Duration("expiry")
+	if expiry < time.Second {
+		return fmt.Errorf("expiry must be at least a second")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetStatusLink(
+		context.Background(), &looprpc.StatusLinkRequest{
+			Id:        idBytes,
+			ExpirySec: uint64(expiry / time.Second),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	link := resp.Path
+	if baseURL := ctx.String("baseurl"); baseURL != "" {
+		link = strings.TrimSuffix(baseURL, "/") + resp.Path
+	}
+
+	fmt.Printf("Status link: %v\n", link)
+	fmt.Printf("Valid until: %v\n", time.Unix(resp.ExpiryUnix, 0))
+
+	return nil
+}
+
+var previewSweepCommand = cli.Command{
+	Name:      "previewsweep",
+	Usage:     "show the transaction that a loop out would sweep with now",
+	ArgsUsage: "id",
+	Description: "Shows the transaction that a pending loop out would " +
+		"sweep its htlc with if it swept now, along with its fee, " +
+		"weight and fee rate, and whether the swap would publish it. " +
+		"Nothing is published",
+	Action: previewSweep,
+}
+
+func previewSweep(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "previewsweep")
+	}
+
+	id := ctx.Args().First()
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.PreviewSweep(
+		context.Background(), &looprpc.PreviewSweepRequest{Id: idBytes},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,456 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var (
+	templateCommand = cli.Command{
+		Name:  "template",
+		Usage: "manage named swap configurations",
+		Description: "Saves swap configurations under a name, so that " +
+			"they can be dispatched with `loop run` rather than " +
+			"retyping their flags.",
+		Subcommands: []cli.Command{
+			setTemplateCommand, listTemplatesCommand,
+			deleteTemplateCommand,
+		},
+	}
+
+	setTemplateCommand = cli.Command{
+		Name:      "set",
+		Usage:     "save a swap template",
+		ArgsUsage: "name",
+		Description: `
+	Saves a swap template under the name provided, replacing any existing
+	template with the same name. Fee limits that are not set are obtained
+	from a quote each time that the template is run.
+
+	Rather than a fixed amount, a template may set the percentage of the
+	balance of its channels that should be local after each swap. The
+	amount is then calculated from the channels' balances each time that
+	the template is run. Loop out templates use the channels set with
+	--channel, and loop in templates the channels with --last_hop, or all
+	active channels if these are not set.`,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "type",
+				Usage: "the type of swap {out, in}",
+				Value: "out",
+			},
+			cli.StringFlag{
+				Name:  "amt",
+				Usage: "the amount to swap " + amtUnitsUsage,
+			},
+			cli.Uint64Flag{
+				Name: "target_local_percent",
+				Usage: "the percentage of the channels' " +
+					"balance that should be local after " +
+					"each swap, used instead of a fixed " +
+					"amount",
+			},
+			cli.StringFlag{
+				Name: "addr",
+				Usage: "the address that loop out swaps sweep " +
+					"to, if left blank the funds will go " +
+					"to lnd's wallet",
+			},
+			cli.StringFlag{
+				Name: "channel",
+				Usage: "the comma-separated list of short " +
+					"channel IDs of the channels to loop " +
+					"out",
+			},
+			cli.Uint64Flag{
+				Name: "conf_target",
+				Usage: "the sweep confirmation target for " +
+					"loop out, or the htlc confirmation " +
+					"target for loop in",
+			},
+			cli.Uint64Flag{
+				Name: "htlc_confs",
+				Usage: "the number of confirmations required " +
+					"for the server's htlc in loop out swaps",
+			},
+			cli.BoolFlag{
+				Name: "fast",
+				Usage: "do not allow the server to delay " +
+					"publishing loop out htlcs",
+			},
+			cli.BoolFlag{
+				Name:  "external",
+				Usage: "expect loop in htlcs to be published externally",
+			},
+			lastHopFlag,
+			cli.StringFlag{
+				Name:  "max_swap_fee",
+				Usage: "the maximum swap fee " + amtUnitsUsage,
+			},
+			cli.StringFlag{
+				Name: "max_miner_fee",
+				Usage: "the maximum on-chain fee " +
+					amtUnitsUsage,
+			},
+			cli.StringFlag{
+				Name: "max_prepay_amt",
+				Usage: "the maximum prepay amount " +
+					amtUnitsUsage + " for loop out",
+			},
+			cli.StringFlag{
+				Name: "max_swap_routing_fee",
+				Usage: "the maximum off-chain swap routing fee " +
+					amtUnitsUsage + " for loop out",
+			},
+			cli.StringFlag{
+				Name: "max_prepay_routing_fee",
+				Usage: "the maximum off-chain prepay routing " +
+					"fee " + amtUnitsUsage + " for loop out",
+			},
+			labelFlag,
+		},
+		Action: setTemplate,
+	}
+
+	listTemplatesCommand = cli.Command{
+		Name:   "list",
+		Usage:  "list all saved swap templates",
+		Action: listTemplates,
+	}
+
+	deleteTemplateCommand = cli.Command{
+		Name:      "delete",
+		Usage:     "delete a swap template",
+		ArgsUsage: "name",
+		Action:    deleteTemplate,
+	}
+
+	runCommand = cli.Command{
+		Name:      "run",
+		Usage:     "dispatch a swap from a saved template",
+		ArgsUsage: "name",
+		Description: "Dispatches a swap using the configuration saved " +
+			"with `loop template set`.",
+		Flags: []cli.Flag{
+			verboseFlag,
+		},
+		Action: runTemplate,
+	}
+)
+
+func setTemplate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "set")
+	}
+
+	amts := make(map[string]btcutil.Amount)
+	for _, name := range []string{
+		"amt", "max_swap_fee", "max_miner_fee", "max_prepay_amt",
+		"max_swap_routing_fee", "max_prepay_routing_fee",
+	} {
+		amt, err := parseAmtFlag(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		amts[name] = amt
+	}
+
+	template := &looprpc.SwapTemplate{
+		Name:                ctx.Args().First(),
+		Amt:                 int64(amts["amt"]),
+		Dest:                ctx.String("addr"),
+		MaxSwapFee:          int64(amts["max_swap_fee"]),
+		MaxMinerFee:         int64(amts["max_miner_fee"]),
+		MaxPrepayAmt:        int64(amts["max_prepay_amt"]),
+		MaxSwapRoutingFee:   int64(amts["max_swap_routing_fee"]),
+		MaxPrepayRoutingFee: int64(amts["max_prepay_routing_fee"]),
+		ConfTarget:          int32(ctx.Uint64("conf_target")),
+		HtlcConfirmations:   int32(ctx.Uint64("htlc_confs")),
+		Fast:                ctx.Bool("fast"),
+		ExternalHtlc:        ctx.Bool("external"),
+		Label:               ctx.String(labelFlag.Name),
+		TargetLocalPercent:  uint32(ctx.Uint64("target_local_percent")),
+	}
+
+	switch ctx.String("type") {
+	case "out":
+		template.Type = looprpc.SwapType_LOOP_OUT
+
+	case "in":
+		template.Type = looprpc.SwapType_LOOP_IN
+
+	default:
+		return fmt.Errorf("unknown swap type: %v", ctx.String("type"))
+	}
+
+	if ctx.IsSet("channel") {
+		chanStrings := strings.Split(ctx.String("channel"), ",")
+		for _, chanString := range chanStrings {
+			chanID, err := strconv.ParseUint(chanString, 10, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing channel id "+
+					"\"%v\"", chanString)
+			}
+			template.OutgoingChanSet = append(
+				template.OutgoingChanSet, chanID,
+			)
+		}
+	}
+
+	if ctx.IsSet(lastHopFlag.Name) {
+		lastHop, err := route.NewVertexFromStr(
+			ctx.String(lastHopFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+
+		template.LastHop = lastHop[:]
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.SetSwapTemplate(
+		context.Background(), &looprpc.SetSwapTemplateRequest{
+			Template: template,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved swap template %v\n", template.Name)
+
+	return nil
+}
+
+func listTemplates(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListSwapTemplates(
+		context.Background(), &looprpc.ListSwapTemplatesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func deleteTemplate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "delete")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.DeleteSwapTemplate(
+		context.Background(), &looprpc.DeleteSwapTemplateRequest{
+			Name: ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted swap template %v\n", ctx.Args().First())
+
+	return nil
+}
+
+func runTemplate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "run")
+	}
+	name := ctx.Args().First()
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// Templates that set a target local balance have their amount
+	// calculated from our current channel balances.
+	resp, err := client.ResolveSwapTemplate(
+		context.Background(), &looprpc.ResolveSwapTemplateRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	template := resp.Template
+
+	verbose := ctx.Bool("verbose")
+
+	switch template.Type {
+	case looprpc.SwapType_LOOP_OUT:
+		return runOutTemplate(client, template, verbose)
+
+	case looprpc.SwapType_LOOP_IN:
+		return runInTemplate(client, template, verbose)
+
+	default:
+		return errors.New("unknown swap type")
+	}
+}
+
+// runOutTemplate dispatches a loop out swap from a template. Fee limits that
+// the template does not set are obtained from a quote.
+func runOutTemplate(client looprpc.SwapClientClient,
+	template *looprpc.SwapTemplate, verbose bool) error {
+
+	amt := btcutil.Amount(template.Amt)
+
+	swapDeadline := time.Now()
+	warning := tr("Fast swap requested.")
+	if !template.Fast {
+		swapDeadline = time.Now().Add(defaultSwapWaitTime)
+		warning = trf("Regular swap speed requested, it "+
+			"might take up to %v for the swap to be executed.",
+			defaultSwapWaitTime)
+	}
+
+	sweepConfTarget := template.ConfTarget
+	if sweepConfTarget == 0 {
+		sweepConfTarget = loop.DefaultSweepConfTarget
+	}
+
+	htlcConfs := template.HtlcConfirmations
+	if htlcConfs == 0 {
+		htlcConfs = int32(loopdb.DefaultLoopOutHtlcConfirmations)
+	}
+
+	quoteReq := &looprpc.QuoteRequest{
+		Amt:                     int64(amt),
+		ConfTarget:              sweepConfTarget,
+		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
+	}
+	quote, err := client.LoopOutQuote(context.Background(), quoteReq)
+	if err != nil {
+		return err
+	}
+
+	limits := getOutLimits(amt, quote)
+	setLimit(&limits.maxSwapFee, template.MaxSwapFee)
+	setLimit(&limits.maxMinerFee, template.MaxMinerFee)
+	setLimit(&limits.maxPrepayAmt, template.MaxPrepayAmt)
+	setLimit(&limits.maxSwapRoutingFee, template.MaxSwapRoutingFee)
+	setLimit(&limits.maxPrepayRoutingFee, template.MaxPrepayRoutingFee)
+
+	err = displayOutDetails(limits, warning, quoteReq, quote, verbose)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.LoopOut(context.Background(), &looprpc.LoopOutRequest{
+		Amt:                     int64(amt),
+		Dest:                    template.Dest,
+		MaxMinerFee:             int64(limits.maxMinerFee),
+		MaxPrepayAmt:            int64(limits.maxPrepayAmt),
+		MaxSwapFee:              int64(limits.maxSwapFee),
+		MaxPrepayRoutingFee:     int64(limits.maxPrepayRoutingFee),
+		MaxSwapRoutingFee:       int64(limits.maxSwapRoutingFee),
+		OutgoingChanSet:         template.OutgoingChanSet,
+		SweepConfTarget:         sweepConfTarget,
+		HtlcConfirmations:       htlcConfs,
+		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
+		Label:                   template.Label,
+		Initiator:               defaultInitiator,
+	})
+	if err != nil {
+		return err
+	}
+
+	printOutResponse(resp)
+
+	return nil
+}
+
+// runInTemplate dispatches a loop in swap from a template. Fee limits that the
+// template does not set are obtained from a quote.
+func runInTemplate(client looprpc.SwapClientClient,
+	template *looprpc.SwapTemplate, verbose bool) error {
+
+	quoteReq := &looprpc.QuoteRequest{
+		Amt:           template.Amt,
+		ConfTarget:    template.ConfTarget,
+		ExternalHtlc:  template.ExternalHtlc,
+		LoopInLastHop: template.LastHop,
+	}
+	quote, err := client.GetLoopInQuote(context.Background(), quoteReq)
+	if err != nil {
+		return err
+	}
+
+	if !template.ExternalHtlc && template.MaxMinerFee == 0 &&
+		quote.HtlcPublishFeeSat == int64(loop.MinerFeeEstimationFailed) {
+
+		return fmt.Errorf("miner fee estimation not " +
+			"possible, lnd has insufficient funds to " +
+			"create a sample transaction for selected " +
+			"amount")
+	}
+
+	limits := getInLimits(quote)
+	setLimit(&limits.maxSwapFee, template.MaxSwapFee)
+	setLimit(&limits.maxMinerFee, template.MaxMinerFee)
+
+	err = displayInDetails(quoteReq, quote, verbose)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.LoopIn(context.Background(), &looprpc.LoopInRequest{
+		Amt:            template.Amt,
+		MaxMinerFee:    int64(limits.maxMinerFee),
+		MaxSwapFee:     int64(limits.maxSwapFee),
+		ExternalHtlc:   template.ExternalHtlc,
+		HtlcConfTarget: template.ConfTarget,
+		Label:          template.Label,
+		Initiator:      defaultInitiator,
+		LastHop:        template.LastHop,
+	})
+	if err != nil {
+		return err
+	}
+
+	printInResponse(resp, template.ExternalHtlc)
+
+	return nil
+}
+
+// setLimit overwrites a fee limit obtained from a quote with the limit set by
+// a template, if it is non-zero.
+func setLimit(limit *btcutil.Amount, templateLimit int64) {
+	if templateLimit != 0 {
+		*limit = btcutil.Amount(templateLimit)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var (
+	tenantCommand = cli.Command{
+		Name:  "tenant",
+		Usage: "manage the tenants of a multi-tenant daemon",
+		Description: "In multi-tenant mode, each macaroon identity is " +
+			"a separate tenant that only sees the swaps it " +
+			"created. Tenant macaroons are baked by the operator.",
+		Subcommands: []cli.Command{
+			bakeTenantCommand,
+		},
+	}
+
+	bakeTenantCommand = cli.Command{
+		Name:  "bake",
+		Usage: "bake a macaroon for a new tenant",
+		Description: `
+	Bakes a macaroon with a new identity, which is a separate tenant that
+	may only use the swap, quote and terms RPCs. The macaroon is printed
+	hex encoded, or written to a file that can be passed to the tenant's
+	cli with --macaroonpath.`,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name: "save_to",
+				Usage: "the file to write the macaroon to, " +
+					"instead of printing it",
+			},
+		},
+		Action: bakeTenant,
+	}
+)
+
+func bakeTenant(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "bake")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.BakeTenantMacaroon(
+		context.Background(), &looprpc.BakeTenantMacaroonRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	path := ctx.String("save_to")
+	if path == "" {
+		printRespJSON(resp)
+
+		return nil
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(path, macBytes, 0600); err != nil {
+		return err
+	}
+
+	fmt.Printf("Macaroon of tenant %v saved to %v\n", resp.Tenant, path)
+
+	return nil
+}
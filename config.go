@@ -16,4 +16,7 @@ type clientConfig struct {
 	LsatStore         lsat.Store
 	CreateExpiryTimer func(expiry time.Duration) <-chan time.Time
 	LoopOutMaxParts   uint32
+	VolumeLimits      *VolumeLimits
+	ServerAddress     string
+	AmountTiers       AmountTiers
 }
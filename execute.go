@@ -0,0 +1,141 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrSwapFailed is returned by ExecuteLoopOut when the swap that it executed
+// reached a final state that is not a success.
+var ErrSwapFailed = errors.New("swap failed")
+
+// swapSubscriptions delivers the updates of our swaps to callers within the
+// client that wait for them, in addition to the status channel that the
+// client is run with.
+type swapSubscriptions struct {
+	subscribers map[int]*queue.ConcurrentQueue
+	nextID      int
+	sync.Mutex
+}
+
+// newSwapSubscriptions creates a set of swap update subscriptions.
+func newSwapSubscriptions() *swapSubscriptions {
+	return &swapSubscriptions{
+		subscribers: make(map[int]*queue.ConcurrentQueue),
+	}
+}
+
+// subscribe returns a channel that receives the updates of all of our swaps
+// until the cancel function returned is called. Updates are queued, so that a
+// slow subscriber does not hold up our swaps.
+func (s *swapSubscriptions) subscribe() (<-chan interface{}, func()) {
+	s.Lock()
+	defer s.Unlock()
+
+	updates := queue.NewConcurrentQueue(10)
+	updates.Start()
+
+	id := s.nextID
+	s.subscribers[id] = updates
+	s.nextID++
+
+	cancel := func() {
+		s.Lock()
+		delete(s.subscribers, id)
+		s.Unlock()
+
+		updates.Stop()
+	}
+
+	return updates.ChanOut(), cancel
+}
+
+// notify delivers a swap update to all of our subscribers. It is safe to call
+// on a nil set of subscriptions, in which case it is a no-op.
+func (s *swapSubscriptions) notify(info SwapInfo) {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	for _, updates := range s.subscribers {
+		updates.ChanIn() <- info
+	}
+}
+
+// ExecuteLoopOut dispatches a loop out swap and blocks until it reaches a
+// final state, returning the swap's final update. If the swap fails, its
+// final update is returned along with ErrSwapFailed. The client must be
+// running. Cancelling the context stops the wait for the swap, but does not
+// cancel the swap itself, which continues to be executed by the client.
+func (s *Client) ExecuteLoopOut(ctx context.Context,
+	request *OutRequest) (*SwapInfo, error) {
+
+	// We subscribe before we dispatch the swap, so that we don't miss any
+	// of its updates.
+	updates, cancel := s.subscriptions.subscribe()
+	defer cancel()
+
+	swapInfo, err := s.LoopOut(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForSwap(ctx, updates, swapInfo.SwapHash)
+}
+
+// ExecuteLoopIn dispatches a loop in swap and blocks until it reaches a final
+// state, in the same way as ExecuteLoopOut.
+func (s *Client) ExecuteLoopIn(ctx context.Context,
+	request *LoopInRequest) (*SwapInfo, error) {
+
+	updates, cancel := s.subscriptions.subscribe()
+	defer cancel()
+
+	swapInfo, err := s.LoopIn(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForSwap(ctx, updates, swapInfo.SwapHash)
+}
+
+// waitForSwap blocks until the swap with the hash provided reaches a final
+// state in the updates provided, returning its final update. If the swap
+// fails, its final update is returned along with ErrSwapFailed.
+func waitForSwap(ctx context.Context, updates <-chan interface{},
+	hash lntypes.Hash) (*SwapInfo, error) {
+
+	for {
+		select {
+		case update := <-updates:
+			info, ok := update.(SwapInfo)
+			if !ok || info.SwapHash != hash {
+				continue
+			}
+
+			switch info.State.Type() {
+			case loopdb.StateTypePending:
+				continue
+
+			case loopdb.StateTypeSuccess:
+				return &info, nil
+
+			default:
+				return &info, fmt.Errorf("%w: %v", ErrSwapFailed,
+					info.State)
+			}
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
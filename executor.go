@@ -11,6 +11,7 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/sweep"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/queue"
 )
 
@@ -27,6 +28,26 @@ type executorConfig struct {
 	loopOutMaxParts uint32
 
 	cancelSwap func(ctx context.Context, details *outCancelDetails) error
+
+	routingHints *routingHints
+
+	sweepDelay func() (time.Duration, error)
+
+	htlcFunder HtlcFunder
+
+	payer Payer
+
+	ampPrepay bool
+
+	paymentPacing *PaymentPacing
+
+	subscriptions *swapSubscriptions
+
+	sweepPreviews *sweepPreviews
+
+	// server is used to resume swaps that were interrupted when our
+	// connection to lnd was lost.
+	server swapServerClient
 }
 
 // executor is responsible for executing swaps.
@@ -38,6 +59,13 @@ type executor struct {
 	currentHeight uint32
 	ready         chan struct{}
 
+	// walletLock serializes the funding of htlcs from lnd's wallet across
+	// all of the swaps that we execute.
+	walletLock sync.Mutex
+
+	// lndConn tracks the state of our subscriptions to lnd.
+	lndConn *lndConnMonitor
+
 	executorConfig
 }
 
@@ -47,11 +75,29 @@ func newExecutor(cfg *executorConfig) *executor {
 		executorConfig: *cfg,
 		newSwaps:       make(chan genericSwap),
 		ready:          make(chan struct{}),
+		lndConn:        newLndConnMonitor(time.Now),
 	}
 }
 
+// swapDone is sent by the goroutine that executes a swap once the swap stops
+// executing.
+type swapDone struct {
+	// id identifies the swap's block epoch queue.
+	id int
+
+	// hash is the hash of the swap.
+	hash lntypes.Hash
+
+	// err is the error that the swap stopped executing with, if any.
+	err error
+}
+
 // run starts the executor event loop. It accepts and executes new swaps,
-// providing them with required config data.
+// providing them with required config data. If our block subscription fails,
+// for example because lnd restarted, we resubscribe with a backoff. Once we
+// are reconnected, swaps that were interrupted by an error are reloaded from
+// the store and resumed if they are still pending, so that they subscribe to
+// lnd again.
 func (s *executor) run(mainCtx context.Context,
 	statusChan chan<- SwapInfo) error {
 
@@ -110,6 +156,7 @@ func (s *executor) run(mainCtx context.Context,
 
 	// Start main event loop.
 	log.Infof("Starting event loop at height %v", height)
+	s.lndConn.connected(0)
 
 	// Signal that executor being ready with an up to date block height.
 	close(s.ready)
@@ -125,50 +172,118 @@ func (s *executor) run(mainCtx context.Context,
 		}
 	}()
 
-	swapDoneChan := make(chan int)
+	swapDoneChan := make(chan swapDone)
 	nextSwapID := 0
+	startSwap := func(newSwap genericSwap) {
+		queue := queue.NewConcurrentQueue(10)
+		queue.Start()
+		swapID := nextSwapID
+		blockEpochQueues[swapID] = queue
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			err := newSwap.execute(mainCtx, &executeConfig{
+				statusChan:      statusChan,
+				sweeper:         s.sweeper,
+				blockEpochChan:  queue.ChanOut(),
+				timerFactory:    s.executorConfig.createExpiryTimer,
+				loopOutMaxParts: s.executorConfig.loopOutMaxParts,
+				cancelSwap:      s.executorConfig.cancelSwap,
+				routingHints:    s.executorConfig.routingHints,
+				sweepDelay:      s.executorConfig.sweepDelay,
+				htlcFunder:      s.executorConfig.htlcFunder,
+				payer:           s.executorConfig.payer,
+				ampPrepay:       s.executorConfig.ampPrepay,
+				paymentPacing:   s.executorConfig.paymentPacing,
+				subscriptions:   s.subscriptions,
+				sweepPreviews:   s.sweepPreviews,
+				walletLock:      &s.walletLock,
+			}, height)
+			if err != nil && err != context.Canceled {
+				log.Errorf("Execute error: %v", err)
+			}
+
+			done := swapDone{
+				id:   swapID,
+				hash: newSwap.swapHash(),
+				err:  err,
+			}
+
+			select {
+			case swapDoneChan <- done:
+			case <-mainCtx.Done():
+			}
+		}()
+
+		nextSwapID++
+	}
+
+	// interrupted holds the swaps that stopped executing with an error
+	// since we last subscribed to lnd. Most likely, their subscriptions
+	// failed along with ours, so we resume them once we are reconnected.
+	interrupted := make(map[lntypes.Hash]struct{})
+
+	// resumeInterrupted resumes our interrupted swaps that are still
+	// pending, returning the number of swaps that were resumed.
+	resumeInterrupted := func() int {
+		swapCfg := newSwapConfig(s.lnd, s.store, s.server)
+
+		var resumed int
+		for hash := range interrupted {
+			swap, err := resumeSwap(mainCtx, swapCfg, hash)
+			if err != nil {
+				log.Errorf("Unable to resume swap %v: %v", hash,
+					err)
+
+				continue
+			}
+
+			if swap == nil {
+				continue
+			}
+
+			log.Infof("Resuming swap %v after lnd reconnect", hash)
+			startSwap(swap)
+			resumed++
+		}
+
+		interrupted = make(map[lntypes.Hash]struct{})
+
+		return resumed
+	}
+
+	var (
+		// reconnectChan fires when we should next try to resubscribe
+		// to lnd. It is nil while we are subscribed.
+		reconnectChan  <-chan time.Time
+		reconnectDelay time.Duration
+
+		// reconnected is true when we have resubscribed to lnd, but
+		// not yet received our first block from the new subscription.
+		reconnected bool
+	)
+
 	for {
 		select {
 
 		case newSwap := <-s.newSwaps:
-			queue := queue.NewConcurrentQueue(10)
-			queue.Start()
-			swapID := nextSwapID
-			blockEpochQueues[swapID] = queue
-
-			s.wg.Add(1)
-			go func() {
-				defer s.wg.Done()
-
-				err := newSwap.execute(mainCtx, &executeConfig{
-					statusChan:      statusChan,
-					sweeper:         s.sweeper,
-					blockEpochChan:  queue.ChanOut(),
-					timerFactory:    s.executorConfig.createExpiryTimer,
-					loopOutMaxParts: s.executorConfig.loopOutMaxParts,
-					cancelSwap:      s.executorConfig.cancelSwap,
-				}, height)
-				if err != nil && err != context.Canceled {
-					log.Errorf("Execute error: %v", err)
-				}
-
-				select {
-				case swapDoneChan <- swapID:
-				case <-mainCtx.Done():
-				}
-			}()
-
-			nextSwapID++
+			startSwap(newSwap)
 
-		case doneID := <-swapDoneChan:
-			queue, ok := blockEpochQueues[doneID]
+		case done := <-swapDoneChan:
+			queue, ok := blockEpochQueues[done.id]
 			if !ok {
 				return fmt.Errorf(
 					"swap id %v not found in queues",
-					doneID)
+					done.id)
 			}
 			queue.Stop()
-			delete(blockEpochQueues, doneID)
+			delete(blockEpochQueues, done.id)
+
+			if done.err != nil && mainCtx.Err() == nil {
+				interrupted[done.hash] = struct{}{}
+			}
 
 		case h := <-blockEpochChan:
 			setHeight(h)
@@ -180,8 +295,43 @@ func (s *executor) run(mainCtx context.Context,
 				}
 			}
 
+			// We only resume swaps once we have received a block
+			// from lnd, so that they start at an up to date
+			// height.
+			if reconnected {
+				reconnected = false
+				s.lndConn.connected(resumeInterrupted())
+			}
+
 		case err := <-blockErrorChan:
-			return fmt.Errorf("block error: %v", err)
+			s.lndConn.disconnected(err)
+
+			blockEpochChan, blockErrorChan = nil, nil
+			reconnected = false
+
+			reconnectDelay = lndReconnectBaseDelay
+			reconnectChan = time.After(reconnectDelay)
+
+		case <-reconnectChan:
+			blockEpochChan, blockErrorChan, err =
+				s.lnd.ChainNotifier.RegisterBlockEpochNtfn(
+					mainCtx,
+				)
+			if err != nil {
+				reconnectDelay = nextLndReconnectDelay(
+					reconnectDelay,
+				)
+				reconnectChan = time.After(reconnectDelay)
+
+				log.Warnf("Unable to resubscribe to lnd, "+
+					"retrying in %v: %v", reconnectDelay,
+					err)
+
+				continue
+			}
+
+			reconnectChan = nil
+			reconnected = true
 
 		case <-mainCtx.Done():
 			return mainCtx.Err()
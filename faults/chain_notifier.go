@@ -0,0 +1,130 @@
+package faults
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// chainNotifier wraps a chain notifier, dropping confirmation notifications
+// and simulating reorgs as its injector instructs.
+type chainNotifier struct {
+	lndclient.ChainNotifierClient
+
+	injector *Injector
+}
+
+// ChainNotifier wraps the chain notifier provided so that faults are injected
+// into the confirmation and block notifications that it delivers.
+func (i *Injector) ChainNotifier(
+	notifier lndclient.ChainNotifierClient) lndclient.ChainNotifierClient {
+
+	return &chainNotifier{
+		ChainNotifierClient: notifier,
+		injector:            i,
+	}
+}
+
+// RegisterConfirmationsNtfn registers for the confirmation of a transaction,
+// dropping the notification if our injector instructs us to.
+func (c *chainNotifier) RegisterConfirmationsNtfn(ctx context.Context,
+	txid *chainhash.Hash, pkScript []byte, numConfs, heightHint int32) (
+	chan *chainntnfs.TxConfirmation, chan error, error) {
+
+	notifier := c.ChainNotifierClient
+	confChan, errChan, err := notifier.RegisterConfirmationsNtfn(
+		ctx, txid, pkScript, numConfs, heightHint,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	faultyConfChan := make(chan *chainntnfs.TxConfirmation, 1)
+
+	go func() {
+		for {
+			select {
+			case conf, ok := <-confChan:
+				if !ok {
+					return
+				}
+
+				if c.injector.dropConfirmation() {
+					continue
+				}
+
+				select {
+				case faultyConfChan <- conf:
+
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return faultyConfChan, errChan, nil
+}
+
+// RegisterBlockEpochNtfn registers for block notifications, notifying the
+// subscriber of reorgs that our injector simulates.
+func (c *chainNotifier) RegisterBlockEpochNtfn(ctx context.Context) (
+	chan int32, chan error, error) {
+
+	notifier := c.ChainNotifierClient
+	blockChan, errChan, err := notifier.RegisterBlockEpochNtfn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id, reorgs := c.injector.subscribeReorgs()
+	faultyBlockChan := make(chan int32)
+
+	go func() {
+		defer c.injector.unsubscribeReorgs(id)
+
+		var tip int32
+		for {
+			var height int32
+
+			select {
+			case block, ok := <-blockChan:
+				if !ok {
+					return
+				}
+
+				tip = block
+				height = block
+
+			case depth := <-reorgs:
+				// We can't reorg before we know our tip.
+				if tip == 0 {
+					continue
+				}
+
+				height = tip - depth
+				if height < 0 {
+					height = 0
+				}
+				tip = height
+
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case faultyBlockChan <- height:
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return faultyBlockChan, errChan, nil
+}
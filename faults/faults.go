@@ -0,0 +1,316 @@
+// Package faults injects failures into the swap server calls and chain
+// notifications of a loop client, so that its handling of realistic failure
+// scenarios can be tested locally. Faults are only injected if the hooks that
+// this package provides are installed, which loopd only does when it is built
+// with the dev build tag.
+package faults
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// serverService is the prefix of the full grpc method names of the
+	// swap server.
+	serverService = "/looprpc.SwapServer/"
+)
+
+var (
+	// ErrNoMethod is returned when a server fault is injected without a
+	// method.
+	ErrNoMethod = errors.New("server fault requires a method")
+
+	// ErrNoEffect is returned when a server fault neither delays nor
+	// fails calls.
+	ErrNoEffect = errors.New("server fault must delay or fail calls")
+
+	// ErrZeroDepth is returned when a reorg is simulated with a depth of
+	// zero.
+	ErrZeroDepth = errors.New("reorg depth must be positive")
+)
+
+// ServerFault is a fault that is injected into calls to a swap server method.
+type ServerFault struct {
+	// Method is the swap server method that the fault applies to, either
+	// as a full grpc method name or as the name of the method in the swap
+	// server service, eg NewLoopOutSwap.
+	Method string
+
+	// Delay is the amount of time that calls are delayed for before they
+	// are made, or failed.
+	Delay time.Duration
+
+	// Fail fails calls with an unavailable error rather than making them.
+	Fail bool
+
+	// Count is the number of calls that the fault is injected into. If it
+	// is zero, the fault is injected into all calls until it is cleared.
+	Count uint32
+}
+
+// fullMethod returns the full grpc method name of a swap server method.
+func fullMethod(method string) string {
+	if strings.HasPrefix(method, "/") {
+		return method
+	}
+
+	return serverService + method
+}
+
+// State describes the faults that are currently injected.
+type State struct {
+	// ServerFaults are the faults injected into server calls, ordered by
+	// method.
+	ServerFaults []ServerFault
+
+	// DropConfirmations is the number of confirmation notifications that
+	// remain to be dropped.
+	DropConfirmations uint32
+}
+
+// Injector injects faults into the server calls and chain notifications that
+// pass through the hooks that it provides.
+type Injector struct {
+	// serverFaults maps full grpc method names to the fault injected into
+	// calls to the method.
+	serverFaults map[string]*ServerFault
+
+	// dropConfs is the number of confirmation notifications that remain
+	// to be dropped.
+	dropConfs uint32
+
+	// reorgSubscribers maps the id of each block epoch subscription to
+	// the channel that simulated reorgs are delivered on.
+	reorgSubscribers map[uint64]chan int32
+
+	// nextSubscriber is the id of our next block epoch subscription.
+	nextSubscriber uint64
+
+	mu sync.Mutex
+}
+
+// NewInjector creates an injector that has no faults set.
+func NewInjector() *Injector {
+	return &Injector{
+		serverFaults:     make(map[string]*ServerFault),
+		reorgSubscribers: make(map[uint64]chan int32),
+	}
+}
+
+// SetServerFault injects a fault into calls to a swap server method,
+// replacing any fault that was set for the method.
+func (i *Injector) SetServerFault(fault ServerFault) error {
+	if fault.Method == "" {
+		return ErrNoMethod
+	}
+
+	if fault.Delay <= 0 && !fault.Fail {
+		return ErrNoEffect
+	}
+
+	fault.Method = fullMethod(fault.Method)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.serverFaults[fault.Method] = &fault
+
+	return nil
+}
+
+// DropConfirmations drops the next number of confirmation notifications
+// provided, so that the transactions appear never to confirm.
+func (i *Injector) DropConfirmations(count uint32) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.dropConfs = count
+}
+
+// SimulateReorg notifies every block epoch subscriber of the block at the
+// depth provided below the tip that it was last notified of, as if the chain
+// had reorged back to that block. The subscribers are notified of the blocks
+// that follow as usual. It returns the number of subscribers that were
+// notified.
+func (i *Injector) SimulateReorg(depth int32) (int, error) {
+	if depth <= 0 {
+		return 0, ErrZeroDepth
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var notified int
+	for _, reorgs := range i.reorgSubscribers {
+		// We don't block on subscribers that have not consumed their
+		// last reorg yet, since they will reorg anyway.
+		select {
+		case reorgs <- depth:
+			notified++
+
+		default:
+		}
+	}
+
+	return notified, nil
+}
+
+// Clear removes all of the faults that are set.
+func (i *Injector) Clear() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.serverFaults = make(map[string]*ServerFault)
+	i.dropConfs = 0
+}
+
+// State returns the faults that are currently injected.
+func (i *Injector) State() *State {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	state := &State{
+		DropConfirmations: i.dropConfs,
+	}
+
+	for _, fault := range i.serverFaults {
+		state.ServerFaults = append(state.ServerFaults, *fault)
+	}
+
+	sort.Slice(state.ServerFaults, func(a, b int) bool {
+		return state.ServerFaults[a].Method <
+			state.ServerFaults[b].Method
+	})
+
+	return state
+}
+
+// takeServerFault returns the fault that is injected into a call to the
+// method provided, if any, using up one of its calls.
+func (i *Injector) takeServerFault(method string) *ServerFault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	fault, ok := i.serverFaults[method]
+	if !ok {
+		return nil
+	}
+
+	if fault.Count == 0 {
+		return fault
+	}
+
+	fault.Count--
+	if fault.Count == 0 {
+		delete(i.serverFaults, method)
+	}
+
+	return fault
+}
+
+// injectServerFault delays or fails a call to the method provided if a fault
+// is set for it.
+func (i *Injector) injectServerFault(ctx context.Context, method string) error {
+	fault := i.takeServerFault(method)
+	if fault == nil {
+		return nil
+	}
+
+	if fault.Delay > 0 {
+		select {
+		case <-time.After(fault.Delay):
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if fault.Fail {
+		return status.Errorf(
+			codes.Unavailable, "injected fault: %v", method,
+		)
+	}
+
+	return nil
+}
+
+// DialOptions returns the options that a connection to the swap server must
+// be dialed with for faults to be injected into its calls.
+func (i *Injector) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(i.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(i.streamInterceptor),
+	}
+}
+
+// unaryInterceptor injects faults into unary server calls.
+func (i *Injector) unaryInterceptor(ctx context.Context, method string,
+	req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	if err := i.injectServerFault(ctx, method); err != nil {
+		return err
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// streamInterceptor injects faults into the creation of server streams.
+func (i *Injector) streamInterceptor(ctx context.Context,
+	desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream,
+	error) {
+
+	if err := i.injectServerFault(ctx, method); err != nil {
+		return nil, err
+	}
+
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// dropConfirmation returns true if the next confirmation notification should
+// be dropped, using up one of our drops.
+func (i *Injector) dropConfirmation() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.dropConfs == 0 {
+		return false
+	}
+
+	i.dropConfs--
+
+	return true
+}
+
+// subscribeReorgs returns the id of a new block epoch subscription and the
+// channel that simulated reorgs are delivered to it on.
+func (i *Injector) subscribeReorgs() (uint64, <-chan int32) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	id := i.nextSubscriber
+	i.nextSubscriber++
+
+	reorgs := make(chan int32, 1)
+	i.reorgSubscribers[id] = reorgs
+
+	return id, reorgs
+}
+
+// unsubscribeReorgs removes a block epoch subscription.
+func (i *Injector) unsubscribeReorgs(id uint64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.reorgSubscribers, id)
+}
@@ -0,0 +1,198 @@
+package faults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestServerFaults tests injection of faults into server calls.
+func TestServerFaults(t *testing.T) {
+	injector := NewInjector()
+	ctx := context.Background()
+
+	var calls int
+	invoker := func(_ context.Context, _ string, _, _ interface{},
+		_ *grpc.ClientConn, _ ...grpc.CallOption) error {
+
+		calls++
+		return nil
+	}
+
+	call := func(method string) error {
+		return injector.unaryInterceptor(
+			ctx, method, nil, nil, nil, invoker,
+		)
+	}
+
+	require.Equal(t, ErrNoMethod, injector.SetServerFault(ServerFault{
+		Fail: true,
+	}))
+	require.Equal(t, ErrNoEffect, injector.SetServerFault(ServerFault{
+		Method: "NewLoopOutSwap",
+	}))
+
+	// Fail the next two calls to create a loop out, which we set by the
+	// method's short name.
+	err := injector.SetServerFault(ServerFault{
+		Method: "NewLoopOutSwap",
+		Fail:   true,
+		Count:  2,
+	})
+	require.NoError(t, err)
+
+	loopOut := serverService + "NewLoopOutSwap"
+	require.Equal(t, &State{
+		ServerFaults: []ServerFault{
+			{
+				Method: loopOut,
+				Fail:   true,
+				Count:  2,
+			},
+		},
+	}, injector.State())
+
+	// Calls to other methods are not affected.
+	require.NoError(t, call(serverService+"NewLoopInSwap"))
+	require.Equal(t, 1, calls)
+
+	for i := 0; i < 2; i++ {
+		err := call(loopOut)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	require.Equal(t, 1, calls)
+
+	// Once the fault's calls are used up, it is removed.
+	require.NoError(t, call(loopOut))
+	require.Equal(t, 2, calls)
+	require.Empty(t, injector.State().ServerFaults)
+
+	// A delayed call is cancelled with its context.
+	err = injector.SetServerFault(ServerFault{
+		Method: loopOut,
+		Delay:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	err = injector.unaryInterceptor(
+		cancelCtx, loopOut, nil, nil, nil, invoker,
+	)
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, 2, calls)
+
+	// Faults without a count stay in place until they are cleared.
+	require.Len(t, injector.State().ServerFaults, 1)
+
+	injector.Clear()
+	require.Equal(t, &State{}, injector.State())
+}
+
+// mockNotifier is a chain notifier that delivers the confirmations and blocks
+// that it is sent.
+type mockNotifier struct {
+	lndclient.ChainNotifierClient
+
+	confs  chan *chainntnfs.TxConfirmation
+	blocks chan int32
+}
+
+func (m *mockNotifier) RegisterConfirmationsNtfn(_ context.Context,
+	_ *chainhash.Hash, _ []byte, _, _ int32) (
+	chan *chainntnfs.TxConfirmation, chan error, error) {
+
+	return m.confs, make(chan error), nil
+}
+
+func (m *mockNotifier) RegisterBlockEpochNtfn(_ context.Context) (
+	chan int32, chan error, error) {
+
+	return m.blocks, make(chan error), nil
+}
+
+// TestDropConfirmations tests that confirmation notifications are dropped.
+func TestDropConfirmations(t *testing.T) {
+	injector := NewInjector()
+	mock := &mockNotifier{
+		confs: make(chan *chainntnfs.TxConfirmation),
+	}
+	notifier := injector.ChainNotifier(mock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	confChan, _, err := notifier.RegisterConfirmationsNtfn(
+		ctx, nil, nil, 1, 0,
+	)
+	require.NoError(t, err)
+
+	injector.DropConfirmations(1)
+	require.Equal(t, uint32(1), injector.State().DropConfirmations)
+
+	dropped := &chainntnfs.TxConfirmation{BlockHeight: 1}
+	delivered := &chainntnfs.TxConfirmation{BlockHeight: 2}
+
+	mock.confs <- dropped
+	mock.confs <- delivered
+
+	select {
+	case conf := <-confChan:
+		require.Equal(t, delivered, conf)
+
+	case <-time.After(time.Second * 5):
+		t.Fatal("confirmation not delivered")
+	}
+
+	require.Zero(t, injector.State().DropConfirmations)
+}
+
+// TestSimulateReorg tests that block subscribers are notified of simulated
+// reorgs.
+func TestSimulateReorg(t *testing.T) {
+	injector := NewInjector()
+	mock := &mockNotifier{
+		blocks: make(chan int32),
+	}
+	notifier := injector.ChainNotifier(mock)
+
+	_, err := injector.SimulateReorg(0)
+	require.Equal(t, ErrZeroDepth, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blockChan, _, err := notifier.RegisterBlockEpochNtfn(ctx)
+	require.NoError(t, err)
+
+	receive := func(expected int32) {
+		select {
+		case height := <-blockChan:
+			require.Equal(t, expected, height)
+
+		case <-time.After(time.Second * 5):
+			t.Fatal("block not delivered")
+		}
+	}
+
+	mock.blocks <- 100
+	receive(100)
+
+	notified, err := injector.SimulateReorg(3)
+	require.NoError(t, err)
+	require.Equal(t, 1, notified)
+	receive(97)
+
+	// After the reorg, blocks are delivered as usual.
+	mock.blocks <- 101
+	receive(101)
+}
@@ -0,0 +1,213 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// ErrFeeBudgetExceeded is returned when a swap is requested whose fees could
+// exceed the fee budget of its tenant or label.
+var ErrFeeBudgetExceeded = errors.New("fee budget exceeded")
+
+// swapFees is the namespaces of a swap, the time it was initiated at and the
+// fees that count towards its budgets.
+type swapFees struct {
+	initiated time.Time
+	tenant    string
+	label     string
+	fees      btcutil.Amount
+}
+
+// inNamespace returns a boolean indicating whether the swap counts towards
+// budgets of the namespace and name provided.
+func (s swapFees) inNamespace(namespace loopdb.BudgetNamespace,
+	name string) bool {
+
+	switch namespace {
+	case loopdb.BudgetNamespaceTenant:
+		return s.tenant == name
+
+	case loopdb.BudgetNamespaceLabel:
+		return s.label == name
+
+	default:
+		return false
+	}
+}
+
+// budgetSpent returns the fees that the swaps provided have spent from a fee
+// budget at the time provided.
+func budgetSpent(budget *loopdb.FeeBudget, swaps []swapFees,
+	now time.Time) btcutil.Amount {
+
+	var spent btcutil.Amount
+	for _, swap := range swaps {
+		if !swap.inNamespace(budget.Namespace, budget.Name) {
+			continue
+		}
+
+		if budget.Period != 0 &&
+			swap.initiated.Before(now.Add(-budget.Period)) {
+
+			continue
+		}
+
+		spent += swap.fees
+	}
+
+	return spent
+}
+
+// checkFeeBudgets checks whether initiating a swap with the fees provided now
+// would exceed any of the budgets that apply to it, given the swaps that have
+// already been initiated.
+func checkFeeBudgets(budgets []*loopdb.FeeBudget, swaps []swapFees,
+	now time.Time, swap swapFees) error {
+
+	for _, budget := range budgets {
+		if !swap.inNamespace(budget.Namespace, budget.Name) {
+			continue
+		}
+
+		spent := budgetSpent(budget, swaps, now) + swap.fees
+		if spent > budget.Budget {
+			return fmt.Errorf("%w: fees of up to %v would exceed "+
+				"budget of %v for %v %v", ErrFeeBudgetExceeded,
+				spent, budget.Budget, budget.Namespace,
+				budget.Name)
+		}
+	}
+
+	return nil
+}
+
+// maxLoopOutFees returns the maximum fees that a loop out request may spend.
+func maxLoopOutFees(request *OutRequest) btcutil.Amount {
+	return request.MaxSwapFee + request.MaxMinerFee +
+		request.MaxSwapRoutingFee + request.MaxPrepayRoutingFee
+}
+
+// maxLoopInFees returns the maximum fees that a loop in request may spend.
+func maxLoopInFees(request *LoopInRequest) btcutil.Amount {
+	return request.MaxSwapFee + request.MaxMinerFee
+}
+
+// reserveFees checks that a swap with the maximum fees provided does not
+// exceed the fee budgets of its tenant or label, and counts it towards them
+// until the release function that is returned is called. The swap should be
+// persisted before it is released, so that it is counted from our store from
+// then on.
+func (s *Client) reserveFees(tenant, label string,
+	maxFees btcutil.Amount) (func(), error) {
+
+	s.volumeLock.Lock()
+	defer s.volumeLock.Unlock()
+
+	budgets, err := s.Store.FetchFeeBudgets()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(budgets) == 0 {
+		return func() {}, nil
+	}
+
+	swaps, err := s.storedFees()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pending := range s.pendingFees {
+		swaps = append(swaps, pending)
+	}
+
+	swap := swapFees{
+		initiated: time.Now(),
+		tenant:    tenant,
+		label:     label,
+		fees:      maxFees,
+	}
+
+	err = checkFeeBudgets(budgets, swaps, swap.initiated, swap)
+	if err != nil {
+		return nil, err
+	}
+
+	id := s.nextVolumeID
+	s.nextVolumeID++
+
+	s.pendingFees[id] = swap
+
+	return func() {
+		s.volumeLock.Lock()
+		delete(s.pendingFees, id)
+		s.volumeLock.Unlock()
+	}, nil
+}
+
+// FeeBudgetSpent returns the fees that have been spent from the budget
+// provided. Completed swaps count with their actual cost, and pending swaps
+// with the maximum fees that they were initiated with.
+func (s *Client) FeeBudgetSpent(budget *loopdb.FeeBudget) (btcutil.Amount,
+	error) {
+
+	swaps, err := s.storedFees()
+	if err != nil {
+		return 0, err
+	}
+
+	return budgetSpent(budget, swaps, time.Now()), nil
+}
+
+// storedFees returns the namespaces, initiation time and fees of all the
+// swaps in our store.
+func (s *Client) storedFees() ([]swapFees, error) {
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	swaps := make([]swapFees, 0, len(loopOutSwaps)+len(loopInSwaps))
+	for _, swp := range loopOutSwaps {
+		fees := swp.State().Cost.Total()
+		if swp.State().State.Type() == loopdb.StateTypePending {
+			fees = swp.Contract.MaxSwapFee +
+				swp.Contract.MaxMinerFee +
+				swp.Contract.MaxSwapRoutingFee +
+				swp.Contract.MaxPrepayRoutingFee
+		}
+
+		swaps = append(swaps, swapFees{
+			initiated: swp.Contract.InitiationTime,
+			tenant:    swp.Contract.Tenant,
+			label:     swp.Contract.Label,
+			fees:      fees,
+		})
+	}
+
+	for _, swp := range loopInSwaps {
+		fees := swp.State().Cost.Total()
+		if swp.State().State.Type() == loopdb.StateTypePending {
+			fees = swp.Contract.MaxSwapFee +
+				swp.Contract.MaxMinerFee
+		}
+
+		swaps = append(swaps, swapFees{
+			initiated: swp.Contract.InitiationTime,
+			tenant:    swp.Contract.Tenant,
+			label:     swp.Contract.Label,
+			fees:      fees,
+		})
+	}
+
+	return swaps, nil
+}
@@ -0,0 +1,130 @@
+package loop
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckFeeBudgets tests enforcement of the fee budgets of tenants and
+// labels.
+func TestCheckFeeBudgets(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	day := time.Hour * 24
+
+	swaps := []swapFees{
+		{
+			// A swap that is outside of our daily budgets.
+			initiated: now.Add(-day - time.Second),
+			tenant:    "alice",
+			label:     "treasury",
+			fees:      1000,
+		},
+		{
+			initiated: now.Add(-time.Hour),
+			tenant:    "alice",
+			fees:      100,
+		},
+		{
+			initiated: now.Add(-time.Hour),
+			tenant:    "bob",
+			label:     "treasury",
+			fees:      200,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		budgets  []*loopdb.FeeBudget
+		tenant   string
+		label    string
+		fees     btcutil.Amount
+		exceeded bool
+	}{
+		{
+			name:   "no budgets",
+			tenant: "alice",
+			fees:   10000,
+		},
+		{
+			name: "within daily tenant budget",
+			budgets: []*loopdb.FeeBudget{{
+				Namespace: loopdb.BudgetNamespaceTenant,
+				Name:      "alice",
+				Budget:    150,
+				Period:    day,
+			}},
+			tenant: "alice",
+			fees:   50,
+		},
+		{
+			name: "daily tenant budget exceeded",
+			budgets: []*loopdb.FeeBudget{{
+				Namespace: loopdb.BudgetNamespaceTenant,
+				Name:      "alice",
+				Budget:    150,
+				Period:    day,
+			}},
+			tenant:   "alice",
+			fees:     51,
+			exceeded: true,
+		},
+		{
+			name: "all time tenant budget exceeded",
+			budgets: []*loopdb.FeeBudget{{
+				Namespace: loopdb.BudgetNamespaceTenant,
+				Name:      "alice",
+				Budget:    1150,
+			}},
+			tenant:   "alice",
+			fees:     51,
+			exceeded: true,
+		},
+		{
+			name: "other tenant's budget",
+			budgets: []*loopdb.FeeBudget{{
+				Namespace: loopdb.BudgetNamespaceTenant,
+				Name:      "bob",
+				Budget:    0,
+			}},
+			tenant: "alice",
+			fees:   51,
+		},
+		{
+			name: "label budget exceeded",
+			budgets: []*loopdb.FeeBudget{{
+				Namespace: loopdb.BudgetNamespaceLabel,
+				Name:      "treasury",
+				Budget:    250,
+				Period:    day,
+			}},
+			tenant:   "alice",
+			label:    "treasury",
+			fees:     51,
+			exceeded: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkFeeBudgets(
+				testCase.budgets, swaps, now, swapFees{
+					initiated: now,
+					tenant:    testCase.tenant,
+					label:     testCase.label,
+					fees:      testCase.fees,
+				},
+			)
+			require.Equal(
+				t, testCase.exceeded,
+				errors.Is(err, ErrFeeBudgetExceeded),
+			)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package loop
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// recordFee stores a record of the fee estimate that the swap observed when
+// it made a fee sensitive decision. The records are only used for analysis,
+// so failures to store them are logged rather than failing the swap.
+func (s *swapKit) recordFee(decision loopdb.FeeDecision, confTarget int32,
+	feeRate chainfee.SatPerKWeight, fee btcutil.Amount, allowed bool) {
+
+	err := s.store.CreateFeeRecord(&loopdb.FeeRecord{
+		Time:       time.Now(),
+		Decision:   decision,
+		SwapHash:   s.hash,
+		ConfTarget: confTarget,
+		FeeRate:    feeRate,
+		Fee:        fee,
+		Allowed:    allowed,
+	})
+	if err != nil {
+		s.log.Warnf("Could not record %v fee: %v", decision, err)
+	}
+}
+
+// recordRealizedFee stores the fee rate that the confirmed transaction of a
+// fee sensitive decision paid on the swap's records for the decision.
+func (s *swapKit) recordRealizedFee(decision loopdb.FeeDecision,
+	tx *wire.MsgTx, fee btcutil.Amount) {
+
+	err := s.store.SetRealizedFeeRate(s.hash, decision, txFeeRate(tx, fee))
+	if err != nil {
+		s.log.Warnf("Could not record realized %v fee: %v", decision,
+			err)
+	}
+}
+
+// txFeeRate returns the fee rate of a transaction that pays the fee provided.
+func txFeeRate(tx *wire.MsgTx, fee btcutil.Amount) chainfee.SatPerKWeight {
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+
+	return chainfee.SatPerKWeight(int64(fee) * 1000 / weight)
+}
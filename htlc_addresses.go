@@ -0,0 +1,95 @@
+package loop
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
+)
+
+// HtlcAddress is the address of a swap's htlc for one of the output script
+// types that the htlc can be paid to.
+type HtlcAddress struct {
+	// OutputType is the output script type of the address.
+	OutputType swap.HtlcOutputType
+
+	// Address is the address of the htlc for the output type.
+	Address btcutil.Address
+
+	// Primary is set for the address that the swap's htlc is published
+	// to.
+	Primary bool
+}
+
+// HtlcAddresses holds the addresses of a swap's htlc for each output script
+// type that the swap supports. Exactly one of the addresses is primary.
+type HtlcAddresses []HtlcAddress
+
+// newHtlcAddresses creates the set of addresses for a swap's htlc, skipping
+// output types that the swap does not have an address for. The native segwit
+// address is primary unless nestedPrimary is set, which is the case for loop
+// in swaps with an external htlc.
+func newHtlcAddresses(p2wsh, np2wsh btcutil.Address,
+	nestedPrimary bool) HtlcAddresses {
+
+	var addresses HtlcAddresses
+
+	if p2wsh != nil {
+		addresses = append(addresses, HtlcAddress{
+			OutputType: swap.HtlcP2WSH,
+			Address:    p2wsh,
+			Primary:    !nestedPrimary || np2wsh == nil,
+		})
+	}
+
+	if np2wsh != nil {
+		addresses = append(addresses, HtlcAddress{
+			OutputType: swap.HtlcNP2WSH,
+			Address:    np2wsh,
+			Primary:    nestedPrimary || p2wsh == nil,
+		})
+	}
+
+	return addresses
+}
+
+// Primary returns the primary address of the set, or nil if the set is empty.
+func (h HtlcAddresses) Primary() btcutil.Address {
+	for _, address := range h {
+		if address.Primary {
+			return address.Address
+		}
+	}
+
+	return nil
+}
+
+// HtlcAddresses returns the addresses of the swap's htlc. The nested segwit
+// address is primary for loop in swaps with an external htlc.
+func (s *SwapInfo) HtlcAddresses() HtlcAddresses {
+	return newHtlcAddresses(
+		s.HtlcAddressP2WSH, s.HtlcAddressNP2WSH, s.ExternalHtlc,
+	)
+}
+
+// HtlcAddresses returns the addresses of the loop in's htlc. The nested segwit
+// address is primary if the htlc is published by an external wallet.
+func (s *LoopInSwapInfo) HtlcAddresses() HtlcAddresses {
+	return newHtlcAddresses(
+		s.HtlcAddressP2WSH, s.HtlcAddressNP2WSH, s.ExternalHtlc,
+	)
+}
+
+// HtlcAddresses returns the addresses of the loop out's htlc, which is always
+// published to its native segwit address by the server.
+func (s *LoopOutSwapInfo) HtlcAddresses() HtlcAddresses {
+	return newHtlcAddresses(s.HtlcAddressP2WSH, nil, false)
+}
+
+// HtlcAddresses returns the addresses of the reserved swap's htlc. The nested
+// segwit address is only set for loop in swaps with an external htlc, so it
+// is primary whenever it is present.
+func (r *SwapReservation) HtlcAddresses() HtlcAddresses {
+	return newHtlcAddresses(
+		r.HtlcAddressP2WSH, r.HtlcAddressNP2WSH,
+		r.HtlcAddressNP2WSH != nil,
+	)
+}
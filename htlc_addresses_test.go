@@ -0,0 +1,95 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHtlcAddresses tests selection of the primary htlc address of swaps.
+func TestHtlcAddresses(t *testing.T) {
+	p2wsh, err := btcutil.NewAddressWitnessScriptHash(
+		make([]byte, 32), &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	np2wsh, err := btcutil.NewAddressScriptHash(
+		[]byte{123}, &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		addresses HtlcAddresses
+		primary   btcutil.Address
+	}{
+		{
+			name: "loop out",
+			addresses: (&LoopOutSwapInfo{
+				HtlcAddressP2WSH: p2wsh,
+			}).HtlcAddresses(),
+			primary: p2wsh,
+		},
+		{
+			name: "internal loop in",
+			addresses: (&LoopInSwapInfo{
+				HtlcAddressP2WSH:  p2wsh,
+				HtlcAddressNP2WSH: np2wsh,
+			}).HtlcAddresses(),
+			primary: p2wsh,
+		},
+		{
+			name: "external loop in",
+			addresses: (&SwapInfo{
+				HtlcAddressP2WSH:  p2wsh,
+				HtlcAddressNP2WSH: np2wsh,
+				ExternalHtlc:      true,
+			}).HtlcAddresses(),
+			primary: np2wsh,
+		},
+		{
+			name: "external loop in reservation",
+			addresses: (&SwapReservation{
+				HtlcAddressP2WSH:  p2wsh,
+				HtlcAddressNP2WSH: np2wsh,
+			}).HtlcAddresses(),
+			primary: np2wsh,
+		},
+		{
+			name:      "no addresses",
+			addresses: (&SwapInfo{}).HtlcAddresses(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.primary, test.addresses.Primary())
+
+			// Each address that is set must be listed with its
+			// output type, and only one may be primary.
+			var primaries int
+			for _, address := range test.addresses {
+				switch address.OutputType {
+				case swap.HtlcP2WSH:
+					require.Equal(t, p2wsh, address.Address)
+
+				case swap.HtlcNP2WSH:
+					require.Equal(t, np2wsh, address.Address)
+				}
+
+				if address.Primary {
+					primaries++
+				}
+			}
+
+			if test.primary != nil {
+				require.Equal(t, 1, primaries)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package loop
+
+import (
+	"context"
+	"errors"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrNoHtlcFunder is returned when a loop in restricts the coins that
+	// its htlc is funded with, but the client cannot fund htlcs with
+	// specific coins.
+	ErrNoHtlcFunder = errors.New("htlc funding restrictions are not " +
+		"supported")
+
+	// ErrExternalHtlcFunding is returned when a loop in with an external
+	// htlc restricts the coins that its htlc is funded with.
+	ErrExternalHtlcFunding = errors.New("htlc funding restrictions " +
+		"cannot be set for external htlcs")
+)
+
+// HtlcFunder publishes loop in htlcs that are funded with specific coins from
+// lnd's wallet.
+type HtlcFunder interface {
+	// FundHtlc funds and publishes a transaction that pays the amount
+	// provided to our htlc address. Only coins that satisfy the funding
+	// restrictions provided are spent, and change is returned to the
+	// account that they set.
+	FundHtlc(ctx context.Context, addr btcutil.Address,
+		amount btcutil.Amount, feeRate chainfee.SatPerKWeight,
+		funding *loopdb.HtlcFunding, label string) (*wire.MsgTx, error)
+}
+
+// validateHtlcFunding checks that the htlc funding restrictions of a loop in
+// request, if any, can be satisfied.
+func (s *Client) validateHtlcFunding(request *LoopInRequest) error {
+	if request.HtlcFunding == nil {
+		return nil
+	}
+
+	if request.ExternalHtlc {
+		return ErrExternalHtlcFunding
+	}
+
+	if s.executor.htlcFunder == nil {
+		return ErrNoHtlcFunder
+	}
+
+	return nil
+}
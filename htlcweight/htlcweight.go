@@ -0,0 +1,141 @@
+// Package htlcweight estimates the weight of the transactions that spend
+// loop's on chain htlcs. Loopd calculates the fees of its sweeps with this
+// package, so that external tools which use it arrive at exactly the same
+// fees. Estimates assume the largest possible signatures, so the weight of a
+// signed transaction never exceeds its estimate.
+package htlcweight
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// Path is a path that an htlc is spent with.
+type Path uint8
+
+const (
+	// PathSuccess is the spend of an htlc by its receiver, which reveals
+	// the preimage of the swap. Loop out swaps sweep their htlc with this
+	// path.
+	PathSuccess Path = iota
+
+	// PathTimeout is the spend of an htlc by its sender once the htlc has
+	// expired. Loop in swaps sweep their htlc with this path if the swap
+	// fails.
+	PathTimeout
+)
+
+// String returns the string representation of a spend path.
+func (p Path) String() string {
+	switch p {
+	case PathSuccess:
+		return "success"
+
+	case PathTimeout:
+		return "timeout"
+
+	default:
+		return "unknown"
+	}
+}
+
+// WitnessSize returns the maximum size of the witness that spends the htlc
+// provided with the path provided.
+func WitnessSize(htlc *swap.Htlc, path Path) (int, error) {
+	switch path {
+	case PathSuccess:
+		return htlc.MaxSuccessWitnessSize(), nil
+
+	case PathTimeout:
+		return htlc.MaxTimeoutWitnessSize(), nil
+
+	default:
+		return 0, fmt.Errorf("unknown spend path %v", path)
+	}
+}
+
+// AddInput adds the spend of the htlc provided with the path provided to a
+// weight estimator.
+func AddInput(estimator *input.TxWeightEstimator, htlc *swap.Htlc,
+	path Path) error {
+
+	switch path {
+	case PathSuccess:
+		htlc.AddSuccessToEstimator(estimator)
+
+	case PathTimeout:
+		htlc.AddTimeoutToEstimator(estimator)
+
+	default:
+		return fmt.Errorf("unknown spend path %v", path)
+	}
+
+	return nil
+}
+
+// AddOutput adds an output that pays to the address provided to a weight
+// estimator.
+func AddOutput(estimator *input.TxWeightEstimator,
+	addr btcutil.Address) error {
+
+	switch addr.(type) {
+	case *btcutil.AddressWitnessScriptHash:
+		estimator.AddP2WSHOutput()
+
+	case *btcutil.AddressWitnessPubKeyHash:
+		estimator.AddP2WKHOutput()
+
+	case *btcutil.AddressScriptHash:
+		estimator.AddP2SHOutput()
+
+	case *btcutil.AddressPubKeyHash:
+		estimator.AddP2PKHOutput()
+
+	default:
+		return fmt.Errorf("unknown address type %T", addr)
+	}
+
+	return nil
+}
+
+// SweepWeight returns the weight of the transaction that spends the htlc
+// provided with the path provided to a single output that pays to the
+// destination address.
+func SweepWeight(htlc *swap.Htlc, path Path,
+	dest btcutil.Address) (int64, error) {
+
+	var estimator input.TxWeightEstimator
+	if err := AddOutput(&estimator, dest); err != nil {
+		return 0, err
+	}
+
+	if err := AddInput(&estimator, htlc, path); err != nil {
+		return 0, err
+	}
+
+	return int64(estimator.Weight()), nil
+}
+
+// SweepFee returns the fee that the transaction that spends the htlc provided
+// with the path provided to the destination address pays at the fee rate
+// provided.
+func SweepFee(htlc *swap.Htlc, path Path, dest btcutil.Address,
+	feeRate chainfee.SatPerKWeight) (btcutil.Amount, error) {
+
+	weight, err := SweepWeight(htlc, path, dest)
+	if err != nil {
+		return 0, err
+	}
+
+	return feeRate.FeeForWeight(weight), nil
+}
+
+// VSize returns the virtual size of a transaction with the weight provided,
+// which is its weight divided by four, rounded up.
+func VSize(weight int64) int64 {
+	return (weight + 3) / 4
+}
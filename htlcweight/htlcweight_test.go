@@ -0,0 +1,218 @@
+package htlcweight
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPreimage = lntypes.Preimage{1}
+
+	// testCltvExpiry is the expiry of our test htlcs, which is pushed as
+	// three bytes in their scripts.
+	testCltvExpiry int32 = 700000
+)
+
+// weightTestCase is the weight of the sweep of an htlc with a spend path.
+type weightTestCase struct {
+	name       string
+	version    swap.ScriptVersion
+	outputType swap.HtlcOutputType
+	path       Path
+	weight     int64
+	vsize      int64
+}
+
+// weightTestCases are the golden weights of the sweeps of every htlc script
+// version, output type and spend path, to a p2wkh address.
+var weightTestCases = []weightTestCase{
+	{
+		name:       "v1 p2wsh success",
+		version:    swap.HtlcV1,
+		outputType: swap.HtlcP2WSH,
+		path:       PathSuccess,
+		weight:     545,
+		vsize:      137,
+	},
+	{
+		name:       "v1 p2wsh timeout",
+		version:    swap.HtlcV1,
+		outputType: swap.HtlcP2WSH,
+		path:       PathTimeout,
+		weight:     514,
+		vsize:      129,
+	},
+	{
+		name:       "v1 np2wsh success",
+		version:    swap.HtlcV1,
+		outputType: swap.HtlcNP2WSH,
+		path:       PathSuccess,
+		weight:     685,
+		vsize:      172,
+	},
+	{
+		name:       "v1 np2wsh timeout",
+		version:    swap.HtlcV1,
+		outputType: swap.HtlcNP2WSH,
+		path:       PathTimeout,
+		weight:     654,
+		vsize:      164,
+	},
+	{
+		name:       "v2 p2wsh success",
+		version:    swap.HtlcV2,
+		outputType: swap.HtlcP2WSH,
+		path:       PathSuccess,
+		weight:     536,
+		vsize:      134,
+	},
+	{
+		name:       "v2 p2wsh timeout",
+		version:    swap.HtlcV2,
+		outputType: swap.HtlcP2WSH,
+		path:       PathTimeout,
+		weight:     538,
+		vsize:      135,
+	},
+	{
+		name:       "v2 np2wsh success",
+		version:    swap.HtlcV2,
+		outputType: swap.HtlcNP2WSH,
+		path:       PathSuccess,
+		weight:     676,
+		vsize:      169,
+	},
+	{
+		name:       "v2 np2wsh timeout",
+		version:    swap.HtlcV2,
+		outputType: swap.HtlcNP2WSH,
+		path:       PathTimeout,
+		weight:     678,
+		vsize:      170,
+	},
+}
+
+// newTestHtlc creates an htlc with the script version and output type
+// provided.
+func newTestHtlc(t *testing.T, version swap.ScriptVersion,
+	outputType swap.HtlcOutputType) *swap.Htlc {
+
+	var senderKey, receiverKey [33]byte
+	senderKey[0] = 2
+	receiverKey[0] = 3
+
+	htlc, err := swap.NewHtlc(
+		version, testCltvExpiry, senderKey, receiverKey,
+		testPreimage.Hash(), outputType, &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	return htlc
+}
+
+// newTestDest returns a p2wkh destination address.
+func newTestDest(t *testing.T) btcutil.Address {
+	dest, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	return dest
+}
+
+// TestSweepWeight tests the weights of the sweeps of every htlc script
+// version, output type and spend path against golden values.
+func TestSweepWeight(t *testing.T) {
+	dest := newTestDest(t)
+
+	for _, testCase := range weightTestCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			htlc := newTestHtlc(
+				t, testCase.version, testCase.outputType,
+			)
+
+			weight, err := SweepWeight(htlc, testCase.path, dest)
+			require.NoError(t, err)
+			require.Equal(t, testCase.weight, weight)
+			require.Equal(t, testCase.vsize, VSize(weight))
+
+			feeRate := chainfee.SatPerKWeight(1000)
+			fee, err := SweepFee(htlc, testCase.path, dest, feeRate)
+			require.NoError(t, err)
+			require.Equal(t, btcutil.Amount(testCase.weight), fee)
+		})
+	}
+}
+
+// TestSweepWeightSigned tests that the weight estimated for each sweep is
+// the weight of the signed sweep, if it has a signature of the maximum size.
+func TestSweepWeightSigned(t *testing.T) {
+	dest := newTestDest(t)
+
+	pkScript, err := txscript.PayToAddrScript(dest)
+	require.NoError(t, err)
+
+	// The largest DER signature is 72 bytes long, our witnesses add the
+	// sighash flag.
+	sig := make([]byte, 72)
+
+	for _, testCase := range weightTestCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			htlc := newTestHtlc(
+				t, testCase.version, testCase.outputType,
+			)
+
+			var witness wire.TxWitness
+			switch testCase.path {
+			case PathSuccess:
+				witness, err = htlc.GenSuccessWitness(
+					sig, testPreimage,
+				)
+				require.NoError(t, err)
+
+			case PathTimeout:
+				witness = htlc.GenTimeoutWitness(sig)
+			}
+
+			tx := wire.NewMsgTx(2)
+			tx.AddTxIn(&wire.TxIn{
+				SignatureScript: htlc.SigScript,
+				Witness:         witness,
+			})
+			tx.AddTxOut(&wire.TxOut{
+				PkScript: pkScript,
+				Value:    10000,
+			})
+
+			weight, err := SweepWeight(htlc, testCase.path, dest)
+			require.NoError(t, err)
+			require.Equal(
+				t, blockchain.GetTransactionWeight(
+					btcutil.NewTx(tx),
+				), weight,
+			)
+		})
+	}
+}
+
+// TestUnknownPath tests that estimating the weight of an unknown spend path
+// fails.
+func TestUnknownPath(t *testing.T) {
+	htlc := newTestHtlc(t, swap.HtlcV2, swap.HtlcP2WSH)
+
+	_, err := SweepWeight(htlc, Path(2), newTestDest(t))
+	require.Error(t, err)
+}
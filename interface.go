@@ -87,6 +87,20 @@ type OutRequest struct {
 	// initiated the swap (loop CLI, autolooper, LiT UI and so on) and is
 	// appended to the user agent string.
 	Initiator string
+
+	// RequestID is an optional ID that identifies the client request that
+	// the swap was created for. It is stored with the swap so that
+	// retries of the request can be matched to it.
+	RequestID string
+
+	// Tenant is the identity of the caller that requested the swap when
+	// loopd runs in multi-tenant mode. It is stored with the swap so that
+	// the swap is only visible to that caller.
+	Tenant string
+
+	// OverrideVolumeLimits indicates that the swap may be initiated even
+	// if it exceeds the client's volume limits.
+	OverrideVolumeLimits bool
 }
 
 // Out contains the full details of a loop out request. This includes things
@@ -207,6 +221,24 @@ type LoopInRequest struct {
 	// initiated the swap (loop CLI, autolooper, LiT UI and so on) and is
 	// appended to the user agent string.
 	Initiator string
+
+	// RequestID is an optional ID that identifies the client request that
+	// the swap was created for. It is stored with the swap so that
+	// retries of the request can be matched to it.
+	RequestID string
+
+	// Tenant is the identity of the caller that requested the swap when
+	// loopd runs in multi-tenant mode. It is stored with the swap so that
+	// the swap is only visible to that caller.
+	Tenant string
+
+	// OverrideVolumeLimits indicates that the swap may be initiated even
+	// if it exceeds the client's volume limits.
+	OverrideVolumeLimits bool
+
+	// HtlcFunding optionally restricts the coins in lnd's wallet that our
+	// htlc is funded with. It may not be set for external htlcs.
+	HtlcFunding *loopdb.HtlcFunding
 }
 
 // LoopInTerms are the server terms on which it executes loop in swaps.
@@ -284,9 +316,18 @@ type LoopInSwapInfo struct { // nolint
 	// where the loop-in funds may be paid.
 	HtlcAddressNP2WSH btcutil.Address
 
+	// ExternalHtlc specifies whether the htlc is published by an external
+	// source, in which case the nested segwit address is its primary
+	// address.
+	ExternalHtlc bool
+
 	// ServerMessages is the human-readable message received from the loop
 	// server.
 	ServerMessage string
+
+	// StructuredServerMessage is an optional structured message received
+	// from the loop server.
+	StructuredServerMessage *loopdb.ServerMessage
 }
 
 // LoopOutSwapInfo contains essential information of a loop-out swap after the
@@ -302,6 +343,10 @@ type LoopOutSwapInfo struct { // nolint:golint
 	// ServerMessages is the human-readable message received from the loop
 	// server.
 	ServerMessage string
+
+	// StructuredServerMessage is an optional structured message received
+	// from the loop server.
+	StructuredServerMessage *loopdb.ServerMessage
 }
 
 // SwapInfoKit contains common swap info fields.
@@ -339,6 +384,25 @@ type SwapInfo struct {
 
 	// ExternalHtlc is set to true for external loop-in swaps.
 	ExternalHtlc bool
+
+	// OutgoingChanSet is the set of channels that a loop out swap is
+	// restricted to. It is empty for loop in swaps, and for loop outs
+	// that may use any channel.
+	OutgoingChanSet loopdb.ChannelSet
+
+	// HtlcConfirmations is the number of confirmations of the server's
+	// htlc that a loop out swap requires before revealing its preimage.
+	// It is zero for loop in swaps.
+	HtlcConfirmations uint32
+
+	// LastHop is the last hop that a loop in swap is restricted to. It is
+	// nil for loop out swaps, and for loop ins that may use any peer.
+	LastHop *route.Vertex
+
+	// Events holds the state changes of a swap, ordered from oldest to
+	// newest. It is only set for swaps that are read from the database,
+	// and not for the updates of swaps that are in flight.
+	Events []*loopdb.LoopEvent
 }
 
 // LastUpdate returns the last update time of the swap
@@ -0,0 +1,160 @@
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lightninglabs/loop/swap"
+)
+
+const (
+	// PlaceholderType is replaced with the type of swap being dispatched.
+	PlaceholderType = "{type}"
+
+	// PlaceholderPeer is replaced with the hex encoded pubkey of the peer
+	// that the swap is performed with.
+	PlaceholderPeer = "{peer}"
+
+	// PlaceholderPeerAlias is replaced with the alias of the peer that the
+	// swap is performed with.
+	PlaceholderPeerAlias = "{peer_alias}"
+
+	// PlaceholderChannels is replaced with a comma separated list of the
+	// short channel IDs that the swap is restricted to.
+	PlaceholderChannels = "{channels}"
+
+	// PlaceholderDate is replaced with the date that the swap is
+	// dispatched on, in YYYY-MM-DD format.
+	PlaceholderDate = "{date}"
+
+	// templateDateFormat is the format we use to render dates in label
+	// templates.
+	templateDateFormat = "2006-01-02"
+)
+
+var (
+	// ErrUnknownPlaceholder is returned when a label template contains a
+	// placeholder that we do not know how to render.
+	ErrUnknownPlaceholder = errors.New("label template contains unknown " +
+		"placeholder")
+
+	// placeholderRegex matches any curly brace delimited placeholder in a
+	// label template.
+	placeholderRegex = regexp.MustCompile(`\{[^{}]*\}`)
+
+	// knownPlaceholders is the set of placeholders that we support.
+	knownPlaceholders = map[string]struct{}{
+		PlaceholderType:      {},
+		PlaceholderPeer:      {},
+		PlaceholderPeerAlias: {},
+		PlaceholderChannels:  {},
+		PlaceholderDate:      {},
+	}
+)
+
+// TemplateValues contains the values that are available for substitution in
+// an autoloop label template.
+type TemplateValues struct {
+	// SwapType is the type of swap that is being dispatched.
+	SwapType swap.Type
+
+	// Peer is the hex encoded pubkey of the peer we are swapping with.
+	Peer string
+
+	// PeerAlias is the alias of the peer we are swapping with. If it is
+	// empty, we fall back to the peer's pubkey.
+	PeerAlias string
+
+	// Channels is the set of channels that the swap is restricted to.
+	Channels []string
+
+	// Time is the time at which the swap is dispatched.
+	Time time.Time
+}
+
+// ValidateTemplate checks that a label template only contains placeholders
+// that we support and is not so long that it can never be rendered within
+// our maximum label length.
+func ValidateTemplate(template string) error {
+	prefix := AutoloopLabel(swap.TypeOut)
+	if len(prefix)+len(template)+2 > MaxLength {
+		return ErrLabelTooLong
+	}
+
+	placeholders := placeholderRegex.FindAllString(template, -1)
+	for _, placeholder := range placeholders {
+		if _, ok := knownPlaceholders[placeholder]; !ok {
+			return fmt.Errorf("%w: %v", ErrUnknownPlaceholder,
+				placeholder)
+		}
+	}
+
+	return nil
+}
+
+// RenderAutoloopLabel renders a label template for an automatically
+// dispatched swap. The rendered template is appended to our default autoloop
+// label so that all automatically dispatched swaps share the reserved prefix
+// and can be identified by IsAutoloopLabel. If the template is empty, the
+// default autoloop label is returned. Labels that exceed our maximum length
+// after rendering are truncated.
+func RenderAutoloopLabel(template string, values TemplateValues) string {
+	label := AutoloopLabel(values.SwapType)
+	if template == "" {
+		return label
+	}
+
+	alias := values.PeerAlias
+	if alias == "" {
+		alias = values.Peer
+	}
+
+	replacer := strings.NewReplacer(
+		PlaceholderType, strings.ToLower(values.SwapType.String()),
+		PlaceholderPeer, values.Peer,
+		PlaceholderPeerAlias, alias,
+		PlaceholderChannels, strings.Join(values.Channels, ","),
+		PlaceholderDate, values.Time.Format(templateDateFormat),
+	)
+
+	label = fmt.Sprintf("%v: %v", label, replacer.Replace(template))
+
+	return truncate(label, MaxLength)
+}
+
+// truncate shortens a label to at most the number of bytes provided. We only
+// cut the label between runes, so that multi-byte characters (which peer
+// aliases may contain) are never split into invalid UTF-8.
+func truncate(label string, maxBytes int) string {
+	if len(label) <= maxBytes {
+		return label
+	}
+
+	var length int
+	for length < len(label) {
+		_, size := utf8.DecodeRuneInString(label[length:])
+		if length+size > maxBytes {
+			break
+		}
+
+		length += size
+	}
+
+	return label[:length]
+}
+
+// IsAutoloopLabel returns a boolean indicating whether a label was produced
+// for an automatically dispatched swap of the type provided, either with or
+// without a rendered template.
+func IsAutoloopLabel(label string, swapType swap.Type) bool {
+	autoLabel := AutoloopLabel(swapType)
+	if label == autoLabel {
+		return true
+	}
+
+	return strings.HasPrefix(label, autoLabel+": ")
+}
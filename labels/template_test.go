@@ -0,0 +1,134 @@
+package labels
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lightninglabs/loop/swap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateTemplate tests validation of label templates.
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		err      error
+	}{
+		{
+			name:     "empty template",
+			template: "",
+			err:      nil,
+		},
+		{
+			name:     "all placeholders",
+			template: "{type}:{peer}:{peer_alias}:{channels}:{date}",
+			err:      nil,
+		},
+		{
+			name:     "unknown placeholder",
+			template: "{peer}:{unknown}",
+			err:      ErrUnknownPlaceholder,
+		},
+		{
+			name:     "too long",
+			template: strings.Repeat("a", MaxLength),
+			err:      ErrLabelTooLong,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateTemplate(test.template)
+			require.True(t, errors.Is(err, test.err))
+		})
+	}
+}
+
+// TestRenderAutoloopLabel tests rendering of autoloop label templates.
+func TestRenderAutoloopLabel(t *testing.T) {
+	values := TemplateValues{
+		SwapType: swap.TypeOut,
+		Peer:     "02aa",
+		Channels: []string{"1", "2"},
+		Time:     time.Date(2020, 2, 13, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		values   TemplateValues
+		expected string
+	}{
+		{
+			name:     "no template",
+			template: "",
+			values:   values,
+			expected: AutoloopLabel(swap.TypeOut),
+		},
+		{
+			name:     "alias falls back to pubkey",
+			template: "{type}:{peer_alias}:{date}",
+			values:   values,
+			expected: "[reserved]: autoloop-out: out:02aa:2020-02-13",
+		},
+		{
+			name:     "alias and channels",
+			template: "{peer_alias} {channels}",
+			values: TemplateValues{
+				SwapType:  swap.TypeIn,
+				PeerAlias: "alias",
+				Channels:  values.Channels,
+			},
+			expected: "[reserved]: autoloop-in: alias 1,2",
+		},
+		{
+			name:     "truncated on rune boundary",
+			template: "{peer_alias}",
+			values: TemplateValues{
+				SwapType: swap.TypeOut,
+				PeerAlias: strings.Repeat("a", 470) +
+					"⚡⚡",
+			},
+			expected: "[reserved]: autoloop-out: " +
+				strings.Repeat("a", 470) + "⚡",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			label := RenderAutoloopLabel(test.template, test.values)
+			require.Equal(t, test.expected, label)
+			require.True(t, utf8.ValidString(label))
+			require.LessOrEqual(t, len(label), MaxLength)
+			require.True(t, IsAutoloopLabel(
+				label, test.values.SwapType,
+			))
+		})
+	}
+}
+
+// TestIsAutoloopLabel tests identification of autoloop labels.
+func TestIsAutoloopLabel(t *testing.T) {
+	require.True(t, IsAutoloopLabel(
+		AutoloopLabel(swap.TypeOut), swap.TypeOut,
+	))
+	require.False(t, IsAutoloopLabel(
+		AutoloopLabel(swap.TypeOut), swap.TypeIn,
+	))
+	require.False(t, IsAutoloopLabel(
+		AutoloopLabel(swap.TypeOut)+"suffix", swap.TypeOut,
+	))
+	require.False(t, IsAutoloopLabel("label", swap.TypeOut))
+}
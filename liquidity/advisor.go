@@ -0,0 +1,311 @@
+package liquidity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// adviceWindow is the period of history that we base our parameter
+	// advice on.
+	adviceWindow = time.Hour * 24 * 30
+
+	// minAdviceSamples is the minimum number of fee checks that we need
+	// before we advise a change to our sweep fee limit.
+	minAdviceSamples = 10
+
+	// minAdviceSwaps is the minimum number of swaps that must have been
+	// affected by a parameter before we advise a change to it.
+	minAdviceSwaps = 3
+
+	// cheapFeePercentile is the percentile of our suggestion fee
+	// estimates that we consider to be a cheap time to sweep.
+	cheapFeePercentile = 25
+)
+
+// Advice is a recommended change to one of our parameters. Advice is never
+// applied automatically, it is up to the user to decide whether to update
+// their parameters.
+type Advice struct {
+	// Parameter is the name of the parameter that the advice is for, which
+	// is the name of the flag that sets it with loop setparams.
+	Parameter string
+
+	// Current is the current value of the parameter.
+	Current string
+
+	// Recommended is the value that we recommend for the parameter.
+	Recommended string
+
+	// Reason explains the history that the recommendation is based on.
+	Reason string
+}
+
+// String returns the string representation of a piece of advice.
+func (a Advice) String() string {
+	return fmt.Sprintf("%v: %v -> %v (%v)", a.Parameter, a.Current,
+		a.Recommended, a.Reason)
+}
+
+// AdviseParameters analyzes the outcomes of our recent swaps and the fee
+// estimates that we have recorded, and returns recommended changes to our
+// current parameters.
+func (m *Manager) AdviseParameters(ctx context.Context) ([]Advice, error) {
+	now := m.cfg.Clock.Now()
+	since := now.Add(-adviceWindow)
+
+	var records []*loopdb.FeeRecord
+	if m.cfg.ListFeeRecords != nil {
+		var err error
+		records, err = m.cfg.ListFeeRecords(since, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	loopOuts, err := m.cfg.ListLoopOut()
+	if err != nil {
+		return nil, err
+	}
+
+	return adviseParameters(
+		m.GetParameters(), m.cfg.MinimumConfirmations, records,
+		loopOuts, since,
+	), nil
+}
+
+// adviseParameters returns recommended changes to the parameters provided
+// based on the fee records and the loop out swaps last updated after the time
+// provided.
+func adviseParameters(params Parameters, minConfs int32,
+	records []*loopdb.FeeRecord, loopOuts []*loopdb.LoopOut,
+	since time.Time) []Advice {
+
+	var advice []Advice
+
+	// Our fee limits are only set per category if we have a fee category
+	// limit, a single fee portion can't be tuned per category.
+	if limit, ok := params.FeeLimit.(*FeeCategoryLimit); ok {
+		if a := adviseSweepLimit(limit, records); a != nil {
+			advice = append(advice, *a)
+		}
+
+		if a := adviseMinerFee(limit, records, loopOuts); a != nil {
+			advice = append(advice, *a)
+		}
+
+		if a := adviseRoutingFee(limit, loopOuts, since); a != nil {
+			advice = append(advice, *a)
+		}
+	}
+
+	a := adviseSweepConf(params.SweepConfTarget, minConfs, loopOuts, since)
+	if a != nil {
+		advice = append(advice, *a)
+	}
+
+	return advice
+}
+
+// adviseSweepLimit recommends raising our sweep fee limit if it blocked swap
+// suggestions even when fees were at their cheapest. We consider the cheapest
+// quarter of the estimates that we checked to be a cheap time to swap, so if
+// our limit is below all of them we would never swap at all.
+func adviseSweepLimit(limit *FeeCategoryLimit,
+	records []*loopdb.FeeRecord) *Advice {
+
+	var estimates []chainfee.SatPerKWeight
+	for _, record := range records {
+		if record.Decision != loopdb.FeeDecisionSuggestion {
+			continue
+		}
+
+		estimates = append(estimates, record.FeeRate)
+	}
+
+	if len(estimates) < minAdviceSamples {
+		return nil
+	}
+
+	sort.Slice(estimates, func(i, j int) bool {
+		return estimates[i] < estimates[j]
+	})
+
+	cheap := estimates[len(estimates)*cheapFeePercentile/100]
+	if cheap <= limit.SweepFeeRateLimit {
+		return nil
+	}
+
+	var missed int
+	for _, estimate := range estimates {
+		if estimate > limit.SweepFeeRateLimit && estimate <= cheap {
+			missed++
+		}
+	}
+
+	// Round the rate up to the next sat/vByte, which is the unit that
+	// our limit is set in.
+	recommended := (int64(cheap)*blockchain.WitnessScaleFactor + 999) /
+		1000
+
+	return &Advice{
+		Parameter: "sweeplimit",
+		Current: fmt.Sprintf("%v sat/vByte",
+			satPerKwToSatPerVByte(limit.SweepFeeRateLimit)),
+		Recommended: fmt.Sprintf("%v sat/vByte", recommended),
+		Reason: fmt.Sprintf("sweep limit blocked swaps during %v of "+
+			"the cheapest %v%% of %v fee checks", missed,
+			cheapFeePercentile, len(estimates)),
+	}
+}
+
+// adviseMinerFee recommends raising our maximum miner fee if it prevented our
+// loop outs from sweeping their htlcs.
+func adviseMinerFee(limit *FeeCategoryLimit, records []*loopdb.FeeRecord,
+	loopOuts []*loopdb.LoopOut) *Advice {
+
+	isLoopOut := make(map[lntypes.Hash]bool, len(loopOuts))
+	for _, loopOut := range loopOuts {
+		isLoopOut[loopOut.Hash] = true
+	}
+
+	var (
+		blocked = make(map[lntypes.Hash]bool)
+		maxFee  btcutil.Amount
+	)
+
+	for _, record := range records {
+		if record.Decision != loopdb.FeeDecisionSweep ||
+			record.Allowed || !isLoopOut[record.SwapHash] {
+
+			continue
+		}
+
+		if record.Fee <= limit.MaximumMinerFee {
+			continue
+		}
+
+		blocked[record.SwapHash] = true
+		if record.Fee > maxFee {
+			maxFee = record.Fee
+		}
+	}
+
+	if len(blocked) < minAdviceSwaps {
+		return nil
+	}
+
+	return &Advice{
+		Parameter:   "maxminer",
+		Current:     limit.MaximumMinerFee.String(),
+		Recommended: maxFee.String(),
+		Reason: fmt.Sprintf("%v loop outs delayed their sweep because "+
+			"the required fee exceeded the maximum miner fee",
+			len(blocked)),
+	}
+}
+
+// adviseRoutingFee recommends raising our routing fee limit if at least half
+// of our loop outs that completed recently failed to pay their invoices.
+func adviseRoutingFee(limit *FeeCategoryLimit, loopOuts []*loopdb.LoopOut,
+	since time.Time) *Advice {
+
+	var failed, completed int
+	for _, loopOut := range recentFinalSwaps(loopOuts, since) {
+		completed++
+
+		if loopOut.State().State == loopdb.StateFailOffchainPayments {
+			failed++
+		}
+	}
+
+	if failed < minAdviceSwaps || failed*2 < completed {
+		return nil
+	}
+
+	recommended := limit.MaximumRoutingFeePPM * 2
+	if recommended > FeeBase {
+		recommended = FeeBase
+	}
+
+	if recommended == limit.MaximumRoutingFeePPM {
+		return nil
+	}
+
+	return &Advice{
+		Parameter: "maxroutingfee",
+		Current: fmt.Sprintf("%v%%", swap.FeeRateAsPercentage(
+			int64(limit.MaximumRoutingFeePPM),
+		)),
+		Recommended: fmt.Sprintf("%v%%", swap.FeeRateAsPercentage(
+			int64(recommended),
+		)),
+		Reason: fmt.Sprintf("%v of %v completed loop outs failed to "+
+			"pay their invoices", failed, completed),
+	}
+}
+
+// adviseSweepConf recommends lowering our sweep confirmation target if our
+// loop outs failed because their sweeps did not confirm in time.
+func adviseSweepConf(confTarget, minConfs int32, loopOuts []*loopdb.LoopOut,
+	since time.Time) *Advice {
+
+	var timedOut int
+	for _, loopOut := range recentFinalSwaps(loopOuts, since) {
+		if loopOut.State().State == loopdb.StateFailSweepTimeout {
+			timedOut++
+		}
+	}
+
+	if timedOut < minAdviceSwaps {
+		return nil
+	}
+
+	recommended := confTarget / 2
+	if recommended < minConfs {
+		recommended = minConfs
+	}
+
+	if recommended >= confTarget {
+		return nil
+	}
+
+	return &Advice{
+		Parameter:   "sweepconf",
+		Current:     fmt.Sprintf("%v blocks", confTarget),
+		Recommended: fmt.Sprintf("%v blocks", recommended),
+		Reason: fmt.Sprintf("%v loop outs timed out before their "+
+			"sweep confirmed", timedOut),
+	}
+}
+
+// recentFinalSwaps returns the loop outs that reached a final state after the
+// time provided.
+func recentFinalSwaps(loopOuts []*loopdb.LoopOut,
+	since time.Time) []*loopdb.LoopOut {
+
+	var recent []*loopdb.LoopOut
+	for _, loopOut := range loopOuts {
+		lastUpdate := loopOut.LastUpdate()
+		if lastUpdate == nil || lastUpdate.Time.Before(since) {
+			continue
+		}
+
+		if lastUpdate.State.Type() == loopdb.StateTypePending {
+			continue
+		}
+
+		recent = append(recent, loopOut)
+	}
+
+	return recent
+}
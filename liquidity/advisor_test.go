@@ -0,0 +1,238 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdviseParameters tests the parameter advice that we give for our swap
+// and fee history.
+func TestAdviseParameters(t *testing.T) {
+	const testMinConfs = 2
+
+	var (
+		since  = time.Unix(100000, 0)
+		before = since.Add(time.Hour * -1)
+		after  = since.Add(time.Hour)
+	)
+
+	newLoopOut := func(hash byte, state loopdb.SwapState,
+		updated time.Time) *loopdb.LoopOut {
+
+		event := &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+			},
+			Time: updated,
+		}
+
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Hash:   lntypes.Hash{hash},
+				Events: []*loopdb.LoopEvent{event},
+			},
+		}
+	}
+
+	// suggestions returns suggestion fee records with the estimates
+	// provided.
+	suggestions := func(
+		estimates ...chainfee.SatPerKWeight) []*loopdb.FeeRecord {
+
+		records := make([]*loopdb.FeeRecord, len(estimates))
+		for i, estimate := range estimates {
+			records[i] = &loopdb.FeeRecord{
+				Decision: loopdb.FeeDecisionSuggestion,
+				FeeRate:  estimate,
+			}
+		}
+
+		return records
+	}
+
+	blockedSweep := func(hash byte, fee btcutil.Amount) *loopdb.FeeRecord {
+		return &loopdb.FeeRecord{
+			Decision: loopdb.FeeDecisionSweep,
+			SwapHash: lntypes.Hash{hash},
+			Fee:      fee,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		records  []*loopdb.FeeRecord
+		loopOuts []*loopdb.LoopOut
+		advice   []Advice
+	}{
+		{
+			name: "no history",
+		},
+		{
+			name: "too few fee checks",
+			records: suggestions(
+				2000, 2000, 2000, 2000, 2000, 2000, 2000, 2000,
+				2000,
+			),
+		},
+		{
+			name: "sweep limit allows cheap fees",
+			records: suggestions(
+				250, 500, 750, 2000, 2000, 2000, 2000, 2000,
+				2000, 2000,
+			),
+		},
+		{
+			name: "sweep limit blocks cheap fees",
+			records: suggestions(
+				800, 1000, 1250, 2000, 2000, 2000, 2000, 2000,
+				2000, 2000,
+			),
+			advice: []Advice{
+				{
+					Parameter:   "sweeplimit",
+					Current:     "3 sat/vByte",
+					Recommended: "5 sat/vByte",
+					Reason: "sweep limit blocked swaps " +
+						"during 3 of the cheapest 25% " +
+						"of 10 fee checks",
+				},
+			},
+		},
+		{
+			name: "miner fee blocked too few swaps",
+			records: []*loopdb.FeeRecord{
+				blockedSweep(1, 2000000),
+				blockedSweep(1, 2500000),
+				blockedSweep(2, 2000000),
+			},
+			loopOuts: []*loopdb.LoopOut{
+				newLoopOut(1, loopdb.StateSuccess, after),
+				newLoopOut(2, loopdb.StateSuccess, after),
+			},
+		},
+		{
+			name: "miner fee blocked sweeps",
+			records: []*loopdb.FeeRecord{
+				blockedSweep(1, 2000000),
+				blockedSweep(2, 2500000),
+				blockedSweep(3, 1600000),
+
+				// A blocked loop in sweep is not counted.
+				blockedSweep(4, 3000000),
+			},
+			loopOuts: []*loopdb.LoopOut{
+				newLoopOut(1, loopdb.StateSuccess, after),
+				newLoopOut(2, loopdb.StateSuccess, after),
+				newLoopOut(3, loopdb.StateSuccess, after),
+			},
+			advice: []Advice{
+				{
+					Parameter:   "maxminer",
+					Current:     "0.015 BTC",
+					Recommended: "0.025 BTC",
+					Reason: "3 loop outs delayed their " +
+						"sweep because the required " +
+						"fee exceeded the maximum " +
+						"miner fee",
+				},
+			},
+		},
+		{
+			name: "routing failures",
+			loopOuts: []*loopdb.LoopOut{
+				newLoopOut(
+					1, loopdb.StateFailOffchainPayments,
+					after,
+				),
+				newLoopOut(
+					2, loopdb.StateFailOffchainPayments,
+					after,
+				),
+				newLoopOut(
+					3, loopdb.StateFailOffchainPayments,
+					after,
+				),
+				newLoopOut(4, loopdb.StateSuccess, after),
+
+				// Pending swaps and swaps that completed
+				// before our window are not counted.
+				newLoopOut(5, loopdb.StateInitiated, after),
+				newLoopOut(6, loopdb.StateSuccess, before),
+			},
+			advice: []Advice{
+				{
+					Parameter:   "maxroutingfee",
+					Current:     "1%",
+					Recommended: "2%",
+					Reason: "3 of 4 completed loop outs " +
+						"failed to pay their invoices",
+				},
+			},
+		},
+		{
+			name: "routing failures in minority",
+			loopOuts: []*loopdb.LoopOut{
+				newLoopOut(
+					1, loopdb.StateFailOffchainPayments,
+					after,
+				),
+				newLoopOut(
+					2, loopdb.StateFailOffchainPayments,
+					after,
+				),
+				newLoopOut(
+					3, loopdb.StateFailOffchainPayments,
+					after,
+				),
+				newLoopOut(4, loopdb.StateSuccess, after),
+				newLoopOut(5, loopdb.StateSuccess, after),
+				newLoopOut(6, loopdb.StateSuccess, after),
+				newLoopOut(7, loopdb.StateSuccess, after),
+			},
+		},
+		{
+			name: "sweep timeouts",
+			loopOuts: []*loopdb.LoopOut{
+				newLoopOut(
+					1, loopdb.StateFailSweepTimeout,
+					after,
+				),
+				newLoopOut(
+					2, loopdb.StateFailSweepTimeout,
+					after,
+				),
+				newLoopOut(
+					3, loopdb.StateFailSweepTimeout,
+					after,
+				),
+			},
+			advice: []Advice{
+				{
+					Parameter:   "sweepconf",
+					Current:     "100 blocks",
+					Recommended: "50 blocks",
+					Reason: "3 loop outs timed out before " +
+						"their sweep confirmed",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			advice := adviseParameters(
+				defaultParameters, testMinConfs,
+				testCase.records, testCase.loopOuts, since,
+			)
+			require.Equal(t, testCase.advice, advice)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrAutoloopEnabled is returned when suggestions are applied while
+	// autoloop is enabled, because autoloop dispatches our suggestions
+	// itself.
+	ErrAutoloopEnabled = errors.New("autoloop is enabled, suggestions " +
+		"are dispatched automatically")
+
+	// ErrNoSelection is returned when suggestions are applied without
+	// selecting any of them.
+	ErrNoSelection = errors.New("no suggestions selected")
+)
+
+// SuggestionSelection selects a subset of our current loop out suggestions.
+// A suggestion is selected if its index or any of its channels are listed.
+type SuggestionSelection struct {
+	// Indexes are the positions of the selected loop outs in our current
+	// suggestions, in the order that SuggestSwaps returns them.
+	Indexes []int
+
+	// Channels selects every loop out that uses any of these channels.
+	Channels map[lnwire.ShortChannelID]struct{}
+}
+
+// selected returns the loop outs that are selected, in the order that they
+// are suggested, failing if an index is out of range.
+func (s *SuggestionSelection) selected(
+	swaps []loop.OutRequest) ([]loop.OutRequest, error) {
+
+	indexes := make(map[int]bool, len(s.Indexes))
+	for _, index := range s.Indexes {
+		if index < 0 || index >= len(swaps) {
+			return nil, fmt.Errorf("suggestion index %v out of "+
+				"range, %v loop outs suggested", index,
+				len(swaps))
+		}
+
+		indexes[index] = true
+	}
+
+	var selected []loop.OutRequest
+	for i, swap := range swaps {
+		if indexes[i] || s.selectsChannel(swap) {
+			selected = append(selected, swap)
+		}
+	}
+
+	return selected, nil
+}
+
+// selectsChannel returns true if any of the channels of a loop out are
+// selected.
+func (s *SuggestionSelection) selectsChannel(swap loop.OutRequest) bool {
+	for _, channel := range swap.OutgoingChanSet {
+		chanID := lnwire.NewShortChanIDFromInt(channel)
+		if _, ok := s.Channels[chanID]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AppliedSuggestion is the outcome of dispatching a selected suggestion.
+type AppliedSuggestion struct {
+	// Request is the loop out that was dispatched.
+	Request loop.OutRequest
+
+	// Swap is the swap that was created, it is nil if dispatch failed.
+	Swap *loop.LoopOutSwapInfo
+
+	// Err is the error that dispatch failed with, if any.
+	Err error
+}
+
+// ApplySuggestions dispatches the selected subset of our current loop out
+// suggestions, with the same parameters that autoloop would dispatch them
+// with. Our suggestions are recomputed, so a selection by index should be
+// made from suggestions that were just reviewed. A failure to dispatch one
+// swap does not prevent the others from being dispatched, the outcome of each
+// is returned.
+func (m *Manager) ApplySuggestions(ctx context.Context,
+	selection *SuggestionSelection) ([]*AppliedSuggestion, error) {
+
+	if len(selection.Indexes) == 0 && len(selection.Channels) == 0 {
+		return nil, ErrNoSelection
+	}
+
+	if m.GetParameters().Autoloop {
+		return nil, ErrAutoloopEnabled
+	}
+
+	eval := &evaluation{}
+	suggestions, err := m.suggestSwaps(ctx, true, eval)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := selection.selected(suggestions.OutSwaps)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.snapshotMissionControl(ctx, len(selected)); err != nil {
+		return nil, err
+	}
+
+	applied := make([]*AppliedSuggestion, len(selected))
+	for i, swap := range selected {
+		loopOut, err := m.dispatchLoopOut(
+			ctx, loopdb.DispatchSourceApplied, swap, eval,
+		)
+		if err != nil {
+			log.Warnf("could not dispatch selected loop out: %v "+
+				"sats over %v: %v", swap.Amount,
+				swap.OutgoingChanSet, err)
+		}
+
+		applied[i] = &AppliedSuggestion{
+			Request: swap,
+			Swap:    loopOut,
+			Err:     err,
+		}
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,183 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApplySuggestions tests dispatch of a selected subset of our
+// suggestions.
+func TestApplySuggestions(t *testing.T) {
+	errDispatch := errors.New("dispatch failed")
+
+	chan1Set := loopdb.ChannelSet{chanID1.ToUint64()}
+	chan2Set := loopdb.ChannelSet{chanID2.ToUint64()}
+
+	rules := map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+
+	tests := []struct {
+		name      string
+		autoloop  bool
+		selection *SuggestionSelection
+
+		// failChan is a channel that loop outs fail to dispatch over.
+		failChan uint64
+
+		// dispatched is the set of channels of each loop out that we
+		// expect to be dispatched.
+		dispatched []loopdb.ChannelSet
+		err        error
+	}{
+		{
+			name:      "nothing selected",
+			selection: &SuggestionSelection{},
+			err:       ErrNoSelection,
+		},
+		{
+			name:     "autoloop enabled",
+			autoloop: true,
+			selection: &SuggestionSelection{
+				Indexes: []int{0},
+			},
+			err: ErrAutoloopEnabled,
+		},
+		{
+			name: "selected by index",
+			selection: &SuggestionSelection{
+				Indexes: []int{1},
+			},
+			dispatched: []loopdb.ChannelSet{chan2Set},
+		},
+		{
+			name: "selected by channel",
+			selection: &SuggestionSelection{
+				Channels: map[lnwire.ShortChannelID]struct{}{
+					chanID1: {},
+				},
+			},
+			dispatched: []loopdb.ChannelSet{chan1Set},
+		},
+		{
+			name: "selected by index and channel",
+			selection: &SuggestionSelection{
+				Indexes: []int{0},
+				Channels: map[lnwire.ShortChannelID]struct{}{
+					chanID1: {},
+					chanID2: {},
+				},
+			},
+			dispatched: []loopdb.ChannelSet{chan1Set, chan2Set},
+		},
+		{
+			name: "dispatch failure",
+			selection: &SuggestionSelection{
+				Indexes: []int{0, 1},
+			},
+			failChan:   chanID1.ToUint64(),
+			dispatched: []loopdb.ChannelSet{chan1Set, chan2Set},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			cfg.LoopOut = func(_ context.Context,
+				req *loop.OutRequest) (*loop.LoopOutSwapInfo,
+				error) {
+
+				if req.OutgoingChanSet[0] == testCase.failChan {
+					return nil, errDispatch
+				}
+
+				return &loop.LoopOutSwapInfo{
+					SwapHash: lntypes.Hash{
+						byte(req.OutgoingChanSet[0]),
+					},
+				}, nil
+			}
+
+			params := defaultParameters
+			params.Autoloop = testCase.autoloop
+			params.ChannelRules = rules
+
+			manager := NewManager(cfg)
+			ctx := context.Background()
+
+			err := manager.SetParameters(ctx, params)
+			require.NoError(t, err)
+
+			applied, err := manager.ApplySuggestions(
+				ctx, testCase.selection,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Len(t, applied, len(testCase.dispatched))
+
+			for i, chanSet := range testCase.dispatched {
+				result := applied[i]
+				require.Equal(
+					t, chanSet,
+					result.Request.OutgoingChanSet,
+				)
+
+				if chanSet[0] == testCase.failChan {
+					require.Equal(t, errDispatch, result.Err)
+					require.Nil(t, result.Swap)
+
+					continue
+				}
+
+				require.NoError(t, result.Err)
+				require.Equal(
+					t, lntypes.Hash{byte(chanSet[0])},
+					result.Swap.SwapHash,
+				)
+			}
+		})
+	}
+}
+
+// TestApplySuggestionsIndexRange tests that selecting a suggestion that does
+// not exist fails without dispatching any swaps.
+func TestApplySuggestionsIndexRange(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{channel1}
+
+	cfg.LoopOut = func(_ context.Context,
+		_ *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+		t.Fatal("unexpected dispatch")
+		return nil, nil
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+	}
+
+	manager := NewManager(cfg)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	_, err := manager.ApplySuggestions(ctx, &SuggestionSelection{
+		Indexes: []int{0, 1},
+	})
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package liquidity
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
 )
 
 // TestAutoLoopDisabled tests the case where we need to perform a swap, but
@@ -283,6 +285,7 @@ func TestCompositeRules(t *testing.T) {
 		// and a single channel with peer 1.
 		channel3 = lndclient.ChannelInfo{
 			ChannelID:     chanID3.ToUint64(),
+			Active:        true,
 			PubKeyBytes:   peer2,
 			LocalBalance:  10000,
 			RemoteBalance: 0,
@@ -455,3 +458,203 @@ func existingSwapFromRequest(request *loop.OutRequest, initTime time.Time,
 		},
 	}
 }
+
+// TestAutoloopLabelTemplate tests rendering of a label template for
+// automatically dispatched swaps, and that swaps with rendered labels are
+// still accounted for as autoloops.
+func TestAutoloopLabelTemplate(t *testing.T) {
+	defer test.Guard(t)()
+
+	channels := []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	params := defaultParameters
+	params.Autoloop = true
+	params.AutoFeeStartDate = testTime
+	params.AutoloopLabelTemplate = "{peer_alias}:{channels}:{date}"
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+	}
+
+	c := newAutoloopTestCtx(t, params, channels, testRestrictions)
+	c.lnd.NodeInfos = map[route.Vertex]*lndclient.NodeInfo{
+		peer1: {
+			Node: &lndclient.Node{
+				PubKey: peer1,
+				Alias:  "alias1",
+			},
+		},
+	}
+	c.start()
+
+	quotes := []quoteRequestResp{
+		{
+			request: &loop.LoopOutQuoteRequest{
+				Amount:          chan1Rec.Amount,
+				SweepConfTarget: chan1Rec.SweepConfTarget,
+			},
+			quote: testQuote,
+		},
+	}
+
+	expectedSwap := chan1Rec
+	expectedSwap.Label = "[reserved]: autoloop-out: alias1:1:2020-02-13"
+
+	loopOuts := []loopOutRequestResp{
+		{
+			request: &expectedSwap,
+			response: &loop.LoopOutSwapInfo{
+				SwapHash: lntypes.Hash{1},
+			},
+		},
+	}
+
+	// Tick our autolooper with no existing swaps, we expect a swap with a
+	// rendered label to be dispatched.
+	c.autoloop(1, chan1Rec.Amount+1, nil, quotes, loopOuts)
+
+	// Tick again with our swap in flight. Since we only allow one swap in
+	// flight, we expect the rendered label to be recognized as an autoloop
+	// and no further swaps to be dispatched.
+	existing := []*loopdb.LoopOut{
+		existingSwapFromRequest(&expectedSwap, testTime, nil),
+	}
+	c.autoloop(1, chan1Rec.Amount+1, existing, nil, nil)
+
+	c.stop()
+}
+
+// TestAutoloopMissionControlSnapshot tests that we snapshot lnd's mission
+// control before we dispatch a batch of swaps that is large enough.
+func TestAutoloopMissionControlSnapshot(t *testing.T) {
+	defer test.Guard(t)()
+
+	channels := []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	params := defaultParameters
+	params.Autoloop = true
+	params.AutoFeeStartDate = testTime
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+	}
+
+	c := newAutoloopTestCtx(t, params, channels, testRestrictions)
+
+	snapshots := make(chan struct{}, 1)
+	c.manager.cfg.MissionControlBatch = 1
+	c.manager.cfg.SnapshotMissionControl = func(context.Context) error {
+		snapshots <- struct{}{}
+		return nil
+	}
+	c.start()
+
+	quotes := []quoteRequestResp{
+		{
+			request: &loop.LoopOutQuoteRequest{
+				Amount:          chan1Rec.Amount,
+				SweepConfTarget: chan1Rec.SweepConfTarget,
+			},
+			quote: testQuote,
+		},
+	}
+
+	expectedSwap := chan1Rec
+	expectedSwap.Label = labels.AutoloopLabel(swap.TypeOut)
+
+	loopOuts := []loopOutRequestResp{
+		{
+			request: &expectedSwap,
+			response: &loop.LoopOutSwapInfo{
+				SwapHash: lntypes.Hash{1},
+			},
+		},
+	}
+
+	// Our snapshot should be taken before our swap is dispatched.
+	c.autoloop(1, chan1Rec.Amount+1, nil, quotes, loopOuts)
+
+	select {
+	case <-snapshots:
+	default:
+		t.Fatal("expected mission control snapshot")
+	}
+
+	c.stop()
+}
+
+// TestAutoloopAdjustChannelFees tests that our fees are adjusted on the
+// outgoing channels of the loop outs that we dispatch automatically.
+func TestAutoloopAdjustChannelFees(t *testing.T) {
+	defer test.Guard(t)()
+
+	channels := []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	params := defaultParameters
+	params.Autoloop = true
+	params.AutoFeeStartDate = testTime
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+	}
+
+	c := newAutoloopTestCtx(t, params, channels, testRestrictions)
+
+	type adjustment struct {
+		hash     lntypes.Hash
+		channels []lnwire.ShortChannelID
+	}
+
+	adjustments := make(chan adjustment, 1)
+	c.manager.cfg.AdjustChannelFees = func(_ context.Context,
+		hash lntypes.Hash, channels []lnwire.ShortChannelID) error {
+
+		adjustments <- adjustment{
+			hash:     hash,
+			channels: channels,
+		}
+
+		return nil
+	}
+	c.start()
+
+	quotes := []quoteRequestResp{
+		{
+			request: &loop.LoopOutQuoteRequest{
+				Amount:          chan1Rec.Amount,
+				SweepConfTarget: chan1Rec.SweepConfTarget,
+			},
+			quote: testQuote,
+		},
+	}
+
+	expectedSwap := chan1Rec
+	expectedSwap.Label = labels.AutoloopLabel(swap.TypeOut)
+
+	loopOuts := []loopOutRequestResp{
+		{
+			request: &expectedSwap,
+			response: &loop.LoopOutSwapInfo{
+				SwapHash: lntypes.Hash{1},
+			},
+		},
+	}
+
+	c.autoloop(1, chan1Rec.Amount+1, nil, quotes, loopOuts)
+
+	select {
+	case adjusted := <-adjustments:
+		require.Equal(t, adjustment{
+			hash:     lntypes.Hash{1},
+			channels: []lnwire.ShortChannelID{chanID1},
+		}, adjusted)
+
+	case <-time.After(test.Timeout):
+		t.Fatal("expected channel fee adjustment")
+	}
+
+	c.stop()
+}
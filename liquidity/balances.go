@@ -8,7 +8,8 @@ import (
 )
 
 // balances summarizes the state of the balances of a channel. Channel reserve,
-// fees and pending htlc balances are not included in these balances.
+// fees and pending htlc balances are not included in these balances, but the
+// portion of the outgoing balance that is reserved is tracked separately.
 type balances struct {
 	// capacity is the total capacity of the channel.
 	capacity btcutil.Amount
@@ -19,6 +20,11 @@ type balances struct {
 	// outgoing is the local balance of the channel.
 	outgoing btcutil.Amount
 
+	// outgoingReserve is the portion of the outgoing balance that cannot
+	// be sent in payments, because it is held for our channel reserve and
+	// commitment fees.
+	outgoingReserve btcutil.Amount
+
 	// channels is the channel that has these balances represent. This may
 	// be more than one channel in the case where we are examining a peer's
 	// liquidity as a whole.
@@ -34,6 +40,9 @@ func newBalances(info lndclient.ChannelInfo) *balances {
 		capacity: info.Capacity,
 		incoming: info.RemoteBalance,
 		outgoing: info.LocalBalance,
+
+		outgoingReserve: OutgoingReserve(info),
+
 		channels: []lnwire.ShortChannelID{
 			lnwire.NewShortChanIDFromInt(info.ChannelID),
 		},
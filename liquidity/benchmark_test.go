@@ -0,0 +1,134 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// benchChannels is the number of channels of our benchmark node, each
+	// of which has a channel rule.
+	benchChannels = 1000
+
+	// benchPeers is the number of peers that our benchmark node's channels
+	// are spread over.
+	benchPeers = 250
+
+	// benchSwaps is the number of historical swaps of our benchmark node.
+	// Our suggestions for a node of this size should be made in tens of
+	// milliseconds.
+	benchSwaps = 10000
+)
+
+// newBenchmarkManager creates a manager for a large node, which has a rule for
+// each of its channels and a long history of swaps over them.
+func newBenchmarkManager(b *testing.B, circular CircularMode) *Manager {
+	cfg, lnd := newTestConfig()
+
+	params := defaultParameters
+	params.ChannelRules = make(
+		map[lnwire.ShortChannelID]*ThresholdRule, benchChannels,
+	)
+	params.AutoFeeBudget = btcutil.SatoshiPerBitcoin
+	params.AutoFeeStartDate = testBudgetStart
+	params.MaxAutoInFlight = benchChannels
+	params.CircularMode = circular
+
+	for i := 0; i < benchChannels; i++ {
+		channel := channel1
+		channel.ChannelID = uint64(i + 1)
+		channel.PubKeyBytes = route.Vertex{1, byte(i % benchPeers)}
+
+		// Every fourth channel holds all of its balance on our peer's
+		// side, so that it is a last hop for circular rebalances.
+		if i%4 == 0 {
+			channel.LocalBalance = 0
+			channel.RemoteBalance = channel.Capacity
+		}
+
+		lnd.Channels = append(lnd.Channels, channel)
+
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		params.ChannelRules[chanID] = chanRule
+	}
+
+	loopOut := make([]*loopdb.LoopOut, benchSwaps)
+	for i := range loopOut {
+		var (
+			label string
+			state = loopdb.StateSuccess
+		)
+
+		switch {
+		case i%500 == 0:
+			state = loopdb.StateInitiated
+
+		case i%10 == 1:
+			state = loopdb.StateFailOffchainPayments
+
+		case i%10 == 2:
+			label = labels.AutoloopLabel(swap.TypeOut)
+		}
+
+		// Our swaps are spread over the week before our test time,
+		// so the most recent ones affect our suggestions.
+		age := time.Duration(benchSwaps-i) * time.Minute
+
+		loopOut[i] = newHistoricLoopOut(
+			state, label, testTime.Add(-age),
+			uint64(i%benchChannels+1),
+		)
+	}
+
+	cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
+		return loopOut, nil
+	}
+
+	cfg.EstimateCircular = func(_ context.Context, _ uint64,
+		_ route.Vertex, _ btcutil.Amount) (btcutil.Amount, error) {
+
+		return 100, nil
+	}
+
+	manager := NewManager(cfg)
+	require.NoError(b, manager.SetParameters(context.Background(), params))
+
+	return manager
+}
+
+// benchmarkSuggestSwaps benchmarks our suggestions for a large node with the
+// circular mode provided.
+func benchmarkSuggestSwaps(b *testing.B, circular CircularMode) {
+	manager := newBenchmarkManager(b, circular)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := manager.SuggestSwaps(ctx, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSuggestSwaps benchmarks our suggestions for a node with many
+// channels and a long history of swaps.
+func BenchmarkSuggestSwaps(b *testing.B) {
+	benchmarkSuggestSwaps(b, CircularDisabled)
+}
+
+// BenchmarkSuggestSwapsCircular benchmarks our suggestions for a large node
+// when we compare each of our loop outs with a circular rebalance.
+func BenchmarkSuggestSwapsCircular(b *testing.B) {
+	benchmarkSuggestSwaps(b, CircularCompare)
+}
@@ -0,0 +1,102 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+)
+
+// SwapQuote is a quote for a single loop out in a bundle of suggestions.
+type SwapQuote struct {
+	// Request is the loop out that was quoted.
+	Request loop.OutRequest
+
+	// Quote is the server's current quote for the loop out.
+	Quote *loop.LoopOutQuote
+
+	// MaxFees is the most that the loop out may pay in fees with the limits
+	// set in its request.
+	MaxFees btcutil.Amount
+}
+
+// Fees returns the fees that the loop out pays if it completes at its quote.
+// Routing fees are not quoted, so they are only included in our maximum fees.
+func (s *SwapQuote) Fees() btcutil.Amount {
+	return s.Quote.SwapFee + s.Quote.MinerFee
+}
+
+// BundleQuote is a quote for a bundle of loop outs, so that the cost of a
+// batch of suggestions can be reviewed before it is dispatched.
+type BundleQuote struct {
+	// Swaps holds the quote for each loop out in the bundle.
+	Swaps []*SwapQuote
+
+	// TotalAmount is the total amount of the loop outs in the bundle.
+	TotalAmount btcutil.Amount
+
+	// TotalFees is the total fee that the bundle pays if every loop out
+	// completes at its quote.
+	TotalFees btcutil.Amount
+
+	// TotalMaxFees is the most that the bundle may pay in fees with the
+	// limits set in its requests.
+	TotalMaxFees btcutil.Amount
+}
+
+// QuoteSuggestions quotes a bundle of loop outs. If no loop outs are provided,
+// the loop outs that we currently suggest are quoted.
+func (m *Manager) QuoteSuggestions(ctx context.Context,
+	swaps []loop.OutRequest) (*BundleQuote, error) {
+
+	if len(swaps) == 0 {
+		suggestions, err := m.SuggestSwaps(ctx, false)
+		if err != nil {
+			return nil, err
+		}
+
+		swaps = suggestions.OutSwaps
+	}
+
+	confTarget := m.GetParameters().SweepConfTarget
+
+	bundle := &BundleQuote{
+		Swaps: make([]*SwapQuote, len(swaps)),
+	}
+
+	for i, swap := range swaps {
+		// Requests that don't set a confirmation target sweep with
+		// the target that our parameters set.
+		if swap.SweepConfTarget == 0 {
+			swap.SweepConfTarget = confTarget
+		}
+
+		quote, err := m.cfg.LoopOutQuote(
+			ctx, &loop.LoopOutQuoteRequest{
+				Amount:                  swap.Amount,
+				SweepConfTarget:         swap.SweepConfTarget,
+				SwapPublicationDeadline: m.cfg.Clock.Now(),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		swapQuote := &SwapQuote{
+			Request: swap,
+			Quote:   quote,
+			MaxFees: worstCaseOutFees(
+				swap.MaxPrepayRoutingFee, swap.MaxSwapRoutingFee,
+				swap.MaxSwapFee, swap.MaxMinerFee,
+				swap.MaxPrepayAmount,
+			),
+		}
+
+		bundle.Swaps[i] = swapQuote
+		bundle.TotalAmount += swap.Amount
+		bundle.TotalFees += swapQuote.Fees()
+		bundle.TotalMaxFees += swapQuote.MaxFees
+	}
+
+	return bundle, nil
+}
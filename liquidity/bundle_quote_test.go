@@ -0,0 +1,118 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQuoteSuggestions tests quoting of a bundle of loop outs, and of our
+// current suggestions if no loop outs are provided.
+func TestQuoteSuggestions(t *testing.T) {
+	maxFees := worstCaseOutFees(
+		chan1Rec.MaxPrepayRoutingFee, chan1Rec.MaxSwapRoutingFee,
+		chan1Rec.MaxSwapFee, chan1Rec.MaxMinerFee,
+		chan1Rec.MaxPrepayAmount,
+	)
+	quotedFees := testQuote.SwapFee + testQuote.MinerFee
+
+	rules := map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+
+	// A loop out without a confirmation target is quoted with the target
+	// that our parameters set.
+	noTarget := chan2Rec
+	noTarget.SweepConfTarget = 0
+
+	tests := []struct {
+		name   string
+		swaps  []loop.OutRequest
+		bundle *BundleQuote
+	}{
+		{
+			name:  "loop outs provided",
+			swaps: []loop.OutRequest{chan1Rec, noTarget},
+			bundle: &BundleQuote{
+				Swaps: []*SwapQuote{
+					{
+						Request: chan1Rec,
+						Quote:   testQuote,
+						MaxFees: maxFees,
+					},
+					{
+						Request: chan2Rec,
+						Quote:   testQuote,
+						MaxFees: maxFees,
+					},
+				},
+				TotalAmount:  chan1Rec.Amount + chan2Rec.Amount,
+				TotalFees:    quotedFees * 2,
+				TotalMaxFees: maxFees * 2,
+			},
+		},
+		{
+			name: "current suggestions",
+			bundle: &BundleQuote{
+				Swaps: []*SwapQuote{
+					{
+						Request: chan1Rec,
+						Quote:   testQuote,
+						MaxFees: maxFees,
+					},
+					{
+						Request: chan2Rec,
+						Quote:   testQuote,
+						MaxFees: maxFees,
+					},
+				},
+				TotalAmount:  chan1Rec.Amount + chan2Rec.Amount,
+				TotalFees:    quotedFees * 2,
+				TotalMaxFees: maxFees * 2,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			cfg.LoopOutQuote = func(_ context.Context,
+				req *loop.LoopOutQuoteRequest) (
+				*loop.LoopOutQuote, error) {
+
+				require.Equal(
+					t, int32(defaultConfTarget),
+					req.SweepConfTarget,
+				)
+
+				return testQuote, nil
+			}
+
+			params := defaultParameters
+			params.ChannelRules = rules
+
+			manager := NewManager(cfg)
+			ctx := context.Background()
+
+			err := manager.SetParameters(ctx, params)
+			require.NoError(t, err)
+
+			bundle, err := manager.QuoteSuggestions(
+				ctx, testCase.swaps,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.bundle, bundle)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// getEligibleChannels returns the set of our channels that may be used for
+// swaps, along with the reason that each of the remaining channels may not be
+// used. Inactive channels are never eligible, because any off-chain payment
+// we make over them is guaranteed to fail, which would needlessly back off
+// our rules for the channel.
+//
+// Private channels are eligible for swaps. We currently only suggest loop
+// outs, which we pay over our own outgoing channel, so lnd does not need route
+// hints to use a private channel as the first hop of the swap payment. Route
+// hints are only required for swaps where the server pays us over a private
+// channel, which is the case for loop in.
+func (m *Manager) getEligibleChannels(ctx context.Context) (
+	[]lndclient.ChannelInfo, map[lnwire.ShortChannelID]Reason, error) {
+
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closing, err := m.closingChannels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		eligible   []lndclient.ChannelInfo
+		ineligible = make(map[lnwire.ShortChannelID]Reason)
+		nodes      = make(map[route.Vertex]*lndclient.NodeInfo)
+	)
+
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+
+		if _, ok := m.excludedChannels[chanID]; ok {
+			debugThrottled(chanID.String(), "Channel: %v not "+
+				"eligible, excluded", chanID)
+
+			ineligible[chanID] = ReasonChannelExcluded
+			continue
+		}
+
+		if !m.params.peerAllowed(channel.PubKeyBytes) {
+			debugThrottled(chanID.String(), "Channel: %v not "+
+				"eligible, peer: %v excluded", chanID,
+				channel.PubKeyBytes)
+
+			ineligible[chanID] = ReasonPeerExcluded
+			continue
+		}
+
+		if _, ok := closing[channel.ChannelPoint]; ok {
+			debugThrottled(chanID.String(), "Channel: %v not "+
+				"eligible, pending close", chanID)
+
+			ineligible[chanID] = ReasonChannelClosing
+			continue
+		}
+
+		// Lnd reports a channel as inactive when our peer is offline,
+		// so this check also covers peer connectivity.
+		if !channel.Active {
+			debugThrottled(chanID.String(), "Channel: %v not "+
+				"eligible, inactive", chanID)
+
+			ineligible[chanID] = ReasonChannelInactive
+			continue
+		}
+
+		if !m.params.uptimeSufficient(channel) {
+			debugThrottled(chanID.String(), "Channel: %v not "+
+				"eligible, peer uptime: %v/%v below minimum: "+
+				"%v%%", chanID,
+				channel.Uptime, channel.LifeTime,
+				m.params.MinUptimePercent)
+
+			ineligible[chanID] = ReasonLowUptime
+			continue
+		}
+
+		if m.params.MaxPeerFeeIncreasePercent != 0 &&
+			m.peerFeeIncreased(ctx, channel, nodes) {
+
+			ineligible[chanID] = ReasonPeerFeeIncrease
+			continue
+		}
+
+		eligible = append(eligible, channel)
+	}
+
+	return eligible, ineligible, nil
+}
+
+// closingChannels returns the set of channel points of our channels that are
+// pending close, including channels that have been force closed and are
+// awaiting resolution, and channels that are waiting for their close
+// transaction to confirm. Swaps over these channels would use capacity that
+// is about to disappear. Lnd does not yet support splicing, so we do not
+// need to account for channels with pending splices.
+func (m *Manager) closingChannels(ctx context.Context) (map[string]struct{},
+	error) {
+
+	pending, err := m.cfg.Lnd.Client.PendingChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	closing := make(map[string]struct{})
+	addChannel := func(channel lndclient.PendingChannel) {
+		if channel.ChannelPoint == nil {
+			return
+		}
+
+		closing[channel.ChannelPoint.String()] = struct{}{}
+	}
+
+	for _, channel := range pending.PendingForceClose {
+		addChannel(channel.PendingChannel)
+	}
+
+	for _, channel := range pending.WaitingClose {
+		addChannel(channel.PendingChannel)
+	}
+
+	return closing, nil
+}
+
+// peerAllowed returns a boolean indicating whether our peer allow and deny
+// lists permit swaps with a peer. A peer is allowed if it is not excluded and
+// we either have no allow list, or the peer is present in our allow list.
+func (p Parameters) peerAllowed(peer route.Vertex) bool {
+	if _, excluded := p.ExcludedPeers[peer]; excluded {
+		return false
+	}
+
+	if len(p.AllowedPeers) == 0 {
+		return true
+	}
+
+	_, allowed := p.AllowedPeers[peer]
+	return allowed
+}
+
+// uptimeSufficient returns a boolean indicating whether our peer's observed
+// uptime for a channel meets our minimum uptime requirement. If lnd has not
+// yet monitored the peer, we have no uptime data to judge it by, so we allow
+// the channel.
+func (p Parameters) uptimeSufficient(channel lndclient.ChannelInfo) bool {
+	if p.MinUptimePercent == 0 || channel.LifeTime == 0 {
+		return true
+	}
+
+	uptime := float64(channel.Uptime) / float64(channel.LifeTime) * 100
+	return uptime >= p.MinUptimePercent
+}
+
+// copyPeerSet returns a deep copy of a set of peers, returning nil if the set
+// is empty.
+func copyPeerSet(peers map[route.Vertex]struct{}) map[route.Vertex]struct{} {
+	if len(peers) == 0 {
+		return nil
+	}
+
+	peerCopy := make(map[route.Vertex]struct{}, len(peers))
+	for peer := range peers {
+		peerCopy[peer] = struct{}{}
+	}
+
+	return peerCopy
+}
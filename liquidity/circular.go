@@ -0,0 +1,281 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// circularCandidates is the number of outgoing channels, and the number of
+// last hops, that we estimate circular rebalances over for each loop out.
+const circularCandidates = 3
+
+// CircularMode describes whether the liquidity manager compares the loop outs
+// that it suggests with circular rebalances, which shift the same liquidity
+// out of a channel by paying ourselves over another channel off-chain.
+type CircularMode uint8
+
+const (
+	// CircularDisabled indicates that we do not estimate circular
+	// rebalances.
+	CircularDisabled CircularMode = iota
+
+	// CircularCompare indicates that we estimate the cost of a circular
+	// rebalance for each suggested loop out and report the comparison,
+	// but always suggest the loop out.
+	CircularCompare
+
+	// CircularAllow indicates that we suggest a circular rebalance in
+	// place of a loop out whenever it is cheaper.
+	CircularAllow
+
+	// circularModeCount is the number of circular modes that we support,
+	// used for validation.
+	circularModeCount
+)
+
+// ErrUnknownCircularMode is returned when an unknown circular mode is set.
+var ErrUnknownCircularMode = errors.New("unknown circular rebalance mode")
+
+// String returns the string representation of a circular mode.
+func (c CircularMode) String() string {
+	switch c {
+	case CircularDisabled:
+		return "disabled"
+
+	case CircularCompare:
+		return "compare"
+
+	case CircularAllow:
+		return "allow"
+
+	default:
+		return "unknown"
+	}
+}
+
+// validate checks that a circular mode is known.
+func (c CircularMode) validate() error {
+	if c >= circularModeCount {
+		return ErrUnknownCircularMode
+	}
+
+	return nil
+}
+
+// CircularRebalance is an off-chain payment to ourselves that shifts
+// liquidity out of one of our channels and into a channel with another peer.
+type CircularRebalance struct {
+	// Amount is the amount that is shifted.
+	Amount btcutil.Amount
+
+	// OutgoingChannel is the channel that the payment leaves over, whose
+	// local balance is reduced.
+	OutgoingChannel lnwire.ShortChannelID
+
+	// LastHop is the peer that the payment returns to us from, whose
+	// channel's local balance is increased.
+	LastHop route.Vertex
+
+	// Fee is the routing fee of the cheapest route that was found for the
+	// payment. Dispatched rebalances may not spend more on fees.
+	Fee btcutil.Amount
+}
+
+// CircularComparison compares the fees of a suggested loop out with the cost
+// of shifting the same liquidity with a circular rebalance.
+type CircularComparison struct {
+	// LoopOut is the suggested loop out.
+	LoopOut loop.OutRequest
+
+	// LoopOutFees is the most that the loop out may spend on fees.
+	LoopOutFees btcutil.Amount
+
+	// Rebalance is the cheapest circular rebalance that we found for the
+	// loop out's liquidity shift. It is nil if we found no route.
+	Rebalance *CircularRebalance
+
+	// UseCircular is true if the rebalance is suggested in place of the
+	// loop out.
+	UseCircular bool
+}
+
+// compareCircular estimates the cost of a circular rebalance for each of our
+// suggested loop outs. If circular rebalances are allowed, loop outs that are
+// more expensive than their rebalance are replaced by it.
+func (m *Manager) compareCircular(ctx context.Context, resp *Suggestions,
+	channels []lndclient.ChannelInfo) {
+
+	var outSwaps []loop.OutRequest
+
+	// We index and sort our channels once, rather than for every loop
+	// out, because nodes with many channels may have many suggestions.
+	candidates := newCircularChannels(channels)
+
+	for _, out := range resp.OutSwaps {
+		suggestion := &loopOutSwapSuggestion{
+			OutRequest: out,
+		}
+
+		comparison := CircularComparison{
+			LoopOut:     out,
+			LoopOutFees: suggestion.fees(),
+			Rebalance:   m.cheapestCircular(ctx, out, candidates),
+		}
+
+		if comparison.Rebalance != nil &&
+			m.params.CircularMode == CircularAllow &&
+			comparison.Rebalance.Fee < comparison.LoopOutFees {
+
+			comparison.UseCircular = true
+			resp.CircularRebalances = append(
+				resp.CircularRebalances, *comparison.Rebalance,
+			)
+		} else {
+			outSwaps = append(outSwaps, out)
+		}
+
+		resp.CircularComparisons = append(
+			resp.CircularComparisons, comparison,
+		)
+	}
+
+	resp.OutSwaps = outSwaps
+}
+
+// circularChannels holds our channels indexed for the selection of circular
+// rebalance candidates.
+type circularChannels struct {
+	// channels is our set of channels.
+	channels []lndclient.ChannelInfo
+
+	// byID maps the ID of each of our channels to its index in channels.
+	byID map[uint64]int
+
+	// byRemote holds our channels, ordered by descending remote balance.
+	byRemote []lndclient.ChannelInfo
+}
+
+// newCircularChannels indexes the set of channels provided.
+func newCircularChannels(channels []lndclient.ChannelInfo) *circularChannels {
+	candidates := &circularChannels{
+		channels: channels,
+		byID:     make(map[uint64]int, len(channels)),
+		byRemote: make([]lndclient.ChannelInfo, len(channels)),
+	}
+
+	for i, channel := range channels {
+		candidates.byID[channel.ChannelID] = i
+	}
+
+	copy(candidates.byRemote, channels)
+	sort.SliceStable(candidates.byRemote, func(i, j int) bool {
+		return candidates.byRemote[i].RemoteBalance >
+			candidates.byRemote[j].RemoteBalance
+	})
+
+	return candidates
+}
+
+// cheapestCircular returns the cheapest circular rebalance that shifts the
+// amount of a loop out off one of its channels, or nil if we find no route.
+// A rebalance leaves over a single channel, so only the loop out's channels
+// that hold its full amount are considered. It returns to us via the peers
+// with the most remote balance that are not already part of the loop out.
+func (m *Manager) cheapestCircular(ctx context.Context, out loop.OutRequest,
+	candidates *circularChannels) *CircularRebalance {
+
+	var (
+		sources  []int
+		lastHops []lndclient.ChannelInfo
+		outPeers = make(map[route.Vertex]bool)
+	)
+
+	for _, id := range out.OutgoingChanSet {
+		i, ok := candidates.byID[id]
+		if !ok {
+			continue
+		}
+
+		channel := candidates.channels[i]
+		outPeers[channel.PubKeyBytes] = true
+
+		if channel.LocalBalance >= out.Amount {
+			sources = append(sources, i)
+		}
+	}
+
+	// We order our sources by descending local balance, falling back to
+	// the order of our channels so that our choice is deterministic.
+	sort.Slice(sources, func(i, j int) bool {
+		a := candidates.channels[sources[i]]
+		b := candidates.channels[sources[j]]
+
+		if a.LocalBalance != b.LocalBalance {
+			return a.LocalBalance > b.LocalBalance
+		}
+
+		return sources[i] < sources[j]
+	})
+
+	if len(sources) > circularCandidates {
+		sources = sources[:circularCandidates]
+	}
+
+	// Our channels are ordered by remote balance, so we can stop as soon
+	// as we reach a channel that can't receive the amount.
+	for _, channel := range candidates.byRemote {
+		if len(lastHops) == circularCandidates ||
+			channel.RemoteBalance < out.Amount {
+
+			break
+		}
+
+		if outPeers[channel.PubKeyBytes] {
+			continue
+		}
+
+		lastHops = append(lastHops, channel)
+	}
+
+	var best *CircularRebalance
+	for _, i := range sources {
+		source := candidates.channels[i]
+
+		for _, lastHop := range lastHops {
+			fee, err := m.cfg.EstimateCircular(
+				ctx, source.ChannelID, lastHop.PubKeyBytes,
+				out.Amount,
+			)
+			if err != nil {
+				log.Debugf("no circular route for %v from "+
+					"channel %v via %v: %v", out.Amount,
+					source.ChannelID, lastHop.PubKeyBytes,
+					err)
+
+				continue
+			}
+
+			if best != nil && fee >= best.Fee {
+				continue
+			}
+
+			best = &CircularRebalance{
+				Amount: out.Amount,
+				OutgoingChannel: lnwire.NewShortChanIDFromInt(
+					source.ChannelID,
+				),
+				LastHop: lastHop.PubKeyBytes,
+				Fee:     fee,
+			}
+		}
+	}
+
+	return best
+}
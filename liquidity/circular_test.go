@@ -0,0 +1,246 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCircularModes tests comparison of suggested loop outs with circular
+// rebalances for each of our circular modes.
+func TestCircularModes(t *testing.T) {
+	var (
+		// inbound is a channel with peer2 that has enough remote
+		// balance for a rebalance of chan1Rec's amount to return
+		// through it.
+		inbound = lndclient.ChannelInfo{
+			ChannelID:     chanID2.ToUint64(),
+			Active:        true,
+			PubKeyBytes:   peer2,
+			LocalBalance:  2000,
+			RemoteBalance: 8000,
+			Capacity:      10000,
+		}
+
+		loopOutFees = (&loopOutSwapSuggestion{
+			OutRequest: chan1Rec,
+		}).fees()
+
+		cheap = &CircularRebalance{
+			Amount:          chan1Rec.Amount,
+			OutgoingChannel: chanID1,
+			LastHop:         peer2,
+			Fee:             loopOutFees - 1,
+		}
+
+		expensive = &CircularRebalance{
+			Amount:          chan1Rec.Amount,
+			OutgoingChannel: chanID1,
+			LastHop:         peer2,
+			Fee:             loopOutFees,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		mode        CircularMode
+		fee         btcutil.Amount
+		estimateErr error
+		suggestions *Suggestions
+	}{
+		{
+			name: "disabled",
+			mode: CircularDisabled,
+			fee:  cheap.Fee,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "compare",
+			mode: CircularCompare,
+			fee:  cheap.Fee,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				CircularComparisons: []CircularComparison{{
+					LoopOut:     chan1Rec,
+					LoopOutFees: loopOutFees,
+					Rebalance:   cheap,
+				}},
+			},
+		},
+		{
+			name: "allow cheaper rebalance",
+			mode: CircularAllow,
+			fee:  cheap.Fee,
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				CircularComparisons: []CircularComparison{{
+					LoopOut:     chan1Rec,
+					LoopOutFees: loopOutFees,
+					Rebalance:   cheap,
+					UseCircular: true,
+				}},
+				CircularRebalances: []CircularRebalance{
+					*cheap,
+				},
+			},
+		},
+		{
+			name: "allow more expensive rebalance",
+			mode: CircularAllow,
+			fee:  expensive.Fee,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				CircularComparisons: []CircularComparison{{
+					LoopOut:     chan1Rec,
+					LoopOutFees: loopOutFees,
+					Rebalance:   expensive,
+				}},
+			},
+		},
+		{
+			name:        "no route",
+			mode:        CircularAllow,
+			estimateErr: errors.New("no route"),
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				CircularComparisons: []CircularComparison{{
+					LoopOut:     chan1Rec,
+					LoopOutFees: loopOutFees,
+				}},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, inbound,
+			}
+
+			cfg.EstimateCircular = func(_ context.Context,
+				outgoing uint64, lastHop route.Vertex,
+				amount btcutil.Amount) (btcutil.Amount, error) {
+
+				require.Equal(t, chanID1.ToUint64(), outgoing)
+				require.Equal(t, peer2, lastHop)
+				require.Equal(t, chan1Rec.Amount, amount)
+
+				return testCase.fee, testCase.estimateErr
+			}
+
+			chanRules := map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: chanRule,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = chanRules
+			params.CircularMode = testCase.mode
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestCircularChannels tests that we pick the same candidates for circular
+// rebalances from our indexed channels as we would from the unsorted set.
+func TestCircularChannels(t *testing.T) {
+	var channels []lndclient.ChannelInfo
+	for i := 0; i < 10; i++ {
+		channel := channel1
+		channel.ChannelID = uint64(i + 1)
+		channel.PubKeyBytes = route.Vertex{byte(i % 4)}
+		channel.LocalBalance = btcutil.Amount(i%2) * 5000
+		channel.RemoteBalance = btcutil.Amount(i) * 1000
+
+		channels = append(channels, channel)
+	}
+
+	candidates := newCircularChannels(channels)
+
+	// Our remote balances are unique, so our channels are ordered by
+	// descending ID.
+	for i, channel := range candidates.byRemote {
+		require.Equal(t, uint64(len(channels)-i), channel.ChannelID)
+	}
+
+	type estimate struct {
+		source  uint64
+		lastHop route.Vertex
+	}
+
+	var estimates []estimate
+	manager := NewManager(&Config{
+		EstimateCircular: func(_ context.Context, source uint64,
+			lastHop route.Vertex, _ btcutil.Amount) (btcutil.Amount,
+			error) {
+
+			estimates = append(estimates, estimate{
+				source:  source,
+				lastHop: lastHop,
+			})
+
+			return btcutil.Amount(100 - len(estimates)), nil
+		},
+	})
+
+	// Our loop out uses channels 2 and 4 with peers 1 and 3, which both
+	// hold enough balance to be sources. Our last hops are the channels
+	// with the most remote balance that are not with either peer.
+	out := chan1Rec
+	out.Amount = 3000
+	out.OutgoingChanSet = loopdb.ChannelSet{4, 2}
+
+	best := manager.cheapestCircular(
+		context.Background(), out, candidates,
+	)
+
+	require.Equal(t, []estimate{
+		{source: 2, lastHop: route.Vertex{0}},
+		{source: 2, lastHop: route.Vertex{2}},
+		{source: 2, lastHop: route.Vertex{0}},
+		{source: 4, lastHop: route.Vertex{0}},
+		{source: 4, lastHop: route.Vertex{2}},
+		{source: 4, lastHop: route.Vertex{0}},
+	}, estimates)
+
+	require.Equal(t, &CircularRebalance{
+		Amount:          out.Amount,
+		OutgoingChannel: lnwire.NewShortChanIDFromInt(4),
+		LastHop:         route.Vertex{0},
+		Fee:             94,
+	}, best)
+}
@@ -0,0 +1,52 @@
+package liquidity
+
+import (
+	"github.com/btcsuite/btcutil"
+)
+
+// peerDeficit accumulates the swap amounts that our channel rules require for
+// a peer's channels when each amount on its own is too small to meet the
+// server's minimum swap amount.
+type peerDeficit struct {
+	// balances is the combined balance of the channels with deficits.
+	balances *balances
+
+	// amount is the total swap amount required by the channels.
+	amount btcutil.Amount
+}
+
+// add adds a channel's balance and the swap amount it requires to a peer's
+// deficit.
+func (p *peerDeficit) add(balance *balances, amount btcutil.Amount) {
+	if p.balances == nil {
+		p.balances = &balances{
+			pubkey: balance.pubkey,
+		}
+	}
+
+	p.balances.capacity += balance.capacity
+	p.balances.incoming += balance.incoming
+	p.balances.outgoing += balance.outgoing
+	p.balances.outgoingReserve += balance.outgoingReserve
+	p.balances.channels = append(
+		p.balances.channels, balance.channels...,
+	)
+
+	p.amount += amount
+}
+
+// swapAmount returns the amount that we should swap to cover a peer's
+// accumulated deficit, or zero if the deficit is still below our minimum swap
+// amount.
+func (p *peerDeficit) swapAmount(restrictions *Restrictions) btcutil.Amount {
+	switch {
+	case p.amount < restrictions.Minimum:
+		return 0
+
+	case p.amount > restrictions.Maximum:
+		return restrictions.Maximum
+
+	default:
+		return p.amount
+	}
+}
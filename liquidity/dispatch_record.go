@@ -0,0 +1,99 @@
+package liquidity
+
+import (
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// recordDispatch stores a record that links a suggested loop out that we
+// dispatched to the rules that it was suggested for, along with the balances
+// of its channels when it was suggested. The records are only used to trace
+// swaps back to our rules, so failures to store them are logged rather than
+// failing the swap.
+func (m *Manager) recordDispatch(source loopdb.DispatchSource,
+	swap loop.OutRequest, hash lntypes.Hash,
+	channels []lndclient.ChannelInfo) {
+
+	if m.cfg.RecordDispatch == nil {
+		return
+	}
+
+	record := newDispatchRecord(m.GetParameters(), channels, swap)
+	record.Time = m.cfg.Clock.Now()
+	record.SwapHash = hash
+	record.Source = source
+
+	if err := m.cfg.RecordDispatch(record); err != nil {
+		log.Warnf("Could not record dispatch of swap: %v: %v", hash,
+			err)
+	}
+}
+
+// newDispatchRecord creates a dispatch record for a suggested loop out,
+// containing the rules that cover its channels and the current balances of
+// those channels. Channel and peer rules are exclusive, so a swap is either
+// linked to the rules of its channels or to the rule of its peer.
+func newDispatchRecord(params Parameters, channels []lndclient.ChannelInfo,
+	swap loop.OutRequest) *loopdb.DispatchRecord {
+
+	record := &loopdb.DispatchRecord{
+		Amount:              swap.Amount,
+		MaxSwapFee:          swap.MaxSwapFee,
+		MaxMinerFee:         swap.MaxMinerFee,
+		MaxSwapRoutingFee:   swap.MaxSwapRoutingFee,
+		MaxPrepayRoutingFee: swap.MaxPrepayRoutingFee,
+		SweepConfTarget:     swap.SweepConfTarget,
+	}
+
+	swapChannels := make(map[uint64]bool, len(swap.OutgoingChanSet))
+	for _, channel := range swap.OutgoingChanSet {
+		swapChannels[channel] = true
+	}
+
+	peers := make(map[route.Vertex]bool)
+	for _, channel := range channels {
+		if !swapChannels[channel.ChannelID] {
+			continue
+		}
+
+		snapshot := loopdb.ChannelSnapshot{
+			ChannelID:     channel.ChannelID,
+			Peer:          channel.PubKeyBytes,
+			Capacity:      channel.Capacity,
+			LocalBalance:  channel.LocalBalance,
+			RemoteBalance: channel.RemoteBalance,
+		}
+		record.Channels = append(record.Channels, snapshot)
+
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		if rule, ok := params.ChannelRules[chanID]; ok {
+			record.Rules = append(record.Rules, loopdb.DispatchRule{
+				ChannelID:       channel.ChannelID,
+				MinimumIncoming: uint32(rule.MinimumIncoming),
+				MinimumOutgoing: uint32(rule.MinimumOutgoing),
+			})
+		}
+
+		// We only add each peer's rule once, even if the swap uses
+		// several of the peer's channels.
+		peer := channel.PubKeyBytes
+		if peers[peer] {
+			continue
+		}
+		peers[peer] = true
+
+		if rule, ok := params.PeerRules[peer]; ok {
+			record.Rules = append(record.Rules, loopdb.DispatchRule{
+				Peer:            peer,
+				MinimumIncoming: uint32(rule.MinimumIncoming),
+				MinimumOutgoing: uint32(rule.MinimumOutgoing),
+			})
+		}
+	}
+
+	return record
+}
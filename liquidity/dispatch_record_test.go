@@ -0,0 +1,116 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecordDispatch tests that applying a suggestion records the rule that
+// it was suggested for along with the balances of its channel.
+func TestRecordDispatch(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{channel1, channel2}
+
+	hash := lntypes.Hash{1}
+	cfg.LoopOut = func(_ context.Context,
+		_ *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+		return &loop.LoopOutSwapInfo{
+			SwapHash: hash,
+		}, nil
+	}
+
+	var records []*loopdb.DispatchRecord
+	cfg.RecordDispatch = func(record *loopdb.DispatchRecord) error {
+		records = append(records, record)
+		return nil
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+	}
+
+	manager := NewManager(cfg)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	_, err := manager.ApplySuggestions(ctx, &SuggestionSelection{
+		Indexes: []int{0},
+	})
+	require.NoError(t, err)
+
+	rule := loopdb.DispatchRule{
+		ChannelID:       chanID1.ToUint64(),
+		MinimumIncoming: uint32(chanRule.MinimumIncoming),
+		MinimumOutgoing: uint32(chanRule.MinimumOutgoing),
+	}
+
+	expected := &loopdb.DispatchRecord{
+		Time:                testTime,
+		SwapHash:            hash,
+		Source:              loopdb.DispatchSourceApplied,
+		Rules:               []loopdb.DispatchRule{rule},
+		Amount:              chan1Rec.Amount,
+		MaxSwapFee:          chan1Rec.MaxSwapFee,
+		MaxMinerFee:         chan1Rec.MaxMinerFee,
+		MaxSwapRoutingFee:   chan1Rec.MaxSwapRoutingFee,
+		MaxPrepayRoutingFee: chan1Rec.MaxPrepayRoutingFee,
+		SweepConfTarget:     chan1Rec.SweepConfTarget,
+		Channels: []loopdb.ChannelSnapshot{
+			{
+				ChannelID:     channel1.ChannelID,
+				Peer:          peer1,
+				Capacity:      channel1.Capacity,
+				LocalBalance:  channel1.LocalBalance,
+				RemoteBalance: channel1.RemoteBalance,
+			},
+		},
+	}
+	require.Equal(t, []*loopdb.DispatchRecord{expected}, records)
+}
+
+// TestNewDispatchRecordPeerRule tests that a swap suggested for a peer rule is
+// linked to the peer's rule once, even if it uses several of its channels.
+func TestNewDispatchRecordPeerRule(t *testing.T) {
+	channel3 := channel1
+	channel3.ChannelID = 3
+
+	params := defaultParameters
+	params.PeerRules = map[route.Vertex]*ThresholdRule{
+		peer1: chanRule,
+	}
+
+	swap := loop.OutRequest{
+		Amount: 10000,
+		OutgoingChanSet: loopdb.ChannelSet{
+			channel1.ChannelID, channel3.ChannelID,
+		},
+	}
+
+	record := newDispatchRecord(
+		params, []lndclient.ChannelInfo{channel1, channel2, channel3},
+		swap,
+	)
+
+	require.Equal(t, []loopdb.DispatchRule{
+		{
+			Peer:            peer1,
+			MinimumIncoming: uint32(chanRule.MinimumIncoming),
+			MinimumOutgoing: uint32(chanRule.MinimumOutgoing),
+		},
+	}, record.Rules)
+
+	require.Len(t, record.Channels, 2)
+	require.Equal(t, channel1.ChannelID, record.Channels[0].ChannelID)
+	require.Equal(t, channel3.ChannelID, record.Channels[1].ChannelID)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
@@ -367,6 +368,28 @@ func (f *FeePortion) loopOutFees(amount btcutil.Amount,
 	return prepayMaxFee, routeMaxFee, minerFee
 }
 
+// FeePortionLimits checks whether the quote provided for a loop out of the
+// amount provided is within the portion of the amount that may be spent on
+// fees, expressed in parts per million. If it is, the maximum prepay routing,
+// swap routing and miner fees for the swap are returned.
+func FeePortionLimits(amount btcutil.Amount, ppm uint64,
+	quote *loop.LoopOutQuote) (btcutil.Amount, btcutil.Amount,
+	btcutil.Amount, error) {
+
+	portion := NewFeePortion(ppm)
+	if err := portion.validate(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	if err := portion.loopOutLimits(amount, quote); err != nil {
+		return 0, 0, 0, err
+	}
+
+	prepay, route, miner := portion.loopOutFees(amount, quote)
+
+	return prepay, route, miner, nil
+}
+
 // splitOffChain takes an available fee budget and divides it among our prepay
 // and swap payments proportional to their volume.
 func splitOffChain(available, prepayAmt,
@@ -384,3 +407,25 @@ func splitOffChain(available, prepayAmt,
 func scaleMinerFee(estimate btcutil.Amount) btcutil.Amount {
 	return estimate * btcutil.Amount(minerMultiplier)
 }
+
+// recordSuggestionFee stores a record of the sweep fee estimate that we
+// checked against our fee limit before suggesting swaps. The records are only
+// used for analysis, so failures to store them are logged.
+func (m *Manager) recordSuggestionFee(estimate chainfee.SatPerKWeight,
+	allowed bool) {
+
+	if m.cfg.RecordFee == nil {
+		return
+	}
+
+	err := m.cfg.RecordFee(&loopdb.FeeRecord{
+		Time:       m.cfg.Clock.Now(),
+		Decision:   loopdb.FeeDecisionSuggestion,
+		ConfTarget: m.params.SweepConfTarget,
+		FeeRate:    estimate,
+		Allowed:    allowed,
+	})
+	if err != nil {
+		log.Warnf("Could not record suggestion fee: %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package liquidity
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// MaxAmountJitterPercent is the largest percentage of a swap's amount that we
+// allow the amounts of automatically dispatched swaps to be randomized by.
+const MaxAmountJitterPercent = 10
+
+// RandomAmount returns a uniformly random amount in [0, max) read from a
+// cryptographically secure source.
+func RandomAmount(max btcutil.Amount) (btcutil.Amount, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, err
+	}
+
+	return btcutil.Amount(n.Int64()), nil
+}
+
+// jitterAmount returns a random amount within the percentage provided of the
+// amount provided, so that repeated swaps of the same amount can't be
+// correlated by observers. The amount returned does not leave the bounds of
+// the restrictions provided.
+func jitterAmount(amount btcutil.Amount, percent float64,
+	restrictions *Restrictions,
+	random func(btcutil.Amount) (btcutil.Amount, error)) (btcutil.Amount,
+	error) {
+
+	band := btcutil.Amount(float64(amount) * percent / 100)
+	if band == 0 {
+		return amount, nil
+	}
+
+	min, max := amount-band, amount+band
+	if min < restrictions.Minimum {
+		min = restrictions.Minimum
+	}
+
+	if restrictions.Maximum != 0 && max > restrictions.Maximum {
+		max = restrictions.Maximum
+	}
+
+	// If our restrictions leave no room to randomize our amount, we use it
+	// as is.
+	if max <= min {
+		return amount, nil
+	}
+
+	offset, err := random(max - min + 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return min + offset, nil
+}
@@ -0,0 +1,101 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJitterAmount tests randomizing of swap amounts within a percentage of
+// the original amount and the restrictions provided.
+func TestJitterAmount(t *testing.T) {
+	tests := []struct {
+		name         string
+		amount       btcutil.Amount
+		percent      float64
+		restrictions *Restrictions
+
+		// offset is the random amount that our source returns.
+		offset btcutil.Amount
+
+		// expectedMax is the exclusive upper bound we expect to be
+		// passed to our random source, zero if it should not be
+		// called.
+		expectedMax btcutil.Amount
+		expected    btcutil.Amount
+	}{
+		{
+			name:         "no band",
+			amount:       10,
+			percent:      1,
+			restrictions: NewRestrictions(1, 100),
+			expected:     10,
+		},
+		{
+			name:         "lowest amount",
+			amount:       1000,
+			percent:      2,
+			restrictions: NewRestrictions(1, 10000),
+			offset:       0,
+			expectedMax:  41,
+			expected:     980,
+		},
+		{
+			name:         "highest amount",
+			amount:       1000,
+			percent:      2,
+			restrictions: NewRestrictions(1, 10000),
+			offset:       40,
+			expectedMax:  41,
+			expected:     1020,
+		},
+		{
+			name:         "clamped to minimum",
+			amount:       1000,
+			percent:      2,
+			restrictions: NewRestrictions(1000, 10000),
+			offset:       0,
+			expectedMax:  21,
+			expected:     1000,
+		},
+		{
+			name:         "clamped to maximum",
+			amount:       1000,
+			percent:      2,
+			restrictions: NewRestrictions(1, 1010),
+			offset:       30,
+			expectedMax:  31,
+			expected:     1010,
+		},
+		{
+			name:         "no room to randomize",
+			amount:       1000,
+			percent:      2,
+			restrictions: NewRestrictions(1000, 1000),
+			expected:     1000,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			var called btcutil.Amount
+			random := func(max btcutil.Amount) (btcutil.Amount,
+				error) {
+
+				called = max
+				return testCase.offset, nil
+			}
+
+			amount, err := jitterAmount(
+				testCase.amount, testCase.percent,
+				testCase.restrictions, random,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, amount)
+			require.Equal(t, testCase.expectedMax, called)
+		})
+	}
+}
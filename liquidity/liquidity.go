@@ -3,10 +3,13 @@
 // balance on a per-channel basis.
 //
 // Swap suggestions are limited to channels that are not currently being used
-// for a pending swap. If we are currently processing an unrestricted swap (ie,
-// a loop out with no outgoing channel targets set or a loop in with no last
-// hop set), we will not suggest any swaps because these swaps will shift the
-// balances of our channels in ways we can't predict.
+// for a pending swap. When evaluating peer rules, the amount of a pending loop
+// out is instead reserved from the peer's balances so that its remaining
+// channels can still be used. Unrestricted swaps (ie, a loop out with no outgoing
+// channel targets set or a loop in with no last hop set) shift the balances
+// of our channels in ways we can't predict, so the unrestricted mode set in
+// our parameters determines whether we ignore them, suggest no swaps while
+// they are pending, or reserve the channels they are most likely to use.
 //
 // Fee restrictions are placed on swap suggestions to ensure that we only
 // suggest swaps that fit the configured fee preferences.
@@ -36,7 +39,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -49,6 +51,7 @@ import (
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/funding"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -110,6 +113,16 @@ var (
 	// ErrNegativeBudget is returned if a negative swap budget is set.
 	ErrNegativeBudget = errors.New("swap budget must be >= 0")
 
+	// ErrNegativeRefreshPeriod is returned if a negative budget refresh
+	// period is set.
+	ErrNegativeRefreshPeriod = errors.New("budget refresh period must " +
+		"be >= 0")
+
+	// ErrRefreshWithoutStart is returned if a budget refresh period is set
+	// without a budget start date that its periods are counted from.
+	ErrRefreshWithoutStart = errors.New("budget refresh period " +
+		"requires a budget start date")
+
 	// ErrZeroInFlight is returned is a zero in flight swaps value is set.
 	ErrZeroInFlight = errors.New("max in flight swaps must be >=0")
 
@@ -135,6 +148,24 @@ var (
 	// set together are specified.
 	ErrExclusiveRules = errors.New("channel and peer rules must be " +
 		"exclusive")
+
+	// ErrPeerAllowedAndExcluded is returned when a peer is present in both
+	// our allow and deny lists.
+	ErrPeerAllowedAndExcluded = errors.New("peer cannot be both allowed " +
+		"and excluded")
+
+	// ErrInvalidUptime is returned when a minimum uptime percentage that
+	// is not in [0, 100] is set.
+	ErrInvalidUptime = errors.New("minimum uptime must be a percentage " +
+		"in [0, 100]")
+
+	// ErrZeroPeerWeight is returned when a peer weight of zero is set.
+	ErrZeroPeerWeight = errors.New("peer weight must be non-zero")
+
+	// ErrInvalidAmountJitter is returned when an amount jitter percentage
+	// that is negative or above our maximum is set.
+	ErrInvalidAmountJitter = fmt.Errorf("amount jitter must be a "+
+		"percentage in [0, %v]", MaxAmountJitterPercent)
 )
 
 // Config contains the external functionality required to run the
@@ -174,6 +205,87 @@ type Config struct {
 	// MinimumConfirmations is the minimum number of confirmations we allow
 	// setting for sweep target.
 	MinimumConfirmations int32
+
+	// SnapshotTicker determines how often we record a snapshot of our
+	// balances. If it is nil, we do not record snapshots.
+	SnapshotTicker ticker.Ticker
+
+	// SnapshotRetention is the amount of time that we keep snapshots for.
+	// If it is zero, snapshots are never pruned.
+	SnapshotRetention time.Duration
+
+	// CreateSnapshot stores a snapshot of our balances.
+	CreateSnapshot func(snapshot *loopdb.LiquiditySnapshot) error
+
+	// ListSnapshots returns the snapshots taken within a time range.
+	ListSnapshots func(start, end time.Time) ([]*loopdb.LiquiditySnapshot,
+		error)
+
+	// PruneSnapshots deletes all snapshots taken before a given time.
+	PruneSnapshots func(before time.Time) error
+
+	// RecordFee stores a record of the fee estimate that we checked
+	// against our fee limit before suggesting swaps. If it is nil, no
+	// records are stored.
+	RecordFee func(record *loopdb.FeeRecord) error
+
+	// ListFeeRecords returns the fee records made within a time range. If
+	// it is nil, parameter advice does not consider our fee history.
+	ListFeeRecords func(start, end time.Time) ([]*loopdb.FeeRecord, error)
+
+	// RecordDispatch stores a record that links a suggested swap that was
+	// dispatched to the rules that it was suggested for. If it is nil, no
+	// records are stored.
+	RecordDispatch func(record *loopdb.DispatchRecord) error
+
+	// MissionControlBatch is the number of swaps that autoloop must
+	// dispatch at once for us to snapshot lnd's mission control first.
+	MissionControlBatch int
+
+	// SnapshotMissionControl saves a snapshot of lnd's mission control.
+	// If it is nil, no snapshots are saved.
+	SnapshotMissionControl func(ctx context.Context) error
+
+	// RandomAmount returns a uniformly random amount in [0, max). It is
+	// used to randomize the amounts of automatically dispatched swaps.
+	RandomAmount func(max btcutil.Amount) (btcutil.Amount, error)
+
+	// SweepAddr generates the address that automatically dispatched swaps
+	// are swept to. If it is nil, lnd's next wallet address is used.
+	SweepAddr func(ctx context.Context) (btcutil.Address, error)
+
+	// AmountTiers are the amount tiers that the client applies to all of
+	// its swaps. We apply them to our suggestions so that we do not
+	// suggest swaps that the client will reject.
+	AmountTiers loop.AmountTiers
+
+	// EstimateCircular returns the routing fee of the cheapest route for a
+	// payment to ourselves of the amount provided that leaves over the
+	// outgoing channel provided and returns via the last hop provided. If
+	// it is nil, we do not compare our loop outs with circular rebalances.
+	EstimateCircular func(ctx context.Context, outgoing uint64,
+		lastHop route.Vertex, amount btcutil.Amount) (btcutil.Amount,
+		error)
+
+	// CircularRebalance dispatches a circular rebalance, spending no more
+	// than its fee. If it is nil, circular rebalances are suggested but
+	// never automatically dispatched.
+	CircularRebalance func(ctx context.Context,
+		rebalance *CircularRebalance) error
+
+	// QuoteChannels returns the prices that our liquidity service
+	// providers quote for opening a channel to us with the inbound
+	// capacity provided. If it is nil, we do not compare our loop outs
+	// with buying inbound channels.
+	QuoteChannels func(ctx context.Context,
+		capacity btcutil.Amount) ([]ChannelQuote, error)
+
+	// AdjustChannelFees is called with the hash and outgoing channels of
+	// each loop out that we dispatch automatically, so that our fees on
+	// the channels can be raised while the swap drains them. If it is
+	// nil, our fees are not adjusted.
+	AdjustChannelFees func(ctx context.Context, hash lntypes.Hash,
+		channels []lnwire.ShortChannelID) error
 }
 
 // Parameters is a set of parameters provided by the user which guide
@@ -192,6 +304,13 @@ type Parameters struct {
 	// dispatched swaps in our current budget, inclusive.
 	AutoFeeStartDate time.Time
 
+	// AutoFeeRefreshPeriod is the interval at which our budget is
+	// replenished. If it is non-zero, our budget applies to the swaps of
+	// the current period only, where periods are counted from our start
+	// date. If it is zero, our budget applies to all swaps since our start
+	// date.
+	AutoFeeRefreshPeriod time.Duration
+
 	// MaxAutoInFlight is the maximum number of in-flight automatically
 	// dispatched swaps we allow.
 	MaxAutoInFlight int
@@ -223,6 +342,62 @@ type Parameters struct {
 	// ChannelRules are exclusively set to prevent overlap between peer
 	// and channel rules map to avoid ambiguity.
 	PeerRules map[route.Vertex]*ThresholdRule
+
+	// AllowedPeers is an optional set of peers that liquidity management
+	// is restricted to. If this set is non-empty, channels with peers that
+	// are not in the set will never be used for swaps.
+	AllowedPeers map[route.Vertex]struct{}
+
+	// ExcludedPeers is a set of peers whose channels will never be used
+	// for swaps, even if a rule covers them.
+	ExcludedPeers map[route.Vertex]struct{}
+
+	// MinUptimePercent is the minimum percentage of time that our peer
+	// must have been observed online by lnd for one of its channels to
+	// be eligible for swaps. If this value is zero, no uptime requirement
+	// is applied.
+	MinUptimePercent float64
+
+	// MaxPeerFeeIncreasePercent is the largest percentage that the peer
+	// of a channel may have raised the fee that it charges to forward
+	// over the channel to us by within the last day for the channel to be
+	// eligible for swaps. If this value is zero, we do not check our
+	// peers' fees.
+	MaxPeerFeeIncreasePercent uint64
+
+	// UnrestrictedMode determines how we account for pending swaps that
+	// are not restricted to specific channels or peers.
+	UnrestrictedMode UnrestrictedMode
+
+	// AggregateDeficits indicates whether we should combine the swap
+	// amounts required by channel rules for several of a peer's channels
+	// into a single swap when each amount on its own is below the server
+	// minimum.
+	AggregateDeficits bool
+
+	// Priority determines the order in which we allocate our budget to
+	// swap suggestions when we cannot afford all of them.
+	Priority Priority
+
+	// PeerWeights is an optional set of weights for peers that are used
+	// to order suggestions when weighted priority is set.
+	PeerWeights map[route.Vertex]uint64
+
+	// AutoloopLabelTemplate is an optional template that is rendered and
+	// appended to the label of automatically dispatched swaps. See the
+	// labels package for the set of supported placeholders.
+	AutoloopLabelTemplate string
+
+	// AmountJitterPercent is the percentage of a swap's amount that the
+	// amounts of automatically dispatched swaps are randomly increased or
+	// decreased by, so that repeated swaps of the same amount can't be
+	// correlated by observers. If it is zero, amounts aren't randomized.
+	AmountJitterPercent float64
+
+	// CircularMode determines whether we compare our loop outs with
+	// circular rebalances that shift the same liquidity off-chain, and
+	// whether we suggest the rebalances when they are cheaper.
+	CircularMode CircularMode
 }
 
 // String returns the string representation of our parameters.
@@ -244,11 +419,23 @@ func (p Parameters) String() string {
 
 	return fmt.Sprintf("rules: %v, failure backoff: %v, sweep "+
 		"sweep conf target: %v, fees: %v, auto budget: %v, budget "+
-		"start: %v, max auto in flight: %v, minimum swap size=%v, "+
-		"maximum swap size=%v", strings.Join(ruleList, ","),
+		"start: %v, budget refresh: %v, max auto in flight: %v, "+
+		"minimum swap size=%v, maximum swap size=%v, label "+
+		"template: %v, allowed peers: "+
+		"%v, excluded peers: %v, minimum uptime: %v%%, maximum "+
+		"peer fee increase: %v%%, unrestricted mode: %v, aggregate "+
+		"deficits: %v, priority: %v, peer weights: %v, amount "+
+		"jitter: %v%%, circular: %v",
+		strings.Join(ruleList, ","),
 		p.FailureBackOff, p.SweepConfTarget, p.FeeLimit,
-		p.AutoFeeBudget, p.AutoFeeStartDate, p.MaxAutoInFlight,
-		p.ClientRestrictions.Minimum, p.ClientRestrictions.Maximum)
+		p.AutoFeeBudget, p.AutoFeeStartDate, p.AutoFeeRefreshPeriod,
+		p.MaxAutoInFlight, p.ClientRestrictions.Minimum,
+		p.ClientRestrictions.Maximum,
+		p.AutoloopLabelTemplate, len(p.AllowedPeers),
+		len(p.ExcludedPeers), p.MinUptimePercent,
+		p.MaxPeerFeeIncreasePercent, p.UnrestrictedMode,
+		p.AggregateDeficits, p.Priority, len(p.PeerWeights),
+		p.AmountJitterPercent, p.CircularMode)
 }
 
 // haveRules returns a boolean indicating whether we have any rules configured.
@@ -264,6 +451,19 @@ func (p Parameters) haveRules() bool {
 	return false
 }
 
+// budgetStart returns the start of the budget period that the time provided
+// falls in. If our budget is not refreshed, or the time is before our start
+// date, this is our start date.
+func (p Parameters) budgetStart(now time.Time) time.Time {
+	if p.AutoFeeRefreshPeriod == 0 || now.Before(p.AutoFeeStartDate) {
+		return p.AutoFeeStartDate
+	}
+
+	periods := now.Sub(p.AutoFeeStartDate) / p.AutoFeeRefreshPeriod
+
+	return p.AutoFeeStartDate.Add(periods * p.AutoFeeRefreshPeriod)
+}
+
 // validate checks whether a set of parameters is valid. Our set of currently
 // open channels are required to check that there is no overlap between the
 // rules set on a per-peer level, and those set for specific channels. We can't
@@ -331,6 +531,14 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 		return ErrNegativeBudget
 	}
 
+	if p.AutoFeeRefreshPeriod < 0 {
+		return ErrNegativeRefreshPeriod
+	}
+
+	if p.AutoFeeRefreshPeriod != 0 && p.AutoFeeStartDate.IsZero() {
+		return ErrRefreshWithoutStart
+	}
+
 	if p.MaxAutoInFlight <= 0 {
 		return ErrZeroInFlight
 	}
@@ -340,6 +548,45 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 		return err
 	}
 
+	if err := labels.ValidateTemplate(p.AutoloopLabelTemplate); err != nil {
+		return err
+	}
+
+	for peer := range p.ExcludedPeers {
+		if _, ok := p.AllowedPeers[peer]; ok {
+			return fmt.Errorf("%w: %v", ErrPeerAllowedAndExcluded,
+				peer)
+		}
+	}
+
+	if p.MinUptimePercent < 0 || p.MinUptimePercent > 100 {
+		return ErrInvalidUptime
+	}
+
+	if p.AmountJitterPercent < 0 ||
+		p.AmountJitterPercent > MaxAmountJitterPercent {
+
+		return ErrInvalidAmountJitter
+	}
+
+	if err := p.UnrestrictedMode.validate(); err != nil {
+		return err
+	}
+
+	if err := p.CircularMode.validate(); err != nil {
+		return err
+	}
+
+	if err := p.Priority.validate(); err != nil {
+		return err
+	}
+
+	for peer, weight := range p.PeerWeights {
+		if weight == 0 {
+			return fmt.Errorf("%w: %v", ErrZeroPeerWeight, peer)
+		}
+	}
+
 	return nil
 }
 
@@ -392,6 +639,15 @@ type Manager struct {
 
 	// paramsLock is a lock for our current set of parameters.
 	paramsLock sync.Mutex
+
+	// peerPolicies tracks the fees that our peers charge for our
+	// channels.
+	peerPolicies *peerPolicies
+
+	// excludedChannels is a set of channels that are not eligible for
+	// swaps. It is only set for suggestions made with per-invocation
+	// overrides.
+	excludedChannels map[lnwire.ShortChannelID]struct{}
 }
 
 // Run periodically checks whether we should automatically dispatch a loop out.
@@ -401,13 +657,29 @@ func (m *Manager) Run(ctx context.Context) error {
 	m.cfg.AutoloopTicker.Resume()
 	defer m.cfg.AutoloopTicker.Stop()
 
+	// If we do not have a snapshot ticker, we leave our snapshot channel
+	// nil so that we never select on it.
+	var snapshotTicks <-chan time.Time
+	if m.cfg.SnapshotTicker != nil {
+		m.cfg.SnapshotTicker.Resume()
+		defer m.cfg.SnapshotTicker.Stop()
+
+		snapshotTicks = m.cfg.SnapshotTicker.Ticks()
+	}
+
 	for {
 		select {
+		case <-snapshotTicks:
+			if err := m.snapshot(ctx); err != nil {
+				log.Errorf("liquidity snapshot failed: %v", err)
+			}
+
 		case <-m.cfg.AutoloopTicker.Ticks():
 			err := m.autoloop(ctx)
 			switch err {
 			case ErrNoRules:
-				log.Debugf("No rules configured for autoloop")
+				debugThrottled("", "No rules configured for "+
+					"autoloop")
 
 			case nil:
 
@@ -424,8 +696,9 @@ func (m *Manager) Run(ctx context.Context) error {
 // NewManager creates a liquidity manager which has no rules set.
 func NewManager(cfg *Config) *Manager {
 	return &Manager{
-		cfg:    cfg,
-		params: defaultParameters,
+		cfg:          cfg,
+		params:       defaultParameters,
+		peerPolicies: newPeerPolicies(),
 	}
 }
 
@@ -440,26 +713,35 @@ func (m *Manager) GetParameters() Parameters {
 // SetParameters updates our current set of parameters if the new parameters
 // provided are valid.
 func (m *Manager) SetParameters(ctx context.Context, params Parameters) error {
-	restrictions, err := m.cfg.Restrictions(ctx, swap.TypeOut)
-	if err != nil {
+	if err := m.validateParameters(ctx, params); err != nil {
 		return err
 	}
 
-	channels, err := m.cfg.Lnd.Client.ListChannels(ctx)
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	m.params = cloneParameters(params)
+	return nil
+}
+
+// validateParameters checks that a set of parameters is valid for our current
+// channels and the server's current restrictions.
+func (m *Manager) validateParameters(ctx context.Context,
+	params Parameters) error {
+
+	restrictions, err := m.cfg.Restrictions(ctx, swap.TypeOut)
 	if err != nil {
 		return err
 	}
 
-	err = params.validate(m.cfg.MinimumConfirmations, channels, restrictions)
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx)
 	if err != nil {
 		return err
 	}
 
-	m.paramsLock.Lock()
-	defer m.paramsLock.Unlock()
-
-	m.params = cloneParameters(params)
-	return nil
+	return params.validate(
+		m.cfg.MinimumConfirmations, channels, restrictions,
+	)
 }
 
 // cloneParameters creates a deep clone of a parameters struct so that callers
@@ -487,17 +769,37 @@ func cloneParameters(params Parameters) Parameters {
 		paramCopy.PeerRules[peer] = &ruleCopy
 	}
 
+	paramCopy.AllowedPeers = copyPeerSet(params.AllowedPeers)
+	paramCopy.ExcludedPeers = copyPeerSet(params.ExcludedPeers)
+
+	if len(params.PeerWeights) != 0 {
+		paramCopy.PeerWeights = make(
+			map[route.Vertex]uint64, len(params.PeerWeights),
+		)
+		for peer, weight := range params.PeerWeights {
+			paramCopy.PeerWeights[peer] = weight
+		}
+	}
+
 	return paramCopy
 }
 
 // autoloop gets a set of suggested swaps and dispatches them automatically if
 // we have automated looping enabled.
 func (m *Manager) autoloop(ctx context.Context) error {
-	suggestion, err := m.SuggestSwaps(ctx, true)
+	eval := &evaluation{}
+	suggestion, err := m.suggestSwaps(ctx, true, eval)
 	if err != nil {
 		return err
 	}
 
+	if m.params.Autoloop {
+		err := m.snapshotMissionControl(ctx, len(suggestion.OutSwaps))
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, swap := range suggestion.OutSwaps {
 		// If we don't actually have dispatch of swaps enabled, log
 		// suggestions.
@@ -508,21 +810,100 @@ func (m *Manager) autoloop(ctx context.Context) error {
 			continue
 		}
 
-		// Create a copy of our range var so that we can reference it.
-		swap := swap
-		loopOut, err := m.cfg.LoopOut(ctx, &swap)
+		_, err := m.dispatchLoopOut(
+			ctx, loopdb.DispatchSourceAutoloop, swap, eval,
+		)
 		if err != nil {
 			return err
 		}
+	}
+
+	for _, rebalance := range suggestion.CircularRebalances {
+		if !m.params.Autoloop || m.cfg.CircularRebalance == nil {
+			log.Debugf("recommended circular rebalance: %v sats "+
+				"over %v via %v", rebalance.Amount,
+				rebalance.OutgoingChannel, rebalance.LastHop)
 
-		log.Infof("loop out automatically dispatched: hash: %v, "+
-			"address: %v", loopOut.SwapHash,
-			loopOut.HtlcAddressP2WSH)
+			continue
+		}
+
+		// A failed rebalance does not shift any liquidity, so it will
+		// be suggested again on our next tick.
+		rebalance := rebalance
+		if err := m.cfg.CircularRebalance(ctx, &rebalance); err != nil {
+			log.Warnf("circular rebalance of %v over %v failed: %v",
+				rebalance.Amount, rebalance.OutgoingChannel, err)
+
+			continue
+		}
+
+		log.Infof("circular rebalance automatically dispatched: %v "+
+			"sats over %v via %v", rebalance.Amount,
+			rebalance.OutgoingChannel, rebalance.LastHop)
+	}
+
+	return nil
+}
+
+// snapshotMissionControl snapshots lnd's mission control before we dispatch a
+// large batch of swaps, so that it can be restored if the swaps' payment
+// attempts penalize routes that are used for other payments. We don't
+// dispatch the batch if we can't take a snapshot.
+func (m *Manager) snapshotMissionControl(ctx context.Context,
+	batch int) error {
+
+	if m.cfg.SnapshotMissionControl == nil ||
+		m.cfg.MissionControlBatch <= 0 ||
+		batch < m.cfg.MissionControlBatch {
+
+		return nil
+	}
+
+	if err := m.cfg.SnapshotMissionControl(ctx); err != nil {
+		return fmt.Errorf("mission control snapshot failed: %v", err)
 	}
 
 	return nil
 }
 
+// dispatchLoopOut dispatches a loop out that was suggested in the evaluation
+// provided, records the rules that it was dispatched for and adjusts the fees
+// of its channels, if we are configured to.
+func (m *Manager) dispatchLoopOut(ctx context.Context,
+	source loopdb.DispatchSource, swap loop.OutRequest,
+	eval *evaluation) (*loop.LoopOutSwapInfo, error) {
+
+	loopOut, err := m.cfg.LoopOut(ctx, &swap)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("suggested loop out dispatched: hash: %v, address: %v",
+		loopOut.SwapHash, loopOut.HtlcAddressP2WSH)
+
+	m.recordDispatch(source, swap, loopOut.SwapHash, eval.channels)
+
+	if m.cfg.AdjustChannelFees == nil {
+		return loopOut, nil
+	}
+
+	// Our swap is already dispatched, so we only log failures to adjust
+	// our fees rather than fail the rest of our swaps.
+	channels := make([]lnwire.ShortChannelID, len(swap.OutgoingChanSet))
+	for i, channel := range swap.OutgoingChanSet {
+		channels[i] = lnwire.NewShortChanIDFromInt(channel)
+	}
+
+	err = m.cfg.AdjustChannelFees(ctx, loopOut.SwapHash, channels)
+	if err != nil {
+		log.Warnf("could not adjust fees of channels: %v for "+
+			"swap: %v: %v", swap.OutgoingChanSet,
+			loopOut.SwapHash, err)
+	}
+
+	return loopOut, nil
+}
+
 // ForceAutoLoop force-ticks our auto-out ticker.
 func (m *Manager) ForceAutoLoop(ctx context.Context) error {
 	select {
@@ -540,6 +921,11 @@ type Suggestions struct {
 	// OutSwaps is the set of loop out swaps that we suggest executing.
 	OutSwaps []loop.OutRequest
 
+	// ElidedOutSwaps is the set of loop out swaps that we would have
+	// suggested, but skipped because they did not fit in our remaining
+	// budget.
+	ElidedOutSwaps []loop.OutRequest
+
 	// DisqualifiedChans maps the set of channels that we do not recommend
 	// swaps on to the reason that we did not recommend a swap.
 	DisqualifiedChans map[lnwire.ShortChannelID]Reason
@@ -547,6 +933,19 @@ type Suggestions struct {
 	// Disqualified peers maps the set of peers that we do not recommend
 	// swaps for to the reason that they were excluded.
 	DisqualifiedPeers map[route.Vertex]Reason
+
+	// CircularComparisons compares each of the loop outs that we would
+	// suggest with a circular rebalance, if comparison is enabled.
+	CircularComparisons []CircularComparison
+
+	// CircularRebalances is the set of circular rebalances that we
+	// suggest in place of more expensive loop outs.
+	CircularRebalances []CircularRebalance
+
+	// ChannelComparisons compares each of the loop outs that we suggest
+	// with buying the same inbound liquidity as a channel, if we have
+	// liquidity service providers configured.
+	ChannelComparisons []ChannelComparison
 }
 
 func newSuggestions() *Suggestions {
@@ -567,6 +966,19 @@ func (s *Suggestions) addSwap(swap swapSuggestion) error {
 	return nil
 }
 
+// addElided adds a swap that was skipped due to budget constraints to our set
+// of suggestions.
+func (s *Suggestions) addElided(swap swapSuggestion) error {
+	out, ok := swap.(*loopOutSwapSuggestion)
+	if !ok {
+		return fmt.Errorf("unexpected swap type: %T", swap)
+	}
+
+	s.ElidedOutSwaps = append(s.ElidedOutSwaps, out.OutRequest)
+
+	return nil
+}
+
 // singleReasonSuggestion is a helper function which returns a set of
 // suggestions where all of our rules are disqualified due to a reason that
 // applies to all of them (such as being out of budget).
@@ -593,6 +1005,23 @@ func (m *Manager) singleReasonSuggestion(reason Reason) *Suggestions {
 func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 	*Suggestions, error) {
 
+	return m.suggestSwaps(ctx, autoloop, &evaluation{})
+}
+
+// evaluation holds the state that we load while suggesting swaps, so that
+// swaps that are dispatched from the suggestions can reuse it rather than
+// querying lnd again.
+type evaluation struct {
+	// channels is the set of channels that were eligible for swaps when
+	// our suggestions were made.
+	channels []lndclient.ChannelInfo
+}
+
+// suggestSwaps returns a set of swap suggestions, recording the state that
+// it loads in the evaluation provided.
+func (m *Manager) suggestSwaps(ctx context.Context, autoloop bool,
+	eval *evaluation) (*Suggestions, error) {
+
 	m.paramsLock.Lock()
 	defer m.paramsLock.Unlock()
 
@@ -606,7 +1035,7 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 	// we should start using our budget at this date. This means that we
 	// have no budget for the present, so we just return.
 	if m.params.AutoFeeStartDate.After(m.cfg.Clock.Now()) {
-		log.Debugf("autoloop fee budget start time: %v is in "+
+		debugThrottled("", "autoloop fee budget start time: %v is in "+
 			"the future", m.params.AutoFeeStartDate)
 
 		return m.singleReasonSuggestion(ReasonBudgetNotStarted), nil
@@ -623,7 +1052,10 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		return nil, err
 	}
 
-	if err := m.params.FeeLimit.mayLoopOut(estimate); err != nil {
+	err = m.params.FeeLimit.mayLoopOut(estimate)
+	m.recordSuggestionFee(estimate, err == nil)
+
+	if err != nil {
 		var reasonErr *reasonError
 		if errors.As(err, &reasonErr) {
 			return m.singleReasonSuggestion(reasonErr.reason), nil
@@ -640,32 +1072,35 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		return nil, err
 	}
 
-	// List our current set of swaps so that we can determine which channels
-	// are already being utilized by swaps. Note that these calls may race
-	// with manual initiation of swaps.
-	loopOut, err := m.cfg.ListLoopOut()
-	if err != nil {
-		return nil, err
-	}
-
-	loopIn, err := m.cfg.ListLoopIn()
+	// Load our current set of swaps so that we can determine which
+	// channels are already being utilized by swaps.
+	history, err := m.loadSwapHistory()
 	if err != nil {
 		return nil, err
 	}
 
 	// Get a summary of our existing swaps so that we can check our autoloop
 	// budget.
-	summary, err := m.checkExistingAutoLoops(ctx, loopOut)
+	summary, err := m.checkExistingAutoLoops(ctx, history)
 	if err != nil {
 		return nil, err
 	}
 
 	if summary.totalFees() >= m.params.AutoFeeBudget {
-		log.Debugf("autoloop fee budget: %v exhausted, %v spent on "+
-			"completed swaps, %v reserved for ongoing swaps "+
-			"(upper limit)",
+		debugThrottled("", "autoloop fee budget: %v exhausted, %v "+
+			"spent on completed swaps, %v reserved for ongoing "+
+			"swaps (upper limit), %v swapped awaiting sweep",
 			m.params.AutoFeeBudget, summary.spentFees,
-			summary.pendingFees)
+			summary.pendingFees, summary.pendingSweep)
+
+		if m.params.AutoFeeRefreshPeriod != 0 {
+			refresh := m.params.budgetStart(m.cfg.Clock.Now()).Add(
+				m.params.AutoFeeRefreshPeriod,
+			)
+
+			debugThrottled("", "autoloop fee budget refreshes at "+
+				"%v", refresh)
+		}
 
 		return m.singleReasonSuggestion(ReasonBudgetElapsed), nil
 	}
@@ -674,21 +1109,58 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 	// swaps, we do not suggest any more at the moment.
 	allowedSwaps := m.params.MaxAutoInFlight - summary.inFlightCount
 	if allowedSwaps <= 0 {
-		log.Debugf("%v autoloops allowed, %v in flight",
+		debugThrottled("", "%v autoloops allowed, %v in flight",
 			m.params.MaxAutoInFlight, summary.inFlightCount)
 
 		return m.singleReasonSuggestion(ReasonInFlight), nil
 	}
 
-	channels, err := m.cfg.Lnd.Client.ListChannels(ctx)
+	// If we have any pending swaps that could affect all of our channels,
+	// we either freeze our suggestions entirely or reserve the channels
+	// they are likely to use, depending on our configured mode.
+	unrestrictedOut, unrestrictedIn := unrestrictedAmounts(
+		history.pendingOut, history.pendingIn,
+	)
+	haveUnrestricted := unrestrictedOut > 0 || unrestrictedIn > 0
+
+	if haveUnrestricted && m.params.UnrestrictedMode == UnrestrictedFreeze {
+		debugThrottled("", "unrestricted swaps pending (loop out: "+
+			"%v, loop in: %v), not suggesting swaps",
+			unrestrictedOut, unrestrictedIn)
+
+		return m.singleReasonSuggestion(ReasonUnrestrictedSwap), nil
+	}
+
+	var (
+		suggestions []swapSuggestion
+		resp        = newSuggestions()
+	)
+
+	channels, ineligible, err := m.getEligibleChannels(ctx)
 	if err != nil {
 		return nil, err
 	}
+	eval.channels = channels
+
+	// Record the reason that we cannot swap for any of our rules that
+	// cover channels or peers that are not eligible for swaps.
+	for channel, reason := range ineligible {
+		if _, ok := m.params.ChannelRules[channel]; ok {
+			resp.DisqualifiedChans[channel] = reason
+		}
+	}
+
+	for peer := range m.params.PeerRules {
+		if !m.params.peerAllowed(peer) {
+			resp.DisqualifiedPeers[peer] = ReasonPeerExcluded
+		}
+	}
 
 	// Collect a map of channel IDs to peer pubkeys, and a set of per-peer
 	// balances which we will use for peer-level liquidity rules.
 	channelPeers := make(map[uint64]route.Vertex)
 	peerChannels := make(map[route.Vertex]*balances)
+	localBalances := make(map[lnwire.ShortChannelID]btcutil.Amount)
 	for _, channel := range channels {
 		channelPeers[channel.ChannelID] = channel.PubKeyBytes
 
@@ -702,19 +1174,22 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		bal.capacity += channel.Capacity
 		bal.incoming += channel.RemoteBalance
 		bal.outgoing += channel.LocalBalance
+		bal.outgoingReserve += OutgoingReserve(channel)
 		bal.pubkey = channel.PubKeyBytes
 
 		peerChannels[channel.PubKeyBytes] = bal
+		localBalances[chanID] = channel.LocalBalance
 	}
 
 	// Get a summary of the channels and peers that are not eligible due
 	// to ongoing swaps.
-	traffic := m.currentSwapTraffic(loopOut, loopIn)
+	traffic := m.currentSwapTraffic(history)
 
-	var (
-		suggestions []swapSuggestion
-		resp        = newSuggestions()
-	)
+	if haveUnrestricted && m.params.UnrestrictedMode == UnrestrictedReserve {
+		traffic.reserveUnrestricted(
+			channels, unrestrictedOut, unrestrictedIn,
+		)
+	}
 
 	for peer, balances := range peerChannels {
 		rule, haveRule := m.params.PeerRules[peer]
@@ -722,6 +1197,15 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 			continue
 		}
 
+		// Rather than excluding peers that have a pending loop out on
+		// one of their channels, we reserve the amount of the pending
+		// swap and only consider the peer's remaining channels.
+		balances = traffic.reservePendingOut(balances, localBalances)
+		if len(balances.channels) == 0 {
+			resp.DisqualifiedPeers[peer] = ReasonLoopOut
+			continue
+		}
+
 		suggestion, err := m.suggestSwap(
 			ctx, traffic, balances, rule, restrictions, autoloop,
 		)
@@ -738,6 +1222,10 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		suggestions = append(suggestions, suggestion)
 	}
 
+	// deficits tracks the swap amounts that our channel rules require
+	// which are too small to swap on their own, keyed by peer.
+	deficits := make(map[route.Vertex]*peerDeficit)
+
 	for _, channel := range channels {
 		balance := newBalances(channel)
 
@@ -754,6 +1242,32 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		var reasonErr *reasonError
 		if errors.As(err, &reasonErr) {
 			resp.DisqualifiedChans[channelID] = reasonErr.reason
+
+			if reasonErr.reason != ReasonLiquidityOk ||
+				!m.params.AggregateDeficits {
+
+				continue
+			}
+
+			// If our channel needs a swap that is below the
+			// server minimum, we record its deficit so that we
+			// can try to combine it with the peer's other
+			// channels.
+			amount := loopOutSwapAmount(
+				balance, rule.MinimumIncoming,
+				rule.MinimumOutgoing,
+			)
+			if amount == 0 {
+				continue
+			}
+
+			deficit, ok := deficits[channel.PubKeyBytes]
+			if !ok {
+				deficit = &peerDeficit{}
+				deficits[channel.PubKeyBytes] = deficit
+			}
+			deficit.add(balance, amount)
+
 			continue
 		}
 
@@ -764,19 +1278,58 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		suggestions = append(suggestions, suggestion)
 	}
 
+	for peer, deficit := range deficits {
+		amount := deficit.swapAmount(restrictions)
+		if amount == 0 {
+			continue
+		}
+
+		log.Debugf("Combining deficits of %v channels with peer: %v "+
+			"into swap of %v", len(deficit.balances.channels),
+			peer, amount)
+
+		swap, err := m.loopOutSwap(
+			ctx, amount, deficit.balances, restrictions, autoloop,
+		)
+
+		var reasonErr *reasonError
+		if errors.As(err, &reasonErr) {
+			reason := reasonErr.reason
+			for _, chanID := range deficit.balances.channels {
+				resp.DisqualifiedChans[chanID] = reason
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, chanID := range deficit.balances.channels {
+			delete(resp.DisqualifiedChans, chanID)
+		}
+
+		suggestions = append(suggestions, &loopOutSwapSuggestion{
+			OutRequest: *swap,
+		})
+	}
+
 	// If we have no swaps to execute after we have applied all of our
 	// limits, just return our set of disqualified swaps.
 	if len(suggestions) == 0 {
 		return resp, nil
 	}
 
-	// Sort suggestions by amount in descending order.
-	sort.SliceStable(suggestions, func(i, j int) bool {
-		return suggestions[i].amount() > suggestions[j].amount()
-	})
+	// Order our suggestions by the priority that we have configured, so
+	// that budget is allocated deterministically.
+	suggestions = prioritize(
+		suggestions, m.params.Priority, m.params.PeerWeights,
+		channelPeers,
+	)
 
-	// Run through our suggested swaps in descending order of amount and
-	// return all of the swaps which will fit within our remaining budget.
+	// Run through our suggested swaps in order of priority and return all
+	// of the swaps which will fit within our remaining budget.
 	available := m.params.AutoFeeBudget - summary.totalFees()
 
 	// setReason is a helper that adds a swap's channels to our disqualified
@@ -818,6 +1371,13 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 
 		if reason != ReasonNone {
 			setReason(reason, swap)
+
+			if reason == ReasonBudgetInsufficient {
+				if err := resp.addElided(swap); err != nil {
+					return nil, err
+				}
+			}
+
 			continue
 		}
 
@@ -834,9 +1394,25 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 			}
 		} else {
 			setReason(ReasonBudgetInsufficient, swap)
+
+			if err := resp.addElided(swap); err != nil {
+				return nil, err
+			}
 		}
 	}
 
+	if m.params.CircularMode != CircularDisabled &&
+		m.cfg.EstimateCircular != nil {
+
+		m.compareCircular(ctx, resp, channels)
+	}
+
+	// We only fetch channel quotes for suggestions that the user asked
+	// for, so that our providers are not queried on every autoloop tick.
+	if !autoloop && m.cfg.QuoteChannels != nil {
+		m.compareChannels(ctx, resp)
+	}
+
 	return resp, nil
 }
 
@@ -852,6 +1428,15 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 		return nil, err
 	}
 
+	// Each rule sets its own cooldown after successful automated swaps,
+	// so we check it for the rule's channels here.
+	err = traffic.cooledDown(
+		balance.channels, rule.SuccessCooldown, m.cfg.Clock.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// We can have nil suggestions in the case where no action is
 	// required, so we skip over them.
 	amount := rule.swapAmount(balance, restrictions)
@@ -859,7 +1444,9 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 		return nil, newReasonError(ReasonLiquidityOk)
 	}
 
-	swap, err := m.loopOutSwap(ctx, amount, balance, autoloop)
+	swap, err := m.loopOutSwap(
+		ctx, amount, balance, restrictions, autoloop,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -872,14 +1459,42 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 // loopOutSwap creates a loop out swap with the amount provided for the balance
 // described by the balance set provided. A reason that indicates whether we
 // can swap is returned. If this value is not ReasonNone, there is no possible
-// swap and the loop out request returned will be nil.
+// swap and the loop out request returned will be nil. If the swap is going to
+// be automatically dispatched and amount jitter is set, the amount is
+// randomized within the restrictions provided before we get a quote for it.
 func (m *Manager) loopOutSwap(ctx context.Context, amount btcutil.Amount,
-	balance *balances, autoloop bool) (*loop.OutRequest, error) {
+	balance *balances, restrictions *Restrictions,
+	autoloop bool) (*loop.OutRequest, error) {
+
+	if autoloop && m.params.AmountJitterPercent != 0 &&
+		m.cfg.RandomAmount != nil {
+
+		jittered, err := jitterAmount(
+			amount, m.params.AmountJitterPercent, restrictions,
+			m.cfg.RandomAmount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debugf("randomized swap amount %v to %v", amount,
+			jittered)
+
+		amount = jittered
+	}
+
+	// Our amount tiers may require larger swaps to sweep sooner than our
+	// parameters do, so we quote with the target that the swap will use.
+	confTarget := m.params.SweepConfTarget
+	tierTarget := m.cfg.AmountTiers.SweepConfTarget(amount)
+	if tierTarget != 0 && tierTarget < confTarget {
+		confTarget = tierTarget
+	}
 
 	quote, err := m.cfg.LoopOutQuote(
 		ctx, &loop.LoopOutQuoteRequest{
 			Amount:                  amount,
-			SweepConfTarget:         m.params.SweepConfTarget,
+			SweepConfTarget:         confTarget,
 			SwapPublicationDeadline: m.cfg.Clock.Now(),
 		},
 	)
@@ -898,12 +1513,19 @@ func (m *Manager) loopOutSwap(ctx context.Context, amount btcutil.Amount,
 	}
 
 	outRequest, err := m.makeLoopOutRequest(
-		ctx, amount, balance, quote, autoloop,
+		ctx, amount, balance, quote, confTarget, autoloop,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	err = m.cfg.AmountTiers.CheckLoopOutFees(&outRequest)
+	if err != nil {
+		log.Debugf("suggestion exceeds amount tier: %v", err)
+
+		return nil, newReasonError(ReasonFeePPMInsufficient)
+	}
+
 	return &outRequest, nil
 }
 
@@ -952,10 +1574,11 @@ func (m *Manager) getSwapRestrictions(ctx context.Context, swapType swap.Type) (
 // to give us some leeway when performing the swap. We take an auto-out which
 // determines whether we set a label identifying this swap as automatically
 // dispatched, and decides whether we set a sweep address (we don't bother for
-// non-auto requests, because the client api will set it anyway).
+// non-auto requests, because the client api will set it anyway). The request
+// sweeps with the confirmation target that its quote was obtained for.
 func (m *Manager) makeLoopOutRequest(ctx context.Context,
 	amount btcutil.Amount, balance *balances, quote *loop.LoopOutQuote,
-	autoloop bool) (loop.OutRequest, error) {
+	confTarget int32, autoloop bool) (loop.OutRequest, error) {
 
 	prepayMaxFee, routeMaxFee, minerFee := m.params.FeeLimit.loopOutFees(
 		amount, quote,
@@ -977,14 +1600,19 @@ func (m *Manager) makeLoopOutRequest(ctx context.Context,
 		MaxMinerFee:         minerFee,
 		MaxSwapFee:          quote.SwapFee,
 		MaxPrepayAmount:     quote.PrepayAmount,
-		SweepConfTarget:     m.params.SweepConfTarget,
+		SweepConfTarget:     confTarget,
 		Initiator:           autoloopSwapInitiator,
 	}
 
 	if autoloop {
-		request.Label = labels.AutoloopLabel(swap.TypeOut)
+		request.Label = m.autoloopLabel(ctx, balance)
+
+		nextAddr := m.cfg.Lnd.WalletKit.NextAddr
+		if m.cfg.SweepAddr != nil {
+			nextAddr = m.cfg.SweepAddr
+		}
 
-		addr, err := m.cfg.Lnd.WalletKit.NextAddr(ctx)
+		addr, err := nextAddr(ctx)
 		if err != nil {
 			return loop.OutRequest{}, err
 		}
@@ -994,6 +1622,42 @@ func (m *Manager) makeLoopOutRequest(ctx context.Context,
 	return request, nil
 }
 
+// autoloopLabel renders our label template for an automatically dispatched
+// swap with the peer and channels in the balance provided. We only look up the
+// peer's alias if our template requires it, and fall back to the peer's pubkey
+// if the lookup fails so that a graph lookup never holds up a swap.
+func (m *Manager) autoloopLabel(ctx context.Context, balance *balances) string {
+	template := m.params.AutoloopLabelTemplate
+
+	values := labels.TemplateValues{
+		SwapType: swap.TypeOut,
+		Peer:     balance.pubkey.String(),
+		Time:     m.cfg.Clock.Now(),
+	}
+
+	for _, channel := range balance.channels {
+		values.Channels = append(
+			values.Channels, fmt.Sprintf("%v", channel.ToUint64()),
+		)
+	}
+
+	if strings.Contains(template, labels.PlaceholderPeerAlias) {
+		info, err := m.cfg.Lnd.Client.GetNodeInfo(
+			ctx, balance.pubkey, false,
+		)
+		switch {
+		case err != nil:
+			log.Warnf("Could not lookup alias for peer: %v: %v",
+				balance.pubkey, err)
+
+		case info.Node != nil:
+			values.PeerAlias = info.Alias
+		}
+	}
+
+	return labels.RenderAutoloopLabel(template, values)
+}
+
 // worstCaseOutFees calculates the largest possible fees for a loop out swap,
 // comparing the fees for a successful swap to the cost when the client pays
 // the prepay because they failed to sweep the on chain htlc. This is unlikely,
@@ -1003,8 +1667,10 @@ func worstCaseOutFees(prepayRouting, swapRouting, swapFee, minerFee,
 	prepayAmount btcutil.Amount) btcutil.Amount {
 
 	var (
-		successFees = prepayRouting + minerFee + swapFee + swapRouting
-		noShowFees  = prepayRouting + prepayAmount
+		successFees = successOutFees(
+			prepayRouting, swapRouting, swapFee, minerFee,
+		)
+		noShowFees = prepayRouting + prepayAmount
 	)
 
 	if noShowFees > successFees {
@@ -1014,6 +1680,14 @@ func worstCaseOutFees(prepayRouting, swapRouting, swapFee, minerFee,
 	return successFees
 }
 
+// successOutFees returns the most that a loop out will pay in fees if it
+// succeeds.
+func successOutFees(prepayRouting, swapRouting, swapFee,
+	minerFee btcutil.Amount) btcutil.Amount {
+
+	return prepayRouting + minerFee + swapFee + swapRouting
+}
+
 // existingAutoLoopSummary provides a summary of the existing autoloops which
 // were dispatched during our current budget period.
 type existingAutoLoopSummary struct {
@@ -1024,6 +1698,11 @@ type existingAutoLoopSummary struct {
 	// flight autoloops.
 	pendingFees btcutil.Amount
 
+	// pendingSweep is the total amount of our in flight autoloops that
+	// have completed off chain, but have not yet had their sweep
+	// confirmed.
+	pendingSweep btcutil.Amount
+
 	// inFlightCount is the total number of automated swaps that are
 	// currently in flight. Note that this may race with swap completion,
 	// but not with initiation of new automated swaps, this is ok, because
@@ -1043,43 +1722,55 @@ func (e *existingAutoLoopSummary) totalFees() btcutil.Amount {
 // total for our set of ongoing, automatically dispatched swaps as well as a
 // current in-flight count.
 func (m *Manager) checkExistingAutoLoops(ctx context.Context,
-	loopOuts []*loopdb.LoopOut) (*existingAutoLoopSummary, error) {
+	history *swapHistory) (*existingAutoLoopSummary, error) {
 
-	var summary existingAutoLoopSummary
+	// Our completed swaps have succeeded or failed, so we just record
+	// the actual fees of those that completed after our budget start date.
+	summary := existingAutoLoopSummary{
+		spentFees: history.autoSpent,
+	}
 
-	for _, out := range loopOuts {
-		if out.Contract.Label != labels.AutoloopLabel(swap.TypeOut) {
+	// For our pending swaps, we are uncertain of the fees that they will
+	// end up paying. We use the worst-case estimate based on the maximum
+	// values we set for each fee category. This will likely over-estimate
+	// our fees (because we probably won't spend our maximum miner amount).
+	for _, out := range history.pendingOut {
+		if !labels.IsAutoloopLabel(out.Contract.Label, swap.TypeOut) {
 			continue
 		}
 
-		// If we have a pending swap, we are uncertain of the fees that
-		// it will end up paying. We use the worst-case estimate based
-		// on the maximum values we set for each fee category. This will
-		// likely over-estimate our fees (because we probably won't
-		// spend our maximum miner amount). If a swap is not pending,
-		// it has succeeded or failed so we just record our actual fees
-		// for the swap provided that the swap completed after our
-		// budget start date.
-		if out.State().State.Type() == loopdb.StateTypePending {
-			summary.inFlightCount++
-
-			prepay, err := m.cfg.Lnd.Client.DecodePaymentRequest(
-				ctx, out.Contract.PrepayInvoice,
-			)
-			if err != nil {
-				return nil, err
-			}
+		summary.inFlightCount++
 
-			summary.pendingFees += worstCaseOutFees(
+		// Once a swap has revealed its preimage, it has completed off
+		// chain and only has its sweep left to confirm. It can no
+		// longer fail as a no-show, so we only need to reserve the
+		// fees of a successful swap rather than our prepay.
+		if pendingSweepAmount(out) != 0 {
+			summary.pendingSweep += out.Contract.AmountRequested
+			summary.pendingFees += successOutFees(
 				out.Contract.MaxPrepayRoutingFee,
 				out.Contract.MaxSwapRoutingFee,
 				out.Contract.MaxSwapFee,
 				out.Contract.MaxMinerFee,
-				mSatToSatoshis(prepay.Value),
 			)
-		} else if !out.LastUpdateTime().Before(m.params.AutoFeeStartDate) {
-			summary.spentFees += out.State().Cost.Total()
+
+			continue
+		}
+
+		prepay, err := m.cfg.Lnd.Client.DecodePaymentRequest(
+			ctx, out.Contract.PrepayInvoice,
+		)
+		if err != nil {
+			return nil, err
 		}
+
+		summary.pendingFees += worstCaseOutFees(
+			out.Contract.MaxPrepayRoutingFee,
+			out.Contract.MaxSwapRoutingFee,
+			out.Contract.MaxSwapFee,
+			out.Contract.MaxMinerFee,
+			mSatToSatoshis(prepay.Value),
+		)
 	}
 
 	return &summary, nil
@@ -1088,9 +1779,7 @@ func (m *Manager) checkExistingAutoLoops(ctx context.Context,
 // currentSwapTraffic examines our existing swaps and returns a summary of the
 // current activity which can be used to determine whether we should perform
 // any swaps.
-func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
-	loopIn []*loopdb.LoopIn) *swapTraffic {
-
+func (m *Manager) currentSwapTraffic(history *swapHistory) *swapTraffic {
 	traffic := newSwapTraffic()
 
 	// Failure cutoff is the most recent failure timestamp we will still
@@ -1098,59 +1787,60 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 	// failed since this point will not be considered.
 	failureCutoff := m.cfg.Clock.Now().Add(m.params.FailureBackOff * -1)
 
-	for _, out := range loopOut {
-		var (
-			state   = out.State().State
-			chanSet = out.Contract.OutgoingChanSet
-		)
-
-		// If a loop out swap failed due to off chain payment after our
-		// failure cutoff, we add all of its channels to a set of
-		// recently failed channels. It is possible that not all of
-		// these channels were used for the swap, but we play it safe
-		// and back off for all of them.
-		//
-		// We only backoff for off temporary failures. In the case of
-		// chain payment failures, our swap failed to route and we do
-		// not want to repeatedly try to route through bad channels
-		// which remain unbalanced because they cannot route a swap, so
-		// we backoff.
-		if state == loopdb.StateFailOffchainPayments {
-			failedAt := out.LastUpdate().Time
-
-			if failedAt.After(failureCutoff) {
-				for _, id := range chanSet {
-					chanID := lnwire.NewShortChanIDFromInt(
-						id,
-					)
-
-					traffic.failedLoopOut[chanID] = failedAt
-				}
-			}
+	// If a loop out failed due to off chain payment after our failure
+	// cutoff, we back off all of its channels. It is possible that not all
+	// of these channels were used for the swap, but we play it safe and
+	// back off for all of them.
+	//
+	// We only backoff for off temporary failures. In the case of chain
+	// payment failures, our swap failed to route and we do not want to
+	// repeatedly try to route through bad channels which remain unbalanced
+	// because they cannot route a swap, so we backoff.
+	for chanID, failedAt := range history.lastFailure {
+		if failedAt.After(failureCutoff) {
+			traffic.failedLoopOut[chanID] = failedAt
 		}
+	}
 
-		// Skip completed swaps, they can't affect our channel balances.
-		// Swaps that fail temporarily are considered to be in a pending
-		// state, so we will also check that channels being used by
-		// these swaps. This is important, because a temporarily failed
-		// swap could be re-dispatched on restart, affecting our
-		// balances.
-		if state.Type() != loopdb.StateTypePending {
-			continue
-		}
+	// We record the last successful automatically dispatched loop out
+	// over each channel, so that the cooldown of each rule can be checked
+	// against the channels that it covers.
+	for chanID, succeededAt := range history.lastAutoSuccess {
+		traffic.lastSuccess[chanID] = succeededAt
+	}
 
-		for _, id := range chanSet {
+	// Completed swaps can't affect our channel balances, so we only check
+	// the channels of our pending swaps. Swaps that fail temporarily are
+	// considered to be in a pending state, so we will also check that
+	// channels being used by these swaps. This is important, because a
+	// temporarily failed swap could be re-dispatched on restart, affecting
+	// our balances.
+	for _, out := range history.pendingOut {
+		var channels []lnwire.ShortChannelID
+		for _, id := range out.Contract.OutgoingChanSet {
 			chanID := lnwire.NewShortChanIDFromInt(id)
 			traffic.ongoingLoopOut[chanID] = true
+
+			channels = append(channels, chanID)
 		}
-	}
 
-	for _, in := range loopIn {
-		// Skip completed swaps, they can't affect our channel balances.
-		if in.State().State.Type() != loopdb.StateTypePending {
+		// Once the swap's invoice has settled, the off-chain portion
+		// of the swap is complete and our channel balances already
+		// reflect it, so we do not need to reserve its amount.
+		state := out.State().State
+		if len(channels) == 0 || state == loopdb.StateInvoiceSettled {
 			continue
 		}
 
+		traffic.pendingLoopOut = append(
+			traffic.pendingLoopOut, pendingLoopOut{
+				channels: channels,
+				amount:   out.Contract.AmountRequested,
+			},
+		)
+	}
+
+	for _, in := range history.pendingIn {
 		// Skip over swaps that may come through any peer.
 		if in.Contract.LastHop == nil {
 			continue
@@ -1162,11 +1852,20 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 	return traffic
 }
 
+// pendingLoopOut describes a pending loop out that is restricted to a set of
+// channels and has not yet shifted our channel balances.
+type pendingLoopOut struct {
+	channels []lnwire.ShortChannelID
+	amount   btcutil.Amount
+}
+
 // swapTraffic contains a summary of our current and previously failed swaps.
 type swapTraffic struct {
 	ongoingLoopOut map[lnwire.ShortChannelID]bool
 	ongoingLoopIn  map[route.Vertex]bool
 	failedLoopOut  map[lnwire.ShortChannelID]time.Time
+	lastSuccess    map[lnwire.ShortChannelID]time.Time
+	pendingLoopOut []pendingLoopOut
 }
 
 func newSwapTraffic() *swapTraffic {
@@ -1174,6 +1873,7 @@ func newSwapTraffic() *swapTraffic {
 		ongoingLoopOut: make(map[lnwire.ShortChannelID]bool),
 		ongoingLoopIn:  make(map[route.Vertex]bool),
 		failedLoopOut:  make(map[lnwire.ShortChannelID]time.Time),
+		lastSuccess:    make(map[lnwire.ShortChannelID]time.Time),
 	}
 }
 
@@ -1185,23 +1885,25 @@ func (s *swapTraffic) maySwap(peer route.Vertex,
 	for _, chanID := range channels {
 		lastFail, recentFail := s.failedLoopOut[chanID]
 		if recentFail {
-			log.Debugf("Channel: %v not eligible for suggestions, was "+
-				"part of a failed swap at: %v", chanID, lastFail)
+			debugThrottled(chanID.String(), "Channel: %v not "+
+				"eligible for suggestions, was part of a "+
+				"failed swap at: %v", chanID, lastFail)
 
 			return newReasonError(ReasonFailureBackoff)
 		}
 
 		if s.ongoingLoopOut[chanID] {
-			log.Debugf("Channel: %v not eligible for suggestions, "+
-				"ongoing loop out utilizing channel", chanID)
+			debugThrottled(chanID.String(), "Channel: %v not "+
+				"eligible for suggestions, ongoing loop out "+
+				"utilizing channel", chanID)
 
 			return newReasonError(ReasonLoopOut)
 		}
 	}
 
 	if s.ongoingLoopIn[peer] {
-		log.Debugf("Peer: %x not eligible for suggestions ongoing "+
-			"loop in utilizing peer", peer)
+		debugThrottled(peer.String(), "Peer: %x not eligible for "+
+			"suggestions ongoing loop in utilizing peer", peer)
 
 		return newReasonError(ReasonLoopIn)
 	}
@@ -1209,6 +1911,79 @@ func (s *swapTraffic) maySwap(peer route.Vertex,
 	return nil
 }
 
+// cooledDown returns an error if any of the channels provided was part of a
+// successful automatically dispatched loop out within the cooldown provided.
+func (s *swapTraffic) cooledDown(channels []lnwire.ShortChannelID,
+	cooldown time.Duration, now time.Time) error {
+
+	if cooldown == 0 {
+		return nil
+	}
+
+	cutoff := now.Add(cooldown * -1)
+	for _, chanID := range channels {
+		lastSuccess, ok := s.lastSuccess[chanID]
+		if !ok || !lastSuccess.After(cutoff) {
+			continue
+		}
+
+		debugThrottled(chanID.String(), "Channel: %v not eligible for "+
+			"suggestions, was part of a successful swap at: %v, "+
+			"cooldown: %v", chanID, lastSuccess, cooldown)
+
+		return newReasonError(ReasonSuccessCooldown)
+	}
+
+	return nil
+}
+
+// reservePendingOut returns a copy of a peer's balances that accounts for the
+// pending loop outs that are restricted to its channels. The amount of each
+// pending swap, capped at the local balance of the peer's channels that the
+// swap may use, is shifted from our outgoing to incoming balance as it will be
+// once the swap completes. Channels that are in use by a pending loop out are
+// removed from the set of channels that a new swap may use.
+func (s *swapTraffic) reservePendingOut(bal *balances,
+	localBalances map[lnwire.ShortChannelID]btcutil.Amount) *balances {
+
+	reserved := &balances{
+		capacity: bal.capacity,
+		incoming: bal.incoming,
+		outgoing: bal.outgoing,
+		pubkey:   bal.pubkey,
+
+		outgoingReserve: bal.outgoingReserve,
+	}
+
+	peerChannels := make(map[lnwire.ShortChannelID]bool, len(bal.channels))
+	for _, chanID := range bal.channels {
+		peerChannels[chanID] = true
+
+		if !s.ongoingLoopOut[chanID] {
+			reserved.channels = append(reserved.channels, chanID)
+		}
+	}
+
+	for _, pending := range s.pendingLoopOut {
+		var available btcutil.Amount
+		for _, chanID := range pending.channels {
+			if peerChannels[chanID] {
+				available += localBalances[chanID]
+			}
+		}
+
+		amount := pending.amount
+		if amount > available {
+			amount = available
+		}
+
+		reserved.outgoing -= amount
+		reserved.incoming += amount
+	}
+
+	return reserved
+}
+
 // satPerKwToSatPerVByte converts sat per kWeight to sat per vByte.
 func satPerKwToSatPerVByte(satPerKw chainfee.SatPerKWeight) int64 {
 	return int64(satPerKw.FeePerKVByte() / 1000)
@@ -2,9 +2,11 @@ package liquidity
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
@@ -32,6 +34,7 @@ var (
 
 	channel1 = lndclient.ChannelInfo{
 		ChannelID:     chanID1.ToUint64(),
+		Active:        true,
 		PubKeyBytes:   peer1,
 		LocalBalance:  10000,
 		RemoteBalance: 0,
@@ -40,6 +43,7 @@ var (
 
 	channel2 = lndclient.ChannelInfo{
 		ChannelID:     chanID2.ToUint64(),
+		Active:        true,
 		PubKeyBytes:   peer2,
 		LocalBalance:  10000,
 		RemoteBalance: 0,
@@ -503,6 +507,7 @@ func TestRestrictedSuggestions(t *testing.T) {
 				channel1,
 				{
 					ChannelID:   chanID3.ToUint64(),
+					Active:      true,
 					PubKeyBytes: peer1,
 				},
 			},
@@ -514,6 +519,62 @@ func TestRestrictedSuggestions(t *testing.T) {
 			peerRules: map[route.Vertex]*ThresholdRule{
 				peer1: NewThresholdRule(0, 50),
 			},
+			expected: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonLiquidityOk,
+				},
+			},
+		},
+		{
+			// Our peer only has 25% incoming liquidity, but once
+			// the loop out that is pending on channel 1 completes
+			// it will have 50%, so we do not need another swap.
+			name: "existing reserved on peer's channel",
+			channels: []lndclient.ChannelInfo{
+				channel1,
+				{
+					ChannelID:     chanID3.ToUint64(),
+					Active:        true,
+					PubKeyBytes:   peer1,
+					LocalBalance:  5000,
+					RemoteBalance: 5000,
+					Capacity:      10000,
+				},
+			},
+			loopOut: []*loopdb.LoopOut{
+				{
+					Contract: &loopdb.LoopOutContract{
+						SwapContract: loopdb.SwapContract{
+							AmountRequested: 5000,
+						},
+						OutgoingChanSet: chan1Out.OutgoingChanSet,
+					},
+				},
+			},
+			peerRules: map[route.Vertex]*ThresholdRule{
+				peer1: NewThresholdRule(50, 0),
+			},
+			expected: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonLiquidityOk,
+				},
+			},
+		},
+		{
+			name: "existing on all peer's channels",
+			channels: []lndclient.ChannelInfo{
+				channel1,
+			},
+			loopOut: []*loopdb.LoopOut{
+				{
+					Contract: chan1Out,
+				},
+			},
+			peerRules: map[route.Vertex]*ThresholdRule{
+				peer1: NewThresholdRule(0, 50),
+			},
 			expected: &Suggestions{
 				DisqualifiedChans: noneDisqualified,
 				DisqualifiedPeers: map[route.Vertex]Reason{
@@ -686,60 +747,753 @@ func TestSuggestSwaps(t *testing.T) {
 				chanID2: NewThresholdRule(10, 10),
 			},
 			suggestions: &Suggestions{
-				DisqualifiedChans: noneDisqualified,
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "multiple peer rules",
+			channels: []lndclient.ChannelInfo{
+				{
+					PubKeyBytes:   peer1,
+					ChannelID:     chanID1.ToUint64(),
+					Active:        true,
+					Capacity:      20000,
+					LocalBalance:  8000,
+					RemoteBalance: 12000,
+				},
+				{
+					PubKeyBytes:   peer1,
+					ChannelID:     chanID2.ToUint64(),
+					Active:        true,
+					Capacity:      10000,
+					LocalBalance:  9000,
+					RemoteBalance: 1000,
+				},
+				{
+					PubKeyBytes:   peer2,
+					ChannelID:     chanID3.ToUint64(),
+					Active:        true,
+					Capacity:      5000,
+					LocalBalance:  2000,
+					RemoteBalance: 3000,
+				},
+			},
+			peerRules: map[route.Vertex]*ThresholdRule{
+				peer1: NewThresholdRule(80, 0),
+				peer2: NewThresholdRule(40, 50),
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					{
+						Amount: expectedAmt,
+						OutgoingChanSet: loopdb.ChannelSet{
+							chanID1.ToUint64(),
+							chanID2.ToUint64(),
+						},
+						MaxPrepayRoutingFee: prepay,
+						MaxSwapRoutingFee:   routing,
+						MaxMinerFee:         scaleMinerFee(testQuote.MinerFee),
+						MaxSwapFee:          testQuote.SwapFee,
+						MaxPrepayAmount:     testQuote.PrepayAmount,
+						SweepConfTarget:     defaultConfTarget,
+						Initiator:           autoloopSwapInitiator,
+					},
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer2: ReasonLiquidityOk,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = testCase.channels
+
+			params := defaultParameters
+			if testCase.rules != nil {
+				params.ChannelRules = testCase.rules
+			}
+
+			if testCase.peerRules != nil {
+				params.PeerRules = testCase.peerRules
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, testCase.err,
+			)
+		})
+	}
+}
+
+// TestPeerLists tests the exclusion of channels from swap suggestions by our
+// peer allow and deny lists.
+func TestPeerLists(t *testing.T) {
+	peerSet := func(peers ...route.Vertex) map[route.Vertex]struct{} {
+		set := make(map[route.Vertex]struct{}, len(peers))
+		for _, peer := range peers {
+			set[peer] = struct{}{}
+		}
+
+		return set
+	}
+
+	chanRules := map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+
+	tests := []struct {
+		name        string
+		rules       map[lnwire.ShortChannelID]*ThresholdRule
+		peerRules   map[route.Vertex]*ThresholdRule
+		allowed     map[route.Vertex]struct{}
+		excluded    map[route.Vertex]struct{}
+		suggestions *Suggestions
+	}{
+		{
+			name:     "peer excluded",
+			rules:    chanRules,
+			excluded: peerSet(peer1),
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonPeerExcluded,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:    "peer not allowed",
+			rules:   chanRules,
+			allowed: peerSet(peer2),
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonPeerExcluded,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "peer rule excluded",
+			peerRules: map[route.Vertex]*ThresholdRule{
+				peer1: chanRule,
+			},
+			excluded: peerSet(peer1),
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonPeerExcluded,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			if testCase.rules != nil {
+				params.ChannelRules = testCase.rules
+			}
+
+			if testCase.peerRules != nil {
+				params.PeerRules = testCase.peerRules
+			}
+
+			params.AllowedPeers = testCase.allowed
+			params.ExcludedPeers = testCase.excluded
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+
+	// Finally, assert that we can't set a peer as both allowed and
+	// excluded.
+	cfg, _ := newTestConfig()
+	manager := NewManager(cfg)
+
+	params := defaultParameters
+	params.AllowedPeers = peerSet(peer1)
+	params.ExcludedPeers = peerSet(peer1)
+
+	err := manager.SetParameters(context.Background(), params)
+	require.True(t, errors.Is(err, ErrPeerAllowedAndExcluded))
+}
+
+// TestChannelActivity tests that inactive channels and channels with peers
+// that fall below our minimum uptime are not used for swaps.
+func TestChannelActivity(t *testing.T) {
+	inactive := channel1
+	inactive.Active = false
+
+	lowUptime := channel1
+	lowUptime.LifeTime = time.Hour
+	lowUptime.Uptime = time.Minute * 30
+
+	highUptime := lowUptime
+	highUptime.Uptime = time.Minute * 54
+
+	chanRules := map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+
+	tests := []struct {
+		name        string
+		channel     lndclient.ChannelInfo
+		minUptime   float64
+		suggestions *Suggestions
+	}{
+		{
+			name:    "channel inactive",
+			channel: inactive,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonChannelInactive,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:      "no uptime requirement",
+			channel:   lowUptime,
+			minUptime: 0,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:      "uptime below minimum",
+			channel:   lowUptime,
+			minUptime: 90,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLowUptime,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:      "uptime above minimum",
+			channel:   highUptime,
+			minUptime: 90,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			// Requiring full uptime disqualifies channel1, whose
+			// peer was only online for 90% of its lifetime.
+			// channel2 has no lifetime recorded by lnd, so it
+			// has no uptime data and remains eligible.
+			name:      "full uptime required",
+			channel:   highUptime,
+			minUptime: 100,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLowUptime,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				testCase.channel, channel2,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = chanRules
+			params.MaxAutoInFlight = 2
+			params.MinUptimePercent = testCase.minUptime
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestClosingChannels tests that channels which are pending close are not
+// used for swaps.
+func TestClosingChannels(t *testing.T) {
+	var (
+		forceClosePoint = wire.OutPoint{Index: 1}
+		waitingPoint    = wire.OutPoint{Index: 2}
+	)
+
+	forceClosed := channel1
+	forceClosed.ChannelPoint = forceClosePoint.String()
+
+	waitingClose := channel2
+	waitingClose.ChannelPoint = waitingPoint.String()
+
+	cfg, lnd := newTestConfig()
+
+	lnd.Channels = []lndclient.ChannelInfo{
+		forceClosed, waitingClose,
+	}
+	lnd.PendingChannels = lndclient.PendingChannels{
+		PendingForceClose: []lndclient.ForceCloseChannel{
+			{
+				PendingChannel: lndclient.PendingChannel{
+					ChannelPoint: &forceClosePoint,
+				},
+			},
+		},
+		WaitingClose: []lndclient.WaitingCloseChannel{
+			{
+				PendingChannel: lndclient.PendingChannel{
+					ChannelPoint: &waitingPoint,
+				},
+			},
+		},
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+
+	expected := &Suggestions{
+		DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+			chanID1: ReasonChannelClosing,
+			chanID2: ReasonChannelClosing,
+		},
+		DisqualifiedPeers: noPeersDisqualified,
+	}
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params), expected, nil,
+	)
+}
+
+// TestSuccessCooldown tests that the rules of channels which were recently
+// part of a successful automated swap do not suggest swaps until the rule's
+// cooldown has passed.
+func TestSuccessCooldown(t *testing.T) {
+	newSuccess := func(label string) *loopdb.LoopOut {
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					{
+						SwapStateData: loopdb.SwapStateData{
+							State: loopdb.StateSuccess,
+						},
+						Time: testTime.Add(time.Hour * -1),
+					},
+				},
+			},
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					Label: label,
+				},
+				OutgoingChanSet: chan1Out.OutgoingChanSet,
+			},
+		}
+	}
+
+	// cooldownRule returns a copy of our channel rule with the cooldown
+	// provided.
+	cooldownRule := func(cooldown time.Duration) *ThresholdRule {
+		rule := *chanRule
+		rule.SuccessCooldown = cooldown
+
+		return &rule
+	}
+
+	var (
+		autoSuccess   = newSuccess(labels.AutoloopLabel(swap.TypeOut))
+		manualSuccess = newSuccess("")
+
+		bothSuggested = &Suggestions{
+			OutSwaps: []loop.OutRequest{
+				chan1Rec, chan2Rec,
+			},
+			DisqualifiedChans: noneDisqualified,
+			DisqualifiedPeers: noPeersDisqualified,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		chanRules   map[lnwire.ShortChannelID]*ThresholdRule
+		peerRules   map[route.Vertex]*ThresholdRule
+		loopOut     *loopdb.LoopOut
+		suggestions *Suggestions
+	}{
+		{
+			name: "no cooldown",
+			chanRules: map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: cooldownRule(0),
+				chanID2: cooldownRule(0),
+			},
+			loopOut:     autoSuccess,
+			suggestions: bothSuggested,
+		},
+		{
+			name: "within cooldown",
+			chanRules: map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: cooldownRule(time.Hour * 2),
+				chanID2: cooldownRule(time.Hour * 2),
+			},
+			loopOut: autoSuccess,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonSuccessCooldown,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "cooldown elapsed",
+			chanRules: map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: cooldownRule(time.Minute * 30),
+				chanID2: cooldownRule(time.Minute * 30),
+			},
+			loopOut:     autoSuccess,
+			suggestions: bothSuggested,
+		},
+		{
+			// Only channel2's rule has a cooldown, and channel2
+			// was not part of the successful swap.
+			name: "cooldown on other rule",
+			chanRules: map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: cooldownRule(0),
+				chanID2: cooldownRule(time.Hour * 2),
+			},
+			loopOut:     autoSuccess,
+			suggestions: bothSuggested,
+		},
+		{
+			name: "manual swap",
+			chanRules: map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: cooldownRule(time.Hour * 2),
+				chanID2: cooldownRule(time.Hour * 2),
+			},
+			loopOut:     manualSuccess,
+			suggestions: bothSuggested,
+		},
+		{
+			name: "peer rule within cooldown",
+			peerRules: map[route.Vertex]*ThresholdRule{
+				peer1: cooldownRule(time.Hour * 2),
+			},
+			loopOut: autoSuccess,
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonSuccessCooldown,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
+				return []*loopdb.LoopOut{testCase.loopOut}, nil
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			if testCase.chanRules != nil {
+				params.ChannelRules = testCase.chanRules
+			}
+
+			if testCase.peerRules != nil {
+				params.PeerRules = testCase.peerRules
+			}
+			params.MaxAutoInFlight = 2
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestPrivateChannels tests that we suggest loop outs over private channels
+// without any special handling, because we pay the swap over our own channel
+// and do not need route hints to do so.
+func TestPrivateChannels(t *testing.T) {
+	cfg, lnd := newTestConfig()
+
+	private := channel1
+	private.Private = true
+
+	lnd.Channels = []lndclient.ChannelInfo{
+		private,
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+	}
+
+	expected := &Suggestions{
+		OutSwaps: []loop.OutRequest{
+			chan1Rec,
+		},
+		DisqualifiedChans: noneDisqualified,
+		DisqualifiedPeers: noPeersDisqualified,
+	}
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params), expected, nil,
+	)
+}
+
+// TestUnrestrictedModes tests the handling of pending swaps that are not
+// restricted to channels or peers for each of our unrestricted modes.
+func TestUnrestrictedModes(t *testing.T) {
+	var (
+		unrestrictedOut = &loopdb.LoopOut{
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: 5000,
+				},
+			},
+		}
+
+		unrestrictedIn = &loopdb.LoopIn{
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: 5000,
+				},
+			},
+		}
+
+		// inbound is a channel with peer2 that has remote balance,
+		// so it may be used by an unrestricted loop in.
+		inbound = lndclient.ChannelInfo{
+			ChannelID:     chanID2.ToUint64(),
+			Active:        true,
+			PubKeyBytes:   peer2,
+			LocalBalance:  2000,
+			RemoteBalance: 8000,
+			Capacity:      10000,
+		}
+
+		chanRules = map[lnwire.ShortChannelID]*ThresholdRule{
+			chanID1: chanRule,
+			chanID2: chanRule,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		mode        UnrestrictedMode
+		channels    []lndclient.ChannelInfo
+		loopOut     []*loopdb.LoopOut
+		loopIn      []*loopdb.LoopIn
+		suggestions *Suggestions
+	}{
+		{
+			name:     "ignore",
+			mode:     UnrestrictedIgnore,
+			channels: []lndclient.ChannelInfo{channel1, channel2},
+			loopOut:  []*loopdb.LoopOut{unrestrictedOut},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "freeze",
+			mode:     UnrestrictedFreeze,
+			channels: []lndclient.ChannelInfo{channel1, channel2},
+			loopIn:   []*loopdb.LoopIn{unrestrictedIn},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonUnrestrictedSwap,
+					chanID2: ReasonUnrestrictedSwap,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			// Both channels have equal outgoing balance, so we
+			// only need to reserve the first one to cover our
+			// unrestricted loop out.
+			name:     "reserve loop out",
+			mode:     UnrestrictedReserve,
+			channels: []lndclient.ChannelInfo{channel1, channel2},
+			loopOut:  []*loopdb.LoopOut{unrestrictedOut},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLoopOut,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			// Only our channel with peer2 has incoming balance, so
+			// it is the only one that we reserve for loop in.
+			name:     "reserve loop in",
+			mode:     UnrestrictedReserve,
+			channels: []lndclient.ChannelInfo{channel1, inbound},
+			loopIn:   []*loopdb.LoopIn{unrestrictedIn},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonLoopIn,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = testCase.channels
+
+			cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
+				return testCase.loopOut, nil
+			}
+			cfg.ListLoopIn = func() ([]*loopdb.LoopIn, error) {
+				return testCase.loopIn, nil
+			}
+
+			params := defaultParameters
+			params.ChannelRules = chanRules
+			params.MaxAutoInFlight = 2
+			params.UnrestrictedMode = testCase.mode
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestAggregateDeficits tests combining of swap amounts that are below the
+// server minimum across several of a peer's channels.
+func TestAggregateDeficits(t *testing.T) {
+	channel3 := lndclient.ChannelInfo{
+		ChannelID:     chanID3.ToUint64(),
+		Active:        true,
+		PubKeyBytes:   peer1,
+		LocalBalance:  10000,
+		RemoteBalance: 0,
+		Capacity:      10000,
+	}
+
+	// Each of our channels requires a swap of 7500 sats, which is below
+	// our minimum. Combined, they exceed our maximum, so we expect a
+	// single swap of our maximum amount.
+	restrictions := NewRestrictions(8000, 10000)
+
+	prepay, routing := testPPMFees(defaultFeePPM, testQuote, 10000)
+	combined := chan1Rec
+	combined.Amount = 10000
+	combined.OutgoingChanSet = loopdb.ChannelSet{
+		chanID1.ToUint64(), chanID3.ToUint64(),
+	}
+	combined.MaxPrepayRoutingFee = prepay
+	combined.MaxSwapRoutingFee = routing
+
+	tests := []struct {
+		name        string
+		aggregate   bool
+		suggestions *Suggestions
+	}{
+		{
+			name:      "not aggregated",
+			aggregate: false,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLiquidityOk,
+					chanID2: ReasonLiquidityOk,
+					chanID3: ReasonLiquidityOk,
+				},
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
 		{
-			name: "multiple peer rules",
-			channels: []lndclient.ChannelInfo{
-				{
-					PubKeyBytes:   peer1,
-					ChannelID:     chanID1.ToUint64(),
-					Capacity:      20000,
-					LocalBalance:  8000,
-					RemoteBalance: 12000,
-				},
-				{
-					PubKeyBytes:   peer1,
-					ChannelID:     chanID2.ToUint64(),
-					Capacity:      10000,
-					LocalBalance:  9000,
-					RemoteBalance: 1000,
-				},
-				{
-					PubKeyBytes:   peer2,
-					ChannelID:     chanID3.ToUint64(),
-					Capacity:      5000,
-					LocalBalance:  2000,
-					RemoteBalance: 3000,
-				},
-			},
-			peerRules: map[route.Vertex]*ThresholdRule{
-				peer1: NewThresholdRule(80, 0),
-				peer2: NewThresholdRule(40, 50),
-			},
+			// Our deficit with peer2 is still below the minimum,
+			// so we do not suggest a swap for its channel.
+			name:      "aggregated",
+			aggregate: true,
 			suggestions: &Suggestions{
 				OutSwaps: []loop.OutRequest{
-					{
-						Amount: expectedAmt,
-						OutgoingChanSet: loopdb.ChannelSet{
-							chanID1.ToUint64(),
-							chanID2.ToUint64(),
-						},
-						MaxPrepayRoutingFee: prepay,
-						MaxSwapRoutingFee:   routing,
-						MaxMinerFee:         scaleMinerFee(testQuote.MinerFee),
-						MaxSwapFee:          testQuote.SwapFee,
-						MaxPrepayAmount:     testQuote.PrepayAmount,
-						SweepConfTarget:     defaultConfTarget,
-						Initiator:           autoloopSwapInitiator,
-					},
+					combined,
 				},
-				DisqualifiedChans: noneDisqualified,
-				DisqualifiedPeers: map[route.Vertex]Reason{
-					peer2: ReasonLiquidityOk,
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonLiquidityOk,
 				},
+				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
 	}
@@ -749,21 +1503,27 @@ func TestSuggestSwaps(t *testing.T) {
 
 		t.Run(testCase.name, func(t *testing.T) {
 			cfg, lnd := newTestConfig()
+			cfg.Restrictions = func(_ context.Context,
+				_ swap.Type) (*Restrictions, error) {
 
-			lnd.Channels = testCase.channels
+				return restrictions, nil
+			}
 
-			params := defaultParameters
-			if testCase.rules != nil {
-				params.ChannelRules = testCase.rules
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2, channel3,
 			}
 
-			if testCase.peerRules != nil {
-				params.PeerRules = testCase.peerRules
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: chanRule,
+				chanID2: chanRule,
+				chanID3: chanRule,
 			}
+			params.AggregateDeficits = testCase.aggregate
 
 			testSuggestSwaps(
 				t, newSuggestSwapsSetup(cfg, lnd, params),
-				testCase.suggestions, testCase.err,
+				testCase.suggestions, nil,
 			)
 		})
 	}
@@ -917,6 +1677,9 @@ func TestFeeBudget(t *testing.T) {
 		// maxMinerFee is the maximum miner fee we will pay for swaps.
 		maxMinerFee btcutil.Amount
 
+		// refresh is the period that our budget is replenished at.
+		refresh time.Duration
+
 		// existingSwaps represents our existing swaps, mapping their
 		// last update time to their total cost.
 		existingSwaps map[time.Time]btcutil.Amount
@@ -948,6 +1711,9 @@ func TestFeeBudget(t *testing.T) {
 				OutSwaps: []loop.OutRequest{
 					chan1,
 				},
+				ElidedOutSwaps: []loop.OutRequest{
+					chan2,
+				},
 				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
 					chanID2: ReasonBudgetInsufficient,
 				},
@@ -984,6 +1750,50 @@ func TestFeeBudget(t *testing.T) {
 				OutSwaps: []loop.OutRequest{
 					chan1,
 				},
+				ElidedOutSwaps: []loop.OutRequest{
+					chan2,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonBudgetInsufficient,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			// Our budget start is an hour before our current
+			// time, so a half hour refresh period starts a new
+			// period now, and the existing swap is in our last
+			// period.
+			name:        "existing swaps, before refreshed period",
+			budget:      10156,
+			maxMinerFee: 5000,
+			refresh:     time.Minute * 30,
+			existingSwaps: map[time.Time]btcutil.Amount{
+				testBudgetStart.Add(time.Minute * 45): 500,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1, chan2,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "existing swaps, in refreshed period",
+			budget:      10156,
+			maxMinerFee: 5000,
+			refresh:     time.Minute * 30,
+			existingSwaps: map[time.Time]btcutil.Amount{
+				testBudgetStart.Add(time.Hour): 500,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1,
+				},
+				ElidedOutSwaps: []loop.OutRequest{
+					chan2,
+				},
 				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
 					chanID2: ReasonBudgetInsufficient,
 				},
@@ -1066,6 +1876,7 @@ func TestFeeBudget(t *testing.T) {
 				chanID2: chanRule,
 			}
 			params.AutoFeeStartDate = testBudgetStart
+			params.AutoFeeRefreshPeriod = testCase.refresh
 			params.AutoFeeBudget = testCase.budget
 			params.MaxAutoInFlight = 2
 			params.FeeLimit = NewFeeCategoryLimit(
@@ -1091,6 +1902,112 @@ func TestFeeBudget(t *testing.T) {
 	}
 }
 
+// prepayLightningClient is a lightning client that decodes every payment
+// request as a prepay invoice for a fixed amount.
+type prepayLightningClient struct {
+	lndclient.LightningClient
+
+	amount lnwire.MilliSatoshi
+}
+
+// DecodePaymentRequest returns a payment request for our prepay amount.
+func (p *prepayLightningClient) DecodePaymentRequest(_ context.Context,
+	_ string) (*lndclient.PaymentRequest, error) {
+
+	return &lndclient.PaymentRequest{
+		Value: p.amount,
+	}, nil
+}
+
+// TestPendingSweepBudget tests that automatically dispatched loop outs that
+// have completed off chain, and are waiting for their sweep to confirm, only
+// reserve the fees of a successful swap from our budget.
+func TestPendingSweepBudget(t *testing.T) {
+	// Our existing swap has a prepay that exceeds our budget, so we can't
+	// afford to reserve it for the case where the swap fails as a no-show.
+	prepay := lnwire.NewMSatFromSatoshis(20000)
+
+	contract := *autoOutContract
+	contract.AmountRequested = 5000
+	contract.MaxMinerFee = 100
+
+	tests := []struct {
+		name        string
+		state       loopdb.SwapState
+		suggestions *Suggestions
+	}{
+		{
+			name:  "prepay may be lost",
+			state: loopdb.StateInitiated,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonBudgetElapsed,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:  "pending sweep",
+			state: loopdb.StatePreimageRevealed,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Client = &prepayLightningClient{
+				LightningClient: lnd.Client,
+				amount:          prepay,
+			}
+
+			event := &loopdb.LoopEvent{
+				SwapStateData: loopdb.SwapStateData{
+					State: testCase.state,
+				},
+				Time: testBudgetStart,
+			}
+
+			existing := &loopdb.LoopOut{
+				Loop: loopdb.Loop{
+					Events: []*loopdb.LoopEvent{event},
+				},
+				Contract: &contract,
+			}
+
+			cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
+				return []*loopdb.LoopOut{existing}, nil
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: chanRule,
+			}
+			params.AutoFeeStartDate = testBudgetStart
+			params.AutoFeeBudget = 10000
+			params.MaxAutoInFlight = 2
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
 // TestInFlightLimit tests the limit we place on the number of in-flight swaps
 // that are allowed.
 func TestInFlightLimit(t *testing.T) {
@@ -1534,6 +2451,125 @@ func TestFeePercentage(t *testing.T) {
 	}
 }
 
+// TestAmountTiers tests that suggestions sweep with the confirmation target
+// of their amount's tier, and are not made if their fees exceed the tier's
+// maximum. Our test is setup to require a 7500 sat swap with fees limited to
+// 3% of its amount.
+func TestAmountTiers(t *testing.T) {
+	var (
+		feePPM uint64 = 30000
+		quote         = &loop.LoopOutQuote{
+			SwapFee:      15,
+			PrepayAmount: 30,
+			MinerFee:     1,
+		}
+
+		rec = loop.OutRequest{
+			Amount:          7500,
+			OutgoingChanSet: loopdb.ChannelSet{chanID1.ToUint64()},
+			MaxMinerFee:     scaleMinerFee(quote.MinerFee),
+			MaxSwapFee:      quote.SwapFee,
+			MaxPrepayAmount: quote.PrepayAmount,
+			SweepConfTarget: defaultConfTarget,
+			Initiator:       autoloopSwapInitiator,
+		}
+	)
+
+	rec.MaxPrepayRoutingFee, rec.MaxSwapRoutingFee = testPPMFees(
+		feePPM, quote, 7500,
+	)
+
+	tieredRec := rec
+	tieredRec.SweepConfTarget = 10
+
+	tests := []struct {
+		name        string
+		tiers       loop.AmountTiers
+		suggestions *Suggestions
+	}{
+		{
+			name: "tier applies",
+			tiers: loop.AmountTiers{
+				{
+					MinAmount:       5000,
+					SweepConfTarget: 10,
+					MaxFeePPM:       feePPM,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					tieredRec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "tier for larger amounts",
+			tiers: loop.AmountTiers{
+				{
+					MinAmount:       10000,
+					SweepConfTarget: 10,
+					MaxFeePPM:       1000,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "tier fee exceeded",
+			tiers: loop.AmountTiers{
+				{
+					MinAmount: 5000,
+					MaxFeePPM: 20000,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonFeePPMInsufficient,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.AmountTiers = testCase.tiers
+
+			cfg.LoopOutQuote = func(_ context.Context,
+				_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
+				error) {
+
+				return quote, nil
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			params := defaultParameters
+			params.FeeLimit = NewFeePortion(feePPM)
+			params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: chanRule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
 // testSuggestSwapsSetup contains the elements that are used to create a
 // suggest swaps test.
 type testSuggestSwapsSetup struct {
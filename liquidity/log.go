@@ -2,6 +2,7 @@ package liquidity
 
 import (
 	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/loop/logging"
 	"github.com/lightningnetwork/lnd/build"
 )
 
@@ -24,3 +25,13 @@ func init() {
 func UseLogger(logger btclog.Logger) {
 	log = logger
 }
+
+// debugThrottled logs a debug message that relates to the object (such as a
+// channel or peer) identified by id, unless the same message was logged for
+// the object recently. It should be used for messages that repeat on every
+// autoloop tick.
+func debugThrottled(id, format string, params ...interface{}) {
+	logging.DefaultThrottle.Logf(
+		log, btclog.LevelDebug, Subsystem, id, format, params...,
+	)
+}
@@ -0,0 +1,108 @@
+package liquidity
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+)
+
+// ChannelQuote is the price that a liquidity service provider quoted for
+// opening a channel to us, which gives us inbound liquidity without a swap.
+type ChannelQuote struct {
+	// Provider identifies the provider that quoted the channel.
+	Provider string
+
+	// Capacity is the inbound capacity of the quoted channel.
+	Capacity btcutil.Amount
+
+	// Fee is the total fee that the provider charges for the channel.
+	Fee btcutil.Amount
+
+	// LeaseBlocks is the number of blocks that the provider commits to
+	// keeping the channel open for, or zero if it makes no commitment.
+	LeaseBlocks uint32
+}
+
+// ChannelComparison compares the fees of a suggested loop out with the price
+// of buying the same inbound liquidity as a channel from a liquidity service
+// provider.
+type ChannelComparison struct {
+	// LoopOut is the suggested loop out.
+	LoopOut loop.OutRequest
+
+	// LoopOutFees is the quoted swap fee and estimated on-chain fee of the
+	// loop out. Off-chain routing fees are not quoted, so they are not
+	// included.
+	LoopOutFees btcutil.Amount
+
+	// Quotes are the channel quotes that our providers returned for the
+	// loop out's amount, cheapest first.
+	Quotes []ChannelQuote
+
+	// ChannelCheaper is true if the cheapest quote costs less than the
+	// loop out's fees.
+	ChannelCheaper bool
+}
+
+// channelQuoteTimeout is the total amount of time that we spend fetching
+// channel quotes for our suggestions, so that slow providers can't hold up
+// our suggestions.
+const channelQuoteTimeout = 30 * time.Second
+
+// compareChannels fetches quotes for buying an inbound channel of the amount
+// of each of our suggested loop outs, and reports how they compare with the
+// loop out's quoted fees. Our suggestions themselves are not changed, because
+// buying a channel is left to the user. Loop outs that we can't get quotes
+// for within channelQuoteTimeout are not compared.
+func (m *Manager) compareChannels(ctx context.Context, resp *Suggestions) {
+	ctx, cancel := context.WithTimeout(ctx, channelQuoteTimeout)
+	defer cancel()
+
+	for _, out := range resp.OutSwaps {
+		// We compare with the fees that the loop out is quoted, rather
+		// than the fee limits of the suggestion, which are the most
+		// that it may spend.
+		quote, err := m.cfg.LoopOutQuote(
+			ctx, &loop.LoopOutQuoteRequest{
+				Amount:                  out.Amount,
+				SweepConfTarget:         out.SweepConfTarget,
+				SwapPublicationDeadline: m.cfg.Clock.Now(),
+			},
+		)
+		if err != nil {
+			log.Warnf("could not quote loop out of %v: %v",
+				out.Amount, err)
+
+			continue
+		}
+
+		quotes, err := m.cfg.QuoteChannels(ctx, out.Amount)
+		if err != nil {
+			log.Warnf("could not quote channels of %v: %v",
+				out.Amount, err)
+
+			continue
+		}
+
+		sort.SliceStable(quotes, func(i, j int) bool {
+			return quotes[i].Fee < quotes[j].Fee
+		})
+
+		comparison := ChannelComparison{
+			LoopOut:     out,
+			LoopOutFees: quote.SwapFee + quote.MinerFee,
+			Quotes:      quotes,
+		}
+
+		if len(quotes) > 0 && quotes[0].Fee < comparison.LoopOutFees {
+			comparison.ChannelCheaper = true
+		}
+
+		resp.ChannelComparisons = append(
+			resp.ChannelComparisons, comparison,
+		)
+	}
+}
@@ -0,0 +1,143 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelComparisons tests comparison of suggested loop outs with the
+// channel quotes of our liquidity service providers.
+func TestChannelComparisons(t *testing.T) {
+	// Channels are compared with the quoted fees of the loop out, rather
+	// than its fee limits.
+	loopOutFees := testQuote.SwapFee + testQuote.MinerFee
+
+	cheap := ChannelQuote{
+		Provider: "cheap",
+		Capacity: chan1Rec.Amount,
+		Fee:      loopOutFees - 1,
+	}
+
+	expensive := ChannelQuote{
+		Provider:    "expensive",
+		Capacity:    chan1Rec.Amount,
+		Fee:         loopOutFees,
+		LeaseBlocks: 4032,
+	}
+
+	tests := []struct {
+		name        string
+		quotes      []ChannelQuote
+		quoteErr    error
+		suggestions *Suggestions
+	}{
+		{
+			name:   "channel cheaper",
+			quotes: []ChannelQuote{expensive, cheap},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				ChannelComparisons: []ChannelComparison{{
+					LoopOut:     chan1Rec,
+					LoopOutFees: loopOutFees,
+					Quotes: []ChannelQuote{
+						cheap, expensive,
+					},
+					ChannelCheaper: true,
+				}},
+			},
+		},
+		{
+			name:   "loop out cheaper",
+			quotes: []ChannelQuote{expensive},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				ChannelComparisons: []ChannelComparison{{
+					LoopOut:     chan1Rec,
+					LoopOutFees: loopOutFees,
+					Quotes: []ChannelQuote{
+						expensive,
+					},
+				}},
+			},
+		},
+		{
+			name: "no quotes",
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				ChannelComparisons: []ChannelComparison{{
+					LoopOut:     chan1Rec,
+					LoopOutFees: loopOutFees,
+				}},
+			},
+		},
+		{
+			name:     "quote failed",
+			quoteErr: errors.New("unavailable"),
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			cfg.QuoteChannels = func(_ context.Context,
+				capacity btcutil.Amount) ([]ChannelQuote,
+				error) {
+
+				require.Equal(t, chan1Rec.Amount, capacity)
+
+				// We return a copy of our quotes, because
+				// they are sorted in place.
+				quotes := append(
+					[]ChannelQuote(nil), testCase.quotes...,
+				)
+
+				return quotes, testCase.quoteErr
+			}
+
+			chanRules := map[lnwire.ShortChannelID]*ThresholdRule{
+				chanID1: chanRule,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = chanRules
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package liquidity
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// peerPolicyWindow is the period that we compare our peers' current
+	// fee policies with. A peer's fee is considered to have increased if
+	// it is higher than the lowest fee that it charged over this period.
+	peerPolicyWindow = time.Hour * 24
+
+	// peerFeeReferenceAmount is the amount that we compare the fees that
+	// our peers' policies charge for, so that changes to the base fee and
+	// the fee rate of a policy are weighed together.
+	peerFeeReferenceAmount = lnwire.MilliSatoshi(1000000000)
+)
+
+// policyObservation is the fee that a peer's policy for one of our channels
+// charged when we looked it up.
+type policyObservation struct {
+	// timestamp is the time that we observed the policy.
+	timestamp time.Time
+
+	// fee is the fee that the policy charges for our reference amount.
+	fee lnwire.MilliSatoshi
+}
+
+// peerPolicies tracks the fees that our peers charge to forward over our
+// channels to us, so that we can detect peers that raise their fees
+// dramatically. Observations are only held in memory, so we start over with
+// no history when we restart.
+type peerPolicies struct {
+	// observations holds the fees that we observed for each channel, in
+	// the order that we observed them. We only record a new observation
+	// when a channel's fee changes.
+	observations map[lnwire.ShortChannelID][]policyObservation
+
+	sync.Mutex
+}
+
+// newPeerPolicies returns a tracker with no observations.
+func newPeerPolicies() *peerPolicies {
+	return &peerPolicies{
+		observations: make(
+			map[lnwire.ShortChannelID][]policyObservation,
+		),
+	}
+}
+
+// observe records the fee that a peer charges for a channel at the time
+// provided. It returns the lowest fee that we observed for the channel over
+// our policy window, and false if we have no earlier observations for the
+// channel.
+func (p *peerPolicies) observe(chanID lnwire.ShortChannelID, now time.Time,
+	fee lnwire.MilliSatoshi) (lnwire.MilliSatoshi, bool) {
+
+	p.Lock()
+	defer p.Unlock()
+
+	// We drop all observations that were replaced before our window
+	// started. The last observation before the window is kept, because
+	// its fee was still in effect when the window started.
+	observations := p.observations[chanID]
+	cutoff := now.Add(peerPolicyWindow * -1)
+	for len(observations) > 1 && !observations[1].timestamp.After(cutoff) {
+		observations = observations[1:]
+	}
+
+	var (
+		lowest lnwire.MilliSatoshi
+		found  = len(observations) > 0
+	)
+	for i, observation := range observations {
+		if i == 0 || observation.fee < lowest {
+			lowest = observation.fee
+		}
+	}
+
+	if !found || observations[len(observations)-1].fee != fee {
+		observations = append(observations, policyObservation{
+			timestamp: now,
+			fee:       fee,
+		})
+	}
+	p.observations[chanID] = observations
+
+	return lowest, found
+}
+
+// feeIncreaseExceeded returns true if the current fee is higher than the
+// earlier fee provided by more than the percentage provided.
+func feeIncreaseExceeded(earlier, current lnwire.MilliSatoshi,
+	maxIncreasePercent uint64) bool {
+
+	limit := uint64(earlier) * (100 + maxIncreasePercent)
+	return uint64(current)*100 > limit
+}
+
+// peerFeeIncreased returns true if the peer of a channel has raised the fee
+// that it charges to forward over the channel to us by more than our maximum
+// increase over our policy window. Swaps through such a peer may cost far
+// more than the fees we estimated from its history. If we can't find the
+// peer's policy, we do not hold up swaps over the channel.
+func (m *Manager) peerFeeIncreased(ctx context.Context,
+	channel lndclient.ChannelInfo,
+	nodes map[route.Vertex]*lndclient.NodeInfo) bool {
+
+	chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+
+	info, ok := nodes[channel.PubKeyBytes]
+	if !ok {
+		var err error
+		info, err = m.cfg.Lnd.Client.GetNodeInfo(
+			ctx, channel.PubKeyBytes, true,
+		)
+		if err != nil {
+			log.Warnf("Could not lookup policies of peer: %v: %v",
+				channel.PubKeyBytes, err)
+		}
+
+		// We cache failed lookups as well, so that we only look up
+		// each peer once.
+		nodes[channel.PubKeyBytes] = info
+	}
+
+	if info == nil {
+		return false
+	}
+
+	var policy *lndclient.RoutingPolicy
+	for _, edge := range info.Channels {
+		if edge.ChannelID != channel.ChannelID {
+			continue
+		}
+
+		policy = edge.Node2Policy
+		if edge.Node1 == channel.PubKeyBytes {
+			policy = edge.Node1Policy
+		}
+
+		break
+	}
+
+	if policy == nil {
+		return false
+	}
+
+	fee := lnwire.MilliSatoshi(policy.FeeBaseMsat) +
+		peerFeeReferenceAmount*lnwire.MilliSatoshi(
+			policy.FeeRateMilliMsat,
+		)/1000000
+
+	lowest, ok := m.peerPolicies.observe(chanID, m.cfg.Clock.Now(), fee)
+	if !ok {
+		return false
+	}
+
+	maxIncrease := m.params.MaxPeerFeeIncreasePercent
+	if !feeIncreaseExceeded(lowest, fee, maxIncrease) {
+		return false
+	}
+
+	debugThrottled(chanID.String(), "Channel: %v not eligible, peer: "+
+		"%v raised fee from %v to %v", chanID, channel.PubKeyBytes,
+		lowest, fee)
+
+	return true
+}
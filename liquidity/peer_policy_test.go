@@ -0,0 +1,140 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPeerPoliciesObserve tests tracking of the lowest fee that a peer
+// charged for a channel over our policy window.
+func TestPeerPoliciesObserve(t *testing.T) {
+	policies := newPeerPolicies()
+
+	// We have no earlier observations for the first fee we observe.
+	_, ok := policies.observe(chanID1, testTime, 100)
+	require.False(t, ok)
+
+	// Once the fee rises, the earlier fee is the lowest in our window.
+	lowest, ok := policies.observe(chanID1, testTime.Add(time.Hour), 300)
+	require.True(t, ok)
+	require.Equal(t, lnwire.MilliSatoshi(100), lowest)
+
+	// Observations of other channels are tracked separately.
+	_, ok = policies.observe(chanID2, testTime.Add(time.Hour), 300)
+	require.False(t, ok)
+
+	// Our first fee was replaced within our window, so it is still the
+	// lowest fee until the window has passed since it was replaced.
+	now := testTime.Add(peerPolicyWindow)
+	lowest, ok = policies.observe(chanID1, now, 300)
+	require.True(t, ok)
+	require.Equal(t, lnwire.MilliSatoshi(100), lowest)
+
+	now = testTime.Add(peerPolicyWindow + time.Hour)
+	lowest, ok = policies.observe(chanID1, now, 300)
+	require.True(t, ok)
+	require.Equal(t, lnwire.MilliSatoshi(300), lowest)
+
+	// Fees that are unchanged are not recorded again.
+	require.Len(t, policies.observations[chanID1], 1)
+}
+
+// TestFeeIncreaseExceeded tests comparison of fee increases with our maximum
+// increase.
+func TestFeeIncreaseExceeded(t *testing.T) {
+	require.False(t, feeIncreaseExceeded(100, 100, 50))
+	require.False(t, feeIncreaseExceeded(100, 150, 50))
+	require.True(t, feeIncreaseExceeded(100, 151, 50))
+	require.False(t, feeIncreaseExceeded(100, 50, 50))
+	require.True(t, feeIncreaseExceeded(0, 1, 50))
+}
+
+// TestPeerFeeIncrease tests that channels whose peer recently raised the fee
+// that it charges to forward to us are not used for swaps.
+func TestPeerFeeIncrease(t *testing.T) {
+	cfg, lnd := newTestConfig()
+
+	testClock := clock.NewTestClock(testTime)
+	cfg.Clock = testClock
+
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1, channel2,
+	}
+
+	// We only know the policy of our first peer, so our second channel
+	// is never disqualified.
+	policy := &lndclient.RoutingPolicy{
+		FeeBaseMsat:      1000,
+		FeeRateMilliMsat: 100,
+	}
+	lnd.NodeInfos = map[route.Vertex]*lndclient.NodeInfo{
+		peer1: {
+			Channels: []lndclient.ChannelEdge{
+				{
+					ChannelID:   chanID1.ToUint64(),
+					Node1:       peer1,
+					Node2:       route.Vertex{9},
+					Node1Policy: policy,
+				},
+			},
+		},
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+	params.MaxAutoInFlight = 2
+	params.MaxPeerFeeIncreasePercent = 100
+
+	manager := NewManager(cfg)
+
+	ctx := context.Background()
+	err := manager.SetParameters(ctx, params)
+	require.NoError(t, err)
+
+	bothChannels := &Suggestions{
+		OutSwaps: []loop.OutRequest{
+			chan1Rec, chan2Rec,
+		},
+		DisqualifiedChans: noneDisqualified,
+		DisqualifiedPeers: noPeersDisqualified,
+	}
+
+	suggestions, err := manager.SuggestSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, bothChannels, suggestions)
+
+	// Our peer triples its fee, which exceeds our maximum increase.
+	policy.FeeBaseMsat = 3000
+	policy.FeeRateMilliMsat = 300
+
+	suggestions, err = manager.SuggestSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, &Suggestions{
+		OutSwaps: []loop.OutRequest{
+			chan2Rec,
+		},
+		DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+			chanID1: ReasonPeerFeeIncrease,
+		},
+		DisqualifiedPeers: noPeersDisqualified,
+	}, suggestions)
+
+	// Once our window has passed since the increase, the raised fee is
+	// our peer's lowest fee and the channel is eligible again.
+	testClock.SetTime(testTime.Add(peerPolicyWindow + time.Second))
+
+	suggestions, err = manager.SuggestSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, bothChannels, suggestions)
+}
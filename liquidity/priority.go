@@ -0,0 +1,188 @@
+package liquidity
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// Priority describes the order in which we allocate our autoloop budget to
+// swap suggestions when we cannot afford all of them.
+type Priority uint8
+
+const (
+	// PriorityAmount allocates budget to the suggestions with the largest
+	// amount first, so that our biggest liquidity deficits are addressed
+	// before smaller ones.
+	PriorityAmount Priority = iota
+
+	// PriorityWeighted allocates budget to suggestions in descending order
+	// of their amount multiplied by the weight configured for their peer.
+	// Peers that do not have a weight set have a weight of 1.
+	PriorityWeighted
+
+	// PriorityRoundRobin allocates budget to one suggestion per peer at a
+	// time, largest amount first, so that a single peer with many channels
+	// cannot consume our whole budget.
+	PriorityRoundRobin
+
+	// priorityCount is the number of priorities that we support, used for
+	// validation.
+	priorityCount
+)
+
+// ErrUnknownPriority is returned when an unknown priority is set.
+var ErrUnknownPriority = errors.New("unknown suggestion priority")
+
+// String returns the string representation of a priority.
+func (p Priority) String() string {
+	switch p {
+	case PriorityAmount:
+		return "amount"
+
+	case PriorityWeighted:
+		return "weighted"
+
+	case PriorityRoundRobin:
+		return "round robin"
+
+	default:
+		return "unknown"
+	}
+}
+
+// validate checks that a priority is known.
+func (p Priority) validate() error {
+	if p >= priorityCount {
+		return ErrUnknownPriority
+	}
+
+	return nil
+}
+
+// prioritizedSwap pairs a swap suggestion with the values we use to order it.
+type prioritizedSwap struct {
+	swapSuggestion
+
+	// peer is the peer that we group the swap under for round robin
+	// ordering. If the swap involves more than one peer, we use the
+	// smallest pubkey so that grouping is deterministic.
+	peer route.Vertex
+
+	// score is the value that we order swaps by, in descending order.
+	score uint64
+}
+
+// less returns a boolean indicating whether a swap should be prioritized over
+// another. We break ties in score using the swap's first channel, and then
+// its peer, so that our ordering is deterministic.
+func (p *prioritizedSwap) less(other *prioritizedSwap) bool {
+	if p.score != other.score {
+		return p.score > other.score
+	}
+
+	pChans, otherChans := p.channels(), other.channels()
+	if len(pChans) > 0 && len(otherChans) > 0 &&
+		pChans[0] != otherChans[0] {
+
+		return pChans[0].ToUint64() < otherChans[0].ToUint64()
+	}
+
+	return lessVertex(p.peer, other.peer)
+}
+
+// lessVertex returns a boolean indicating whether a pubkey sorts before
+// another.
+func lessVertex(a, b route.Vertex) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+
+	return false
+}
+
+// prioritize returns our set of swap suggestions in the order that we should
+// allocate budget to them, according to the priority provided. Peer weights
+// are only used for weighted priority.
+func prioritize(suggestions []swapSuggestion, priority Priority,
+	weights map[route.Vertex]uint64,
+	channelPeers map[uint64]route.Vertex) []swapSuggestion {
+
+	swaps := make([]*prioritizedSwap, len(suggestions))
+	for i, suggestion := range suggestions {
+		swap := &prioritizedSwap{
+			swapSuggestion: suggestion,
+			score:          uint64(suggestion.amount()),
+		}
+
+		weight := uint64(1)
+		for j, peer := range suggestion.peers(channelPeers) {
+			if j == 0 || lessVertex(peer, swap.peer) {
+				swap.peer = peer
+			}
+
+			if peerWeight, ok := weights[peer]; ok &&
+				peerWeight > weight {
+
+				weight = peerWeight
+			}
+		}
+
+		if priority == PriorityWeighted {
+			swap.score *= weight
+		}
+
+		swaps[i] = swap
+	}
+
+	sort.SliceStable(swaps, func(i, j int) bool {
+		return swaps[i].less(swaps[j])
+	})
+
+	if priority == PriorityRoundRobin {
+		swaps = roundRobin(swaps)
+	}
+
+	prioritized := make([]swapSuggestion, len(swaps))
+	for i, swap := range swaps {
+		prioritized[i] = swap.swapSuggestion
+	}
+
+	return prioritized
+}
+
+// roundRobin reorders a sorted set of swaps so that we take one swap from
+// each peer in turn. Peers are visited in the order of their highest ranked
+// swap, and each peer's swaps retain their relative order.
+func roundRobin(swaps []*prioritizedSwap) []*prioritizedSwap {
+	var (
+		peerOrder []route.Vertex
+		peerSwaps = make(map[route.Vertex][]*prioritizedSwap)
+	)
+
+	for _, swap := range swaps {
+		if _, ok := peerSwaps[swap.peer]; !ok {
+			peerOrder = append(peerOrder, swap.peer)
+		}
+
+		peerSwaps[swap.peer] = append(peerSwaps[swap.peer], swap)
+	}
+
+	ordered := make([]*prioritizedSwap, 0, len(swaps))
+	for len(ordered) < len(swaps) {
+		for _, peer := range peerOrder {
+			pending := peerSwaps[peer]
+			if len(pending) == 0 {
+				continue
+			}
+
+			ordered = append(ordered, pending[0])
+			peerSwaps[peer] = pending[1:]
+		}
+	}
+
+	return ordered
+}
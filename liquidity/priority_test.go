@@ -0,0 +1,106 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrioritize tests ordering of swap suggestions for each of our
+// priorities.
+func TestPrioritize(t *testing.T) {
+	newSwap := func(amount btcutil.Amount,
+		channel uint64) swapSuggestion {
+
+		return &loopOutSwapSuggestion{
+			OutRequest: loop.OutRequest{
+				Amount:          amount,
+				OutgoingChanSet: loopdb.ChannelSet{channel},
+			},
+		}
+	}
+
+	var (
+		// Channels 1 and 2 are with peer1, and channel 3 is with
+		// peer2.
+		channelPeers = map[uint64]route.Vertex{
+			1: peer1,
+			2: peer1,
+			3: peer2,
+		}
+
+		swap1 = newSwap(300, 1)
+		swap2 = newSwap(200, 2)
+		swap3 = newSwap(100, 3)
+
+		// swap4 has the same amount as swap3, so it is ordered by
+		// channel ID.
+		swap4 = newSwap(100, 4)
+	)
+
+	tests := []struct {
+		name     string
+		priority Priority
+		weights  map[route.Vertex]uint64
+		expected []swapSuggestion
+	}{
+		{
+			name:     "amount",
+			priority: PriorityAmount,
+			expected: []swapSuggestion{
+				swap1, swap2, swap3, swap4,
+			},
+		},
+		{
+			name:     "weights ignored for amount",
+			priority: PriorityAmount,
+			weights: map[route.Vertex]uint64{
+				peer2: 10,
+			},
+			expected: []swapSuggestion{
+				swap1, swap2, swap3, swap4,
+			},
+		},
+		{
+			name:     "weighted",
+			priority: PriorityWeighted,
+			weights: map[route.Vertex]uint64{
+				peer2: 10,
+			},
+			expected: []swapSuggestion{
+				swap3, swap1, swap2, swap4,
+			},
+		},
+		{
+			// Channel 4 has no known peer, so it is grouped under
+			// an empty pubkey.
+			name:     "round robin",
+			priority: PriorityRoundRobin,
+			expected: []swapSuggestion{
+				swap1, swap3, swap4, swap2,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			// Provide our swaps in reverse order so that we know
+			// that ordering does not depend on our input.
+			swaps := []swapSuggestion{
+				swap4, swap3, swap2, swap1,
+			}
+
+			prioritized := prioritize(
+				swaps, testCase.priority, testCase.weights,
+				channelPeers,
+			)
+			require.Equal(t, testCase.expected, prioritized)
+		})
+	}
+}
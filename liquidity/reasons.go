@@ -65,6 +65,43 @@ const (
 	// ReasonFeePPMInsufficient indicates that the fees a swap would require
 	// are greater than the portion of swap amount allocated to fees.
 	ReasonFeePPMInsufficient
+
+	// ReasonPeerExcluded indicates that a target is not eligible for swaps
+	// because its peer is excluded by our peer allow or deny lists.
+	ReasonPeerExcluded
+
+	// ReasonChannelInactive indicates that a channel is not eligible for
+	// swaps because it is currently inactive, which is the case when our
+	// peer is offline.
+	ReasonChannelInactive
+
+	// ReasonLowUptime indicates that a channel is not eligible for swaps
+	// because our peer's observed uptime is below our minimum.
+	ReasonLowUptime
+
+	// ReasonUnrestrictedSwap indicates that we are not suggesting swaps
+	// because there is a pending swap that may use any of our channels,
+	// and we are configured to freeze suggestions while such swaps are
+	// in flight.
+	ReasonUnrestrictedSwap
+
+	// ReasonChannelClosing indicates that a channel is not eligible for
+	// swaps because it is pending close.
+	ReasonChannelClosing
+
+	// ReasonSuccessCooldown indicates that a channel was recently part of
+	// a successful automatically dispatched swap, and our cooldown period
+	// has not yet passed.
+	ReasonSuccessCooldown
+
+	// ReasonPeerFeeIncrease indicates that a channel is not eligible for
+	// swaps because our peer recently raised the fee that it charges to
+	// forward over the channel by more than our maximum.
+	ReasonPeerFeeIncrease
+
+	// ReasonChannelExcluded indicates that a channel is not eligible for
+	// swaps because it was excluded from a single set of suggestions.
+	ReasonChannelExcluded
 )
 
 // String returns a string representation of a reason.
@@ -112,6 +149,30 @@ func (r Reason) String() string {
 	case ReasonFeePPMInsufficient:
 		return "fee portion insufficient"
 
+	case ReasonPeerExcluded:
+		return "peer excluded"
+
+	case ReasonChannelInactive:
+		return "channel inactive"
+
+	case ReasonLowUptime:
+		return "peer uptime too low"
+
+	case ReasonUnrestrictedSwap:
+		return "unrestricted swap in flight"
+
+	case ReasonChannelClosing:
+		return "channel closing"
+
+	case ReasonSuccessCooldown:
+		return "success cooldown"
+
+	case ReasonPeerFeeIncrease:
+		return "peer fee increased"
+
+	case ReasonChannelExcluded:
+		return "channel excluded"
+
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,59 @@
+package liquidity
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// snapshot records the current balances of our wallet and channels, and
+// prunes any snapshots that are older than our retention period.
+func (m *Manager) snapshot(ctx context.Context) error {
+	wallet, err := m.cfg.Lnd.Client.WalletBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := m.cfg.Clock.Now()
+
+	snapshot := &loopdb.LiquiditySnapshot{
+		Timestamp:        now,
+		ConfirmedBalance: wallet.Confirmed,
+		Channels:         make([]loopdb.ChannelSnapshot, len(channels)),
+	}
+
+	for i, channel := range channels {
+		snapshot.Channels[i] = loopdb.ChannelSnapshot{
+			ChannelID:     channel.ChannelID,
+			Peer:          channel.PubKeyBytes,
+			Capacity:      channel.Capacity,
+			LocalBalance:  channel.LocalBalance,
+			RemoteBalance: channel.RemoteBalance,
+		}
+	}
+
+	if err := m.cfg.CreateSnapshot(snapshot); err != nil {
+		return err
+	}
+
+	if m.cfg.SnapshotRetention == 0 {
+		return nil
+	}
+
+	return m.cfg.PruneSnapshots(now.Add(m.cfg.SnapshotRetention * -1))
+}
+
+// GetSnapshots returns the liquidity snapshots that we have recorded between
+// the start and end time provided, inclusive. A zero start or end time leaves
+// that side of the range unbounded.
+func (m *Manager) GetSnapshots(start, end time.Time) (
+	[]*loopdb.LiquiditySnapshot, error) {
+
+	return m.cfg.ListSnapshots(start, end)
+}
@@ -0,0 +1,98 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshot tests recording of liquidity snapshots and pruning of old
+// snapshots.
+func TestSnapshot(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention time.Duration
+		pruned    bool
+	}{
+		{
+			name:      "no retention",
+			retention: 0,
+			pruned:    false,
+		},
+		{
+			name:      "prune old snapshots",
+			retention: time.Hour,
+			pruned:    true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+			lnd.WalletBalance = lndclient.WalletBalance{
+				Confirmed: 1000,
+			}
+
+			var (
+				created     *loopdb.LiquiditySnapshot
+				pruneBefore time.Time
+			)
+
+			cfg.SnapshotRetention = testCase.retention
+			cfg.CreateSnapshot = func(
+				s *loopdb.LiquiditySnapshot) error {
+
+				created = s
+				return nil
+			}
+			cfg.PruneSnapshots = func(before time.Time) error {
+				pruneBefore = before
+				return nil
+			}
+
+			manager := NewManager(cfg)
+			require.NoError(t, manager.snapshot(context.Background()))
+
+			expected := &loopdb.LiquiditySnapshot{
+				Timestamp:        testTime,
+				ConfirmedBalance: 1000,
+				Channels: []loopdb.ChannelSnapshot{
+					{
+						ChannelID:     channel1.ChannelID,
+						Peer:          channel1.PubKeyBytes,
+						Capacity:      channel1.Capacity,
+						LocalBalance:  channel1.LocalBalance,
+						RemoteBalance: channel1.RemoteBalance,
+					},
+					{
+						ChannelID:     channel2.ChannelID,
+						Peer:          channel2.PubKeyBytes,
+						Capacity:      channel2.Capacity,
+						LocalBalance:  channel2.LocalBalance,
+						RemoteBalance: channel2.RemoteBalance,
+					},
+				},
+			}
+			require.Equal(t, expected, created)
+
+			if !testCase.pruned {
+				require.True(t, pruneBefore.IsZero())
+				return
+			}
+
+			require.Equal(
+				t, testTime.Add(testCase.retention*-1),
+				pruneBefore,
+			)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package liquidity
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// chanReserveDivisor sets our channel reserve to 1% of a channel's
+	// capacity, which is the reserve that lnd requires by default.
+	chanReserveDivisor = 100
+
+	// maxAnchorCommitFeeRate is the fee rate that lnd caps the commitment
+	// fee rate of anchor channels at by default, 10 sat/vbyte.
+	maxAnchorCommitFeeRate chainfee.SatPerKWeight = 2500
+)
+
+// commitFeeBuffer returns the amount that the initiator of an anchor channel
+// must hold on top of the commitment fee that it already pays to add an htlc
+// to the commitment at the fee rate provided. Lnd requires the initiator to be
+// able to pay the fee of the commitment with the additional htlc at twice its
+// fee rate, so that it can absorb fee spikes.
+func commitFeeBuffer(feeRate chainfee.SatPerKWeight) btcutil.Amount {
+	commitWeight := int64(input.AnchorCommitWeight)
+	htlcWeight := int64(input.HTLCWeight)
+
+	return 2*feeRate.FeeForWeight(commitWeight+htlcWeight) -
+		feeRate.FeeForWeight(commitWeight)
+}
+
+// OutgoingReserve returns the portion of the local balance of a channel that
+// cannot be sent in payments. This is our channel reserve and, if we opened
+// the channel, the buffer that lnd keeps to pay commitment fees. Since we do
+// not know the current commitment fee rate, we assume that it is at the cap
+// that lnd sets for anchor channels.
+func OutgoingReserve(channel lndclient.ChannelInfo) btcutil.Amount {
+	reserve := channel.Capacity / chanReserveDivisor
+
+	if channel.Initiator {
+		reserve += commitFeeBuffer(maxAnchorCommitFeeRate)
+	}
+
+	return reserve
+}
+
+// SpendableBalance returns the portion of the local balance of a channel that
+// can be sent in payments.
+func SpendableBalance(channel lndclient.ChannelInfo) btcutil.Amount {
+	reserve := OutgoingReserve(channel)
+	if channel.LocalBalance <= reserve {
+		return 0
+	}
+
+	return channel.LocalBalance - reserve
+}
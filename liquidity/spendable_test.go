@@ -0,0 +1,80 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpendableBalance tests calculation of the portion of a channel's local
+// balance that can be sent in payments.
+func TestSpendableBalance(t *testing.T) {
+	// The initiator of a channel at the maximum anchor commitment fee
+	// rate must be able to pay 2 * 1296 weight * 2.5 sat/weight for a
+	// commitment with an additional htlc, of which it already pays 1124
+	// weight * 2.5 sat/weight.
+	require.Equal(
+		t, btcutil.Amount(3670), commitFeeBuffer(maxAnchorCommitFeeRate),
+	)
+
+	tests := []struct {
+		name      string
+		local     btcutil.Amount
+		initiator bool
+		spendable btcutil.Amount
+	}{
+		{
+			name:      "channel reserve",
+			local:     50000,
+			spendable: 49000,
+		},
+		{
+			name:      "initiator fee buffer",
+			local:     50000,
+			initiator: true,
+			spendable: 45330,
+		},
+		{
+			name:      "balance below reserve",
+			local:     500,
+			spendable: 0,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			channel := lndclient.ChannelInfo{
+				Capacity:     100000,
+				LocalBalance: testCase.local,
+				Initiator:    testCase.initiator,
+			}
+
+			require.Equal(
+				t, testCase.spendable,
+				SpendableBalance(channel),
+			)
+		})
+	}
+}
+
+// TestLoopOutSwapAmountReserve tests that loop outs do not include the portion
+// of our outgoing balance that lnd holds for our channel reserve and
+// commitment fees.
+func TestLoopOutSwapAmountReserve(t *testing.T) {
+	bal := &balances{
+		capacity: 100000,
+		outgoing: 100000,
+	}
+
+	// Without a reserve, we loop out to the midpoint of our thresholds.
+	require.Equal(t, btcutil.Amount(75000), loopOutSwapAmount(bal, 50, 0))
+
+	// If reaching the midpoint would spend our reserve, we do not loop
+	// out.
+	bal.outgoingReserve = 60000
+	require.Zero(t, loopOutSwapAmount(bal, 50, 0))
+}
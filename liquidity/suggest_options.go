@@ -0,0 +1,84 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// SuggestOptions are overrides that apply to a single set of swap
+// suggestions. They are never persisted, so that callers can explore what we
+// would suggest under different conditions without changing our parameters.
+type SuggestOptions struct {
+	// ExcludedChannels is a set of channels that are not eligible for
+	// swaps, in addition to the channels that our parameters exclude.
+	ExcludedChannels map[lnwire.ShortChannelID]struct{}
+
+	// ExcludedPeers is a set of peers whose channels are not eligible for
+	// swaps, in addition to the peers that our parameters exclude.
+	ExcludedPeers map[route.Vertex]struct{}
+
+	// MaxSwaps is the maximum number of loop outs that we suggest. If it
+	// is zero, the number of suggestions is not capped.
+	MaxSwaps int
+
+	// ChannelRules and PeerRules replace our current rules if either of
+	// them are set. The rules are validated against our current channels,
+	// but are not stored.
+	ChannelRules map[lnwire.ShortChannelID]*ThresholdRule
+	PeerRules    map[route.Vertex]*ThresholdRule
+}
+
+// SuggestSwapsWithOptions returns a set of swap suggestions for our current
+// parameters with the per-invocation overrides provided applied. Suggestions
+// are never used for autoloop.
+func (m *Manager) SuggestSwapsWithOptions(ctx context.Context,
+	opts *SuggestOptions) (*Suggestions, error) {
+
+	params := m.GetParameters()
+
+	if len(opts.ChannelRules) != 0 || len(opts.PeerRules) != 0 {
+		params.ChannelRules = opts.ChannelRules
+		params.PeerRules = opts.PeerRules
+
+		if err := m.validateParameters(ctx, params); err != nil {
+			return nil, err
+		}
+
+		params = cloneParameters(params)
+	}
+
+	if len(opts.ExcludedPeers) != 0 {
+		if params.ExcludedPeers == nil {
+			params.ExcludedPeers = make(map[route.Vertex]struct{})
+		}
+
+		for peer := range opts.ExcludedPeers {
+			params.ExcludedPeers[peer] = struct{}{}
+		}
+	}
+
+	// We evaluate our suggestions with a manager that shares our config
+	// and peer policies, but holds its own parameters, so that our
+	// overrides never affect autoloop.
+	view := &Manager{
+		cfg:              m.cfg,
+		params:           params,
+		peerPolicies:     m.peerPolicies,
+		excludedChannels: opts.ExcludedChannels,
+	}
+
+	suggestions, err := view.SuggestSwaps(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Our suggestions are ordered by priority, so we keep the swaps that
+	// we would dispatch first.
+	if opts.MaxSwaps > 0 && len(suggestions.OutSwaps) > opts.MaxSwaps {
+		suggestions.OutSwaps = suggestions.OutSwaps[:opts.MaxSwaps]
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,142 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSuggestSwapsWithOptions tests that per-invocation overrides are applied
+// to our suggestions without changing our parameters.
+func TestSuggestSwapsWithOptions(t *testing.T) {
+	var (
+		rules = map[lnwire.ShortChannelID]*ThresholdRule{
+			chanID1: chanRule,
+			chanID2: chanRule,
+		}
+
+		chan2Rules = map[lnwire.ShortChannelID]*ThresholdRule{
+			chanID2: chanRule,
+		}
+
+		chan1Excluded = map[lnwire.ShortChannelID]Reason{
+			chanID1: ReasonChannelExcluded,
+		}
+
+		chan2PeerExcluded = map[lnwire.ShortChannelID]Reason{
+			chanID2: ReasonPeerExcluded,
+		}
+
+		excludeChan1 = map[lnwire.ShortChannelID]struct{}{
+			chanID1: {},
+		}
+	)
+
+	tests := []struct {
+		name        string
+		opts        *SuggestOptions
+		suggestions *Suggestions
+	}{
+		{
+			name: "no overrides",
+			opts: &SuggestOptions{},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "channel excluded",
+			opts: &SuggestOptions{
+				ExcludedChannels: excludeChan1,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: chan1Excluded,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "peer excluded",
+			opts: &SuggestOptions{
+				ExcludedPeers: map[route.Vertex]struct{}{
+					peer2: {},
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: chan2PeerExcluded,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "swaps capped",
+			opts: &SuggestOptions{
+				MaxSwaps: 1,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "hypothetical rules",
+			opts: &SuggestOptions{
+				ChannelRules: chan2Rules,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = rules
+
+			manager := NewManager(cfg)
+			ctx := context.Background()
+
+			err := manager.SetParameters(ctx, params)
+			require.NoError(t, err)
+
+			suggestions, err := manager.SuggestSwapsWithOptions(
+				ctx, testCase.opts,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.suggestions, suggestions)
+
+			// Our overrides must not have been persisted.
+			current := manager.GetParameters()
+			require.Equal(t, rules, current.ChannelRules)
+			require.Empty(t, current.ExcludedPeers)
+		})
+	}
+}
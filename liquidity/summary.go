@@ -0,0 +1,185 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// Summary contains a summary of our node's liquidity, including funds that
+// are in transit due to our swaps.
+type Summary struct {
+	// ConfirmedBalance is our confirmed on chain wallet balance.
+	ConfirmedBalance btcutil.Amount
+
+	// UnconfirmedBalance is our unconfirmed on chain wallet balance.
+	UnconfirmedBalance btcutil.Amount
+
+	// LocalBalance is our total local balance across the channels that
+	// are covered by our liquidity rules.
+	LocalBalance btcutil.Amount
+
+	// RemoteBalance is our total remote balance across the channels that
+	// are covered by our liquidity rules.
+	RemoteBalance btcutil.Amount
+
+	// PendingLoopOut is the total amount of our pending loop outs.
+	PendingLoopOut btcutil.Amount
+
+	// PendingLoopIn is the total amount of our pending loop ins.
+	PendingLoopIn btcutil.Amount
+
+	// PendingSweep is the total amount of our loop outs that have
+	// completed off chain, but have not yet had their sweep confirmed.
+	// These funds will be available on chain once the sweep confirms, less
+	// the sweep's miner fee.
+	PendingSweep btcutil.Amount
+
+	// AutoloopPendingSweep is the portion of our pending sweep amount
+	// that belongs to automatically dispatched swaps.
+	AutoloopPendingSweep btcutil.Amount
+
+	// Targets contains our distance from each of our liquidity rules.
+	Targets []TargetSummary
+}
+
+// TargetSummary describes the distance between the current balance of a
+// channel or peer and the thresholds of the rule set for it.
+type TargetSummary struct {
+	// Channel is the channel that the rule applies to. This field is only
+	// set for channel rules.
+	Channel lnwire.ShortChannelID
+
+	// Peer is the peer that the rule applies to. This field is only set
+	// for peer rules.
+	Peer route.Vertex
+
+	// IncomingDeficit is the amount of incoming liquidity we require to
+	// reach the rule's minimum incoming threshold.
+	IncomingDeficit btcutil.Amount
+
+	// OutgoingDeficit is the amount of outgoing liquidity we require to
+	// reach the rule's minimum outgoing threshold.
+	OutgoingDeficit btcutil.Amount
+}
+
+// GetSummary returns a summary of our node's current liquidity.
+func (m *Manager) GetSummary(ctx context.Context) (*Summary, error) {
+	params := m.GetParameters()
+
+	wallet, err := m.cfg.Lnd.Client.WalletBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loopOut, err := m.cfg.ListLoopOut()
+	if err != nil {
+		return nil, err
+	}
+
+	loopIn, err := m.cfg.ListLoopIn()
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		ConfirmedBalance:   wallet.Confirmed,
+		UnconfirmedBalance: wallet.Unconfirmed,
+	}
+
+	peerBalances := make(map[route.Vertex]*balances)
+	for _, channel := range channels {
+		balance := newBalances(channel)
+		chanID := balance.channels[0]
+
+		rule, chanRule := params.ChannelRules[chanID]
+		_, peerRule := params.PeerRules[channel.PubKeyBytes]
+
+		if !chanRule && !peerRule {
+			continue
+		}
+
+		summary.LocalBalance += channel.LocalBalance
+		summary.RemoteBalance += channel.RemoteBalance
+
+		if chanRule {
+			incoming, outgoing := rule.deficits(balance)
+			summary.Targets = append(summary.Targets, TargetSummary{
+				Channel:         chanID,
+				IncomingDeficit: incoming,
+				OutgoingDeficit: outgoing,
+			})
+
+			continue
+		}
+
+		peerBalance, ok := peerBalances[channel.PubKeyBytes]
+		if !ok {
+			peerBalance = &balances{
+				pubkey: channel.PubKeyBytes,
+			}
+			peerBalances[channel.PubKeyBytes] = peerBalance
+		}
+
+		peerBalance.capacity += balance.capacity
+		peerBalance.incoming += balance.incoming
+		peerBalance.outgoing += balance.outgoing
+		peerBalance.channels = append(peerBalance.channels, chanID)
+	}
+
+	for peer, balance := range peerBalances {
+		incoming, outgoing := params.PeerRules[peer].deficits(balance)
+		summary.Targets = append(summary.Targets, TargetSummary{
+			Peer:            peer,
+			IncomingDeficit: incoming,
+			OutgoingDeficit: outgoing,
+		})
+	}
+
+	for _, out := range loopOut {
+		if out.State().State.Type() == loopdb.StateTypePending {
+			summary.PendingLoopOut += out.Contract.AmountRequested
+		}
+
+		amount := pendingSweepAmount(out)
+		if amount == 0 {
+			continue
+		}
+
+		summary.PendingSweep += amount
+
+		if labels.IsAutoloopLabel(out.Contract.Label, swap.TypeOut) {
+			summary.AutoloopPendingSweep += amount
+		}
+	}
+
+	for _, in := range loopIn {
+		if in.State().State.Type() == loopdb.StateTypePending {
+			summary.PendingLoopIn += in.Contract.AmountRequested
+		}
+	}
+
+	return summary, nil
+}
+
+// pendingSweepAmount returns the amount that a loop out will sweep on chain
+// if the swap has completed off chain but its sweep has not yet confirmed. A
+// loop out reaches this point once it has revealed its preimage to the server.
+// If the swap is in any other state, zero is returned.
+func pendingSweepAmount(out *loopdb.LoopOut) btcutil.Amount {
+	if out.State().State != loopdb.StatePreimageRevealed {
+		return 0
+	}
+
+	return out.Contract.AmountRequested
+}
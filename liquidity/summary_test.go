@@ -0,0 +1,116 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetSummary tests calculation of our liquidity summary.
+func TestGetSummary(t *testing.T) {
+	newLoopOut := func(state loopdb.SwapState, label string,
+		amount btcutil.Amount) *loopdb.LoopOut {
+
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					{
+						SwapStateData: loopdb.SwapStateData{
+							State: state,
+						},
+					},
+				},
+			},
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amount,
+					Label:           label,
+				},
+			},
+		}
+	}
+
+	autoLabel := labels.AutoloopLabel(swap.TypeOut)
+
+	cfg, lnd := newTestConfig()
+	lnd.WalletBalance = lndclient.WalletBalance{
+		Confirmed:   1000,
+		Unconfirmed: 500,
+	}
+
+	// Our third channel is not covered by any rules, so it should not be
+	// included in our summary.
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1, channel2, {
+			ChannelID:     chanID3.ToUint64(),
+			PubKeyBytes:   route.Vertex{3},
+			LocalBalance:  10000,
+			RemoteBalance: 10000,
+			Capacity:      20000,
+		},
+	}
+
+	cfg.ListLoopIn = func() ([]*loopdb.LoopIn, error) {
+		return []*loopdb.LoopIn{
+			{
+				Contract: &loopdb.LoopInContract{
+					SwapContract: loopdb.SwapContract{
+						AmountRequested: 1600,
+					},
+				},
+			},
+		}, nil
+	}
+	cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
+		return []*loopdb.LoopOut{
+			newLoopOut(loopdb.StatePreimageRevealed, "", 100),
+			newLoopOut(loopdb.StatePreimageRevealed, autoLabel, 200),
+			newLoopOut(loopdb.StateInitiated, autoLabel, 400),
+			newLoopOut(loopdb.StateSuccess, "", 800),
+		}, nil
+	}
+
+	manager := NewManager(cfg)
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*ThresholdRule{
+		chanID1: NewThresholdRule(50, 0),
+	}
+	params.PeerRules = map[route.Vertex]*ThresholdRule{
+		peer2: NewThresholdRule(20, 30),
+	}
+
+	err := manager.SetParameters(context.Background(), params)
+	require.NoError(t, err)
+
+	summary, err := manager.GetSummary(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &Summary{
+		ConfirmedBalance:     1000,
+		UnconfirmedBalance:   500,
+		LocalBalance:         20000,
+		RemoteBalance:        0,
+		PendingLoopOut:       700,
+		PendingLoopIn:        1600,
+		PendingSweep:         300,
+		AutoloopPendingSweep: 200,
+		Targets: []TargetSummary{
+			{
+				Channel:         chanID1,
+				IncomingDeficit: 5000,
+			},
+			{
+				Peer:            peer2,
+				IncomingDeficit: 2000,
+			},
+		},
+	}, summary)
+}
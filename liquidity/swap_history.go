@@ -0,0 +1,117 @@
+package liquidity
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// swapHistory is an index of the swaps that affect our suggestions. Our set of
+// swaps only ever grows, so long-lived nodes may have many thousands of them
+// while only a handful are pending or recent enough to matter. The history is
+// built in a single pass when our swaps are loaded, indexing completed swaps
+// by the channels that they used, so that the rest of an evaluation never
+// walks our full set of swaps.
+type swapHistory struct {
+	// pendingOut holds our pending loop outs.
+	pendingOut []*loopdb.LoopOut
+
+	// pendingIn holds our pending loop ins.
+	pendingIn []*loopdb.LoopIn
+
+	// autoSpent is the total cost of the automatically dispatched loop
+	// outs that completed on or after our budget start date.
+	autoSpent btcutil.Amount
+
+	// lastFailure holds the time of the most recent loop out that failed
+	// to make its off chain payment over each channel.
+	lastFailure map[lnwire.ShortChannelID]time.Time
+
+	// lastAutoSuccess holds the time of the most recent automatically
+	// dispatched loop out that succeeded over each channel.
+	lastAutoSuccess map[lnwire.ShortChannelID]time.Time
+}
+
+// newSwapHistory indexes the loop outs and loop ins provided, recording the
+// fees of automatically dispatched loop outs that completed on or after the
+// budget start date provided.
+func newSwapHistory(loopOut []*loopdb.LoopOut, loopIn []*loopdb.LoopIn,
+	budgetStart time.Time) *swapHistory {
+
+	history := &swapHistory{
+		lastFailure:     make(map[lnwire.ShortChannelID]time.Time),
+		lastAutoSuccess: make(map[lnwire.ShortChannelID]time.Time),
+	}
+
+	for _, out := range loopOut {
+		state := out.State()
+
+		if state.State.Type() == loopdb.StateTypePending {
+			history.pendingOut = append(history.pendingOut, out)
+			continue
+		}
+
+		isAuto := labels.IsAutoloopLabel(
+			out.Contract.Label, swap.TypeOut,
+		)
+		if isAuto && !out.LastUpdateTime().Before(budgetStart) {
+			history.autoSpent += state.Cost.Total()
+		}
+
+		var index map[lnwire.ShortChannelID]time.Time
+		switch {
+		case state.State == loopdb.StateFailOffchainPayments:
+			index = history.lastFailure
+
+		case state.State == loopdb.StateSuccess && isAuto:
+			index = history.lastAutoSuccess
+
+		default:
+			continue
+		}
+
+		updated := out.LastUpdate().Time
+		for _, id := range out.Contract.OutgoingChanSet {
+			chanID := lnwire.NewShortChanIDFromInt(id)
+
+			if last, ok := index[chanID]; ok && last.After(updated) {
+				continue
+			}
+
+			index[chanID] = updated
+		}
+	}
+
+	for _, in := range loopIn {
+		if in.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		history.pendingIn = append(history.pendingIn, in)
+	}
+
+	return history
+}
+
+// loadSwapHistory lists our swaps and indexes them for a single evaluation of
+// our suggestions. Note that listing our swaps may race with the manual
+// initiation of swaps.
+func (m *Manager) loadSwapHistory() (*swapHistory, error) {
+	loopOut, err := m.cfg.ListLoopOut()
+	if err != nil {
+		return nil, err
+	}
+
+	loopIn, err := m.cfg.ListLoopIn()
+	if err != nil {
+		return nil, err
+	}
+
+	budgetStart := m.params.budgetStart(m.cfg.Clock.Now())
+
+	return newSwapHistory(loopOut, loopIn, budgetStart), nil
+}
@@ -0,0 +1,107 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// newHistoricLoopOut creates a loop out over the channels provided that was
+// last updated to the state provided at the time provided.
+func newHistoricLoopOut(state loopdb.SwapState, label string,
+	updated time.Time, channels ...uint64) *loopdb.LoopOut {
+
+	event := &loopdb.LoopEvent{
+		SwapStateData: loopdb.SwapStateData{
+			State: state,
+			Cost: loopdb.SwapCost{
+				Server: 10,
+			},
+		},
+		Time: updated,
+	}
+
+	return &loopdb.LoopOut{
+		Loop: loopdb.Loop{
+			Events: []*loopdb.LoopEvent{event},
+		},
+		Contract: &loopdb.LoopOutContract{
+			SwapContract: loopdb.SwapContract{
+				AmountRequested: 7500,
+				Label:           label,
+			},
+			OutgoingChanSet: channels,
+		},
+	}
+}
+
+// TestSwapHistory tests indexing of our swaps by channel.
+func TestSwapHistory(t *testing.T) {
+	var (
+		autoLabel  = labels.AutoloopLabel(swap.TypeOut)
+		minuteAgo  = testTime.Add(-time.Minute)
+		hourAgo    = testTime.Add(-time.Hour)
+		twoHourAgo = testTime.Add(-time.Hour * 2)
+
+		pendingOut = newHistoricLoopOut(
+			loopdb.StateInitiated, autoLabel, testTime, 1,
+		)
+
+		pendingIn = &loopdb.LoopIn{
+			Contract: &loopdb.LoopInContract{},
+		}
+
+		completedIn = &loopdb.LoopIn{
+			Loop: newHistoricLoopOut(
+				loopdb.StateSuccess, "", testTime,
+			).Loop,
+			Contract: &loopdb.LoopInContract{},
+		}
+	)
+
+	loopOut := []*loopdb.LoopOut{
+		pendingOut,
+
+		// Failures are indexed by channel, keeping the most recent.
+		newHistoricLoopOut(
+			loopdb.StateFailOffchainPayments, "", minuteAgo, 1,
+		),
+		newHistoricLoopOut(
+			loopdb.StateFailOffchainPayments, "", hourAgo, 1, 2,
+		),
+
+		// Only automatically dispatched successes are indexed, and
+		// their fees count towards our budget if they completed after
+		// our budget start date.
+		newHistoricLoopOut(
+			loopdb.StateSuccess, autoLabel, twoHourAgo, 3,
+		),
+		newHistoricLoopOut(
+			loopdb.StateSuccess, autoLabel, minuteAgo, 3,
+		),
+		newHistoricLoopOut(loopdb.StateSuccess, "", minuteAgo, 4),
+	}
+
+	history := newSwapHistory(
+		loopOut, []*loopdb.LoopIn{pendingIn, completedIn},
+		testBudgetStart,
+	)
+
+	require.Equal(t, &swapHistory{
+		pendingOut: []*loopdb.LoopOut{pendingOut},
+		pendingIn:  []*loopdb.LoopIn{pendingIn},
+		autoSpent:  10,
+		lastFailure: map[lnwire.ShortChannelID]time.Time{
+			chanID1: minuteAgo,
+			chanID2: hourAgo,
+		},
+		lastAutoSuccess: map[lnwire.ShortChannelID]time.Time{
+			chanID3: minuteAgo,
+		},
+	}, history)
+}
@@ -3,6 +3,7 @@ package liquidity
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcutil"
 )
@@ -17,6 +18,10 @@ var (
 	// provided for a threshold rule is >= 100.
 	errInvalidThresholdSum = errors.New("sum of incoming and outgoing " +
 		"percentages must be < 100")
+
+	// errNegativeCooldown is returned when a rule's success cooldown is
+	// negative.
+	errNegativeCooldown = errors.New("success cooldown must be >= 0")
 )
 
 // ThresholdRule is a liquidity rule that implements minimum incoming and
@@ -29,6 +34,14 @@ type ThresholdRule struct {
 	// MinimumOutgoing is the percentage of outgoing liquidity that we do
 	// not want to drop below.
 	MinimumOutgoing int
+
+	// SuccessCooldown is the amount of time that we require passes after
+	// one of the rule's channels has been part of a successful
+	// automatically dispatched loop out before we suggest a swap for the
+	// rule again. This gives the channel balances time to settle after a
+	// rebalance. If this value is zero, we do not wait after successful
+	// swaps.
+	SuccessCooldown time.Duration
 }
 
 // NewThresholdRule returns a new threshold rule.
@@ -42,7 +55,8 @@ func NewThresholdRule(minIncoming, minOutgoing int) *ThresholdRule {
 // String returns a string representation of a rule.
 func (r *ThresholdRule) String() string {
 	return fmt.Sprintf("threshold rule: minimum incoming: %v%%, minimum "+
-		"outgoing: %v%%", r.MinimumIncoming, r.MinimumOutgoing)
+		"outgoing: %v%%, success cooldown: %v", r.MinimumIncoming,
+		r.MinimumOutgoing, r.SuccessCooldown)
 }
 
 // validate validates the parameters that a rule was created with.
@@ -59,9 +73,39 @@ func (r *ThresholdRule) validate() error {
 		return errInvalidThresholdSum
 	}
 
+	if r.SuccessCooldown < 0 {
+		return errNegativeCooldown
+	}
+
 	return nil
 }
 
+// deficits returns the amount of incoming and outgoing liquidity that a set of
+// balances is short of our thresholds by. Zero amounts indicate that the
+// balances meet the threshold.
+func (r *ThresholdRule) deficits(balances *balances) (btcutil.Amount,
+	btcutil.Amount) {
+
+	minimumIncoming := btcutil.Amount(
+		uint64(balances.capacity) * uint64(r.MinimumIncoming) / 100,
+	)
+
+	minimumOutgoing := btcutil.Amount(
+		uint64(balances.capacity) * uint64(r.MinimumOutgoing) / 100,
+	)
+
+	var incoming, outgoing btcutil.Amount
+	if balances.incoming < minimumIncoming {
+		incoming = minimumIncoming - balances.incoming
+	}
+
+	if balances.outgoing < minimumOutgoing {
+		outgoing = minimumOutgoing - balances.outgoing
+	}
+
+	return incoming, outgoing
+}
+
 // swapAmount suggests a swap based on the liquidity thresholds configured,
 // returning zero if no swap is recommended.
 func (r *ThresholdRule) swapAmount(channel *balances,
@@ -133,6 +177,14 @@ func loopOutSwapAmount(balances *balances, incomingThresholdPercent,
 	// threshold.
 	available := balances.outgoing - minimumOutgoing
 
+	// We also cannot shift the portion of our outbound capacity that lnd
+	// holds for our channel reserve and commitment fees, otherwise our
+	// swap payment would fail for insufficient balance.
+	spendable := balances.outgoing - balances.outgoingReserve
+	if spendable < available {
+		available = spendable
+	}
+
 	// If we do not have enough balance available to reach our midpoint, we
 	// take no action. This is the case when we have a large portion of
 	// pending htlcs.
@@ -2,6 +2,7 @@ package liquidity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcutil"
 	"github.com/stretchr/testify/require"
@@ -79,6 +80,13 @@ func TestValidateThreshold(t *testing.T) {
 			},
 			err: errInvalidThresholdSum,
 		},
+		{
+			name: "negative cooldown",
+			threshold: ThresholdRule{
+				SuccessCooldown: -time.Second,
+			},
+			err: errNegativeCooldown,
+		},
 	}
 
 	for _, testCase := range tests {
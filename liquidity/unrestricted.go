@@ -0,0 +1,148 @@
+package liquidity
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// UnrestrictedMode describes how the liquidity manager accounts for pending
+// swaps that are not restricted to a set of channels or peers (ie, a loop out
+// with no outgoing channel set, or a loop in with no last hop). These swaps
+// may shift the balances of any of our channels, so we cannot know which
+// channels they will affect ahead of time.
+type UnrestrictedMode uint8
+
+const (
+	// UnrestrictedIgnore indicates that unrestricted swaps do not affect
+	// our suggestions.
+	UnrestrictedIgnore UnrestrictedMode = iota
+
+	// UnrestrictedFreeze indicates that we should not suggest any swaps
+	// while an unrestricted swap is pending.
+	UnrestrictedFreeze
+
+	// UnrestrictedReserve indicates that we should assume the worst case
+	// for pending unrestricted swaps, and exclude the channels with the
+	// largest relevant balances until the total amount of our unrestricted
+	// swaps is covered. This allows us to continue suggesting swaps for our
+	// remaining channels.
+	UnrestrictedReserve
+
+	// unrestrictedModeCount is the number of unrestricted modes that we
+	// support, used for validation.
+	unrestrictedModeCount
+)
+
+// ErrUnknownUnrestrictedMode is returned when an unknown unrestricted mode is
+// set.
+var ErrUnknownUnrestrictedMode = errors.New("unknown unrestricted swap mode")
+
+// String returns the string representation of an unrestricted mode.
+func (u UnrestrictedMode) String() string {
+	switch u {
+	case UnrestrictedIgnore:
+		return "ignore"
+
+	case UnrestrictedFreeze:
+		return "freeze"
+
+	case UnrestrictedReserve:
+		return "reserve"
+
+	default:
+		return "unknown"
+	}
+}
+
+// validate checks that an unrestricted mode is known.
+func (u UnrestrictedMode) validate() error {
+	if u >= unrestrictedModeCount {
+		return ErrUnknownUnrestrictedMode
+	}
+
+	return nil
+}
+
+// unrestrictedAmounts returns the total amount of our pending loop outs and
+// loop ins that are not restricted to specific channels or peers.
+func unrestrictedAmounts(loopOut []*loopdb.LoopOut,
+	loopIn []*loopdb.LoopIn) (btcutil.Amount, btcutil.Amount) {
+
+	var outAmt, inAmt btcutil.Amount
+
+	for _, out := range loopOut {
+		if out.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		if len(out.Contract.OutgoingChanSet) == 0 {
+			outAmt += out.Contract.AmountRequested
+		}
+	}
+
+	for _, in := range loopIn {
+		if in.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		if in.Contract.LastHop == nil {
+			inAmt += in.Contract.AmountRequested
+		}
+	}
+
+	return outAmt, inAmt
+}
+
+// reserveUnrestricted marks the channels that our pending unrestricted swaps
+// could affect as having ongoing swaps. Loop outs can only use our outgoing
+// balance, so we reserve the channels with the most local balance until the
+// total unrestricted loop out amount is covered. Likewise, we reserve the
+// peers of the channels with the most remote balance for loop in. Channels
+// without any relevant balance cannot be used by the swap, so they are never
+// reserved. This is a conservative estimate, because lnd may split payments
+// across channels with smaller balances.
+func (s *swapTraffic) reserveUnrestricted(channels []lndclient.ChannelInfo,
+	outAmt, inAmt btcutil.Amount) {
+
+	sorted := make([]lndclient.ChannelInfo, len(channels))
+
+	if outAmt > 0 {
+		copy(sorted, channels)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].LocalBalance > sorted[j].LocalBalance
+		})
+
+		var reserved btcutil.Amount
+		for _, channel := range sorted {
+			if reserved >= outAmt || channel.LocalBalance == 0 {
+				break
+			}
+
+			chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+			s.ongoingLoopOut[chanID] = true
+			reserved += channel.LocalBalance
+		}
+	}
+
+	if inAmt > 0 {
+		copy(sorted, channels)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].RemoteBalance > sorted[j].RemoteBalance
+		})
+
+		var reserved btcutil.Amount
+		for _, channel := range sorted {
+			if reserved >= inAmt || channel.RemoteBalance == 0 {
+				break
+			}
+
+			s.ongoingLoopIn[channel.PubKeyBytes] = true
+			reserved += channel.RemoteBalance
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package loop
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// lndReconnectBaseDelay is the time that we wait before we first try
+	// to resubscribe to lnd after our subscriptions failed.
+	lndReconnectBaseDelay = time.Second
+
+	// lndReconnectMaxDelay is the longest that we back off for between
+	// attempts to resubscribe to lnd.
+	lndReconnectMaxDelay = time.Minute
+
+	// maxLndConnEvents is the number of lnd connection state changes that
+	// we keep a record of.
+	maxLndConnEvents = 20
+)
+
+// LndConnState is the state of our connection to lnd.
+type LndConnState uint8
+
+const (
+	// LndConnConnected indicates that we are subscribed to lnd's block
+	// notifications.
+	LndConnConnected LndConnState = iota
+
+	// LndConnDisconnected indicates that our subscriptions to lnd failed,
+	// for example because lnd restarted, and that we are resubscribing.
+	LndConnDisconnected
+)
+
+// String returns a string representation of an lnd connection state.
+func (s LndConnState) String() string {
+	switch s {
+	case LndConnConnected:
+		return "Connected"
+
+	case LndConnDisconnected:
+		return "Disconnected"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// LndConnEvent is a change in the state of our connection to lnd.
+type LndConnEvent struct {
+	// State is the state that the connection changed to.
+	State LndConnState
+
+	// Time is the time at which the connection changed state.
+	Time time.Time
+
+	// ResumedSwaps is the number of pending swaps that were interrupted
+	// while we were disconnected, and resumed once we reconnected. It is
+	// only set for reconnects.
+	ResumedSwaps int
+}
+
+// LndConnInfo describes the health of our connection to lnd.
+type LndConnInfo struct {
+	// State is the current state of the connection.
+	State LndConnState
+
+	// Since is the time at which the connection entered its current
+	// state.
+	Since time.Time
+
+	// Reconnects is the number of times that we resubscribed to lnd after
+	// our subscriptions failed.
+	Reconnects uint32
+
+	// Events holds the most recent changes in the connection's state,
+	// ordered from oldest to newest.
+	Events []LndConnEvent
+}
+
+// lndConnMonitor tracks the state of our connection to lnd.
+type lndConnMonitor struct {
+	now func() time.Time
+
+	info LndConnInfo
+	lock sync.Mutex
+}
+
+// newLndConnMonitor creates a monitor for our connection to lnd.
+func newLndConnMonitor(now func() time.Time) *lndConnMonitor {
+	return &lndConnMonitor{
+		now: now,
+	}
+}
+
+// connected records that we are subscribed to lnd, having resumed the number
+// of swaps provided.
+func (m *lndConnMonitor) connected(resumedSwaps int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if len(m.info.Events) > 0 {
+		m.info.Reconnects++
+		log.Infof("Resubscribed to lnd, resumed %v swaps",
+			resumedSwaps)
+	}
+
+	m.record(LndConnEvent{
+		State:        LndConnConnected,
+		ResumedSwaps: resumedSwaps,
+	})
+}
+
+// disconnected records that our subscriptions to lnd failed.
+func (m *lndConnMonitor) disconnected(err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	log.Warnf("Lost connection to lnd, resubscribing: %v", err)
+
+	m.record(LndConnEvent{
+		State: LndConnDisconnected,
+	})
+}
+
+// record updates our connection info with the event provided. The caller must
+// hold the monitor's lock.
+func (m *lndConnMonitor) record(event LndConnEvent) {
+	event.Time = m.now()
+
+	m.info.State = event.State
+	m.info.Since = event.Time
+
+	m.info.Events = append(m.info.Events, event)
+	if len(m.info.Events) > maxLndConnEvents {
+		m.info.Events = m.info.Events[1:]
+	}
+}
+
+// getInfo returns a copy of our current connection info.
+func (m *lndConnMonitor) getInfo() LndConnInfo {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	info := m.info
+	info.Events = make([]LndConnEvent, len(m.info.Events))
+	copy(info.Events, m.info.Events)
+
+	return info
+}
+
+// LndConnInfo returns the state of our connection to lnd.
+func (s *Client) LndConnInfo() LndConnInfo {
+	return s.executor.lndConn.getInfo()
+}
+
+// nextLndReconnectDelay returns the time that we wait before our next attempt
+// to resubscribe to lnd, given the delay before our previous attempt.
+func nextLndReconnectDelay(delay time.Duration) time.Duration {
+	delay *= 2
+	if delay > lndReconnectMaxDelay {
+		delay = lndReconnectMaxDelay
+	}
+
+	return delay
+}
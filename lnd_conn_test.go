@@ -0,0 +1,56 @@
+package loop
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLndConnMonitor tests tracking of our lnd connection's state changes and
+// reconnects.
+func TestLndConnMonitor(t *testing.T) {
+	now := time.Unix(1000, 0)
+	monitor := newLndConnMonitor(func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	})
+
+	// Our first connection is not a reconnect.
+	monitor.connected(0)
+	monitor.disconnected(errors.New("lnd restarted"))
+	monitor.connected(2)
+
+	info := monitor.getInfo()
+	require.Equal(t, LndConnConnected, info.State)
+	require.Equal(t, time.Unix(1003, 0), info.Since)
+	require.Equal(t, uint32(1), info.Reconnects)
+	require.Len(t, info.Events, 3)
+	require.Equal(t, LndConnDisconnected, info.Events[1].State)
+	require.Equal(t, 2, info.Events[2].ResumedSwaps)
+
+	// We only keep a limited number of events.
+	for i := 0; i < maxLndConnEvents; i++ {
+		monitor.disconnected(errors.New("lnd restarted"))
+		monitor.connected(0)
+	}
+
+	info = monitor.getInfo()
+	require.Len(t, info.Events, maxLndConnEvents)
+	require.Equal(t, uint32(1+maxLndConnEvents), info.Reconnects)
+}
+
+// TestNextLndReconnectDelay tests backing off between attempts to resubscribe
+// to lnd.
+func TestNextLndReconnectDelay(t *testing.T) {
+	delay := lndReconnectBaseDelay
+
+	delay = nextLndReconnectDelay(delay)
+	require.Equal(t, 2*lndReconnectBaseDelay, delay)
+
+	for i := 0; i < 10; i++ {
+		delay = nextLndReconnectDelay(delay)
+	}
+	require.Equal(t, lndReconnectMaxDelay, delay)
+}
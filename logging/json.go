@@ -0,0 +1,304 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btclog"
+)
+
+const (
+	// FieldTime is the field that the time of a log entry is recorded in.
+	FieldTime = "time"
+
+	// FieldLevel is the field that the level of a log entry is recorded
+	// in.
+	FieldLevel = "level"
+
+	// FieldSubsystem is the field that the subsystem that created a log
+	// entry is recorded in.
+	FieldSubsystem = "subsystem"
+
+	// FieldMessage is the field that the message of a log entry is
+	// recorded in.
+	FieldMessage = "msg"
+
+	// FieldSwapID is the field that the hash of the swap that a log entry
+	// relates to is recorded in.
+	FieldSwapID = "swap_id"
+
+	// FieldState is the field that the state of the swap that a log entry
+	// relates to is recorded in.
+	FieldState = "state"
+)
+
+// levelNames maps log levels to the names that we record them under, which
+// match the names used to set levels with the debuglevel option.
+var levelNames = map[btclog.Level]string{
+	btclog.LevelTrace:    "trace",
+	btclog.LevelDebug:    "debug",
+	btclog.LevelInfo:     "info",
+	btclog.LevelWarn:     "warn",
+	btclog.LevelError:    "error",
+	btclog.LevelCritical: "critical",
+}
+
+// Fields is a set of key/value pairs that are included in structured log
+// entries.
+type Fields map[string]string
+
+// FieldLogger is implemented by loggers that are able to include fields in
+// their log entries.
+type FieldLogger interface {
+	btclog.Logger
+
+	// WithFields returns a logger that includes the fields provided in
+	// all of its log entries, in addition to any fields that the logger
+	// already includes. The logger returned shares its level with the
+	// original logger.
+	WithFields(fields Fields) btclog.Logger
+}
+
+// WithFields returns a logger that includes the fields provided in all of its
+// log entries. If the logger provided does not support fields, it is returned
+// unchanged.
+func WithFields(logger btclog.Logger, fields Fields) btclog.Logger {
+	fieldLogger, ok := logger.(FieldLogger)
+	if !ok {
+		return logger
+	}
+
+	return fieldLogger.WithFields(fields)
+}
+
+// JSONBackend writes log entries to a writer as newline delimited json
+// objects.
+type JSONBackend struct {
+	w io.Writer
+
+	// now returns the current time, and is used to timestamp entries.
+	now func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewJSONBackend creates a backend that writes json log entries to the writer
+// provided.
+func NewJSONBackend(w io.Writer) *JSONBackend {
+	return &JSONBackend{
+		w:   w,
+		now: time.Now,
+	}
+}
+
+// Logger returns a logger for a subsystem which writes to our backend. The
+// shutdown function provided is called when a critical entry is logged, and
+// may be nil. The logger uses the info level by default.
+func (b *JSONBackend) Logger(subsystem string, shutdown func()) btclog.Logger {
+	level := uint32(btclog.LevelInfo)
+
+	return &jsonLogger{
+		backend:   b,
+		subsystem: subsystem,
+		level:     &level,
+		shutdown:  shutdown,
+	}
+}
+
+// write writes a single entry to our backend.
+func (b *JSONBackend) write(level btclog.Level, subsystem, msg string,
+	fields Fields) {
+
+	entry := make(map[string]string, len(fields)+4)
+	for key, value := range fields {
+		entry[key] = value
+	}
+
+	entry[FieldTime] = b.now().UTC().Format(time.RFC3339Nano)
+	entry[FieldLevel] = levelNames[level]
+	entry[FieldSubsystem] = subsystem
+	entry[FieldMessage] = msg
+
+	// Marshalling a map of strings cannot fail, so we can safely ignore
+	// the error here.
+	line, _ := json.Marshal(entry)
+	line = append(line, '\n')
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, _ = b.w.Write(line)
+}
+
+// jsonLogger is a subsystem logger for a json backend. It implements the
+// FieldLogger interface.
+type jsonLogger struct {
+	backend *JSONBackend
+
+	subsystem string
+
+	// level is the logger's current level. It is shared by all of the
+	// loggers derived from this one with WithFields, so that setting the
+	// level of a subsystem applies to all of its loggers. It must be
+	// accessed atomically.
+	level *uint32
+
+	// fields are the fields that are included in all of the logger's
+	// entries.
+	fields Fields
+
+	// shutdown is called when a critical entry is logged, if non-nil.
+	shutdown func()
+}
+
+// A compile time assertion to ensure that jsonLogger satisfies the
+// FieldLogger interface.
+var _ FieldLogger = (*jsonLogger)(nil)
+
+// WithFields returns a logger that includes the fields provided in all of its
+// entries.
+//
+// NOTE: This is part of the FieldLogger interface.
+func (l *jsonLogger) WithFields(fields Fields) btclog.Logger {
+	merged := make(Fields, len(l.fields)+len(fields))
+	for key, value := range l.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+
+	return &jsonLogger{
+		backend:   l.backend,
+		subsystem: l.subsystem,
+		level:     l.level,
+		fields:    merged,
+		shutdown:  l.shutdown,
+	}
+}
+
+// print writes an entry formatted with the default formats for its operands
+// if our level permits it.
+func (l *jsonLogger) print(level btclog.Level, args ...interface{}) {
+	if l.Level() > level {
+		return
+	}
+
+	// We trim the newline that Sprintln adds, but use it rather than
+	// Sprint so that operands are always separated by spaces, matching
+	// the text backend.
+	msg := strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+	l.backend.write(level, l.subsystem, msg, l.fields)
+}
+
+// printf writes an entry formatted according to the format specifier provided
+// if our level permits it.
+func (l *jsonLogger) printf(level btclog.Level, format string,
+	args ...interface{}) {
+
+	if l.Level() > level {
+		return
+	}
+
+	msg := fmt.Sprintf(format, args...)
+	l.backend.write(level, l.subsystem, msg, l.fields)
+}
+
+// Tracef formats message according to format specifier and writes to log
+// with LevelTrace.
+func (l *jsonLogger) Tracef(format string, params ...interface{}) {
+	l.printf(btclog.LevelTrace, format, params...)
+}
+
+// Debugf formats message according to format specifier and writes to log
+// with LevelDebug.
+func (l *jsonLogger) Debugf(format string, params ...interface{}) {
+	l.printf(btclog.LevelDebug, format, params...)
+}
+
+// Infof formats message according to format specifier and writes to log
+// with LevelInfo.
+func (l *jsonLogger) Infof(format string, params ...interface{}) {
+	l.printf(btclog.LevelInfo, format, params...)
+}
+
+// Warnf formats message according to format specifier and writes to log
+// with LevelWarn.
+func (l *jsonLogger) Warnf(format string, params ...interface{}) {
+	l.printf(btclog.LevelWarn, format, params...)
+}
+
+// Errorf formats message according to format specifier and writes to log
+// with LevelError.
+func (l *jsonLogger) Errorf(format string, params ...interface{}) {
+	l.printf(btclog.LevelError, format, params...)
+}
+
+// Criticalf formats message according to format specifier and writes to log
+// with LevelCritical, then requests shutdown.
+func (l *jsonLogger) Criticalf(format string, params ...interface{}) {
+	l.printf(btclog.LevelCritical, format, params...)
+	l.requestShutdown()
+}
+
+// Trace formats message using the default formats for its operands and
+// writes to log with LevelTrace.
+func (l *jsonLogger) Trace(v ...interface{}) {
+	l.print(btclog.LevelTrace, v...)
+}
+
+// Debug formats message using the default formats for its operands and
+// writes to log with LevelDebug.
+func (l *jsonLogger) Debug(v ...interface{}) {
+	l.print(btclog.LevelDebug, v...)
+}
+
+// Info formats message using the default formats for its operands and writes
+// to log with LevelInfo.
+func (l *jsonLogger) Info(v ...interface{}) {
+	l.print(btclog.LevelInfo, v...)
+}
+
+// Warn formats message using the default formats for its operands and writes
+// to log with LevelWarn.
+func (l *jsonLogger) Warn(v ...interface{}) {
+	l.print(btclog.LevelWarn, v...)
+}
+
+// Error formats message using the default formats for its operands and
+// writes to log with LevelError.
+func (l *jsonLogger) Error(v ...interface{}) {
+	l.print(btclog.LevelError, v...)
+}
+
+// Critical formats message using the default formats for its operands and
+// writes to log with LevelCritical, then requests shutdown.
+func (l *jsonLogger) Critical(v ...interface{}) {
+	l.print(btclog.LevelCritical, v...)
+	l.requestShutdown()
+}
+
+// requestShutdown calls our shutdown function, if we have one.
+func (l *jsonLogger) requestShutdown() {
+	if l.shutdown == nil {
+		return
+	}
+
+	l.Info("Sending request for shutdown")
+	l.shutdown()
+}
+
+// Level returns the current logging level.
+func (l *jsonLogger) Level() btclog.Level {
+	return btclog.Level(atomic.LoadUint32(l.level))
+}
+
+// SetLevel changes the logging level to the passed level.
+func (l *jsonLogger) SetLevel(level btclog.Level) {
+	atomic.StoreUint32(l.level, uint32(level))
+}
@@ -0,0 +1,121 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBackend creates a backend that writes to a buffer with a fixed
+// timestamp.
+func newTestBackend(timestamp time.Time) (*JSONBackend, *bytes.Buffer) {
+	var buf bytes.Buffer
+
+	backend := NewJSONBackend(&buf)
+	backend.now = func() time.Time {
+		return timestamp
+	}
+
+	return backend, &buf
+}
+
+// readEntries decodes the entries that have been written to a buffer.
+func readEntries(t *testing.T, buf *bytes.Buffer) []map[string]string {
+	var entries []map[string]string
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		var entry map[string]string
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// TestJSONLogger tests the entries written by our json logger, and that
+// loggers created with fields share their level with their parent.
+func TestJSONLogger(t *testing.T) {
+	timestamp := time.Unix(1000, 0)
+	backend, buf := newTestBackend(timestamp)
+
+	logger := backend.Logger("LOOP", nil)
+	swapLogger := WithFields(logger, Fields{
+		FieldSwapID: "abcd",
+		FieldState:  "Initiated",
+	})
+
+	logger.Infof("started %v", 1)
+	swapLogger.Debug("not logged")
+	swapLogger.Warn("swap", "update")
+
+	// Lowering the level of the parent logger should apply to the logger
+	// that we created with fields.
+	logger.SetLevel(btclog.LevelDebug)
+	swapLogger.Debugf("now logged")
+
+	entryTime := timestamp.UTC().Format(time.RFC3339Nano)
+	expected := []map[string]string{
+		{
+			FieldTime:      entryTime,
+			FieldLevel:     "info",
+			FieldSubsystem: "LOOP",
+			FieldMessage:   "started 1",
+		},
+		{
+			FieldTime:      entryTime,
+			FieldLevel:     "warn",
+			FieldSubsystem: "LOOP",
+			FieldMessage:   "swap update",
+			FieldSwapID:    "abcd",
+			FieldState:     "Initiated",
+		},
+		{
+			FieldTime:      entryTime,
+			FieldLevel:     "debug",
+			FieldSubsystem: "LOOP",
+			FieldMessage:   "now logged",
+			FieldSwapID:    "abcd",
+			FieldState:     "Initiated",
+		},
+	}
+
+	require.Equal(t, expected, readEntries(t, buf))
+}
+
+// TestWithFieldsUnsupported tests that loggers that do not support fields are
+// returned unchanged.
+func TestWithFieldsUnsupported(t *testing.T) {
+	logger := btclog.NewBackend(&bytes.Buffer{}).Logger("LOOP")
+
+	require.Equal(t, logger, WithFields(logger, Fields{
+		FieldSwapID: "abcd",
+	}))
+}
+
+// TestCriticalShutdown tests that logging a critical entry requests shutdown.
+func TestCriticalShutdown(t *testing.T) {
+	backend, buf := newTestBackend(time.Unix(1000, 0))
+
+	var shutdown bool
+	logger := backend.Logger("LOOP", func() {
+		shutdown = true
+	})
+
+	logger.Criticalf("failure")
+	require.True(t, shutdown)
+
+	entries := readEntries(t, buf)
+	require.Len(t, entries, 2)
+	require.Equal(t, "critical", entries[0][FieldLevel])
+}
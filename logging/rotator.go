@@ -0,0 +1,65 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/jrick/logrotate/rotator"
+)
+
+// RotatingWriter writes to stdout and to a log file which is rotated once it
+// reaches its maximum size.
+type RotatingWriter struct {
+	rotator *rotator.Rotator
+
+	pipe *io.PipeWriter
+}
+
+// NewRotatingWriter creates a writer that writes to stdout and to the log file
+// provided. Up to maxLogFiles rotated files of maxLogFileSize MB are kept.
+func NewRotatingWriter(logFile string, maxLogFileSize,
+	maxLogFiles int) (*RotatingWriter, error) {
+
+	logDir, _ := filepath.Split(logFile)
+	if err := os.MkdirAll(logDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %v", err)
+	}
+
+	r, err := rotator.New(
+		logFile, int64(maxLogFileSize*1024), false, maxLogFiles,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file rotator: %v", err)
+	}
+
+	// The rotator reads from a pipe, so we run it in a goroutine which
+	// reports any failures, because we have no other way of surfacing
+	// them once we are running.
+	pr, pw := io.Pipe()
+	go func() {
+		if err := r.Run(pr); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr,
+				"failed to run file rotator: %v\n", err)
+		}
+	}()
+
+	return &RotatingWriter{
+		rotator: r,
+		pipe:    pw,
+	}, nil
+}
+
+// Write writes the bytes provided to stdout and our log file.
+func (r *RotatingWriter) Write(b []byte) (int, error) {
+	_, _ = os.Stdout.Write(b)
+	_, _ = r.pipe.Write(b)
+
+	return len(b), nil
+}
+
+// Close closes our log file.
+func (r *RotatingWriter) Close() error {
+	return r.rotator.Close()
+}
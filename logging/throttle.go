@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btclog"
+)
+
+// DefaultThrottleInterval is the default interval within which repeats of a
+// throttled message are suppressed.
+const DefaultThrottleInterval = 10 * time.Minute
+
+// DefaultThrottle is the throttle that our subsystems log messages that repeat
+// on every poll or block with.
+var DefaultThrottle = NewThrottle(DefaultThrottleInterval)
+
+// throttleKey identifies a throttled message by the subsystem that logs it,
+// the object (such as a swap or channel) that it relates to and its format
+// string.
+type throttleKey struct {
+	subsystem string
+	id        string
+	format    string
+}
+
+// throttleEntry tracks the repeats of a throttled message.
+type throttleEntry struct {
+	// logged is the time at which the message was last logged.
+	logged time.Time
+
+	// suppressed is the number of repeats of the message that were
+	// suppressed since it was last logged.
+	suppressed int
+}
+
+// Throttle suppresses repeats of log messages that are logged within an
+// interval of each other. When a message is logged again after its interval
+// has passed, the number of repeats that were suppressed is included with it,
+// so that the log still summarizes how often the message occurred.
+type Throttle struct {
+	// interval is the interval within which repeats of a message are
+	// suppressed. If it is zero, no messages are suppressed.
+	interval time.Duration
+
+	// now returns the current time.
+	now func() time.Time
+
+	// entries holds the messages that we have logged recently, or that
+	// have suppressed repeats.
+	entries map[throttleKey]*throttleEntry
+
+	// lastPrune is the time at which we last removed expired entries.
+	lastPrune time.Time
+
+	mu sync.Mutex
+}
+
+// NewThrottle creates a throttle which suppresses repeats of a message within
+// the interval provided.
+func NewThrottle(interval time.Duration) *Throttle {
+	return &Throttle{
+		interval: interval,
+		now:      time.Now,
+		entries:  make(map[throttleKey]*throttleEntry),
+	}
+}
+
+// SetInterval updates the interval within which repeats of a message are
+// suppressed. An interval of zero disables throttling.
+func (t *Throttle) SetInterval(interval time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.interval = interval
+}
+
+// allow returns a boolean indicating whether a message should be logged, and
+// the number of its repeats that were suppressed since it was last logged.
+func (t *Throttle) allow(key throttleKey) (bool, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.interval == 0 {
+		return true, 0
+	}
+
+	now := t.now()
+	t.prune(now)
+
+	entry, ok := t.entries[key]
+	if ok && now.Sub(entry.logged) < t.interval {
+		entry.suppressed++
+		return false, 0
+	}
+
+	var suppressed int
+	if ok {
+		suppressed = entry.suppressed
+	}
+
+	t.entries[key] = &throttleEntry{
+		logged: now,
+	}
+
+	return true, suppressed
+}
+
+// prune removes the entries of messages that were last logged more than an
+// interval ago, and have no suppressed repeats, at most once per interval.
+// Entries with suppressed repeats are kept so that their repeats can be
+// reported when the message is next logged.
+//
+// NOTE: This must be called with the throttle's mutex held.
+func (t *Throttle) prune(now time.Time) {
+	if now.Sub(t.lastPrune) < t.interval {
+		return
+	}
+
+	for key, entry := range t.entries {
+		if entry.suppressed == 0 &&
+			now.Sub(entry.logged) >= t.interval {
+
+			delete(t.entries, key)
+		}
+	}
+
+	t.lastPrune = now
+}
+
+// Logf logs a message with the logger and at the level provided, unless the
+// same message was logged for the subsystem and id provided within our
+// interval. Messages are identified by their format string, so repeats with
+// different parameters are also suppressed.
+func (t *Throttle) Logf(logger btclog.Logger, level btclog.Level, subsystem,
+	id, format string, params ...interface{}) {
+
+	// We don't track messages that would not be logged at the logger's
+	// current level.
+	if logger.Level() > level {
+		return
+	}
+
+	allowed, suppressed := t.allow(throttleKey{
+		subsystem: subsystem,
+		id:        id,
+		format:    format,
+	})
+	if !allowed {
+		return
+	}
+
+	msg := fmt.Sprintf(format, params...)
+	if suppressed > 0 {
+		msg = fmt.Sprintf("%v (repeated %v times since last logged)",
+			msg, suppressed)
+	}
+
+	switch level {
+	case btclog.LevelTrace:
+		logger.Trace(msg)
+
+	case btclog.LevelDebug:
+		logger.Debug(msg)
+
+	case btclog.LevelInfo:
+		logger.Info(msg)
+
+	case btclog.LevelWarn:
+		logger.Warn(msg)
+
+	case btclog.LevelError:
+		logger.Error(msg)
+
+	case btclog.LevelCritical:
+		logger.Critical(msg)
+	}
+}
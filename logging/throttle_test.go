@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestThrottle tests suppression of repeated messages, and that the number of
+// suppressed repeats is reported when a message is next logged.
+func TestThrottle(t *testing.T) {
+	var buf bytes.Buffer
+	logger := btclog.NewBackend(&buf).Logger("LQDY")
+	logger.SetLevel(btclog.LevelDebug)
+
+	now := time.Unix(1000, 0)
+	throttle := NewThrottle(time.Minute)
+	throttle.now = func() time.Time {
+		return now
+	}
+
+	logf := func(id, format string, params ...interface{}) {
+		throttle.Logf(
+			logger, btclog.LevelDebug, "LQDY", id, format,
+			params...,
+		)
+	}
+
+	// lines returns the messages that have been logged since it was last
+	// called.
+	lines := func() []string {
+		defer buf.Reset()
+
+		var msgs []string
+		for _, line := range strings.Split(buf.String(), "\n") {
+			if line == "" {
+				continue
+			}
+
+			// Strip the timestamp, level and subsystem.
+			parts := strings.SplitN(line, ": ", 2)
+			msgs = append(msgs, parts[1])
+		}
+
+		return msgs
+	}
+
+	logf("1", "channel %v inactive", 1)
+	logf("2", "channel %v inactive", 2)
+	require.Equal(t, []string{
+		"channel 1 inactive",
+		"channel 2 inactive",
+	}, lines())
+
+	// Repeats of a message for the same id are suppressed, even if their
+	// parameters differ.
+	now = now.Add(time.Second * 30)
+	logf("1", "channel %v inactive", 1)
+	logf("1", "channel %v inactive", 10)
+	logf("1", "channel %v closing", 1)
+	require.Equal(t, []string{"channel 1 closing"}, lines())
+
+	// Once our interval has passed, the message is logged again with the
+	// number of repeats that were suppressed.
+	now = now.Add(time.Minute)
+	logf("1", "channel %v inactive", 1)
+	logf("2", "channel %v inactive", 2)
+	require.Equal(t, []string{
+		"channel 1 inactive (repeated 2 times since last logged)",
+		"channel 2 inactive",
+	}, lines())
+
+	// Messages that would not be logged at the logger's level are not
+	// tracked.
+	logger.SetLevel(btclog.LevelInfo)
+	logf("3", "channel %v inactive", 3)
+	logger.SetLevel(btclog.LevelDebug)
+	logf("3", "channel %v inactive", 3)
+	require.Equal(t, []string{"channel 3 inactive"}, lines())
+
+	// Messages without repeats are pruned once our interval has passed.
+	now = now.Add(time.Minute)
+	logf("4", "channel %v inactive", 4)
+	require.Len(t, throttle.entries, 1)
+	require.Len(t, lines(), 1)
+
+	// Disabling throttling logs all repeats.
+	throttle.SetInterval(0)
+	logf("4", "channel %v inactive", 4)
+	require.Equal(t, []string{"channel 4 inactive"}, lines())
+}
@@ -0,0 +1,338 @@
+// Package loopclient is a thin Go client for loopd's rpc server. It loads the
+// daemon's TLS certificate and macaroon, and wraps the raw looprpc client with
+// typed methods that dispatch swaps with the same defaults as loop's cli.
+package loopclient
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopd"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc"
+)
+
+const (
+	// DefaultRPCServer is the default address of loopd's rpc server.
+	DefaultRPCServer = "localhost:11010"
+
+	// DefaultInitiator is the initiator that swaps are dispatched with if
+	// the client is not configured with one.
+	DefaultInitiator = "loopclient"
+
+	// DefaultSwapWaitTime is the time that the server may wait for before
+	// it publishes the htlc of a loop out that is not dispatched as fast.
+	DefaultSwapWaitTime = 30 * time.Minute
+)
+
+// Config holds the settings that the client connects to loopd with. Unset
+// fields are given the defaults that loopd itself uses.
+type Config struct {
+	// RPCServer is the address of loopd's rpc server, either a host:port,
+	// unix:///path/to/socket or unix-abstract://name address.
+	RPCServer string
+
+	// Network is the network that loopd is running on.
+	Network string
+
+	// LoopDir is loopd's base directory, which the TLS certificate and
+	// macaroon are read from if their paths are not set.
+	LoopDir string
+
+	// TLSCertPath is the path of loopd's TLS certificate.
+	TLSCertPath string
+
+	// MacaroonPath is the path of the macaroon that we authenticate with.
+	MacaroonPath string
+
+	// MacaroonTimeout is the number of seconds that the macaroon we send
+	// with each call is valid for.
+	MacaroonTimeout int64
+
+	// Initiator is the initiator that our swaps are dispatched with, which
+	// is reported to the server in loopd's user agent.
+	Initiator string
+}
+
+// withDefaults returns a copy of the config with its unset fields set to
+// their defaults.
+func (c Config) withDefaults() Config {
+	if c.RPCServer == "" {
+		c.RPCServer = DefaultRPCServer
+	}
+
+	if c.Network == "" {
+		c.Network = loopd.DefaultNetwork
+	}
+
+	if c.LoopDir == "" {
+		c.LoopDir = loopd.LoopDirBase
+	}
+	c.LoopDir = lncfg.CleanAndExpandPath(c.LoopDir)
+
+	if c.TLSCertPath == "" {
+		c.TLSCertPath = filepath.Join(
+			c.LoopDir, c.Network, loopd.DefaultTLSCertFilename,
+		)
+	}
+	c.TLSCertPath = lncfg.CleanAndExpandPath(c.TLSCertPath)
+
+	if c.MacaroonPath == "" {
+		c.MacaroonPath = filepath.Join(
+			c.LoopDir, c.Network, loopd.DefaultMacaroonFilename,
+		)
+	}
+	c.MacaroonPath = lncfg.CleanAndExpandPath(c.MacaroonPath)
+
+	if c.MacaroonTimeout == 0 {
+		c.MacaroonTimeout = DefaultMacaroonTimeout
+	}
+
+	if c.Initiator == "" {
+		c.Initiator = DefaultInitiator
+	}
+
+	return c
+}
+
+// Client is a connection to loopd's rpc server.
+type Client struct {
+	cfg  Config
+	conn *grpc.ClientConn
+	rpc  looprpc.SwapClientClient
+}
+
+// New connects to loopd with the config provided. The connection must be
+// closed with Close once the client is no longer used.
+func New(cfg Config) (*Client, error) {
+	cfg = cfg.withDefaults()
+
+	if _, err := loopd.ChainParams(cfg.Network); err != nil {
+		return nil, err
+	}
+
+	conn, err := dial(
+		cfg.RPCServer, cfg.TLSCertPath, cfg.MacaroonPath,
+		cfg.MacaroonTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		cfg:  cfg,
+		conn: conn,
+		rpc:  looprpc.NewSwapClientClient(conn),
+	}, nil
+}
+
+// Close closes the client's connection to loopd.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// RPC returns the raw rpc client, for calls that the client does not wrap.
+func (c *Client) RPC() looprpc.SwapClientClient {
+	return c.rpc
+}
+
+// GetInfo returns general information about loopd.
+func (c *Client) GetInfo(ctx context.Context) (*looprpc.GetInfoResponse,
+	error) {
+
+	return c.rpc.GetInfo(ctx, &looprpc.GetInfoRequest{})
+}
+
+// ListSwaps returns all of loopd's swaps.
+func (c *Client) ListSwaps(ctx context.Context) ([]*looprpc.SwapStatus,
+	error) {
+
+	resp, err := c.rpc.ListSwaps(ctx, &looprpc.ListSwapsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Swaps, nil
+}
+
+// SwapInfo returns the swap with the hash provided.
+func (c *Client) SwapInfo(ctx context.Context,
+	hash lntypes.Hash) (*looprpc.SwapStatus, error) {
+
+	return c.rpc.SwapInfo(ctx, &looprpc.SwapInfoRequest{
+		Id: hash[:],
+	})
+}
+
+// Monitor returns an iterator over the updates of loopd's swaps. The iterator
+// ends when the context is cancelled or the iterator is closed.
+func (c *Client) Monitor(ctx context.Context) (*SwapIterator, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.rpc.Monitor(ctx, &looprpc.MonitorRequest{})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return newSwapIterator(stream, cancel), nil
+}
+
+// LoopOutRequest describes a loop out that is dispatched by the client. Only
+// the amount is required.
+type LoopOutRequest struct {
+	// Amount is the amount that we swap.
+	Amount btcutil.Amount
+
+	// Dest is the address that the swap is swept to. If it is empty, loopd
+	// generates an address in lnd's wallet.
+	Dest string
+
+	// SweepConfTarget is the confirmation target of the sweep. If it is
+	// zero, loop's default target is used.
+	SweepConfTarget int32
+
+	// HtlcConfirmations is the number of confirmations that we require
+	// for the htlc. If it is zero, loop's default is used.
+	HtlcConfirmations int32
+
+	// OutgoingChanSet restricts the channels that the swap is paid
+	// through.
+	OutgoingChanSet []uint64
+
+	// Fast requests that the server publishes the htlc immediately,
+	// rather than batching it for up to the default swap wait time.
+	Fast bool
+
+	// Label is an optional label for the swap.
+	Label string
+
+	// RequestID makes the request idempotent if it is set.
+	RequestID string
+
+	// Limits overrides the fee limits that are derived from the quote for
+	// the swap.
+	Limits *OutLimits
+}
+
+// LoopOut quotes and dispatches a loop out. Unless the request has limits set,
+// the swap is dispatched with the default limits for its quote.
+func (c *Client) LoopOut(ctx context.Context,
+	req *LoopOutRequest) (*looprpc.SwapResponse, error) {
+
+	confTarget := req.SweepConfTarget
+	if confTarget == 0 {
+		confTarget = loop.DefaultSweepConfTarget
+	}
+
+	htlcConfs := req.HtlcConfirmations
+	if htlcConfs == 0 {
+		htlcConfs = int32(loopdb.DefaultLoopOutHtlcConfirmations)
+	}
+
+	deadline := time.Now()
+	if !req.Fast {
+		deadline = deadline.Add(DefaultSwapWaitTime)
+	}
+
+	limits := req.Limits
+	if limits == nil {
+		quote, err := c.rpc.LoopOutQuote(ctx, &looprpc.QuoteRequest{
+			Amt:                     int64(req.Amount),
+			ConfTarget:              confTarget,
+			SwapPublicationDeadline: uint64(deadline.Unix()),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("loop out quote: %v", err)
+		}
+
+		limits = DefaultOutLimits(req.Amount, quote)
+	}
+
+	return c.rpc.LoopOut(ctx, &looprpc.LoopOutRequest{
+		Amt:                     int64(req.Amount),
+		Dest:                    req.Dest,
+		MaxMinerFee:             int64(limits.MaxMinerFee),
+		MaxPrepayAmt:            int64(limits.MaxPrepayAmt),
+		MaxSwapFee:              int64(limits.MaxSwapFee),
+		MaxPrepayRoutingFee:     int64(limits.MaxPrepayRoutingFee),
+		MaxSwapRoutingFee:       int64(limits.MaxSwapRoutingFee),
+		OutgoingChanSet:         req.OutgoingChanSet,
+		SweepConfTarget:         confTarget,
+		HtlcConfirmations:       htlcConfs,
+		SwapPublicationDeadline: uint64(deadline.Unix()),
+		Label:                   req.Label,
+		Initiator:               c.cfg.Initiator,
+		RequestId:               req.RequestID,
+	})
+}
+
+// LoopInRequest describes a loop in that is dispatched by the client. Only the
+// amount is required.
+type LoopInRequest struct {
+	// Amount is the amount that we swap.
+	Amount btcutil.Amount
+
+	// HtlcConfTarget is the confirmation target of the htlc that we
+	// publish. If it is zero, loopd's default target is used. It must not
+	// be set for external htlcs.
+	HtlcConfTarget int32
+
+	// ExternalHtlc is set if the htlc is published by an external wallet
+	// rather than by loopd.
+	ExternalHtlc bool
+
+	// LastHop is the optional pubkey of the last hop that the swap is
+	// paid to us through.
+	LastHop []byte
+
+	// Label is an optional label for the swap.
+	Label string
+
+	// RequestID makes the request idempotent if it is set.
+	RequestID string
+
+	// Limits overrides the fee limits that are derived from the quote for
+	// the swap.
+	Limits *InLimits
+}
+
+// LoopIn quotes and dispatches a loop in. Unless the request has limits set,
+// the swap is dispatched with the default limits for its quote.
+func (c *Client) LoopIn(ctx context.Context,
+	req *LoopInRequest) (*looprpc.SwapResponse, error) {
+
+	limits := req.Limits
+	if limits == nil {
+		quote, err := c.rpc.GetLoopInQuote(ctx, &looprpc.QuoteRequest{
+			Amt:           int64(req.Amount),
+			ConfTarget:    req.HtlcConfTarget,
+			ExternalHtlc:  req.ExternalHtlc,
+			LoopInLastHop: req.LastHop,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("loop in quote: %v", err)
+		}
+
+		limits = DefaultInLimits(quote)
+	}
+
+	return c.rpc.LoopIn(ctx, &looprpc.LoopInRequest{
+		Amt:            int64(req.Amount),
+		MaxMinerFee:    int64(limits.MaxMinerFee),
+		MaxSwapFee:     int64(limits.MaxSwapFee),
+		LastHop:        req.LastHop,
+		ExternalHtlc:   req.ExternalHtlc,
+		HtlcConfTarget: req.HtlcConfTarget,
+		Label:          req.Label,
+		Initiator:      c.cfg.Initiator,
+		RequestId:      req.RequestID,
+	})
+}
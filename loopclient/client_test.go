@@ -0,0 +1,142 @@
+package loopclient
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopd"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConfigDefaults tests that unset config fields are given loopd's
+// defaults, and that the TLS certificate and macaroon are found in a custom
+// loop directory.
+func TestConfigDefaults(t *testing.T) {
+	cfg := Config{}.withDefaults()
+	require.Equal(t, DefaultRPCServer, cfg.RPCServer)
+	require.Equal(t, loopd.DefaultNetwork, cfg.Network)
+	require.Equal(t, loopd.DefaultTLSCertPath, cfg.TLSCertPath)
+	require.Equal(t, loopd.DefaultMacaroonPath, cfg.MacaroonPath)
+	require.Equal(t, DefaultMacaroonTimeout, cfg.MacaroonTimeout)
+	require.Equal(t, DefaultInitiator, cfg.Initiator)
+
+	cfg = Config{
+		Network:   "testnet",
+		LoopDir:   "/loop",
+		Initiator: "platform",
+	}.withDefaults()
+	require.Equal(t, filepath.Join(
+		"/loop", "testnet", loopd.DefaultTLSCertFilename,
+	), cfg.TLSCertPath)
+	require.Equal(t, filepath.Join(
+		"/loop", "testnet", loopd.DefaultMacaroonFilename,
+	), cfg.MacaroonPath)
+	require.Equal(t, "platform", cfg.Initiator)
+}
+
+// TestDefaultLimits tests the default fee limits that swaps are dispatched
+// with.
+func TestDefaultLimits(t *testing.T) {
+	outLimits := DefaultOutLimits(
+		100000, &looprpc.OutQuoteResponse{
+			SwapFeeSat:      100,
+			PrepayAmtSat:    1000,
+			HtlcSweepFeeSat: 10,
+		},
+	)
+	require.Equal(t, &OutLimits{
+		MaxSwapRoutingFee:   MaxRoutingFee(100000),
+		MaxPrepayRoutingFee: MaxRoutingFee(1000),
+		MaxMinerFee:         2500,
+		MaxSwapFee:          100,
+		MaxPrepayAmt:        1000,
+	}, outLimits)
+
+	inLimits := DefaultInLimits(&looprpc.InQuoteResponse{
+		SwapFeeSat:        100,
+		HtlcPublishFeeSat: 10,
+	})
+	require.Equal(t, &InLimits{
+		MaxMinerFee: 30,
+		MaxSwapFee:  100,
+	}, inLimits)
+
+	require.Equal(t, btcutil.Amount(2010), MaxRoutingFee(100000))
+}
+
+// mockStream is a swap stream that returns a fixed set of updates, followed
+// by an error.
+type mockStream struct {
+	swaps []*looprpc.SwapStatus
+	err   error
+}
+
+// Recv returns the next update in the stream.
+func (m *mockStream) Recv() (*looprpc.SwapStatus, error) {
+	if len(m.swaps) == 0 {
+		return nil, m.err
+	}
+
+	swap := m.swaps[0]
+	m.swaps = m.swaps[1:]
+
+	return swap, nil
+}
+
+// TestSwapIterator tests iteration over a stream of swap updates.
+func TestSwapIterator(t *testing.T) {
+	errStream := errors.New("stream failed")
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "stream ends",
+			err:  io.EOF,
+		},
+		{
+			name: "stream fails",
+			err:  errStream,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			swaps := []*looprpc.SwapStatus{
+				{Id: "1"}, {Id: "2"},
+			}
+
+			var cancelled int
+			updates := newSwapIterator(
+				&mockStream{
+					swaps: swaps,
+					err:   test.err,
+				},
+				func() { cancelled++ },
+			)
+
+			var received []*looprpc.SwapStatus
+			for updates.Next() {
+				require.NoError(t, updates.Err())
+				received = append(received, updates.Swap())
+			}
+
+			require.Equal(t, swaps, received)
+			require.Equal(t, test.err, updates.Err())
+			require.Nil(t, updates.Swap())
+			require.Equal(t, 1, cancelled)
+
+			// Once the stream has ended, we don't read from it
+			// again.
+			require.False(t, updates.Next())
+			require.Equal(t, 1, cancelled)
+		})
+	}
+}
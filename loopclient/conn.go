@@ -0,0 +1,124 @@
+package loopclient
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/lightninglabs/loop/loopd"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"gopkg.in/macaroon.v2"
+)
+
+var (
+	// maxMsgRecvSize is the largest message our client will receive. We
+	// set this to 200MiB atm.
+	maxMsgRecvSize = grpc.MaxCallRecvMsgSize(1 * 1024 * 1024 * 200)
+
+	// DefaultMacaroonTimeout is the default macaroon timeout in seconds
+	// that we set when sending it over the line.
+	DefaultMacaroonTimeout int64 = 60
+)
+
+// Dial connects to the loopd rpc server at the address provided, which is
+// either a host:port, unix:///path/to/socket or unix-abstract://name address,
+// using the TLS certificate and the macaroon at the paths provided. The
+// macaroon is sent with every call, constrained to the default macaroon
+// timeout.
+func Dial(address, tlsCertPath, macaroonPath string) (*grpc.ClientConn,
+	error) {
+
+	return dial(address, tlsCertPath, macaroonPath, DefaultMacaroonTimeout)
+}
+
+// dial connects to the loopd rpc server, constraining the macaroon that we
+// send to the timeout provided.
+func dial(address, tlsCertPath, macaroonPath string,
+	macaroonTimeout int64) (*grpc.ClientConn, error) {
+
+	// We always need to send a macaroon.
+	macOption, err := MacaroonDialOption(macaroonPath, macaroonTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithDefaultCallOptions(maxMsgRecvSize),
+		macOption,
+	}
+
+	// TLS cannot be disabled, we'll always have a cert file to read.
+	creds, err := credentials.NewClientTLSFromFile(tlsCertPath, "")
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, grpc.WithTransportCredentials(creds))
+
+	// Local socket addresses are dialed with a custom dialer.
+	target, socketOpts, err := loopd.RPCDialOptions(address)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, socketOpts...)
+
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to RPC server: %v",
+			err)
+	}
+
+	return conn, nil
+}
+
+// MacaroonDialOption reads the macaroon file at the specified path and creates
+// a gRPC dial option that sends it with every call, valid for the number of
+// seconds provided.
+func MacaroonDialOption(macPath string, timeout int64) (grpc.DialOption,
+	error) {
+
+	mac, err := LoadMacaroon(macPath)
+	if err != nil {
+		return nil, err
+	}
+
+	macConstraints := []macaroons.Constraint{
+		// We add a time-based constraint to prevent replay of the
+		// macaroon. It's good for 60 seconds by default to make up for
+		// any discrepancy between client and server clocks, but leaking
+		// the macaroon before it becomes invalid makes it possible for
+		// an attacker to reuse the macaroon. In addition, the validity
+		// time of the macaroon is extended by the time the server clock
+		// is behind the client clock, or shortened by the time the
+		// server clock is ahead of the client clock (or invalid
+		// altogether if, in the latter case, this time is more than 60
+		// seconds).
+		macaroons.TimeoutConstraint(timeout),
+	}
+
+	// Apply constraints to the macaroon.
+	constrainedMac, err := macaroons.AddConstraints(mac, macConstraints...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Now we append the macaroon credentials to the dial options.
+	cred := macaroons.NewMacaroonCredential(constrainedMac)
+	return grpc.WithPerRPCCredentials(cred), nil
+}
+
+// LoadMacaroon reads and decodes the macaroon file at the specified path.
+func LoadMacaroon(macPath string) (*macaroon.Macaroon, error) {
+	macBytes, err := ioutil.ReadFile(macPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read macaroon path : %v", err)
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err = mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	return mac, nil
+}
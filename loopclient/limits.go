@@ -0,0 +1,89 @@
+package loopclient
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+)
+
+var (
+	// Define route independent max routing fees. We have currently no way
+	// to get a reliable estimate of the routing fees. Best we can do is
+	// the minimum routing fees, which is not very indicative.
+	maxRoutingFeeBase = btcutil.Amount(10)
+
+	maxRoutingFeeRate = int64(20000)
+
+	// outMinerFeeMultiplier is the multiplier that we apply to the
+	// quoted sweep fee of a loop out, to not get the swap canceled
+	// because fees increased in the mean time.
+	outMinerFeeMultiplier = btcutil.Amount(250)
+
+	// inMinerFeeMultiplier is the multiplier that we apply to the quoted
+	// htlc publication fee of a loop in.
+	inMinerFeeMultiplier = btcutil.Amount(3)
+)
+
+// OutLimits holds the fee limits that a loop out is dispatched with.
+type OutLimits struct {
+	// MaxSwapRoutingFee is the maximum off-chain fee that we pay to route
+	// the swap payment.
+	MaxSwapRoutingFee btcutil.Amount
+
+	// MaxPrepayRoutingFee is the maximum off-chain fee that we pay to
+	// route the prepay.
+	MaxPrepayRoutingFee btcutil.Amount
+
+	// MaxMinerFee is the maximum on-chain fee that we pay to sweep the
+	// htlc.
+	MaxMinerFee btcutil.Amount
+
+	// MaxSwapFee is the maximum fee that we pay the server.
+	MaxSwapFee btcutil.Amount
+
+	// MaxPrepayAmt is the maximum prepay that we pay the server.
+	MaxPrepayAmt btcutil.Amount
+}
+
+// InLimits holds the fee limits that a loop in is dispatched with.
+type InLimits struct {
+	// MaxMinerFee is the maximum on-chain fee that we pay to publish the
+	// htlc.
+	MaxMinerFee btcutil.Amount
+
+	// MaxSwapFee is the maximum fee that we pay the server.
+	MaxSwapFee btcutil.Amount
+}
+
+// MaxRoutingFee returns the default maximum fee that we pay to route a
+// payment of the amount provided.
+func MaxRoutingFee(amt btcutil.Amount) btcutil.Amount {
+	return swap.CalcFee(amt, maxRoutingFeeBase, maxRoutingFeeRate)
+}
+
+// DefaultOutLimits returns the default fee limits for a loop out of the amount
+// provided, based on the server's quote for it.
+func DefaultOutLimits(amt btcutil.Amount,
+	quote *looprpc.OutQuoteResponse) *OutLimits {
+
+	maxPrepayAmt := btcutil.Amount(quote.PrepayAmtSat)
+
+	return &OutLimits{
+		MaxSwapRoutingFee:   MaxRoutingFee(amt),
+		MaxPrepayRoutingFee: MaxRoutingFee(maxPrepayAmt),
+		MaxMinerFee: btcutil.Amount(quote.HtlcSweepFeeSat) *
+			outMinerFeeMultiplier,
+		MaxSwapFee:   btcutil.Amount(quote.SwapFeeSat),
+		MaxPrepayAmt: maxPrepayAmt,
+	}
+}
+
+// DefaultInLimits returns the default fee limits for a loop in, based on the
+// server's quote for it.
+func DefaultInLimits(quote *looprpc.InQuoteResponse) *InLimits {
+	return &InLimits{
+		MaxMinerFee: btcutil.Amount(quote.HtlcPublishFeeSat) *
+			inMinerFeeMultiplier,
+		MaxSwapFee: btcutil.Amount(quote.SwapFeeSat),
+	}
+}
@@ -0,0 +1,71 @@
+package loopclient
+
+import (
+	"github.com/lightninglabs/loop/looprpc"
+)
+
+// swapStream is the part of the monitor stream that we read swap updates from.
+type swapStream interface {
+	Recv() (*looprpc.SwapStatus, error)
+}
+
+// SwapIterator iterates over the swap updates that loopd streams to us:
+//
+//	for updates.Next() {
+//		handle(updates.Swap())
+//	}
+//	if err := updates.Err(); err != nil {
+//		...
+//	}
+type SwapIterator struct {
+	stream swapStream
+	cancel func()
+
+	swap *looprpc.SwapStatus
+	err  error
+}
+
+// newSwapIterator creates an iterator over the stream provided. The cancel
+// function is called once the iterator is done.
+func newSwapIterator(stream swapStream, cancel func()) *SwapIterator {
+	return &SwapIterator{
+		stream: stream,
+		cancel: cancel,
+	}
+}
+
+// Next blocks until the next swap update is received, returning false if the
+// stream has ended. Err reports the reason that it ended for.
+func (s *SwapIterator) Next() bool {
+	if s.err != nil {
+		return false
+	}
+
+	swap, err := s.stream.Recv()
+	if err != nil {
+		s.swap = nil
+		s.err = err
+		s.cancel()
+
+		return false
+	}
+
+	s.swap = swap
+	return true
+}
+
+// Swap returns the update that was received by the last call to Next.
+func (s *SwapIterator) Swap() *looprpc.SwapStatus {
+	return s.swap
+}
+
+// Err returns the error that ended the stream. It is nil while the stream is
+// running.
+func (s *SwapIterator) Err() error {
+	return s.err
+}
+
+// Close ends the stream. Updates that have not yet been read are dropped.
+func (s *SwapIterator) Close() {
+	s.cancel()
+}
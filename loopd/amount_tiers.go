@@ -0,0 +1,130 @@
+package loopd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+)
+
+// errTierValueSet is returned when a tier option sets a value for an amount
+// more than once.
+var errTierValueSet = errors.New("value set multiple times for amount")
+
+// getAmountTiers parses the amount tiers set in our config. Each tier option
+// is a list of amount:value pairs, and values that are set for the same
+// amount by different options are combined into a single tier.
+func getAmountTiers(config *tiersConfig) (loop.AmountTiers, error) {
+	tiers := make(map[btcutil.Amount]*loop.AmountTier)
+
+	// setValues parses the values of a tier option, and sets each value
+	// on the tier for its amount, creating tiers that do not exist yet.
+	setValues := func(option string, values []string,
+		set func(*loop.AmountTier, uint32) error) error {
+
+		for _, value := range values {
+			amount, v, err := parseTierValue(value)
+			if err != nil {
+				return fmt.Errorf("%v tier %v: %v", option,
+					value, err)
+			}
+
+			tier, ok := tiers[amount]
+			if !ok {
+				tier = &loop.AmountTier{
+					MinAmount: amount,
+				}
+				tiers[amount] = tier
+			}
+
+			if err := set(tier, v); err != nil {
+				return fmt.Errorf("%v tier %v: %v", option,
+					value, err)
+			}
+		}
+
+		return nil
+	}
+
+	err := setValues("htlcconfs", config.HtlcConfs,
+		func(tier *loop.AmountTier, confs uint32) error {
+			if tier.HtlcConfirmations != 0 {
+				return errTierValueSet
+			}
+
+			tier.HtlcConfirmations = confs
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setValues("sweepconftarget", config.SweepConfTarget,
+		func(tier *loop.AmountTier, target uint32) error {
+			if tier.SweepConfTarget != 0 {
+				return errTierValueSet
+			}
+
+			if target < minConfTarget {
+				return fmt.Errorf("confirmation target must "+
+					"be at least %v", minConfTarget)
+			}
+
+			tier.SweepConfTarget = int32(target)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setValues("maxfeeppm", config.MaxFeePPM,
+		func(tier *loop.AmountTier, ppm uint32) error {
+			if tier.MaxFeePPM != 0 {
+				return errTierValueSet
+			}
+
+			tier.MaxFeePPM = uint64(ppm)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	amountTiers := make([]loop.AmountTier, 0, len(tiers))
+	for _, tier := range tiers {
+		amountTiers = append(amountTiers, *tier)
+	}
+
+	return loop.NewAmountTiers(amountTiers)
+}
+
+// parseTierValue parses a tier option in the form amount:value, requiring
+// that the value is non-zero.
+func parseTierValue(tier string) (btcutil.Amount, uint32, error) {
+	parts := strings.Split(tier, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected amount:value")
+	}
+
+	amount, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid amount: %v", err)
+	}
+
+	value, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid value: %v", err)
+	}
+
+	if value == 0 {
+		return 0, 0, fmt.Errorf("value must be non-zero")
+	}
+
+	return btcutil.Amount(amount), uint32(value), nil
+}
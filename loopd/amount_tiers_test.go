@@ -0,0 +1,116 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetAmountTiers tests parsing of the amount tiers set in our config.
+func TestGetAmountTiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *tiersConfig
+		tiers   loop.AmountTiers
+		success bool
+	}{
+		{
+			name:    "no tiers",
+			config:  &tiersConfig{},
+			tiers:   loop.AmountTiers{},
+			success: true,
+		},
+		{
+			name: "htlc confs",
+			config: &tiersConfig{
+				HtlcConfs: []string{"1000000:6", "100000:3"},
+			},
+			tiers: loop.AmountTiers{
+				{
+					MinAmount:         100000,
+					HtlcConfirmations: 3,
+				},
+				{
+					MinAmount:         1000000,
+					HtlcConfirmations: 6,
+				},
+			},
+			success: true,
+		},
+		{
+			name: "combined tiers",
+			config: &tiersConfig{
+				HtlcConfs: []string{"100000:3"},
+				SweepConfTarget: []string{
+					"100000:20", "1000000:6",
+				},
+				MaxFeePPM: []string{"1000000:5000"},
+			},
+			tiers: loop.AmountTiers{
+				{
+					MinAmount:         100000,
+					HtlcConfirmations: 3,
+					SweepConfTarget:   20,
+				},
+				{
+					MinAmount:       1000000,
+					SweepConfTarget: 6,
+					MaxFeePPM:       5000,
+				},
+			},
+			success: true,
+		},
+		{
+			name: "conf target too low",
+			config: &tiersConfig{
+				SweepConfTarget: []string{"100000:1"},
+			},
+		},
+		{
+			name: "fee ppm too high",
+			config: &tiersConfig{
+				MaxFeePPM: []string{"100000:1000001"},
+			},
+		},
+		{
+			name: "duplicate amount",
+			config: &tiersConfig{
+				HtlcConfs: []string{"100000:3", "100000:6"},
+			},
+		},
+		{
+			name: "zero confs",
+			config: &tiersConfig{
+				HtlcConfs: []string{"100000:0"},
+			},
+		},
+		{
+			name: "missing value",
+			config: &tiersConfig{
+				HtlcConfs: []string{"100000"},
+			},
+		},
+		{
+			name: "invalid amount",
+			config: &tiersConfig{
+				HtlcConfs: []string{"-1:3"},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			tiers, err := getAmountTiers(testCase.config)
+			if !testCase.success {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.tiers, tiers)
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package loopd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+// macaroonIdentityLength is the number of bytes of a macaroon's id hash that
+// are used to identify it.
+const macaroonIdentityLength = 8
+
+// errNoMacaroon is returned when a swap that requires approval is requested,
+// approved or denied without a macaroon that we can identify the caller by.
+var errNoMacaroon = status.Error(
+	codes.Unauthenticated, "swaps that require approval must be "+
+		"requested and approved with a macaroon",
+)
+
+// errApprovalUnsupported is returned when a swap that exceeds our approval
+// threshold is requested by a call that cannot wait for it to be approved.
+// Such swaps must be requested with LoopOut or LoopIn, which hold them until
+// they are approved.
+var errApprovalUnsupported = status.Error(
+	codes.FailedPrecondition, "swaps that require approval must be "+
+		"requested with LoopOut or LoopIn",
+)
+
+// MacaroonIdentity returns the identity that a macaroon is recorded under
+// when it is used to request or approve swaps. The identity is derived from the
+// macaroon's id, and is not changed by adding caveats to the macaroon.
+func MacaroonIdentity(mac *macaroon.Macaroon) string {
+	hash := sha256.Sum256(mac.Id())
+	return "macaroon:" + hex.EncodeToString(hash[:macaroonIdentityLength])
+}
+
+// identityFromContext returns the identity of the macaroon that the call with
+// the context provided was made with.
+func identityFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("macaroon")) != 1 {
+		return "", errNoMacaroon
+	}
+
+	macBytes, err := hex.DecodeString(md.Get("macaroon")[0])
+	if err != nil {
+		return "", err
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return "", errors.New("unable to decode macaroon")
+	}
+
+	return MacaroonIdentity(mac), nil
+}
+
+// requiresApproval returns a boolean indicating whether a swap with the amount
+// provided must be approved before it is executed.
+func (s *swapClientServer) requiresApproval(amount btcutil.Amount) bool {
+	s.policyLock.RLock()
+	defer s.policyLock.RUnlock()
+
+	return s.approvalThreshold != 0 && amount > s.approvalThreshold
+}
+
+// getApprovalTimeout returns the amount of time that swaps which require
+// approval may be approved within.
+func (s *swapClientServer) getApprovalTimeout() time.Duration {
+	s.policyLock.RLock()
+	defer s.policyLock.RUnlock()
+
+	return s.approvalTimeout
+}
+
+// requestLoopOutApproval reserves a loop out swap that must be approved
+// before it is executed, recording the caller as its requester.
+func (s *swapClientServer) requestLoopOutApproval(ctx context.Context,
+	req *loop.OutRequest) (*loop.SwapReservation, error) {
+
+	requester, err := identityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.impl.RequestLoopOutApproval(
+		ctx, req, requester, s.getApprovalTimeout(),
+	)
+}
+
+// requestLoopInApproval reserves a loop in swap that must be approved before
+// it is executed, recording the caller as its requester.
+func (s *swapClientServer) requestLoopInApproval(ctx context.Context,
+	req *loop.LoopInRequest) (*loop.SwapReservation, error) {
+
+	requester, err := identityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.impl.RequestLoopInApproval(
+		ctx, req, requester, s.getApprovalTimeout(),
+	)
+}
+
+// pendingApprovalResponse returns the swap response for a swap that is
+// awaiting approval.
+func pendingApprovalResponse(
+	reservation *loop.SwapReservation) *looprpc.SwapResponse {
+
+	response := reservationSwapResponse(reservation)
+	response.PendingApproval = true
+	response.ApprovalExpiryUnix = reservation.Expiry.Unix()
+
+	return response
+}
+
+// approvalError converts the errors that are returned when a swap that is
+// awaiting approval is approved or denied to grpc status errors.
+func approvalError(err error) error {
+	switch err {
+	case loop.ErrReservationNotFound:
+		return status.Error(codes.NotFound, err.Error())
+
+	case loop.ErrReservationExpired, loop.ErrApprovalRequired:
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case loop.ErrSelfApproval:
+		return status.Error(codes.PermissionDenied, err.Error())
+
+	case loopdb.ErrApproverTooLong, loopdb.ErrDenialReasonTooLong:
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	default:
+		return volumeLimitError(err)
+	}
+}
+
+// ListPendingApprovals returns all swaps that are awaiting approval.
+func (s *swapClientServer) ListPendingApprovals(ctx context.Context,
+	_ *looprpc.ListPendingApprovalsRequest) (
+	*looprpc.ListPendingApprovalsResponse, error) {
+
+	pending := s.impl.PendingApprovals(ctx)
+
+	resp := &looprpc.ListPendingApprovalsResponse{
+		Approvals: make([]*looprpc.SwapReservation, len(pending)),
+	}
+	for i, reservation := range pending {
+		resp.Approvals[i] = marshallReservation(reservation)
+	}
+
+	return resp, nil
+}
+
+// ApproveSwap executes a swap that is awaiting approval.
+func (s *swapClientServer) ApproveSwap(ctx context.Context,
+	in *looprpc.ApproveSwapRequest) (*looprpc.SwapResponse, error) {
+
+	hash, err := lntypes.MakeHash(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	approver, err := identityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Approve swap request received from %v: %v", approver, hash)
+
+	held := s.holdRequests(hash)
+
+	reservation, err := s.impl.ApproveSwap(ctx, hash, approver)
+	if err != nil {
+		s.resolveRequests(held, s.awaitingApproval(ctx, hash), nil)
+
+		log.Errorf("Approve swap: %v", err)
+		return nil, approvalError(err)
+	}
+
+	response := reservationSwapResponse(reservation)
+	s.resolveRequests(held, false, response)
+
+	return response, nil
+}
+
+// DenySwap cancels a swap that is awaiting approval.
+func (s *swapClientServer) DenySwap(ctx context.Context,
+	in *looprpc.DenySwapRequest) (*looprpc.DenySwapResponse, error) {
+
+	hash, err := lntypes.MakeHash(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denier, err := identityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Deny swap request received from %v: %v", denier, hash)
+
+	held := s.holdRequests(hash)
+
+	err = s.impl.DenySwap(ctx, hash, denier, in.Reason)
+	if err != nil {
+		s.resolveRequests(held, s.awaitingApproval(ctx, hash), nil)
+
+		log.Errorf("Deny swap: %v", err)
+		return nil, approvalError(err)
+	}
+
+	// The swap will never be executed, so its requests may be retried.
+	s.resolveRequests(held, false, nil)
+
+	return &looprpc.DenySwapResponse{}, nil
+}
+
+// ListSwapDenials returns the swaps that required approval, but were denied
+// or expired instead.
+func (s *swapClientServer) ListSwapDenials(_ context.Context,
+	_ *looprpc.ListSwapDenialsRequest) (*looprpc.ListSwapDenialsResponse,
+	error) {
+
+	denials, err := s.impl.SwapDenials()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.ListSwapDenialsResponse{
+		Denials: make([]*looprpc.SwapDenial, len(denials)),
+	}
+	for i, denial := range denials {
+		resp.Denials[i] = marshallSwapDenial(denial)
+	}
+
+	return resp, nil
+}
+
+// marshallSwapDenial converts a swap denial to its rpc representation.
+func marshallSwapDenial(denial *loopdb.SwapDenial) *looprpc.SwapDenial {
+	rpcDenial := &looprpc.SwapDenial{
+		Id:          denial.Hash[:],
+		Type:        looprpc.SwapType_LOOP_OUT,
+		Amt:         int64(denial.Amount),
+		RequestedBy: denial.Requester,
+		DeniedBy:    denial.Denier,
+		Expired:     denial.Expired,
+		Reason:      denial.Reason,
+		DenialTime:  denial.Time.UnixNano(),
+	}
+
+	if denial.SwapType == swap.TypeIn {
+		rpcDenial.Type = looprpc.SwapType_LOOP_IN
+	}
+
+	return rpcDenial
+}
+
+// awaitingApproval returns a boolean indicating whether the swap with the
+// hash provided is awaiting approval.
+func (s *swapClientServer) awaitingApproval(ctx context.Context,
+	hash lntypes.Hash) bool {
+
+	for _, reservation := range s.impl.PendingApprovals(ctx) {
+		if reservation.SwapHash == hash {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+// TestRequiresApproval tests that swaps require approval once they exceed our
+// approval threshold.
+func TestRequiresApproval(t *testing.T) {
+	server := &swapClientServer{}
+	require.False(t, server.requiresApproval(btcutil.SatoshiPerBitcoin))
+
+	server.approvalThreshold = 1000
+	require.False(t, server.requiresApproval(1000))
+	require.True(t, server.requiresApproval(1001))
+}
+
+// TestPendingApprovalResponse tests the swap response that is returned for
+// swaps that are awaiting approval.
+func TestPendingApprovalResponse(t *testing.T) {
+	expiry := time.Unix(1000, 0)
+
+	response := pendingApprovalResponse(&loop.SwapReservation{
+		SwapHash:         lntypes.Hash{1},
+		HtlcAddressP2WSH: testnetAddr,
+		Expiry:           expiry,
+		RequiresApproval: true,
+	})
+
+	require.True(t, response.PendingApproval)
+	require.Equal(t, expiry.Unix(), response.ApprovalExpiryUnix)
+	require.Equal(t, lntypes.Hash{1}.String(), response.Id)
+	require.Equal(t, testnetAddr.String(), response.HtlcAddress) // nolint:staticcheck
+}
+
+// TestIdentityFromContext tests identification of the macaroon that requests
+// or approves a swap.
+func TestIdentityFromContext(t *testing.T) {
+	_, err := identityFromContext(context.Background())
+	require.Equal(t, errNoMacaroon, err)
+
+	mac, err := macaroon.New(
+		[]byte("root key"), []byte("id"), "loop", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.Pairs(
+			"macaroon", hex.EncodeToString(macBytes),
+		),
+	)
+
+	approver, err := identityFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, MacaroonIdentity(mac), approver)
+
+	// Adding caveats to the macaroon should not change its identity.
+	require.NoError(t, mac.AddFirstPartyCaveat([]byte("time-before 1")))
+	require.Equal(t, approver, MacaroonIdentity(mac))
+}
+
+// TestMarshallSwapDenial tests conversion of swap denials to their rpc
+// representation.
+func TestMarshallSwapDenial(t *testing.T) {
+	hash := lntypes.Hash{1}
+
+	denial := marshallSwapDenial(&loopdb.SwapDenial{
+		Hash:      hash,
+		SwapType:  swap.TypeIn,
+		Amount:    1000,
+		Requester: "requester",
+		Denier:    "denier",
+		Reason:    "reason",
+		Time:      time.Unix(0, 2000),
+	})
+
+	require.Equal(t, &looprpc.SwapDenial{
+		Id:          hash[:],
+		Type:        looprpc.SwapType_LOOP_IN,
+		Amt:         1000,
+		RequestedBy: "requester",
+		DeniedBy:    "denier",
+		Reason:      "reason",
+		DenialTime:  2000,
+	}, denial)
+
+	// Swaps that are approved by their requester are rejected with a
+	// permission error.
+	require.Equal(
+		t, codes.PermissionDenied,
+		status.Code(approvalError(loop.ErrSelfApproval)),
+	)
+}
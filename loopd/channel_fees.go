@@ -0,0 +1,334 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc"
+)
+
+// channelPolicyStore stores the original policies of the channels whose fees
+// we raised while swaps drained them.
+type channelPolicyStore interface {
+	// PutChannelPolicy stores the original policy of a channel.
+	PutChannelPolicy(policy *loopdb.ChannelPolicy) error
+
+	// FetchChannelPolicies returns all stored channel policies.
+	FetchChannelPolicies() ([]*loopdb.ChannelPolicy, error)
+
+	// DeleteChannelPolicy deletes the stored policy of a channel.
+	DeleteChannelPolicy(channelID uint64) error
+}
+
+// lndChannelFees raises our fee rate on the channels that automatically
+// dispatched loop outs drain, and restores our original fees once the swaps
+// complete. While a swap drains a channel, we don't want to route payments
+// over the channel that use the liquidity that the swap shifts. The version
+// of lndclient that we use does not expose channel policy updates, so we use
+// a separate connection to lnd's lightning rpc.
+type lndChannelFees struct {
+	conn   *grpc.ClientConn
+	client lnrpc.LightningClient
+	store  channelPolicyStore
+
+	// self is our node's pubkey, which identifies our side of the policies
+	// of our channels.
+	self route.Vertex
+
+	// feeRatePPM is the fee rate that we set on channels while swaps
+	// drain them.
+	feeRatePPM uint32
+
+	// mu serializes our policy updates, so that we never store the raised
+	// policy of a channel as its original policy.
+	mu sync.Mutex
+}
+
+// newLndChannelFees connects to the lightning rpc of the lnd instance in the
+// config provided. The macaroon used must hold the info:read and
+// offchain:write permissions.
+func newLndChannelFees(cfg *lndConfig, network string, self route.Vertex,
+	store channelPolicyStore, feeRatePPM uint32) (*lndChannelFees, error) {
+
+	conn, err := lndclient.NewBasicConn(
+		cfg.Host, cfg.TLSPath, filepath.Dir(cfg.MacaroonPath), network,
+		lndclient.MacFilename(filepath.Base(cfg.MacaroonPath)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lndChannelFees{
+		conn:       conn,
+		client:     lnrpc.NewLightningClient(conn),
+		store:      store,
+		self:       self,
+		feeRatePPM: feeRatePPM,
+	}, nil
+}
+
+// adjust raises our fee rate on the channels provided, which the swap with
+// the hash provided drains. The original policies of the channels are stored
+// before they are replaced, so that they are restored even if we restart
+// before the swap completes. Channels whose fees were already raised for
+// another swap, or whose fee rate is already at least our rate, are left
+// unchanged.
+func (l *lndChannelFees) adjust(ctx context.Context, hash lntypes.Hash,
+	channels []lnwire.ShortChannelID) error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stored, err := l.store.FetchChannelPolicies()
+	if err != nil {
+		return err
+	}
+
+	raised := make(map[uint64]bool, len(stored))
+	for _, policy := range stored {
+		raised[policy.ChannelID] = true
+	}
+
+	for _, channel := range channels {
+		chanID := channel.ToUint64()
+		if raised[chanID] {
+			continue
+		}
+
+		chanPoint, policy, err := l.ourPolicy(ctx, chanID)
+		if err != nil {
+			return err
+		}
+
+		if policy.FeeRateMilliMsat >= int64(l.feeRatePPM) {
+			continue
+		}
+
+		err = l.store.PutChannelPolicy(&loopdb.ChannelPolicy{
+			ChannelID:     chanID,
+			SwapHash:      hash,
+			BaseFeeMsat:   policy.FeeBaseMsat,
+			FeeRatePPM:    uint64(policy.FeeRateMilliMsat),
+			TimeLockDelta: policy.TimeLockDelta,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = l.updatePolicy(
+			ctx, chanPoint, policy.FeeBaseMsat,
+			uint64(l.feeRatePPM), policy.TimeLockDelta,
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("Raised fee rate of channel %v from %v to %v ppm "+
+			"for swap %v", channel, policy.FeeRateMilliMsat,
+			l.feeRatePPM, hash)
+	}
+
+	return nil
+}
+
+// restore restores the original policies of the channels whose fees we raised
+// for the swap with the hash provided.
+func (l *lndChannelFees) restore(ctx context.Context, hash lntypes.Hash) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stored, err := l.store.FetchChannelPolicies()
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range stored {
+		if policy.SwapHash != hash {
+			continue
+		}
+
+		if err := l.restorePolicy(ctx, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreCompleted restores the original policies of the channels whose fees
+// we raised for swaps that are no longer pending, which is the case if swaps
+// completed while we were offline. Policies that were stored for swaps that
+// we don't know are restored as well.
+func (l *lndChannelFees) restoreCompleted(ctx context.Context,
+	swaps []*loopdb.LoopOut) error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	pending := make(map[lntypes.Hash]bool)
+	for _, swap := range swaps {
+		if swap.State().State.Type() == loopdb.StateTypePending {
+			pending[swap.Hash] = true
+		}
+	}
+
+	stored, err := l.store.FetchChannelPolicies()
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range stored {
+		if pending[policy.SwapHash] {
+			continue
+		}
+
+		if err := l.restorePolicy(ctx, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restorePolicy restores the original policy of a channel and deletes it from
+// our store. If the channel was closed in the meantime, there is nothing to
+// restore.
+func (l *lndChannelFees) restorePolicy(ctx context.Context,
+	original *loopdb.ChannelPolicy) error {
+
+	chanPoint, _, err := l.ourPolicy(ctx, original.ChannelID)
+	switch {
+	case err != nil && isEdgeNotFound(err):
+		log.Infof("Channel %v closed, not restoring its fees",
+			lnwire.NewShortChanIDFromInt(original.ChannelID))
+
+	case err != nil:
+		return err
+
+	default:
+		err := l.updatePolicy(
+			ctx, chanPoint, original.BaseFeeMsat,
+			original.FeeRatePPM, original.TimeLockDelta,
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("Restored fee rate of channel %v to %v ppm",
+			lnwire.NewShortChanIDFromInt(original.ChannelID),
+			original.FeeRatePPM)
+	}
+
+	return l.store.DeleteChannelPolicy(original.ChannelID)
+}
+
+// restoreChannelFees restores the fees of the channels that a swap drained
+// once the swap has completed.
+func (s *swapClientServer) restoreChannelFees(ctx context.Context,
+	info *loop.SwapInfo) {
+
+	if s.channelFees == nil ||
+		info.State.Type() == loopdb.StateTypePending {
+
+		return
+	}
+
+	if err := s.channelFees.restore(ctx, info.SwapHash); err != nil {
+		log.Errorf("Could not restore fees of channels drained by "+
+			"swap %v: %v", info.SwapHash, err)
+	}
+}
+
+// isEdgeNotFound returns true if an error from lnd's lightning rpc indicates
+// that lnd does not know the channel that was looked up.
+func isEdgeNotFound(err error) bool {
+	return strings.Contains(err.Error(), channeldb.ErrEdgeNotFound.Error())
+}
+
+// ourPolicy looks up one of our channels, and returns its channel point along
+// with our policy for it.
+func (l *lndChannelFees) ourPolicy(ctx context.Context, chanID uint64) (
+	string, *lnrpc.RoutingPolicy, error) {
+
+	edge, err := l.client.GetChanInfo(ctx, &lnrpc.ChanInfoRequest{
+		ChanId: chanID,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	policy := edge.Node2Policy
+	if edge.Node1Pub == hex.EncodeToString(l.self[:]) {
+		policy = edge.Node1Policy
+	}
+
+	if policy == nil {
+		return "", nil, fmt.Errorf("no policy for channel: %v",
+			lnwire.NewShortChanIDFromInt(chanID))
+	}
+
+	return edge.ChanPoint, policy, nil
+}
+
+// updatePolicy sets the fees of the channel with the channel point provided.
+func (l *lndChannelFees) updatePolicy(ctx context.Context, chanPoint string,
+	baseFeeMsat int64, feeRatePPM uint64, timeLockDelta uint32) error {
+
+	point, err := parseChannelPoint(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	// Lnd truncates the fee rate that we provide to parts per million, so
+	// we add half a part to ensure that floating point imprecision doesn't
+	// round our rate down.
+	_, err = l.client.UpdateChannelPolicy(ctx, &lnrpc.PolicyUpdateRequest{
+		Scope: &lnrpc.PolicyUpdateRequest_ChanPoint{
+			ChanPoint: point,
+		},
+		BaseFeeMsat:   baseFeeMsat,
+		FeeRate:       (float64(feeRatePPM) + 0.5) / 1e6,
+		TimeLockDelta: timeLockDelta,
+	})
+
+	return err
+}
+
+// parseChannelPoint parses a channel point in the txid:index format that lnd
+// reports channel points in.
+func parseChannelPoint(chanPoint string) (*lnrpc.ChannelPoint, error) {
+	parts := strings.Split(chanPoint, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid channel point: %v", chanPoint)
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel point: %v", chanPoint)
+	}
+
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+			FundingTxidStr: parts[0],
+		},
+		OutputIndex: uint32(index),
+	}, nil
+}
+
+// close closes our connection to lnd.
+func (l *lndChannelFees) close() error {
+	return l.conn.Close()
+}
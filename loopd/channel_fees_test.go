@@ -0,0 +1,205 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// mockChannelFeesLightning holds the policies of our channels, and updates
+// them when they are replaced.
+type mockChannelFeesLightning struct {
+	lnrpc.LightningClient
+
+	edges   map[uint64]*lnrpc.ChannelEdge
+	updates []*lnrpc.PolicyUpdateRequest
+}
+
+// GetChanInfo returns the edge of the channel requested.
+func (m *mockChannelFeesLightning) GetChanInfo(_ context.Context,
+	req *lnrpc.ChanInfoRequest, _ ...grpc.CallOption) (*lnrpc.ChannelEdge,
+	error) {
+
+	edge, ok := m.edges[req.ChanId]
+	if !ok {
+		return nil, channeldb.ErrEdgeNotFound
+	}
+
+	return edge, nil
+}
+
+// UpdateChannelPolicy records the update provided and applies it to our side
+// of the channel that it is scoped to.
+func (m *mockChannelFeesLightning) UpdateChannelPolicy(_ context.Context,
+	req *lnrpc.PolicyUpdateRequest, _ ...grpc.CallOption) (
+	*lnrpc.PolicyUpdateResponse, error) {
+
+	m.updates = append(m.updates, req)
+
+	chanPoint := req.GetChanPoint()
+	for _, edge := range m.edges {
+		point, err := parseChannelPoint(edge.ChanPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		if point.GetFundingTxidStr() != chanPoint.GetFundingTxidStr() ||
+			point.OutputIndex != chanPoint.OutputIndex {
+
+			continue
+		}
+
+		edge.Node1Policy.FeeBaseMsat = req.BaseFeeMsat
+		edge.Node1Policy.FeeRateMilliMsat = int64(req.FeeRate * 1e6)
+		edge.Node1Policy.TimeLockDelta = req.TimeLockDelta
+	}
+
+	return &lnrpc.PolicyUpdateResponse{}, nil
+}
+
+// mockChannelPolicyStore stores channel policies in memory.
+type mockChannelPolicyStore struct {
+	policies map[uint64]*loopdb.ChannelPolicy
+}
+
+func (m *mockChannelPolicyStore) PutChannelPolicy(
+	policy *loopdb.ChannelPolicy) error {
+
+	m.policies[policy.ChannelID] = policy
+	return nil
+}
+
+func (m *mockChannelPolicyStore) FetchChannelPolicies() (
+	[]*loopdb.ChannelPolicy, error) {
+
+	var policies []*loopdb.ChannelPolicy
+	for _, policy := range m.policies {
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (m *mockChannelPolicyStore) DeleteChannelPolicy(channelID uint64) error {
+	if _, ok := m.policies[channelID]; !ok {
+		return errors.New("not found")
+	}
+
+	delete(m.policies, channelID)
+	return nil
+}
+
+// TestChannelFees tests raising our fees on the channels that swaps drain,
+// and restoring them once the swaps complete.
+func TestChannelFees(t *testing.T) {
+	self := route.Vertex{1}
+
+	edge := func(chanPoint string, feeRate int64) *lnrpc.ChannelEdge {
+		return &lnrpc.ChannelEdge{
+			ChanPoint: chanPoint,
+			Node1Pub:  hex.EncodeToString(self[:]),
+			Node1Policy: &lnrpc.RoutingPolicy{
+				FeeBaseMsat:      1000,
+				FeeRateMilliMsat: feeRate,
+				TimeLockDelta:    40,
+			},
+			Node2Policy: &lnrpc.RoutingPolicy{
+				FeeRateMilliMsat: 1,
+			},
+		}
+	}
+
+	client := &mockChannelFeesLightning{
+		edges: map[uint64]*lnrpc.ChannelEdge{
+			1: edge("aa:0", 100),
+			2: edge("bb:1", 5000),
+			3: edge("cc:2", 10),
+		},
+	}
+	store := &mockChannelPolicyStore{
+		policies: make(map[uint64]*loopdb.ChannelPolicy),
+	}
+
+	fees := &lndChannelFees{
+		client:     client,
+		store:      store,
+		self:       self,
+		feeRatePPM: 2000,
+	}
+
+	ctx := context.Background()
+	hash1 := lntypes.Hash{1}
+	hash2 := lntypes.Hash{2}
+
+	// The fee rate of our second channel is already higher than our
+	// rate, so only our first channel's fees are raised.
+	err := fees.adjust(ctx, hash1, []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(2),
+	})
+	require.NoError(t, err)
+
+	policy1 := client.edges[1].Node1Policy
+	require.Len(t, client.updates, 1)
+	require.Equal(t, int64(2000), policy1.FeeRateMilliMsat)
+	require.Equal(t, int64(1000), policy1.FeeBaseMsat)
+	require.Equal(t, uint32(40), policy1.TimeLockDelta)
+
+	require.Equal(t, map[uint64]*loopdb.ChannelPolicy{
+		1: {
+			ChannelID:     1,
+			SwapHash:      hash1,
+			BaseFeeMsat:   1000,
+			FeeRatePPM:    100,
+			TimeLockDelta: 40,
+		},
+	}, store.policies)
+
+	// A second swap over our first channel does not replace the original
+	// policy that we stored for it.
+	err = fees.adjust(ctx, hash2, []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(3),
+	})
+	require.NoError(t, err)
+	require.Len(t, client.updates, 2)
+	require.Equal(t, uint64(100), store.policies[1].FeeRatePPM)
+	require.Equal(t, hash2, store.policies[3].SwapHash)
+
+	// Once our first swap completes, its channel's fees are restored.
+	require.NoError(t, fees.restore(ctx, hash1))
+	require.Equal(t, int64(100), policy1.FeeRateMilliMsat)
+	require.NotContains(t, store.policies, uint64(1))
+	require.Contains(t, store.policies, uint64(3))
+
+	// Our second swap is still pending, so its channel's fees are not
+	// restored on startup.
+	pending := &loopdb.LoopOut{
+		Loop: loopdb.Loop{
+			Hash: hash2,
+		},
+	}
+	err = fees.restoreCompleted(ctx, []*loopdb.LoopOut{pending})
+	require.NoError(t, err)
+	require.Contains(t, store.policies, uint64(3))
+
+	// Once it has completed and its channel was closed, we delete its
+	// policy without restoring it.
+	delete(client.edges, 3)
+	updates := len(client.updates)
+
+	err = fees.restoreCompleted(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, client.updates, updates)
+	require.Empty(t, store.policies)
+}
@@ -0,0 +1,286 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// defaultChannelSwapFeePPM is the portion of the amount of each swap that
+// changes the balance of a single channel that may be spent on fees if no
+// portion is set, 2%.
+const defaultChannelSwapFeePPM = 20000
+
+var (
+	// errChannelNotFound is returned when a channel that we change the
+	// balance of is not one of our open channels.
+	errChannelNotFound = errors.New("channel not found")
+
+	// errFeeLimitExceeded is returned when the quote for a swap exceeds
+	// the portion of its amount that it may spend on fees.
+	errFeeLimitExceeded = errors.New("fee limit exceeded")
+)
+
+// channelSwapParams holds the parameters of the swaps that we dispatch to
+// change the balance of a single channel, or to execute a swap plan.
+type channelSwapParams struct {
+	// dest is the address that loop outs are swept to. If it is nil, each
+	// swap is swept to a new address from our wallet.
+	dest btcutil.Address
+
+	// sweepConfTarget is the confirmation target of loop out sweeps.
+	sweepConfTarget int32
+
+	// htlcConfTarget is the confirmation target of loop in htlcs.
+	htlcConfTarget int32
+
+	// feePPM is the portion of each swap's amount that may be spent on
+	// fees, expressed in parts per million.
+	feePPM uint64
+
+	// label is the label of the swaps.
+	label string
+
+	// initiator is the initiator of the swaps.
+	initiator string
+
+	// publicationWindow is the time that the server may delay the
+	// publication of loop out htlcs by, so that they can be batched at a
+	// lower fee.
+	publicationWindow time.Duration
+}
+
+// newChannelSwapParams validates the parameters of swaps that change the
+// balance of a single channel, filling in defaults for those not set.
+func (s *swapClientServer) newChannelSwapParams(ctx context.Context,
+	dest string, sweepConfTarget, htlcConfTarget int32, feePPM uint64,
+	label, initiator string) (*channelSwapParams, error) {
+
+	if err := labels.Validate(label); err != nil {
+		return nil, err
+	}
+
+	if feePPM == 0 {
+		feePPM = defaultChannelSwapFeePPM
+	}
+
+	sweepConfTarget, err := validateConfTarget(
+		sweepConfTarget, loop.DefaultSweepConfTarget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcConfTarget, err = validateConfTarget(
+		htlcConfTarget, loop.DefaultHtlcConfTarget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &channelSwapParams{
+		sweepConfTarget: sweepConfTarget,
+		htlcConfTarget:  htlcConfTarget,
+		feePPM:          feePPM,
+		label:           label,
+		initiator:       initiator,
+	}
+
+	if dest == "" {
+		return params, nil
+	}
+
+	params.dest, err = btcutil.DecodeAddress(dest, s.lnd.ChainParams)
+	if err != nil {
+		return nil, fmt.Errorf("decode address: %v", err)
+	}
+
+	if !params.dest.IsForNet(s.lnd.ChainParams) {
+		return nil, fmt.Errorf("%w: Current active network is %s",
+			errIncorrectChain, s.lnd.ChainParams.Name)
+	}
+
+	if err := s.checkDestination(ctx, params.dest); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// findChannel returns the open channel with the short channel id provided.
+func (s *swapClientServer) findChannel(ctx context.Context,
+	chanID uint64) (*lndclient.ChannelInfo, error) {
+
+	channels, err := s.lnd.Client.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range channels {
+		if channel.ChannelID == chanID {
+			channel := channel
+			return &channel, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %v", errChannelNotFound, chanID)
+}
+
+// capToApproval caps the amount of a swap at our approval threshold. The
+// swaps that change the balance of a single channel are kept at our
+// threshold, since they are dispatched without waiting for approval.
+func (s *swapClientServer) capToApproval(
+	amount btcutil.Amount) btcutil.Amount {
+
+	s.policyLock.RLock()
+	defer s.policyLock.RUnlock()
+
+	if s.approvalThreshold != 0 && amount > s.approvalThreshold {
+		return s.approvalThreshold
+	}
+
+	return amount
+}
+
+// loopOutRequest creates a request for a loop out of the amount provided,
+// optionally restricted to the channels provided, whose fee limits are set
+// from a quote at the fee portion of our parameters.
+func (s *swapClientServer) loopOutRequest(ctx context.Context,
+	amount btcutil.Amount, outgoing loopdb.ChannelSet,
+	params *channelSwapParams) (*loop.OutRequest, error) {
+
+	deadline := time.Now().Add(params.publicationWindow)
+
+	quote, err := s.impl.LoopOutQuote(ctx, &loop.LoopOutQuoteRequest{
+		Amount:                  amount,
+		SweepConfTarget:         params.sweepConfTarget,
+		SwapPublicationDeadline: deadline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prepayFee, routeFee, minerFee, err := liquidity.FeePortionLimits(
+		amount, params.feePPM, quote,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: swap of %v exceeds fee limit of %v "+
+			"ppm: %v", errFeeLimitExceeded, amount, params.feePPM,
+			err)
+	}
+
+	dest := params.dest
+	if dest == nil {
+		nextAddr := s.lnd.WalletKit.NextAddr
+		if s.nextAddr != nil {
+			nextAddr = s.nextAddr
+		}
+
+		dest, err = nextAddr(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("NextAddr error: %v", err)
+		}
+	}
+
+	return &loop.OutRequest{
+		Amount:                  amount,
+		DestAddr:                dest,
+		MaxMinerFee:             minerFee,
+		MaxPrepayAmount:         quote.PrepayAmount,
+		MaxPrepayRoutingFee:     prepayFee,
+		MaxSwapRoutingFee:       routeFee,
+		MaxSwapFee:              quote.SwapFee,
+		SweepConfTarget:         params.sweepConfTarget,
+		OutgoingChanSet:         outgoing,
+		Label:                   params.label,
+		Initiator:               params.initiator,
+		SwapPublicationDeadline: deadline,
+	}, nil
+}
+
+// loopInRequest creates a request for a loop in of the amount provided,
+// optionally paid to us via the last hop provided, whose fee limits are set
+// from a quote at the fee portion of our parameters.
+func (s *swapClientServer) loopInRequest(ctx context.Context,
+	amount btcutil.Amount, lastHop *route.Vertex,
+	params *channelSwapParams) (*loop.LoopInRequest, error) {
+
+	quote, err := s.impl.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
+		Amount:         amount,
+		HtlcConfTarget: params.htlcConfTarget,
+		LastHop:        lastHop,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Any part of our fee limit that the swap fee leaves is available for
+	// the miner fee of our htlc.
+	feeLimit := btcutil.Amount(
+		uint64(amount) * params.feePPM / liquidity.FeeBase,
+	)
+	if quote.SwapFee+quote.MinerFee > feeLimit {
+		return nil, fmt.Errorf("%w: swap of %v exceeds fee limit of %v "+
+			"ppm: swap fee %v, miner fee %v", errFeeLimitExceeded,
+			amount, params.feePPM, quote.SwapFee, quote.MinerFee)
+	}
+
+	return &loop.LoopInRequest{
+		Amount:         amount,
+		MaxSwapFee:     quote.SwapFee,
+		MaxMinerFee:    feeLimit - quote.SwapFee,
+		HtlcConfTarget: params.htlcConfTarget,
+		LastHop:        lastHop,
+		Label:          params.label,
+		Initiator:      params.initiator,
+	}, nil
+}
+
+// channelLoopOut dispatches a loop out of the amount provided over the
+// channel provided and blocks until it completes.
+func (s *swapClientServer) channelLoopOut(ctx context.Context,
+	chanID uint64, amount btcutil.Amount,
+	params *channelSwapParams) (*loop.SwapInfo, error) {
+
+	if s.requiresApproval(amount) {
+		return nil, errApprovalUnsupported
+	}
+
+	req, err := s.loopOutRequest(
+		ctx, amount, loopdb.ChannelSet{chanID}, params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.impl.ExecuteLoopOut(ctx, req)
+}
+
+// channelLoopIn dispatches a loop in of the amount provided that is paid to
+// us over the channel provided, and blocks until it completes.
+func (s *swapClientServer) channelLoopIn(ctx context.Context,
+	channel *lndclient.ChannelInfo, amount btcutil.Amount,
+	params *channelSwapParams) (*loop.SwapInfo, error) {
+
+	if s.requiresApproval(amount) {
+		return nil, errApprovalUnsupported
+	}
+
+	lastHop := channel.PubKeyBytes
+
+	req, err := s.loopInRequest(ctx, amount, &lastHop, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.impl.ExecuteLoopIn(ctx, req)
+}
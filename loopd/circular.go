@@ -0,0 +1,141 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc"
+)
+
+const (
+	// circularTimeout is the number of seconds that lnd may spend trying
+	// to pay a circular rebalance.
+	circularTimeout = 60
+
+	// circularMemo is the memo of the invoices that we pay ourselves for
+	// circular rebalances.
+	circularMemo = "loop circular rebalance"
+)
+
+// errNoCircularRoute is returned when lnd finds no route for a circular
+// rebalance.
+var errNoCircularRoute = errors.New("no circular route found")
+
+// lndCircular estimates and pays circular rebalances with lnd. The version of
+// lndclient that we use does not expose route queries or self-payments, so we
+// use a separate connection to lnd's lightning and router rpcs.
+type lndCircular struct {
+	conn   *grpc.ClientConn
+	client lnrpc.LightningClient
+	router routerrpc.RouterClient
+
+	// self is our node's pubkey, which circular rebalances are paid to.
+	self route.Vertex
+}
+
+// newLndCircular connects to the lnd instance in the config provided. The
+// macaroon used must hold the offchain:read, offchain:write and
+// invoices:write permissions.
+func newLndCircular(cfg *lndConfig, network string,
+	self route.Vertex) (*lndCircular, error) {
+
+	conn, err := lndclient.NewBasicConn(
+		cfg.Host, cfg.TLSPath, filepath.Dir(cfg.MacaroonPath), network,
+		lndclient.MacFilename(filepath.Base(cfg.MacaroonPath)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lndCircular{
+		conn:   conn,
+		client: lnrpc.NewLightningClient(conn),
+		router: routerrpc.NewRouterClient(conn),
+		self:   self,
+	}, nil
+}
+
+// estimate returns the routing fee of the cheapest route for a payment to
+// ourselves that leaves over the outgoing channel provided and returns via
+// the last hop provided.
+func (l *lndCircular) estimate(ctx context.Context, outgoing uint64,
+	lastHop route.Vertex, amount btcutil.Amount) (btcutil.Amount, error) {
+
+	resp, err := l.client.QueryRoutes(ctx, &lnrpc.QueryRoutesRequest{
+		PubKey:            hex.EncodeToString(l.self[:]),
+		Amt:               int64(amount),
+		OutgoingChanId:    outgoing,
+		LastHopPubkey:     lastHop[:],
+		UseMissionControl: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(resp.Routes) == 0 {
+		return 0, errNoCircularRoute
+	}
+
+	// Round our fee up to the nearest satoshi so that we never understate
+	// the cost of a rebalance.
+	feeMsat := resp.Routes[0].TotalFeesMsat
+
+	return btcutil.Amount((feeMsat + 999) / 1000), nil
+}
+
+// rebalance pays an invoice to ourselves over the rebalance's outgoing channel
+// and last hop, spending no more than its fee, and waits for the payment to
+// complete.
+func (l *lndCircular) rebalance(ctx context.Context,
+	rebalance *liquidity.CircularRebalance) error {
+
+	invoice, err := l.client.AddInvoice(ctx, &lnrpc.Invoice{
+		Memo:  circularMemo,
+		Value: int64(rebalance.Amount),
+	})
+	if err != nil {
+		return err
+	}
+
+	stream, err := l.router.SendPaymentV2(ctx, &routerrpc.SendPaymentRequest{
+		PaymentRequest:   invoice.PaymentRequest,
+		TimeoutSeconds:   circularTimeout,
+		FeeLimitSat:      int64(rebalance.Fee),
+		OutgoingChanIds:  []uint64{rebalance.OutgoingChannel.ToUint64()},
+		LastHopPubkey:    rebalance.LastHop[:],
+		AllowSelfPayment: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		payment, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch payment.Status {
+		case lnrpc.Payment_SUCCEEDED:
+			return nil
+
+		case lnrpc.Payment_FAILED:
+			return fmt.Errorf("payment failed: %v",
+				payment.FailureReason)
+		}
+	}
+}
+
+// close closes our connection to lnd.
+func (l *lndCircular) close() error {
+	return l.conn.Close()
+}
@@ -7,10 +7,15 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/logging"
+	"github.com/lightninglabs/loop/metrics"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -28,6 +33,11 @@ var (
 	defaultLogDirname  = "logs"
 	defaultLogFilename = "loopd.log"
 
+	// logFormatText and logFormatJSON are the formats that we can write
+	// our logs in.
+	logFormatText = "text"
+	logFormatJSON = "json"
+
 	defaultLogDir     = filepath.Join(LoopDirBase, defaultLogDirname)
 	defaultConfigFile = filepath.Join(
 		LoopDirBase, DefaultNetwork, defaultConfigFilename,
@@ -37,6 +47,26 @@ var (
 	defaultMaxLogFileSize  = 10
 	defaultLoopOutMaxParts = uint32(5)
 
+	// defaultSnapshotInterval is the default interval at which we record
+	// snapshots of our node's balances.
+	defaultSnapshotInterval = time.Hour
+
+	// defaultSnapshotRetention is the default amount of time that we keep
+	// balance snapshots for.
+	defaultSnapshotRetention = time.Hour * 24 * 90
+
+	// defaultAdviceInterval is the default interval at which we check
+	// whether our swap history suggests changes to our parameters.
+	defaultAdviceInterval = time.Hour * 24
+
+	// defaultApprovalTimeout is the default amount of time that swaps
+	// which require approval may be approved within.
+	defaultApprovalTimeout = 30 * time.Minute
+
+	// defaultTracingServiceName is the default service name that we
+	// report our traces under.
+	defaultTracingServiceName = "loopd"
+
 	// DefaultTLSCertFilename is the default file name for the autogenerated
 	// TLS certificate.
 	DefaultTLSCertFilename = "tls.cert"
@@ -72,6 +102,16 @@ var (
 	DefaultMacaroonPath = filepath.Join(
 		LoopDirBase, DefaultNetwork, DefaultMacaroonFilename,
 	)
+
+	// DefaultApproverMacaroonFilename is the default file name for the
+	// autogenerated macaroon that approves swaps.
+	DefaultApproverMacaroonFilename = "approver.macaroon"
+
+	// DefaultApproverMacaroonPath is the default full path of the macaroon
+	// that approves swaps.
+	DefaultApproverMacaroonPath = filepath.Join(
+		LoopDirBase, DefaultNetwork, DefaultApproverMacaroonFilename,
+	)
 )
 
 type lndConfig struct {
@@ -99,18 +139,62 @@ type loopServerConfig struct {
 	TLSPath string `long:"tlspath" description:"Path to loop server tls certificate [testing only]"`
 }
 
+type metricsConfig struct {
+	InfluxURL     string        `long:"influxurl" description:"The URL of an InfluxDB v2 server that swap and liquidity metrics are pushed to, for example http://localhost:8086. Metrics are not exported if this is not set."`
+	InfluxToken   string        `long:"influxtoken" description:"The API token used to authenticate with the InfluxDB server."`
+	InfluxOrg     string        `long:"influxorg" description:"The InfluxDB organization that the metrics bucket belongs to."`
+	InfluxBucket  string        `long:"influxbucket" description:"The InfluxDB bucket that metrics are written to."`
+	FlushInterval time.Duration `long:"flushinterval" description:"The interval at which buffered metrics are pushed to the server."`
+}
+
+type tracingConfig struct {
+	OTLPEndpoint  string        `long:"otlpendpoint" description:"The base URL of an OpenTelemetry collector's otlp/http receiver that swap traces are exported to, for example http://localhost:4318. Traces are not exported if this is not set."`
+	ServiceName   string        `long:"servicename" description:"The service name that traces are reported under."`
+	FlushInterval time.Duration `long:"flushinterval" description:"The interval at which completed spans are exported to the collector."`
+}
+
+type limitsConfig struct {
+	MaxDailyVolume  uint64 `long:"maxdailyvolume" description:"The maximum total amount in satoshis of swaps that may be initiated in any rolling 24 hour period, covering both manual and automated swaps. Set to 0 to disable the limit."`
+	MaxWeeklyVolume uint64 `long:"maxweeklyvolume" description:"The maximum total amount in satoshis of swaps that may be initiated in any rolling 7 day period. Set to 0 to disable the limit."`
+	MaxDailySwaps   uint32 `long:"maxdailyswaps" description:"The maximum number of swaps that may be initiated in any rolling 24 hour period. Set to 0 to disable the limit."`
+	MaxWeeklySwaps  uint32 `long:"maxweeklyswaps" description:"The maximum number of swaps that may be initiated in any rolling 7 day period. Set to 0 to disable the limit."`
+
+	ApprovalThreshold uint64        `long:"approvalthreshold" description:"The amount in satoshis above which swaps that are requested over RPC are only executed once they are approved with a macaroon that holds the approvals:write permission. Swaps dispatched by autoloop are not subject to approval, and are limited by the autoloop fee budget and the volume limits instead. Channel drains and fills and swap plan steps above the threshold are rejected, since they cannot wait for approval. Set to 0 to disable approvals."`
+	ApprovalTimeout   time.Duration `long:"approvaltimeout" description:"The amount of time that swaps which require approval may be approved within, after which they expire. Swaps that are awaiting approval also expire when loopd restarts."`
+}
+
+type tiersConfig struct {
+	HtlcConfs       []string `long:"htlcconfs" description:"Requires a minimum number of confirmations of the server's htlc for loop out swaps of at least an amount in satoshis, in the form amount:confs. Swaps that request fewer confirmations wait for the number required by the largest tier that their amount reaches. May be set multiple times."`
+	SweepConfTarget []string `long:"sweepconftarget" description:"Limits the confirmation target that loop out swaps of at least an amount in satoshis are swept with, in the form amount:target. Swaps that request a larger target are swept with the target of the largest tier that their amount reaches. May be set multiple times."`
+	MaxFeePPM       []string `long:"maxfeeppm" description:"Limits the total fees that swaps of at least an amount in satoshis may be requested with, as parts per million of the swap amount, in the form amount:ppm. Manual and automated swaps whose fee limits exceed the largest tier that their amount reaches are rejected. May be set multiple times."`
+}
+
+type replicaConfig struct {
+	Path     string        `long:"path" description:"The file that a read-only replica of the swap database is periodically written to. In watch mode, this is the replica that swaps are read from. The replica is not written if this is not set."`
+	Interval time.Duration `long:"interval" description:"The interval at which the replica is written, or read in watch mode."`
+}
+
 type viewParameters struct{}
 
+type importParameters struct {
+	FromDataDir string `long:"from-datadir" description:"The data directory of the loopd instance to import completed swaps from, which swaps are read from for the network that we run on."`
+}
+
 type Config struct {
 	ShowVersion bool   `long:"version" description:"Display version information and exit"`
-	Network     string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"mainnet" choice:"simnet"`
-	RPCListen   string `long:"rpclisten" description:"Address to listen on for gRPC clients"`
-	RESTListen  string `long:"restlisten" description:"Address to listen on for REST clients"`
+	Network     string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"mainnet" choice:"simnet" choice:"signet"`
+	RPCListen   string `long:"rpclisten" description:"Address to listen on for gRPC clients, either host:port, unix:///path/to/socket or unix-abstract://name (linux only)"`
+	RESTListen  string `long:"restlisten" description:"Address to listen on for REST clients, either host:port, unix:///path/to/socket or unix-abstract://name (linux only)"`
 	CORSOrigin  string `long:"corsorigin" description:"The value to send in the Access-Control-Allow-Origin header. Header will be omitted if empty."`
 
-	LoopDir    string `long:"loopdir" description:"The directory for all of loop's data. If set, this option overwrites --datadir, --logdir, --tlscertpath, --tlskeypath and --macaroonpath."`
-	ConfigFile string `long:"configfile" description:"Path to configuration file."`
-	DataDir    string `long:"datadir" description:"Directory for loopdb."`
+	RESTSigningKeyFile string        `long:"restsigningkeyfile" description:"Path to a file holding a hex encoded key of at least 32 bytes. If set, state-changing REST requests must carry X-Loop-Timestamp, X-Loop-Nonce and X-Loop-Signature headers, where the signature is the hex encoded HMAC-SHA256 of the timestamp, nonce, method, request uri and body."`
+	RESTSigningWindow  time.Duration `long:"restsigningwindow" description:"The amount of time that the timestamp of a signed REST request may differ from loopd's clock by."`
+
+	LoopDir        string `long:"loopdir" description:"The directory for all of loop's data. If set, this option overwrites --datadir, --logdir, --tlscertpath, --tlskeypath and --macaroonpath."`
+	ConfigFile     string `long:"configfile" description:"Path to configuration file."`
+	DataDir        string `long:"datadir" description:"Directory for loopdb."`
+	SecretsDir     string `long:"secretsdir" description:"Directory for an encrypted store that the preimages of swaps are kept in, apart from the rest of the swap data in loopdb, so that loopdb and its replicas can be shared without exposing preimages. The preimages of existing swaps are moved to it when it is first used, and it must be backed up along with loopdb from then on. A subdirectory is created for each network. If not set, preimages are stored in loopdb."`
+	SecretsKeyFile string `long:"secretskeyfile" description:"The file that holds the key that the store in secretsdir is encrypted with, which is created with a new random key if it does not exist. Required if secretsdir is set. It must not be within secretsdir or the data directory, so that copies of either don't expose the key along with the data that it protects, and it must be backed up along with secretsdir."`
 
 	TLSCertPath        string   `long:"tlscertpath" description:"Path to write the TLS certificate for loop's RPC and REST services."`
 	TLSKeyPath         string   `long:"tlskeypath" description:"Path to write the TLS private key for loop's RPC and REST services."`
@@ -121,9 +205,13 @@ type Config struct {
 
 	MacaroonPath string `long:"macaroonpath" description:"Path to write the macaroon for loop's RPC and REST services if it doesn't exist."`
 
-	LogDir         string `long:"logdir" description:"Directory to log output."`
-	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)."`
-	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB."`
+	ApproverMacaroonPath string `long:"approvermacaroonpath" description:"Path to write the macaroon that approves and denies swaps if it doesn't exist. The macaroon at macaroonpath cannot approve swaps, so that swaps which exceed the approval threshold are approved by a different credential than the one that requested them."`
+
+	LogDir              string        `long:"logdir" description:"Directory to log output."`
+	MaxLogFiles         int           `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)."`
+	MaxLogFileSize      int           `long:"maxlogfilesize" description:"Maximum logfile size in MB."`
+	LogFormat           string        `long:"logformat" description:"The format to write logs in. The json format writes one object per entry, and includes the swap hash and state in all swap related entries." choice:"text" choice:"json"`
+	LogThrottleInterval time.Duration `long:"logthrottleinterval" description:"The interval within which repeats of messages that are logged on every block or autoloop tick for the same swap or channel are suppressed. The number of suppressed repeats is included when the message is next logged. Set to 0 to log every repeat."`
 
 	DebugLevel  string `long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 	MaxLSATCost uint32 `long:"maxlsatcost" description:"Maximum cost in satoshis that loopd is going to pay for an LSAT token automatically. Does not include routing fees."`
@@ -131,11 +219,72 @@ type Config struct {
 
 	LoopOutMaxParts uint32 `long:"loopoutmaxparts" description:"The maximum number of payment parts that may be used for a loop out swap."`
 
+	AmpPrepay bool `long:"ampprepay" description:"Pay the prepay invoices of loop out swaps with AMP if the server signals support for it, so that they can be split over more routes. Requires access to lnd's router rpc. Swap invoices are never paid with AMP, because AMP payments do not release the swap preimage."`
+
+	PaymentMaxShard uint64 `long:"paymentmaxshard" description:"The largest amount in satoshis that a single shard of a loop out payment may carry, so that large swaps don't take up all of the outbound liquidity of a channel at once. Requires access to lnd's router rpc. Set to 0 to disable."`
+
+	PaymentShardDelay time.Duration `long:"paymentsharddelay" description:"The delay between dispatching the shards of loop out payments that are split by paymentmaxshard, so that concurrent forwards can use the outbound liquidity that a shard leaves. Shards are dispatched within 90 seconds regardless, so that the receiver accepts them. Set to 0 to dispatch all shards at once."`
+
+	SwapChannelFeeRate uint32 `long:"swapchannelfeerate" description:"The fee rate in parts per million that is set on our channels while loop outs that autoloop dispatched drain them, so that we don't route payments that use the liquidity that the swaps shift. Our original fees are restored once the swaps complete. Channels whose fee rate is already higher are left unchanged. Set to 0 to disable."`
+
+	MissionControlBatch uint32 `long:"missioncontrolbatch" description:"Save a snapshot of lnd's mission control before autoloop dispatches at least this many swaps at once, so that it can be restored with loop missioncontrol restore if the swaps' payment attempts affect lnd's other payments. Set to 0 to disable."`
+
+	RoutingFailurePeriod time.Duration `long:"routingfailureperiod" description:"The amount of time that the channels that a loop out payment failed to route over are avoided for by later swap payments. The failures are imported into lnd's mission control, which also affects lnd's other payments. Set to 0 to disable."`
+
+	MaxSweepDelay time.Duration `long:"maxsweepdelay" description:"The maximum random delay to wait before first sweeping a loop out htlc, so that the sweep's timing does not correlate with the settlement of the swap payment. The delay is not applied once the swap gets close to its expiry. Set to 0 to disable."`
+
+	SweepAddrType string `long:"sweepaddrtype" description:"The type of address that is generated for loop out swaps that are not given a destination, so that sweeps match the rest of the wallet's outputs. If not set, lnd's default address type is used." choice:"p2wkh" choice:"np2wkh"`
+
+	MatchWalletSweeps bool `long:"matchwalletsweeps" description:"Set the nSequence and nLockTime values of sweep transactions to those used by common wallets, rather than fixed values that identify loop sweeps."`
+
+	MinSweepOutput uint64 `long:"minsweepoutput" description:"The smallest output value in satoshis that loop out sweeps and loop in refunds may create. Spends are always held back until fees drop if their output is dust or would cost more to spend than it is worth."`
+
+	StrictServerValidation bool `long:"strictservervalidation" description:"Fail swaps if any of the parameters that the server provides for them is unexpected, such as invoices that pay to a different node than earlier swaps. Without this option, unexpected parameters are logged, and only parameters that put funds at risk fail swaps."`
+
+	FeeOverpaymentPercent float64 `long:"feeoverpaymentpercent" description:"The percentage by which the realized server or on-chain fee of a completed swap may exceed the fee that was quoted when it was initiated before a warning is logged. The quoted and realized fees are also recorded as metrics if metrics export is enabled. Set to 0 to disable warnings."`
+
+	SLAAlertFactor float64 `long:"slaalertfactor" description:"The factor by which a pending swap may exceed the completion time that was expected when it was initiated before a warning is logged. The expected and actual durations of swaps are also recorded as metrics if metrics export is enabled. Set to 0 to disable warnings."`
+
+	AllowedDest []string `long:"alloweddest" description:"Adds an address, or an addr() or raw() output descriptor, that loop out swaps may be swept to. If any are set, requests for other destinations are rejected unless they are made with an admin macaroon. Addresses generated by the backing lnd wallet are always allowed."`
+
+	LSPEndpoints []string `long:"lspendpoint" description:"Adds the http endpoint of a liquidity service provider that quotes the price of opening an inbound channel to us, so that suggested loop outs are compared with buying the same inbound liquidity. The endpoint is queried with a GET request that holds the inbound capacity in satoshis as the capacity_sat parameter, and must respond with a JSON object holding fee_sat, the total price of the channel in satoshis, and optionally lease_blocks, the number of blocks that the provider keeps the channel open for. This is not LSPS1, so providers that only speak LSPS1 need an adapter. Providers are queried in parallel, each with a 10 second timeout. May be set multiple times."`
+
+	Profile string `long:"profile" description:"Enable HTTP profiling on the given host:port, for example localhost:6060. The listener is not authenticated, so it should not be exposed publicly."`
+
+	SnapshotInterval  time.Duration `long:"snapshotinterval" description:"The interval at which snapshots of the node's balances are recorded. Set to 0 to disable snapshots."`
+	SnapshotRetention time.Duration `long:"snapshotretention" description:"The amount of time that balance snapshots are kept for. Set to 0 to keep snapshots forever."`
+
+	AdviceInterval time.Duration `long:"adviceinterval" description:"The interval at which recent swap outcomes and fee estimates are analyzed for recommended changes to the liquidity parameters. New recommendations are logged as warnings and never applied automatically. Set to 0 to disable checks, advice is still available with loop paramadvice."`
+
+	AgentName string `long:"agentname" description:"The name that loopd identifies itself with in the user agent that is sent to the swap server, which also includes loopd's version and commit. Platforms that embed loop may set this to attribute their swaps. If not set, loopd is used."`
+	Initiator string `long:"initiator" description:"The initiator that is added to the user agent of swaps that are requested without one, for example to identify the user interface that drives loopd."`
+
+	MultiTenant bool `long:"multitenant" description:"Run in multi-tenant mode, where each macaroon identity is a separate tenant that only sees the swaps it created, and may only use the swap, quote and terms RPCs. The macaroons that loopd creates at --macaroonpath and --approvermacaroonpath identify the operator, who keeps access to all swaps and to autoloop, approvals, templates and the daemon's other RPCs, and bakes macaroons for new tenants with the BakeTenantMacaroon RPC. Liquidity parameters and autoloop are not namespaced per tenant: tenants have no channels of their own, and their swaps use the operator's wallet and channels."`
+
+	HealthCheckExec string `long:"healthcheck-exec" description:"Check the health of the loopd that runs with this config and exit, with a non-zero status if it is unhealthy, for use as an exec probe. The ready check passes once loopd is connected to lnd and the swap server with its database migrated and subsystems running. The live check passes as long as loopd keeps checking its readiness, and should be used to decide whether to restart it." choice:"ready" choice:"live"`
+
+	MonitorBuffer   int    `long:"monitorbuffer" description:"The number of swap updates that are buffered for each subscriber to swap updates, such as loop monitor, that has not read them yet. Only applies if monitoroverflow is drop-oldest or block."`
+	MonitorOverflow string `long:"monitoroverflow" description:"How the swap updates for subscribers that have not read them yet are buffered. With unbounded, the default, no updates are lost, but a slow subscriber can grow loopd's memory usage without bounds. With drop-oldest, the subscriber's oldest update is dropped once monitorbuffer updates are buffered, and the dropped updates are recorded as metrics if metrics export is enabled. With block, updates to all subscribers wait for the subscriber to catch up once its buffer is full." choice:"unbounded" choice:"drop-oldest" choice:"block"`
+
+	Watch bool `long:"watch" description:"Run in watch mode, serving only the read-only swap RPCs and the monitor stream from the database replica set with --replica.path. No connection is made to lnd or the swap server, and no swaps are executed."`
+
 	Lnd *lndConfig `group:"lnd" namespace:"lnd"`
 
 	Server *loopServerConfig `group:"server" namespace:"server"`
 
+	Metrics *metricsConfig `group:"metrics" namespace:"metrics"`
+
+	Tracing *tracingConfig `group:"tracing" namespace:"tracing"`
+
+	Limits *limitsConfig `group:"limits" namespace:"limits"`
+
+	Tiers *tiersConfig `group:"tiers" namespace:"tiers"`
+
+	Replica *replicaConfig `group:"replica" namespace:"replica"`
+
 	View viewParameters `command:"view" alias:"v" description:"View all swaps in the database. This command can only be executed when loopd is not running."`
+
+	Import importParameters `command:"import" description:"Import the completed swaps of another loopd data directory into the database. Swaps that are already stored are skipped if they are identical, and the import fails if they differ. The database of the other data directory is upgraded to our version if needed. This command can only be executed when neither loopd is running."`
 }
 
 const (
@@ -149,6 +298,8 @@ func DefaultConfig() Config {
 		Network:    DefaultNetwork,
 		RPCListen:  "localhost:11010",
 		RESTListen: "localhost:8081",
+
+		RESTSigningWindow: defaultRESTSigningWindow,
 		Server: &loopServerConfig{
 			NoTLS: false,
 		},
@@ -159,12 +310,45 @@ func DefaultConfig() Config {
 		MaxLogFiles:     defaultMaxLogFiles,
 		MaxLogFileSize:  defaultMaxLogFileSize,
 		DebugLevel:      defaultLogLevel,
+		LogFormat:       logFormatText,
 		TLSCertPath:     DefaultTLSCertPath,
 		TLSKeyPath:      DefaultTLSKeyPath,
 		MacaroonPath:    DefaultMacaroonPath,
 		MaxLSATCost:     lsat.DefaultMaxCostSats,
 		MaxLSATFee:      lsat.DefaultMaxRoutingFeeSats,
 		LoopOutMaxParts: defaultLoopOutMaxParts,
+
+		ApproverMacaroonPath:  DefaultApproverMacaroonPath,
+		LogThrottleInterval:   logging.DefaultThrottleInterval,
+		FeeOverpaymentPercent: defaultFeeOverpaymentPercent,
+		SLAAlertFactor:        defaultSLAAlertFactor,
+
+		SnapshotInterval:  defaultSnapshotInterval,
+		SnapshotRetention: defaultSnapshotRetention,
+		AdviceInterval:    defaultAdviceInterval,
+
+		MonitorBuffer:   defaultMonitorBuffer,
+		MonitorOverflow: overflowUnbounded,
+
+		Metrics: &metricsConfig{
+			FlushInterval: metrics.DefaultFlushInterval,
+		},
+
+		Tracing: &tracingConfig{
+			ServiceName:   defaultTracingServiceName,
+			FlushInterval: tracing.DefaultFlushInterval,
+		},
+
+		Limits: &limitsConfig{
+			ApprovalTimeout: defaultApprovalTimeout,
+		},
+
+		Tiers: &tiersConfig{},
+
+		Replica: &replicaConfig{
+			Interval: defaultReplicaInterval,
+		},
+
 		Lnd: &lndConfig{
 			Host: "localhost:10009",
 			MacaroonPath: filepath.Join(
@@ -185,6 +369,15 @@ func Validate(cfg *Config) error {
 	cfg.TLSCertPath = lncfg.CleanAndExpandPath(cfg.TLSCertPath)
 	cfg.TLSKeyPath = lncfg.CleanAndExpandPath(cfg.TLSKeyPath)
 	cfg.MacaroonPath = lncfg.CleanAndExpandPath(cfg.MacaroonPath)
+	cfg.ApproverMacaroonPath = lncfg.CleanAndExpandPath(
+		cfg.ApproverMacaroonPath,
+	)
+	cfg.RESTSigningKeyFile = lncfg.CleanAndExpandPath(
+		cfg.RESTSigningKeyFile,
+	)
+	cfg.Replica.Path = lncfg.CleanAndExpandPath(cfg.Replica.Path)
+	cfg.SecretsDir = lncfg.CleanAndExpandPath(cfg.SecretsDir)
+	cfg.SecretsKeyFile = lncfg.CleanAndExpandPath(cfg.SecretsKeyFile)
 
 	// Since our loop directory overrides our log/data dir values, make sure
 	// that they are not set when loop dir is set. We hard here rather than
@@ -223,11 +416,66 @@ func Validate(cfg *Config) error {
 		cfg.LogDir = filepath.Join(cfg.LoopDir, defaultLogDirname)
 	}
 
+	// Our data and log directories hold a subdirectory for each network,
+	// so we make sure that they were not set to the subdirectory of
+	// another network, which would mix the data of both networks.
+	err := checkNetworkDir("loopdir", cfg.LoopDir, cfg.Network)
+	if err != nil {
+		return err
+	}
+
+	err = checkNetworkDir("datadir", cfg.DataDir, cfg.Network)
+	if err != nil {
+		return err
+	}
+
+	err = checkNetworkDir("logdir", cfg.LogDir, cfg.Network)
+	if err != nil {
+		return err
+	}
+
 	// Append the network type to the data and log directory so they are
 	// "namespaced" per network.
 	cfg.DataDir = filepath.Join(cfg.DataDir, cfg.Network)
 	cfg.LogDir = filepath.Join(cfg.LogDir, cfg.Network)
 
+	if cfg.SecretsDir != "" {
+		err = checkNetworkDir("secretsdir", cfg.SecretsDir, cfg.Network)
+		if err != nil {
+			return err
+		}
+
+		cfg.SecretsDir = filepath.Join(cfg.SecretsDir, cfg.Network)
+
+		if cfg.SecretsDir == cfg.DataDir {
+			return fmt.Errorf("secretsdir must not be the data " +
+				"directory")
+		}
+
+		// Our secrets are encrypted with a key of their own, which
+		// must not be kept with our swap data or with the secrets of
+		// any of our networks.
+		if cfg.SecretsKeyFile == "" {
+			return fmt.Errorf("secretskeyfile must be set if " +
+				"secretsdir is set")
+		}
+
+		for _, dir := range []string{
+			cfg.DataDir, filepath.Dir(cfg.SecretsDir),
+		} {
+			rel, err := filepath.Rel(dir, cfg.SecretsKeyFile)
+			outside := rel == ".." || strings.HasPrefix(
+				rel, ".."+string(filepath.Separator),
+			)
+			if err == nil && !outside {
+				return fmt.Errorf("secretskeyfile must not be "+
+					"within %v", dir)
+			}
+		}
+	} else if cfg.SecretsKeyFile != "" {
+		return fmt.Errorf("secretskeyfile requires secretsdir")
+	}
+
 	// We want the TLS and macaroon files to also be in the "namespaced" sub
 	// directory. Replace the default values with actual values in case the
 	// user specified either loopdir or datadir.
@@ -246,6 +494,11 @@ func Validate(cfg *Config) error {
 			cfg.DataDir, DefaultMacaroonFilename,
 		)
 	}
+	if cfg.ApproverMacaroonPath == DefaultApproverMacaroonPath {
+		cfg.ApproverMacaroonPath = filepath.Join(
+			cfg.DataDir, DefaultApproverMacaroonFilename,
+		)
+	}
 
 	// If either of these directories do not exist, create them.
 	if err := os.MkdirAll(cfg.DataDir, os.ModePerm); err != nil {
@@ -256,6 +509,82 @@ func Validate(cfg *Config) error {
 		return err
 	}
 
+	// Watch mode serves all of its swaps from a replica, so we require
+	// that one is set.
+	if cfg.Watch && cfg.Replica.Path == "" {
+		return fmt.Errorf("watch mode requires --replica.path")
+	}
+
+	// Tenants are identified by the macaroons of our own rpc server,
+	// which watch mode shares with the daemon that it watches.
+	if cfg.Watch && cfg.MultiTenant {
+		return fmt.Errorf("multi-tenant mode is not supported in " +
+			"watch mode")
+	}
+
+	if cfg.Replica.Path != "" && cfg.Replica.Interval <= 0 {
+		return fmt.Errorf("replica interval must be positive")
+	}
+
+	if _, _, err := ParseRPCAddress(cfg.RPCListen); err != nil {
+		return fmt.Errorf("invalid rpclisten: %v", err)
+	}
+
+	if _, _, err := ParseRPCAddress(cfg.RESTListen); err != nil {
+		return fmt.Errorf("invalid restlisten: %v", err)
+	}
+
+	if cfg.RoutingFailurePeriod < 0 {
+		return fmt.Errorf("routing failure period must not be negative")
+	}
+
+	if cfg.MaxSweepDelay < 0 {
+		return fmt.Errorf("max sweep delay must not be negative")
+	}
+
+	if cfg.PaymentShardDelay < 0 {
+		return fmt.Errorf("payment shard delay must not be negative")
+	}
+
+	if cfg.PaymentShardDelay > 0 && cfg.PaymentMaxShard == 0 {
+		return fmt.Errorf("payment shard delay requires a maximum " +
+			"payment shard size")
+	}
+
+	if cfg.FeeOverpaymentPercent < 0 {
+		return fmt.Errorf("fee overpayment percent must not be " +
+			"negative")
+	}
+
+	if cfg.SLAAlertFactor != 0 && cfg.SLAAlertFactor < 1 {
+		return fmt.Errorf("sla alert factor must be at least 1, or 0 " +
+			"to disable alerts")
+	}
+
+	for _, endpoint := range cfg.LSPEndpoints {
+		if _, err := parseLSPEndpoint(endpoint); err != nil {
+			return err
+		}
+	}
+
+	if cfg.LogThrottleInterval < 0 {
+		return fmt.Errorf("log throttle interval must not be negative")
+	}
+
+	if cfg.AdviceInterval < 0 {
+		return fmt.Errorf("advice interval must not be negative")
+	}
+
+	if cfg.MonitorBuffer < 1 {
+		return fmt.Errorf("monitor buffer must be at least 1")
+	}
+
+	if cfg.AgentName != "" {
+		if err := loop.ValidateAgentName(cfg.AgentName); err != nil {
+			return err
+		}
+	}
+
 	// Make sure only one of the macaroon options is used.
 	switch {
 	case cfg.Lnd.MacaroonPath != "" && cfg.Lnd.MacaroonDir != "":
@@ -7,17 +7,21 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
 
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -84,7 +88,23 @@ type Daemon struct {
 	restListener  net.Listener
 	restCtxCancel func()
 
+	// profileServer serves runtime profiling data if profiling is enabled.
+	profileServer *http.Server
+
 	macaroonService *macaroons.Service
+
+	// lndValidator validates lnd's macaroons when we are fronted by lnd's
+	// rpc server. If it is nil, calls are authenticated with loop's own
+	// macaroons.
+	lndValidator macaroons.MacaroonValidator
+
+	// loadConfig reads our config again when it is reloaded. It is nil if
+	// the daemon was not started from the command line, in which case our
+	// config cannot be reloaded.
+	loadConfig func() (*Config, error)
+
+	// reloadLock ensures that only one config reload runs at a time.
+	reloadLock sync.Mutex
 }
 
 // New creates a new instance of the loop client daemon.
@@ -117,19 +137,26 @@ func (d *Daemon) Start() error {
 		return errOnlyStartOnce
 	}
 
-	network := lndclient.Network(d.cfg.Network)
-
 	var err error
-	d.lnd, err = d.listenerCfg.getLnd(network, d.cfg.Lnd)
-	if err != nil {
-		return err
-	}
+	if d.cfg.Watch {
+		// In watch mode we only serve swaps from a database replica,
+		// so we don't need a connection to lnd.
+		err = d.initializeWatch()
+	} else {
+		network := lndclient.Network(d.cfg.Network)
 
-	// With lnd connected, initialize everything else, such as the swap
-	// server client, the swap client RPC server instance and our main swap
-	// and error handlers. If this fails, then nothing has been started yet
-	// and we can just return the error.
-	err = d.initialize()
+		d.lnd, err = d.listenerCfg.getLnd(network, d.cfg.Lnd)
+		if err != nil {
+			return err
+		}
+
+		// With lnd connected, initialize everything else, such as the
+		// swap server client, the swap client RPC server instance and
+		// our main swap and error handlers. If this fails, then
+		// nothing has been started yet and we can just return the
+		// error.
+		err = d.initialize()
+	}
 	if errors.Is(err, bbolt.ErrTimeout) {
 		// We're trying to be started as a standalone Loop daemon, most
 		// likely LiT is already running and blocking the DB
@@ -154,6 +181,15 @@ func (d *Daemon) Start() error {
 		return startErr
 	}
 
+	// Now that we serve our rpcs, we start to report our readiness.
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		log.Infof("Monitoring readiness")
+		d.monitorReadiness(d.mainCtx)
+	}()
+
 	return nil
 }
 
@@ -169,6 +205,10 @@ func (d *Daemon) StartAsSubserver(lndGrpc *lndclient.GrpcLndServices) error {
 		return errOnlyStartOnce
 	}
 
+	if d.cfg.Watch {
+		return errWatchSubserver
+	}
+
 	// When starting as a subserver, we get passed in an already established
 	// connection to lnd that might be shared among other subservers.
 	d.lnd = lndGrpc
@@ -192,14 +232,20 @@ func (d *Daemon) StartAsSubserver(lndGrpc *lndclient.GrpcLndServices) error {
 // method are contained within and finally ensures all caveat conditions are
 // met. A non-nil error is returned if any of the checks fail. This method is
 // needed to enable loopd running as an external subserver in the same process
-// as lnd but still validate its own macaroons.
+// as lnd but still validate its own macaroons. In multi-tenant mode, it also
+// checks that tenants only call the methods that they may use.
 func (d *Daemon) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op, fullMethod string) error {
 
 	// Delegate the call to loop's own macaroon validator service.
-	return d.macaroonService.ValidateMacaroon(
+	err := d.macaroonService.ValidateMacaroon(
 		ctx, requiredPermissions, fullMethod,
 	)
+	if err != nil {
+		return err
+	}
+
+	return d.tenants.checkMethod(ctx, fullMethod)
 }
 
 // startWebServers starts the gRPC and REST servers in goroutines.
@@ -214,10 +260,23 @@ func (d *Daemon) startWebServers() error {
 		return fmt.Errorf("error with macaroon interceptor: %v", err)
 	}
 	d.grpcServer = grpc.NewServer(serverOpts...)
-	looprpc.RegisterSwapClientServer(d.grpcServer, d)
 
-	// Register our debug server if it is compiled in.
-	d.registerDebugServer()
+	// In watch mode we only register the read-only RPCs, all others are
+	// left unimplemented.
+	if d.cfg.Watch {
+		looprpc.RegisterSwapClientServer(
+			d.grpcServer, &watchServer{server: &d.swapClientServer},
+		)
+	} else {
+		looprpc.RegisterSwapClientServer(d.grpcServer, d)
+
+		// Register our debug server if it is compiled in.
+		d.registerDebugServer()
+
+		// Register the servers of the swap extensions that are
+		// compiled in.
+		d.RegisterExtensionServers(d.grpcServer)
+	}
 
 	// Next, start the gRPC server listening for HTTP/2 connections.
 	log.Infof("Starting gRPC listener")
@@ -251,18 +310,39 @@ func (d *Daemon) startWebServers() error {
 	d.restCtxCancel = cancel
 	mux := proxy.NewServeMux(customMarshalerOption)
 	var restHandler http.Handler = mux
+	if d.statusLinks != nil {
+		restHandler = d.statusLinks.handler(
+			restHandler, d.swapClientServer.linkedSwapStatus,
+		)
+	}
+	if d.cfg.RESTSigningKeyFile != "" {
+		key, err := loadRESTSigningKey(d.cfg.RESTSigningKeyFile)
+		if err != nil {
+			return err
+		}
+
+		signer := newRESTSigner(key, d.cfg.RESTSigningWindow)
+		restHandler = signer.handler(restHandler)
+	}
 	if d.cfg.CORSOrigin != "" {
 		restHandler = allowCORS(restHandler, d.cfg.CORSOrigin)
 	}
+	// If our rpc server listens on a local socket, the REST proxy dials
+	// the socket rather than a tcp address.
+	restProxyDest, socketOpts, err := RPCDialOptions(d.cfg.RPCListen)
+	if err != nil {
+		return err
+	}
+
 	proxyOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(*restClientCreds),
 		grpc.WithDefaultCallOptions(maxMsgRecvSize),
 	}
+	proxyOpts = append(proxyOpts, socketOpts...)
 
 	// With TLS enabled by default, we cannot call 0.0.0.0 internally from
 	// the REST proxy as that IP address isn't in the cert. We need to
 	// rewrite it to the loopback address.
-	restProxyDest := d.cfg.RPCListen
 	switch {
 	case strings.Contains(restProxyDest, "0.0.0.0"):
 		restProxyDest = strings.Replace(
@@ -314,6 +394,23 @@ func (d *Daemon) startWebServers() error {
 		log.Infof("REST proxy disabled")
 	}
 
+	// Start our profiling listener if it is enabled. Profiling is a
+	// debugging aid, so we only log failures rather than shutting down.
+	if d.cfg.Profile != "" {
+		d.profileServer = newProfileServer(d.cfg.Profile)
+
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Infof("Profiling listening on %s", d.cfg.Profile)
+			err := d.profileServer.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				log.Errorf("Profiling listener failed: %v", err)
+			}
+		}()
+	}
+
 	// Start the grpc server.
 	d.wg.Add(1)
 	go func() {
@@ -338,28 +435,177 @@ func (d *Daemon) startWebServers() error {
 // this method fails with an error then no goroutine was started yet and no
 // cleanup is necessary. If it succeeds, then goroutines have been spawned.
 func (d *Daemon) initialize() error {
-	// If no swap server is specified, use the default addresses for mainnet
-	// and testnet.
+	// If no swap server is specified, use the default address for our
+	// network if it has one.
 	if d.cfg.Server.Host == "" {
 		// TODO(wilmer): Use onion service addresses when proxy is
 		// active.
-		switch d.cfg.Network {
-		case "mainnet":
-			d.cfg.Server.Host = mainnetServer
-		case "testnet":
-			d.cfg.Server.Host = testnetServer
-		default:
-			return errors.New("no swap server address specified")
+		host, ok := defaultServers[d.cfg.Network]
+		if !ok {
+			return fmt.Errorf("no swap server address specified, "+
+				"%v does not have a default server",
+				d.cfg.Network)
 		}
+
+		d.cfg.Server.Host = host
 	}
 
 	log.Infof("Swap server address: %v", d.cfg.Server.Host)
 
+	// Before we create anything that depends on our network, we make sure
+	// that lnd and our swap server agree with it, so that we fail fast
+	// rather than handing out addresses that can't be decoded. Our swap
+	// database performs the same check when it is opened.
+	err := checkServerNetwork(d.cfg.Network, d.cfg.Server.Host)
+	if err != nil {
+		return err
+	}
+
+	err = checkLndNetwork(
+		context.Background(), d.lnd.Client, d.cfg.Network,
+	)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := getMetricsExporter(d.cfg.Metrics)
+	if err != nil {
+		return err
+	}
+
+	traceExporter, err := getTraceExporter(d.cfg.Tracing)
+	if err != nil {
+		return err
+	}
+
+	allowedDests, err := newDestAllowlist(
+		d.cfg.AllowedDest, d.lnd.ChainParams,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Connect to lnd's mission control. The connection is only required
+	// if we import routing failures, so otherwise we just log a warning
+	// if it can't be set up and the mission control rpcs are unavailable.
+	missionControl, err := newLndMissionControl(
+		d.cfg.Lnd, d.cfg.Network,
+		filepath.Join(d.cfg.DataDir, missionControlSnapshotFile),
+	)
+	if err != nil {
+		if d.cfg.RoutingFailurePeriod > 0 {
+			return fmt.Errorf("unable to connect to lnd mission "+
+				"control: %v", err)
+		}
+
+		log.Warnf("Mission control unavailable: %v", err)
+	}
+
+	// Connect to lnd's wallet kit to fund loop in htlcs with specific
+	// coins. If this fails, only loop ins that restrict their htlc's
+	// funding are unavailable, so we just log a warning.
+	htlcFunder, err := newLndHtlcFunder(d.cfg.Lnd, d.cfg.Network)
+	if err != nil {
+		log.Warnf("Htlc funding restrictions unavailable: %v", err)
+	}
+
 	// Create an instance of the loop client library.
-	swapclient, clientCleanup, err := getClient(d.cfg, &d.lnd.LndServices)
+	swapclient, clientCleanup, err := getClient(
+		d.cfg, &d.lnd.LndServices, missionControl, htlcFunder,
+	)
 	if err != nil {
 		return err
 	}
+
+	// If a sweep address type is set, we connect to lnd to generate
+	// addresses of that type. The connection is closed along with our
+	// client.
+	var sweepAddrs *lndSweepAddrs
+	if d.cfg.SweepAddrType != "" {
+		sweepAddrs, err = newLndSweepAddrs(
+			d.cfg.Lnd, d.cfg.Network,
+			sweepAddrTypes[d.cfg.SweepAddrType], d.lnd.ChainParams,
+		)
+		if err != nil {
+			clientCleanup()
+			return fmt.Errorf("unable to connect to lnd to "+
+				"generate sweep addresses: %v", err)
+		}
+
+		cleanup := clientCleanup
+		clientCleanup = func() {
+			cleanup()
+
+			if err := sweepAddrs.close(); err != nil {
+				log.Errorf("Error closing sweep address "+
+					"connection: %v", err)
+			}
+		}
+	}
+	// Connect to lnd to close channels once they are drained. If this
+	// fails, only drains that close their channel are unavailable, so we
+	// just log a warning. The connection is closed along with our client.
+	var closer channelCloser
+	lndCloser, err := newLndChannelCloser(d.cfg.Lnd, d.cfg.Network)
+	if err != nil {
+		log.Warnf("Closing drained channels unavailable: %v", err)
+	} else {
+		closer = lndCloser
+
+		cleanup := clientCleanup
+		clientCleanup = func() {
+			cleanup()
+
+			if err := lndCloser.close(); err != nil {
+				log.Errorf("Error closing channel close "+
+					"connection: %v", err)
+			}
+		}
+	}
+
+	// Connect to lnd to compare our suggested loop outs with circular
+	// rebalances. If this fails, only the comparison is unavailable, so
+	// we just log a warning.
+	circular, err := newLndCircular(
+		d.cfg.Lnd, d.cfg.Network, route.Vertex(d.lnd.NodePubkey),
+	)
+	if err != nil {
+		log.Warnf("Circular rebalances unavailable: %v", err)
+	} else {
+		cleanup := clientCleanup
+		clientCleanup = func() {
+			cleanup()
+
+			if err := circular.close(); err != nil {
+				log.Errorf("Error closing circular rebalance "+
+					"connection: %v", err)
+			}
+		}
+	}
+
+	// If we raise our fees on the channels that autoloop drains, we
+	// connect to lnd to update our channel policies. If this fails, our
+	// fees are left unchanged, so we just log a warning.
+	var channelFees *lndChannelFees
+	if d.cfg.SwapChannelFeeRate != 0 {
+		channelFees, err = newLndChannelFees(
+			d.cfg.Lnd, d.cfg.Network, route.Vertex(d.lnd.NodePubkey),
+			swapclient.Store, d.cfg.SwapChannelFeeRate,
+		)
+		if err != nil {
+			log.Warnf("Swap channel fees unavailable: %v", err)
+		} else {
+			cleanup := clientCleanup
+			clientCleanup = func() {
+				cleanup()
+
+				if err := channelFees.close(); err != nil {
+					log.Errorf("Error closing channel fee "+
+						"connection: %v", err)
+				}
+			}
+		}
+	}
 	d.clientCleanup = clientCleanup
 
 	// Both the client RPC server and and the swap server client should
@@ -377,20 +623,111 @@ func (d *Daemon) initialize() error {
 		return err
 	}
 
+	liquidityMgr := getLiquidityManager(
+		d.cfg, swapclient, exporter, missionControl, sweepAddrs,
+		circular, channelFees,
+	)
+
+	// Swaps may have completed while we were offline, so we restore the
+	// fees of the channels that they drained before we start.
+	if channelFees != nil {
+		swaps, err := swapclient.Store.FetchLoopOutSwaps()
+		if err == nil {
+			err = channelFees.restoreCompleted(d.mainCtx, swaps)
+		}
+		if err != nil {
+			log.Warnf("Could not restore channel fees: %v", err)
+		}
+	}
+
 	// Now finally fully initialize the swap client RPC server instance.
 	d.swapClientServer = swapClientServer{
 		network:      lndclient.Network(d.cfg.Network),
 		impl:         swapclient,
-		liquidityMgr: getLiquidityManager(swapclient),
+		liquidityMgr: liquidityMgr,
+		metrics:      exporter,
 		lnd:          &d.lnd.LndServices,
 		swaps:        make(map[lntypes.Hash]loop.SwapInfo),
-		subscribers:  make(map[int]chan<- interface{}),
+		requests:     make(map[requestKey]*clientRequest),
+		subscribers:  make(map[int]*subscriberQueue),
 		statusChan:   make(chan loop.SwapInfo),
 		mainCtx:      d.mainCtx,
+		profileDir:   filepath.Join(d.cfg.DataDir, defaultProfileDirname),
+
+		validateMacaroon: d.macaroonService.ValidateMacaroon,
+		allowedDests:     allowedDests,
+		approvalThreshold: btcutil.Amount(
+			d.cfg.Limits.ApprovalThreshold,
+		),
+		approvalTimeout: d.cfg.Limits.ApprovalTimeout,
+		reload:          d.reloadConfig,
+		missionControl:  missionControl,
+		closer:          closer,
+		channelFees:     channelFees,
+		paths:           marshallDaemonPaths(d.cfg),
+
+		feeOverpaymentPercent: d.cfg.FeeOverpaymentPercent,
+		slaAlertFactor:        d.cfg.SLAAlertFactor,
+		slaAlerts:             make(map[lntypes.Hash]bool),
+		health:                make(map[lntypes.Hash]*swapHealthEntry),
+
+		monitorBuffer:   d.cfg.MonitorBuffer,
+		monitorOverflow: d.cfg.MonitorOverflow,
+	}
+
+	if sweepAddrs != nil {
+		d.swapClientServer.nextAddr = sweepAddrs.nextAddr
 	}
 
-	// Retrieve all currently existing swaps from the database.
-	swapsList, err := d.impl.FetchSwaps()
+	// The links that grant read-only access to a single swap are signed
+	// with a key in our data directory. If we can't read or create the
+	// key, only our status links are unavailable, so we just log a
+	// warning.
+	d.swapClientServer.statusLinks, err = newStatusLinks(d.cfg.DataDir)
+	if err != nil {
+		log.Warnf("Status links unavailable: %v", err)
+	}
+
+	// When lnd's macaroons authenticate our calls, our additional
+	// permission checks must be made against them as well.
+	if d.lndValidator != nil {
+		d.swapClientServer.validateMacaroon =
+			d.lndValidator.ValidateMacaroon
+	}
+
+	// In multi-tenant mode, the macaroons that we created at our macaroon
+	// and approver macaroon paths identify the operator. If we are
+	// authenticated with lnd's macaroons, the macaroon that we connect to
+	// lnd with is the operator's instead.
+	if d.cfg.MultiTenant {
+		operatorMac := d.cfg.MacaroonPath
+		approverMac := d.cfg.ApproverMacaroonPath
+		if d.lndValidator != nil {
+			operatorMac = d.cfg.Lnd.MacaroonPath
+			approverMac = ""
+		}
+
+		d.swapClientServer.tenants, err = newTenantPolicy(
+			operatorMac, approverMac,
+		)
+		if err != nil {
+			if err := d.stopMacaroonService(); err != nil {
+				log.Errorf("Error shutting down macaroon "+
+					"service: %v", err)
+			}
+			clientCleanup()
+			return err
+		}
+
+		if d.lndValidator == nil {
+			d.swapClientServer.tenants.bake = d.bakeMacaroon
+		}
+	}
+
+	// Retrieve our pending swaps from the database. The rest of our swap
+	// history is only read once it is needed, so that a long history does
+	// not slow down our startup.
+	swapsList, err := d.impl.FetchPendingSwaps()
 	if err != nil {
 		// The client and the macaroon service are the only things we
 		// started yet, so if we clean that up now, nothing else needs
@@ -406,6 +743,24 @@ func (d *Daemon) initialize() error {
 	for _, s := range swapsList {
 		d.swaps[s.SwapHash] = *s
 	}
+	d.historyDeferred = true
+
+	// Provide our swap extensions with their resources before we register
+	// their servers.
+	if err := d.initExtensions(); err != nil {
+		if err := d.stopMacaroonService(); err != nil {
+			log.Errorf("Error shutting down macaroon service: %v",
+				err)
+		}
+		clientCleanup()
+		return err
+	}
+
+	// We set our trace exporter before we start our client so that we
+	// trace swaps from the moment they are resumed.
+	if traceExporter != nil {
+		tracing.SetExporter(traceExporter)
+	}
 
 	// Start the swap client itself.
 	d.wg.Add(1)
@@ -433,6 +788,44 @@ func (d *Daemon) initialize() error {
 		d.processStatusUpdates(d.mainCtx)
 	}()
 
+	if d.cfg.SLAAlertFactor != 0 {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Infof("Monitoring swap completion times")
+			d.monitorSwapSLAs(d.mainCtx)
+		}()
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		log.Infof("Monitoring swap health")
+		d.monitorSwapHealth(d.mainCtx)
+	}()
+
+	if d.cfg.AdviceInterval != 0 {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Infof("Monitoring parameter advice")
+			d.monitorParameterAdvice(
+				d.mainCtx, d.cfg.AdviceInterval,
+			)
+		}()
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		log.Infof("Executing swap plans")
+		d.runSwapPlans(d.mainCtx)
+	}()
+
 	d.wg.Add(1)
 	go func() {
 		defer d.wg.Done()
@@ -446,12 +839,65 @@ func (d *Daemon) initialize() error {
 		log.Info("Liquidity manager stopped")
 	}()
 
-	// Last, start our internal error handler. This will return exactly one
-	// error or nil on the main error channel to inform the caller that
-	// something went wrong or that shutdown is complete. We don't add to
-	// the wait group here because this goroutine will itself wait for the
-	// stop to complete and signal its completion through the main error
-	// channel.
+	if d.metrics != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Info("Starting metrics exporter")
+			err := d.metrics.Run(d.mainCtx)
+			if err != nil && err != context.Canceled {
+				d.internalErrChan <- err
+			}
+
+			log.Info("Metrics exporter stopped")
+		}()
+	}
+
+	if traceExporter != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Info("Starting trace exporter")
+			err := traceExporter.Run(d.mainCtx)
+			if err != nil && err != context.Canceled {
+				d.internalErrChan <- err
+			}
+
+			tracing.SetExporter(nil)
+			log.Info("Trace exporter stopped")
+		}()
+	}
+
+	// Write a replica of our database for daemons in watch mode if
+	// configured.
+	if d.cfg.Replica.Path != "" {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Infof("Writing swap database replica to %v",
+				d.cfg.Replica.Path)
+			d.writeReplica()
+		}()
+	}
+
+	// Run the swap extensions that are compiled in.
+	d.runExtensions()
+
+	// Last, start our internal error handler.
+	d.startErrorHandler()
+
+	return nil
+}
+
+// startErrorHandler starts our internal error handler. This will return
+// exactly one error or nil on the main error channel to inform the caller that
+// something went wrong or that shutdown is complete. We don't add to the wait
+// group here because this goroutine will itself wait for the stop to complete
+// and signal its completion through the main error channel.
+func (d *Daemon) startErrorHandler() {
 	go func() {
 		var runtimeErr error
 
@@ -476,8 +922,6 @@ func (d *Daemon) initialize() error {
 		// even if it's nil because we cleanly shut down.
 		d.ErrChan <- runtimeErr
 	}()
-
-	return nil
 }
 
 // Stop tries to gracefully shut down the daemon. A caller needs to wait for a
@@ -515,6 +959,12 @@ func (d *Daemon) stop() {
 	if d.restCtxCancel != nil {
 		d.restCtxCancel()
 	}
+	if d.profileServer != nil {
+		err := d.profileServer.Close()
+		if err != nil {
+			log.Errorf("Error stopping profiling listener: %v", err)
+		}
+	}
 
 	err := d.macaroonService.Close()
 	if err != nil {
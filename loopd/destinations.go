@@ -0,0 +1,260 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// descriptorInputCharset is the set of characters that may be used in
+	// an output descriptor, ordered as required for its checksum.
+	descriptorInputCharset = "0123456789()[],'/*abcdefgh@:$%{}" +
+		"IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~" +
+		"ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+
+	// descriptorChecksumCharset is the set of characters that an output
+	// descriptor's checksum is encoded with.
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// descriptorChecksumLength is the length of an output descriptor's
+	// checksum.
+	descriptorChecksumLength = 8
+)
+
+// errDestNotAllowed is returned when a loop out is requested to a destination
+// that is not in our allowlist by a caller without an admin macaroon.
+var errDestNotAllowed = status.Error(
+	codes.PermissionDenied, "destination address is not in the "+
+		"allowlist, swaps to other destinations require an admin "+
+		"macaroon",
+)
+
+// destAllowlist is a set of output scripts that loop out swaps may sweep to.
+type destAllowlist map[string]struct{}
+
+// newDestAllowlist parses the destinations provided, which may either be
+// plain addresses or addr() and raw() output descriptors, into an allowlist.
+// If no destinations are provided, a nil allowlist is returned which permits
+// any destination.
+func newDestAllowlist(dests []string,
+	params *chaincfg.Params) (destAllowlist, error) {
+
+	if len(dests) == 0 {
+		return nil, nil
+	}
+
+	allowlist := make(destAllowlist, len(dests))
+	for _, dest := range dests {
+		pkScript, err := parseDestination(dest, params)
+		if err != nil {
+			return nil, fmt.Errorf("allowed destination %v: %v",
+				dest, err)
+		}
+
+		allowlist[string(pkScript)] = struct{}{}
+	}
+
+	return allowlist, nil
+}
+
+// allowed returns a boolean indicating whether the address provided is in
+// our allowlist. All addresses are allowed if the allowlist is nil.
+func (d destAllowlist) allowed(addr btcutil.Address) (bool, error) {
+	if d == nil {
+		return true, nil
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := d[string(pkScript)]
+	return ok, nil
+}
+
+// parseDestination parses an address or an addr() or raw() output descriptor
+// and returns the output script it describes.
+func parseDestination(dest string, params *chaincfg.Params) ([]byte, error) {
+	if !strings.Contains(dest, "(") {
+		return addressScript(dest, params)
+	}
+
+	desc, err := checkDescriptorChecksum(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(desc, ")") {
+		return nil, errors.New("invalid descriptor")
+	}
+	desc = strings.TrimSuffix(desc, ")")
+
+	switch {
+	case strings.HasPrefix(desc, "addr("):
+		return addressScript(strings.TrimPrefix(desc, "addr("), params)
+
+	case strings.HasPrefix(desc, "raw("):
+		pkScript, err := hex.DecodeString(
+			strings.TrimPrefix(desc, "raw("),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pkScript) == 0 {
+			return nil, errors.New("empty raw script")
+		}
+
+		return pkScript, nil
+
+	default:
+		return nil, errors.New("only addr() and raw() descriptors are " +
+			"supported")
+	}
+}
+
+// addressScript decodes an address for the network provided and returns its
+// output script.
+func addressScript(addr string, params *chaincfg.Params) ([]byte, error) {
+	decoded, err := btcutil.DecodeAddress(addr, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !decoded.IsForNet(params) {
+		return nil, fmt.Errorf("address is not for %v", params.Name)
+	}
+
+	return txscript.PayToAddrScript(decoded)
+}
+
+// checkDescriptorChecksum validates the checksum of an output descriptor, if
+// it has one, and returns the descriptor with its checksum removed.
+func checkDescriptorChecksum(desc string) (string, error) {
+	parts := strings.Split(desc, "#")
+	switch len(parts) {
+	case 1:
+		return desc, nil
+
+	case 2:
+
+	default:
+		return "", errors.New("multiple '#' symbols in descriptor")
+	}
+
+	checksum, err := descriptorChecksum(parts[0])
+	if err != nil {
+		return "", err
+	}
+
+	if parts[1] != checksum {
+		return "", fmt.Errorf("invalid descriptor checksum %v, "+
+			"expected %v", parts[1], checksum)
+	}
+
+	return parts[0], nil
+}
+
+// descriptorChecksum calculates the checksum of an output descriptor as
+// defined in BIP 380.
+func descriptorChecksum(desc string) (string, error) {
+	var (
+		c          uint64 = 1
+		class      uint64
+		classCount int
+	)
+
+	for _, char := range desc {
+		pos := strings.IndexRune(descriptorInputCharset, char)
+		if pos < 0 {
+			return "", fmt.Errorf("invalid descriptor character %q",
+				char)
+		}
+
+		c = descriptorPolyMod(c, uint64(pos)&31)
+		class = class*3 + uint64(pos)>>5
+
+		classCount++
+		if classCount == 3 {
+			c = descriptorPolyMod(c, class)
+			class, classCount = 0, 0
+		}
+	}
+
+	if classCount > 0 {
+		c = descriptorPolyMod(c, class)
+	}
+
+	for i := 0; i < descriptorChecksumLength; i++ {
+		c = descriptorPolyMod(c, 0)
+	}
+	c ^= 1
+
+	checksum := make([]byte, descriptorChecksumLength)
+	for i := range checksum {
+		shift := 5 * uint(descriptorChecksumLength-1-i)
+		checksum[i] = descriptorChecksumCharset[(c>>shift)&31]
+	}
+
+	return string(checksum), nil
+}
+
+// descriptorPolyMod is the BCH code generator used to calculate descriptor
+// checksums.
+func descriptorPolyMod(c, val uint64) uint64 {
+	generators := [5]uint64{
+		0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a,
+		0x644d626ffd,
+	}
+
+	c0 := c >> 35
+	c = ((c & 0x7ffffffff) << 5) ^ val
+	for i, generator := range generators {
+		if (c0>>uint(i))&1 == 1 {
+			c ^= generator
+		}
+	}
+
+	return c
+}
+
+// checkDestination checks that a caller provided loop out destination is in
+// our allowlist. Callers with an admin macaroon may sweep to any destination.
+// If we have no macaroon validator, destinations outside of the allowlist are
+// always rejected.
+func (s *swapClientServer) checkDestination(ctx context.Context,
+	addr btcutil.Address) error {
+
+	s.policyLock.RLock()
+	allowed, err := s.allowedDests.allowed(addr)
+	s.policyLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if allowed {
+		return nil
+	}
+
+	if s.validateMacaroon == nil {
+		return errDestNotAllowed
+	}
+
+	method, _ := grpc.Method(ctx)
+	if err := s.validateMacaroon(ctx, allPermissions, method); err != nil {
+		return errDestNotAllowed
+	}
+
+	return nil
+}
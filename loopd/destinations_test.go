@@ -0,0 +1,147 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// TestDescriptorChecksum tests calculation of output descriptor checksums
+// against the test vectors in BIP 380.
+func TestDescriptorChecksum(t *testing.T) {
+	desc, err := checkDescriptorChecksum("raw(deadbeef)#89f8spxm")
+	require.NoError(t, err)
+	require.Equal(t, "raw(deadbeef)", desc)
+
+	_, err = checkDescriptorChecksum("raw(deedbeef)#89f8spxm")
+	require.Error(t, err)
+
+	_, err = checkDescriptorChecksum("raw(deadbeef)##9f8spxm")
+	require.Error(t, err)
+}
+
+// TestDestAllowlist tests parsing of our destination allowlist and checking
+// of destinations against it.
+func TestDestAllowlist(t *testing.T) {
+	params := &chaincfg.TestNet3Params
+
+	pkScript, err := txscript.PayToAddrScript(testnetAddr)
+	require.NoError(t, err)
+
+	otherAddr, err := btcutil.NewAddressScriptHash([]byte{1}, params)
+	require.NoError(t, err)
+
+	// A nil allowlist permits any destination.
+	allowlist, err := newDestAllowlist(nil, params)
+	require.NoError(t, err)
+
+	allowed, err := allowlist.allowed(otherAddr)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	tests := []struct {
+		name  string
+		dest  string
+		valid bool
+	}{
+		{
+			name:  "address",
+			dest:  testnetAddr.String(),
+			valid: true,
+		},
+		{
+			name:  "addr descriptor",
+			dest:  "addr(" + testnetAddr.String() + ")",
+			valid: true,
+		},
+		{
+			name:  "raw descriptor",
+			dest:  "raw(" + hex.EncodeToString(pkScript) + ")",
+			valid: true,
+		},
+		{
+			name: "wrong network",
+			dest: mainnetAddr.String(),
+		},
+		{
+			name: "unsupported descriptor",
+			dest: "wpkh(" + testnetAddr.String() + ")",
+		},
+		{
+			name: "empty raw descriptor",
+			dest: "raw()",
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			allowlist, err := newDestAllowlist(
+				[]string{testCase.dest}, params,
+			)
+			if !testCase.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			allowed, err := allowlist.allowed(testnetAddr)
+			require.NoError(t, err)
+			require.True(t, allowed)
+
+			allowed, err = allowlist.allowed(otherAddr)
+			require.NoError(t, err)
+			require.False(t, allowed)
+		})
+	}
+}
+
+// TestCheckDestination tests that destinations outside of our allowlist
+// require an admin macaroon.
+func TestCheckDestination(t *testing.T) {
+	ctx := context.Background()
+
+	allowlist, err := newDestAllowlist(
+		[]string{testnetAddr.String()}, &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	otherAddr, err := btcutil.NewAddressScriptHash(
+		[]byte{1}, &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	var checked []bakery.Op
+	server := &swapClientServer{
+		allowedDests: allowlist,
+		validateMacaroon: func(_ context.Context, ops []bakery.Op,
+			_ string) error {
+
+			checked = ops
+			return errors.New("permission denied")
+		},
+	}
+
+	// Destinations in our allowlist do not require any permissions.
+	require.NoError(t, server.checkDestination(ctx, testnetAddr))
+	require.Nil(t, checked)
+
+	err = server.checkDestination(ctx, otherAddr)
+	require.Equal(t, errDestNotAllowed, err)
+	require.Equal(t, allPermissions, checked)
+
+	server.validateMacaroon = func(context.Context, []bakery.Op,
+		string) error {
+
+		return nil
+	}
+	require.NoError(t, server.checkDestination(ctx, otherAddr))
+}
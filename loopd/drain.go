@@ -0,0 +1,277 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	// defaultDrainReserveDivisor sets the local balance that is left in a
+	// drained channel if no reserve is set to 1% of its capacity, which is
+	// lnd's default channel reserve.
+	defaultDrainReserveDivisor = 100
+
+	// drainInitiator is the initiator of the swaps that drain channels.
+	drainInitiator = "drain"
+)
+
+var (
+	// errCloseUnavailable is returned when a drained channel should be
+	// closed, but we could not connect to lnd to close channels.
+	errCloseUnavailable = errors.New("closing channels is unavailable")
+)
+
+// channelCloser cooperatively closes channels.
+type channelCloser interface {
+	// closeChannel initiates the cooperative close of a channel and
+	// returns the txid of its closing transaction once it is published. A
+	// zero fee rate leaves the fee rate to lnd's estimate.
+	closeChannel(ctx context.Context, channel *wire.OutPoint,
+		satPerVbyte uint64) (*chainhash.Hash, error)
+}
+
+// lndChannelCloser closes channels with lnd. The version of lndclient that
+// we use does not expose channel closes, so we use a separate connection to
+// lnd's lightning rpc.
+type lndChannelCloser struct {
+	conn   *grpc.ClientConn
+	client lnrpc.LightningClient
+}
+
+// newLndChannelCloser connects to the lightning rpc of the lnd instance in
+// the config provided. The macaroon used must hold the offchain:write
+// permission.
+func newLndChannelCloser(cfg *lndConfig, network string) (*lndChannelCloser,
+	error) {
+
+	conn, err := lndclient.NewBasicConn(
+		cfg.Host, cfg.TLSPath, filepath.Dir(cfg.MacaroonPath), network,
+		lndclient.MacFilename(filepath.Base(cfg.MacaroonPath)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lndChannelCloser{
+		conn:   conn,
+		client: lnrpc.NewLightningClient(conn),
+	}, nil
+}
+
+// closeChannel initiates the cooperative close of a channel and returns the
+// txid of its closing transaction once lnd has published it.
+//
+// NOTE: Part of the channelCloser interface.
+func (l *lndChannelCloser) closeChannel(ctx context.Context,
+	channel *wire.OutPoint, satPerVbyte uint64) (*chainhash.Hash, error) {
+
+	// We only wait for our closing transaction to be published, so we
+	// cancel the stream of updates for the close once we return.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := l.client.CloseChannel(ctx, &lnrpc.CloseChannelRequest{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: channel.Hash[:],
+			},
+			OutputIndex: channel.Index,
+		},
+		SatPerVbyte: satPerVbyte,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		pending := update.GetClosePending()
+		if pending == nil {
+			continue
+		}
+
+		return chainhash.NewHash(pending.Txid)
+	}
+}
+
+// close closes our connection to lnd.
+func (l *lndChannelCloser) close() error {
+	return l.conn.Close()
+}
+
+// drainAmount returns the amount of the next swap that drains a channel. The
+// amount leaves the reserve provided in the channel, along with the most
+// that the swap may spend on fees at the portion of its amount provided, and
+// does not exceed the maximum amount provided.
+func drainAmount(localBalance, reserve btcutil.Amount, feePPM uint64,
+	maxAmount btcutil.Amount) btcutil.Amount {
+
+	if localBalance <= reserve {
+		return 0
+	}
+
+	amount := (localBalance - reserve) * liquidity.FeeBase /
+		btcutil.Amount(liquidity.FeeBase+feePPM)
+
+	if amount > maxAmount {
+		amount = maxAmount
+	}
+
+	return amount
+}
+
+// drainSwap dispatches a swap that drains the channel provided and blocks
+// until it completes. A nil swap is returned if the channel cannot carry
+// another swap.
+func (s *swapClientServer) drainSwap(ctx context.Context,
+	channel *lndclient.ChannelInfo, reserve btcutil.Amount,
+	params *channelSwapParams) (*loop.SwapInfo, error) {
+
+	terms, err := s.impl.LoopOutTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if reserve == 0 {
+		reserve = channel.Capacity / defaultDrainReserveDivisor
+	}
+
+	// We always leave the portion of the local balance that lnd holds for
+	// our channel reserve and commitment fees, which we cannot send.
+	outgoingReserve := liquidity.OutgoingReserve(*channel)
+	if reserve < outgoingReserve {
+		reserve = outgoingReserve
+	}
+
+	amount := s.capToApproval(drainAmount(
+		channel.LocalBalance, reserve, params.feePPM,
+		terms.MaxSwapAmount,
+	))
+	if amount < terms.MinSwapAmount {
+		return nil, nil
+	}
+
+	return s.channelLoopOut(ctx, channel.ChannelID, amount, params)
+}
+
+// DrainChannel loops out as much of the local balance of a channel as
+// possible, one swap at a time, and optionally closes the channel once it is
+// drained.
+func (s *swapClientServer) DrainChannel(in *looprpc.DrainChannelRequest,
+	server looprpc.SwapClient_DrainChannelServer) error {
+
+	log.Infof("Drain channel request received")
+
+	ctx := server.Context()
+
+	switch {
+	case in.Channel == 0:
+		return errors.New("channel required")
+
+	case in.Close && in.CloseThresholdSat == 0:
+		return errors.New("close threshold required to close channel")
+
+	case in.Close && s.closer == nil:
+		return errCloseUnavailable
+	}
+
+	params, err := s.newChannelSwapParams(
+		ctx, in.Dest, in.SweepConfTarget, 0, in.FeePpm, in.Label,
+		drainInitiator,
+	)
+	if err != nil {
+		return err
+	}
+
+	var completed uint32
+	for in.MaxSwaps == 0 || completed < in.MaxSwaps {
+		channel, err := s.findChannel(ctx, in.Channel)
+		if err != nil {
+			return err
+		}
+
+		info, err := s.drainSwap(
+			ctx, channel, btcutil.Amount(in.ReserveSat), params,
+		)
+		if err != nil {
+			return err
+		}
+
+		if info == nil {
+			break
+		}
+
+		completed++
+		log.Infof("Drain swap %v of channel %v completed", completed,
+			in.Channel)
+
+		swap, err := s.marshallSwap(info)
+		if err != nil {
+			return err
+		}
+
+		channel, err = s.findChannel(ctx, in.Channel)
+		if err != nil {
+			return err
+		}
+
+		err = server.Send(&looprpc.DrainUpdate{
+			State:           looprpc.DrainState_DRAIN_SWAPPING,
+			LocalBalanceSat: uint64(channel.LocalBalance),
+			Swap:            swap,
+			SwapsCompleted:  completed,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	channel, err := s.findChannel(ctx, in.Channel)
+	if err != nil {
+		return err
+	}
+
+	update := &looprpc.DrainUpdate{
+		State:           looprpc.DrainState_DRAIN_COMPLETE,
+		LocalBalanceSat: uint64(channel.LocalBalance),
+		SwapsCompleted:  completed,
+	}
+
+	threshold := btcutil.Amount(in.CloseThresholdSat)
+	if !in.Close || channel.LocalBalance >= threshold {
+		return server.Send(update)
+	}
+
+	outpoint, err := parseOutpoint(channel.ChannelPoint)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Closing drained channel %v", in.Channel)
+
+	txid, err := s.closer.closeChannel(ctx, outpoint, in.CloseSatPerVbyte)
+	if err != nil {
+		return fmt.Errorf("close channel: %v", err)
+	}
+
+	update.State = looprpc.DrainState_DRAIN_CLOSING
+	update.ClosingTxid = txid.String()
+
+	return server.Send(update)
+}
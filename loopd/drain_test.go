@@ -0,0 +1,58 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDrainAmount tests calculation of the amounts of the swaps that drain a
+// channel.
+func TestDrainAmount(t *testing.T) {
+	tests := []struct {
+		name         string
+		localBalance btcutil.Amount
+		reserve      btcutil.Amount
+		feePPM       uint64
+		maxAmount    btcutil.Amount
+		amount       btcutil.Amount
+	}{
+		{
+			name:         "balance below reserve",
+			localBalance: 1000,
+			reserve:      2000,
+			feePPM:       20000,
+			maxAmount:    100000,
+			amount:       0,
+		},
+		{
+			name:         "fees left in channel",
+			localBalance: 112000,
+			reserve:      10000,
+			feePPM:       20000,
+			maxAmount:    1000000,
+			amount:       100000,
+		},
+		{
+			name:         "limited to maximum amount",
+			localBalance: 1000000,
+			reserve:      10000,
+			feePPM:       20000,
+			maxAmount:    500000,
+			amount:       500000,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			amount := drainAmount(
+				testCase.localBalance, testCase.reserve,
+				testCase.feePPM, testCase.maxAmount,
+			)
+			require.Equal(t, testCase.amount, amount)
+		})
+	}
+}
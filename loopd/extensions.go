@@ -0,0 +1,203 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swapfsm"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// SwapExtension is a swap protocol that is compiled into loopd in addition to
+// loop out and loop in. Experimental protocols register themselves from an
+// init function in a file that is only built with their build tag:
+//
+//	// +build hyperloop
+//
+//	func init() {
+//		if err := loopd.RegisterExtension(&hyperloop{}); err != nil {
+//			panic(err)
+//		}
+//	}
+type SwapExtension interface {
+	// Name returns the unique name of the extension, which also
+	// namespaces its storage.
+	Name() string
+
+	// Machine returns the state machine that the extension's swaps are
+	// driven through.
+	Machine() *swapfsm.Machine
+
+	// Permissions returns the macaroon permissions that are required for
+	// each of the extension's rpc methods, keyed by full method name.
+	Permissions() map[string][]bakery.Op
+
+	// Init provides the extension with the daemon's resources. It is
+	// called before the extension's rpc services are registered.
+	Init(env *ExtensionEnv) error
+
+	// RegisterRPC registers the extension's rpc services.
+	RegisterRPC(registrar grpc.ServiceRegistrar)
+
+	// Run runs the extension until the context is cancelled.
+	Run(ctx context.Context) error
+}
+
+// ExtensionEnv holds the resources of the daemon that extensions may use.
+type ExtensionEnv struct {
+	// Network is the network that the daemon is running on.
+	Network string
+
+	// Lnd is the daemon's connection to lnd.
+	Lnd *lndclient.LndServices
+
+	// Store is the daemon's swap store. Extensions should keep their
+	// swaps in their own bucket through its extension methods.
+	Store loopdb.SwapStore
+}
+
+var (
+	// extensions holds the swap extensions that are compiled in, keyed by
+	// name.
+	extensions = make(map[string]SwapExtension)
+
+	// extensionsMtx guards our registered extensions.
+	extensionsMtx sync.Mutex
+
+	// errExtensionRegistered is returned when an extension is registered
+	// with a name that is already taken.
+	errExtensionRegistered = errors.New("extension already registered")
+)
+
+// RegisterExtension registers a swap extension with the daemon. The
+// extension's permissions are added to RequiredPermissions immediately, so
+// that they are enforced by processes that embed loopd as well.
+func RegisterExtension(ext SwapExtension) error {
+	extensionsMtx.Lock()
+	defer extensionsMtx.Unlock()
+
+	name := ext.Name()
+	if name == "" {
+		return errors.New("extension name required")
+	}
+
+	if _, ok := extensions[name]; ok {
+		return fmt.Errorf("%w: %v", errExtensionRegistered, name)
+	}
+
+	if ext.Machine() == nil {
+		return fmt.Errorf("extension %v has no state machine", name)
+	}
+
+	permissions := ext.Permissions()
+	for method := range permissions {
+		if _, ok := RequiredPermissions[method]; ok {
+			return fmt.Errorf("extension %v method %v is already "+
+				"registered", name, method)
+		}
+	}
+
+	for method, ops := range permissions {
+		RequiredPermissions[method] = ops
+	}
+	extensions[name] = ext
+
+	return nil
+}
+
+// registeredExtensions returns our registered extensions, ordered by name.
+func registeredExtensions() []SwapExtension {
+	extensionsMtx.Lock()
+	defer extensionsMtx.Unlock()
+
+	exts := make([]SwapExtension, 0, len(extensions))
+	for _, ext := range extensions {
+		exts = append(exts, ext)
+	}
+
+	sort.Slice(exts, func(i, j int) bool {
+		return exts[i].Name() < exts[j].Name()
+	})
+
+	return exts
+}
+
+// extensionPermissions returns the distinct permissions that our registered
+// extensions require, so that they can be added to our default macaroon.
+func extensionPermissions() []bakery.Op {
+	seen := make(map[bakery.Op]bool)
+
+	var permissions []bakery.Op
+	for _, ext := range registeredExtensions() {
+		for _, ops := range ext.Permissions() {
+			for _, op := range ops {
+				if seen[op] {
+					continue
+				}
+
+				seen[op] = true
+				permissions = append(permissions, op)
+			}
+		}
+	}
+
+	return permissions
+}
+
+// initExtensions provides our registered extensions with the daemon's
+// resources.
+func (d *Daemon) initExtensions() error {
+	env := &ExtensionEnv{
+		Network: d.cfg.Network,
+		Lnd:     &d.lnd.LndServices,
+		Store:   d.impl.Store,
+	}
+
+	for _, ext := range registeredExtensions() {
+		log.Infof("Initializing swap extension %v (%v)", ext.Name(),
+			ext.Machine())
+
+		if err := ext.Init(env); err != nil {
+			return fmt.Errorf("extension %v: %v", ext.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// RegisterExtensionServers registers the rpc services of our swap extensions.
+// Processes that run loopd as a subserver must call it with their own server,
+// because loopd does not create a gRPC server in that mode.
+func (d *Daemon) RegisterExtensionServers(registrar grpc.ServiceRegistrar) {
+	for _, ext := range registeredExtensions() {
+		ext.RegisterRPC(registrar)
+	}
+}
+
+// runExtensions runs our registered extensions until the daemon's main
+// context is cancelled.
+func (d *Daemon) runExtensions() {
+	for _, ext := range registeredExtensions() {
+		ext := ext
+
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Infof("Starting swap extension %v", ext.Name())
+			err := ext.Run(d.mainCtx)
+			if err != nil && err != context.Canceled {
+				d.internalErrChan <- fmt.Errorf("extension "+
+					"%v: %v", ext.Name(), err)
+			}
+
+			log.Infof("Swap extension %v stopped", ext.Name())
+		}()
+	}
+}
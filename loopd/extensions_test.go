@@ -0,0 +1,99 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/loop/swapfsm"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// mockExtension is a swap extension that does nothing.
+type mockExtension struct {
+	name        string
+	machine     *swapfsm.Machine
+	permissions map[string][]bakery.Op
+}
+
+func (m *mockExtension) Name() string {
+	return m.name
+}
+
+func (m *mockExtension) Machine() *swapfsm.Machine {
+	return m.machine
+}
+
+func (m *mockExtension) Permissions() map[string][]bakery.Op {
+	return m.permissions
+}
+
+func (m *mockExtension) Init(_ *ExtensionEnv) error {
+	return nil
+}
+
+func (m *mockExtension) RegisterRPC(_ grpc.ServiceRegistrar) {}
+
+func (m *mockExtension) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// TestRegisterExtension tests registration of swap extensions and their
+// permissions.
+func TestRegisterExtension(t *testing.T) {
+	// Restore our global registry once we're done.
+	defer func() {
+		for _, ext := range registeredExtensions() {
+			for method := range ext.Permissions() {
+				delete(RequiredPermissions, method)
+			}
+		}
+		extensions = make(map[string]SwapExtension)
+	}()
+
+	readOp := bakery.Op{Entity: "hyperloop", Action: "read"}
+	writeOp := bakery.Op{Entity: "hyperloop", Action: "write"}
+
+	hyperloop := &mockExtension{
+		name:    "hyperloop",
+		machine: swapfsm.LoopOut,
+		permissions: map[string][]bakery.Op{
+			"/hyperlooprpc.Hyperloop/List": {readOp},
+			"/hyperlooprpc.Hyperloop/Join": {readOp, writeOp},
+		},
+	}
+	require.NoError(t, RegisterExtension(hyperloop))
+	require.Equal(
+		t, []bakery.Op{readOp},
+		RequiredPermissions["/hyperlooprpc.Hyperloop/List"],
+	)
+
+	// Names must be unique.
+	err := RegisterExtension(&mockExtension{
+		name:    "hyperloop",
+		machine: swapfsm.LoopIn,
+	})
+	require.True(t, errors.Is(err, errExtensionRegistered))
+
+	// Extensions need a state machine.
+	require.Error(t, RegisterExtension(&mockExtension{
+		name: "taproot",
+	}))
+
+	// Extensions can't replace the permissions of existing methods.
+	require.Error(t, RegisterExtension(&mockExtension{
+		name:    "taproot",
+		machine: swapfsm.LoopOut,
+		permissions: map[string][]bakery.Op{
+			"/looprpc.SwapClient/LoopOut": {writeOp},
+		},
+	}))
+
+	require.Equal(t, []SwapExtension{hyperloop}, registeredExtensions())
+	require.ElementsMatch(
+		t, []bakery.Op{readOp, writeOp}, extensionPermissions(),
+	)
+}
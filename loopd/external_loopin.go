@@ -0,0 +1,295 @@
+package loopd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// awaitingPayment is the state of external loop ins that have not
+	// been paid.
+	awaitingPayment = looprpc.ExternalLoopInState_EXTERNAL_AWAITING_PAYMENT
+
+	// htlcConfirmed is the state of external loop ins whose payment to
+	// the htlc confirmed.
+	htlcConfirmed = looprpc.ExternalLoopInState_EXTERNAL_PAYMENT_CONFIRMED
+)
+
+// errExternalApproval is returned when an external loop in exceeds our
+// approval threshold. Such swaps must be reserved with LoopIn and approved
+// before they are executed.
+var errExternalApproval = status.Error(
+	codes.FailedPrecondition, "swaps that require approval must be "+
+		"requested with LoopIn",
+)
+
+// BIP21URI returns a BIP21 uri that requests payment of the amount provided to
+// the address provided. The label is only included if it is non-empty.
+func BIP21URI(addr string, amount btcutil.Amount, label string) string {
+	uri := fmt.Sprintf("bitcoin:%v?amount=%v", addr,
+		strconv.FormatFloat(amount.ToBTC(), 'f', -1, 64))
+
+	// Query escaping encodes spaces as '+', which wallets may not decode
+	// as spaces, so we percent-encode them instead.
+	if label != "" {
+		uri += "&label=" + strings.ReplaceAll(
+			url.QueryEscape(label), "+", "%20",
+		)
+	}
+
+	return uri
+}
+
+// externalLoopInState returns the external loop in state for a swap's state.
+// Pending swaps are reported as awaiting payment, because the swap's state
+// does not change when its htlc confirms.
+func externalLoopInState(state loopdb.SwapState) looprpc.ExternalLoopInState {
+	switch state.Type() {
+	case loopdb.StateTypeSuccess:
+		return looprpc.ExternalLoopInState_EXTERNAL_COMPLETE
+
+	case loopdb.StateTypeFail:
+		return looprpc.ExternalLoopInState_EXTERNAL_FAILED
+
+	default:
+		return awaitingPayment
+	}
+}
+
+// ExternalLoopIn initiates a loop in that is paid by an external wallet and
+// streams its progress until the swap completes or fails.
+func (s *swapClientServer) ExternalLoopIn(in *looprpc.ExternalLoopInRequest,
+	server looprpc.SwapClient_ExternalLoopInServer) error {
+
+	log.Infof("External loop in request received")
+
+	ctx := server.Context()
+
+	tenant, err := s.tenants.tenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if in.Amt <= 0 {
+		return status.Error(
+			codes.InvalidArgument, "amount must be positive",
+		)
+	}
+	amount := btcutil.Amount(in.Amt)
+
+	if err := labels.Validate(in.Label); err != nil {
+		return err
+	}
+
+	if s.requiresApproval(amount) {
+		return errExternalApproval
+	}
+
+	var lastHop *route.Vertex
+	if len(in.LastHop) != 0 {
+		lastHopVertex, err := route.NewVertexFromBytes(in.LastHop)
+		if err != nil {
+			return err
+		}
+
+		lastHop = &lastHopVertex
+	}
+
+	htlcConfTarget, err := validateLoopInRequest(0, true)
+	if err != nil {
+		return err
+	}
+
+	quote, err := s.impl.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
+		Amount:         amount,
+		HtlcConfTarget: htlcConfTarget,
+		ExternalHtlc:   true,
+		LastHop:        lastHop,
+	})
+	if err != nil {
+		return err
+	}
+
+	maxSwapFee := btcutil.Amount(in.MaxSwapFee)
+	if maxSwapFee == 0 {
+		maxSwapFee = quote.SwapFee
+	}
+
+	if quote.SwapFee > maxSwapFee {
+		return status.Errorf(codes.InvalidArgument, "quoted swap fee "+
+			"%v exceeds maximum swap fee %v", quote.SwapFee,
+			maxSwapFee)
+	}
+
+	// Lookup our current height, so that we only look for payments to
+	// the htlc from this point onwards.
+	info, err := s.lnd.Client.GetInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe to swap updates before we initiate the swap, so that we
+	// do not miss any of its updates.
+	updates := s.newSubscriber("external_loop_in")
+
+	s.swapsLock.Lock()
+	id := s.nextSubscriberID
+	s.nextSubscriberID++
+	s.subscribers[id] = updates
+	s.swapsLock.Unlock()
+
+	defer func() {
+		updates.stop()
+
+		s.swapsLock.Lock()
+		delete(s.subscribers, id)
+		s.swapsLock.Unlock()
+	}()
+
+	swapInfo, err := s.impl.LoopIn(ctx, &loop.LoopInRequest{
+		Amount:         amount,
+		MaxSwapFee:     maxSwapFee,
+		MaxMinerFee:    quote.MinerFee,
+		HtlcConfTarget: htlcConfTarget,
+		ExternalHtlc:   true,
+		LastHop:        lastHop,
+		Label:          in.Label,
+		Initiator:      in.Initiator,
+		Tenant:         tenant,
+	})
+	if err != nil {
+		log.Errorf("External loop in: %v", err)
+		return volumeLimitError(err)
+	}
+
+	htlcAddress := swapInfo.HtlcAddressP2WSH
+	if in.NestedSegwit {
+		htlcAddress = swapInfo.HtlcAddressNP2WSH
+	}
+
+	update := &looprpc.ExternalLoopInUpdate{
+		State:       awaitingPayment,
+		IdBytes:     swapInfo.SwapHash[:],
+		HtlcAddress: htlcAddress.String(),
+		Amt:         int64(amount),
+		SwapFeeSat:  int64(quote.SwapFee),
+		PaymentUri:  BIP21URI(htlcAddress.String(), amount, in.Label),
+	}
+	if err := server.Send(update); err != nil {
+		return err
+	}
+
+	pkScript, err := txscript.PayToAddrScript(htlcAddress)
+	if err != nil {
+		return err
+	}
+
+	confChan, confErr, err := s.lnd.ChainNotifier.RegisterConfirmationsNtfn(
+		ctx, nil, pkScript, 1, int32(info.BlockHeight),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.trackExternalLoopIn(
+		server, update, swapInfo.SwapHash, updates, confChan, confErr,
+	)
+}
+
+// trackExternalLoopIn streams the progress of an external loop in until it
+// completes or fails.
+func (s *swapClientServer) trackExternalLoopIn(
+	server looprpc.SwapClient_ExternalLoopInServer,
+	update *looprpc.ExternalLoopInUpdate, hash lntypes.Hash,
+	updates *subscriberQueue,
+	confChan <-chan *chainntnfs.TxConfirmation,
+	confErr <-chan error) error {
+
+	// handleUpdate reports an update of our swap to the client, and
+	// returns whether the swap is done.
+	handleUpdate := func(info loop.SwapInfo) (bool, error) {
+		if info.SwapHash != hash {
+			return false, nil
+		}
+
+		state := externalLoopInState(info.State)
+		if state == awaitingPayment {
+			// We report the htlc tx from the swap if we have not
+			// seen it confirm ourselves.
+			reported := update.HtlcTxid != ""
+			if info.HtlcTxHash == nil || reported {
+				return false, nil
+			}
+
+			confChan, confErr = nil, nil
+
+			update.State = htlcConfirmed
+			update.HtlcTxid = info.HtlcTxHash.String()
+
+			return false, server.Send(update)
+		}
+
+		rpcSwap, err := s.marshallSwap(&info)
+		if err != nil {
+			return false, err
+		}
+
+		update.State = state
+		update.Swap = rpcSwap
+
+		return true, server.Send(update)
+	}
+
+	for {
+		select {
+		case conf := <-confChan:
+			confChan, confErr = nil, nil
+
+			update.State = htlcConfirmed
+			update.HtlcTxid = conf.Tx.TxHash().String()
+
+			if err := server.Send(update); err != nil {
+				return err
+			}
+
+		// If our confirmation subscription fails, for example
+		// because lnd restarted, we rely on the swap, which reports
+		// its htlc tx once it has resubscribed and seen it confirm.
+		case err := <-confErr:
+			log.Warnf("Htlc confirmation subscription for swap %v "+
+				"failed: %v", hash, err)
+
+			confChan, confErr = nil, nil
+
+		case <-updates.updates():
+			for _, item := range updates.drain() {
+				done, err := handleUpdate(item.(loop.SwapInfo))
+				if err != nil || done {
+					return err
+				}
+			}
+
+		// The client cancels the subscription.
+		case <-server.Context().Done():
+			return nil
+
+		// The server is shutting down.
+		case <-s.mainCtx.Done():
+			return fmt.Errorf("server is shutting down")
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBIP21URI tests creation of the payment uris of external loop ins.
+func TestBIP21URI(t *testing.T) {
+	addr := "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+
+	tests := []struct {
+		name   string
+		amount btcutil.Amount
+		label  string
+		uri    string
+	}{
+		{
+			name:   "no label",
+			amount: 250000,
+			uri: "bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv" +
+				"8f3t4?amount=0.0025",
+		},
+		{
+			name:   "whole bitcoin",
+			amount: btcutil.SatoshiPerBitcoin,
+			uri: "bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv" +
+				"8f3t4?amount=1",
+		},
+		{
+			name:   "escaped label",
+			amount: 1,
+			label:  "channel & rebalance",
+			uri: "bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv" +
+				"8f3t4?amount=0.00000001&label=channel%20%26" +
+				"%20rebalance",
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			uri := BIP21URI(addr, testCase.amount, testCase.label)
+			require.Equal(t, testCase.uri, uri)
+		})
+	}
+}
+
+// TestExternalLoopInState tests mapping of swap states to the states that we
+// report for external loop ins.
+func TestExternalLoopInState(t *testing.T) {
+	require.Equal(
+		t, awaitingPayment,
+		externalLoopInState(loopdb.StateHtlcPublished),
+	)
+	require.Equal(
+		t, looprpc.ExternalLoopInState_EXTERNAL_COMPLETE,
+		externalLoopInState(loopdb.StateSuccess),
+	)
+	require.Equal(
+		t, looprpc.ExternalLoopInState_EXTERNAL_FAILED,
+		externalLoopInState(loopdb.StateFailIncorrectHtlcAmt),
+	)
+}
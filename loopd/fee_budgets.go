@@ -0,0 +1,148 @@
+package loopd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// unmarshallBudgetNamespace converts a budget namespace received over rpc to
+// the namespace that we store.
+func unmarshallBudgetNamespace(
+	namespace looprpc.BudgetNamespace) (loopdb.BudgetNamespace, error) {
+
+	switch namespace {
+	case looprpc.BudgetNamespace_BUDGET_NAMESPACE_TENANT:
+		return loopdb.BudgetNamespaceTenant, nil
+
+	case looprpc.BudgetNamespace_BUDGET_NAMESPACE_LABEL:
+		return loopdb.BudgetNamespaceLabel, nil
+
+	default:
+		return 0, fmt.Errorf("unknown budget namespace: %v", namespace)
+	}
+}
+
+// marshallBudgetNamespace converts a stored budget namespace to its rpc
+// representation.
+func marshallBudgetNamespace(
+	namespace loopdb.BudgetNamespace) looprpc.BudgetNamespace {
+
+	if namespace == loopdb.BudgetNamespaceLabel {
+		return looprpc.BudgetNamespace_BUDGET_NAMESPACE_LABEL
+	}
+
+	return looprpc.BudgetNamespace_BUDGET_NAMESPACE_TENANT
+}
+
+// unmarshallFeeBudget validates a fee budget received over rpc and converts
+// it to the budget that we store.
+func unmarshallFeeBudget(budget *looprpc.FeeBudget) (*loopdb.FeeBudget,
+	error) {
+
+	if budget == nil {
+		return nil, errors.New("budget required")
+	}
+
+	switch {
+	case budget.Name == "":
+		return nil, errors.New("budget name required")
+
+	case len(budget.Name) > loopdb.MaxFeeBudgetNameLength:
+		return nil, fmt.Errorf("budget name exceeds maximum length "+
+			"of %v", loopdb.MaxFeeBudgetNameLength)
+
+	case budget.BudgetSat < 0:
+		return nil, errors.New("budget may not be negative")
+	}
+
+	namespace, err := unmarshallBudgetNamespace(budget.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loopdb.FeeBudget{
+		Namespace: namespace,
+		Name:      budget.Name,
+		Budget:    btcutil.Amount(budget.BudgetSat),
+		Period:    time.Duration(budget.PeriodSec) * time.Second,
+	}, nil
+}
+
+// marshallFeeBudget converts a stored fee budget to its rpc representation.
+func marshallFeeBudget(budget *loopdb.FeeBudget) *looprpc.FeeBudget {
+	return &looprpc.FeeBudget{
+		Namespace: marshallBudgetNamespace(budget.Namespace),
+		Name:      budget.Name,
+		BudgetSat: int64(budget.Budget),
+		PeriodSec: uint64(budget.Period / time.Second),
+	}
+}
+
+// aggregateCosts aggregates the amounts and costs of the swaps provided that
+// were initiated within the time range provided by their tenant or label. A
+// zero start or end time leaves the range open on that side.
+func aggregateCosts(swaps map[lntypes.Hash]loop.SwapInfo,
+	groupBy loopdb.BudgetNamespace, start,
+	end time.Time) []*looprpc.NamespaceCost {
+
+	costs := make(map[string]*looprpc.NamespaceCost)
+	for _, swp := range swaps {
+		if !start.IsZero() && swp.InitiationTime.Before(start) {
+			continue
+		}
+
+		if !end.IsZero() && swp.InitiationTime.After(end) {
+			continue
+		}
+
+		name := swp.Label
+		if groupBy == loopdb.BudgetNamespaceTenant {
+			name = swp.Tenant
+		}
+
+		cost, ok := costs[name]
+		if !ok {
+			cost = &looprpc.NamespaceCost{
+				Name: name,
+			}
+			costs[name] = cost
+		}
+
+		switch swp.State.Type() {
+		case loopdb.StateTypePending:
+			cost.SwapsPending++
+			continue
+
+		case loopdb.StateTypeSuccess:
+			cost.SwapsSucceeded++
+			cost.AmountSat += int64(swp.AmountRequested)
+
+		default:
+			cost.SwapsFailed++
+		}
+
+		cost.CostServer += int64(swp.Cost.Server)
+		cost.CostOnchain += int64(swp.Cost.Onchain)
+		cost.CostOffchain += int64(swp.Cost.Offchain)
+		cost.TotalCost += int64(swp.Cost.Total())
+	}
+
+	statement := make([]*looprpc.NamespaceCost, 0, len(costs))
+	for _, cost := range costs {
+		statement = append(statement, cost)
+	}
+
+	sort.Slice(statement, func(i, j int) bool {
+		return statement[i].Name < statement[j].Name
+	})
+
+	return statement
+}
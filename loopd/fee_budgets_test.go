@@ -0,0 +1,120 @@
+package loopd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeBudgetRoundTrip tests validation and conversion of fee budgets.
+func TestFeeBudgetRoundTrip(t *testing.T) {
+	rpcBudget := &looprpc.FeeBudget{
+		Namespace: looprpc.BudgetNamespace_BUDGET_NAMESPACE_LABEL,
+		Name:      "treasury",
+		BudgetSat: 5000,
+		PeriodSec: 86400,
+	}
+
+	budget, err := unmarshallFeeBudget(rpcBudget)
+	require.NoError(t, err)
+	require.Equal(t, &loopdb.FeeBudget{
+		Namespace: loopdb.BudgetNamespaceLabel,
+		Name:      "treasury",
+		Budget:    5000,
+		Period:    time.Hour * 24,
+	}, budget)
+	require.Equal(t, rpcBudget, marshallFeeBudget(budget))
+
+	_, err = unmarshallFeeBudget(&looprpc.FeeBudget{BudgetSat: 1})
+	require.Error(t, err)
+
+	_, err = unmarshallFeeBudget(&looprpc.FeeBudget{
+		Name:      "treasury",
+		BudgetSat: -1,
+	})
+	require.Error(t, err)
+}
+
+// TestAggregateCosts tests aggregation of swap costs by label and tenant.
+func TestAggregateCosts(t *testing.T) {
+	now := time.Unix(1000000, 0)
+
+	swap := func(tenant, label string, state loopdb.SwapState,
+		initiated time.Time) loop.SwapInfo {
+
+		return loop.SwapInfo{
+			SwapContract: loopdb.SwapContract{
+				AmountRequested: 1000,
+				InitiationTime:  initiated,
+				Label:           label,
+				Tenant:          tenant,
+			},
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+				Cost: loopdb.SwapCost{
+					Server:   10,
+					Onchain:  20,
+					Offchain: 30,
+				},
+			},
+		}
+	}
+
+	swaps := map[lntypes.Hash]loop.SwapInfo{
+		{1}: swap("alice", "treasury", loopdb.StateSuccess, now),
+		{2}: swap("bob", "treasury", loopdb.StateFailTimeout, now),
+		{3}: swap("alice", "", loopdb.StateInitiated, now),
+		{4}: swap(
+			"alice", "treasury", loopdb.StateSuccess,
+			now.Add(-time.Hour),
+		),
+	}
+
+	require.Equal(t, []*looprpc.NamespaceCost{
+		{
+			Name:         "",
+			SwapsPending: 1,
+		},
+		{
+			Name:           "treasury",
+			SwapsSucceeded: 1,
+			SwapsFailed:    1,
+			AmountSat:      1000,
+			CostServer:     20,
+			CostOnchain:    40,
+			CostOffchain:   60,
+			TotalCost:      120,
+		},
+	}, aggregateCosts(
+		swaps, loopdb.BudgetNamespaceLabel, now.Add(-time.Minute),
+		time.Time{},
+	))
+
+	require.Equal(t, []*looprpc.NamespaceCost{
+		{
+			Name:           "alice",
+			SwapsSucceeded: 2,
+			SwapsPending:   1,
+			AmountSat:      2000,
+			CostServer:     20,
+			CostOnchain:    40,
+			CostOffchain:   60,
+			TotalCost:      120,
+		},
+		{
+			Name:         "bob",
+			SwapsFailed:  1,
+			CostServer:   10,
+			CostOnchain:  20,
+			CostOffchain: 30,
+			TotalCost:    60,
+		},
+	}, aggregateCosts(
+		swaps, loopdb.BudgetNamespaceTenant, time.Time{}, time.Time{},
+	))
+}
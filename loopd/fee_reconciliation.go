@@ -0,0 +1,124 @@
+package loopd
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/metrics"
+)
+
+const (
+	// feeReconciliationMeasurement is the measurement that we record the
+	// quoted and realized fees of each component of a completed swap's
+	// cost under.
+	feeReconciliationMeasurement = "loop_fee_reconciliation"
+
+	// feeComponentServer and feeComponentOnchain are the component tags
+	// that we reconcile the swap fee and miner fee under.
+	feeComponentServer  = "server"
+	feeComponentOnchain = "onchain"
+
+	// defaultFeeOverpaymentPercent is the default percentage by which a
+	// fee component may exceed its quote before we report it.
+	defaultFeeOverpaymentPercent = 20
+)
+
+// feeReconciliation compares the fee that was quoted for a single component
+// of a swap's cost to the fee that the swap realized.
+type feeReconciliation struct {
+	// Component is the part of the swap's cost that is reconciled.
+	Component string
+
+	// Quoted is the fee that was quoted when the swap was initiated.
+	Quoted btcutil.Amount
+
+	// Realized is the fee that the swap paid.
+	Realized btcutil.Amount
+}
+
+// overpaidPercent returns the percentage by which the realized fee exceeded
+// the quote, or a negative value if less than the quote was paid.
+func (f *feeReconciliation) overpaidPercent() float64 {
+	return float64(f.Realized-f.Quoted) / float64(f.Quoted) * 100
+}
+
+// reconcileFees compares the quoted and realized fees of a successful swap
+// per component. Components that were not quoted are not reconciled, because
+// we have nothing to compare them to. Swaps that were created before we
+// stored quotes, or that did not succeed, return no reconciliations.
+func reconcileFees(info *loop.SwapInfo) []*feeReconciliation {
+	if info.Quote == nil || info.State != loopdb.StateSuccess {
+		return nil
+	}
+
+	var fees []*feeReconciliation
+
+	if info.Quote.SwapFee > 0 {
+		fees = append(fees, &feeReconciliation{
+			Component: feeComponentServer,
+			Quoted:    info.Quote.SwapFee,
+			Realized:  info.Cost.Server,
+		})
+	}
+
+	if info.Quote.MinerFee > 0 {
+		fees = append(fees, &feeReconciliation{
+			Component: feeComponentOnchain,
+			Quoted:    info.Quote.MinerFee,
+			Realized:  info.Cost.Onchain,
+		})
+	}
+
+	return fees
+}
+
+// newFeeReconciliationPoint creates a metrics point for a single fee component
+// of a completed swap.
+func newFeeReconciliationPoint(info *loop.SwapInfo, fee *feeReconciliation,
+	overpaid bool) *metrics.Point {
+
+	return &metrics.Point{
+		Measurement: feeReconciliationMeasurement,
+		Tags: map[string]string{
+			"direction": swapDirection(info.SwapType),
+			"initiator": swapInitiator(info.SwapType, info.Label),
+			"component": fee.Component,
+		},
+		Fields: map[string]interface{}{
+			"quoted_fee_sat":   int64(fee.Quoted),
+			"realized_fee_sat": int64(fee.Realized),
+			"overpaid_percent": fee.overpaidPercent(),
+			"overpaid":         overpaid,
+		},
+		Time: info.LastUpdate,
+	}
+}
+
+// reconcileSwapFees reports the quoted and realized fees of a swap once it has
+// succeeded. A warning is logged for every component whose realized fee
+// exceeds its quote by more than our overpayment percentage, so that
+// systematic underquoting or bad fee estimates are noticed.
+func (s *swapClientServer) reconcileSwapFees(info *loop.SwapInfo) {
+	for _, fee := range reconcileFees(info) {
+		percent := fee.overpaidPercent()
+		overpaid := s.feeOverpaymentPercent != 0 &&
+			percent > s.feeOverpaymentPercent
+
+		if overpaid {
+			log.Warnf("Swap %v overpaid %v fee: quoted %v, "+
+				"realized %v (%.1f%% over quote)",
+				info.SwapHash, fee.Component, fee.Quoted,
+				fee.Realized, percent)
+		} else {
+			log.Infof("Swap %v %v fee: quoted %v, realized %v",
+				info.SwapHash, fee.Component, fee.Quoted,
+				fee.Realized)
+		}
+
+		if s.metrics != nil {
+			s.metrics.Record(
+				newFeeReconciliationPoint(info, fee, overpaid),
+			)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReconcileFees tests comparison of the quoted and realized fees of
+// completed swaps.
+func TestReconcileFees(t *testing.T) {
+	quote := &loopdb.SwapQuote{
+		SwapFee:  100,
+		MinerFee: 50,
+	}
+
+	tests := []struct {
+		name     string
+		state    loopdb.SwapState
+		quote    *loopdb.SwapQuote
+		expected []*feeReconciliation
+	}{
+		{
+			name:  "no quote",
+			state: loopdb.StateSuccess,
+		},
+		{
+			name:  "swap failed",
+			state: loopdb.StateFailTimeout,
+			quote: quote,
+		},
+		{
+			name:  "all components quoted",
+			state: loopdb.StateSuccess,
+			quote: quote,
+			expected: []*feeReconciliation{
+				{
+					Component: feeComponentServer,
+					Quoted:    100,
+					Realized:  110,
+				},
+				{
+					Component: feeComponentOnchain,
+					Quoted:    50,
+					Realized:  100,
+				},
+			},
+		},
+		{
+			name:  "miner fee not quoted",
+			state: loopdb.StateSuccess,
+			quote: &loopdb.SwapQuote{
+				SwapFee: 100,
+			},
+			expected: []*feeReconciliation{
+				{
+					Component: feeComponentServer,
+					Quoted:    100,
+					Realized:  110,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			info := &loop.SwapInfo{
+				SwapType: swap.TypeOut,
+				SwapContract: loopdb.SwapContract{
+					Quote: testCase.quote,
+				},
+				SwapStateData: loopdb.SwapStateData{
+					State: testCase.state,
+					Cost: loopdb.SwapCost{
+						Server:  110,
+						Onchain: 100,
+					},
+				},
+			}
+
+			fees := reconcileFees(info)
+			require.Equal(t, testCase.expected, fees)
+		})
+	}
+}
+
+// TestOverpaidPercent tests calculation of the percentage by which a fee
+// exceeded its quote.
+func TestOverpaidPercent(t *testing.T) {
+	fee := &feeReconciliation{
+		Quoted:   100,
+		Realized: 150,
+	}
+	require.Equal(t, float64(50), fee.overpaidPercent())
+
+	fee.Realized = 80
+	require.Equal(t, float64(-20), fee.overpaidPercent())
+}
@@ -0,0 +1,249 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+)
+
+const (
+	// fillInitiator is the initiator of the swaps that fill channels.
+	fillInitiator = "fill"
+
+	// fillPollInterval is the interval at which we check whether a channel
+	// that we fill has opened.
+	fillPollInterval = time.Second * 30
+)
+
+// fillAmount returns the amount and type of the next swap that brings the
+// local balance of a channel to the target provided. Loop outs leave the most
+// that they may spend on fees at the portion of their amount provided in the
+// channel, along with the balance that lnd holds for our channel reserve and
+// commitment fees, and loop ins leave the peer's reserve of 1% of the
+// channel's capacity. A zero amount is returned if the channel is at its
+// target.
+func fillAmount(channel *lndclient.ChannelInfo, target btcutil.Amount,
+	feePPM uint64, maxOut, maxIn btcutil.Amount) (btcutil.Amount,
+	swap.Type) {
+
+	if channel.LocalBalance > target {
+		reserve := target
+		outgoingReserve := liquidity.OutgoingReserve(*channel)
+		if reserve < outgoingReserve {
+			reserve = outgoingReserve
+		}
+
+		amount := drainAmount(
+			channel.LocalBalance, reserve, feePPM, maxOut,
+		)
+
+		return amount, swap.TypeOut
+	}
+
+	amount := target - channel.LocalBalance
+
+	peerReserve := channel.Capacity / defaultDrainReserveDivisor
+	available := channel.RemoteBalance - peerReserve
+	if available < 0 {
+		available = 0
+	}
+
+	if amount > available {
+		amount = available
+	}
+
+	if amount > maxIn {
+		amount = maxIn
+	}
+
+	return amount, swap.TypeIn
+}
+
+// fillSwap dispatches a swap that brings the channel provided towards its
+// target local balance and blocks until it completes. A nil swap is returned
+// if the channel is as close to its target as swaps can bring it.
+func (s *swapClientServer) fillSwap(ctx context.Context,
+	channel *lndclient.ChannelInfo, localPercent uint32,
+	params *channelSwapParams) (*loop.SwapInfo, error) {
+
+	outTerms, err := s.impl.LoopOutTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inTerms, err := s.impl.LoopInTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target := channel.Capacity * btcutil.Amount(localPercent) / 100
+
+	amount, swapType := fillAmount(
+		channel, target, params.feePPM, outTerms.MaxSwapAmount,
+		inTerms.MaxSwapAmount,
+	)
+	amount = s.capToApproval(amount)
+
+	if swapType == swap.TypeOut {
+		if amount < outTerms.MinSwapAmount {
+			return nil, nil
+		}
+
+		return s.channelLoopOut(ctx, channel.ChannelID, amount, params)
+	}
+
+	if amount < inTerms.MinSwapAmount {
+		return nil, nil
+	}
+
+	return s.channelLoopIn(ctx, channel, amount, params)
+}
+
+// waitForChannel returns the channel that a fill request is for. If the
+// request provides a channel point, we wait until the channel is open and
+// active, sending an update once if we have to wait.
+func (s *swapClientServer) waitForChannel(ctx context.Context,
+	in *looprpc.FillChannelRequest,
+	server looprpc.SwapClient_FillChannelServer) (*lndclient.ChannelInfo,
+	error) {
+
+	if in.Channel != 0 {
+		return s.findChannel(ctx, in.Channel)
+	}
+
+	outpoint, err := parseOutpoint(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(fillPollInterval)
+	defer ticker.Stop()
+
+	var waiting bool
+	for {
+		channels, err := s.lnd.Client.ListChannels(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range channels {
+			if channel.ChannelPoint == outpoint.String() &&
+				channel.Active {
+
+				channel := channel
+				return &channel, nil
+			}
+		}
+
+		if !waiting {
+			log.Infof("Waiting for channel %v to open", outpoint)
+
+			err := server.Send(&looprpc.FillUpdate{
+				State: looprpc.FillState_FILL_WAITING,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			waiting = true
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// FillChannel brings the balance of a channel to a target split between its
+// local and remote sides, one swap at a time.
+func (s *swapClientServer) FillChannel(in *looprpc.FillChannelRequest,
+	server looprpc.SwapClient_FillChannelServer) error {
+
+	log.Infof("Fill channel request received")
+
+	ctx := server.Context()
+
+	switch {
+	case in.Channel == 0 && in.ChannelPoint == "":
+		return errors.New("channel or channel point required")
+
+	case in.Channel != 0 && in.ChannelPoint != "":
+		return errors.New("channel and channel point are mutually " +
+			"exclusive")
+
+	case in.LocalPercent > 100:
+		return errors.New("local percent may not exceed 100")
+	}
+
+	params, err := s.newChannelSwapParams(
+		ctx, in.Dest, in.SweepConfTarget, in.HtlcConfTarget, in.FeePpm,
+		in.Label, fillInitiator,
+	)
+	if err != nil {
+		return err
+	}
+
+	channel, err := s.waitForChannel(ctx, in, server)
+	if err != nil {
+		return err
+	}
+
+	update := func(state looprpc.FillState, channel *lndclient.ChannelInfo,
+		status *looprpc.SwapStatus, completed uint32) error {
+
+		return server.Send(&looprpc.FillUpdate{
+			State:            state,
+			Channel:          channel.ChannelID,
+			LocalBalanceSat:  uint64(channel.LocalBalance),
+			RemoteBalanceSat: uint64(channel.RemoteBalance),
+			Swap:             status,
+			SwapsCompleted:   completed,
+		})
+	}
+
+	var completed uint32
+	for in.MaxSwaps == 0 || completed < in.MaxSwaps {
+		info, err := s.fillSwap(ctx, channel, in.LocalPercent, params)
+		if err != nil {
+			return err
+		}
+
+		if info == nil {
+			break
+		}
+
+		completed++
+		log.Infof("Fill swap %v of channel %v completed", completed,
+			channel.ChannelID)
+
+		status, err := s.marshallSwap(info)
+		if err != nil {
+			return err
+		}
+
+		channel, err = s.findChannel(ctx, channel.ChannelID)
+		if err != nil {
+			return err
+		}
+
+		err = update(
+			looprpc.FillState_FILL_SWAPPING, channel, status,
+			completed,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return update(looprpc.FillState_FILL_COMPLETE, channel, nil, completed)
+}
@@ -0,0 +1,96 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFillAmount tests calculation of the amounts and types of the swaps that
+// bring channels to their target balance.
+func TestFillAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		local     btcutil.Amount
+		remote    btcutil.Amount
+		initiator bool
+		target    btcutil.Amount
+		amount    btcutil.Amount
+		swapType  swap.Type
+	}{
+		{
+			name:     "new outbound channel",
+			local:    1000000,
+			target:   388000,
+			amount:   600000,
+			swapType: swap.TypeOut,
+		},
+		{
+			name:     "loop out limited by channel reserve",
+			local:    100000,
+			target:   0,
+			amount:   88235,
+			swapType: swap.TypeOut,
+		},
+		{
+			name:      "loop out limited by commitment fee buffer",
+			local:     100000,
+			initiator: true,
+			target:    0,
+			amount:    84637,
+			swapType:  swap.TypeOut,
+		},
+		{
+			name:     "new inbound channel",
+			remote:   1000000,
+			target:   500000,
+			amount:   500000,
+			swapType: swap.TypeIn,
+		},
+		{
+			name:     "loop in limited by peer reserve",
+			local:    500000,
+			remote:   20000,
+			target:   600000,
+			amount:   10000,
+			swapType: swap.TypeIn,
+		},
+		{
+			name:     "loop in limited by maximum amount",
+			remote:   1000000,
+			target:   900000,
+			amount:   800000,
+			swapType: swap.TypeIn,
+		},
+		{
+			name:     "at target",
+			local:    500000,
+			remote:   500000,
+			target:   500000,
+			amount:   0,
+			swapType: swap.TypeIn,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			channel := &lndclient.ChannelInfo{
+				Capacity:      1000000,
+				LocalBalance:  testCase.local,
+				RemoteBalance: testCase.remote,
+				Initiator:     testCase.initiator,
+			}
+
+			amount, swapType := fillAmount(
+				channel, testCase.target, 20000, 800000, 800000,
+			)
+			require.Equal(t, testCase.amount, amount)
+			require.Equal(t, testCase.swapType, swapType)
+		})
+	}
+}
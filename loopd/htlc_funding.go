@@ -0,0 +1,206 @@
+package loopd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"google.golang.org/grpc"
+)
+
+// lndHtlcFunder funds loop in htlcs with specific coins from lnd's wallet. The
+// version of lndclient that we use does not expose lnd's psbt funding, so we
+// use a separate connection to lnd's wallet kit rpc.
+type lndHtlcFunder struct {
+	conn   *grpc.ClientConn
+	wallet walletrpc.WalletKitClient
+}
+
+// newLndHtlcFunder connects to the wallet kit rpc of the lnd instance in the
+// config provided. The macaroon used must hold the onchain:write permission.
+func newLndHtlcFunder(cfg *lndConfig, network string) (*lndHtlcFunder,
+	error) {
+
+	conn, err := lndclient.NewBasicConn(
+		cfg.Host, cfg.TLSPath, filepath.Dir(cfg.MacaroonPath), network,
+		lndclient.MacFilename(filepath.Base(cfg.MacaroonPath)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lndHtlcFunder{
+		conn:   conn,
+		wallet: walletrpc.NewWalletKitClient(conn),
+	}, nil
+}
+
+// FundHtlc funds a transaction paying to our htlc with a psbt that only
+// spends coins from the account and outpoints provided, then signs and
+// publishes it.
+//
+// NOTE: Part of the loop.HtlcFunder interface.
+func (l *lndHtlcFunder) FundHtlc(ctx context.Context, addr btcutil.Address,
+	amount btcutil.Amount, feeRate chainfee.SatPerKWeight,
+	funding *loopdb.HtlcFunding, label string) (*wire.MsgTx, error) {
+
+	inputs := make([]*lnrpc.OutPoint, len(funding.Outpoints))
+	for i, outpoint := range funding.Outpoints {
+		inputs[i] = &lnrpc.OutPoint{
+			TxidBytes:   outpoint.Hash[:],
+			OutputIndex: outpoint.Index,
+		}
+	}
+
+	fundResp, err := l.wallet.FundPsbt(ctx, &walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_Raw{
+			Raw: &walletrpc.TxTemplate{
+				Inputs: inputs,
+				Outputs: map[string]uint64{
+					addr.String(): uint64(amount),
+				},
+			},
+		},
+		Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: uint64(feeRate.FeePerKVByte() / 1000),
+		},
+		Account: funding.Account,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// If we fail to publish our transaction, we release the coins that lnd
+	// locked for it so that they can be used again.
+	tx, err := l.finalizeAndPublish(ctx, fundResp.FundedPsbt, funding, label)
+	if err != nil {
+		for _, lock := range fundResp.LockedUtxos {
+			_, releaseErr := l.wallet.ReleaseOutput(
+				ctx, &walletrpc.ReleaseOutputRequest{
+					Id:       lock.Id,
+					Outpoint: lock.Outpoint,
+				},
+			)
+			if releaseErr != nil {
+				log.Errorf("Could not release htlc funding "+
+					"output: %v", releaseErr)
+			}
+		}
+
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// finalizeAndPublish signs a funded psbt with the account in the funding
+// restrictions provided and publishes the resulting transaction.
+func (l *lndHtlcFunder) finalizeAndPublish(ctx context.Context,
+	packet []byte, funding *loopdb.HtlcFunding, label string) (*wire.MsgTx,
+	error) {
+
+	finalizeResp, err := l.wallet.FinalizePsbt(
+		ctx, &walletrpc.FinalizePsbtRequest{
+			FundedPsbt: packet,
+			Account:    funding.Account,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &wire.MsgTx{}
+	err = tx.Deserialize(bytes.NewReader(finalizeResp.RawFinalTx))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.wallet.PublishTransaction(ctx, &walletrpc.Transaction{
+		TxHex: finalizeResp.RawFinalTx,
+		Label: label,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.PublishError != "" {
+		return nil, fmt.Errorf("publish: %v", resp.PublishError)
+	}
+
+	return tx, nil
+}
+
+// close closes our connection to lnd.
+func (l *lndHtlcFunder) close() error {
+	return l.conn.Close()
+}
+
+// parseOutpoint parses an outpoint in the format txid:index.
+func parseOutpoint(s string) (*wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("outpoint %v must be in the format "+
+			"txid:index", s)
+	}
+
+	// We require a full txid, because shorter hex strings are
+	// accepted by chainhash and would be zero padded.
+	if len(parts[0]) != chainhash.MaxHashStringSize {
+		return nil, fmt.Errorf("invalid txid %v", parts[0])
+	}
+
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid txid %v: %v", parts[0], err)
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index %v: %v",
+			parts[1], err)
+	}
+
+	return wire.NewOutPoint(hash, uint32(index)), nil
+}
+
+// unmarshallHtlcFunding returns the htlc funding restrictions set by the
+// account and outpoints provided, or nil if neither is set.
+func unmarshallHtlcFunding(account string,
+	outpoints []string) (*loopdb.HtlcFunding, error) {
+
+	if account == "" && len(outpoints) == 0 {
+		return nil, nil
+	}
+
+	funding := &loopdb.HtlcFunding{
+		Account: account,
+	}
+
+	seen := make(map[wire.OutPoint]bool, len(outpoints))
+	for _, s := range outpoints {
+		outpoint, err := parseOutpoint(s)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[*outpoint] {
+			return nil, fmt.Errorf("duplicate outpoint %v", s)
+		}
+		seen[*outpoint] = true
+
+		funding.Outpoints = append(funding.Outpoints, *outpoint)
+	}
+
+	return funding, nil
+}
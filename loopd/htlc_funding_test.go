@@ -0,0 +1,86 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnmarshallHtlcFunding tests parsing of the htlc funding restrictions of
+// a loop in request.
+func TestUnmarshallHtlcFunding(t *testing.T) {
+	txid := chainhash.Hash{1}
+
+	tests := []struct {
+		name      string
+		account   string
+		outpoints []string
+		expected  *loopdb.HtlcFunding
+		err       bool
+	}{
+		{
+			name: "no restrictions",
+		},
+		{
+			name:    "account only",
+			account: "savings",
+			expected: &loopdb.HtlcFunding{
+				Account: "savings",
+			},
+		},
+		{
+			name: "outpoints",
+			outpoints: []string{
+				txid.String() + ":0", txid.String() + ":1",
+			},
+			expected: &loopdb.HtlcFunding{
+				Outpoints: []wire.OutPoint{
+					{Hash: txid, Index: 0},
+					{Hash: txid, Index: 1},
+				},
+			},
+		},
+		{
+			name:      "no index",
+			outpoints: []string{txid.String()},
+			err:       true,
+		},
+		{
+			name:      "invalid txid",
+			outpoints: []string{"abc:1"},
+			err:       true,
+		},
+		{
+			name:      "invalid index",
+			outpoints: []string{txid.String() + ":-1"},
+			err:       true,
+		},
+		{
+			name: "duplicate outpoint",
+			outpoints: []string{
+				txid.String() + ":0", txid.String() + ":0",
+			},
+			err: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			funding, err := unmarshallHtlcFunding(
+				testCase.account, testCase.outpoints,
+			)
+			if testCase.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, funding)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package loopd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// importResult summarizes the swaps that an import found in another store.
+type importResult struct {
+	// imported holds the hashes of the swaps that were imported.
+	imported []lntypes.Hash
+
+	// duplicates holds the hashes of the swaps that we already store
+	// exactly as they are stored in the other store, which are skipped so
+	// that the same store can be imported more than once.
+	duplicates []lntypes.Hash
+
+	// pending holds the hashes of the swaps that are skipped because they
+	// have not completed yet. They are left to the daemon that created
+	// them, which is the only one that can complete them.
+	pending []lntypes.Hash
+}
+
+// importSwaps imports the swap history of the loopd data directory that the
+// import command was run with into our own store.
+func importSwaps(config *Config) error {
+	if config.Import.FromDataDir == "" {
+		return errors.New("--from-datadir required")
+	}
+
+	chainParams, err := ChainParams(config.Network)
+	if err != nil {
+		return err
+	}
+
+	// Our own data directory is namespaced by network once our config
+	// has been validated, so we do the same for the directory that we
+	// import from.
+	fromDir := filepath.Join(
+		lncfg.CleanAndExpandPath(config.Import.FromDataDir),
+		config.Network,
+	)
+	if fromDir == filepath.Clean(config.DataDir) {
+		return errors.New("cannot import swaps from our own data " +
+			"directory")
+	}
+
+	// We check that the other store exists, because opening it would
+	// otherwise create an empty one.
+	if !loopdb.StoreExists(fromDir) {
+		return fmt.Errorf("no swap database found in %v", fromDir)
+	}
+
+	from, err := loopdb.NewBoltSwapStore(fromDir, chainParams)
+	if err != nil {
+		return err
+	}
+	defer from.Close()
+
+	store, err := loopdb.NewBoltSwapStore(config.DataDir, chainParams)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	// The preimages of the swaps that we import are moved to our secret
+	// store, if we use one, just like those of the swaps that we create.
+	if config.SecretsDir != "" {
+		secrets, err := loopdb.NewBoltSecretStore(
+			config.SecretsDir, config.SecretsKeyFile,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := store.UseSecretStore(secrets); err != nil {
+			return err
+		}
+	}
+
+	result, err := mergeSwaps(store, from)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range result.pending {
+		fmt.Printf("Skipped pending swap: %v\n", hash)
+	}
+
+	fmt.Printf("Imported %v swaps from %v, skipped %v swaps that were "+
+		"already imported and %v pending swaps\n",
+		len(result.imported), fromDir, len(result.duplicates),
+		len(result.pending))
+
+	return nil
+}
+
+// mergeSwaps imports the completed swaps of one store into another. Swaps
+// that both stores hold are skipped if they are identical, but if any of them
+// differ we can't tell which copy is correct, so we fail without importing
+// anything.
+func mergeSwaps(store, from loopdb.SwapStore) (*importResult, error) {
+	ourOut, err := store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	ourIn, err := store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	fromOut, err := from.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	fromIn, err := from.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	existingOut := make(map[lntypes.Hash]*loopdb.LoopOut, len(ourOut))
+	for _, swap := range ourOut {
+		existingOut[swap.Hash] = swap
+	}
+
+	existingIn := make(map[lntypes.Hash]*loopdb.LoopIn, len(ourIn))
+	for _, swap := range ourIn {
+		existingIn[swap.Hash] = swap
+	}
+
+	var (
+		result    importResult
+		conflicts []lntypes.Hash
+		loopOuts  []*loopdb.LoopOut
+		loopIns   []*loopdb.LoopIn
+	)
+
+	// classify sorts a swap from the other store into the swaps that we
+	// import or skip, or the swaps that conflict with our own. It returns
+	// true if the swap should be imported.
+	classify := func(loop *loopdb.Loop, existing interface{},
+		found bool, swap interface{}) bool {
+
+		switch {
+		case loop.State().State.Type() == loopdb.StateTypePending:
+			result.pending = append(result.pending, loop.Hash)
+
+		case found && reflect.DeepEqual(existing, swap):
+			result.duplicates = append(result.duplicates, loop.Hash)
+
+		case found:
+			conflicts = append(conflicts, loop.Hash)
+
+		default:
+			result.imported = append(result.imported, loop.Hash)
+			return true
+		}
+
+		return false
+	}
+
+	for _, swap := range fromOut {
+		existing, found := existingOut[swap.Hash]
+		if classify(&swap.Loop, existing, found, swap) {
+			loopOuts = append(loopOuts, swap)
+		}
+	}
+
+	for _, swap := range fromIn {
+		existing, found := existingIn[swap.Hash]
+		if classify(&swap.Loop, existing, found, swap) {
+			loopIns = append(loopIns, swap)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("%v swaps differ from the swaps that "+
+			"we store with the same hash, nothing imported: %v",
+			len(conflicts), conflicts)
+	}
+
+	if err := store.ImportSwaps(loopOuts, loopIns); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
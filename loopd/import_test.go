@@ -0,0 +1,111 @@
+package loopd
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSwapStore creates a swap store in a temporary directory.
+func newTestSwapStore(t *testing.T) (loopdb.SwapStore, func()) {
+	dir, err := ioutil.TempDir("", "loopd-import")
+	require.NoError(t, err)
+
+	store, err := loopdb.NewBoltSwapStore(dir, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	return store, func() {
+		store.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// addTestLoopIn stores a loop in with the preimage provided, and moves it to
+// the states provided.
+func addTestLoopIn(t *testing.T, store loopdb.SwapStore,
+	preimage lntypes.Preimage, states ...loopdb.SwapState) lntypes.Hash {
+
+	hash := preimage.Hash()
+	err := store.CreateLoopIn(hash, &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			Preimage:        preimage,
+			AmountRequested: 100,
+			CltvExpiry:      144,
+			InitiationTime:  time.Unix(0, 1),
+		},
+	})
+	require.NoError(t, err)
+
+	for i, state := range states {
+		err := store.UpdateLoopIn(
+			hash, time.Unix(int64(i), 0), loopdb.SwapStateData{
+				State: state,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	return hash
+}
+
+// TestMergeSwaps tests importing the completed swaps of another store.
+func TestMergeSwaps(t *testing.T) {
+	store, cleanup := newTestSwapStore(t)
+	defer cleanup()
+
+	from, cleanup := newTestSwapStore(t)
+	defer cleanup()
+
+	// We already store the first swap exactly as the other store does.
+	duplicate := addTestLoopIn(
+		t, store, lntypes.Preimage{1}, loopdb.StateSuccess,
+	)
+	addTestLoopIn(t, from, lntypes.Preimage{1}, loopdb.StateSuccess)
+
+	completed := addTestLoopIn(
+		t, from, lntypes.Preimage{2}, loopdb.StateHtlcPublished,
+		loopdb.StateFailTimeout,
+	)
+	pending := addTestLoopIn(
+		t, from, lntypes.Preimage{3}, loopdb.StateHtlcPublished,
+	)
+
+	result, err := mergeSwaps(store, from)
+	require.NoError(t, err)
+	require.Equal(t, &importResult{
+		imported:   []lntypes.Hash{completed},
+		duplicates: []lntypes.Hash{duplicate},
+		pending:    []lntypes.Hash{pending},
+	}, result)
+
+	swaps, err := store.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Len(t, swaps, 2)
+
+	// Importing the same store again skips all of its swaps.
+	result, err = mergeSwaps(store, from)
+	require.NoError(t, err)
+	require.Empty(t, result.imported)
+
+	// If one of our swaps differs from the swap with the same hash in the
+	// other store, nothing is imported.
+	conflicting := addTestLoopIn(
+		t, store, lntypes.Preimage{4}, loopdb.StateSuccess,
+	)
+	addTestLoopIn(t, from, lntypes.Preimage{4}, loopdb.StateFailTimeout)
+	addTestLoopIn(t, from, lntypes.Preimage{5}, loopdb.StateSuccess)
+
+	_, err = mergeSwaps(store, from)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), conflicting.String())
+
+	swaps, err = store.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Len(t, swaps, 3)
+}
@@ -0,0 +1,146 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// unixScheme prefixes rpc addresses that are unix domain socket
+	// paths. Unix domain sockets are supported on windows from windows 10
+	// onwards.
+	unixScheme = "unix://"
+
+	// abstractScheme prefixes rpc addresses that are names in linux's
+	// abstract socket namespace, which don't create a file and are removed
+	// once they are closed.
+	abstractScheme = "unix-abstract://"
+
+	// localServerName is the name that we verify loopd's TLS certificate
+	// against when we connect to a local socket, which is always included
+	// in the certificates that loopd generates.
+	localServerName = "localhost"
+)
+
+var (
+	// errAbstractUnsupported is returned when an abstract socket is used
+	// on a platform other than linux.
+	errAbstractUnsupported = errors.New("abstract sockets are only " +
+		"supported on linux")
+
+	// errEmptySocket is returned when a socket address has no path or
+	// name.
+	errEmptySocket = errors.New("socket path or name required")
+)
+
+// ParseRPCAddress returns the network and address that the rpc address
+// provided is listened on and dialed with. Addresses prefixed with unix://
+// are unix domain socket paths, and addresses prefixed with unix-abstract://
+// are names in linux's abstract socket namespace. All other addresses are
+// tcp host:port addresses.
+func ParseRPCAddress(address string) (string, string, error) {
+	switch {
+	case strings.HasPrefix(address, abstractScheme):
+		name := strings.TrimPrefix(address, abstractScheme)
+		if name == "" {
+			return "", "", errEmptySocket
+		}
+
+		if runtime.GOOS != "linux" {
+			return "", "", errAbstractUnsupported
+		}
+
+		// Go places unix sockets whose name starts with @ in the
+		// abstract namespace.
+		return "unix", "@" + name, nil
+
+	case strings.HasPrefix(address, unixScheme):
+		path := strings.TrimPrefix(address, unixScheme)
+		if path == "" {
+			return "", "", errEmptySocket
+		}
+
+		return "unix", path, nil
+
+	default:
+		return "tcp", address, nil
+	}
+}
+
+// listenRPC listens on the rpc address provided. If the address is a unix
+// domain socket path and a socket file was left behind at the path by a
+// previous run, the stale file is removed first.
+func listenRPC(address string) (net.Listener, error) {
+	network, addr, err := ParseRPCAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if network == "unix" && !strings.HasPrefix(addr, "@") {
+		if err := removeStaleSocket(addr); err != nil {
+			return nil, err
+		}
+	}
+
+	return net.Listen(network, addr)
+}
+
+// removeStaleSocket removes the socket file at the path provided if no
+// process is listening on it anymore. We refuse to remove files that are not
+// sockets, so that a misconfigured path never deletes a user's data.
+func removeStaleSocket(path string) error {
+	info, err := os.Stat(path)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%v exists and is not a socket", path)
+	}
+
+	// If we can connect to the socket, another process is still serving
+	// on it, so we leave it alone and fail to listen.
+	conn, err := net.Dial("unix", path)
+	if err == nil {
+		conn.Close()
+
+		return fmt.Errorf("%v is already in use", path)
+	}
+
+	return os.Remove(path)
+}
+
+// RPCDialOptions returns the target and dial options that a grpc client
+// connects to the rpc address provided with. Local sockets are dialed with a
+// custom dialer, and their target is set to the name that we verify loopd's
+// TLS certificate against.
+func RPCDialOptions(address string) (string, []grpc.DialOption, error) {
+	network, addr, err := ParseRPCAddress(address)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if network == "tcp" {
+		return address, nil, nil
+	}
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, network, addr)
+	}
+
+	target := "passthrough:///" + localServerName
+
+	return target, []grpc.DialOption{grpc.WithContextDialer(dialer)}, nil
+}
@@ -0,0 +1,130 @@
+package loopd
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseRPCAddress tests parsing of tcp and local socket rpc addresses.
+func TestParseRPCAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		network string
+		addr    string
+		err     error
+	}{
+		{
+			name:    "tcp",
+			address: "localhost:11010",
+			network: "tcp",
+			addr:    "localhost:11010",
+		},
+		{
+			name:    "unix socket",
+			address: "unix:///tmp/loopd.sock",
+			network: "unix",
+			addr:    "/tmp/loopd.sock",
+		},
+		{
+			name:    "empty unix socket",
+			address: "unix://",
+			err:     errEmptySocket,
+		},
+		{
+			name:    "empty abstract socket",
+			address: "unix-abstract://",
+			err:     errEmptySocket,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			network, addr, err := ParseRPCAddress(test.address)
+			require.Equal(t, test.err, err)
+			require.Equal(t, test.network, network)
+			require.Equal(t, test.addr, addr)
+		})
+	}
+
+	// Abstract sockets are only available on linux.
+	network, addr, err := ParseRPCAddress("unix-abstract://loopd")
+	if runtime.GOOS != "linux" {
+		require.Equal(t, errAbstractUnsupported, err)
+		return
+	}
+
+	require.NoError(t, err)
+	require.Equal(t, "unix", network)
+	require.Equal(t, "@loopd", addr)
+}
+
+// TestListenRPCSocket tests listening on a unix socket, and dialing it with
+// the dial options that our clients use.
+func TestListenRPCSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loopd-ipc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "loopd.sock")
+	address := unixScheme + path
+
+	listener, err := listenRPC(address)
+	require.NoError(t, err)
+
+	// We can't listen on a socket that is still in use.
+	_, err = listenRPC(address)
+	require.Error(t, err)
+
+	target, opts, err := RPCDialOptions(address)
+	require.NoError(t, err)
+	require.Equal(t, "passthrough:///"+localServerName, target)
+	require.Len(t, opts, 1)
+
+	// Once our listener is gone, a socket file that was left behind is
+	// removed so that we can listen on it again.
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, listener.Close())
+
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	listener, err = listenRPC(address)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			conn.Close()
+		}
+		done <- err
+	}()
+
+	var d net.Dialer
+	conn, err := d.DialContext(context.Background(), "unix", path)
+	require.NoError(t, err)
+	conn.Close()
+
+	require.NoError(t, <-done)
+	require.NoError(t, listener.Close())
+
+	// Files that are not sockets are never removed.
+	file := filepath.Join(dir, "file")
+	require.NoError(t, ioutil.WriteFile(file, nil, 0600))
+
+	_, err = listenRPC(unixScheme + file)
+	require.Error(t, err)
+
+	_, err = os.Stat(file)
+	require.NoError(t, err)
+}
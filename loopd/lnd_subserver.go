@@ -0,0 +1,129 @@
+package loopd
+
+import (
+	"context"
+	"sync/atomic"
+
+	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// errLoopNotReady is returned for calls that lnd passes on to us before our
+// daemon has been started.
+var errLoopNotReady = status.Error(
+	codes.Unavailable, "loop is not yet ready to accept calls",
+)
+
+// LndSubserver registers loopd's rpc services with the external subserver
+// mechanism of an lnd node that runs in the same process, so that lnd's TLS
+// and single endpoint front loop. It implements lnd's GrpcRegistrar,
+// RestRegistrar and MacaroonValidator interfaces, and is passed to lnd as
+// the registrar and validator of its ExternalRPCSubserverCfg, and as its
+// ExternalRestRegistrar.
+//
+// Since lnd registers its subservers when its rpc server starts, calls may
+// reach us before the daemon is started with Start. These calls are rejected
+// until the daemon is ready.
+type LndSubserver struct {
+	// ready is set to 1 once our daemon has been started. It must be used
+	// atomically.
+	ready int32
+
+	daemon *Daemon
+}
+
+// NewLndSubserver creates an lnd subserver for the daemon provided, which
+// must not have been started yet. If a validator for lnd's macaroons is
+// provided, calls are authenticated with lnd's macaroons rather than loop's
+// own, so that macaroons which lnd has baked with loop's permissions can be
+// used with loop. Otherwise loop's own macaroons are required.
+func NewLndSubserver(daemon *Daemon,
+	lndValidator macaroons.MacaroonValidator) *LndSubserver {
+
+	daemon.lndValidator = lndValidator
+
+	return &LndSubserver{
+		daemon: daemon,
+	}
+}
+
+// Start starts our daemon with the connection to lnd provided. Calls are
+// accepted once it has started successfully.
+func (s *LndSubserver) Start(lndGrpc *lndclient.GrpcLndServices) error {
+	if err := s.daemon.StartAsSubserver(lndGrpc); err != nil {
+		return err
+	}
+
+	atomic.StoreInt32(&s.ready, 1)
+
+	return nil
+}
+
+// RegisterGrpcSubserver registers loop's rpc services with lnd's gRPC server.
+//
+// NOTE: Part of lnd's GrpcRegistrar interface.
+func (s *LndSubserver) RegisterGrpcSubserver(server *grpc.Server) error {
+	looprpc.RegisterSwapClientServer(server, s.daemon)
+	s.daemon.RegisterExtensionServers(server)
+
+	return nil
+}
+
+// RegisterRestSubserver registers loop's REST proxy with lnd's REST mux,
+// proxying calls to loop's services at lnd's gRPC endpoint.
+//
+// NOTE: Part of lnd's RestRegistrar interface.
+func (s *LndSubserver) RegisterRestSubserver(ctx context.Context,
+	mux *proxy.ServeMux, endpoint string,
+	dialOpts []grpc.DialOption) error {
+
+	return looprpc.RegisterSwapClientHandlerFromEndpoint(
+		ctx, mux, endpoint, dialOpts,
+	)
+}
+
+// ValidateMacaroon rejects calls until our daemon is ready, and then
+// validates the macaroon of the call with lnd's validator if we were created
+// with one, or with loop's own macaroon service otherwise.
+//
+// NOTE: Part of lnd's MacaroonValidator interface.
+func (s *LndSubserver) ValidateMacaroon(ctx context.Context,
+	requiredPermissions []bakery.Op, fullMethod string) error {
+
+	if atomic.LoadInt32(&s.ready) != 1 {
+		return errLoopNotReady
+	}
+
+	if s.daemon.lndValidator == nil {
+		return s.daemon.ValidateMacaroon(
+			ctx, requiredPermissions, fullMethod,
+		)
+	}
+
+	err := s.daemon.lndValidator.ValidateMacaroon(
+		ctx, requiredPermissions, fullMethod,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.daemon.tenants.checkMethod(ctx, fullMethod)
+}
+
+// Permissions returns the macaroon permissions that are required for each of
+// the rpc methods that we register with lnd, including those of our
+// registered swap extensions.
+func (s *LndSubserver) Permissions() map[string][]bakery.Op {
+	permissions := make(map[string][]bakery.Op, len(RequiredPermissions))
+	for method, ops := range RequiredPermissions {
+		permissions[method] = ops
+	}
+
+	return permissions
+}
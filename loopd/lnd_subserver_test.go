@@ -0,0 +1,71 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// mockValidator is a macaroon validator that returns a fixed error.
+type mockValidator struct {
+	err error
+}
+
+// ValidateMacaroon returns the mock's error.
+func (m *mockValidator) ValidateMacaroon(_ context.Context, _ []bakery.Op,
+	_ string) error {
+
+	return m.err
+}
+
+// TestLndSubserverValidateMacaroon tests validation of calls that lnd passes
+// on to loop with lnd's macaroons.
+func TestLndSubserverValidateMacaroon(t *testing.T) {
+	operatorMac, operatorCtx := macaroonContext(t, "operator")
+	_, tenantCtx := macaroonContext(t, "tenant")
+
+	validator := &mockValidator{}
+	subserver := NewLndSubserver(New(&Config{}, nil), validator)
+	subserver.daemon.tenants = &tenantPolicy{
+		operator: MacaroonIdentity(operatorMac),
+	}
+
+	const (
+		loopOut     = "/looprpc.SwapClient/LoopOut"
+		setLiqParam = "/looprpc.SwapClient/SetLiquidityParams"
+	)
+
+	// Calls are rejected until the daemon has been started.
+	err := subserver.ValidateMacaroon(operatorCtx, nil, loopOut)
+	require.Equal(t, errLoopNotReady, err)
+
+	subserver.ready = 1
+
+	require.NoError(t, subserver.ValidateMacaroon(operatorCtx, nil, loopOut))
+	require.NoError(t, subserver.ValidateMacaroon(tenantCtx, nil, loopOut))
+
+	// Tenants are still restricted to their methods.
+	err = subserver.ValidateMacaroon(tenantCtx, nil, setLiqParam)
+	require.Equal(t, errOperatorOnly, err)
+
+	// Calls that lnd's validator rejects are rejected.
+	validator.err = errors.New("invalid macaroon")
+	err = subserver.ValidateMacaroon(operatorCtx, nil, loopOut)
+	require.Equal(t, validator.err, err)
+}
+
+// TestLndSubserverPermissions tests that we provide lnd with the permissions
+// of all of our rpc methods.
+func TestLndSubserverPermissions(t *testing.T) {
+	subserver := NewLndSubserver(New(&Config{}, nil), nil)
+
+	permissions := subserver.Permissions()
+	require.Equal(t, RequiredPermissions, permissions)
+
+	// Changes to the permissions we return must not change our own.
+	permissions["/looprpc.SwapClient/Test"] = nil
+	require.NotContains(t, RequiredPermissions, "/looprpc.SwapClient/Test")
+}
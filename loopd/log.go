@@ -6,7 +6,10 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/logging"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/metrics"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
@@ -22,20 +25,46 @@ var (
 
 // SetupLoggers initializes all package-global logger variables.
 func SetupLoggers(root *build.RotatingLogWriter, intercept signal.Interceptor) {
-	genLogger := genSubLogger(root, intercept)
+	setupLoggers(root, intercept, genSubLogger(root, intercept))
+}
+
+// SetupJSONLoggers initializes all package-global logger variables with
+// loggers that write structured json entries to the backend provided. The
+// loggers are registered with the root writer so that their levels can be
+// set.
+func SetupJSONLoggers(root *build.RotatingLogWriter,
+	backend *logging.JSONBackend, intercept signal.Interceptor) {
+
+	setupLoggers(root, intercept, genJSONSubLogger(backend, intercept))
+}
+
+// setupLoggers initializes all package-global logger variables with loggers
+// created by the function provided.
+func setupLoggers(root *build.RotatingLogWriter, intercept signal.Interceptor,
+	genLogger func(string) btclog.Logger) {
 
 	logWriter = root
 	log = build.NewSubLogger(Subsystem, genLogger)
 	interceptor = intercept
 
 	lnd.SetSubLogger(root, Subsystem, log)
-	lnd.AddSubLogger(root, "LOOP", intercept, loop.UseLogger)
-	lnd.AddSubLogger(root, "LNDC", intercept, lndclient.UseLogger)
-	lnd.AddSubLogger(root, "STORE", intercept, loopdb.UseLogger)
-	lnd.AddSubLogger(root, lsat.Subsystem, intercept, lsat.UseLogger)
-	lnd.AddSubLogger(
-		root, liquidity.Subsystem, intercept, liquidity.UseLogger,
-	)
+	addSubLogger(root, genLogger, "LOOP", loop.UseLogger)
+	addSubLogger(root, genLogger, "LNDC", lndclient.UseLogger)
+	addSubLogger(root, genLogger, "STORE", loopdb.UseLogger)
+	addSubLogger(root, genLogger, lsat.Subsystem, lsat.UseLogger)
+	addSubLogger(root, genLogger, liquidity.Subsystem, liquidity.UseLogger)
+	addSubLogger(root, genLogger, metrics.Subsystem, metrics.UseLogger)
+	addSubLogger(root, genLogger, tracing.Subsystem, tracing.UseLogger)
+}
+
+// addSubLogger creates a logger for a subsystem, registers it with the root
+// writer and passes it to the functions provided.
+func addSubLogger(root *build.RotatingLogWriter,
+	genLogger func(string) btclog.Logger, subsystem string,
+	useLoggers ...func(btclog.Logger)) {
+
+	logger := build.NewSubLogger(subsystem, genLogger)
+	lnd.SetSubLogger(root, subsystem, logger, useLoggers...)
 }
 
 // genSubLogger creates a logger for a subsystem. We provide an instance of
@@ -43,15 +72,7 @@ func SetupLoggers(root *build.RotatingLogWriter, intercept signal.Interceptor) {
 func genSubLogger(root *build.RotatingLogWriter,
 	interceptor signal.Interceptor) func(string) btclog.Logger {
 
-	// Create a shutdown function which will request shutdown from our
-	// interceptor if it is listening.
-	shutdown := func() {
-		if !interceptor.Listening() {
-			return
-		}
-
-		interceptor.RequestShutdown()
-	}
+	shutdown := shutdownFunc(interceptor)
 
 	// Return a function which will create a sublogger from our root
 	// logger without shutdown fn.
@@ -59,3 +80,28 @@ func genSubLogger(root *build.RotatingLogWriter,
 		return root.GenSubLogger(tag, shutdown)
 	}
 }
+
+// genJSONSubLogger creates a structured logger for a subsystem, which
+// requests shutdown from the interceptor provided in the case of a critical
+// error.
+func genJSONSubLogger(backend *logging.JSONBackend,
+	interceptor signal.Interceptor) func(string) btclog.Logger {
+
+	shutdown := shutdownFunc(interceptor)
+
+	return func(tag string) btclog.Logger {
+		return backend.Logger(tag, shutdown)
+	}
+}
+
+// shutdownFunc creates a shutdown function which will request shutdown from
+// our interceptor if it is listening.
+func shutdownFunc(interceptor signal.Interceptor) func() {
+	return func() {
+		if !interceptor.Listening() {
+			return
+		}
+
+		interceptor.RequestShutdown()
+	}
+}
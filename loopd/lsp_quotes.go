@@ -0,0 +1,175 @@
+package loopd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/liquidity"
+)
+
+// lspQuoteTimeout is the amount of time that each of our liquidity service
+// providers has to quote a channel.
+const lspQuoteTimeout = 10 * time.Second
+
+// lspQuoteResponse is the response that we expect from the channel quote
+// endpoints of our liquidity service providers.
+type lspQuoteResponse struct {
+	// FeeSat is the total fee in satoshis that the provider charges for
+	// the channel.
+	FeeSat int64 `json:"fee_sat"`
+
+	// LeaseBlocks is the number of blocks that the provider keeps the
+	// channel open for, if it commits to a lease.
+	LeaseBlocks uint32 `json:"lease_blocks"`
+}
+
+// lspQuoter fetches the prices of inbound channels from a set of liquidity
+// service providers' http endpoints. Each endpoint is queried with a GET
+// request that holds the inbound capacity that we want in satoshis as the
+// capacity_sat parameter, and must respond with an lspQuoteResponse. This is
+// not a standard protocol: LSPS1 orders are placed over lightning peer
+// messages and only price a channel once an order is created, so providers
+// are expected to be fronted by an adapter that serves this schema.
+type lspQuoter struct {
+	endpoints []*url.URL
+	client    *http.Client
+}
+
+// parseLSPEndpoint parses a liquidity service provider's endpoint, which
+// must be an http or https url.
+func parseLSPEndpoint(endpoint string) (*url.URL, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lsp endpoint %v: %v", endpoint,
+			err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("lsp endpoint %v must be an http or "+
+			"https url", endpoint)
+	}
+
+	return parsed, nil
+}
+
+// newLSPQuoter creates a quoter for the endpoints provided.
+func newLSPQuoter(endpoints []string) (*lspQuoter, error) {
+	quoter := &lspQuoter{
+		client: &http.Client{
+			Timeout: lspQuoteTimeout,
+		},
+	}
+
+	for _, endpoint := range endpoints {
+		parsed, err := parseLSPEndpoint(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		quoter.endpoints = append(quoter.endpoints, parsed)
+	}
+
+	return quoter, nil
+}
+
+// quote returns the quotes of all of our providers for a channel with the
+// inbound capacity provided. Our providers are queried in parallel, so that
+// a quote takes no longer than our slowest provider, up to lspQuoteTimeout.
+// Providers that fail to quote are logged and skipped, so that one
+// unavailable provider does not hide the others.
+func (l *lspQuoter) quote(ctx context.Context,
+	capacity btcutil.Amount) ([]liquidity.ChannelQuote, error) {
+
+	results := make([]*liquidity.ChannelQuote, len(l.endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range l.endpoints {
+		i, endpoint := i, endpoint
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			quote, err := l.quoteEndpoint(ctx, endpoint, capacity)
+			if err != nil {
+				log.Warnf("Channel quote from %v failed: %v",
+					endpoint.Host, err)
+
+				return
+			}
+
+			results[i] = quote
+		}()
+	}
+	wg.Wait()
+
+	// If we ran out of time, our quotes are incomplete, so we don't
+	// report them.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var quotes []liquidity.ChannelQuote
+	for _, quote := range results {
+		if quote != nil {
+			quotes = append(quotes, *quote)
+		}
+	}
+
+	return quotes, nil
+}
+
+// quoteEndpoint fetches the quote of a single provider.
+func (l *lspQuoter) quoteEndpoint(ctx context.Context, endpoint *url.URL,
+	capacity btcutil.Amount) (*liquidity.ChannelQuote, error) {
+
+	quoteURL := *endpoint
+	query := quoteURL.Query()
+	query.Set("capacity_sat", strconv.FormatInt(int64(capacity), 10))
+	quoteURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, quoteURL.String(), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("%v: %s", resp.Status, msg)
+	}
+
+	var quote lspQuoteResponse
+	err = json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&quote)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quote: %v", err)
+	}
+
+	if quote.FeeSat < 0 {
+		return nil, fmt.Errorf("negative fee quoted: %v", quote.FeeSat)
+	}
+
+	return &liquidity.ChannelQuote{
+		Provider:    endpoint.Host,
+		Capacity:    capacity,
+		Fee:         btcutil.Amount(quote.FeeSat),
+		LeaseBlocks: quote.LeaseBlocks,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package loopd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLSPQuoter tests that we query our providers for channel quotes, and
+// that providers which fail to quote are skipped.
+func TestLSPQuoter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "100000", r.URL.Query().Get(
+				"capacity_sat",
+			))
+			require.Equal(t, "abc", r.URL.Query().Get("key"))
+
+			_, _ = w.Write([]byte(
+				`{"fee_sat":1200,"lease_blocks":4032}`,
+			))
+		},
+	))
+	defer server.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unavailable", http.StatusBadGateway)
+		},
+	))
+	defer failing.Close()
+
+	// An endpoint may hold query parameters of its own, which are kept.
+	quoter, err := newLSPQuoter([]string{
+		server.URL + "/quote?key=abc", failing.URL,
+	})
+	require.NoError(t, err)
+
+	quotes, err := quoter.quote(context.Background(), 100000)
+	require.NoError(t, err)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	require.Equal(t, []liquidity.ChannelQuote{{
+		Provider:    serverURL.Host,
+		Capacity:    100000,
+		Fee:         btcutil.Amount(1200),
+		LeaseBlocks: 4032,
+	}}, quotes)
+
+	// If we run out of time, we don't report incomplete quotes.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = quoter.quote(ctx, 100000)
+	require.Equal(t, context.Canceled, err)
+
+	// Endpoints that are not http urls are rejected.
+	_, err = newLSPQuoter([]string{"ftp://example.com"})
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/lightningnetwork/lnd/rpcperms"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
 )
 
 const (
@@ -39,10 +40,60 @@ var (
 			Entity: "loop",
 			Action: "in",
 		}},
+		"/looprpc.SwapClient/ReserveLoopOut": {{
+			Entity: "swap",
+			Action: "execute",
+		}, {
+			Entity: "loop",
+			Action: "out",
+		}},
+		"/looprpc.SwapClient/ReserveLoopIn": {{
+			Entity: "swap",
+			Action: "execute",
+		}, {
+			Entity: "loop",
+			Action: "in",
+		}},
+		"/looprpc.SwapClient/ConfirmReservation": {{
+			Entity: "swap",
+			Action: "execute",
+		}},
+		"/looprpc.SwapClient/ListPendingApprovals": {{
+			Entity: "approvals",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ApproveSwap": {{
+			Entity: "approvals",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/DenySwap": {{
+			Entity: "approvals",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ListSwapDenials": {{
+			Entity: "approvals",
+			Action: "read",
+		}},
 		"/looprpc.SwapClient/Monitor": {{
 			Entity: "swap",
 			Action: "read",
 		}},
+		"/looprpc.SwapClient/RedactSwap": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/GetStatusLink": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetSwapStats": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetInfo": {{
+			Entity: "swap",
+			Action: "read",
+		}},
 		"/looprpc.SwapClient/ListSwaps": {{
 			Entity: "swap",
 			Action: "read",
@@ -91,10 +142,169 @@ var (
 			Entity: "suggestions",
 			Action: "read",
 		}},
+		"/looprpc.SwapClient/QuoteSuggestions": {{
+			Entity: "suggestions",
+			Action: "read",
+		}, {
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ApplySuggestions": {{
+			Entity: "suggestions",
+			Action: "read",
+		}, {
+			Entity: "swap",
+			Action: "execute",
+		}, {
+			Entity: "loop",
+			Action: "out",
+		}},
+		"/looprpc.SwapClient/GetParameterAdvice": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
 		"/looprpc.SwapClient/SetLiquidityParams": {{
 			Entity: "suggestions",
 			Action: "write",
 		}},
+		"/looprpc.SwapClient/GetSwapProof": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/PreviewSweep": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetLiquiditySummary": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ListLiquiditySnapshots": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ListFeeRecords": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ListDispatchRecords": {{
+			Entity: "suggestions",
+			Action: "read",
+		}, {
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/DebugLevel": {{
+			Entity: "log",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/CaptureProfile": {{
+			Entity: "profile",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/SetSwapTemplate": {{
+			Entity: "templates",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ListSwapTemplates": {{
+			Entity: "templates",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/DeleteSwapTemplate": {{
+			Entity: "templates",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ResolveSwapTemplate": {{
+			Entity: "templates",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/SetFeeBudget": {{
+			Entity: "budgets",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ListFeeBudgets": {{
+			Entity: "budgets",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/DeleteFeeBudget": {{
+			Entity: "budgets",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/GetCostStatement": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/DrainChannel": {{
+			Entity: "swap",
+			Action: "execute",
+		}, {
+			Entity: "loop",
+			Action: "out",
+		}, {
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/FillChannel": {{
+			Entity: "swap",
+			Action: "execute",
+		}, {
+			Entity: "loop",
+			Action: "out",
+		}, {
+			Entity: "loop",
+			Action: "in",
+		}, {
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/CreateSwapPlan": {{
+			Entity: "plans",
+			Action: "write",
+		}, {
+			Entity: "swap",
+			Action: "execute",
+		}},
+		"/looprpc.SwapClient/ListSwapPlans": {{
+			Entity: "plans",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/UpdateSwapPlan": {{
+			Entity: "plans",
+			Action: "write",
+		}, {
+			Entity: "swap",
+			Action: "execute",
+		}},
+		"/looprpc.SwapClient/ExternalLoopIn": {{
+			Entity: "swap",
+			Action: "execute",
+		}, {
+			Entity: "loop",
+			Action: "in",
+		}, {
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ReloadConfig": {{
+			Entity: "config",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/BakeTenantMacaroon": {{
+			Entity: "tenants",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/SnapshotMissionControl": {{
+			Entity: "missioncontrol",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ResetMissionControl": {{
+			Entity: "missioncontrol",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/RestoreMissionControl": {{
+			Entity: "missioncontrol",
+			Action: "write",
+		}},
 		"/looprpc.SwapClient/Probe": {{
 			Entity: "swap",
 			Action: "execute",
@@ -105,9 +315,9 @@ var (
 	}
 
 	// allPermissions is the list of all existing permissions that exist
-	// for loopd's RPC. The default macaroon that is created on startup
-	// contains all these permissions and is therefore equivalent to lnd's
-	// admin.macaroon but for loop.
+	// for loopd's RPC, except for approving swaps. The default macaroon
+	// that is created on startup contains all these permissions and is
+	// therefore equivalent to lnd's admin.macaroon but for loop.
 	allPermissions = []bakery.Op{{
 		Entity: "loop",
 		Action: "out",
@@ -120,6 +330,9 @@ var (
 	}, {
 		Entity: "swap",
 		Action: "read",
+	}, {
+		Entity: "swap",
+		Action: "write",
 	}, {
 		Entity: "terms",
 		Action: "read",
@@ -132,6 +345,60 @@ var (
 	}, {
 		Entity: "suggestions",
 		Action: "write",
+	}, {
+		Entity: "log",
+		Action: "write",
+	}, {
+		Entity: "profile",
+		Action: "write",
+	}, {
+		Entity: "templates",
+		Action: "read",
+	}, {
+		Entity: "templates",
+		Action: "write",
+	}, {
+		Entity: "budgets",
+		Action: "read",
+	}, {
+		Entity: "budgets",
+		Action: "write",
+	}, {
+		Entity: "plans",
+		Action: "read",
+	}, {
+		Entity: "plans",
+		Action: "write",
+	}, {
+		Entity: "limits",
+		Action: "override",
+	}, {
+		Entity: "approvals",
+		Action: "read",
+	}, {
+		Entity: "config",
+		Action: "write",
+	}, {
+		Entity: "tenants",
+		Action: "write",
+	}, {
+		Entity: "missioncontrol",
+		Action: "write",
+	}}
+
+	// approverPermissions is the list of permissions of the approver
+	// macaroon that is created on startup. Approving and denying swaps is
+	// left out of our default macaroon, so that the swaps which it
+	// requests must be approved with a separate credential.
+	approverPermissions = []bakery.Op{{
+		Entity: "approvals",
+		Action: "read",
+	}, {
+		Entity: "approvals",
+		Action: "write",
+	}, {
+		Entity: "swap",
+		Action: "read",
 	}}
 
 	// macDbDefaultPw is the default encryption password used to encrypt the
@@ -176,36 +443,71 @@ func (d *Daemon) startMacaroonService() error {
 
 	// Create macaroon files for loop CLI to use if they don't exist.
 	if !lnrpc.FileExists(d.cfg.MacaroonPath) {
-		// We don't offer the ability to rotate macaroon root keys yet,
-		// so just use the default one since the service expects some
-		// value to be set.
-		idCtx := macaroons.ContextWithRootKeyID(
-			context.Background(), macaroons.DefaultRootKeyID,
-		)
-
-		// We only generate one default macaroon that contains all
-		// existing permissions (equivalent to the admin.macaroon in
-		// lnd). Custom macaroons can be created through the bakery
-		// RPC. Add our debug permissions if required.
+		// We generate one default macaroon that contains all existing
+		// permissions except for approvals (equivalent to the
+		// admin.macaroon in lnd). Custom macaroons can be created
+		// through the bakery RPC. Add our debug and extension
+		// permissions if required.
 		allPermissions = append(allPermissions, debugPermissions...)
-		loopMac, err := d.macaroonService.Oven.NewMacaroon(
-			idCtx, bakery.LatestVersion, nil, allPermissions...,
+		allPermissions = append(
+			allPermissions, extensionPermissions()...,
 		)
+		err := d.writeMacaroon(d.cfg.MacaroonPath, allPermissions)
 		if err != nil {
 			return err
 		}
-		loopMacBytes, err := loopMac.M().MarshalBinary()
+	}
+
+	if !lnrpc.FileExists(d.cfg.ApproverMacaroonPath) {
+		err := d.writeMacaroon(
+			d.cfg.ApproverMacaroonPath, approverPermissions,
+		)
 		if err != nil {
 			return err
 		}
-		err = ioutil.WriteFile(d.cfg.MacaroonPath, loopMacBytes, 0644)
-		if err != nil {
-			if err := os.Remove(d.cfg.MacaroonPath); err != nil {
-				log.Errorf("Unable to remove %s: %v",
-					d.cfg.MacaroonPath, err)
-			}
-			return err
+	}
+
+	return nil
+}
+
+// bakeMacaroon bakes a macaroon with the permissions provided. Each macaroon
+// that we bake has a new random id, and therefore a new identity.
+func (d *Daemon) bakeMacaroon(permissions []bakery.Op) (*macaroon.Macaroon,
+	error) {
+
+	// We don't offer the ability to rotate macaroon root keys yet, so just
+	// use the default one since the service expects some value to be set.
+	idCtx := macaroons.ContextWithRootKeyID(
+		context.Background(), macaroons.DefaultRootKeyID,
+	)
+
+	mac, err := d.macaroonService.Oven.NewMacaroon(
+		idCtx, bakery.LatestVersion, nil, permissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mac.M(), nil
+}
+
+// writeMacaroon bakes a macaroon with the permissions provided and writes it
+// to the path provided.
+func (d *Daemon) writeMacaroon(path string, permissions []bakery.Op) error {
+	mac, err := d.bakeMacaroon(permissions)
+	if err != nil {
+		return err
+	}
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(path, macBytes, 0644)
+	if err != nil {
+		if err := os.Remove(path); err != nil {
+			log.Errorf("Unable to remove %s: %v", path, err)
 		}
+		return err
 	}
 
 	return nil
@@ -241,10 +543,26 @@ func (d *Daemon) macaroonInterceptor() ([]grpc.ServerOption, error) {
 		}
 	}
 
-	unaryInterceptor := interceptor.MacaroonUnaryServerInterceptor()
-	streamInterceptor := interceptor.MacaroonStreamServerInterceptor()
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		interceptor.MacaroonUnaryServerInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		interceptor.MacaroonStreamServerInterceptor(),
+	}
+
+	// In multi-tenant mode, tenants are restricted to a subset of our
+	// methods once their macaroon has been validated.
+	if d.tenants != nil {
+		unaryInterceptors = append(
+			unaryInterceptors, d.tenants.unaryInterceptor,
+		)
+		streamInterceptors = append(
+			streamInterceptors, d.tenants.streamInterceptor,
+		)
+	}
+
 	return []grpc.ServerOption{
-		grpc.UnaryInterceptor(unaryInterceptor),
-		grpc.StreamInterceptor(streamInterceptor),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	}, nil
 }
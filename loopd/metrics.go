@@ -0,0 +1,180 @@
+package loopd
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/metrics"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// swapMeasurement is the measurement that we record completed swaps
+	// under.
+	swapMeasurement = "loop_swap"
+
+	// liquidityMeasurement is the measurement that we record our node's
+	// aggregate balances under.
+	liquidityMeasurement = "loop_liquidity"
+
+	// channelMeasurement is the measurement that we record the balances
+	// of each of our channels under.
+	channelMeasurement = "loop_channel_liquidity"
+
+	// initiatorAutoloop and initiatorUser are the initiator tags that we
+	// set for automatically and manually dispatched swaps.
+	initiatorAutoloop = "autoloop"
+	initiatorUser     = "user"
+)
+
+// swapDirection returns the direction tag for a swap type.
+func swapDirection(swapType swap.Type) string {
+	if swapType == swap.TypeOut {
+		return "loop_out"
+	}
+
+	return "loop_in"
+}
+
+// swapInitiator returns the initiator tag for a swap, based on its label.
+func swapInitiator(swapType swap.Type, label string) string {
+	if labels.IsAutoloopLabel(label, swapType) {
+		return initiatorAutoloop
+	}
+
+	return initiatorUser
+}
+
+// newSwapPoint creates a metrics point for a swap that has reached a final
+// state. The peer provided is optional, because swaps are not necessarily
+// restricted to a single peer.
+func newSwapPoint(info *loop.SwapInfo, peer *route.Vertex) *metrics.Point {
+	tags := map[string]string{
+		"direction": swapDirection(info.SwapType),
+		"initiator": swapInitiator(info.SwapType, info.Label),
+		"state":     info.State.String(),
+	}
+
+	if peer != nil {
+		tags["peer"] = peer.String()
+	}
+
+	cost := info.Cost
+	latency := info.LastUpdate.Sub(info.InitiationTime)
+
+	return &metrics.Point{
+		Measurement: swapMeasurement,
+		Tags:        tags,
+		Fields: map[string]interface{}{
+			"amount_sat":       int64(info.AmountRequested),
+			"server_fee_sat":   int64(cost.Server),
+			"onchain_fee_sat":  int64(cost.Onchain),
+			"offchain_fee_sat": int64(cost.Offchain),
+			"total_fee_sat": int64(
+				cost.Server + cost.Onchain + cost.Offchain,
+			),
+			"latency_sec": latency.Seconds(),
+		},
+		Time: info.LastUpdate,
+	}
+}
+
+// swapPeer returns the peer that a swap was restricted to, if any. Loop outs
+// are restricted to channels, so we look up their peer in our list of
+// channels. If a loop out's channels belong to more than one peer, or we can
+// no longer find them, no peer is returned.
+func (s *swapClientServer) swapPeer(ctx context.Context,
+	info *loop.SwapInfo) (*route.Vertex, error) {
+
+	if info.SwapType == swap.TypeIn {
+		return info.LastHop, nil
+	}
+
+	if len(info.OutgoingChanSet) == 0 {
+		return nil, nil
+	}
+
+	channels, err := s.lnd.Client.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make(map[uint64]route.Vertex, len(channels))
+	for _, channel := range channels {
+		peers[channel.ChannelID] = channel.PubKeyBytes
+	}
+
+	var peer *route.Vertex
+	for _, chanID := range info.OutgoingChanSet {
+		chanPeer, ok := peers[chanID]
+		if !ok {
+			return nil, nil
+		}
+
+		if peer != nil && *peer != chanPeer {
+			return nil, nil
+		}
+
+		peer = &chanPeer
+	}
+
+	return peer, nil
+}
+
+// recordSwapMetrics records a metrics point for a swap if it has reached a
+// final state.
+func (s *swapClientServer) recordSwapMetrics(ctx context.Context,
+	info *loop.SwapInfo) {
+
+	if s.metrics == nil || info.State.Type() == loopdb.StateTypePending {
+		return
+	}
+
+	peer, err := s.swapPeer(ctx, info)
+	if err != nil {
+		log.Errorf("Could not look up peer for swap %v metrics: %v",
+			info.SwapHash, err)
+	}
+
+	s.metrics.Record(newSwapPoint(info, peer))
+}
+
+// newSnapshotPoints creates metrics points for a liquidity snapshot. We
+// create one point for our aggregate balances, and one point per channel.
+func newSnapshotPoints(snapshot *loopdb.LiquiditySnapshot) []*metrics.Point {
+	points := make([]*metrics.Point, 0, len(snapshot.Channels)+1)
+
+	points = append(points, &metrics.Point{
+		Measurement: liquidityMeasurement,
+		Fields: map[string]interface{}{
+			"confirmed_balance_sat": int64(snapshot.ConfirmedBalance),
+			"local_balance_sat":     int64(snapshot.LocalBalance()),
+			"remote_balance_sat":    int64(snapshot.RemoteBalance()),
+		},
+		Time: snapshot.Timestamp,
+	})
+
+	for _, channel := range snapshot.Channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+
+		points = append(points, &metrics.Point{
+			Measurement: channelMeasurement,
+			Tags: map[string]string{
+				"channel": chanID.String(),
+				"peer":    channel.Peer.String(),
+			},
+			Fields: map[string]interface{}{
+				"capacity_sat":       int64(channel.Capacity),
+				"local_balance_sat":  int64(channel.LocalBalance),
+				"remote_balance_sat": int64(channel.RemoteBalance),
+			},
+			Time: snapshot.Timestamp,
+		})
+	}
+
+	return points
+}
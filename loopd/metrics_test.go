@@ -0,0 +1,68 @@
+package loopd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/metrics"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewSwapPoint tests creation of metrics points for completed swaps.
+func TestNewSwapPoint(t *testing.T) {
+	var (
+		initiated = time.Unix(1000, 0)
+		completed = initiated.Add(time.Minute)
+		peer      = route.Vertex{1}
+	)
+
+	info := &loop.SwapInfo{
+		SwapType:   swap.TypeOut,
+		LastUpdate: completed,
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: 1000,
+			InitiationTime:  initiated,
+			Label:           labels.AutoloopLabel(swap.TypeOut),
+		},
+		SwapStateData: loopdb.SwapStateData{
+			State: loopdb.StateSuccess,
+			Cost: loopdb.SwapCost{
+				Server:   10,
+				Onchain:  20,
+				Offchain: 30,
+			},
+		},
+	}
+
+	expected := &metrics.Point{
+		Measurement: swapMeasurement,
+		Tags: map[string]string{
+			"direction": "loop_out",
+			"initiator": initiatorAutoloop,
+			"state":     loopdb.StateSuccess.String(),
+			"peer":      peer.String(),
+		},
+		Fields: map[string]interface{}{
+			"amount_sat":       int64(1000),
+			"server_fee_sat":   int64(10),
+			"onchain_fee_sat":  int64(20),
+			"offchain_fee_sat": int64(30),
+			"total_fee_sat":    int64(60),
+			"latency_sec":      float64(60),
+		},
+		Time: completed,
+	}
+	require.Equal(t, expected, newSwapPoint(info, &peer))
+
+	// A manually dispatched swap without a peer restriction should be
+	// tagged as user initiated, and have no peer tag.
+	info.Label = ""
+	expected.Tags["initiator"] = initiatorUser
+	delete(expected.Tags, "peer")
+	require.Equal(t, expected, newSwapPoint(info, nil))
+}
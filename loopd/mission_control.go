@@ -0,0 +1,254 @@
+package loopd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// missionControlSnapshotFile is the name of the file in our data directory
+// that snapshots of lnd's mission control are written to.
+const missionControlSnapshotFile = "missioncontrol.snapshot"
+
+var (
+	// errNoMissionControl is returned when mission control is used but we
+	// could not connect to lnd's router rpc.
+	errNoMissionControl = status.Error(
+		codes.Unavailable, "lnd mission control is not available",
+	)
+
+	// errNoMissionControlSnapshot is returned when mission control is
+	// restored before a snapshot was taken.
+	errNoMissionControlSnapshot = status.Error(
+		codes.FailedPrecondition, "no mission control snapshot found",
+	)
+)
+
+// lndMissionControl provides access to lnd's mission control. The version of
+// lndclient that we use does not expose mission control, so we use a separate
+// connection to lnd's router rpc.
+type lndMissionControl struct {
+	conn   *grpc.ClientConn
+	router routerrpc.RouterClient
+
+	// snapshotPath is the file that snapshots of mission control are
+	// written to.
+	snapshotPath string
+
+	// snapshotLock ensures that only one snapshot is written or restored
+	// at a time.
+	snapshotLock sync.Mutex
+}
+
+// newLndMissionControl connects to the router rpc of the lnd instance in the
+// config provided. The macaroon used must hold the offchain:read and
+// offchain:write permissions.
+func newLndMissionControl(cfg *lndConfig, network,
+	snapshotPath string) (*lndMissionControl, error) {
+
+	conn, err := lndclient.NewBasicConn(
+		cfg.Host, cfg.TLSPath, filepath.Dir(cfg.MacaroonPath), network,
+		lndclient.MacFilename(filepath.Base(cfg.MacaroonPath)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lndMissionControl{
+		conn:         conn,
+		router:       routerrpc.NewRouterClient(conn),
+		snapshotPath: snapshotPath,
+	}, nil
+}
+
+// ImportFailures imports the routing failures provided into lnd's mission
+// control.
+//
+// NOTE: Part of the loop.MissionControl interface.
+func (m *lndMissionControl) ImportFailures(ctx context.Context,
+	failures []loopdb.RoutingFailure) error {
+
+	pairs := make([]*routerrpc.PairHistory, len(failures))
+	for i, failure := range failures {
+		from, to := failure.From, failure.To
+
+		pairs[i] = &routerrpc.PairHistory{
+			NodeFrom: from[:],
+			NodeTo:   to[:],
+			History: &routerrpc.PairData{
+				FailTime:    failure.Time.Unix(),
+				FailAmtSat:  int64(failure.Amount.ToSatoshis()),
+				FailAmtMsat: int64(failure.Amount),
+			},
+		}
+	}
+
+	_, err := m.router.XImportMissionControl(
+		ctx, &routerrpc.XImportMissionControlRequest{
+			Pairs: pairs,
+		},
+	)
+
+	return err
+}
+
+// snapshot writes the current state of lnd's mission control to our snapshot
+// file, replacing any existing snapshot.
+func (m *lndMissionControl) snapshot(ctx context.Context) (
+	*looprpc.MissionControlSnapshot, error) {
+
+	resp, err := m.router.QueryMissionControl(
+		ctx, &routerrpc.QueryMissionControlRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We store the snapshot in the format that lnd imports it in, so that
+	// it can be restored as is.
+	value, err := proto.Marshal(&routerrpc.XImportMissionControlRequest{
+		Pairs: resp.Pairs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.snapshotLock.Lock()
+	defer m.snapshotLock.Unlock()
+
+	// Write the snapshot to a temporary file first, so that an existing
+	// snapshot isn't lost if we fail part way through.
+	tmpPath := m.snapshotPath + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, value, 0600); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, m.snapshotPath); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Mission control snapshot taken: %v pairs", len(resp.Pairs))
+
+	return &looprpc.MissionControlSnapshot{
+		SnapshotTime: time.Now().Unix(),
+		Pairs:        uint32(len(resp.Pairs)),
+	}, nil
+}
+
+// reset clears all of lnd's mission control state.
+func (m *lndMissionControl) reset(ctx context.Context) error {
+	_, err := m.router.ResetMissionControl(
+		ctx, &routerrpc.ResetMissionControlRequest{},
+	)
+
+	return err
+}
+
+// restore resets lnd's mission control and imports our last snapshot. We
+// reset first because lnd does not import results that are older than the
+// results it currently holds.
+func (m *lndMissionControl) restore(ctx context.Context) (
+	*looprpc.MissionControlSnapshot, error) {
+
+	m.snapshotLock.Lock()
+	defer m.snapshotLock.Unlock()
+
+	info, err := os.Stat(m.snapshotPath)
+	if os.IsNotExist(err) {
+		return nil, errNoMissionControlSnapshot
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := ioutil.ReadFile(m.snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot routerrpc.XImportMissionControlRequest
+	if err := proto.Unmarshal(value, &snapshot); err != nil {
+		return nil, err
+	}
+
+	if err := m.reset(ctx); err != nil {
+		return nil, err
+	}
+
+	// lnd requires at least one pair to be imported, so if our snapshot is
+	// empty we are done once mission control has been reset.
+	if len(snapshot.Pairs) > 0 {
+		_, err = m.router.XImportMissionControl(ctx, &snapshot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Infof("Mission control restored from snapshot taken at %v: %v "+
+		"pairs", info.ModTime(), len(snapshot.Pairs))
+
+	return &looprpc.MissionControlSnapshot{
+		SnapshotTime: info.ModTime().Unix(),
+		Pairs:        uint32(len(snapshot.Pairs)),
+	}, nil
+}
+
+// close closes our connection to lnd.
+func (m *lndMissionControl) close() error {
+	return m.conn.Close()
+}
+
+// SnapshotMissionControl saves a snapshot of lnd's mission control.
+func (s *swapClientServer) SnapshotMissionControl(ctx context.Context,
+	_ *looprpc.SnapshotMissionControlRequest) (
+	*looprpc.MissionControlSnapshot, error) {
+
+	if s.missionControl == nil {
+		return nil, errNoMissionControl
+	}
+
+	return s.missionControl.snapshot(ctx)
+}
+
+// ResetMissionControl clears all of lnd's mission control state.
+func (s *swapClientServer) ResetMissionControl(ctx context.Context,
+	_ *looprpc.ResetMissionControlRequest) (
+	*looprpc.ResetMissionControlResponse, error) {
+
+	if s.missionControl == nil {
+		return nil, errNoMissionControl
+	}
+
+	log.Infof("Resetting mission control")
+
+	if err := s.missionControl.reset(ctx); err != nil {
+		return nil, err
+	}
+
+	return &looprpc.ResetMissionControlResponse{}, nil
+}
+
+// RestoreMissionControl resets lnd's mission control and restores our last
+// snapshot.
+func (s *swapClientServer) RestoreMissionControl(ctx context.Context,
+	_ *looprpc.RestoreMissionControlRequest) (
+	*looprpc.MissionControlSnapshot, error) {
+
+	if s.missionControl == nil {
+		return nil, errNoMissionControl
+	}
+
+	return s.missionControl.restore(ctx)
+}
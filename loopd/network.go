@@ -0,0 +1,84 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/lndclient"
+)
+
+const (
+	// networkSignet is the name of the signet network.
+	networkSignet = "signet"
+
+	// lndNetworkTimeout is the time that we allow lnd to report its
+	// network in at startup.
+	lndNetworkTimeout = 30 * time.Second
+)
+
+var (
+	// defaultServers holds the default swap server address for each of
+	// the networks that has one. Swaps on other networks require a server
+	// address to be configured.
+	defaultServers = map[string]string{
+		"mainnet": mainnetServer,
+		"testnet": testnetServer,
+	}
+
+	// errNetworkMismatch is returned at startup when lnd or the swap
+	// server run on a different network than the one we are configured
+	// for.
+	errNetworkMismatch = errors.New("network mismatch")
+)
+
+// ChainParams returns the chain parameters for the network provided. Signet
+// is handled separately, because lndclient does not provide its parameters.
+func ChainParams(network string) (*chaincfg.Params, error) {
+	if network == networkSignet {
+		return &chaincfg.SigNetParams, nil
+	}
+
+	return lndclient.Network(network).ChainParams()
+}
+
+// checkServerNetwork checks that the swap server address provided is not the
+// default server of another network, since any addresses that the server
+// provides would not decode on our network.
+func checkServerNetwork(network, host string) error {
+	for serverNetwork, server := range defaultServers {
+		if host != server || serverNetwork == network {
+			continue
+		}
+
+		return fmt.Errorf("%w: swap server %v serves %v, loop is "+
+			"running on %v", errNetworkMismatch, host,
+			serverNetwork, network)
+	}
+
+	return nil
+}
+
+// checkLndNetwork checks that the lnd node that we are connected to runs on
+// the network provided. Nodes that do not report their network are assumed to
+// be on the correct network.
+func checkLndNetwork(ctx context.Context, lnd lndclient.LightningClient,
+	network string) error {
+
+	ctx, cancel := context.WithTimeout(ctx, lndNetworkTimeout)
+	defer cancel()
+
+	info, err := lnd.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get lnd network: %v", err)
+	}
+
+	if info.Network == "" || info.Network == network {
+		return nil
+	}
+
+	return fmt.Errorf("%w: lnd is running on %v, loop is running on %v",
+		errNetworkMismatch, info.Network, network)
+}
@@ -0,0 +1,64 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	mock_lnd "github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChainParams tests lookup of the chain parameters of our networks.
+func TestChainParams(t *testing.T) {
+	params, err := ChainParams("signet")
+	require.NoError(t, err)
+	require.Equal(t, &chaincfg.SigNetParams, params)
+
+	params, err = ChainParams("testnet")
+	require.NoError(t, err)
+	require.Equal(t, &chaincfg.TestNet3Params, params)
+
+	_, err = ChainParams("unknown")
+	require.Error(t, err)
+}
+
+// TestCheckServerNetwork tests that we reject the default swap servers of
+// other networks.
+func TestCheckServerNetwork(t *testing.T) {
+	require.NoError(t, checkServerNetwork("mainnet", mainnetServer))
+	require.NoError(t, checkServerNetwork("signet", "localhost:11009"))
+
+	err := checkServerNetwork("signet", testnetServer)
+	require.True(t, errors.Is(err, errNetworkMismatch))
+
+	err = checkServerNetwork("testnet", mainnetServer)
+	require.True(t, errors.Is(err, errNetworkMismatch))
+}
+
+// TestCheckLndNetwork tests that we reject lnd nodes that run on another
+// network.
+func TestCheckLndNetwork(t *testing.T) {
+	lnd := mock_lnd.NewMockLnd()
+	ctx := context.Background()
+
+	require.NoError(t, checkLndNetwork(ctx, lnd.Client, "testnet"))
+
+	err := checkLndNetwork(ctx, lnd.Client, "signet")
+	require.True(t, errors.Is(err, errNetworkMismatch))
+
+	// Nodes that don't report their network are not rejected.
+	lnd.Network = ""
+	require.NoError(t, checkLndNetwork(ctx, lnd.Client, "signet"))
+}
+
+// TestCheckNetworkDir tests that we reject directories that hold the data of
+// another network.
+func TestCheckNetworkDir(t *testing.T) {
+	require.NoError(t, checkNetworkDir("datadir", "/loop", "mainnet"))
+	require.NoError(t, checkNetworkDir("datadir", "/testnet", "testnet"))
+
+	err := checkNetworkDir("datadir", "/loop/testnet", "mainnet")
+	require.True(t, errors.Is(err, errNetworkMismatch))
+}
@@ -0,0 +1,103 @@
+package loopd
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/looprpc"
+)
+
+// GetParameterAdvice returns recommended changes to our liquidity parameters
+// based on our recent swap outcomes and fee history.
+func (s *swapClientServer) GetParameterAdvice(ctx context.Context,
+	_ *looprpc.ParameterAdviceRequest) (*looprpc.ParameterAdviceResponse,
+	error) {
+
+	advice, err := s.liquidityMgr.AdviseParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.ParameterAdviceResponse{
+		Advice: make([]*looprpc.ParameterAdvice, len(advice)),
+	}
+
+	for i, a := range advice {
+		resp.Advice[i] = marshallParameterAdvice(a)
+	}
+
+	return resp, nil
+}
+
+// marshallParameterAdvice converts a piece of parameter advice to its rpc
+// representation.
+func marshallParameterAdvice(advice liquidity.Advice) *looprpc.ParameterAdvice {
+	return &looprpc.ParameterAdvice{
+		Parameter:   advice.Parameter,
+		Current:     advice.Current,
+		Recommended: advice.Recommended,
+		Reason:      advice.Reason,
+	}
+}
+
+// monitorParameterAdvice periodically checks whether our history suggests
+// changes to our parameters, and logs a warning for each new recommendation.
+// Recommendations are never applied.
+func (s *swapClientServer) monitorParameterAdvice(ctx context.Context,
+	interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// reported holds the recommended value of each parameter that we
+	// last warned about, so that we only warn about each recommendation
+	// once.
+	reported := make(map[string]string)
+
+	for {
+		select {
+		case <-ticker.C:
+			advice, err := s.liquidityMgr.AdviseParameters(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Errorf("Could not check parameter "+
+						"advice: %v", err)
+				}
+
+				continue
+			}
+
+			reportParameterAdvice(advice, reported)
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reportParameterAdvice logs a warning for each piece of advice that differs
+// from the advice that we already reported for its parameter, and updates the
+// set of reported advice. Parameters that no longer have advice are removed,
+// so that they are reported again if the advice recurs.
+func reportParameterAdvice(advice []liquidity.Advice,
+	reported map[string]string) {
+
+	current := make(map[string]bool, len(advice))
+	for _, a := range advice {
+		current[a.Parameter] = true
+
+		if reported[a.Parameter] == a.Recommended {
+			continue
+		}
+
+		log.Warnf("Parameter advice: %v", a)
+		reported[a.Parameter] = a.Recommended
+	}
+
+	for parameter := range reported {
+		if !current[parameter] {
+			delete(reported, parameter)
+		}
+	}
+}
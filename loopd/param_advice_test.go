@@ -0,0 +1,45 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReportParameterAdvice tests that each recommendation is only reported
+// once, and reported again if it recurs after it was resolved.
+func TestReportParameterAdvice(t *testing.T) {
+	reported := make(map[string]string)
+
+	sweepConf := liquidity.Advice{
+		Parameter:   "sweepconf",
+		Recommended: "50 blocks",
+	}
+	maxMiner := liquidity.Advice{
+		Parameter:   "maxminer",
+		Recommended: "0.025 BTC",
+	}
+
+	reportParameterAdvice([]liquidity.Advice{sweepConf}, reported)
+	require.Equal(t, map[string]string{
+		"sweepconf": "50 blocks",
+	}, reported)
+
+	// A new recommendation for a parameter replaces the one that we
+	// reported.
+	sweepConf.Recommended = "25 blocks"
+	reportParameterAdvice(
+		[]liquidity.Advice{sweepConf, maxMiner}, reported,
+	)
+	require.Equal(t, map[string]string{
+		"sweepconf": "25 blocks",
+		"maxminer":  "0.025 BTC",
+	}, reported)
+
+	// Once a parameter no longer has advice, it is forgotten.
+	reportParameterAdvice([]liquidity.Advice{maxMiner}, reported)
+	require.Equal(t, map[string]string{
+		"maxminer": "0.025 BTC",
+	}, reported)
+}
@@ -0,0 +1,56 @@
+package loopd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+)
+
+// networkDirs are the names of the subdirectories that the data of each of
+// our networks is stored in.
+var networkDirs = map[string]bool{
+	"mainnet": true,
+	"testnet": true,
+	"regtest": true,
+	"simnet":  true,
+	"signet":  true,
+}
+
+// checkNetworkDir fails if the directory that an option is set to is the
+// subdirectory of another network than the one that we run on. Our data is
+// stored in a subdirectory for our network, so such a directory means that
+// the option was set to the data of one network rather than the directory
+// that holds the data of all networks, and that we would store the data of
+// our network within the data of the other.
+func checkNetworkDir(option, dir, network string) error {
+	dirNetwork := filepath.Base(dir)
+	if !networkDirs[dirNetwork] || dirNetwork == network {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %v %v is the directory of %v, loop is "+
+		"running on %v, please set it to its parent directory",
+		errNetworkMismatch, option, dir, dirNetwork, network)
+}
+
+// marshallDaemonPaths returns the rpc representation of the resolved paths
+// in the validated config provided.
+func marshallDaemonPaths(cfg *Config) *looprpc.DaemonPaths {
+	configFile, _ := getConfigPath(*cfg, cfg.LoopDir)
+
+	database := loopdb.StorePath(cfg.DataDir)
+	if cfg.Watch {
+		database = cfg.Replica.Path
+	}
+
+	return &looprpc.DaemonPaths{
+		DataDir:      cfg.DataDir,
+		LogFile:      filepath.Join(cfg.LogDir, defaultLogFilename),
+		ConfigFile:   configFile,
+		Database:     database,
+		TlsCertPath:  cfg.TLSCertPath,
+		MacaroonPath: cfg.MacaroonPath,
+	}
+}
@@ -0,0 +1,127 @@
+package loopd
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// lndPayer dispatches payments that are paid with AMP, carry custom records
+// or are paced. The version of lndclient that we use does not expose these
+// options, so we use lnd's rpcs directly.
+type lndPayer struct {
+	router    routerrpc.RouterClient
+	lightning lnrpc.LightningClient
+}
+
+// SendPayment pays the invoice in the request provided.
+//
+// NOTE: Part of the loop.Payer interface.
+func (l *lndPayer) SendPayment(ctx context.Context,
+	req loop.PaymentRequest) (chan lndclient.PaymentStatus, chan error,
+	error) {
+
+	rpcReq := &routerrpc.SendPaymentRequest{
+		PaymentRequest:    req.Invoice,
+		FeeLimitSat:       int64(req.MaxFee),
+		OutgoingChanIds:   req.OutgoingChanIds,
+		TimeoutSeconds:    int32(req.Timeout / time.Second),
+		MaxParts:          req.MaxParts,
+		Amp:               req.Amp,
+		DestCustomRecords: req.CustomRecords,
+	}
+
+	if req.Pacing != nil {
+		rpcReq.MaxShardSizeMsat = uint64(
+			lnwire.NewMSatFromSatoshis(req.Pacing.MaxShardSize),
+		)
+
+		// Lnd dispatches all shards of a payment at once, so we
+		// dispatch the shards of payments that need to be spaced out
+		// ourselves. AMP payments derive the hashes of their shards
+		// from a shared secret, so we leave them to lnd.
+		if req.Pacing.ShardDelay > 0 && !req.Amp {
+			return l.sendPacedPayment(ctx, req)
+		}
+	}
+
+	stream, err := l.router.SendPaymentV2(ctx, rpcReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statusChan, errChan := forwardPaymentUpdates(ctx, stream)
+
+	return statusChan, errChan, nil
+}
+
+// paymentStream is a stream of payment updates from lnd's router rpc.
+type paymentStream interface {
+	Recv() (*lnrpc.Payment, error)
+}
+
+// forwardPaymentUpdates delivers the updates of a payment stream on a status
+// channel, and the error that ends the stream on an error channel.
+func forwardPaymentUpdates(ctx context.Context, stream paymentStream) (
+	chan lndclient.PaymentStatus, chan error) {
+
+	statusChan := make(chan lndclient.PaymentStatus)
+	errChan := make(chan error, 1)
+
+	go func() {
+		for {
+			payment, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			status, err := unmarshallPaymentStatus(payment)
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			select {
+			case statusChan <- *status:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return statusChan, errChan
+}
+
+// unmarshallPaymentStatus converts a payment update from lnd's router rpc to
+// the payment status that lndclient delivers for payments.
+func unmarshallPaymentStatus(payment *lnrpc.Payment) (
+	*lndclient.PaymentStatus, error) {
+
+	status := &lndclient.PaymentStatus{
+		State:         payment.Status,
+		FailureReason: payment.FailureReason,
+		Value:         lnwire.MilliSatoshi(payment.ValueMsat),
+		Fee:           lnwire.MilliSatoshi(payment.FeeMsat),
+	}
+
+	// The preimage of a payment is only set once it succeeded.
+	if payment.Status == lnrpc.Payment_SUCCEEDED {
+		preimage, err := lntypes.MakePreimageFromStr(
+			payment.PaymentPreimage,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		status.Preimage = preimage
+	}
+
+	return status, nil
+}
@@ -0,0 +1,54 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnmarshallPaymentStatus tests conversion of the payment updates from lnd's
+// router rpc to the payment status that our swaps expect.
+func TestUnmarshallPaymentStatus(t *testing.T) {
+	preimage := lntypes.Preimage{1, 2, 3}
+
+	// Payments that are in flight report an empty preimage, which we do
+	// not parse.
+	status, err := unmarshallPaymentStatus(&lnrpc.Payment{
+		Status:          lnrpc.Payment_IN_FLIGHT,
+		PaymentPreimage: lntypes.Preimage{}.String(),
+		ValueMsat:       100000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &lndclient.PaymentStatus{
+		State: lnrpc.Payment_IN_FLIGHT,
+		Value: 100000,
+	}, status)
+
+	status, err = unmarshallPaymentStatus(&lnrpc.Payment{
+		Status:          lnrpc.Payment_SUCCEEDED,
+		PaymentPreimage: preimage.String(),
+		ValueMsat:       100000,
+		FeeMsat:         2000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &lndclient.PaymentStatus{
+		State:    lnrpc.Payment_SUCCEEDED,
+		Preimage: preimage,
+		Value:    100000,
+		Fee:      2000,
+	}, status)
+
+	noRoute := lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE
+	status, err = unmarshallPaymentStatus(&lnrpc.Payment{
+		Status:        lnrpc.Payment_FAILED,
+		FailureReason: noRoute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &lndclient.PaymentStatus{
+		State:         lnrpc.Payment_FAILED,
+		FailureReason: noRoute,
+	}, status)
+}
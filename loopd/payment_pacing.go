@@ -0,0 +1,544 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// maxPacingDuration is the longest time that we spread the shards of
+	// a paced payment over. Receivers only hold the shards of a multi-path
+	// payment for a limited time while they wait for the rest of them to
+	// arrive, which is two minutes in lnd, so we shorten the delay between
+	// shards of payments that have many shards.
+	maxPacingDuration = 90 * time.Second
+
+	// minPacedShard is the smallest shard that we split a paced payment
+	// into when we can't find a route for a larger shard.
+	minPacedShard = lnwire.MilliSatoshi(10000000)
+)
+
+// The reasons that paced payments fail for.
+const (
+	failureNone             = lnrpc.PaymentFailureReason_FAILURE_REASON_NONE
+	failureNoRoute          = lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE
+	failureTimeout          = lnrpc.PaymentFailureReason_FAILURE_REASON_TIMEOUT
+	failureError            = lnrpc.PaymentFailureReason_FAILURE_REASON_ERROR
+	failureIncorrectDetails = lnrpc.PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS
+)
+
+// errNoRoute is returned when lnd does not find a route for a shard.
+var errNoRoute = errors.New("no route found")
+
+// shardDelay returns the delay between the shards of a paced payment of the
+// amount provided, or zero if the payment fits in a single shard.
+func shardDelay(pacing *loop.PaymentPacing,
+	amount lnwire.MilliSatoshi) time.Duration {
+
+	maxShard := lnwire.NewMSatFromSatoshis(pacing.MaxShardSize)
+	if maxShard == 0 || amount <= maxShard {
+		return 0
+	}
+
+	shards := (amount + maxShard - 1) / maxShard
+	maxDelay := maxPacingDuration / time.Duration(shards-1)
+	if pacing.ShardDelay > maxDelay {
+		return maxDelay
+	}
+
+	return pacing.ShardDelay
+}
+
+// sendPacedPayment pays the invoice in the request provided by dispatching
+// its shards one at a time, with the delay that the request's pacing sets
+// between them.
+func (l *lndPayer) sendPacedPayment(ctx context.Context,
+	req loop.PaymentRequest) (chan lndclient.PaymentStatus, chan error,
+	error) {
+
+	payReq, err := l.lightning.DecodePayReq(
+		ctx, &lnrpc.PayReqString{PayReq: req.Invoice},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hash, err := hex.DecodeString(payReq.PaymentHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Payments that fit in a single shard don't need to be paced, and
+	// invoices without a payment address can't be paid in shards, so we
+	// leave them to lnd.
+	amount := lnwire.MilliSatoshi(payReq.NumMsat)
+	delay := shardDelay(req.Pacing, amount)
+	if delay == 0 || len(payReq.PaymentAddr) == 0 {
+		pacing := *req.Pacing
+		pacing.ShardDelay = 0
+		req.Pacing = &pacing
+
+		return l.SendPayment(ctx, req)
+	}
+
+	// If we already dispatched the payment, for example before we
+	// restarted, we track it rather than dispatch shards on top of the
+	// ones that lnd already holds.
+	stream, err := l.trackExistingPayment(ctx, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stream != nil {
+		statusChan, errChan := forwardPaymentUpdates(ctx, stream)
+		return statusChan, errChan, nil
+	}
+
+	destFeatures := make([]lnrpc.FeatureBit, 0, len(payReq.Features))
+	for bit := range payReq.Features {
+		destFeatures = append(destFeatures, lnrpc.FeatureBit(bit))
+	}
+
+	payment := &pacedPayment{
+		router:       l.router,
+		lightning:    l.lightning,
+		req:          req,
+		payReq:       payReq,
+		hash:         hash,
+		amount:       amount,
+		delay:        delay,
+		destFeatures: destFeatures,
+	}
+
+	statusChan := make(chan lndclient.PaymentStatus)
+	errChan := make(chan error, 1)
+
+	go func() {
+		if err := payment.run(ctx, statusChan); err != nil {
+			errChan <- err
+		}
+	}()
+
+	return statusChan, errChan, nil
+}
+
+// trackExistingPayment returns a stream of updates for the payment with the
+// hash provided if lnd has dispatched it and it has not failed. If lnd does
+// not know the payment, or it failed, nil is returned.
+func (l *lndPayer) trackExistingPayment(ctx context.Context,
+	hash []byte) (paymentStream, error) {
+
+	trackCtx, cancel := context.WithCancel(ctx)
+
+	stream, err := l.router.TrackPaymentV2(
+		trackCtx, &routerrpc.TrackPaymentRequest{
+			PaymentHash: hash,
+		},
+	)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	payment, err := stream.Recv()
+	switch {
+	case err != nil && isPaymentNotInitiated(err):
+		cancel()
+		return nil, nil
+
+	case err != nil:
+		cancel()
+		return nil, err
+
+	case payment.Status == lnrpc.Payment_FAILED:
+		cancel()
+		return nil, nil
+	}
+
+	return &peekedPaymentStream{
+		first:  payment,
+		stream: stream,
+		cancel: cancel,
+	}, nil
+}
+
+// isPaymentNotInitiated returns true if an error from lnd's router rpc
+// indicates that lnd does not know the payment that was tracked.
+func isPaymentNotInitiated(err error) bool {
+	return strings.Contains(
+		err.Error(), channeldb.ErrPaymentNotInitiated.Error(),
+	)
+}
+
+// peekedPaymentStream is a payment stream whose first update was already
+// received.
+type peekedPaymentStream struct {
+	first  *lnrpc.Payment
+	stream paymentStream
+	cancel func()
+}
+
+// Recv returns the update that was already received, followed by the
+// stream's remaining updates. The stream is canceled once it fails.
+func (p *peekedPaymentStream) Recv() (*lnrpc.Payment, error) {
+	if p.first != nil {
+		first := p.first
+		p.first = nil
+
+		return first, nil
+	}
+
+	payment, err := p.stream.Recv()
+	if err != nil {
+		p.cancel()
+	}
+
+	return payment, err
+}
+
+// shardResult is the outcome of a shard of a paced payment.
+type shardResult struct {
+	// amount is the amount that the shard paid to the receiver.
+	amount lnwire.MilliSatoshi
+
+	// fee is the routing fee of the shard's route.
+	fee lnwire.MilliSatoshi
+
+	// settled is true if the receiver settled the shard.
+	settled bool
+
+	// preimage is the preimage that the shard was settled with.
+	preimage lntypes.Preimage
+
+	// failure is set if the shard failed for a reason that retrying it
+	// can't resolve, so the payment as a whole fails.
+	failure lnrpc.PaymentFailureReason
+}
+
+// pacedPayment dispatches the shards of a payment one at a time.
+type pacedPayment struct {
+	router    routerrpc.RouterClient
+	lightning lnrpc.LightningClient
+
+	req    loop.PaymentRequest
+	payReq *lnrpc.PayReq
+	hash   []byte
+
+	// amount is the total amount of the payment.
+	amount lnwire.MilliSatoshi
+
+	// delay is the delay between dispatching shards.
+	delay time.Duration
+
+	// destFeatures are the features that the invoice signals.
+	destFeatures []lnrpc.FeatureBit
+
+	// chanIndex is the index of the channel in the request's outgoing
+	// channel set that the next shard is routed over first, so that
+	// consecutive shards use different channels.
+	chanIndex int
+}
+
+// run dispatches the payment's shards, retrying the amounts of shards that
+// fail, until the payment succeeds, fails or times out. The payment's status
+// is sent on the status channel provided whenever a shard is dispatched, and
+// once the payment is final.
+func (p *pacedPayment) run(ctx context.Context,
+	statusChan chan<- lndclient.PaymentStatus) error {
+
+	shardSize := lnwire.NewMSatFromSatoshis(p.req.Pacing.MaxShardSize)
+	if shardSize > p.amount {
+		shardSize = p.amount
+	}
+
+	var (
+		remaining = p.amount
+		feeBudget = lnwire.NewMSatFromSatoshis(p.req.MaxFee)
+		fees      lnwire.MilliSatoshi
+		inFlight  int
+		settled   bool
+		preimage  lntypes.Preimage
+		timedOut  bool
+		failure   = failureNone
+		results   = make(chan shardResult)
+
+		// next fires when the next shard should be dispatched. It is
+		// nil while we wait for shards in flight.
+		next = time.After(0)
+	)
+
+	var deadline <-chan time.Time
+	if p.req.Timeout > 0 {
+		deadline = time.After(p.req.Timeout)
+	}
+
+	sendStatus := func(status lndclient.PaymentStatus) error {
+		select {
+		case statusChan <- status:
+			return nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	failed := func() bool {
+		return failure != failureNone
+	}
+
+	for {
+		// We only report a final state once all of our shards have
+		// been resolved, so that the payment isn't considered failed
+		// while it still holds our outbound liquidity.
+		switch {
+		case settled && inFlight == 0:
+			return sendStatus(lndclient.PaymentStatus{
+				State:    lnrpc.Payment_SUCCEEDED,
+				Preimage: preimage,
+				Value:    p.amount,
+				Fee:      fees,
+			})
+
+		case failed() && inFlight == 0:
+			return sendStatus(lndclient.PaymentStatus{
+				State:         lnrpc.Payment_FAILED,
+				FailureReason: failure,
+				Value:         p.amount,
+			})
+		}
+
+		select {
+		case <-next:
+			next = nil
+
+			if remaining == 0 || settled || failed() {
+				continue
+			}
+
+			amount := shardSize
+			if amount > remaining {
+				amount = remaining
+			}
+
+			route, err := p.queryRoute(ctx, amount, feeBudget)
+			if err != nil {
+				log.Debugf("Payment %x: no route for shard of "+
+					"%v: %v", p.hash, amount, err)
+
+				// We try smaller shards before we wait for
+				// the shards that we have in flight.
+				switch {
+				case shardSize/2 >= minPacedShard:
+					shardSize /= 2
+					next = time.After(0)
+
+				case inFlight == 0:
+					failure = failureNoRoute
+
+				default:
+					next = time.After(p.delay)
+				}
+
+				continue
+			}
+
+			fee := lnwire.MilliSatoshi(route.TotalFeesMsat)
+			remaining -= amount
+			feeBudget -= fee
+			inFlight++
+
+			go func() {
+				result := p.sendShard(ctx, route, amount, fee)
+
+				select {
+				case results <- result:
+				case <-ctx.Done():
+				}
+			}()
+
+			err = sendStatus(lndclient.PaymentStatus{
+				State: lnrpc.Payment_IN_FLIGHT,
+				Value: p.amount,
+			})
+			if err != nil {
+				return err
+			}
+
+			if remaining > 0 {
+				next = time.After(p.delay)
+			}
+
+		case result := <-results:
+			inFlight--
+
+			if result.settled {
+				settled = true
+				preimage = result.preimage
+				fees += result.fee
+
+				continue
+			}
+
+			// The amount of a failed shard is paid again by a
+			// later shard, unless the payment can't succeed.
+			remaining += result.amount
+			feeBudget += result.fee
+
+			switch {
+			case failed():
+				// The payment already failed, so we only wait
+				// for our remaining shards to be resolved.
+
+			case result.failure != failureNone:
+				failure = result.failure
+
+			case timedOut:
+				failure = failureTimeout
+
+			case next == nil:
+				next = time.After(p.delay)
+			}
+
+		// Once the payment's timeout has passed, we stop dispatching
+		// shards. Shards that the receiver holds are not affected.
+		case <-deadline:
+			deadline = nil
+			timedOut = true
+
+			if remaining > 0 && !failed() {
+				failure = failureTimeout
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// queryRoute finds a route for a shard of the amount provided that pays at
+// most the fee budget provided. If the request restricts the channels that
+// the payment may use, the channels are tried in turn, starting with the one
+// after the channel that the previous shard was routed over.
+func (p *pacedPayment) queryRoute(ctx context.Context,
+	amount, feeBudget lnwire.MilliSatoshi) (*lnrpc.Route, error) {
+
+	chanIDs := p.req.OutgoingChanIds
+
+	attempts := len(chanIDs)
+	if attempts == 0 {
+		attempts = 1
+	}
+
+	lastErr := errNoRoute
+	for i := 0; i < attempts; i++ {
+		var chanID uint64
+		if len(chanIDs) > 0 {
+			chanID = chanIDs[p.chanIndex%len(chanIDs)]
+			p.chanIndex++
+		}
+
+		resp, err := p.lightning.QueryRoutes(
+			ctx, &lnrpc.QueryRoutesRequest{
+				PubKey:         p.payReq.Destination,
+				AmtMsat:        int64(amount),
+				FinalCltvDelta: int32(p.payReq.CltvExpiry),
+				FeeLimit: &lnrpc.FeeLimit{
+					Limit: &lnrpc.FeeLimit_FixedMsat{
+						FixedMsat: int64(feeBudget),
+					},
+				},
+				UseMissionControl: true,
+				OutgoingChanId:    chanID,
+				RouteHints:        p.payReq.RouteHints,
+				DestFeatures:      p.destFeatures,
+				DestCustomRecords: p.req.CustomRecords,
+			},
+		)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if len(resp.Routes) == 0 {
+			continue
+		}
+
+		// Each shard carries the invoice's payment address and the
+		// total amount of the payment, so that the receiver waits for
+		// all shards before it settles them.
+		route := resp.Routes[0]
+		finalHop := route.Hops[len(route.Hops)-1]
+		finalHop.TlvPayload = true
+		finalHop.CustomRecords = p.req.CustomRecords
+		finalHop.MppRecord = &lnrpc.MPPRecord{
+			PaymentAddr:  p.payReq.PaymentAddr,
+			TotalAmtMsat: int64(p.amount),
+		}
+
+		return route, nil
+	}
+
+	return nil, lastErr
+}
+
+// sendShard sends a shard along the route provided, and waits for it to be
+// resolved.
+func (p *pacedPayment) sendShard(ctx context.Context, route *lnrpc.Route,
+	amount, fee lnwire.MilliSatoshi) shardResult {
+
+	result := shardResult{
+		amount:  amount,
+		fee:     fee,
+		failure: failureNone,
+	}
+
+	attempt, err := p.router.SendToRouteV2(
+		ctx, &routerrpc.SendToRouteRequest{
+			PaymentHash: p.hash,
+			Route:       route,
+		},
+	)
+	if err != nil {
+		log.Warnf("Payment %x: shard of %v failed: %v", p.hash, amount,
+			err)
+
+		result.failure = failureError
+		return result
+	}
+
+	if attempt.Status == lnrpc.HTLCAttempt_SUCCEEDED {
+		preimage, err := lntypes.MakePreimage(attempt.Preimage)
+		if err != nil {
+			result.failure = failureError
+			return result
+		}
+
+		result.settled = true
+		result.preimage = preimage
+
+		return result
+	}
+
+	// If the receiver rejected the shard's payment details, retrying the
+	// shard won't succeed.
+	code := attempt.Failure.GetCode()
+	source := int(attempt.Failure.GetFailureSourceIndex())
+	if code == lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS &&
+		source == len(route.Hops) {
+
+		result.failure = failureIncorrectDetails
+	}
+
+	return result
+}
@@ -0,0 +1,218 @@
+package loopd
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// TestShardDelay tests calculation of the delay between the shards of paced
+// payments.
+func TestShardDelay(t *testing.T) {
+	pacing := &loop.PaymentPacing{
+		MaxShardSize: 100000,
+		ShardDelay:   10 * time.Second,
+	}
+
+	tests := []struct {
+		name   string
+		amount lnwire.MilliSatoshi
+		delay  time.Duration
+	}{
+		{
+			name:   "single shard",
+			amount: lnwire.NewMSatFromSatoshis(100000),
+			delay:  0,
+		},
+		{
+			name:   "two shards",
+			amount: lnwire.NewMSatFromSatoshis(100001),
+			delay:  10 * time.Second,
+		},
+		{
+			name:   "delay limited by pacing duration",
+			amount: lnwire.NewMSatFromSatoshis(2000000),
+			delay:  maxPacingDuration / 19,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t, testCase.delay,
+				shardDelay(pacing, testCase.amount),
+			)
+		})
+	}
+}
+
+// mockPacingLightning returns single hop routes for the shards of paced
+// payments.
+type mockPacingLightning struct {
+	lnrpc.LightningClient
+
+	mu       sync.Mutex
+	channels []uint64
+}
+
+// QueryRoutes returns a route over the outgoing channel that is requested.
+func (m *mockPacingLightning) QueryRoutes(_ context.Context,
+	req *lnrpc.QueryRoutesRequest, _ ...grpc.CallOption) (
+	*lnrpc.QueryRoutesResponse, error) {
+
+	m.mu.Lock()
+	m.channels = append(m.channels, req.OutgoingChanId)
+	m.mu.Unlock()
+
+	hop := &lnrpc.Hop{
+		ChanId:           req.OutgoingChanId,
+		AmtToForwardMsat: req.AmtMsat,
+	}
+
+	return &lnrpc.QueryRoutesResponse{
+		Routes: []*lnrpc.Route{
+			{
+				TotalFeesMsat: 1000,
+				TotalAmtMsat:  req.AmtMsat + 1000,
+				Hops:          []*lnrpc.Hop{hop},
+			},
+		},
+	}, nil
+}
+
+// mockPacingRouter settles the shards of paced payments once the receiver
+// has received the total amount, failing the first shard that it receives.
+type mockPacingRouter struct {
+	routerrpc.RouterClient
+
+	preimage lntypes.Preimage
+	total    int64
+
+	mu       sync.Mutex
+	shards   []*routerrpc.SendToRouteRequest
+	received int64
+	complete chan struct{}
+}
+
+// SendToRouteV2 records the shard provided. It fails the first shard, and
+// holds the others until the payment's total amount has been received.
+func (m *mockPacingRouter) SendToRouteV2(_ context.Context,
+	req *routerrpc.SendToRouteRequest, _ ...grpc.CallOption) (
+	*lnrpc.HTLCAttempt, error) {
+
+	const temporaryChannelFailure = lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE
+
+	m.mu.Lock()
+	m.shards = append(m.shards, req)
+	if len(m.shards) == 1 {
+		m.mu.Unlock()
+
+		return &lnrpc.HTLCAttempt{
+			Status: lnrpc.HTLCAttempt_FAILED,
+			Failure: &lnrpc.Failure{
+				Code:               temporaryChannelFailure,
+				FailureSourceIndex: 1,
+			},
+		}, nil
+	}
+
+	m.received += req.Route.Hops[0].AmtToForwardMsat
+	if m.received == m.total {
+		close(m.complete)
+	}
+	m.mu.Unlock()
+
+	<-m.complete
+
+	return &lnrpc.HTLCAttempt{
+		Status:   lnrpc.HTLCAttempt_SUCCEEDED,
+		Preimage: m.preimage[:],
+	}, nil
+}
+
+// TestPacedPayment tests dispatching the shards of a paced payment, retrying
+// the amount of a failed shard.
+func TestPacedPayment(t *testing.T) {
+	amount := lnwire.NewMSatFromSatoshis(300000)
+
+	lightning := &mockPacingLightning{}
+	router := &mockPacingRouter{
+		preimage: lntypes.Preimage{1, 2, 3},
+		total:    int64(amount),
+		complete: make(chan struct{}),
+	}
+
+	payment := &pacedPayment{
+		router:    router,
+		lightning: lightning,
+		req: loop.PaymentRequest{
+			SendPaymentRequest: lndclient.SendPaymentRequest{
+				MaxFee:          100,
+				OutgoingChanIds: []uint64{1, 2},
+				Timeout:         time.Minute,
+			},
+			Pacing: &loop.PaymentPacing{
+				MaxShardSize: 100000,
+				ShardDelay:   time.Millisecond,
+			},
+		},
+		payReq: &lnrpc.PayReq{
+			PaymentAddr: []byte{1},
+		},
+		hash:   []byte{4, 5, 6},
+		amount: amount,
+		delay:  time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	statusChan := make(chan lndclient.PaymentStatus)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- payment.run(ctx, statusChan)
+	}()
+
+	var status lndclient.PaymentStatus
+	for status.State != lnrpc.Payment_SUCCEEDED {
+		select {
+		case status = <-statusChan:
+			require.NotEqual(t, lnrpc.Payment_FAILED, status.State)
+
+		case <-time.After(5 * time.Second):
+			t.Fatal("payment not completed")
+		}
+	}
+	require.NoError(t, <-errChan)
+
+	// The three shards that settled pay the payment's amount and their
+	// fees.
+	require.Equal(t, router.preimage, status.Preimage)
+	require.Equal(t, amount, status.Value)
+	require.Equal(t, lnwire.MilliSatoshi(3000), status.Fee)
+
+	// The amount of the failed shard was paid again by a fourth shard, and
+	// the shards were routed over our channels in turn.
+	require.Len(t, router.shards, 4)
+	require.Equal(t, []uint64{1, 2, 1, 2}, lightning.channels)
+
+	for _, shard := range router.shards {
+		hop := shard.Route.Hops[0]
+		require.Equal(t, payment.hash, shard.PaymentHash)
+		require.Equal(t, int64(100000000), hop.AmtToForwardMsat)
+		require.Equal(t, int64(amount), hop.MppRecord.TotalAmtMsat)
+		require.True(t, hop.TlvPayload)
+	}
+}
@@ -0,0 +1,129 @@
+package loopd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	runtimepprof "runtime/pprof"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+)
+
+const (
+	// defaultProfileDirname is the directory within our data directory
+	// that profiles captured over rpc are written to.
+	defaultProfileDirname = "profiles"
+
+	// goroutineDumpDebug is the debug level that we write goroutine
+	// profiles with. This level writes the full stack trace of every
+	// goroutine in the same format as an unrecovered panic, which is most
+	// useful when investigating hung swaps.
+	goroutineDumpDebug = 2
+)
+
+// newProfileServer creates a http server which serves the runtime profiling
+// data expected by the pprof tool on the address provided.
+func newProfileServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/", http.RedirectHandler(
+		"/debug/pprof/", http.StatusSeeOther,
+	))
+
+	return &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+}
+
+// profileFile describes the file that a profile type is written to.
+type profileFile struct {
+	// name is the name of the runtime profile.
+	name string
+
+	// extension is the file extension that we use for the profile.
+	extension string
+
+	// debug is the debug level that the profile is written with.
+	debug int
+}
+
+// profileFiles maps each profile type that can be captured to the file that
+// we write it to.
+var profileFiles = map[looprpc.ProfileType]profileFile{
+	looprpc.ProfileType_GOROUTINE: {
+		name:      "goroutine",
+		extension: "txt",
+		debug:     goroutineDumpDebug,
+	},
+	looprpc.ProfileType_HEAP: {
+		name:      "heap",
+		extension: "pprof",
+	},
+}
+
+// writeProfiles writes the profiles requested to files in the directory
+// provided, returning the paths of the files that were written. If no
+// profiles are requested, all profiles are written.
+func writeProfiles(dir string, types []looprpc.ProfileType,
+	now time.Time) ([]string, error) {
+
+	if len(types) == 0 {
+		types = []looprpc.ProfileType{
+			looprpc.ProfileType_GOROUTINE, looprpc.ProfileType_HEAP,
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(types))
+	for _, profileType := range types {
+		file, ok := profileFiles[profileType]
+		if !ok {
+			return nil, fmt.Errorf("unknown profile type: %v",
+				profileType)
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf(
+			"%v-%v.%v", file.name, now.Format("20060102-150405"),
+			file.extension,
+		))
+
+		if err := writeProfile(path, file); err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// writeProfile writes a single runtime profile to the path provided.
+func writeProfile(path string, file profileFile) error {
+	profile := runtimepprof.Lookup(file.name)
+	if profile == nil {
+		return fmt.Errorf("profile %v not found", file.name)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := profile.WriteTo(f, file.debug); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
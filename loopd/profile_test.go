@@ -0,0 +1,48 @@
+package loopd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriteProfiles tests writing of runtime profiles to disk.
+func TestWriteProfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	profileDir := filepath.Join(dir, defaultProfileDirname)
+	now := time.Date(2021, 8, 1, 12, 30, 0, 0, time.UTC)
+
+	// When no profiles are requested, we expect all of them to be
+	// written.
+	files, err := writeProfiles(profileDir, nil, now)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(profileDir, "goroutine-20210801-123000.txt"),
+		filepath.Join(profileDir, "heap-20210801-123000.pprof"),
+	}, files)
+
+	// Our goroutine profile is written in the same format as a panic, so
+	// we expect to find this test's goroutine in it.
+	dump, err := ioutil.ReadFile(files[0])
+	require.NoError(t, err)
+	require.True(t, strings.Contains(string(dump), "TestWriteProfiles"))
+
+	info, err := os.Stat(files[1])
+	require.NoError(t, err)
+	require.NotZero(t, info.Size())
+
+	// Unknown profile types should fail.
+	_, err = writeProfiles(
+		profileDir, []looprpc.ProfileType{looprpc.ProfileType(99)}, now,
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,279 @@
+package loopd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// readinessInterval is the interval at which we check whether we are
+	// ready and record the result in our health file.
+	readinessInterval = 30 * time.Second
+
+	// readinessTimeout is the maximum amount of time that each of our
+	// readiness checks may take.
+	readinessTimeout = 10 * time.Second
+
+	// livenessFactor is the number of check intervals that may pass
+	// without our health file being updated before we are considered
+	// stuck.
+	livenessFactor = 3
+
+	// healthFilename is the name of the file in our data directory that
+	// holds the result of our last readiness check.
+	healthFilename = "health.json"
+
+	// healthCheckReady is the health check that passes if a running
+	// daemon is connected to lnd and the swap server.
+	healthCheckReady = "ready"
+
+	// healthCheckLive is the health check that passes as long as a
+	// running daemon keeps checking its readiness, even if it is not
+	// ready. Orchestrators should only restart loopd if this check fails,
+	// because restarting it does not help if lnd or the swap server are
+	// unreachable.
+	healthCheckLive = "live"
+)
+
+// healthStatus is the result of a readiness check, which we record in our
+// health file.
+type healthStatus struct {
+	// Ready is true if we were connected to lnd and the swap server.
+	Ready bool `json:"ready"`
+
+	// Reason describes why we were not ready.
+	Reason string `json:"reason,omitempty"`
+
+	// Updated is the time of the check.
+	Updated time.Time `json:"updated"`
+
+	// Interval is the interval at which we check our readiness.
+	Interval time.Duration `json:"interval"`
+}
+
+// check returns an error if the status does not pass the health check
+// provided at the time provided.
+func (h *healthStatus) check(healthCheck string, now time.Time) error {
+	// If our status was not updated for several intervals, the daemon is
+	// stuck or has exited without removing its health file.
+	maxAge := h.Interval * livenessFactor
+	if age := now.Sub(h.Updated); age > maxAge {
+		return fmt.Errorf("loopd last checked its readiness %v ago",
+			age.Round(time.Second))
+	}
+
+	if healthCheck == healthCheckReady && !h.Ready {
+		return fmt.Errorf("loopd is not ready: %v", h.Reason)
+	}
+
+	return nil
+}
+
+// writeHealthFile atomically replaces the health file at the path provided
+// with the status provided.
+func writeHealthFile(path string, status *healthStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// runHealthCheck checks the health file of a running daemon, returning an
+// error if it does not pass the health check set in the config provided.
+func runHealthCheck(cfg *Config) error {
+	path := filepath.Join(cfg.DataDir, healthFilename)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("loopd is not running: %v", err)
+	}
+
+	var status healthStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return fmt.Errorf("invalid health file %v: %v", path, err)
+	}
+
+	if err := status.check(cfg.HealthCheckExec, time.Now()); err != nil {
+		return err
+	}
+
+	fmt.Printf("loopd is %v\n", cfg.HealthCheckExec)
+
+	return nil
+}
+
+// sdNotify sends the state provided to the service manager that started us
+// with systemd's notification protocol. It is a no-op if we were not given a
+// notification socket.
+func sdNotify(state string) error {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return nil
+	}
+
+	// Abstract sockets are named with a leading @.
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{
+		Name: socket,
+		Net:  "unixgram",
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(state))
+	return err
+}
+
+// watchdogInterval returns the interval within which systemd expects us to
+// ping its watchdog, or zero if its watchdog is not enabled for us.
+func watchdogInterval() time.Duration {
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0
+	}
+
+	// The watchdog may be meant for another process of our service.
+	pid := os.Getenv("WATCHDOG_PID")
+	if pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0
+	}
+
+	return time.Duration(usec) * time.Microsecond
+}
+
+// checkReadiness returns an error if we are not connected to lnd and the swap
+// server. In watch mode we connect to neither, so we are always ready once
+// our rpc servers are started.
+func (d *Daemon) checkReadiness(ctx context.Context,
+	timeout time.Duration) error {
+
+	if d.cfg.Watch {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if _, err := d.lnd.Client.GetInfo(ctx); err != nil {
+		return fmt.Errorf("lnd unreachable: %v", err)
+	}
+
+	if _, err := d.impl.LoopOutTerms(ctx); err != nil {
+		return fmt.Errorf("swap server unreachable: %v", err)
+	}
+
+	return nil
+}
+
+// monitorReadiness checks our readiness at a regular interval, records the
+// result in our health file and reports it to systemd. Our health file is
+// updated and systemd's watchdog is pinged even if we are not ready, so that
+// orchestrators can tell that we are still live.
+func (d *Daemon) monitorReadiness(ctx context.Context) {
+	interval := readinessInterval
+
+	// Systemd recommends that its watchdog is pinged at half of its
+	// interval.
+	watchdog := watchdogInterval()
+	if watchdog != 0 && watchdog/2 < interval {
+		interval = watchdog / 2
+	}
+
+	timeout := readinessTimeout
+	if interval < timeout {
+		timeout = interval
+	}
+
+	healthFile := filepath.Join(d.cfg.DataDir, healthFilename)
+
+	defer func() {
+		err := os.Remove(healthFile)
+		if err != nil && !os.IsNotExist(err) {
+			log.Errorf("Unable to remove health file: %v", err)
+		}
+
+		if err := sdNotify("STOPPING=1"); err != nil {
+			log.Errorf("Unable to notify systemd: %v", err)
+		}
+	}()
+
+	var ready bool
+	check := func() {
+		err := d.checkReadiness(ctx, timeout)
+
+		status := &healthStatus{
+			Ready:    err == nil,
+			Updated:  time.Now(),
+			Interval: interval,
+		}
+
+		var states []string
+		switch {
+		case err != nil:
+			status.Reason = err.Error()
+			reason := "STATUS=Not ready: " + status.Reason
+			states = append(states, reason)
+
+			if ready {
+				log.Warnf("Daemon no longer ready: %v", err)
+			}
+
+		case !ready:
+			states = append(states, "READY=1", "STATUS=Ready")
+			log.Infof("Daemon ready")
+		}
+		ready = err == nil
+
+		if err := writeHealthFile(healthFile, status); err != nil {
+			log.Errorf("Unable to write health file: %v", err)
+		}
+
+		if watchdog != 0 {
+			states = append(states, "WATCHDOG=1")
+		}
+
+		if len(states) == 0 {
+			return
+		}
+
+		err = sdNotify(strings.Join(states, "\n"))
+		if err != nil {
+			log.Errorf("Unable to notify systemd: %v", err)
+		}
+	}
+
+	check()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			check()
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
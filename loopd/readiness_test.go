@@ -0,0 +1,134 @@
+package loopd
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHealthStatusCheck tests our ready and live health checks.
+func TestHealthStatusCheck(t *testing.T) {
+	now := time.Unix(100000, 0)
+
+	tests := []struct {
+		name        string
+		status      healthStatus
+		healthCheck string
+		healthy     bool
+	}{
+		{
+			name: "ready",
+			status: healthStatus{
+				Ready:    true,
+				Updated:  now.Add(-time.Minute),
+				Interval: readinessInterval,
+			},
+			healthCheck: healthCheckReady,
+			healthy:     true,
+		},
+		{
+			name: "not ready",
+			status: healthStatus{
+				Reason:   "lnd unreachable",
+				Updated:  now,
+				Interval: readinessInterval,
+			},
+			healthCheck: healthCheckReady,
+		},
+		{
+			name: "live while not ready",
+			status: healthStatus{
+				Reason:   "lnd unreachable",
+				Updated:  now,
+				Interval: readinessInterval,
+			},
+			healthCheck: healthCheckLive,
+			healthy:     true,
+		},
+		{
+			name: "stale ready",
+			status: healthStatus{
+				Ready:    true,
+				Updated:  now.Add(-time.Hour),
+				Interval: readinessInterval,
+			},
+			healthCheck: healthCheckReady,
+		},
+		{
+			name: "stale live",
+			status: healthStatus{
+				Ready:    true,
+				Updated:  now.Add(-time.Hour),
+				Interval: readinessInterval,
+			},
+			healthCheck: healthCheckLive,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.status.check(testCase.healthCheck, now)
+			require.Equal(t, testCase.healthy, err == nil)
+		})
+	}
+}
+
+// TestRunHealthCheck tests checking the health file that a daemon writes.
+func TestRunHealthCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "health")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := &Config{
+		DataDir:         dir,
+		HealthCheckExec: healthCheckReady,
+	}
+
+	// Without a health file, loopd is not running.
+	require.Error(t, runHealthCheck(cfg))
+
+	path := filepath.Join(dir, healthFilename)
+	err = writeHealthFile(path, &healthStatus{
+		Ready:    true,
+		Updated:  time.Now(),
+		Interval: readinessInterval,
+	})
+	require.NoError(t, err)
+	require.NoError(t, runHealthCheck(cfg))
+}
+
+// TestSdNotify tests sending states to a systemd notification socket.
+func TestSdNotify(t *testing.T) {
+	// Without a notification socket, notifying is a no-op.
+	require.NoError(t, os.Unsetenv("NOTIFY_SOCKET"))
+	require.NoError(t, sdNotify("READY=1"))
+
+	dir, err := ioutil.TempDir("", "notify")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{
+		Name: socket,
+		Net:  "unixgram",
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, os.Setenv("NOTIFY_SOCKET", socket))
+	defer os.Unsetenv("NOTIFY_SOCKET")
+
+	require.NoError(t, sdNotify("READY=1\nSTATUS=Ready"))
+
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "READY=1\nSTATUS=Ready", string(buf[:n]))
+}
@@ -0,0 +1,51 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RedactSwap removes the metadata that may identify the people behind a swap
+// in a final state from our store and from the swaps that we serve.
+func (s *swapClientServer) RedactSwap(_ context.Context,
+	req *looprpc.RedactSwapRequest) (*looprpc.RedactSwapResponse, error) {
+
+	hash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.impl.Store.RedactSwap(hash)
+	switch {
+	case errors.Is(err, loopdb.ErrSwapNotFound):
+		return nil, status.Errorf(codes.NotFound, "swap %v not found",
+			hash)
+
+	case errors.Is(err, loopdb.ErrSwapNotFinal):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	// Swaps in a final state are no longer updated, so our in-memory copy
+	// of the swap is not overwritten with its unredacted metadata.
+	s.swapsLock.Lock()
+	if swp, ok := s.swaps[hash]; ok {
+		loopdb.RedactContract(&swp.SwapContract)
+		s.swaps[hash] = swp
+	}
+	s.swapsLock.Unlock()
+
+	s.forgetRequests(hash)
+
+	log.Infof("Redacted swap %v", hash)
+
+	return &looprpc.RedactSwapResponse{}, nil
+}
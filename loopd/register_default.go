@@ -2,7 +2,10 @@
 
 package loopd
 
-import "gopkg.in/macaroon-bakery.v2/bakery"
+import (
+	"github.com/lightninglabs/loop"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
 
 var (
 	debugRequiredPermissions = map[string][]bakery.Op{}
@@ -12,3 +15,7 @@ var (
 // registerDebugServer is our default debug server registration function, which
 // excludes debug functionality.
 func (d *Daemon) registerDebugServer() {}
+
+// installFaultHooks is our default fault hook installation function, which
+// never injects faults.
+func installFaultHooks(_ *Config, _ *loop.ClientConfig) {}
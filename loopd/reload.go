@@ -0,0 +1,140 @@
+package loopd
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/build"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errReloadUnsupported is returned when a config reload is requested from a
+// daemon that was not started from the command line, for example when it runs
+// as a subserver.
+var errReloadUnsupported = status.Error(
+	codes.Unimplemented, "config reload is only supported when loopd is "+
+		"started from the command line",
+)
+
+// Names of the options that can be reloaded without restarting the daemon.
+const (
+	reloadDebugLevel  = "debuglevel"
+	reloadLimits      = "limits"
+	reloadAllowedDest = "alloweddest"
+)
+
+// reloadableChanges returns the names of the reloadable options that differ
+// between the two configs provided. In watch mode, we don't execute swaps so
+// only our log levels can be reloaded.
+func reloadableChanges(current, updated *Config) []string {
+	var changed []string
+	if current.DebugLevel != updated.DebugLevel {
+		changed = append(changed, reloadDebugLevel)
+	}
+
+	if current.Watch {
+		return changed
+	}
+
+	if *current.Limits != *updated.Limits {
+		changed = append(changed, reloadLimits)
+	}
+
+	if !reflect.DeepEqual(current.AllowedDest, updated.AllowedDest) {
+		changed = append(changed, reloadAllowedDest)
+	}
+
+	return changed
+}
+
+// reloadConfig reads our config again and applies the options that can be
+// changed without interrupting the swaps that we are running. All other
+// options are left unchanged until we are restarted. The names of the options
+// that were changed are returned.
+func (d *Daemon) reloadConfig() ([]string, error) {
+	if d.loadConfig == nil {
+		return nil, errReloadUnsupported
+	}
+
+	cfg, err := d.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	d.reloadLock.Lock()
+	defer d.reloadLock.Unlock()
+
+	changed := reloadableChanges(d.cfg, cfg)
+
+	// Parse our new allowlist before we apply anything, so that an invalid
+	// config is not partially applied.
+	var allowedDests destAllowlist
+	if !d.cfg.Watch {
+		allowedDests, err = newDestAllowlist(
+			cfg.AllowedDest, d.lnd.ChainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, option := range changed {
+		switch option {
+		case reloadDebugLevel:
+			err := build.ParseAndSetDebugLevels(
+				cfg.DebugLevel, logWriter,
+			)
+			if err != nil {
+				return nil, err
+			}
+			d.cfg.DebugLevel = cfg.DebugLevel
+
+		case reloadLimits:
+			d.impl.SetVolumeLimits(getVolumeLimits(cfg.Limits))
+
+			d.policyLock.Lock()
+			d.approvalThreshold = btcutil.Amount(
+				cfg.Limits.ApprovalThreshold,
+			)
+			d.approvalTimeout = cfg.Limits.ApprovalTimeout
+			d.policyLock.Unlock()
+
+			d.cfg.Limits = cfg.Limits
+
+		case reloadAllowedDest:
+			d.policyLock.Lock()
+			d.allowedDests = allowedDests
+			d.policyLock.Unlock()
+
+			d.cfg.AllowedDest = cfg.AllowedDest
+		}
+	}
+
+	log.Infof("Config reloaded, changed options: %v", changed)
+
+	return changed, nil
+}
+
+// ReloadConfig reads our config again and applies the options that can be
+// changed without a restart.
+func (s *swapClientServer) ReloadConfig(_ context.Context,
+	_ *looprpc.ReloadConfigRequest) (*looprpc.ReloadConfigResponse, error) {
+
+	if s.reload == nil {
+		return nil, errReloadUnsupported
+	}
+
+	log.Infof("Reload config request received")
+
+	changed, err := s.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.ReloadConfigResponse{
+		ChangedOptions: changed,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestReloadableChanges tests that only changes to options that can be
+// reloaded are reported, and that watch mode only reloads log levels.
+func TestReloadableChanges(t *testing.T) {
+	newConfig := func() *Config {
+		cfg := DefaultConfig()
+		return &cfg
+	}
+
+	current := newConfig()
+	updated := newConfig()
+
+	// Options that can't be reloaded are not reported.
+	updated.RPCListen = "localhost:1"
+	updated.MaxLSATCost = 1
+	require.Empty(t, reloadableChanges(current, updated))
+
+	updated.DebugLevel = "debug"
+	updated.Limits.MaxDailySwaps = 3
+	updated.AllowedDest = []string{"raw(deadbeef)"}
+	require.Equal(t, []string{
+		reloadDebugLevel, reloadLimits, reloadAllowedDest,
+	}, reloadableChanges(current, updated))
+
+	current.Watch = true
+	require.Equal(
+		t, []string{reloadDebugLevel},
+		reloadableChanges(current, updated),
+	)
+}
@@ -0,0 +1,285 @@
+package loopd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// errRequestInProgress is returned when a swap is requested with the ID of a
+// request that is still being processed.
+var errRequestInProgress = status.Error(
+	codes.Aborted, "a swap request with this request id is in progress",
+)
+
+// clientRequest tracks a swap request that was made with a client provided
+// request ID.
+type clientRequest struct {
+	// swapType is the type of swap that was requested.
+	swapType swap.Type
+
+	// response is the response that we returned for the request. It is
+	// nil while the request is in progress.
+	response *looprpc.SwapResponse
+}
+
+// requestKey identifies a request ID. Request IDs are scoped to the tenant
+// that made the request, so that tenants cannot collide with or learn about
+// each other's requests in multi-tenant mode.
+type requestKey struct {
+	tenant    string
+	requestID string
+}
+
+// reserveRequest checks whether we have already created a swap for the
+// request ID provided. If we have, the response for that swap is returned.
+// Otherwise, the request ID is reserved until completeRequest is called, so
+// that concurrent requests with the same ID cannot both create a swap. No
+// reservation is made for empty request IDs.
+func (s *swapClientServer) reserveRequest(tenant, requestID string,
+	swapType swap.Type) (*looprpc.SwapResponse, error) {
+
+	if requestID == "" {
+		return nil, nil
+	}
+
+	if len(requestID) > loopdb.MaxRequestIDLength {
+		return nil, status.Error(
+			codes.InvalidArgument, loopdb.ErrRequestIDTooLong.Error(),
+		)
+	}
+
+	s.requestsLock.Lock()
+	defer s.requestsLock.Unlock()
+
+	key := requestKey{
+		tenant:    tenant,
+		requestID: requestID,
+	}
+
+	// A swap that was awaiting approval when it expired will never be
+	// executed, so the request may be retried.
+	request, ok := s.requests[key]
+	if ok && approvalExpired(request.response, time.Now()) {
+		delete(s.requests, key)
+		ok = false
+	}
+
+	if !ok {
+		var err error
+		request, err = s.lookupRequest(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case request == nil:
+		s.requests[key] = &clientRequest{
+			swapType: swapType,
+		}
+
+		return nil, nil
+
+	case request.swapType != swapType:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf(
+			"request id already used for a loop %v swap",
+			strings.ToLower(request.swapType.String()),
+		))
+
+	case request.response == nil:
+		return nil, errRequestInProgress
+
+	default:
+		return request.response, nil
+	}
+}
+
+// lookupRequest returns the request for a swap that was created by the tenant
+// with the request ID provided in a previous run, or nil if there is no such
+// swap.
+//
+// NOTE: The requests lock must be held when calling this function.
+func (s *swapClientServer) lookupRequest(key requestKey) (*clientRequest,
+	error) {
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	if err := s.loadSwaps(); err != nil {
+		return nil, err
+	}
+
+	for _, info := range s.swaps {
+		if info.RequestID != key.requestID ||
+			info.Tenant != key.tenant {
+
+			continue
+		}
+
+		info := info
+		request := &clientRequest{
+			swapType: info.SwapType,
+			response: existingSwapResponse(&info),
+		}
+		s.requests[key] = request
+
+		return request, nil
+	}
+
+	return nil, nil
+}
+
+// completeRequest releases the reservation for a request ID. If the request
+// created a swap, the response provided is stored so that it can be returned
+// for retries of the request. If it failed, the response should be nil so
+// that the request may be retried.
+func (s *swapClientServer) completeRequest(tenant, requestID string,
+	response *looprpc.SwapResponse) {
+
+	if requestID == "" {
+		return
+	}
+
+	s.requestsLock.Lock()
+	defer s.requestsLock.Unlock()
+
+	key := requestKey{
+		tenant:    tenant,
+		requestID: requestID,
+	}
+
+	if response == nil {
+		delete(s.requests, key)
+		return
+	}
+
+	// We store a copy of the response which is marked as an existing
+	// swap, since that is what we return for retries.
+	existing := proto.Clone(response).(*looprpc.SwapResponse)
+	existing.ExistingSwap = true
+
+	s.requests[key].response = existing
+}
+
+// approvalExpired returns a boolean indicating whether the response provided is
+// for a swap that was awaiting approval, and can no longer be approved.
+func approvalExpired(response *looprpc.SwapResponse, now time.Time) bool {
+	if response == nil || !response.PendingApproval {
+		return false
+	}
+
+	return now.Unix() > response.ApprovalExpiryUnix
+}
+
+// holdRequests marks the requests that are awaiting approval of the swap
+// provided as in progress while it is approved or denied, so that retries
+// cannot return a stale response or create another swap in the meantime. The
+// responses of the requests are returned so that they can be resolved once
+// the swap is approved or denied.
+func (s *swapClientServer) holdRequests(
+	hash lntypes.Hash) map[requestKey]*looprpc.SwapResponse {
+
+	s.requestsLock.Lock()
+	defer s.requestsLock.Unlock()
+
+	held := make(map[requestKey]*looprpc.SwapResponse)
+	for key, request := range s.requests {
+		response := request.response
+		if response == nil || !response.PendingApproval {
+			continue
+		}
+
+		if !bytes.Equal(response.IdBytes, hash[:]) {
+			continue
+		}
+
+		held[key] = response
+		request.response = nil
+	}
+
+	return held
+}
+
+// resolveRequests resolves requests that were held while their swap was
+// approved or denied. If the swap is still awaiting approval, the requests'
+// responses are restored. If it was approved, the response provided is stored
+// for retries. Otherwise, the swap will never be executed so the requests are
+// forgotten, and may be retried to create a new swap.
+func (s *swapClientServer) resolveRequests(
+	held map[requestKey]*looprpc.SwapResponse, stillPending bool,
+	approved *looprpc.SwapResponse) {
+
+	s.requestsLock.Lock()
+	defer s.requestsLock.Unlock()
+
+	for key, response := range held {
+		switch {
+		case stillPending:
+			s.requests[key].response = response
+
+		case approved != nil:
+			existing := proto.Clone(approved).(*looprpc.SwapResponse)
+			existing.ExistingSwap = true
+
+			s.requests[key].response = existing
+
+		default:
+			delete(s.requests, key)
+		}
+	}
+}
+
+// existingSwapResponse creates the response that we return for a swap that
+// was created for an earlier request with the same request ID.
+func existingSwapResponse(info *loop.SwapInfo) *looprpc.SwapResponse {
+	response := &looprpc.SwapResponse{
+		Id:      info.SwapHash.String(),
+		IdBytes: info.SwapHash[:],
+		StructuredServerMessage: marshallServerMessage(
+			info.ServerMessage,
+		),
+		ExistingSwap:  true,
+		HtlcAddresses: marshallHtlcAddresses(info.HtlcAddresses()),
+	}
+
+	if info.HtlcAddressP2WSH != nil {
+		response.HtlcAddressP2Wsh = info.HtlcAddressP2WSH.String()
+		response.HtlcAddress = response.HtlcAddressP2Wsh // nolint:staticcheck
+	}
+
+	if info.ExternalHtlc && info.HtlcAddressNP2WSH != nil {
+		response.HtlcAddressNp2Wsh = info.HtlcAddressNP2WSH.String()
+		response.HtlcAddress = response.HtlcAddressNp2Wsh // nolint:staticcheck
+	}
+
+	return response
+}
+
+// forgetRequests removes the requests that created the swap provided from the
+// requests that we track, so that their request IDs are no longer linked to
+// the swap once it is redacted.
+func (s *swapClientServer) forgetRequests(hash lntypes.Hash) {
+	s.requestsLock.Lock()
+	defer s.requestsLock.Unlock()
+
+	for key, request := range s.requests {
+		if request.response == nil {
+			continue
+		}
+
+		if bytes.Equal(request.response.IdBytes, hash[:]) {
+			delete(s.requests, key)
+		}
+	}
+}
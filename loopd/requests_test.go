@@ -0,0 +1,180 @@
+package loopd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRequestIDs tests that swap requests with client provided request IDs
+// are only processed once.
+func TestRequestIDs(t *testing.T) {
+	restoredHash := lntypes.Hash{1}
+
+	server := &swapClientServer{
+		swaps: map[lntypes.Hash]loop.SwapInfo{
+			restoredHash: {
+				SwapHash: restoredHash,
+				SwapType: swap.TypeOut,
+				SwapContract: loopdb.SwapContract{
+					RequestID: "restored",
+				},
+			},
+		},
+		requests: make(map[requestKey]*clientRequest),
+	}
+
+	// Requests without an ID are never reserved.
+	existing, err := server.reserveRequest("", "", swap.TypeOut)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	// The first request with an ID reserves it, so that a concurrent
+	// request with the same ID fails.
+	existing, err = server.reserveRequest("", "request", swap.TypeOut)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	_, err = server.reserveRequest("", "request", swap.TypeOut)
+	require.Equal(t, errRequestInProgress, err)
+
+	// If the request fails, it may be retried.
+	server.completeRequest("", "request", nil)
+
+	existing, err = server.reserveRequest("", "request", swap.TypeOut)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	// Once the request succeeds, retries return its swap.
+	hash := lntypes.Hash{2}
+	server.completeRequest("", "request", &looprpc.SwapResponse{
+		IdBytes: hash[:],
+	})
+
+	existing, err = server.reserveRequest("", "request", swap.TypeOut)
+	require.NoError(t, err)
+	require.Equal(t, hash[:], existing.IdBytes)
+	require.True(t, existing.ExistingSwap)
+
+	// Using the request ID for the other type of swap should fail.
+	_, err = server.reserveRequest("", "request", swap.TypeIn)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Swaps that were created with a request ID before we restarted
+	// should also be returned.
+	existing, err = server.reserveRequest("", "restored", swap.TypeOut)
+	require.NoError(t, err)
+	require.Equal(t, restoredHash[:], existing.IdBytes)
+	require.True(t, existing.ExistingSwap)
+
+	// Request IDs are scoped to their tenant, so other tenants may reuse
+	// them.
+	existing, err = server.reserveRequest("tenant", "request", swap.TypeIn)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	existing, err = server.reserveRequest("tenant", "restored", swap.TypeOut)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+}
+
+// TestPendingApprovalRequests tests that requests for swaps that are awaiting
+// approval may only be retried to create a new swap once the swap is denied or
+// expires.
+func TestPendingApprovalRequests(t *testing.T) {
+	server := &swapClientServer{
+		swaps:    make(map[lntypes.Hash]loop.SwapInfo),
+		requests: make(map[requestKey]*clientRequest),
+	}
+
+	now := time.Now()
+
+	// pending adds a request that created a swap which is awaiting
+	// approval until the expiry provided.
+	pending := func(requestID string, hash lntypes.Hash,
+		expiry time.Time) {
+
+		existing, err := server.reserveRequest(
+			"", requestID, swap.TypeOut,
+		)
+		require.NoError(t, err)
+		require.Nil(t, existing)
+
+		server.completeRequest("", requestID, &looprpc.SwapResponse{
+			IdBytes:            hash[:],
+			PendingApproval:    true,
+			ApprovalExpiryUnix: expiry.Unix(),
+		})
+	}
+
+	// assertNew asserts that a retry of a request creates a new swap.
+	assertNew := func(requestID string) {
+		existing, err := server.reserveRequest(
+			"", requestID, swap.TypeOut,
+		)
+		require.NoError(t, err)
+		require.Nil(t, existing)
+
+		server.completeRequest("", requestID, nil)
+	}
+
+	// Retries of a request for a swap that is awaiting approval return
+	// the swap, until it expires.
+	expiredHash := lntypes.Hash{1}
+	pending("expired", expiredHash, now.Add(time.Hour))
+
+	existing, err := server.reserveRequest("", "expired", swap.TypeOut)
+	require.NoError(t, err)
+	require.Equal(t, expiredHash[:], existing.IdBytes)
+	require.True(t, existing.PendingApproval)
+
+	server.requests[requestKey{requestID: "expired"}].response.
+		ApprovalExpiryUnix = now.Add(-time.Second).Unix()
+
+	assertNew("expired")
+
+	// While a swap is being approved or denied, retries are in progress.
+	deniedHash := lntypes.Hash{2}
+	pending("denied", deniedHash, now.Add(time.Hour))
+
+	held := server.holdRequests(deniedHash)
+	_, err = server.reserveRequest("", "denied", swap.TypeOut)
+	require.Equal(t, errRequestInProgress, err)
+
+	// If the swap is still awaiting approval afterwards, retries return
+	// it again.
+	server.resolveRequests(held, true, nil)
+
+	existing, err = server.reserveRequest("", "denied", swap.TypeOut)
+	require.NoError(t, err)
+	require.True(t, existing.PendingApproval)
+
+	// Once the swap is denied, the request may be retried.
+	held = server.holdRequests(deniedHash)
+	server.resolveRequests(held, false, nil)
+
+	assertNew("denied")
+
+	// Once a swap is approved, retries return the approved swap.
+	approvedHash := lntypes.Hash{3}
+	pending("approved", approvedHash, now.Add(time.Hour))
+
+	held = server.holdRequests(approvedHash)
+	server.resolveRequests(held, false, &looprpc.SwapResponse{
+		IdBytes: approvedHash[:],
+	})
+
+	existing, err = server.reserveRequest("", "approved", swap.TypeOut)
+	require.NoError(t, err)
+	require.Equal(t, approvedHash[:], existing.IdBytes)
+	require.False(t, existing.PendingApproval)
+	require.True(t, existing.ExistingSwap)
+}
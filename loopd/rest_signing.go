@@ -0,0 +1,228 @@
+package loopd
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// restTimestampHeader is the header that holds the unix timestamp in
+	// seconds at which a signed REST request was created.
+	restTimestampHeader = "X-Loop-Timestamp"
+
+	// restNonceHeader is the header that holds a unique value for each
+	// signed REST request.
+	restNonceHeader = "X-Loop-Nonce"
+
+	// restSignatureHeader is the header that holds the hex encoded
+	// HMAC-SHA256 signature of a REST request.
+	restSignatureHeader = "X-Loop-Signature"
+
+	// maxRESTNonceLength is the maximum length of a signed request's
+	// nonce.
+	maxRESTNonceLength = 64
+
+	// minRESTSigningKeyLength is the minimum length in bytes of the key
+	// that REST requests are signed with.
+	minRESTSigningKeyLength = 32
+
+	// maxSignedRequestSize is the maximum size of the body of a signed
+	// REST request.
+	maxSignedRequestSize = 1 << 20
+
+	// defaultRESTSigningWindow is the default amount of time that a signed
+	// REST request's timestamp may differ from our clock by.
+	defaultRESTSigningWindow = 5 * time.Minute
+)
+
+var (
+	// errRESTSignatureMissing is returned when a state-changing REST
+	// request is not signed.
+	errRESTSignatureMissing = errors.New("request signature required")
+
+	// errRESTSignatureInvalid is returned when a REST request's signature
+	// does not match its contents.
+	errRESTSignatureInvalid = errors.New("invalid request signature")
+
+	// errRESTRequestExpired is returned when a signed REST request's
+	// timestamp is outside of our signing window.
+	errRESTRequestExpired = errors.New("request timestamp outside of " +
+		"signing window")
+
+	// errRESTNonceReused is returned when a signed REST request reuses
+	// the nonce of a request that we have already accepted.
+	errRESTNonceReused = errors.New("request nonce already used")
+)
+
+// restSigner verifies the signatures of state-changing REST requests, so that
+// requests which are intercepted cannot be replayed.
+type restSigner struct {
+	// key is the secret that requests are signed with.
+	key []byte
+
+	// window is the amount of time that a request's timestamp may differ
+	// from our clock by.
+	window time.Duration
+
+	// now returns the current time.
+	now func() time.Time
+
+	// nonces holds the nonces of the requests that we have accepted within
+	// our signing window, along with the time they expire at.
+	nonces map[string]time.Time
+	lock   sync.Mutex
+}
+
+// newRESTSigner creates a verifier for requests signed with the key provided.
+func newRESTSigner(key []byte, window time.Duration) *restSigner {
+	return &restSigner{
+		key:    key,
+		window: window,
+		now:    time.Now,
+		nonces: make(map[string]time.Time),
+	}
+}
+
+// loadRESTSigningKey reads the hex encoded REST signing key stored in the file
+// provided.
+func loadRESTSigningKey(path string) ([]byte, error) {
+	keyHex, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read REST signing key: %v",
+			err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(keyHex)))
+	if err != nil {
+		return nil, fmt.Errorf("REST signing key must be hex "+
+			"encoded: %v", err)
+	}
+
+	if len(key) < minRESTSigningKeyLength {
+		return nil, fmt.Errorf("REST signing key must be at least %v "+
+			"bytes", minRESTSigningKeyLength)
+	}
+
+	return key, nil
+}
+
+// restSignature returns the hex encoded signature of a REST request. The
+// signature commits to the request's timestamp, nonce, method, uri and body,
+// each separated by a newline.
+func restSignature(key []byte, timestamp, nonce, method, uri string,
+	body []byte) string {
+
+	mac := hmac.New(sha256.New, key)
+	for _, part := range []string{timestamp, nonce, method, uri} {
+		_, _ = mac.Write([]byte(part))
+		_, _ = mac.Write([]byte{'\n'})
+	}
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// requiresSignature returns a boolean indicating whether requests with the
+// method provided change state, and must therefore be signed.
+func requiresSignature(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+
+	default:
+		return true
+	}
+}
+
+// verify checks the signature, timestamp and nonce of a request with the body
+// provided.
+func (r *restSigner) verify(req *http.Request, body []byte) error {
+	timestamp := req.Header.Get(restTimestampHeader)
+	nonce := req.Header.Get(restNonceHeader)
+	signature := req.Header.Get(restSignatureHeader)
+
+	if timestamp == "" || nonce == "" || signature == "" {
+		return errRESTSignatureMissing
+	}
+
+	if len(nonce) > maxRESTNonceLength {
+		return fmt.Errorf("nonce may not exceed %v characters",
+			maxRESTNonceLength)
+	}
+
+	expected := restSignature(
+		r.key, timestamp, nonce, req.Method, req.URL.RequestURI(),
+		body,
+	)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errRESTSignatureInvalid
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %v", err)
+	}
+
+	now := r.now()
+	created := time.Unix(unix, 0)
+	if created.Before(now.Add(-r.window)) ||
+		created.After(now.Add(r.window)) {
+
+		return errRESTRequestExpired
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// Remove the nonces of requests that are outside of our window, since
+	// they would be rejected by their timestamp anyway.
+	for seen, expiry := range r.nonces {
+		if now.After(expiry) {
+			delete(r.nonces, seen)
+		}
+	}
+
+	if _, ok := r.nonces[nonce]; ok {
+		return errRESTNonceReused
+	}
+	r.nonces[nonce] = created.Add(r.window)
+
+	return nil
+}
+
+// handler wraps a REST handler so that state-changing requests are only
+// passed on if they are correctly signed.
+func (r *restSigner) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !requiresSignature(req.Method) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		body, err := ioutil.ReadAll(
+			http.MaxBytesReader(w, req.Body, maxSignedRequestSize),
+		)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := r.verify(req, body); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, req)
+	})
+}
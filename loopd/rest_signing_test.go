@@ -0,0 +1,95 @@
+package loopd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRESTSigner tests verification of signed REST requests.
+func TestRESTSigner(t *testing.T) {
+	var (
+		key    = bytes.Repeat([]byte{1}, minRESTSigningKeyLength)
+		now    = time.Unix(1000000, 0)
+		body   = []byte(`{"amt":"100000"}`)
+		uri    = "/v1/loop/out"
+		window = time.Minute
+	)
+
+	signer := newRESTSigner(key, window)
+	signer.now = func() time.Time {
+		return now
+	}
+
+	var received []byte
+	handler := signer.handler(http.HandlerFunc(
+		func(w http.ResponseWriter, req *http.Request) {
+			var err error
+			received, err = ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+		},
+	))
+
+	// send makes a request to our handler and returns its status code.
+	send := func(method string, created time.Time, nonce string,
+		signKey []byte) int {
+
+		req := httptest.NewRequest(method, uri, bytes.NewReader(body))
+
+		if signKey != nil {
+			timestamp := strconv.FormatInt(created.Unix(), 10)
+
+			req.Header.Set(restTimestampHeader, timestamp)
+			req.Header.Set(restNonceHeader, nonce)
+			req.Header.Set(restSignatureHeader, restSignature(
+				signKey, timestamp, nonce, method, uri, body,
+			))
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+
+		return recorder.Code
+	}
+
+	// Requests that don't change state do not need to be signed.
+	require.Equal(t, http.StatusOK, send(http.MethodGet, now, "", nil))
+
+	require.Equal(
+		t, http.StatusUnauthorized, send(http.MethodPost, now, "", nil),
+	)
+
+	otherKey := bytes.Repeat([]byte{2}, minRESTSigningKeyLength)
+	require.Equal(
+		t, http.StatusUnauthorized,
+		send(http.MethodPost, now, "1", otherKey),
+	)
+
+	require.Equal(
+		t, http.StatusUnauthorized,
+		send(http.MethodPost, now.Add(-window*2), "1", key),
+	)
+
+	received = nil
+	require.Equal(t, http.StatusOK, send(http.MethodPost, now, "1", key))
+	require.Equal(t, body, received)
+
+	// Replaying a request with the same nonce should fail.
+	require.Equal(
+		t, http.StatusUnauthorized, send(http.MethodPost, now, "1", key),
+	)
+
+	require.Equal(t, http.StatusOK, send(http.MethodPost, now, "2", key))
+
+	// Once our window has passed, old nonces are removed since requests
+	// that used them would have expired.
+	now = now.Add(window * 2)
+	require.Equal(t, http.StatusOK, send(http.MethodPost, now, "3", key))
+	require.Len(t, signer.nonces, 1)
+}
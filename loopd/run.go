@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"net"
 	"os"
+	ossignal "os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/logging"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
@@ -59,7 +62,7 @@ func newListenerCfg(config *Config, rpcCfg RPCConfig) *listenerCfg {
 				return rpcCfg.RPCListener, nil
 			}
 
-			listener, err := net.Listen("tcp", config.RPCListen)
+			listener, err := listenRPC(config.RPCListen)
 			if err != nil {
 				return nil, err
 			}
@@ -72,7 +75,7 @@ func newListenerCfg(config *Config, rpcCfg RPCConfig) *listenerCfg {
 				return nil, nil
 			}
 
-			listener, err := net.Listen("tcp", config.RESTListen)
+			listener, err := listenRPC(config.RESTListen)
 			if err != nil {
 				return nil, err
 			}
@@ -131,8 +134,10 @@ func newListenerCfg(config *Config, rpcCfg RPCConfig) *listenerCfg {
 	}
 }
 
-// Run starts the loop daemon and blocks until it's shut down again.
-func Run(rpcCfg RPCConfig) error {
+// loadConfig parses our command line flags and config file into a new config.
+// Command line flags take precedence over values set in the config file. The
+// parser is returned so that the caller can check which command was run.
+func loadConfig() (*Config, *flags.Parser, error) {
 	config := DefaultConfig()
 
 	// Parse command line flags.
@@ -140,11 +145,8 @@ func Run(rpcCfg RPCConfig) error {
 	parser.SubcommandsOptional = true
 
 	_, err := parser.Parse()
-	if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
-		return nil
-	}
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	// Parse ini file.
@@ -159,13 +161,41 @@ func Run(rpcCfg RPCConfig) error {
 		// operations in data directory would also fail so we treat it
 		// as early detection of a problem.
 		if hasExplicitConfig || !os.IsNotExist(err) {
-			return err
+			return nil, nil, err
 		}
 	}
 
 	// Parse command line flags again to restore flags overwritten by ini
 	// parse.
 	_, err = parser.Parse()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &config, parser, nil
+}
+
+// readConfig reads and validates our config again, so that the daemon can
+// apply the options that may be changed without a restart.
+func readConfig() (*Config, error) {
+	config, _, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Validate(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// Run starts the loop daemon and blocks until it's shut down again.
+func Run(rpcCfg RPCConfig) error {
+	config, parser, err := loadConfig()
+	if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -186,10 +216,16 @@ func Run(rpcCfg RPCConfig) error {
 	}
 
 	// Validate our config before we proceed.
-	if err := Validate(&config); err != nil {
+	if err := Validate(config); err != nil {
 		return err
 	}
 
+	// If we were asked to check the health of a running daemon, we do so
+	// and exit without starting one.
+	if config.HealthCheckExec != "" {
+		return runHealthCheck(config)
+	}
+
 	// Start listening for signal interrupts regardless of which command
 	// we are running. When our command tries to get a lnd connection, it
 	// blocks until lnd is synced. We listen for interrupts so that we can
@@ -201,49 +237,93 @@ func Run(rpcCfg RPCConfig) error {
 
 	// Initialize logging at the default logging level.
 	logWriter := build.NewRotatingLogWriter()
-	SetupLoggers(logWriter, shutdownInterceptor)
+	logFile := filepath.Join(config.LogDir, defaultLogFilename)
+
+	switch config.LogFormat {
+	// Structured loggers write to their own rotating file, and only use
+	// our log writer to keep track of their levels.
+	case logFormatJSON:
+		jsonWriter, err := logging.NewRotatingWriter(
+			logFile, config.MaxLogFileSize, config.MaxLogFiles,
+		)
+		if err != nil {
+			return err
+		}
 
-	err = logWriter.InitLogRotator(
-		filepath.Join(config.LogDir, defaultLogFilename),
-		config.MaxLogFileSize, config.MaxLogFiles,
-	)
-	if err != nil {
-		return err
+		SetupJSONLoggers(
+			logWriter, logging.NewJSONBackend(jsonWriter),
+			shutdownInterceptor,
+		)
+
+	default:
+		SetupLoggers(logWriter, shutdownInterceptor)
+
+		err = logWriter.InitLogRotator(
+			logFile, config.MaxLogFileSize, config.MaxLogFiles,
+		)
+		if err != nil {
+			return err
+		}
 	}
+
 	err = build.ParseAndSetDebugLevels(config.DebugLevel, logWriter)
 	if err != nil {
 		return err
 	}
 
+	logging.DefaultThrottle.SetInterval(config.LogThrottleInterval)
+
 	// Print the version before executing either primary directive.
 	log.Infof("Version: %v", loop.Version())
 
-	lisCfg := newListenerCfg(&config, rpcCfg)
+	lisCfg := newListenerCfg(config, rpcCfg)
 
 	// Execute command.
 	if parser.Active == nil {
-		daemon := New(&config, lisCfg)
+		daemon := New(config, lisCfg)
+		daemon.loadConfig = readConfig
 		if err := daemon.Start(); err != nil {
 			return err
 		}
 
-		select {
-		case <-interceptor.ShutdownChannel():
-			log.Infof("Received SIGINT (Ctrl+C).")
-			daemon.Stop()
+		// Reload our config when we receive SIGHUP, so that operators
+		// can change minor options without restarting.
+		reload := make(chan os.Signal, 1)
+		ossignal.Notify(reload, syscall.SIGHUP)
+		defer ossignal.Stop(reload)
+
+		for {
+			select {
+			case <-reload:
+				log.Infof("Received SIGHUP, reloading config")
+
+				_, err := daemon.reloadConfig()
+				if err != nil {
+					log.Errorf("Unable to reload config: %v",
+						err)
+				}
+
+			case <-interceptor.ShutdownChannel():
+				log.Infof("Received SIGINT (Ctrl+C).")
+				daemon.Stop()
 
-			// The above stop will return immediately. But we'll be
-			// notified on the error channel once the process is
-			// complete.
-			return <-daemon.ErrChan
+				// The above stop will return immediately. But
+				// we'll be notified on the error channel once
+				// the process is complete.
+				return <-daemon.ErrChan
 
-		case err := <-daemon.ErrChan:
-			return err
+			case err := <-daemon.ErrChan:
+				return err
+			}
 		}
 	}
 
 	if parser.Active.Name == "view" {
-		return view(&config, lisCfg)
+		return view(config, lisCfg)
+	}
+
+	if parser.Active.Name == "import" {
+		return importSwaps(config)
 	}
 
 	return fmt.Errorf("unimplemented command %v", parser.Active.Name)
@@ -0,0 +1,310 @@
+package loopd
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// statusLinkKeyFilename is the name of the file in our data directory
+	// that holds the key that our status links are signed with.
+	statusLinkKeyFilename = "statuslink.key"
+
+	// statusLinkKeyLength is the length of the key that our status links
+	// are signed with.
+	statusLinkKeyLength = 32
+
+	// statusLinkPrefix is the path on our REST proxy that swap statuses
+	// are served at, followed by the token of a status link.
+	statusLinkPrefix = "/v1/loop/status/"
+
+	// statusLinkTokenLength is the length of a decoded status link token,
+	// which holds a swap hash, an expiry timestamp and a signature.
+	statusLinkTokenLength = lntypes.HashSize + 8 + sha256.Size
+
+	// defaultStatusLinkExpiry is the amount of time that status links are
+	// valid for if no expiry is requested.
+	defaultStatusLinkExpiry = 24 * time.Hour
+
+	// maxStatusLinkExpiry is the longest amount of time that a status
+	// link may be valid for.
+	maxStatusLinkExpiry = 30 * 24 * time.Hour
+)
+
+var (
+	// errStatusLinkInvalid is returned when a status link's token is
+	// malformed or its signature does not match.
+	errStatusLinkInvalid = errors.New("invalid status link")
+
+	// errStatusLinkExpired is returned when a status link has expired.
+	errStatusLinkExpired = errors.New("status link expired")
+)
+
+// statusLinks mints and verifies the tokens of links that grant read-only
+// access to the status of a single swap. A token holds the swap's hash and
+// the time the link expires at, signed with a key that only we hold, so that
+// links can be verified without being stored.
+type statusLinks struct {
+	// key is the secret that our tokens are signed with.
+	key []byte
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// newStatusLinks creates a status link signer with the key stored in the
+// directory provided, creating the key if it does not exist yet. Deleting
+// the key revokes all the links that were signed with it.
+func newStatusLinks(dir string) (*statusLinks, error) {
+	path := filepath.Join(dir, statusLinkKeyFilename)
+
+	var (
+		key []byte
+		err error
+	)
+	if lnrpc.FileExists(path) {
+		key, err = ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(key) != statusLinkKeyLength {
+			return nil, fmt.Errorf("status link key %v has length "+
+				"%v, expected %v", path, len(key),
+				statusLinkKeyLength)
+		}
+	} else {
+		key = make([]byte, statusLinkKeyLength)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+
+		if err := ioutil.WriteFile(path, key, 0600); err != nil {
+			return nil, err
+		}
+	}
+
+	return &statusLinks{
+		key: key,
+		now: time.Now,
+	}, nil
+}
+
+// signature returns the signature of a token for the swap and expiry
+// provided.
+func (l *statusLinks) signature(hash lntypes.Hash, expiry []byte) []byte {
+	mac := hmac.New(sha256.New, l.key)
+	mac.Write(hash[:])
+	mac.Write(expiry)
+
+	return mac.Sum(nil)
+}
+
+// mint returns the token of a link to the swap provided that is valid until
+// the expiry provided.
+func (l *statusLinks) mint(hash lntypes.Hash, expiry time.Time) string {
+	var expiryBytes [8]byte
+	binary.BigEndian.PutUint64(expiryBytes[:], uint64(expiry.Unix()))
+
+	token := make([]byte, 0, statusLinkTokenLength)
+	token = append(token, hash[:]...)
+	token = append(token, expiryBytes[:]...)
+	token = append(token, l.signature(hash, expiryBytes[:])...)
+
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// verify checks that a token was signed by us and has not expired, and
+// returns the hash of the swap that it grants access to.
+func (l *statusLinks) verify(token string) (lntypes.Hash, error) {
+	var hash lntypes.Hash
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(decoded) != statusLinkTokenLength {
+		return hash, errStatusLinkInvalid
+	}
+
+	copy(hash[:], decoded[:lntypes.HashSize])
+	expiry := decoded[lntypes.HashSize : lntypes.HashSize+8]
+	signature := decoded[lntypes.HashSize+8:]
+
+	if !hmac.Equal(signature, l.signature(hash, expiry)) {
+		return hash, errStatusLinkInvalid
+	}
+
+	expiryUnix := int64(binary.BigEndian.Uint64(expiry))
+	if l.now().Unix() >= expiryUnix {
+		return hash, errStatusLinkExpired
+	}
+
+	return hash, nil
+}
+
+// handler serves the status of the swaps that our status links grant access
+// to, passing all other requests on to the handler provided. The statuses
+// are looked up with the function provided, which bypasses our macaroons,
+// so only the fields of publicSwapStatus are served.
+func (l *statusLinks) handler(next http.Handler,
+	lookup func(lntypes.Hash) (*looprpc.SwapStatus, error)) http.Handler {
+
+	marshaler := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.HasPrefix(req.URL.Path, statusLinkPrefix) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		if req.Method != http.MethodGet {
+			http.Error(
+				w, "status links are read-only",
+				http.StatusMethodNotAllowed,
+			)
+			return
+		}
+
+		token := strings.TrimPrefix(req.URL.Path, statusLinkPrefix)
+		hash, err := l.verify(token)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		swapStatus, err := lookup(hash)
+		if err != nil {
+			log.Debugf("Status link lookup of swap %v failed: %v",
+				hash, err)
+
+			http.Error(w, "swap not found", http.StatusNotFound)
+			return
+		}
+
+		resp, err := marshaler.Marshal(publicSwapStatus(swapStatus))
+		if err != nil {
+			http.Error(
+				w, err.Error(), http.StatusInternalServerError,
+			)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = w.Write(resp)
+	})
+}
+
+// publicSwapStatus returns the parts of a swap's status that are served
+// through status links. Its costs, addresses, label and the identities of the
+// people involved in the swap are left out, since links may be shared with
+// anyone.
+func publicSwapStatus(swapStatus *looprpc.SwapStatus) *looprpc.SwapStatus {
+	return &looprpc.SwapStatus{
+		Amt:                    swapStatus.Amt,
+		Id:                     swapStatus.Id,
+		IdBytes:                swapStatus.IdBytes,
+		Type:                   swapStatus.Type,
+		State:                  swapStatus.State,
+		FailureReason:          swapStatus.FailureReason,
+		InitiationTime:         swapStatus.InitiationTime,
+		LastUpdateTime:         swapStatus.LastUpdateTime,
+		ExpectedCompletionTime: swapStatus.ExpectedCompletionTime,
+		Health:                 swapStatus.Health,
+		HtlcConfirmations:      swapStatus.HtlcConfirmations,
+		HtlcTxid:               swapStatus.HtlcTxid,
+	}
+}
+
+// linkedSwapStatus returns the status of a swap that a status link grants
+// access to.
+func (s *swapClientServer) linkedSwapStatus(hash lntypes.Hash) (
+	*looprpc.SwapStatus, error) {
+
+	swp, ok, err := s.knownSwap(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("swap %v not found", hash)
+	}
+
+	return s.marshallSwap(&swp)
+}
+
+// GetStatusLink mints a signed, expiring link that grants read-only access to
+// the status of a single swap over our REST proxy.
+func (s *swapClientServer) GetStatusLink(ctx context.Context,
+	req *looprpc.StatusLinkRequest) (*looprpc.StatusLinkResponse, error) {
+
+	if s.statusLinks == nil {
+		return nil, status.Error(
+			codes.Unavailable, "status links are not available",
+		)
+	}
+
+	hash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	expiry := defaultStatusLinkExpiry
+	if req.ExpirySec != 0 {
+		expiry = time.Duration(req.ExpirySec) * time.Second
+	}
+
+	if expiry > maxStatusLinkExpiry {
+		return nil, status.Errorf(codes.InvalidArgument, "status "+
+			"links may not be valid for more than %v",
+			maxStatusLinkExpiry)
+	}
+
+	tenant, err := s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tenants may only share links to their own swaps, so swaps of other
+	// tenants are reported as unknown.
+	swp, ok, err := s.knownSwap(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok || !visibleTo(&swp, tenant) {
+		return nil, status.Errorf(codes.NotFound, "swap %v not found",
+			hash)
+	}
+
+	expiresAt := s.statusLinks.now().Add(expiry)
+	token := s.statusLinks.mint(hash, expiresAt)
+
+	log.Infof("Status link for swap %v minted, valid until %v", hash,
+		expiresAt)
+
+	return &looprpc.StatusLinkResponse{
+		Token:      token,
+		Path:       statusLinkPrefix + token,
+		ExpiryUnix: expiresAt.Unix(),
+	}, nil
+}
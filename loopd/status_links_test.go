@@ -0,0 +1,134 @@
+package loopd
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// TestStatusLinks tests that status links grant read-only access to the
+// public status of the swap that they were minted for until they expire.
+func TestStatusLinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "statuslinks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	links, err := newStatusLinks(dir)
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	links.now = func() time.Time {
+		return now
+	}
+
+	hash := lntypes.Hash{1}
+	token := links.mint(hash, now.Add(time.Hour))
+
+	verified, err := links.verify(token)
+	require.NoError(t, err)
+	require.Equal(t, hash, verified)
+
+	// Our key is kept, so links remain valid once we restart.
+	reopened, err := newStatusLinks(dir)
+	require.NoError(t, err)
+	reopened.now = links.now
+
+	verified, err = reopened.verify(token)
+	require.NoError(t, err)
+	require.Equal(t, hash, verified)
+
+	// A link can't be moved to another swap.
+	other := links.mint(lntypes.Hash{2}, now.Add(time.Hour))
+	forged := other[:2] + token[2:]
+	_, err = links.verify(forged)
+	require.Equal(t, errStatusLinkInvalid, err)
+
+	_, err = links.verify("invalid")
+	require.Equal(t, errStatusLinkInvalid, err)
+
+	swapStatus := &looprpc.SwapStatus{
+		Amt:          100000,
+		IdBytes:      hash[:],
+		State:        looprpc.SwapState_HTLC_PUBLISHED,
+		Label:        "customer",
+		CostServer:   100,
+		HtlcAddress:  "address",
+		HtlcTxid:     "txid",
+		ApprovedBy:   "approver",
+		RequestId:    "request",
+		HealthReason: "reason",
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	handler := links.handler(next, func(h lntypes.Hash) (
+		*looprpc.SwapStatus, error) {
+
+		require.Equal(t, hash, h)
+		return swapStatus, nil
+	})
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	// Only the public parts of the swap's status are served.
+	rec := serve(http.MethodGet, statusLinkPrefix+token)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	served := &looprpc.SwapStatus{}
+	require.NoError(t, protojson.Unmarshal(rec.Body.Bytes(), served))
+	require.Equal(t, int64(100000), served.Amt)
+	require.Equal(t, looprpc.SwapState_HTLC_PUBLISHED, served.State)
+	require.Equal(t, "txid", served.HtlcTxid)
+	require.Empty(t, served.Label)
+	require.Empty(t, served.CostServer)
+	require.Empty(t, served.HtlcAddress)
+	require.Empty(t, served.ApprovedBy)
+	require.Empty(t, served.RequestId)
+	require.Empty(t, served.HealthReason)
+
+	// Links are read-only, and forged links are rejected.
+	rec = serve(http.MethodPost, statusLinkPrefix+token)
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = serve(http.MethodGet, statusLinkPrefix+forged)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// All other requests are passed on.
+	rec = serve(http.MethodGet, "/v1/loop/swaps")
+	require.Equal(t, http.StatusTeapot, rec.Code)
+
+	// Once a link expires, it is rejected.
+	now = now.Add(time.Hour)
+	_, err = links.verify(token)
+	require.Equal(t, errStatusLinkExpired, err)
+
+	rec = serve(http.MethodGet, statusLinkPrefix+token)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Once our key is deleted, all links are revoked.
+	require.NoError(t, os.Remove(
+		filepath.Join(dir, statusLinkKeyFilename),
+	))
+
+	rotated, err := newStatusLinks(dir)
+	require.NoError(t, err)
+
+	_, err = rotated.verify(other)
+	require.Equal(t, errStatusLinkInvalid, err)
+}
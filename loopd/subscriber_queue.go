@@ -0,0 +1,194 @@
+package loopd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/loop/metrics"
+)
+
+const (
+	// overflowUnbounded grows a subscriber's buffer without bounds, so
+	// that no updates are lost however slowly the subscriber reads them.
+	overflowUnbounded = "unbounded"
+
+	// overflowDropOldest drops the oldest update in a subscriber's buffer
+	// to make room for a new update when the buffer is full.
+	overflowDropOldest = "drop-oldest"
+
+	// overflowBlock waits for a subscriber to read from its buffer when
+	// the buffer is full, which holds back updates to all subscribers.
+	overflowBlock = "block"
+
+	// defaultMonitorBuffer is the default number of swap updates that we
+	// buffer for each subscriber.
+	defaultMonitorBuffer = 100
+
+	// droppedUpdatesMeasurement is the measurement that we record the swap
+	// updates that were dropped for slow subscribers under.
+	droppedUpdatesMeasurement = "loop_dropped_updates"
+)
+
+// subscriberQueue is a buffer of the swap updates for a single subscriber.
+// Unless its overflow policy is unbounded, the buffer is bounded, so that a
+// subscriber that reads its updates slowly can't cause our memory usage to
+// grow without bounds. When a bounded buffer is full, the oldest update is
+// either dropped or we wait for the subscriber to catch up, depending on our
+// overflow policy.
+type subscriberQueue struct {
+	// capacity is the maximum number of updates that we buffer.
+	capacity int
+
+	// policy is the overflow policy that applies when our buffer is full.
+	policy string
+
+	// onDrop is called whenever an update is dropped, if it is non-nil.
+	onDrop func()
+
+	// items holds the updates that the subscriber has not read yet.
+	items []interface{}
+	mu    sync.Mutex
+
+	// ready is signaled when updates are added to our buffer.
+	ready chan struct{}
+
+	// space is signaled when updates are read from our buffer.
+	space chan struct{}
+
+	// quit is closed once the subscriber no longer reads its updates.
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+// newSubscriberQueue creates a subscriber queue that buffers up to capacity
+// updates before the overflow policy provided applies. The capacity is
+// ignored if the policy is unbounded.
+func newSubscriberQueue(capacity int, policy string,
+	onDrop func()) *subscriberQueue {
+
+	return &subscriberQueue{
+		capacity: capacity,
+		policy:   policy,
+		onDrop:   onDrop,
+		ready:    make(chan struct{}, 1),
+		space:    make(chan struct{}, 1),
+		quit:     make(chan struct{}),
+	}
+}
+
+// signalQueue notifies a waiter on the channel provided without blocking.
+func signalQueue(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+// push adds an update to our buffer. If our buffer is full and our overflow
+// policy is to block, push waits until the subscriber reads its updates, the
+// subscriber is stopped or the context provided is canceled.
+func (q *subscriberQueue) push(ctx context.Context, item interface{}) error {
+	for {
+		q.mu.Lock()
+		switch {
+		case len(q.items) < q.capacity ||
+			q.policy == overflowUnbounded:
+
+			q.items = append(q.items, item)
+			q.mu.Unlock()
+
+			signalQueue(q.ready)
+
+			return nil
+
+		case q.policy != overflowBlock:
+			copy(q.items, q.items[1:])
+			q.items[len(q.items)-1] = item
+			q.mu.Unlock()
+
+			if q.onDrop != nil {
+				q.onDrop()
+			}
+			signalQueue(q.ready)
+
+			return nil
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-q.space:
+
+		// If the subscriber is gone, there is no one to deliver the
+		// update to.
+		case <-q.quit:
+			return nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// updates returns a channel that is signaled when updates are available to
+// be read with drain.
+func (q *subscriberQueue) updates() <-chan struct{} {
+	return q.ready
+}
+
+// drain returns all of the updates in our buffer, oldest first, and empties
+// the buffer.
+func (q *subscriberQueue) drain() []interface{} {
+	q.mu.Lock()
+	items := q.items
+	q.items = nil
+	q.mu.Unlock()
+
+	signalQueue(q.space)
+
+	return items
+}
+
+// stop signals that the subscriber no longer reads its updates, so that we no
+// longer wait for it to make space in its buffer.
+func (q *subscriberQueue) stop() {
+	q.quitOnce.Do(func() {
+		close(q.quit)
+	})
+}
+
+// newSubscriber creates the update queue for a new subscriber of the kind
+// provided, which is used to label the metrics of the updates that are
+// dropped for it.
+func (s *swapClientServer) newSubscriber(kind string) *subscriberQueue {
+	capacity := s.monitorBuffer
+	if capacity <= 0 {
+		capacity = defaultMonitorBuffer
+	}
+
+	policy := s.monitorOverflow
+	if policy == "" {
+		policy = overflowUnbounded
+	}
+
+	onDrop := func() {
+		log.Warnf("Dropped swap update for slow %v subscriber", kind)
+
+		if s.metrics == nil {
+			return
+		}
+
+		s.metrics.Record(&metrics.Point{
+			Measurement: droppedUpdatesMeasurement,
+			Tags: map[string]string{
+				"subscriber": kind,
+			},
+			Fields: map[string]interface{}{
+				"dropped": int64(1),
+			},
+			Time: time.Now(),
+		})
+	}
+
+	return newSubscriberQueue(capacity, policy, onDrop)
+}
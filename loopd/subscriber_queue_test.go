@@ -0,0 +1,98 @@
+package loopd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubscriberQueueUnbounded tests that an unbounded subscriber queue keeps
+// all of its updates, regardless of its capacity.
+func TestSubscriberQueueUnbounded(t *testing.T) {
+	q := newSubscriberQueue(2, overflowUnbounded, func() {
+		t.Fatalf("unexpected drop")
+	})
+
+	ctx := context.Background()
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, q.push(ctx, i))
+	}
+
+	<-q.updates()
+	require.Equal(t, []interface{}{1, 2, 3, 4}, q.drain())
+}
+
+// TestSubscriberQueueDropOldest tests that a full subscriber queue drops its
+// oldest updates when it is set to drop them.
+func TestSubscriberQueueDropOldest(t *testing.T) {
+	var dropped int
+	q := newSubscriberQueue(2, overflowDropOldest, func() {
+		dropped++
+	})
+
+	ctx := context.Background()
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, q.push(ctx, i))
+	}
+
+	<-q.updates()
+	require.Equal(t, []interface{}{3, 4}, q.drain())
+	require.Equal(t, 2, dropped)
+
+	// Once the subscriber has read its updates, there is room for new
+	// updates again.
+	require.NoError(t, q.push(ctx, 5))
+	require.Equal(t, []interface{}{5}, q.drain())
+	require.Equal(t, 2, dropped)
+}
+
+// TestSubscriberQueueBlock tests that a full subscriber queue waits for the
+// subscriber to read its updates when it is set to block.
+func TestSubscriberQueueBlock(t *testing.T) {
+	q := newSubscriberQueue(1, overflowBlock, func() {
+		t.Fatalf("unexpected drop")
+	})
+
+	ctx := context.Background()
+	require.NoError(t, q.push(ctx, 1))
+
+	pushed := make(chan error, 1)
+	go func() {
+		pushed <- q.push(ctx, 2)
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatalf("push did not block on full queue")
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the subscriber reads its updates, our blocked push completes.
+	require.Equal(t, []interface{}{1}, q.drain())
+
+	select {
+	case err := <-pushed:
+		require.NoError(t, err)
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("push did not complete")
+	}
+
+	require.Equal(t, []interface{}{2}, q.drain())
+
+	// A push to a full queue completes when its context is canceled.
+	require.NoError(t, q.push(ctx, 3))
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.Equal(t, context.Canceled, q.push(cancelCtx, 4))
+
+	// A push to a full queue also completes once the subscriber is
+	// stopped.
+	q.stop()
+	require.NoError(t, q.push(ctx, 5))
+}
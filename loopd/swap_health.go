@@ -0,0 +1,375 @@
+package loopd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// healthCheckInterval is the interval at which we classify the health
+	// of pending swaps.
+	healthCheckInterval = time.Minute
+
+	// healthBlockTime is the average time between blocks that we use to
+	// estimate the number of blocks that a swap spent in its state before
+	// we started tracking it, for example after a restart.
+	healthBlockTime = 10 * time.Minute
+)
+
+// swapHealth describes how a pending swap is progressing.
+type swapHealth uint8
+
+const (
+	// healthUnknown is the health of swaps that are not pending, or that
+	// have not been checked yet.
+	healthUnknown swapHealth = iota
+
+	// healthHealthy indicates that a swap is progressing as expected.
+	healthHealthy
+
+	// healthSlow indicates that a swap spent longer than expected in its
+	// current state, but may still progress without intervention.
+	healthSlow
+
+	// healthStuck indicates that a swap is unlikely to progress without
+	// intervention.
+	healthStuck
+)
+
+// String returns a string representation of a swap's health.
+func (h swapHealth) String() string {
+	switch h {
+	case healthUnknown:
+		return "unknown"
+
+	case healthHealthy:
+		return "healthy"
+
+	case healthSlow:
+		return "slow"
+
+	case healthStuck:
+		return "stuck"
+
+	default:
+		return "invalid"
+	}
+}
+
+// healthRule describes how long a swap may spend in one of its states before
+// we consider it slow or stuck. States that wait for on chain confirmation
+// are limited in blocks, and all other states are limited in time.
+type healthRule struct {
+	// waitingFor describes what a swap in the state is waiting for.
+	waitingFor string
+
+	// slowBlocks and stuckBlocks are the number of blocks after which a
+	// swap in the state is slow or stuck. They are only used if non-zero.
+	slowBlocks  uint32
+	stuckBlocks uint32
+
+	// slowAfter and stuckAfter are the amount of time after which a swap
+	// in the state is slow or stuck. They are only used if non-zero.
+	slowAfter  time.Duration
+	stuckAfter time.Duration
+
+	// action is the action that we suggest for slow or stuck swaps.
+	action string
+}
+
+// healthPhase identifies the state of a swap of a specific type.
+type healthPhase struct {
+	swapType swap.Type
+	state    loopdb.SwapState
+}
+
+// healthRules holds the rules that we classify pending swaps with. Swaps in
+// states that have no rule are always considered healthy.
+var healthRules = map[healthPhase]healthRule{
+	{swap.TypeOut, loopdb.StateInitiated}: {
+		waitingFor: "off-chain payments in flight",
+		slowAfter:  time.Hour,
+		stuckAfter: 6 * time.Hour,
+		action: "check the swap's payments with lncli listpayments; " +
+			"payments that are held by a peer resolve once the " +
+			"server publishes the htlc or the swap times out",
+	},
+	{swap.TypeOut, loopdb.StatePreimageRevealed}: {
+		waitingFor:  "sweep unconfirmed",
+		slowBlocks:  6,
+		stuckBlocks: 36,
+		action: "check the logs for skipped sweeps, and the fee " +
+			"rate of the sweep against the current mempool; a " +
+			"lower sweep confirmation target increases the fee",
+	},
+	{swap.TypeIn, loopdb.StateInitiated}: {
+		waitingFor: "htlc not published",
+		slowAfter:  30 * time.Minute,
+		stuckAfter: 6 * time.Hour,
+		action: "publish a transaction that pays the swap amount to " +
+			"the swap's htlc address, or check the logs for htlc " +
+			"funding errors",
+	},
+	{swap.TypeIn, loopdb.StateHtlcPublished}: {
+		waitingFor:  "htlc unconfirmed",
+		slowBlocks:  6,
+		stuckBlocks: 36,
+		action: "check that the htlc transaction confirmed; if it " +
+			"is unconfirmed, consider bumping its fee with " +
+			"child-pays-for-parent",
+	},
+	{swap.TypeIn, loopdb.StateInvoiceSettled}: {
+		waitingFor:  "server sweep unconfirmed",
+		slowBlocks:  12,
+		stuckBlocks: 144,
+		action: "the swap invoice was paid, so no funds are at " +
+			"risk; contact the server operator if the htlc is " +
+			"not swept",
+	},
+}
+
+// swapHealthStatus is the classification of a pending swap.
+type swapHealthStatus struct {
+	// health is the health of the swap.
+	health swapHealth
+
+	// reason describes why the swap is slow or stuck, if it is.
+	reason string
+
+	// action is the action that we suggest for the swap, if it is slow or
+	// stuck.
+	action string
+}
+
+// classifySwapHealth classifies a swap that has spent the amount of time and
+// number of blocks provided in its current state.
+func classifySwapHealth(swapType swap.Type, state loopdb.SwapState,
+	elapsed time.Duration, blocks uint32) swapHealthStatus {
+
+	rule, ok := healthRules[healthPhase{swapType, state}]
+	if !ok {
+		return swapHealthStatus{health: healthHealthy}
+	}
+
+	exceeds := func(blockLimit uint32, timeLimit time.Duration) bool {
+		if blockLimit != 0 && blocks >= blockLimit {
+			return true
+		}
+
+		return timeLimit != 0 && elapsed >= timeLimit
+	}
+
+	var health swapHealth
+	switch {
+	case exceeds(rule.stuckBlocks, rule.stuckAfter):
+		health = healthStuck
+
+	case exceeds(rule.slowBlocks, rule.slowAfter):
+		health = healthSlow
+
+	default:
+		return swapHealthStatus{health: healthHealthy}
+	}
+
+	reason := fmt.Sprintf("%v for %v", rule.waitingFor,
+		elapsed.Truncate(time.Minute))
+	if rule.slowBlocks != 0 {
+		reason = fmt.Sprintf("%v for %v blocks", rule.waitingFor,
+			blocks)
+	}
+
+	return swapHealthStatus{
+		health: health,
+		reason: reason,
+		action: rule.action,
+	}
+}
+
+// swapHealthEntry tracks the health of a pending swap in its current state.
+type swapHealthEntry struct {
+	swapHealthStatus
+
+	// state is the state that the swap was classified in.
+	state loopdb.SwapState
+
+	// startHeight is the height at which the swap reached its state.
+	startHeight uint32
+}
+
+// stateStartTime returns the time at which a swap reached its current state.
+func stateStartTime(info *loop.SwapInfo) time.Time {
+	if len(info.Events) == 0 {
+		return info.InitiationTime
+	}
+
+	return info.Events[len(info.Events)-1].Time
+}
+
+// checkSwapHealth classifies all pending swaps at the time and block height
+// provided, and returns the hashes of the swaps whose health changed.
+func (s *swapClientServer) checkSwapHealth(now time.Time,
+	height uint32) []lntypes.Hash {
+
+	s.swapsLock.Lock()
+	pending := make(map[lntypes.Hash]loop.SwapInfo)
+	for hash, swp := range s.swaps {
+		if swp.State.Type() == loopdb.StateTypePending {
+			pending[hash] = swp
+		}
+	}
+	s.swapsLock.Unlock()
+
+	s.healthLock.Lock()
+	defer s.healthLock.Unlock()
+
+	for hash := range s.health {
+		if _, ok := pending[hash]; !ok {
+			delete(s.health, hash)
+		}
+	}
+
+	var changed []lntypes.Hash
+	for hash, swp := range pending {
+		swp := swp
+		elapsed := now.Sub(stateStartTime(&swp))
+
+		// If we have not seen the swap in its current state yet, we
+		// estimate the height at which it reached the state from the
+		// time that it has spent in it.
+		entry, ok := s.health[hash]
+		if !ok || entry.state != swp.State {
+			estimated := uint32(elapsed / healthBlockTime)
+			if estimated > height {
+				estimated = height
+			}
+
+			entry = &swapHealthEntry{
+				state:       swp.State,
+				startHeight: height - estimated,
+			}
+			s.health[hash] = entry
+		}
+
+		var blocks uint32
+		if height > entry.startHeight {
+			blocks = height - entry.startHeight
+		}
+
+		status := classifySwapHealth(
+			swp.SwapType, swp.State, elapsed, blocks,
+		)
+		if status.health != entry.health {
+			changed = append(changed, hash)
+		}
+
+		entry.swapHealthStatus = status
+	}
+
+	return changed
+}
+
+// swapHealth returns the health of a swap in the state provided, if it was
+// classified in that state.
+func (s *swapClientServer) swapHealth(hash lntypes.Hash,
+	state loopdb.SwapState) (swapHealthStatus, bool) {
+
+	s.healthLock.Lock()
+	defer s.healthLock.Unlock()
+
+	entry, ok := s.health[hash]
+	if !ok || entry.state != state {
+		return swapHealthStatus{}, false
+	}
+
+	return entry.swapHealthStatus, true
+}
+
+// reportSwapHealth classifies our pending swaps at the current block height,
+// logs the swaps that became slow or stuck and notifies our subscribers of
+// every swap whose health changed.
+func (s *swapClientServer) reportSwapHealth(ctx context.Context) error {
+	info, err := s.lnd.Client.GetInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	changed := s.checkSwapHealth(time.Now(), info.BlockHeight)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	for _, hash := range changed {
+		swp, ok := s.swaps[hash]
+		if !ok {
+			continue
+		}
+
+		status, ok := s.swapHealth(hash, swp.State)
+		if !ok {
+			continue
+		}
+
+		if status.health == healthSlow ||
+			status.health == healthStuck {
+
+			log.Warnf("Swap %v is %v: %v. Suggested action: %v",
+				hash, status.health, status.reason,
+				status.action)
+		}
+
+		for _, subscriber := range s.subscribers {
+			if err := subscriber.push(ctx, swp); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// monitorSwapHealth periodically classifies the health of our pending swaps.
+func (s *swapClientServer) monitorSwapHealth(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := s.reportSwapHealth(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Errorf("Could not check swap health: %v",
+					err)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// marshallSwapHealth converts a swap's health to its rpc representation.
+func marshallSwapHealth(health swapHealth) looprpc.SwapHealth {
+	switch health {
+	case healthHealthy:
+		return looprpc.SwapHealth_SWAP_HEALTH_HEALTHY
+
+	case healthSlow:
+		return looprpc.SwapHealth_SWAP_HEALTH_SLOW
+
+	case healthStuck:
+		return looprpc.SwapHealth_SWAP_HEALTH_STUCK
+
+	default:
+		return looprpc.SwapHealth_SWAP_HEALTH_UNKNOWN
+	}
+}
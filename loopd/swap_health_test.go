@@ -0,0 +1,220 @@
+package loopd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClassifySwapHealth tests classification of swaps by the time and blocks
+// that they spent in their current state.
+func TestClassifySwapHealth(t *testing.T) {
+	tests := []struct {
+		name     string
+		swapType swap.Type
+		state    loopdb.SwapState
+		elapsed  time.Duration
+		blocks   uint32
+		health   swapHealth
+		reason   string
+	}{
+		{
+			name:     "state without rule",
+			swapType: swap.TypeOut,
+			state:    loopdb.StateHtlcPublished,
+			elapsed:  time.Hour * 100,
+			blocks:   1000,
+			health:   healthHealthy,
+		},
+		{
+			name:     "payments in flight",
+			swapType: swap.TypeOut,
+			state:    loopdb.StateInitiated,
+			elapsed:  time.Minute * 10,
+			health:   healthHealthy,
+		},
+		{
+			name:     "payments in flight slow",
+			swapType: swap.TypeOut,
+			state:    loopdb.StateInitiated,
+			elapsed:  time.Minute * 90,
+			health:   healthSlow,
+			reason:   "off-chain payments in flight for 1h30m0s",
+		},
+		{
+			name:     "payments in flight stuck",
+			swapType: swap.TypeOut,
+			state:    loopdb.StateInitiated,
+			elapsed:  time.Hour * 6,
+			health:   healthStuck,
+			reason:   "off-chain payments in flight for 6h0m0s",
+		},
+		{
+			name:     "htlc confirming",
+			swapType: swap.TypeIn,
+			state:    loopdb.StateHtlcPublished,
+			elapsed:  time.Hour * 10,
+			blocks:   5,
+			health:   healthHealthy,
+		},
+		{
+			name:     "htlc unconfirmed slow",
+			swapType: swap.TypeIn,
+			state:    loopdb.StateHtlcPublished,
+			blocks:   6,
+			health:   healthSlow,
+			reason:   "htlc unconfirmed for 6 blocks",
+		},
+		{
+			name:     "htlc unconfirmed stuck",
+			swapType: swap.TypeIn,
+			state:    loopdb.StateHtlcPublished,
+			blocks:   40,
+			health:   healthStuck,
+			reason:   "htlc unconfirmed for 40 blocks",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			status := classifySwapHealth(
+				test.swapType, test.state, test.elapsed,
+				test.blocks,
+			)
+			require.Equal(t, test.health, status.health)
+			require.Equal(t, test.reason, status.reason)
+
+			if test.health == healthHealthy {
+				require.Empty(t, status.action)
+			} else {
+				require.NotEmpty(t, status.action)
+			}
+		})
+	}
+}
+
+// TestCheckSwapHealth tests tracking of the health of pending swaps across
+// health checks.
+func TestCheckSwapHealth(t *testing.T) {
+	var (
+		start  = time.Unix(100000, 0)
+		hash   = lntypes.Hash{1}
+		height = uint32(100)
+	)
+
+	published := loop.SwapInfo{
+		SwapHash: hash,
+		SwapType: swap.TypeIn,
+		SwapContract: loopdb.SwapContract{
+			InitiationTime: start,
+		},
+		SwapStateData: loopdb.SwapStateData{
+			State: loopdb.StateHtlcPublished,
+		},
+		Events: []*loopdb.LoopEvent{
+			newEvent(loopdb.StateHtlcPublished, start),
+		},
+	}
+
+	s := &swapClientServer{
+		swaps: map[lntypes.Hash]loop.SwapInfo{
+			hash: published,
+		},
+		health: make(map[lntypes.Hash]*swapHealthEntry),
+	}
+
+	// The first check classifies the swap. As the swap only just reached
+	// its state, it is healthy.
+	changed := s.checkSwapHealth(start, height)
+	require.Equal(t, []lntypes.Hash{hash}, changed)
+
+	status, ok := s.swapHealth(hash, loopdb.StateHtlcPublished)
+	require.True(t, ok)
+	require.Equal(t, healthHealthy, status.health)
+
+	// A swap that is checked in a different state than it was classified
+	// in has no health.
+	_, ok = s.swapHealth(hash, loopdb.StateInvoiceSettled)
+	require.False(t, ok)
+
+	// Unchanged classifications are not reported.
+	require.Empty(t, s.checkSwapHealth(start, height+1))
+
+	// Once the htlc is unconfirmed for long enough, the swap is slow.
+	changed = s.checkSwapHealth(start, height+6)
+	require.Equal(t, []lntypes.Hash{hash}, changed)
+
+	status, ok = s.swapHealth(hash, loopdb.StateHtlcPublished)
+	require.True(t, ok)
+	require.Equal(t, healthSlow, status.health)
+
+	// When the swap progresses, it is classified in its new state again.
+	settled := published
+	settled.State = loopdb.StateInvoiceSettled
+	settled.Events = append(
+		settled.Events, newEvent(loopdb.StateInvoiceSettled, start),
+	)
+	s.swaps[hash] = settled
+
+	changed = s.checkSwapHealth(start, height+7)
+	require.Equal(t, []lntypes.Hash{hash}, changed)
+
+	status, ok = s.swapHealth(hash, loopdb.StateInvoiceSettled)
+	require.True(t, ok)
+	require.Equal(t, healthHealthy, status.health)
+
+	// Completed swaps are no longer tracked.
+	settled.State = loopdb.StateSuccess
+	s.swaps[hash] = settled
+
+	require.Empty(t, s.checkSwapHealth(start, height+8))
+	require.Empty(t, s.health)
+}
+
+// TestCheckSwapHealthRestart tests that swaps that we start tracking after
+// they reached their state are classified by the number of blocks that we
+// estimate them to have spent in it.
+func TestCheckSwapHealthRestart(t *testing.T) {
+	var (
+		start = time.Unix(100000, 0)
+		hash  = lntypes.Hash{1}
+	)
+
+	s := &swapClientServer{
+		swaps: map[lntypes.Hash]loop.SwapInfo{
+			hash: {
+				SwapHash: hash,
+				SwapType: swap.TypeOut,
+				SwapContract: loopdb.SwapContract{
+					InitiationTime: start,
+				},
+				SwapStateData: loopdb.SwapStateData{
+					State: loopdb.StatePreimageRevealed,
+				},
+				Events: []*loopdb.LoopEvent{
+					newEvent(
+						loopdb.StatePreimageRevealed,
+						start,
+					),
+				},
+			},
+		},
+		health: make(map[lntypes.Hash]*swapHealthEntry),
+	}
+
+	// The swap has been waiting for its sweep to confirm for six hours,
+	// so we estimate that it did so for 36 blocks.
+	s.checkSwapHealth(start.Add(time.Hour*6), 1000)
+
+	status, ok := s.swapHealth(hash, loopdb.StatePreimageRevealed)
+	require.True(t, ok)
+	require.Equal(t, healthStuck, status.health)
+	require.Equal(t, "sweep unconfirmed for 36 blocks", status.reason)
+}
@@ -0,0 +1,845 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultPlanSteps is the number of swaps that a plan may be split
+	// into if no maximum is set.
+	defaultPlanSteps = 10
+
+	// maxPlanSteps is the largest number of swaps that a plan may be
+	// split into.
+	maxPlanSteps = 100
+
+	// defaultPlanInterval is the time between the dispatch of consecutive
+	// steps of a plan if no interval is set.
+	defaultPlanInterval = time.Hour
+
+	// planInitiator is the initiator of the swaps that execute plans.
+	planInitiator = "plan"
+
+	// planTickInterval is how often we check whether the steps of our
+	// running plans are due or have completed.
+	planTickInterval = time.Minute
+)
+
+// errNoPlan is returned when an amount cannot be split into swaps that are
+// within the server's terms and the fee budget of a plan.
+var errNoPlan = errors.New("no plan within fee budget")
+
+// feeEstimator returns the fees that are quoted for a single swap of the
+// amount provided. It fails with errFeeLimitExceeded if the swap may not
+// spend that much on fees.
+type feeEstimator func(ctx context.Context,
+	amount btcutil.Amount) (btcutil.Amount, error)
+
+// planSplit is a split of a liquidity change into swaps.
+type planSplit struct {
+	// amounts are the amounts of the swaps.
+	amounts []btcutil.Amount
+
+	// fees are the fees that were quoted for each of the swaps.
+	fees []btcutil.Amount
+
+	// total is the sum of the fees of all of the swaps.
+	total btcutil.Amount
+}
+
+// splitAmount splits a total into n amounts that differ by at most one
+// satoshi, with the larger amounts first.
+func splitAmount(total btcutil.Amount, n int) []btcutil.Amount {
+	share := total / btcutil.Amount(n)
+	rest := total % btcutil.Amount(n)
+
+	amounts := make([]btcutil.Amount, n)
+	for i := range amounts {
+		amounts[i] = share
+		if btcutil.Amount(i) < rest {
+			amounts[i]++
+		}
+	}
+
+	return amounts
+}
+
+// planAmounts picks the split of a total into swaps between the minimum and
+// maximum amount provided that has the lowest quoted fees. The server's fees
+// are not linear in the swap amount, so we quote every number of swaps that
+// is allowed rather than assume that fewer, larger swaps are cheaper. Ties
+// are broken in favor of fewer swaps.
+func planAmounts(ctx context.Context, total, minAmt, maxAmt btcutil.Amount,
+	maxSteps int, estimate feeEstimator) (*planSplit, error) {
+
+	if minAmt < 1 {
+		minAmt = 1
+	}
+
+	if total < minAmt {
+		return nil, fmt.Errorf("%w: amount %v is below the minimum "+
+			"swap amount %v", errNoPlan, total, minAmt)
+	}
+
+	fewest := int((total + maxAmt - 1) / maxAmt)
+	most := int(total / minAmt)
+	if most > maxSteps {
+		most = maxSteps
+	}
+
+	if fewest > most {
+		return nil, fmt.Errorf("%w: amount %v requires at least %v "+
+			"swaps of at most %v, %v allowed", errNoPlan, total,
+			fewest, maxAmt, maxSteps)
+	}
+
+	// Split amounts only differ by a satoshi, so most of them are shared
+	// between candidates and we only quote each amount once. Amounts
+	// that exceed their fee limit are recorded as nil.
+	quotes := make(map[btcutil.Amount]*btcutil.Amount)
+	quote := func(amount btcutil.Amount) (*btcutil.Amount, error) {
+		if fee, ok := quotes[amount]; ok {
+			return fee, nil
+		}
+
+		fee, err := estimate(ctx, amount)
+		switch {
+		case errors.Is(err, errFeeLimitExceeded):
+			quotes[amount] = nil
+			return nil, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		quotes[amount] = &fee
+		return &fee, nil
+	}
+
+	var best *planSplit
+
+candidates:
+	for n := fewest; n <= most; n++ {
+		split := &planSplit{
+			amounts: splitAmount(total, n),
+		}
+
+		for _, amount := range split.amounts {
+			fee, err := quote(amount)
+			if err != nil {
+				return nil, err
+			}
+
+			if fee == nil {
+				continue candidates
+			}
+
+			split.fees = append(split.fees, *fee)
+			split.total += *fee
+		}
+
+		if best == nil || split.total < best.total {
+			best = split
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("%w: every split of %v exceeds its fee "+
+			"limit", errNoPlan, total)
+	}
+
+	return best, nil
+}
+
+// planFeePPM returns the portion of each swap's amount that a plan may spend
+// on fees, so that all of its swaps stay within its budget.
+func planFeePPM(plan *loopdb.SwapPlan) uint64 {
+	return uint64(plan.MaxFees) * liquidity.FeeBase / uint64(plan.Amount)
+}
+
+// planParams returns the parameters of the swaps that execute a plan.
+func planParams(plan *loopdb.SwapPlan) *channelSwapParams {
+	return &channelSwapParams{
+		sweepConfTarget:   plan.ConfTarget,
+		htlcConfTarget:    plan.ConfTarget,
+		feePPM:            planFeePPM(plan),
+		label:             plan.Label,
+		initiator:         planInitiator,
+		publicationWindow: plan.Interval,
+	}
+}
+
+// planFeeEstimator returns an estimator that quotes the swaps of a plan.
+// Loop outs are quoted with the publication window of the plan, so that the
+// discount for batched htlcs is taken into account.
+func (s *swapClientServer) planFeeEstimator(plan *loopdb.SwapPlan,
+	params *channelSwapParams) feeEstimator {
+
+	return func(ctx context.Context,
+		amount btcutil.Amount) (btcutil.Amount, error) {
+
+		if plan.Type == swap.TypeIn {
+			quote, err := s.impl.LoopInQuote(
+				ctx, &loop.LoopInQuoteRequest{
+					Amount:         amount,
+					HtlcConfTarget: params.htlcConfTarget,
+					LastHop:        plan.LastHop,
+				},
+			)
+			if err != nil {
+				return 0, err
+			}
+
+			fees := quote.SwapFee + quote.MinerFee
+			limit := btcutil.Amount(
+				uint64(amount) * params.feePPM /
+					liquidity.FeeBase,
+			)
+			if fees > limit {
+				return 0, errFeeLimitExceeded
+			}
+
+			return fees, nil
+		}
+
+		quote, err := s.impl.LoopOutQuote(ctx, &loop.LoopOutQuoteRequest{
+			Amount:          amount,
+			SweepConfTarget: params.sweepConfTarget,
+			SwapPublicationDeadline: time.Now().Add(
+				params.publicationWindow,
+			),
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		_, _, _, err = liquidity.FeePortionLimits(
+			amount, params.feePPM, quote,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %v", errFeeLimitExceeded, err)
+		}
+
+		return quote.SwapFee + quote.MinerFee, nil
+	}
+}
+
+// planTerms returns the minimum and maximum amount of the swaps of a plan.
+// Swaps are kept at our approval threshold, since they are dispatched
+// without waiting for approval.
+func (s *swapClientServer) planTerms(ctx context.Context,
+	swapType swap.Type) (btcutil.Amount, btcutil.Amount, error) {
+
+	if swapType == swap.TypeIn {
+		terms, err := s.impl.LoopInTerms(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return terms.MinSwapAmount, s.capToApproval(
+			terms.MaxSwapAmount,
+		), nil
+	}
+
+	terms, err := s.impl.LoopOutTerms(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return terms.MinSwapAmount, s.capToApproval(terms.MaxSwapAmount), nil
+}
+
+// unmarshallSwapPlanRequest validates a request for a swap plan, returning
+// the plan without steps, the time that its first step is due and the
+// maximum number of steps that it may be split into.
+func unmarshallSwapPlanRequest(req *looprpc.CreateSwapPlanRequest,
+	now time.Time) (*loopdb.SwapPlan, time.Time, int, error) {
+
+	if req.Amt <= 0 {
+		return nil, time.Time{}, 0, errors.New("amount must be " +
+			"positive")
+	}
+
+	if req.MaxFeeSat <= 0 {
+		return nil, time.Time{}, 0, errors.New("fee budget must be " +
+			"positive")
+	}
+
+	if err := labels.Validate(req.Label); err != nil {
+		return nil, time.Time{}, 0, err
+	}
+
+	maxSteps := int(req.MaxSwaps)
+	switch {
+	case maxSteps == 0:
+		maxSteps = defaultPlanSteps
+
+	case maxSteps > maxPlanSteps:
+		return nil, time.Time{}, 0, fmt.Errorf("plans may be split "+
+			"into at most %v swaps", maxPlanSteps)
+	}
+
+	interval := time.Duration(req.IntervalSec) * time.Second
+	if interval == 0 {
+		interval = defaultPlanInterval
+	}
+
+	start := now
+	if req.StartTime != 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+
+	plan := &loopdb.SwapPlan{
+		Amount:       btcutil.Amount(req.Amt),
+		MaxFees:      btcutil.Amount(req.MaxFeeSat),
+		Interval:     interval,
+		Label:        req.Label,
+		CreationTime: now,
+	}
+
+	var err error
+	switch req.Type {
+	case looprpc.SwapType_LOOP_OUT:
+		if len(req.LastHop) != 0 {
+			return nil, time.Time{}, 0, errors.New("last hop is " +
+				"not supported for loop out")
+		}
+
+		plan.Type = swap.TypeOut
+		plan.OutgoingChanSet = req.OutgoingChanSet
+		plan.ConfTarget, err = validateConfTarget(
+			req.ConfTarget, loop.DefaultSweepConfTarget,
+		)
+
+	case looprpc.SwapType_LOOP_IN:
+		if len(req.OutgoingChanSet) != 0 {
+			return nil, time.Time{}, 0, errors.New("outgoing " +
+				"channels are not supported for loop in")
+		}
+
+		if len(req.LastHop) != 0 {
+			lastHop, err := route.NewVertexFromBytes(req.LastHop)
+			if err != nil {
+				return nil, time.Time{}, 0, err
+			}
+			plan.LastHop = &lastHop
+		}
+
+		plan.Type = swap.TypeIn
+		plan.ConfTarget, err = validateConfTarget(
+			req.ConfTarget, loop.DefaultHtlcConfTarget,
+		)
+
+	default:
+		return nil, time.Time{}, 0, fmt.Errorf("unknown swap type: %v",
+			req.Type)
+	}
+	if err != nil {
+		return nil, time.Time{}, 0, err
+	}
+
+	return plan, start, maxSteps, nil
+}
+
+// CreateSwapPlan splits a liquidity change into the sequence of swaps with
+// the lowest quoted fees and stores it as a plan.
+func (s *swapClientServer) CreateSwapPlan(ctx context.Context,
+	req *looprpc.CreateSwapPlanRequest) (*looprpc.SwapPlan, error) {
+
+	plan, start, maxSteps, err := unmarshallSwapPlanRequest(
+		req, time.Now(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	minAmt, maxAmt, err := s.planTerms(ctx, plan.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := planAmounts(
+		ctx, plan.Amount, minAmt, maxAmt, maxSteps,
+		s.planFeeEstimator(plan, planParams(plan)),
+	)
+	switch {
+	case errors.Is(err, errNoPlan):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	if split.total > plan.MaxFees {
+		return nil, status.Errorf(codes.FailedPrecondition, "estimated "+
+			"fees of %v exceed budget of %v", split.total,
+			plan.MaxFees)
+	}
+
+	// Plan steps are dispatched without waiting for approval, so we do
+	// not allow steps that would need it.
+	for _, amount := range split.amounts {
+		if s.requiresApproval(amount) {
+			return nil, errApprovalUnsupported
+		}
+	}
+
+	for i, amount := range split.amounts {
+		delay := time.Duration(i) * plan.Interval
+
+		plan.Steps = append(plan.Steps, &loopdb.PlanStep{
+			Amount:        amount,
+			NotBefore:     start.Add(delay),
+			EstimatedFees: split.fees[i],
+		})
+	}
+
+	if req.Start {
+		plan.State = loopdb.SwapPlanRunning
+	}
+
+	s.plansLock.Lock()
+	err = s.impl.Store.CreateSwapPlan(plan)
+	s.plansLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Created swap plan %v: %v %v in %v swaps, estimated fees "+
+		"%v", plan.ID, plan.Type, plan.Amount, len(plan.Steps),
+		split.total)
+
+	return marshallSwapPlan(plan), nil
+}
+
+// ListSwapPlans returns all of our swap plans.
+func (s *swapClientServer) ListSwapPlans(_ context.Context,
+	_ *looprpc.ListSwapPlansRequest) (*looprpc.ListSwapPlansResponse,
+	error) {
+
+	plans, err := s.impl.Store.FetchSwapPlans()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.ListSwapPlansResponse{
+		Plans: make([]*looprpc.SwapPlan, len(plans)),
+	}
+	for i, plan := range plans {
+		resp.Plans[i] = marshallSwapPlan(plan)
+	}
+
+	return resp, nil
+}
+
+// UpdateSwapPlan resumes, pauses or cancels a swap plan.
+func (s *swapClientServer) UpdateSwapPlan(_ context.Context,
+	req *looprpc.UpdateSwapPlanRequest) (*looprpc.SwapPlan, error) {
+
+	s.plansLock.Lock()
+	defer s.plansLock.Unlock()
+
+	plan, err := s.fetchSwapPlan(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case looprpc.SwapPlanAction_PLAN_RESUME:
+		if plan.State == loopdb.SwapPlanComplete ||
+			plan.State == loopdb.SwapPlanCancelled {
+
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"plan %v is %v", plan.ID, plan.State)
+		}
+
+		// Resuming a failed plan retries its failed step.
+		for _, step := range plan.Steps {
+			if step.State == loopdb.PlanStepFailed {
+				step.State = loopdb.PlanStepPending
+				step.SwapHash = lntypes.Hash{}
+			}
+		}
+
+		plan.State = loopdb.SwapPlanRunning
+
+	case looprpc.SwapPlanAction_PLAN_PAUSE:
+		if plan.State.Final() {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"plan %v is %v", plan.ID, plan.State)
+		}
+
+		plan.State = loopdb.SwapPlanPaused
+
+	case looprpc.SwapPlanAction_PLAN_CANCEL:
+		if plan.State == loopdb.SwapPlanComplete ||
+			plan.State == loopdb.SwapPlanCancelled {
+
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"plan %v is %v", plan.ID, plan.State)
+		}
+
+		plan.State = loopdb.SwapPlanCancelled
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown "+
+			"action: %v", req.Action)
+	}
+
+	if err := s.impl.Store.UpdateSwapPlan(plan); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Swap plan %v is %v", plan.ID, plan.State)
+
+	return marshallSwapPlan(plan), nil
+}
+
+// fetchSwapPlan returns the swap plan with the id provided.
+func (s *swapClientServer) fetchSwapPlan(id uint64) (*loopdb.SwapPlan,
+	error) {
+
+	plans, err := s.impl.Store.FetchSwapPlans()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, plan := range plans {
+		if plan.ID == id {
+			return plan, nil
+		}
+	}
+
+	return nil, status.Error(
+		codes.NotFound, loopdb.ErrSwapPlanNotFound.Error(),
+	)
+}
+
+// runSwapPlans periodically advances our running swap plans until the
+// context provided is cancelled.
+func (s *swapClientServer) runSwapPlans(ctx context.Context) {
+	ticker := time.NewTicker(planTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := s.advanceSwapPlans(ctx, time.Now())
+			if err != nil && ctx.Err() == nil {
+				log.Errorf("Could not advance swap plans: %v",
+					err)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// advanceSwapPlans advances each of our running swap plans, storing the
+// plans that changed.
+func (s *swapClientServer) advanceSwapPlans(ctx context.Context,
+	now time.Time) error {
+
+	s.plansLock.Lock()
+	defer s.plansLock.Unlock()
+
+	plans, err := s.impl.Store.FetchSwapPlans()
+	if err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		if plan.State != loopdb.SwapPlanRunning {
+			continue
+		}
+
+		changed, err := s.advanceSwapPlan(ctx, plan, now)
+		switch {
+		// If the fees of the next step are currently too high, we
+		// wait for them to drop rather than fail the plan.
+		case errors.Is(err, errFeeLimitExceeded):
+			log.Infof("Swap plan %v waiting for fees: %v", plan.ID,
+				err)
+
+		case err != nil:
+			log.Errorf("Swap plan %v: %v", plan.ID, err)
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err := s.impl.Store.UpdateSwapPlan(plan); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advanceSwapPlan records the outcome of a running plan's dispatched step,
+// or dispatches its next step once it is due. Steps are dispatched one at a
+// time, in order. It returns whether the plan changed.
+func (s *swapClientServer) advanceSwapPlan(ctx context.Context,
+	plan *loopdb.SwapPlan, now time.Time) (bool, error) {
+
+	for i, step := range plan.Steps {
+		switch step.State {
+		case loopdb.PlanStepSucceeded:
+			continue
+
+		case loopdb.PlanStepDispatched:
+			return s.checkPlanStep(plan, i)
+
+		case loopdb.PlanStepPending:
+			if now.Before(step.NotBefore) {
+				return false, nil
+			}
+
+			return s.dispatchPlanStep(ctx, plan, i)
+
+		default:
+			plan.State = loopdb.SwapPlanFailed
+			return true, nil
+		}
+	}
+
+	plan.State = loopdb.SwapPlanComplete
+	log.Infof("Swap plan %v complete", plan.ID)
+
+	return true, nil
+}
+
+// checkPlanStep records the outcome of a dispatched step's swap once it has
+// reached a final state, failing the plan if the swap failed. It returns
+// whether the plan changed.
+func (s *swapClientServer) checkPlanStep(plan *loopdb.SwapPlan,
+	index int) (bool, error) {
+
+	step := plan.Steps[index]
+
+	info, ok, err := s.knownSwap(step.SwapHash)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	switch info.State.Type() {
+	case loopdb.StateTypeSuccess:
+		step.State = loopdb.PlanStepSucceeded
+		log.Infof("Swap plan %v step %v succeeded", plan.ID, index)
+
+	case loopdb.StateTypeFail:
+		step.State = loopdb.PlanStepFailed
+		plan.State = loopdb.SwapPlanFailed
+		log.Warnf("Swap plan %v step %v failed: %v", plan.ID, index,
+			info.State)
+
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// dispatchPlanStep dispatches the swap of a plan's step. If the swap cannot
+// be dispatched for any reason other than its fees, the plan fails so that
+// it can be retried once resumed. It returns whether the plan changed.
+func (s *swapClientServer) dispatchPlanStep(ctx context.Context,
+	plan *loopdb.SwapPlan, index int) (bool, error) {
+
+	step := plan.Steps[index]
+	requestID := fmt.Sprintf("plan-%v-%v", plan.ID, index)
+
+	// If we dispatched the step's swap but shut down before we recorded
+	// it, we adopt the swap rather than dispatch it again.
+	hash, ok, err := s.planStepSwap(requestID)
+	if err != nil {
+		return false, err
+	}
+
+	if ok {
+		step.State = loopdb.PlanStepDispatched
+		step.SwapHash = hash
+
+		return true, nil
+	}
+
+	hash, err = s.dispatchPlanSwap(ctx, plan, step.Amount, requestID)
+	switch {
+	case errors.Is(err, errFeeLimitExceeded):
+		return false, err
+
+	case err != nil:
+		step.State = loopdb.PlanStepFailed
+		plan.State = loopdb.SwapPlanFailed
+
+		return true, err
+	}
+
+	step.State = loopdb.PlanStepDispatched
+	step.SwapHash = hash
+
+	log.Infof("Swap plan %v step %v dispatched: %v", plan.ID, index, hash)
+
+	return true, nil
+}
+
+// dispatchPlanSwap dispatches a swap of the amount provided for a plan,
+// without waiting for it to complete.
+func (s *swapClientServer) dispatchPlanSwap(ctx context.Context,
+	plan *loopdb.SwapPlan, amount btcutil.Amount,
+	requestID string) (lntypes.Hash, error) {
+
+	// Our approval threshold may have been lowered since the plan was
+	// created.
+	if s.requiresApproval(amount) {
+		return lntypes.Hash{}, errApprovalUnsupported
+	}
+
+	params := planParams(plan)
+
+	if plan.Type == swap.TypeIn {
+		req, err := s.loopInRequest(ctx, amount, plan.LastHop, params)
+		if err != nil {
+			return lntypes.Hash{}, err
+		}
+		req.RequestID = requestID
+
+		info, err := s.impl.LoopIn(ctx, req)
+		if err != nil {
+			return lntypes.Hash{}, err
+		}
+
+		return info.SwapHash, nil
+	}
+
+	req, err := s.loopOutRequest(ctx, amount, plan.OutgoingChanSet, params)
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+	req.RequestID = requestID
+
+	info, err := s.impl.LoopOut(ctx, req)
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	return info.SwapHash, nil
+}
+
+// planStepSwap returns the hash of the swap that was dispatched for a plan
+// step with the request id provided, if it has not failed.
+func (s *swapClientServer) planStepSwap(requestID string) (lntypes.Hash,
+	bool, error) {
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	if err := s.loadSwaps(); err != nil {
+		return lntypes.Hash{}, false, err
+	}
+
+	for hash, info := range s.swaps {
+		if info.RequestID != requestID || info.Tenant != "" {
+			continue
+		}
+
+		if info.State.Type() == loopdb.StateTypeFail {
+			continue
+		}
+
+		return hash, true, nil
+	}
+
+	return lntypes.Hash{}, false, nil
+}
+
+// marshallSwapPlan converts a swap plan to its rpc representation.
+func marshallSwapPlan(plan *loopdb.SwapPlan) *looprpc.SwapPlan {
+	rpcPlan := &looprpc.SwapPlan{
+		Id:           plan.ID,
+		Type:         looprpc.SwapType_LOOP_OUT,
+		Amt:          int64(plan.Amount),
+		MaxFeeSat:    int64(plan.MaxFees),
+		State:        marshallSwapPlanState(plan.State),
+		Label:        plan.Label,
+		CreationTime: plan.CreationTime.Unix(),
+	}
+
+	if plan.Type == swap.TypeIn {
+		rpcPlan.Type = looprpc.SwapType_LOOP_IN
+	}
+
+	for _, step := range plan.Steps {
+		rpcStep := &looprpc.PlanStep{
+			Amt:             int64(step.Amount),
+			NotBefore:       step.NotBefore.Unix(),
+			EstimatedFeeSat: int64(step.EstimatedFees),
+			State:           marshallPlanStepState(step.State),
+		}
+
+		if step.State != loopdb.PlanStepPending {
+			rpcStep.IdBytes = step.SwapHash[:]
+		}
+
+		rpcPlan.EstimatedFeeSat += rpcStep.EstimatedFeeSat
+		rpcPlan.Steps = append(rpcPlan.Steps, rpcStep)
+	}
+
+	return rpcPlan
+}
+
+// marshallSwapPlanState converts the state of a swap plan to its rpc
+// representation.
+func marshallSwapPlanState(state loopdb.SwapPlanState) looprpc.SwapPlanState {
+	switch state {
+	case loopdb.SwapPlanRunning:
+		return looprpc.SwapPlanState_PLAN_RUNNING
+
+	case loopdb.SwapPlanComplete:
+		return looprpc.SwapPlanState_PLAN_COMPLETE
+
+	case loopdb.SwapPlanFailed:
+		return looprpc.SwapPlanState_PLAN_FAILED
+
+	case loopdb.SwapPlanCancelled:
+		return looprpc.SwapPlanState_PLAN_CANCELLED
+
+	default:
+		return looprpc.SwapPlanState_PLAN_PAUSED
+	}
+}
+
+// marshallPlanStepState converts the state of a plan step to its rpc
+// representation.
+func marshallPlanStepState(state loopdb.PlanStepState) looprpc.PlanStepState {
+	switch state {
+	case loopdb.PlanStepDispatched:
+		return looprpc.PlanStepState_STEP_DISPATCHED
+
+	case loopdb.PlanStepSucceeded:
+		return looprpc.PlanStepState_STEP_SUCCEEDED
+
+	case loopdb.PlanStepFailed:
+		return looprpc.PlanStepState_STEP_FAILED
+
+	default:
+		return looprpc.PlanStepState_STEP_PENDING
+	}
+}
@@ -0,0 +1,138 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplitAmount tests splitting amounts into near-equal parts.
+func TestSplitAmount(t *testing.T) {
+	require.Equal(
+		t, []btcutil.Amount{4, 3, 3}, splitAmount(10, 3),
+	)
+	require.Equal(
+		t, []btcutil.Amount{5, 5}, splitAmount(10, 2),
+	)
+	require.Equal(
+		t, []btcutil.Amount{10}, splitAmount(10, 1),
+	)
+}
+
+// TestPlanAmounts tests picking the split of a swap plan's amount with the
+// lowest quoted fees.
+func TestPlanAmounts(t *testing.T) {
+	// flatFee quotes a fee that is independent of the swap amount.
+	flatFee := func(_ context.Context,
+		_ btcutil.Amount) (btcutil.Amount, error) {
+
+		return 1000, nil
+	}
+
+	// tieredFee quotes a lower fee for swaps that fit in a lower tier.
+	tieredFee := func(_ context.Context,
+		amount btcutil.Amount) (btcutil.Amount, error) {
+
+		if amount <= 500000 {
+			return 500, nil
+		}
+
+		return amount / 100, nil
+	}
+
+	// limitedFee rejects swaps that exceed their fee limit.
+	limitedFee := func(_ context.Context,
+		amount btcutil.Amount) (btcutil.Amount, error) {
+
+		if amount > 600000 {
+			return 0, errFeeLimitExceeded
+		}
+
+		return 1000, nil
+	}
+
+	tests := []struct {
+		name     string
+		total    btcutil.Amount
+		maxSteps int
+		estimate feeEstimator
+		swaps    int
+		fees     btcutil.Amount
+		err      error
+	}{
+		{
+			name:     "fewest swaps",
+			total:    2500000,
+			maxSteps: 10,
+			estimate: flatFee,
+			swaps:    3,
+			fees:     3000,
+		},
+		{
+			name:     "lower fee tier",
+			total:    2500000,
+			maxSteps: 10,
+			estimate: tieredFee,
+			swaps:    5,
+			fees:     2500,
+		},
+		{
+			name:     "fee limit exceeded",
+			total:    2500000,
+			maxSteps: 10,
+			estimate: limitedFee,
+			swaps:    5,
+			fees:     5000,
+		},
+		{
+			name:     "too few swaps allowed",
+			total:    2500000,
+			maxSteps: 2,
+			estimate: flatFee,
+			err:      errNoPlan,
+		},
+		{
+			name:     "no split within fee limit",
+			total:    2500000,
+			maxSteps: 4,
+			estimate: limitedFee,
+			err:      errNoPlan,
+		},
+		{
+			name:     "below minimum",
+			total:    50000,
+			maxSteps: 10,
+			estimate: flatFee,
+			err:      errNoPlan,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			split, err := planAmounts(
+				context.Background(), testCase.total, 100000,
+				1000000, testCase.maxSteps, testCase.estimate,
+			)
+			if testCase.err != nil {
+				require.True(t, errors.Is(err, testCase.err))
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, split.amounts, testCase.swaps)
+			require.Len(t, split.fees, testCase.swaps)
+			require.Equal(t, testCase.fees, split.total)
+
+			var total btcutil.Amount
+			for _, amount := range split.amounts {
+				total += amount
+			}
+			require.Equal(t, testCase.total, total)
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/metrics"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// swapSLAMeasurement is the measurement that we record the expected
+	// and actual durations of swaps under.
+	swapSLAMeasurement = "loop_swap_sla"
+
+	// slaStatusCompleted and slaStatusOverdue are the status tags that we
+	// record the durations of completed swaps and of pending swaps that
+	// exceeded their expected duration under.
+	slaStatusCompleted = "completed"
+	slaStatusOverdue   = "overdue"
+
+	// defaultSLAAlertFactor is the default factor by which a pending swap
+	// may exceed its expected duration before we report it.
+	defaultSLAAlertFactor = 2
+
+	// slaCheckInterval is the interval at which we check whether pending
+	// swaps have exceeded their expected duration.
+	slaCheckInterval = time.Minute
+)
+
+// swapPhase is the amount of time that a swap spent in one of its states.
+type swapPhase struct {
+	// State is the state that the swap was in.
+	State loopdb.SwapState
+
+	// Duration is the time that the swap spent in the state.
+	Duration time.Duration
+}
+
+// swapPhases returns the time that a swap spent in each of the states that it
+// passed through, in the order that they were reached. A swap is in its
+// initiated state from its initiation time until its first event. The time
+// spent in the swap's current state is not included.
+func swapPhases(info *loop.SwapInfo) []swapPhase {
+	var (
+		phases []swapPhase
+		state  = loopdb.StateInitiated
+		start  = info.InitiationTime
+	)
+
+	for _, event := range info.Events {
+		phases = append(phases, swapPhase{
+			State:    state,
+			Duration: event.Time.Sub(start),
+		})
+
+		state, start = event.State, event.Time
+	}
+
+	return phases
+}
+
+// withSwapEvents carries the events of a swap that we already know of over to
+// an update of the swap, and adds the update as a new event if it changed the
+// swap's state. Updates that were read from the database already hold all of
+// their events, and are returned unchanged.
+func withSwapEvents(known []*loopdb.LoopEvent,
+	update loop.SwapInfo) loop.SwapInfo {
+
+	if update.Events != nil {
+		return update
+	}
+
+	lastState := loopdb.StateInitiated
+	if len(known) > 0 {
+		lastState = known[len(known)-1].State
+	}
+
+	if update.State == lastState {
+		update.Events = known
+		return update
+	}
+
+	// Copy our known events so that the slice of the previous update is
+	// not modified.
+	events := make([]*loopdb.LoopEvent, len(known), len(known)+1)
+	copy(events, known)
+
+	update.Events = append(events, &loopdb.LoopEvent{
+		SwapStateData: update.SwapStateData,
+		Time:          update.LastUpdate,
+	})
+
+	return update
+}
+
+// slaKey identifies the swaps that we aggregate durations for.
+type slaKey struct {
+	server   string
+	swapType swap.Type
+}
+
+// slaStats aggregates the expected and actual durations of a set of
+// successful swaps.
+type slaStats struct {
+	slaKey
+
+	// swaps is the number of swaps that were aggregated.
+	swaps int
+
+	// late is the number of swaps that took longer than expected.
+	late int
+
+	// expected and actual are the total expected and actual durations
+	// of the swaps.
+	expected time.Duration
+	actual   time.Duration
+
+	// phaseOrder holds the states that the swaps passed through, in the
+	// order that they were first reached.
+	phaseOrder []loopdb.SwapState
+
+	// phaseTotals and phaseCounts hold the total time that the swaps
+	// spent in each state, and the number of swaps that passed through
+	// the state.
+	phaseTotals map[loopdb.SwapState]time.Duration
+	phaseCounts map[loopdb.SwapState]int
+}
+
+// newSLAStats creates an empty aggregate for the swaps identified by the key
+// provided.
+func newSLAStats(key slaKey) *slaStats {
+	return &slaStats{
+		slaKey:      key,
+		phaseTotals: make(map[loopdb.SwapState]time.Duration),
+		phaseCounts: make(map[loopdb.SwapState]int),
+	}
+}
+
+// add adds a successful swap's durations to our aggregate.
+func (s *slaStats) add(info *loop.SwapInfo) {
+	actual := info.LastUpdate.Sub(info.InitiationTime)
+
+	s.swaps++
+	s.expected += info.SLA.ExpectedDuration
+	s.actual += actual
+
+	if actual > info.SLA.ExpectedDuration {
+		s.late++
+	}
+
+	// Count every state once per swap, so that states that a swap
+	// returned to do not skew our averages.
+	seen := make(map[loopdb.SwapState]bool)
+	for _, phase := range swapPhases(info) {
+		if _, ok := s.phaseTotals[phase.State]; !ok {
+			s.phaseOrder = append(s.phaseOrder, phase.State)
+		}
+
+		s.phaseTotals[phase.State] += phase.Duration
+
+		if !seen[phase.State] {
+			s.phaseCounts[phase.State]++
+			seen[phase.State] = true
+		}
+	}
+}
+
+// aggregateSLAs aggregates the durations of all successful swaps that
+// recorded an expected duration, by the server that they were made with and
+// their type. The aggregates are sorted by server and type.
+func aggregateSLAs(swaps map[lntypes.Hash]loop.SwapInfo) []*slaStats {
+	// Add our swaps in the order that they were initiated, so that the
+	// order in which we first see each state is deterministic.
+	completed := make([]loop.SwapInfo, 0, len(swaps))
+	for _, swp := range swaps {
+		if swp.SLA == nil || swp.State != loopdb.StateSuccess {
+			continue
+		}
+
+		completed = append(completed, swp)
+	}
+
+	sort.Slice(completed, func(i, j int) bool {
+		return completed[i].InitiationTime.Before(
+			completed[j].InitiationTime,
+		)
+	})
+
+	aggregates := make(map[slaKey]*slaStats)
+	for i := range completed {
+		swp := &completed[i]
+
+		key := slaKey{
+			server:   swp.SLA.Server,
+			swapType: swp.SwapType,
+		}
+
+		stats, ok := aggregates[key]
+		if !ok {
+			stats = newSLAStats(key)
+			aggregates[key] = stats
+		}
+
+		stats.add(swp)
+	}
+
+	result := make([]*slaStats, 0, len(aggregates))
+	for _, stats := range aggregates {
+		result = append(result, stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].server != result[j].server {
+			return result[i].server < result[j].server
+		}
+
+		return result[i].swapType < result[j].swapType
+	})
+
+	return result
+}
+
+// marshallSLAStats converts aggregated swap durations to their rpc
+// representation.
+func marshallSLAStats(stats *slaStats) (*looprpc.SwapSlaStats, error) {
+	var swapType looprpc.SwapType
+	switch stats.swapType {
+	case swap.TypeOut:
+		swapType = looprpc.SwapType_LOOP_OUT
+
+	case swap.TypeIn:
+		swapType = looprpc.SwapType_LOOP_IN
+
+	default:
+		return nil, errors.New("unknown swap type")
+	}
+
+	var (
+		count       = time.Duration(stats.swaps)
+		avgExpected = stats.expected / count
+		avgActual   = stats.actual / count
+	)
+
+	rpcStats := &looprpc.SwapSlaStats{
+		Server:                 stats.server,
+		Type:                   swapType,
+		Swaps:                  uint32(stats.swaps),
+		LateSwaps:              uint32(stats.late),
+		AvgExpectedDurationSec: int64(avgExpected.Seconds()),
+		AvgActualDurationSec:   int64(avgActual.Seconds()),
+	}
+
+	for _, state := range stats.phaseOrder {
+		avg := stats.phaseTotals[state] /
+			time.Duration(stats.phaseCounts[state])
+
+		rpcStats.Phases = append(
+			rpcStats.Phases, &looprpc.SwapPhaseDuration{
+				State:          state.String(),
+				AvgDurationSec: int64(avg.Seconds()),
+			},
+		)
+	}
+
+	return rpcStats, nil
+}
+
+// newSwapSLAPoint creates a metrics point for the expected and actual
+// duration of a swap.
+func newSwapSLAPoint(info *loop.SwapInfo, status string, elapsed time.Duration,
+	now time.Time) *metrics.Point {
+
+	expected := info.SLA.ExpectedDuration
+
+	return &metrics.Point{
+		Measurement: swapSLAMeasurement,
+		Tags: map[string]string{
+			"direction": swapDirection(info.SwapType),
+			"initiator": swapInitiator(info.SwapType, info.Label),
+			"server":    info.SLA.Server,
+			"status":    status,
+		},
+		Fields: map[string]interface{}{
+			"expected_duration_sec": expected.Seconds(),
+			"actual_duration_sec":   elapsed.Seconds(),
+			"late":                  elapsed > expected,
+		},
+		Time: now,
+	}
+}
+
+// recordSwapSLA reports the expected and actual duration of a swap once it
+// has succeeded.
+func (s *swapClientServer) recordSwapSLA(info *loop.SwapInfo) {
+	if info.SLA == nil || info.State != loopdb.StateSuccess {
+		return
+	}
+
+	elapsed := info.LastUpdate.Sub(info.InitiationTime)
+	log.Infof("Swap %v completed in %v, expected within %v",
+		info.SwapHash, elapsed, info.SLA.ExpectedDuration)
+
+	if s.metrics != nil {
+		s.metrics.Record(newSwapSLAPoint(
+			info, slaStatusCompleted, elapsed, info.LastUpdate,
+		))
+	}
+}
+
+// overdueSwaps returns the pending swaps that have exceeded their expected
+// duration by more than our alert factor at the time provided, and that we
+// have not reported yet. The swaps returned are marked as reported.
+func (s *swapClientServer) overdueSwaps(now time.Time) []loop.SwapInfo {
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	var overdue []loop.SwapInfo
+	for hash, swp := range s.swaps {
+		if swp.State.Type() != loopdb.StateTypePending {
+			delete(s.slaAlerts, hash)
+			continue
+		}
+
+		if swp.SLA == nil || s.slaAlerts[hash] {
+			continue
+		}
+
+		allowed := time.Duration(
+			float64(swp.SLA.ExpectedDuration) * s.slaAlertFactor,
+		)
+		if now.Sub(swp.InitiationTime) <= allowed {
+			continue
+		}
+
+		s.slaAlerts[hash] = true
+		overdue = append(overdue, swp)
+	}
+
+	return overdue
+}
+
+// reportOverdueSwaps logs a warning for every pending swap that exceeded its
+// expected duration by more than our alert factor at the time provided, and
+// that we have not reported yet.
+func (s *swapClientServer) reportOverdueSwaps(now time.Time) {
+	for _, swp := range s.overdueSwaps(now) {
+		swp := swp
+		elapsed := now.Sub(swp.InitiationTime)
+
+		log.Warnf("Swap %v pending for %v, expected to complete "+
+			"within %v (state: %v)", swp.SwapHash, elapsed,
+			swp.SLA.ExpectedDuration, swp.State)
+
+		if s.metrics != nil {
+			s.metrics.Record(newSwapSLAPoint(
+				&swp, slaStatusOverdue, elapsed, now,
+			))
+		}
+	}
+}
+
+// monitorSwapSLAs periodically reports pending swaps that have exceeded their
+// expected duration by more than our alert factor.
+func (s *swapClientServer) monitorSwapSLAs(ctx context.Context) {
+	ticker := time.NewTicker(slaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.reportOverdueSwaps(time.Now())
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package loopd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// newSLASwap creates a loop in swap with an expected duration that passed
+// through the events provided, completing in its last event's state.
+func newSLASwap(hash lntypes.Hash, initiation time.Time,
+	expected time.Duration, events ...*loopdb.LoopEvent) loop.SwapInfo {
+
+	info := loop.SwapInfo{
+		SwapHash: hash,
+		SwapType: swap.TypeIn,
+		SwapContract: loopdb.SwapContract{
+			InitiationTime: initiation,
+			SLA: &loopdb.SwapSLA{
+				Server:           "server",
+				ExpectedDuration: expected,
+			},
+		},
+		LastUpdate: initiation,
+		Events:     events,
+	}
+
+	if len(events) > 0 {
+		last := events[len(events)-1]
+		info.State = last.State
+		info.LastUpdate = last.Time
+	}
+
+	return info
+}
+
+// newEvent creates a swap event for the state and time provided.
+func newEvent(state loopdb.SwapState, time time.Time) *loopdb.LoopEvent {
+	return &loopdb.LoopEvent{
+		SwapStateData: loopdb.SwapStateData{
+			State: state,
+		},
+		Time: time,
+	}
+}
+
+// TestWithSwapEvents tests tracking of the events of swaps in flight.
+func TestWithSwapEvents(t *testing.T) {
+	start := time.Unix(1000, 0)
+	published := newEvent(loopdb.StateHtlcPublished, start.Add(time.Minute))
+
+	update := func(state loopdb.SwapState, ts time.Time) loop.SwapInfo {
+		return loop.SwapInfo{
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+			},
+			LastUpdate: ts,
+		}
+	}
+
+	// The initial update of a swap does not change its state.
+	info := withSwapEvents(nil, update(loopdb.StateInitiated, start))
+	require.Empty(t, info.Events)
+
+	// Updates that change the swap's state are added as events.
+	info = withSwapEvents(
+		info.Events, update(published.State, published.Time),
+	)
+	require.Equal(t, []*loopdb.LoopEvent{published}, info.Events)
+
+	// Updates in the same state keep our known events.
+	info = withSwapEvents(
+		info.Events, update(published.State, start.Add(time.Hour)),
+	)
+	require.Equal(t, []*loopdb.LoopEvent{published}, info.Events)
+
+	// Updates that hold their own events are not changed.
+	dbEvents := []*loopdb.LoopEvent{
+		newEvent(loopdb.StateSuccess, start.Add(time.Hour)),
+	}
+	dbUpdate := update(loopdb.StateSuccess, start.Add(time.Hour))
+	dbUpdate.Events = dbEvents
+
+	info = withSwapEvents(info.Events, dbUpdate)
+	require.Equal(t, dbEvents, info.Events)
+}
+
+// TestAggregateSLAs tests aggregation of the expected and actual durations of
+// completed swaps.
+func TestAggregateSLAs(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	onTime := newSLASwap(
+		lntypes.Hash{1}, start, time.Hour,
+		newEvent(loopdb.StateHtlcPublished, start.Add(10*time.Minute)),
+		newEvent(loopdb.StateSuccess, start.Add(30*time.Minute)),
+	)
+
+	late := newSLASwap(
+		lntypes.Hash{2}, start.Add(time.Second), time.Hour,
+		newEvent(loopdb.StateHtlcPublished, start.Add(30*time.Minute)),
+		newEvent(loopdb.StateSuccess, start.Add(90*time.Minute)),
+	)
+
+	// Pending swaps and swaps without expectations are not aggregated.
+	pending := newSLASwap(
+		lntypes.Hash{3}, start, time.Hour,
+		newEvent(loopdb.StateHtlcPublished, start.Add(time.Minute)),
+	)
+
+	noSLA := onTime
+	noSLA.SwapHash = lntypes.Hash{4}
+	noSLA.SLA = nil
+
+	otherServer := onTime
+	otherServer.SwapHash = lntypes.Hash{5}
+	otherServer.SLA = &loopdb.SwapSLA{
+		Server:           "another",
+		ExpectedDuration: time.Hour,
+	}
+
+	swaps := make(map[lntypes.Hash]loop.SwapInfo)
+	for _, swp := range []loop.SwapInfo{
+		onTime, late, pending, noSLA, otherServer,
+	} {
+		swaps[swp.SwapHash] = swp
+	}
+
+	stats := aggregateSLAs(swaps)
+	require.Len(t, stats, 2)
+
+	require.Equal(t, "another", stats[0].server)
+	require.Equal(t, 1, stats[0].swaps)
+
+	rpcStats, err := marshallSLAStats(stats[1])
+	require.NoError(t, err)
+
+	require.Equal(t, "server", rpcStats.Server)
+	require.EqualValues(t, 2, rpcStats.Swaps)
+	require.EqualValues(t, 1, rpcStats.LateSwaps)
+	require.EqualValues(t, 3600, rpcStats.AvgExpectedDurationSec)
+
+	// The late swap was initiated a second later, so it took one second
+	// less than 90 minutes.
+	require.EqualValues(t, (30*60+90*60-1)/2, rpcStats.AvgActualDurationSec)
+
+	require.Len(t, rpcStats.Phases, 2)
+	require.Equal(
+		t, loopdb.StateInitiated.String(), rpcStats.Phases[0].State,
+	)
+	require.EqualValues(t, (10*60+30*60-1)/2,
+		rpcStats.Phases[0].AvgDurationSec)
+
+	require.Equal(
+		t, loopdb.StateHtlcPublished.String(), rpcStats.Phases[1].State,
+	)
+	require.EqualValues(t, (20*60+60*60)/2,
+		rpcStats.Phases[1].AvgDurationSec)
+}
+
+// TestOverdueSwaps tests detection of pending swaps that exceed their expected
+// duration.
+func TestOverdueSwaps(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	pending := newSLASwap(
+		lntypes.Hash{1}, start, time.Hour,
+		newEvent(loopdb.StateHtlcPublished, start.Add(time.Minute)),
+	)
+
+	completed := newSLASwap(
+		lntypes.Hash{2}, start, time.Hour,
+		newEvent(loopdb.StateSuccess, start.Add(time.Minute)),
+	)
+
+	s := &swapClientServer{
+		swaps: map[lntypes.Hash]loop.SwapInfo{
+			pending.SwapHash:   pending,
+			completed.SwapHash: completed,
+		},
+		slaAlertFactor: 2,
+		slaAlerts:      make(map[lntypes.Hash]bool),
+	}
+
+	// Swaps are not overdue until they exceed their expected duration by
+	// our alert factor.
+	require.Empty(t, s.overdueSwaps(start.Add(2*time.Hour)))
+
+	overdue := s.overdueSwaps(start.Add(2*time.Hour + time.Second))
+	require.Len(t, overdue, 1)
+	require.Equal(t, pending.SwapHash, overdue[0].SwapHash)
+
+	// Swaps are only reported once.
+	require.Empty(t, s.overdueSwaps(start.Add(3*time.Hour)))
+
+	// Once the swap completes, it is no longer tracked.
+	s.swaps[pending.SwapHash] = completed
+	require.Empty(t, s.overdueSwaps(start.Add(3*time.Hour)))
+	require.Empty(t, s.slaAlerts)
+}
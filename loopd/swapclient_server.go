@@ -1,16 +1,19 @@
 package loopd
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
@@ -18,11 +21,12 @@ import (
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/metrics"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
-	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"google.golang.org/grpc/codes"
@@ -54,6 +58,11 @@ var (
 	errBalanceTooLow = errors.New(
 		"channel balance too low for loop out amount",
 	)
+
+	// errSwapPending is returned when a proof is requested for a swap
+	// that has not yet completed.
+	errSwapPending = errors.New("proofs can only be produced for " +
+		"completed swaps")
 )
 
 // swapClientServer implements the grpc service exposed by loopd.
@@ -65,13 +74,113 @@ type swapClientServer struct {
 	network          lndclient.Network
 	impl             *loop.Client
 	liquidityMgr     *liquidity.Manager
+	metrics          *metrics.InfluxExporter
 	lnd              *lndclient.LndServices
 	swaps            map[lntypes.Hash]loop.SwapInfo
-	subscribers      map[int]chan<- interface{}
+	subscribers      map[int]*subscriberQueue
 	statusChan       chan loop.SwapInfo
 	nextSubscriberID int
 	swapsLock        sync.Mutex
+	profileDir       string
 	mainCtx          context.Context
+
+	// historyDeferred is true while the swaps that were in a final state
+	// when we started have not been read into swaps yet. Only our pending
+	// swaps are read at startup, and the rest of our swap history is read
+	// the first time that it is needed. It is guarded by swapsLock.
+	historyDeferred bool
+
+	// monitorBuffer is the number of swap updates that we buffer for each
+	// of our subscribers. If it is zero, our default buffer size is used.
+	monitorBuffer int
+
+	// monitorOverflow is the policy that applies when the buffer of one of
+	// our subscribers is full. If it is empty, we drop the oldest update.
+	monitorOverflow string
+
+	// requests tracks the swap requests that were made with a client
+	// provided request ID, keyed by tenant and ID.
+	requests     map[requestKey]*clientRequest
+	requestsLock sync.Mutex
+
+	// validateMacaroon is used to check that callers that override our
+	// volume limits or destination allowlist hold the permission to do
+	// so.
+	validateMacaroon macaroonValidator
+
+	// approvalThreshold is the amount above which swaps must be approved
+	// before they are executed. If it is zero, no approval is required.
+	approvalThreshold btcutil.Amount
+
+	// approvalTimeout is the amount of time that swaps which require
+	// approval may be approved within.
+	approvalTimeout time.Duration
+
+	// allowedDests is the set of destinations that loop out swaps may be
+	// requested to without an admin macaroon. If it is nil, all
+	// destinations are allowed.
+	allowedDests destAllowlist
+
+	// policyLock guards our approval settings and destination allowlist,
+	// which may be replaced when our config is reloaded.
+	policyLock sync.RWMutex
+
+	// reload reads our config again and applies the options that can be
+	// changed without a restart, returning the names of the options that
+	// changed. It is nil if our config cannot be reloaded.
+	reload func() ([]string, error)
+
+	// missionControl provides access to lnd's mission control. It is nil
+	// if we could not connect to lnd's router rpc.
+	missionControl *lndMissionControl
+
+	// nextAddr generates the address that loop out swaps without a
+	// destination are swept to. If it is nil, lnd's next wallet address is
+	// used.
+	nextAddr func(ctx context.Context) (btcutil.Address, error)
+
+	// feeOverpaymentPercent is the percentage by which a completed swap's
+	// realized fees may exceed its quote before we report an overpayment.
+	// If it is zero, overpayments are not reported.
+	feeOverpaymentPercent float64
+
+	// slaAlertFactor is the factor by which a pending swap may exceed its
+	// expected duration before we report it. If it is zero, overdue swaps
+	// are not reported.
+	slaAlertFactor float64
+
+	// slaAlerts holds the pending swaps that we already reported as
+	// overdue, so that each swap is only reported once. It is guarded by
+	// swapsLock.
+	slaAlerts map[lntypes.Hash]bool
+
+	// health holds the health of our pending swaps, as classified by our
+	// last health check.
+	health     map[lntypes.Hash]*swapHealthEntry
+	healthLock sync.Mutex
+
+	// tenants separates the swaps of our callers in multi-tenant mode. It
+	// is nil if multi-tenant mode is disabled.
+	tenants *tenantPolicy
+
+	// closer closes channels once they are drained. It is nil if we could
+	// not connect to lnd's lightning rpc.
+	closer channelCloser
+
+	// channelFees raises our fees on the channels that autoloop drains.
+	// It is nil if our fees are not adjusted.
+	channelFees *lndChannelFees
+
+	// plansLock serializes the updates of our swap plans by our rpc
+	// handlers and by the executor that dispatches their steps.
+	plansLock sync.Mutex
+
+	// paths holds the resolved locations of the files that we use.
+	paths *looprpc.DaemonPaths
+
+	// statusLinks signs the links that grant read-only access to the
+	// status of a single swap. It is nil if status links are unavailable.
+	statusLinks *statusLinks
 }
 
 // LoopOut initiates an loop out swap with the given parameters. The call
@@ -84,11 +193,85 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 
 	log.Infof("Loop out request received")
 
+	req, err := s.unmarshallLoopOutRequest(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkVolumeOverride(ctx, in.OverrideVolumeLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.reserveRequest(
+		req.Tenant, in.RequestId, swap.TypeOut,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		log.Infof("Returning existing swap for request id %v",
+			in.RequestId)
+
+		return existing, nil
+	}
+
+	// Swaps that exceed our approval threshold are only reserved, and
+	// executed once they are approved.
+	if s.requiresApproval(req.Amount) {
+		reservation, err := s.requestLoopOutApproval(ctx, req)
+		if err != nil {
+			s.completeRequest(req.Tenant, in.RequestId, nil)
+
+			log.Errorf("Loop out approval: %v", err)
+			return nil, volumeLimitError(err)
+		}
+
+		response := pendingApprovalResponse(reservation)
+		s.completeRequest(req.Tenant, in.RequestId, response)
+
+		return response, nil
+	}
+
+	info, err := s.impl.LoopOut(ctx, req)
+	if err != nil {
+		s.completeRequest(req.Tenant, in.RequestId, nil)
+
+		log.Errorf("LoopOut: %v", err)
+		return nil, volumeLimitError(err)
+	}
+
+	response := &looprpc.SwapResponse{
+		Id:               info.SwapHash.String(),
+		IdBytes:          info.SwapHash[:],
+		HtlcAddress:      info.HtlcAddressP2WSH.String(),
+		HtlcAddressP2Wsh: info.HtlcAddressP2WSH.String(),
+		ServerMessage:    info.ServerMessage,
+		StructuredServerMessage: marshallServerMessage(
+			info.StructuredServerMessage,
+		),
+		HtlcAddresses: marshallHtlcAddresses(info.HtlcAddresses()),
+	}
+	s.completeRequest(req.Tenant, in.RequestId, response)
+
+	return response, nil
+}
+
+// unmarshallLoopOutRequest validates a loop out request and converts it to
+// the request that is passed to our client.
+func (s *swapClientServer) unmarshallLoopOutRequest(ctx context.Context,
+	in *looprpc.LoopOutRequest) (*loop.OutRequest, error) {
+
 	var sweepAddr btcutil.Address
 	if in.Dest == "" {
 		// Generate sweep address if none specified.
+		nextAddr := s.lnd.WalletKit.NextAddr
+		if s.nextAddr != nil {
+			nextAddr = s.nextAddr
+		}
+
 		var err error
-		sweepAddr, err = s.lnd.WalletKit.NextAddr(context.Background())
+		sweepAddr, err = nextAddr(context.Background())
 		if err != nil {
 			return nil, fmt.Errorf("NextAddr error: %v", err)
 		}
@@ -100,6 +283,18 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		if err != nil {
 			return nil, fmt.Errorf("decode address: %v", err)
 		}
+
+		// Only destinations that are provided by our caller are
+		// checked against our allowlist, since addresses from our own
+		// wallet cannot be tampered with.
+		if err := s.checkDestination(ctx, sweepAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	tenant, err := s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	sweepConfTarget, err := validateLoopOutRequest(
@@ -125,6 +320,10 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		),
 		Label:     in.Label,
 		Initiator: in.Initiator,
+		RequestID: in.RequestId,
+		Tenant:    tenant,
+
+		OverrideVolumeLimits: in.OverrideVolumeLimits,
 	}
 
 	switch {
@@ -139,19 +338,7 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		req.OutgoingChanSet = in.OutgoingChanSet
 	}
 
-	info, err := s.impl.LoopOut(ctx, req)
-	if err != nil {
-		log.Errorf("LoopOut: %v", err)
-		return nil, err
-	}
-
-	return &looprpc.SwapResponse{
-		Id:               info.SwapHash.String(),
-		IdBytes:          info.SwapHash[:],
-		HtlcAddress:      info.HtlcAddressP2WSH.String(),
-		HtlcAddressP2Wsh: info.HtlcAddressP2WSH.String(),
-		ServerMessage:    info.ServerMessage,
-	}, nil
+	return req, nil
 }
 
 func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
@@ -235,7 +422,7 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		return nil, errors.New("unknown swap type")
 	}
 
-	return &looprpc.SwapStatus{
+	rpcSwap := &looprpc.SwapStatus{
 		Amt:               int64(loopSwap.AmountRequested),
 		Id:                loopSwap.SwapHash.String(),
 		IdBytes:           loopSwap.SwapHash[:],
@@ -251,7 +438,99 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		CostOnchain:       int64(loopSwap.Cost.Onchain),
 		CostOffchain:      int64(loopSwap.Cost.Offchain),
 		Label:             loopSwap.Label,
-	}, nil
+		StructuredServerMessage: marshallServerMessage(
+			loopSwap.ServerMessage,
+		),
+		RequestId:         loopSwap.RequestID,
+		HtlcConfirmations: loopSwap.HtlcConfirmations,
+		HtlcAddresses: marshallHtlcAddresses(
+			loopSwap.HtlcAddresses(),
+		),
+	}
+
+	if loopSwap.Approval != nil {
+		rpcSwap.ApprovedBy = loopSwap.Approval.Approver
+		rpcSwap.RequestedBy = loopSwap.Approval.Requester
+		rpcSwap.ApprovalTime = loopSwap.Approval.Time.UnixNano()
+	}
+
+	if loopSwap.HtlcTxHash != nil {
+		rpcSwap.HtlcTxid = loopSwap.HtlcTxHash.String()
+	}
+
+	rpcSwap.CostPrepay = int64(loopSwap.Prepay.Cost())
+	rpcSwap.PrepayLost = loopSwap.PrepayLost() != 0
+
+	if loopSwap.SLA != nil {
+		rpcSwap.ExpectedCompletionTime = loopSwap.InitiationTime.Add(
+			loopSwap.SLA.ExpectedDuration,
+		).UnixNano()
+	}
+
+	status, ok := s.swapHealth(loopSwap.SwapHash, loopSwap.State)
+	if ok && loopSwap.State.Type() == loopdb.StateTypePending {
+		rpcSwap.Health = marshallSwapHealth(status.health)
+		rpcSwap.HealthReason = status.reason
+		rpcSwap.SuggestedAction = status.action
+	}
+
+	return rpcSwap, nil
+}
+
+// marshallServerMessage converts a structured server message to its rpc
+// representation, returning nil if no message is present.
+func marshallServerMessage(
+	msg *loopdb.ServerMessage) *looprpc.StructuredServerMessage {
+
+	if msg == nil {
+		return nil
+	}
+
+	return &looprpc.StructuredServerMessage{
+		Code:   msg.Code,
+		Params: msg.Params,
+	}
+}
+
+// marshallHtlcAddresses converts a swap's htlc addresses to their rpc
+// representation.
+func marshallHtlcAddresses(
+	addresses loop.HtlcAddresses) *looprpc.HtlcAddresses {
+
+	rpcAddresses := &looprpc.HtlcAddresses{
+		Addresses: make([]*looprpc.HtlcAddress, 0, len(addresses)),
+	}
+
+	for _, address := range addresses {
+		rpcAddresses.Addresses = append(
+			rpcAddresses.Addresses, &looprpc.HtlcAddress{
+				OutputType: marshallHtlcOutputType(
+					address.OutputType,
+				),
+				Address: address.Address.EncodeAddress(),
+				Primary: address.Primary,
+			},
+		)
+	}
+
+	return rpcAddresses
+}
+
+// marshallHtlcOutputType converts a htlc output type to its rpc
+// representation.
+func marshallHtlcOutputType(
+	outputType swap.HtlcOutputType) looprpc.HtlcOutputType {
+
+	switch outputType {
+	case swap.HtlcP2WSH:
+		return looprpc.HtlcOutputType_HTLC_OUTPUT_TYPE_P2WSH
+
+	case swap.HtlcNP2WSH:
+		return looprpc.HtlcOutputType_HTLC_OUTPUT_TYPE_NP2WSH
+
+	default:
+		return looprpc.HtlcOutputType_HTLC_OUTPUT_TYPE_UNKNOWN
+	}
 }
 
 // Monitor will return a stream of swap updates for currently active swaps.
@@ -260,7 +539,16 @@ func (s *swapClientServer) Monitor(in *looprpc.MonitorRequest,
 
 	log.Infof("Monitor request received")
 
+	tenant, err := s.tenants.tenant(server.Context())
+	if err != nil {
+		return err
+	}
+
 	send := func(info loop.SwapInfo) error {
+		if !visibleTo(&info, tenant) {
+			return nil
+		}
+
 		rpcSwap, err := s.marshallSwap(&info)
 		if err != nil {
 			return err
@@ -270,20 +558,30 @@ func (s *swapClientServer) Monitor(in *looprpc.MonitorRequest,
 	}
 
 	// Start a notification queue for this subscriber.
-	queue := queue.NewConcurrentQueue(20)
-	queue.Start()
+	queue := s.newSubscriber("monitor")
 
 	// Add this subscriber to the global subscriber list. Also create a
 	// snapshot of all pending and completed swaps within the lock, to
 	// prevent subscribers from receiving duplicate updates.
 	s.swapsLock.Lock()
 
+	if err := s.loadSwaps(); err != nil {
+		s.swapsLock.Unlock()
+
+		return err
+	}
+
 	id := s.nextSubscriberID
 	s.nextSubscriberID++
-	s.subscribers[id] = queue.ChanIn()
+	s.subscribers[id] = queue
 
 	var pendingSwaps, completedSwaps []loop.SwapInfo
 	for _, swap := range s.swaps {
+		swap := swap
+		if !visibleTo(&swap, tenant) {
+			continue
+		}
+
 		if swap.State.Type() == loopdb.StateTypePending {
 			pendingSwaps = append(pendingSwaps, swap)
 		} else {
@@ -293,11 +591,15 @@ func (s *swapClientServer) Monitor(in *looprpc.MonitorRequest,
 
 	s.swapsLock.Unlock()
 
+	// We stop our queue before we unsubscribe, so that our status update
+	// handler does not wait for us to read updates while it holds the
+	// swaps lock.
 	defer func() {
+		queue.stop()
+
 		s.swapsLock.Lock()
 		delete(s.subscribers, id)
 		s.swapsLock.Unlock()
-		queue.Stop()
 	}()
 
 	// Sort completed swaps new to old.
@@ -333,14 +635,12 @@ func (s *swapClientServer) Monitor(in *looprpc.MonitorRequest,
 	// updates.
 	for {
 		select {
-		case queueItem, ok := <-queue.ChanOut():
-			if !ok {
-				return nil
-			}
-
-			swap := queueItem.(loop.SwapInfo)
-			if err := send(swap); err != nil {
-				return err
+		case <-queue.updates():
+			for _, queueItem := range queue.drain() {
+				swap := queueItem.(loop.SwapInfo)
+				if err := send(swap); err != nil {
+					return err
+				}
 			}
 
 		// The client cancels the subscription.
@@ -356,35 +656,42 @@ func (s *swapClientServer) Monitor(in *looprpc.MonitorRequest,
 
 // ListSwaps returns a list of all currently known swaps and their current
 // status.
-func (s *swapClientServer) ListSwaps(_ context.Context,
+func (s *swapClientServer) ListSwaps(ctx context.Context,
 	_ *looprpc.ListSwapsRequest) (*looprpc.ListSwapsResponse, error) {
 
-	var (
-		rpcSwaps = make([]*looprpc.SwapStatus, len(s.swaps))
-		idx      = 0
-		err      error
-	)
+	tenant, err := s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	s.swapsLock.Lock()
 	defer s.swapsLock.Unlock()
 
+	if err := s.loadSwaps(); err != nil {
+		return nil, err
+	}
+
 	// We can just use the server's in-memory cache as that contains the
 	// most up-to-date state including temporary failures which aren't
-	// persisted to disk. The swaps field is a map, that's why we need an
-	// additional index.
+	// persisted to disk.
+	rpcSwaps := make([]*looprpc.SwapStatus, 0, len(s.swaps))
 	for _, swp := range s.swaps {
 		swp := swp
-		rpcSwaps[idx], err = s.marshallSwap(&swp)
+		if !visibleTo(&swp, tenant) {
+			continue
+		}
+
+		rpcSwap, err := s.marshallSwap(&swp)
 		if err != nil {
 			return nil, err
 		}
-		idx++
+		rpcSwaps = append(rpcSwaps, rpcSwap)
 	}
 	return &looprpc.ListSwapsResponse{Swaps: rpcSwaps}, nil
 }
 
 // SwapInfo returns all known details about a single swap.
-func (s *swapClientServer) SwapInfo(_ context.Context,
+func (s *swapClientServer) SwapInfo(ctx context.Context,
 	req *looprpc.SwapInfoRequest) (*looprpc.SwapStatus, error) {
 
 	swapHash, err := lntypes.MakeHash(req.Id)
@@ -392,298 +699,1483 @@ func (s *swapClientServer) SwapInfo(_ context.Context,
 		return nil, fmt.Errorf("error parsing swap hash: %v", err)
 	}
 
-	// Just return the server's in-memory cache here too as we also want to
-	// return temporary failures to the client.
-	swp, ok := s.swaps[swapHash]
-	if !ok {
-		return nil, fmt.Errorf("swap with hash %s not found", req.Id)
+	tenant, err := s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return s.marshallSwap(&swp)
-}
-
-// LoopOutTerms returns the terms that the server enforces for loop out swaps.
-func (s *swapClientServer) LoopOutTerms(ctx context.Context,
-	req *looprpc.TermsRequest) (*looprpc.OutTermsResponse, error) {
-
-	log.Infof("Loop out terms request received")
 
-	terms, err := s.impl.LoopOutTerms(ctx)
+	// Just return the server's in-memory cache here too as we also want to
+	// return temporary failures to the client. Swaps of other tenants are
+	// reported as unknown.
+	swp, ok, err := s.knownSwap(swapHash)
 	if err != nil {
-		log.Errorf("Terms request: %v", err)
 		return nil, err
 	}
 
-	return &looprpc.OutTermsResponse{
-		MinSwapAmount: int64(terms.MinSwapAmount),
-		MaxSwapAmount: int64(terms.MaxSwapAmount),
-		MinCltvDelta:  terms.MinCltvDelta,
-		MaxCltvDelta:  terms.MaxCltvDelta,
-	}, nil
+	if !ok || !visibleTo(&swp, tenant) {
+		return nil, fmt.Errorf("swap with hash %s not found", req.Id)
+	}
+	return s.marshallSwap(&swp)
 }
 
-// LoopOutQuote returns a quote for a loop out swap with the provided
-// parameters.
-func (s *swapClientServer) LoopOutQuote(ctx context.Context,
-	req *looprpc.QuoteRequest) (*looprpc.OutQuoteResponse, error) {
+// GetSwapStats returns the outcomes of all the swaps that we know of, and the
+// prepays that we lost to failed swaps.
+func (s *swapClientServer) GetSwapStats(ctx context.Context,
+	_ *looprpc.SwapStatsRequest) (*looprpc.SwapStatsResponse, error) {
 
-	confTarget, err := validateConfTarget(
-		req.ConfTarget, loop.DefaultSweepConfTarget,
-	)
+	tenant, err := s.tenants.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
-	quote, err := s.impl.LoopOutQuote(ctx, &loop.LoopOutQuoteRequest{
-		Amount:          btcutil.Amount(req.Amt),
-		SweepConfTarget: confTarget,
-		SwapPublicationDeadline: time.Unix(
-			int64(req.SwapPublicationDeadline), 0,
-		),
-	})
-	if err != nil {
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	if err := s.loadSwaps(); err != nil {
 		return nil, err
 	}
 
-	return &looprpc.OutQuoteResponse{
-		HtlcSweepFeeSat: int64(quote.MinerFee),
-		PrepayAmtSat:    int64(quote.PrepayAmount),
-		SwapFeeSat:      int64(quote.SwapFee),
-		SwapPaymentDest: quote.SwapPaymentDest[:],
-		ConfTarget:      confTarget,
-	}, nil
-}
+	swaps := make(map[lntypes.Hash]loop.SwapInfo, len(s.swaps))
+	for hash, swp := range s.swaps {
+		swp := swp
+		if visibleTo(&swp, tenant) {
+			swaps[hash] = swp
+		}
+	}
 
-// GetTerms returns the terms that the server enforces for swaps.
-func (s *swapClientServer) GetLoopInTerms(ctx context.Context,
-	req *looprpc.TermsRequest) (*looprpc.InTermsResponse, error) {
+	stats := &looprpc.SwapStatsResponse{}
+	for _, swp := range swaps {
+		state := swp.State.Type()
+		if state == loopdb.StateTypePending {
+			continue
+		}
 
-	log.Infof("Loop in terms request received")
+		stats.TotalCost += int64(swp.Cost.Total())
 
-	terms, err := s.impl.LoopInTerms(ctx)
-	if err != nil {
-		log.Errorf("Terms request: %v", err)
-		return nil, err
-	}
+		success := state == loopdb.StateTypeSuccess
+		switch {
+		case swp.SwapType == swap.TypeOut && success:
+			stats.LoopOutSucceeded++
 
-	return &looprpc.InTermsResponse{
-		MinSwapAmount: int64(terms.MinSwapAmount),
-		MaxSwapAmount: int64(terms.MaxSwapAmount),
-	}, nil
-}
+		case swp.SwapType == swap.TypeOut:
+			stats.LoopOutFailed++
 
-// GetQuote returns a quote for a swap with the provided parameters.
-func (s *swapClientServer) GetLoopInQuote(ctx context.Context,
-	req *looprpc.QuoteRequest) (*looprpc.InQuoteResponse, error) {
+		case success:
+			stats.LoopInSucceeded++
 
-	log.Infof("Loop in quote request received")
+		default:
+			stats.LoopInFailed++
+		}
 
-	htlcConfTarget, err := validateLoopInRequest(
-		req.ConfTarget, req.ExternalHtlc,
-	)
-	if err != nil {
-		return nil, err
+		if lost := swp.PrepayLost(); lost != 0 {
+			stats.PrepaysLost++
+			stats.PrepayLoss += int64(lost)
+		}
 	}
 
-	var lastHop *route.Vertex
-	if req.LoopInLastHop != nil {
-		lastHopVertex, err := route.NewVertexFromBytes(
-			req.LoopInLastHop,
-		)
+	for _, sla := range aggregateSLAs(swaps) {
+		rpcSLA, err := marshallSLAStats(sla)
 		if err != nil {
 			return nil, err
 		}
 
-		lastHop = &lastHopVertex
-	}
-
-	routeHints, err := unmarshallRouteHints(req.LoopInRouteHints)
-	if err != nil {
-		return nil, err
+		stats.SlaStats = append(stats.SlaStats, rpcSLA)
 	}
 
-	quote, err := s.impl.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
-		Amount:         btcutil.Amount(req.Amt),
-		HtlcConfTarget: htlcConfTarget,
-		ExternalHtlc:   req.ExternalHtlc,
-		LastHop:        lastHop,
-		RouteHints:     routeHints,
-	})
-	if err != nil {
-		return nil, err
-	}
-	return &looprpc.InQuoteResponse{
-		HtlcPublishFeeSat: int64(quote.MinerFee),
-		SwapFeeSat:        int64(quote.SwapFee),
-		ConfTarget:        htlcConfTarget,
-	}, nil
+	return stats, nil
 }
 
-// unmarshallRouteHints unmarshalls a list of route hints.
-func unmarshallRouteHints(rpcRouteHints []*looprpc.RouteHint) (
-	[][]zpay32.HopHint, error) {
+// GetInfo returns basic information about the daemon and the state of its
+// connection to the swap server. The connection is omitted in watch mode,
+// where we don't connect to the server.
+func (s *swapClientServer) GetInfo(_ context.Context,
+	_ *looprpc.GetInfoRequest) (*looprpc.GetInfoResponse, error) {
 
-	routeHints := make([][]zpay32.HopHint, 0, len(rpcRouteHints))
-	for _, rpcRouteHint := range rpcRouteHints {
-		routeHint := make(
-			[]zpay32.HopHint, 0, len(rpcRouteHint.HopHints),
-		)
-		for _, rpcHint := range rpcRouteHint.HopHints {
-			hint, err := unmarshallHopHint(rpcHint)
-			if err != nil {
-				return nil, err
-			}
+	resp := &looprpc.GetInfoResponse{
+		Version: loop.Version(),
+		Network: string(s.network),
+		Paths:   s.paths,
+	}
 
-			routeHint = append(routeHint, hint)
-		}
-		routeHints = append(routeHints, routeHint)
+	if s.impl != nil {
+		resp.ServerConnection = marshallServerConn(
+			s.impl.ServerConnInfo(),
+		)
+		resp.LndConnection = marshallLndConn(s.impl.LndConnInfo())
+		resp.UserAgent = s.impl.UserAgent()
+	}
+
+	return resp, nil
+}
+
+// marshallServerConn converts the state of our server connection to its rpc
+// representation.
+func marshallServerConn(info loop.ServerConnInfo) *looprpc.ServerConnection {
+	events := make([]*looprpc.ServerConnectionEvent, len(info.Events))
+	for i, event := range info.Events {
+		events[i] = &looprpc.ServerConnectionEvent{
+			State: marshallServerConnState(event.State),
+			Time:  event.Time.UnixNano(),
+		}
+	}
+
+	return &looprpc.ServerConnection{
+		State:      marshallServerConnState(info.State),
+		StateSince: info.Since.UnixNano(),
+		Reconnects: info.Reconnects,
+		Events:     events,
+	}
+}
+
+// marshallServerConnState converts a server connection state to its rpc
+// representation.
+func marshallServerConnState(
+	state loop.ServerConnState) looprpc.ServerConnectionState {
+
+	switch state {
+	case loop.ServerConnConnecting:
+		return looprpc.ServerConnectionState_SERVER_CONNECTION_CONNECTING
+
+	case loop.ServerConnReady:
+		return looprpc.ServerConnectionState_SERVER_CONNECTION_READY
+
+	case loop.ServerConnTransientFailure:
+		return looprpc.ServerConnectionState_SERVER_CONNECTION_TRANSIENT_FAILURE
+
+	case loop.ServerConnShutdown:
+		return looprpc.ServerConnectionState_SERVER_CONNECTION_SHUTDOWN
+
+	default:
+		return looprpc.ServerConnectionState_SERVER_CONNECTION_IDLE
+	}
+}
+
+// marshallLndConn converts the state of our lnd connection to its rpc
+// representation.
+func marshallLndConn(info loop.LndConnInfo) *looprpc.LndConnection {
+	events := make([]*looprpc.LndConnectionEvent, len(info.Events))
+	for i, event := range info.Events {
+		events[i] = &looprpc.LndConnectionEvent{
+			State:        marshallLndConnState(event.State),
+			Time:         event.Time.UnixNano(),
+			ResumedSwaps: uint32(event.ResumedSwaps),
+		}
+	}
+
+	return &looprpc.LndConnection{
+		State:      marshallLndConnState(info.State),
+		StateSince: info.Since.UnixNano(),
+		Reconnects: info.Reconnects,
+		Events:     events,
+	}
+}
+
+// marshallLndConnState converts an lnd connection state to its rpc
+// representation.
+func marshallLndConnState(state loop.LndConnState) looprpc.LndConnectionState {
+	if state == loop.LndConnDisconnected {
+		return looprpc.LndConnectionState_LND_CONNECTION_DISCONNECTED
+	}
+
+	return looprpc.LndConnectionState_LND_CONNECTION_CONNECTED
+}
+
+// GetSwapProof returns the data required to independently verify a completed
+// swap on chain and off chain.
+func (s *swapClientServer) GetSwapProof(ctx context.Context,
+	req *looprpc.SwapProofRequest) (*looprpc.SwapProof, error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	tenant, err := s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	swp, ok, err := s.knownSwap(swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok || !visibleTo(&swp, tenant) {
+		return nil, fmt.Errorf("swap with hash %v not found", swapHash)
+	}
+
+	if swp.State.Type() == loopdb.StateTypePending {
+		return nil, errSwapPending
+	}
+
+	// Marshal our swap so that we can reuse its state, failure reason
+	// and address fields.
+	status, err := s.marshallSwap(&swp)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptVersion := loop.GetHtlcScriptVersion(swp.ProtocolVersion)
+	htlc, err := swap.NewHtlc(
+		scriptVersion, swp.CltvExpiry, swp.SenderKey, swp.ReceiverKey,
+		swapHash, swap.HtlcP2WSH, s.lnd.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &looprpc.SwapProof{
+		Id:                swapHash[:],
+		Type:              status.Type,
+		State:             status.State,
+		FailureReason:     status.FailureReason,
+		Amt:               int64(swp.AmountRequested),
+		Preimage:          swp.Preimage[:],
+		HtlcScriptVersion: uint32(scriptVersion),
+		HtlcScript:        htlc.Script(),
+		HtlcAddressP2Wsh:  status.HtlcAddressP2Wsh,
+		HtlcAddressNp2Wsh: status.HtlcAddressNp2Wsh,
+		SenderKey:         swp.SenderKey[:],
+		ReceiverKey:       swp.ReceiverKey[:],
+		CltvExpiry:        swp.CltvExpiry,
+		InitiationHeight:  swp.InitiationHeight,
+		CostServer:        status.CostServer,
+		CostOnchain:       status.CostOnchain,
+		CostOffchain:      status.CostOffchain,
+	}
+
+	if swp.HtlcTxHash != nil {
+		proof.HtlcTxid = swp.HtlcTxHash.String()
+	}
+
+	// Our in-memory swaps do not contain the swap invoices, so we look
+	// them up separately.
+	switch swp.SwapType {
+	case swap.TypeOut:
+		swaps, err := s.impl.Store.FetchLoopOutSwaps()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, out := range swaps {
+			if out.Hash != swapHash {
+				continue
+			}
+
+			proof.SwapInvoice = out.Contract.SwapInvoice
+			proof.PrepayInvoice = out.Contract.PrepayInvoice
+		}
+
+	// We do not store loop in swap invoices, so we look them up in lnd's
+	// invoice database.
+	case swap.TypeIn:
+		invoice, err := s.lnd.Client.LookupInvoice(ctx, swapHash)
+		if err != nil {
+			return nil, fmt.Errorf("could not lookup swap invoice: "+
+				"%v", err)
+		}
+
+		proof.SwapInvoice = invoice.PaymentRequest
+	}
+
+	proof.Transactions, err = s.swapTransactions(ctx, swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// swapTransactions returns the on chain transactions that our wallet has
+// published for a swap, identified by the labels that we publish them with.
+func (s *swapClientServer) swapTransactions(ctx context.Context,
+	swapHash lntypes.Hash) ([]*looprpc.SwapProofTransaction, error) {
+
+	shortHash := swap.ShortHash(&swapHash)
+	swapLabels := map[string]bool{
+		labels.LoopOutSweepSuccess(shortHash): true,
+		labels.LoopInHtlcLabel(shortHash):     true,
+		labels.LoopInSweepTimeout(shortHash):  true,
+	}
+
+	txns, err := s.lnd.Client.ListTransactions(ctx, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcTxns []*looprpc.SwapProofTransaction
+	for _, tx := range txns {
+		if !swapLabels[tx.Label] {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := tx.Tx.Serialize(&buf); err != nil {
+			return nil, err
+		}
+
+		rpcTxns = append(rpcTxns, &looprpc.SwapProofTransaction{
+			Txid:  tx.TxHash,
+			Label: tx.Label,
+			RawTx: buf.Bytes(),
+		})
+	}
+
+	return rpcTxns, nil
+}
+
+// PreviewSweep returns the transaction that a pending loop out would sweep its
+// htlc with if it swept now.
+func (s *swapClientServer) PreviewSweep(ctx context.Context,
+	req *looprpc.PreviewSweepRequest) (*looprpc.PreviewSweepResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tenant, err := s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	swp, ok, err := s.knownSwap(swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok || !visibleTo(&swp, tenant) {
+		return nil, status.Errorf(
+			codes.NotFound, "swap with hash %v not found", swapHash,
+		)
+	}
+
+	preview, err := s.impl.PreviewSweep(ctx, swapHash)
+	switch err {
+	case nil:
+
+	case loop.ErrSweepNotReady:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return nil, err
+	}
+
+	return marshallSweepPreview(preview, s.lnd.ChainParams)
+}
+
+// marshallSweepPreview returns the rpc representation of a sweep preview.
+func marshallSweepPreview(preview *loop.SweepPreview,
+	params *chaincfg.Params) (*looprpc.PreviewSweepResponse, error) {
+
+	resp := &looprpc.PreviewSweepResponse{
+		FeeSat:         int64(preview.Fee),
+		RequiredFeeSat: int64(preview.RequiredFee),
+		Weight:         preview.Weight,
+		SatPerKw:       int64(preview.FeeRate),
+		ConfTarget:     preview.ConfTarget,
+		Publish:        preview.Publish,
+		Reason:         preview.Reason,
+	}
+
+	if preview.Tx == nil {
+		return resp, nil
+	}
+
+	var buf bytes.Buffer
+	if err := preview.Tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	resp.RawTx = buf.Bytes()
+	resp.Txid = preview.Tx.TxHash().String()
+
+	// Our sweeps only ever spend the htlc.
+	for _, txIn := range preview.Tx.TxIn {
+		resp.Inputs = append(resp.Inputs, &looprpc.SweepInput{
+			Outpoint: txIn.PreviousOutPoint.String(),
+			ValueSat: int64(preview.HtlcValue),
+		})
+	}
+
+	for _, txOut := range preview.Tx.TxOut {
+		output := &looprpc.SweepOutput{
+			ValueSat: txOut.Value,
+		}
+
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			txOut.PkScript, params,
+		)
+		if err == nil && len(addrs) == 1 {
+			output.Address = addrs[0].String()
+		}
+
+		resp.Outputs = append(resp.Outputs, output)
+	}
+
+	return resp, nil
+}
+
+// LoopOutTerms returns the terms that the server enforces for loop out swaps.
+func (s *swapClientServer) LoopOutTerms(ctx context.Context,
+	req *looprpc.TermsRequest) (*looprpc.OutTermsResponse, error) {
+
+	log.Infof("Loop out terms request received")
+
+	terms, err := s.impl.LoopOutTerms(ctx)
+	if err != nil {
+		log.Errorf("Terms request: %v", err)
+		return nil, err
+	}
+
+	return &looprpc.OutTermsResponse{
+		MinSwapAmount: int64(terms.MinSwapAmount),
+		MaxSwapAmount: int64(terms.MaxSwapAmount),
+		MinCltvDelta:  terms.MinCltvDelta,
+		MaxCltvDelta:  terms.MaxCltvDelta,
+	}, nil
+}
+
+// LoopOutQuote returns a quote for a loop out swap with the provided
+// parameters.
+func (s *swapClientServer) LoopOutQuote(ctx context.Context,
+	req *looprpc.QuoteRequest) (*looprpc.OutQuoteResponse, error) {
+
+	confTarget, err := validateConfTarget(
+		req.ConfTarget, loop.DefaultSweepConfTarget,
+	)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := s.impl.LoopOutQuote(ctx, &loop.LoopOutQuoteRequest{
+		Amount:          btcutil.Amount(req.Amt),
+		SweepConfTarget: confTarget,
+		SwapPublicationDeadline: time.Unix(
+			int64(req.SwapPublicationDeadline), 0,
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.OutQuoteResponse{
+		HtlcSweepFeeSat: int64(quote.MinerFee),
+		PrepayAmtSat:    int64(quote.PrepayAmount),
+		SwapFeeSat:      int64(quote.SwapFee),
+		SwapPaymentDest: quote.SwapPaymentDest[:],
+		ConfTarget:      confTarget,
+	}, nil
+}
+
+// GetTerms returns the terms that the server enforces for swaps.
+func (s *swapClientServer) GetLoopInTerms(ctx context.Context,
+	req *looprpc.TermsRequest) (*looprpc.InTermsResponse, error) {
+
+	log.Infof("Loop in terms request received")
+
+	terms, err := s.impl.LoopInTerms(ctx)
+	if err != nil {
+		log.Errorf("Terms request: %v", err)
+		return nil, err
+	}
+
+	return &looprpc.InTermsResponse{
+		MinSwapAmount: int64(terms.MinSwapAmount),
+		MaxSwapAmount: int64(terms.MaxSwapAmount),
+	}, nil
+}
+
+// GetQuote returns a quote for a swap with the provided parameters.
+func (s *swapClientServer) GetLoopInQuote(ctx context.Context,
+	req *looprpc.QuoteRequest) (*looprpc.InQuoteResponse, error) {
+
+	log.Infof("Loop in quote request received")
+
+	htlcConfTarget, err := validateLoopInRequest(
+		req.ConfTarget, req.ExternalHtlc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastHop *route.Vertex
+	if req.LoopInLastHop != nil {
+		lastHopVertex, err := route.NewVertexFromBytes(
+			req.LoopInLastHop,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		lastHop = &lastHopVertex
+	}
+
+	routeHints, err := unmarshallRouteHints(req.LoopInRouteHints)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.impl.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
+		Amount:         btcutil.Amount(req.Amt),
+		HtlcConfTarget: htlcConfTarget,
+		ExternalHtlc:   req.ExternalHtlc,
+		LastHop:        lastHop,
+		RouteHints:     routeHints,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &looprpc.InQuoteResponse{
+		HtlcPublishFeeSat: int64(quote.MinerFee),
+		SwapFeeSat:        int64(quote.SwapFee),
+		ConfTarget:        htlcConfTarget,
+	}, nil
+}
+
+// unmarshallRouteHints unmarshalls a list of route hints.
+func unmarshallRouteHints(rpcRouteHints []*looprpc.RouteHint) (
+	[][]zpay32.HopHint, error) {
+
+	routeHints := make([][]zpay32.HopHint, 0, len(rpcRouteHints))
+	for _, rpcRouteHint := range rpcRouteHints {
+		routeHint := make(
+			[]zpay32.HopHint, 0, len(rpcRouteHint.HopHints),
+		)
+		for _, rpcHint := range rpcRouteHint.HopHints {
+			hint, err := unmarshallHopHint(rpcHint)
+			if err != nil {
+				return nil, err
+			}
+
+			routeHint = append(routeHint, hint)
+		}
+		routeHints = append(routeHints, routeHint)
 	}
 
 	return routeHints, nil
 }
 
-// unmarshallHopHint unmarshalls a single hop hint.
-func unmarshallHopHint(rpcHint *looprpc.HopHint) (zpay32.HopHint, error) {
-	pubBytes, err := hex.DecodeString(rpcHint.NodeId)
+// unmarshallHopHint unmarshalls a single hop hint.
+func unmarshallHopHint(rpcHint *looprpc.HopHint) (zpay32.HopHint, error) {
+	pubBytes, err := hex.DecodeString(rpcHint.NodeId)
+	if err != nil {
+		return zpay32.HopHint{}, err
+	}
+
+	pubkey, err := btcec.ParsePubKey(pubBytes, btcec.S256())
+	if err != nil {
+		return zpay32.HopHint{}, err
+	}
+
+	return zpay32.HopHint{
+		NodeID:                    pubkey,
+		ChannelID:                 rpcHint.ChanId,
+		FeeBaseMSat:               rpcHint.FeeBaseMsat,
+		FeeProportionalMillionths: rpcHint.FeeProportionalMillionths,
+		CLTVExpiryDelta:           uint16(rpcHint.CltvExpiryDelta),
+	}, nil
+}
+
+// Probe requests the server to probe the client's node to test inbound
+// liquidity.
+func (s *swapClientServer) Probe(ctx context.Context,
+	req *looprpc.ProbeRequest) (*looprpc.ProbeResponse, error) {
+
+	log.Infof("Probe request received")
+
+	var lastHop *route.Vertex
+	if req.LastHop != nil {
+		lastHopVertex, err := route.NewVertexFromBytes(req.LastHop)
+		if err != nil {
+			return nil, err
+		}
+
+		lastHop = &lastHopVertex
+	}
+
+	routeHints, err := unmarshallRouteHints(req.RouteHints)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.impl.Probe(ctx, &loop.ProbeRequest{
+		Amount:     btcutil.Amount(req.Amt),
+		LastHop:    lastHop,
+		RouteHints: routeHints,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.ProbeResponse{}, nil
+}
+
+func (s *swapClientServer) LoopIn(ctx context.Context,
+	in *looprpc.LoopInRequest) (
+	*looprpc.SwapResponse, error) {
+
+	log.Infof("Loop in request received")
+
+	req, err := unmarshallLoopInRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Tenant, err = s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkVolumeOverride(ctx, in.OverrideVolumeLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.reserveRequest(
+		req.Tenant, in.RequestId, swap.TypeIn,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		log.Infof("Returning existing swap for request id %v",
+			in.RequestId)
+
+		return existing, nil
+	}
+
+	// Swaps that exceed our approval threshold are only reserved, and
+	// executed once they are approved.
+	if s.requiresApproval(req.Amount) {
+		reservation, err := s.requestLoopInApproval(ctx, req)
+		if err != nil {
+			s.completeRequest(req.Tenant, in.RequestId, nil)
+
+			log.Errorf("Loop in approval: %v", err)
+			return nil, volumeLimitError(err)
+		}
+
+		response := pendingApprovalResponse(reservation)
+		s.completeRequest(req.Tenant, in.RequestId, response)
+
+		return response, nil
+	}
+
+	swapInfo, err := s.impl.LoopIn(ctx, req)
+	if err != nil {
+		s.completeRequest(req.Tenant, in.RequestId, nil)
+
+		log.Errorf("Loop in: %v", err)
+		return nil, volumeLimitError(err)
+	}
+
+	response := &looprpc.SwapResponse{
+		Id:               swapInfo.SwapHash.String(),
+		IdBytes:          swapInfo.SwapHash[:],
+		HtlcAddressP2Wsh: swapInfo.HtlcAddressP2WSH.String(),
+		ServerMessage:    swapInfo.ServerMessage,
+		StructuredServerMessage: marshallServerMessage(
+			swapInfo.StructuredServerMessage,
+		),
+		HtlcAddresses: marshallHtlcAddresses(swapInfo.HtlcAddresses()),
+	}
+
+	if req.ExternalHtlc {
+		response.HtlcAddressNp2Wsh = swapInfo.HtlcAddressNP2WSH.String()
+		response.HtlcAddress = response.HtlcAddressNp2Wsh // nolint:staticcheck
+	} else {
+		response.HtlcAddress = response.HtlcAddressP2Wsh // nolint:staticcheck
+	}
+	s.completeRequest(req.Tenant, in.RequestId, response)
+
+	return response, nil
+}
+
+// errReservationRequestID is returned when a swap reservation is requested
+// with a request ID. Reserved swaps are identified by their hash instead, and
+// may only be confirmed once.
+var errReservationRequestID = status.Error(
+	codes.InvalidArgument, "request ids are not supported for reservations",
+)
+
+// ReserveLoopOut sets up a loop out swap with the server, but only executes it
+// once it is confirmed.
+func (s *swapClientServer) ReserveLoopOut(ctx context.Context,
+	in *looprpc.LoopOutRequest) (*looprpc.SwapReservation, error) {
+
+	log.Infof("Reserve loop out request received")
+
+	if in.RequestId != "" {
+		return nil, errReservationRequestID
+	}
+
+	req, err := s.unmarshallLoopOutRequest(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkVolumeOverride(ctx, in.OverrideVolumeLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservation *loop.SwapReservation
+	if s.requiresApproval(req.Amount) {
+		reservation, err = s.requestLoopOutApproval(ctx, req)
+	} else {
+		reservation, err = s.impl.ReserveLoopOut(ctx, req)
+	}
+	if err != nil {
+		log.Errorf("Reserve loop out: %v", err)
+		return nil, volumeLimitError(err)
+	}
+
+	return marshallReservation(reservation), nil
+}
+
+// ReserveLoopIn sets up a loop in swap with the server, but only executes it
+// once it is confirmed.
+func (s *swapClientServer) ReserveLoopIn(ctx context.Context,
+	in *looprpc.LoopInRequest) (*looprpc.SwapReservation, error) {
+
+	log.Infof("Reserve loop in request received")
+
+	if in.RequestId != "" {
+		return nil, errReservationRequestID
+	}
+
+	req, err := unmarshallLoopInRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Tenant, err = s.tenants.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkVolumeOverride(ctx, in.OverrideVolumeLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservation *loop.SwapReservation
+	if s.requiresApproval(req.Amount) {
+		reservation, err = s.requestLoopInApproval(ctx, req)
+	} else {
+		reservation, err = s.impl.ReserveLoopIn(ctx, req)
+	}
+	if err != nil {
+		log.Errorf("Reserve loop in: %v", err)
+		return nil, volumeLimitError(err)
+	}
+
+	return marshallReservation(reservation), nil
+}
+
+// ConfirmReservation executes a reserved swap. If the swap has already been
+// confirmed, the existing swap is returned.
+func (s *swapClientServer) ConfirmReservation(ctx context.Context,
+	in *looprpc.ConfirmReservationRequest) (*looprpc.SwapResponse, error) {
+
+	log.Infof("Confirm reservation request received")
+
+	hash, err := lntypes.MakeHash(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	reservation, err := s.impl.ConfirmReservation(ctx, hash)
+	switch err {
+	case nil:
+
+	case loop.ErrReservationNotFound:
+		info, ok, err := s.knownSwap(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return existingSwapResponse(&info), nil
+		}
+
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case loop.ErrReservationExpired, loop.ErrApprovalRequired:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		log.Errorf("Confirm reservation: %v", err)
+		return nil, volumeLimitError(err)
+	}
+
+	return reservationSwapResponse(reservation), nil
+}
+
+// reservationSwapResponse returns the swap response for a reserved swap.
+func reservationSwapResponse(
+	reservation *loop.SwapReservation) *looprpc.SwapResponse {
+
+	response := &looprpc.SwapResponse{
+		Id:               reservation.SwapHash.String(),
+		IdBytes:          reservation.SwapHash[:],
+		HtlcAddressP2Wsh: reservation.HtlcAddressP2WSH.String(),
+		ServerMessage:    reservation.ServerMessage,
+		StructuredServerMessage: marshallServerMessage(
+			reservation.StructuredServerMessage,
+		),
+		HtlcAddresses: marshallHtlcAddresses(
+			reservation.HtlcAddresses(),
+		),
+	}
+
+	if reservation.HtlcAddressNP2WSH != nil {
+		response.HtlcAddressNp2Wsh = reservation.HtlcAddressNP2WSH.String()
+		response.HtlcAddress = response.HtlcAddressNp2Wsh // nolint:staticcheck
+	} else {
+		response.HtlcAddress = response.HtlcAddressP2Wsh // nolint:staticcheck
+	}
+
+	return response
+}
+
+// marshallReservation converts a swap reservation to its rpc representation.
+func marshallReservation(
+	reservation *loop.SwapReservation) *looprpc.SwapReservation {
+
+	rpcReservation := &looprpc.SwapReservation{
+		Id:               reservation.SwapHash[:],
+		Type:             looprpc.SwapType_LOOP_OUT,
+		Amt:              int64(reservation.Amount),
+		SwapFeeSat:       int64(reservation.SwapFee),
+		PrepayAmtSat:     int64(reservation.PrepayAmount),
+		MaxMinerFee:      int64(reservation.MaxMinerFee),
+		CltvExpiry:       reservation.CltvExpiry,
+		HtlcAddressP2Wsh: reservation.HtlcAddressP2WSH.String(),
+		ExpiryUnix:       reservation.Expiry.Unix(),
+		ServerMessage:    reservation.ServerMessage,
+		RequiresApproval: reservation.RequiresApproval,
+		RequestedBy:      reservation.Requester,
+		StructuredServerMessage: marshallServerMessage(
+			reservation.StructuredServerMessage,
+		),
+		HtlcAddresses: marshallHtlcAddresses(
+			reservation.HtlcAddresses(),
+		),
+	}
+
+	if reservation.SwapType == swap.TypeIn {
+		rpcReservation.Type = looprpc.SwapType_LOOP_IN
+	}
+
+	if reservation.HtlcAddressNP2WSH != nil {
+		rpcReservation.HtlcAddressNp2Wsh =
+			reservation.HtlcAddressNP2WSH.String()
+	}
+
+	return rpcReservation
+}
+
+// unmarshallLoopInRequest validates a loop in request and converts it to the
+// request that is passed to our client.
+func unmarshallLoopInRequest(in *looprpc.LoopInRequest) (*loop.LoopInRequest,
+	error) {
+
+	htlcConfTarget, err := validateLoopInRequest(
+		in.HtlcConfTarget, in.ExternalHtlc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the label is valid.
+	if err := labels.Validate(in.Label); err != nil {
+		return nil, err
+	}
+
+	req := &loop.LoopInRequest{
+		Amount:         btcutil.Amount(in.Amt),
+		MaxMinerFee:    btcutil.Amount(in.MaxMinerFee),
+		MaxSwapFee:     btcutil.Amount(in.MaxSwapFee),
+		HtlcConfTarget: htlcConfTarget,
+		ExternalHtlc:   in.ExternalHtlc,
+		Label:          in.Label,
+		Initiator:      in.Initiator,
+		RequestID:      in.RequestId,
+
+		OverrideVolumeLimits: in.OverrideVolumeLimits,
+	}
+
+	req.HtlcFunding, err = unmarshallHtlcFunding(
+		in.FundingAccount, in.FundingOutpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.LastHop != nil {
+		lastHop, err := route.NewVertexFromBytes(in.LastHop)
+		if err != nil {
+			return nil, err
+		}
+		req.LastHop = &lastHop
+	}
+
+	return req, nil
+}
+
+// GetLsatTokens returns all tokens that are contained in the LSAT token store.
+func (s *swapClientServer) GetLsatTokens(ctx context.Context,
+	_ *looprpc.TokensRequest) (*looprpc.TokensResponse, error) {
+
+	log.Infof("Get LSAT tokens request received")
+
+	tokens, err := s.impl.LsatStore.AllTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcTokens := make([]*looprpc.LsatToken, len(tokens))
+	idx := 0
+	for key, token := range tokens {
+		macBytes, err := token.BaseMacaroon().MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		rpcTokens[idx] = &looprpc.LsatToken{
+			BaseMacaroon:       macBytes,
+			PaymentHash:        token.PaymentHash[:],
+			PaymentPreimage:    token.Preimage[:],
+			AmountPaidMsat:     int64(token.AmountPaid),
+			RoutingFeePaidMsat: int64(token.RoutingFeePaid),
+			TimeCreated:        token.TimeCreated.Unix(),
+			Expired:            !token.IsValid(),
+			StorageName:        key,
+		}
+		idx++
+	}
+
+	return &looprpc.TokensResponse{Tokens: rpcTokens}, nil
+}
+
+// GetLiquiditySummary returns a summary of our node's current liquidity.
+func (s *swapClientServer) GetLiquiditySummary(ctx context.Context,
+	_ *looprpc.LiquiditySummaryRequest) (*looprpc.LiquiditySummary, error) {
+
+	summary, err := s.liquidityMgr.GetSummary(ctx)
 	if err != nil {
-		return zpay32.HopHint{}, err
+		return nil, err
 	}
 
-	pubkey, err := btcec.ParsePubKey(pubBytes, btcec.S256())
-	if err != nil {
-		return zpay32.HopHint{}, err
+	targets := make([]*looprpc.LiquidityTarget, len(summary.Targets))
+	for i, target := range summary.Targets {
+		target := target
+		rpcTarget := &looprpc.LiquidityTarget{
+			ChannelId:          target.Channel.ToUint64(),
+			IncomingDeficitSat: uint64(target.IncomingDeficit),
+			OutgoingDeficitSat: uint64(target.OutgoingDeficit),
+		}
+
+		if target.Peer != (route.Vertex{}) {
+			rpcTarget.Pubkey = target.Peer[:]
+		}
+
+		targets[i] = rpcTarget
 	}
 
-	return zpay32.HopHint{
-		NodeID:                    pubkey,
-		ChannelID:                 rpcHint.ChanId,
-		FeeBaseMSat:               rpcHint.FeeBaseMsat,
-		FeeProportionalMillionths: rpcHint.FeeProportionalMillionths,
-		CLTVExpiryDelta:           uint16(rpcHint.CltvExpiryDelta),
+	return &looprpc.LiquiditySummary{
+		PendingSweepSat:         uint64(summary.PendingSweep),
+		AutoloopPendingSweepSat: uint64(summary.AutoloopPendingSweep),
+		ConfirmedBalanceSat:     uint64(summary.ConfirmedBalance),
+		UnconfirmedBalanceSat:   uint64(summary.UnconfirmedBalance),
+		LocalBalanceSat:         uint64(summary.LocalBalance),
+		RemoteBalanceSat:        uint64(summary.RemoteBalance),
+		PendingLoopOutSat:       uint64(summary.PendingLoopOut),
+		PendingLoopInSat:        uint64(summary.PendingLoopIn),
+		Targets:                 targets,
 	}, nil
 }
 
-// Probe requests the server to probe the client's node to test inbound
-// liquidity.
-func (s *swapClientServer) Probe(ctx context.Context,
-	req *looprpc.ProbeRequest) (*looprpc.ProbeResponse, error) {
+// ListLiquiditySnapshots returns the liquidity snapshots that we have recorded
+// within the time range requested.
+func (s *swapClientServer) ListLiquiditySnapshots(_ context.Context,
+	req *looprpc.ListLiquiditySnapshotsRequest) (
+	*looprpc.ListLiquiditySnapshotsResponse, error) {
 
-	log.Infof("Probe request received")
+	var start, end time.Time
+	if req.StartTime != 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
 
-	var lastHop *route.Vertex
-	if req.LastHop != nil {
-		lastHopVertex, err := route.NewVertexFromBytes(req.LastHop)
+	if req.EndTime != 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	if !end.IsZero() && end.Before(start) {
+		return nil, status.Error(
+			codes.InvalidArgument, "end time before start time",
+		)
+	}
+
+	snapshots, err := s.liquidityMgr.GetSnapshots(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.ListLiquiditySnapshotsResponse{
+		Snapshots: make([]*looprpc.LiquiditySnapshot, len(snapshots)),
+	}
+
+	for i, snapshot := range snapshots {
+		channels := make(
+			[]*looprpc.ChannelBalanceSnapshot, len(snapshot.Channels),
+		)
+
+		for j, channel := range snapshot.Channels {
+			channel := channel
+			channels[j] = &looprpc.ChannelBalanceSnapshot{
+				ChannelId:        channel.ChannelID,
+				Pubkey:           channel.Peer[:],
+				CapacitySat:      uint64(channel.Capacity),
+				LocalBalanceSat:  uint64(channel.LocalBalance),
+				RemoteBalanceSat: uint64(channel.RemoteBalance),
+			}
+		}
+
+		resp.Snapshots[i] = &looprpc.LiquiditySnapshot{
+			Timestamp:           snapshot.Timestamp.Unix(),
+			ConfirmedBalanceSat: uint64(snapshot.ConfirmedBalance),
+			LocalBalanceSat:     uint64(snapshot.LocalBalance()),
+			RemoteBalanceSat:    uint64(snapshot.RemoteBalance()),
+			Channels:            channels,
+		}
+	}
+
+	return resp, nil
+}
+
+// ListFeeRecords returns the fee records that we have made within the time
+// range requested.
+func (s *swapClientServer) ListFeeRecords(_ context.Context,
+	req *looprpc.ListFeeRecordsRequest) (*looprpc.ListFeeRecordsResponse,
+	error) {
+
+	var start, end time.Time
+	if req.StartTime != 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+
+	if req.EndTime != 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	if !end.IsZero() && end.Before(start) {
+		return nil, status.Error(
+			codes.InvalidArgument, "end time before start time",
+		)
+	}
+
+	records, err := s.impl.Store.FetchFeeRecords(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.ListFeeRecordsResponse{
+		Records: make([]*looprpc.FeeRecord, len(records)),
+	}
+
+	for i, record := range records {
+		rpcRecord := &looprpc.FeeRecord{
+			Timestamp:        record.Time.Unix(),
+			Decision:         looprpc.FeeDecision(record.Decision),
+			ConfTarget:       record.ConfTarget,
+			SatPerKw:         int64(record.FeeRate),
+			FeeSat:           int64(record.Fee),
+			Allowed:          record.Allowed,
+			RealizedSatPerKw: int64(record.RealizedFeeRate),
+		}
+
+		if record.Decision != loopdb.FeeDecisionSuggestion {
+			rpcRecord.Id = record.SwapHash[:]
+		}
+
+		resp.Records[i] = rpcRecord
+	}
+
+	return resp, nil
+}
+
+// ListDispatchRecords returns the records of the suggested swaps that we have
+// dispatched within the time range requested, optionally filtered by swap.
+func (s *swapClientServer) ListDispatchRecords(_ context.Context,
+	req *looprpc.ListDispatchRecordsRequest) (
+	*looprpc.ListDispatchRecordsResponse, error) {
+
+	var start, end time.Time
+	if req.StartTime != 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+
+	if req.EndTime != 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	if !end.IsZero() && end.Before(start) {
+		return nil, status.Error(
+			codes.InvalidArgument, "end time before start time",
+		)
+	}
+
+	var swapHash *lntypes.Hash
+	if len(req.Id) != 0 {
+		hash, err := lntypes.MakeHash(req.Id)
 		if err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.InvalidArgument,
+				"error parsing swap hash: %v", err)
 		}
+		swapHash = &hash
+	}
 
-		lastHop = &lastHopVertex
+	records, err := s.impl.Store.FetchDispatchRecords(start, end)
+	if err != nil {
+		return nil, err
 	}
 
-	routeHints, err := unmarshallRouteHints(req.RouteHints)
+	resp := &looprpc.ListDispatchRecordsResponse{}
+	for _, record := range records {
+		if swapHash != nil && record.SwapHash != *swapHash {
+			continue
+		}
+
+		resp.Records = append(
+			resp.Records, marshallDispatchRecord(record),
+		)
+	}
+
+	return resp, nil
+}
+
+// marshallDispatchRecord converts a dispatch record to its rpc representation.
+func marshallDispatchRecord(
+	record *loopdb.DispatchRecord) *looprpc.DispatchRecord {
+
+	rpcRecord := &looprpc.DispatchRecord{
+		Timestamp:           record.Time.Unix(),
+		Id:                  record.SwapHash[:],
+		Source:              looprpc.DispatchSource(record.Source),
+		Amt:                 uint64(record.Amount),
+		MaxSwapFee:          uint64(record.MaxSwapFee),
+		MaxMinerFee:         uint64(record.MaxMinerFee),
+		MaxSwapRoutingFee:   uint64(record.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: uint64(record.MaxPrepayRoutingFee),
+		SweepConfTarget:     record.SweepConfTarget,
+	}
+
+	for _, rule := range record.Rules {
+		rule := rule
+
+		threshold := liquidity.NewThresholdRule(
+			int(rule.MinimumIncoming), int(rule.MinimumOutgoing),
+		)
+
+		var peer []byte
+		if rule.ChannelID == 0 {
+			peer = rule.Peer[:]
+		}
+
+		rpcRule := newRPCRule(rule.ChannelID, peer, threshold)
+		rpcRecord.Rules = append(rpcRecord.Rules, rpcRule)
+	}
+
+	for _, channel := range record.Channels {
+		channel := channel
+		rpcRecord.Channels = append(
+			rpcRecord.Channels, &looprpc.ChannelBalanceSnapshot{
+				ChannelId:        channel.ChannelID,
+				Pubkey:           channel.Peer[:],
+				CapacitySat:      uint64(channel.Capacity),
+				LocalBalanceSat:  uint64(channel.LocalBalance),
+				RemoteBalanceSat: uint64(channel.RemoteBalance),
+			},
+		)
+	}
+
+	return rpcRecord
+}
+
+// DebugLevel sets the log levels of our subsystems, or lists the subsystems
+// that are available if the request sets show.
+func (s *swapClientServer) DebugLevel(_ context.Context,
+	req *looprpc.DebugLevelRequest) (*looprpc.DebugLevelResponse, error) {
+
+	if req.Show {
+		return &looprpc.DebugLevelResponse{
+			SubSystems: strings.Join(
+				logWriter.SupportedSubsystems(), " ",
+			),
+		}, nil
+	}
+
+	log.Infof("Debug level command received: %v", req.LevelSpec)
+
+	err := build.ParseAndSetDebugLevels(req.LevelSpec, logWriter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &looprpc.DebugLevelResponse{}, nil
+}
+
+// CaptureProfile writes the runtime profiles requested to our profile
+// directory.
+func (s *swapClientServer) CaptureProfile(_ context.Context,
+	req *looprpc.CaptureProfileRequest) (*looprpc.CaptureProfileResponse,
+	error) {
+
+	files, err := writeProfiles(s.profileDir, req.ProfileTypes, time.Now())
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.impl.Probe(ctx, &loop.ProbeRequest{
-		Amount:     btcutil.Amount(req.Amt),
-		LastHop:    lastHop,
-		RouteHints: routeHints,
-	})
+	log.Infof("Captured profiles: %v", files)
+
+	return &looprpc.CaptureProfileResponse{
+		Files: files,
+	}, nil
+}
+
+// SetSwapTemplate validates and stores a named swap template.
+func (s *swapClientServer) SetSwapTemplate(_ context.Context,
+	req *looprpc.SetSwapTemplateRequest) (*looprpc.SetSwapTemplateResponse,
+	error) {
+
+	template, err := unmarshallSwapTemplate(req.Template, s.lnd.ChainParams)
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.impl.Store.PutSwapTemplate(template); err != nil {
 		return nil, err
 	}
 
-	return &looprpc.ProbeResponse{}, nil
+	log.Infof("Stored swap template %v", template.Name)
+
+	return &looprpc.SetSwapTemplateResponse{}, nil
 }
 
-func (s *swapClientServer) LoopIn(ctx context.Context,
-	in *looprpc.LoopInRequest) (
-	*looprpc.SwapResponse, error) {
+// ListSwapTemplates returns all of our stored swap templates.
+func (s *swapClientServer) ListSwapTemplates(_ context.Context,
+	_ *looprpc.ListSwapTemplatesRequest) (
+	*looprpc.ListSwapTemplatesResponse, error) {
 
-	log.Infof("Loop in request received")
+	templates, err := s.impl.Store.FetchSwapTemplates()
+	if err != nil {
+		return nil, err
+	}
 
-	htlcConfTarget, err := validateLoopInRequest(
-		in.HtlcConfTarget, in.ExternalHtlc,
-	)
+	resp := &looprpc.ListSwapTemplatesResponse{
+		Templates: make([]*looprpc.SwapTemplate, len(templates)),
+	}
+	for i, template := range templates {
+		resp.Templates[i] = marshallSwapTemplate(template)
+	}
+
+	return resp, nil
+}
+
+// DeleteSwapTemplate deletes a stored swap template.
+func (s *swapClientServer) DeleteSwapTemplate(_ context.Context,
+	req *looprpc.DeleteSwapTemplateRequest) (
+	*looprpc.DeleteSwapTemplateResponse, error) {
+
+	err := s.impl.Store.DeleteSwapTemplate(req.Name)
+	switch err {
+	case nil:
+
+	case loopdb.ErrSwapTemplateNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	default:
+		return nil, err
+	}
+
+	log.Infof("Deleted swap template %v", req.Name)
+
+	return &looprpc.DeleteSwapTemplateResponse{}, nil
+}
+
+// ResolveSwapTemplate returns a stored swap template with the amount that it
+// should be dispatched with, which is calculated from our channel balances
+// for templates that set a target local balance.
+func (s *swapClientServer) ResolveSwapTemplate(ctx context.Context,
+	req *looprpc.ResolveSwapTemplateRequest) (
+	*looprpc.ResolveSwapTemplateResponse, error) {
+
+	templates, err := s.impl.Store.FetchSwapTemplates()
 	if err != nil {
 		return nil, err
 	}
 
-	// Check that the label is valid.
-	if err := labels.Validate(in.Label); err != nil {
+	var template *loopdb.SwapTemplate
+	for _, t := range templates {
+		if t.Name == req.Name {
+			template = t
+			break
+		}
+	}
+
+	if template == nil {
+		return nil, status.Error(
+			codes.NotFound, loopdb.ErrSwapTemplateNotFound.Error(),
+		)
+	}
+
+	channels, err := s.lnd.Client.ListChannels(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	req := &loop.LoopInRequest{
-		Amount:         btcutil.Amount(in.Amt),
-		MaxMinerFee:    btcutil.Amount(in.MaxMinerFee),
-		MaxSwapFee:     btcutil.Amount(in.MaxSwapFee),
-		HtlcConfTarget: htlcConfTarget,
-		ExternalHtlc:   in.ExternalHtlc,
-		Label:          in.Label,
-		Initiator:      in.Initiator,
+	amount, err := templateAmount(template, channels)
+	if errors.Is(err, errNoTemplateChannels) ||
+		errors.Is(err, errTemplateAtTarget) {
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
-	if in.LastHop != nil {
-		lastHop, err := route.NewVertexFromBytes(in.LastHop)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcTemplate := marshallSwapTemplate(template)
+	rpcTemplate.Amt = int64(amount)
+
+	return &looprpc.ResolveSwapTemplateResponse{
+		Template: rpcTemplate,
+	}, nil
+}
+
+// SetFeeBudget validates and stores the fee budget of a tenant or label.
+func (s *swapClientServer) SetFeeBudget(_ context.Context,
+	req *looprpc.SetFeeBudgetRequest) (*looprpc.SetFeeBudgetResponse,
+	error) {
+
+	budget, err := unmarshallFeeBudget(req.Budget)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.impl.Store.PutFeeBudget(budget); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Set fee budget of %v for %v %v", budget.Budget,
+		budget.Namespace, budget.Name)
+
+	return &looprpc.SetFeeBudgetResponse{}, nil
+}
+
+// ListFeeBudgets returns all of our fee budgets along with the fees that have
+// been spent from them.
+func (s *swapClientServer) ListFeeBudgets(_ context.Context,
+	_ *looprpc.ListFeeBudgetsRequest) (*looprpc.ListFeeBudgetsResponse,
+	error) {
+
+	budgets, err := s.impl.Store.FetchFeeBudgets()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.ListFeeBudgetsResponse{
+		Budgets: make([]*looprpc.FeeBudgetStatus, len(budgets)),
+	}
+	for i, budget := range budgets {
+		spent, err := s.impl.FeeBudgetSpent(budget)
 		if err != nil {
 			return nil, err
 		}
-		req.LastHop = &lastHop
+
+		remaining := budget.Budget - spent
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		resp.Budgets[i] = &looprpc.FeeBudgetStatus{
+			Budget:       marshallFeeBudget(budget),
+			SpentSat:     int64(spent),
+			RemainingSat: int64(remaining),
+		}
 	}
-	swapInfo, err := s.impl.LoopIn(ctx, req)
+
+	return resp, nil
+}
+
+// DeleteFeeBudget deletes the fee budget of a tenant or label.
+func (s *swapClientServer) DeleteFeeBudget(_ context.Context,
+	req *looprpc.DeleteFeeBudgetRequest) (*looprpc.DeleteFeeBudgetResponse,
+	error) {
+
+	namespace, err := unmarshallBudgetNamespace(req.Namespace)
 	if err != nil {
-		log.Errorf("Loop in: %v", err)
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	response := &looprpc.SwapResponse{
-		Id:               swapInfo.SwapHash.String(),
-		IdBytes:          swapInfo.SwapHash[:],
-		HtlcAddressP2Wsh: swapInfo.HtlcAddressP2WSH.String(),
-		ServerMessage:    swapInfo.ServerMessage,
-	}
+	err = s.impl.Store.DeleteFeeBudget(namespace, req.Name)
+	switch err {
+	case nil:
 
-	if req.ExternalHtlc {
-		response.HtlcAddressNp2Wsh = swapInfo.HtlcAddressNP2WSH.String()
-		response.HtlcAddress = response.HtlcAddressNp2Wsh // nolint:staticcheck
-	} else {
-		response.HtlcAddress = response.HtlcAddressP2Wsh // nolint:staticcheck
+	case loopdb.ErrFeeBudgetNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	default:
+		return nil, err
 	}
 
-	return response, nil
+	log.Infof("Deleted fee budget for %v %v", namespace, req.Name)
+
+	return &looprpc.DeleteFeeBudgetResponse{}, nil
 }
 
-// GetLsatTokens returns all tokens that are contained in the LSAT token store.
-func (s *swapClientServer) GetLsatTokens(ctx context.Context,
-	_ *looprpc.TokensRequest) (*looprpc.TokensResponse, error) {
+// GetCostStatement returns the amounts and costs of the swaps that are
+// visible to the caller, aggregated by tenant or label.
+func (s *swapClientServer) GetCostStatement(ctx context.Context,
+	req *looprpc.CostStatementRequest) (*looprpc.CostStatement, error) {
 
-	log.Infof("Get LSAT tokens request received")
+	groupBy, err := unmarshallBudgetNamespace(req.GroupBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var start, end time.Time
+	if req.StartTime != 0 {
+		start = time.Unix(int64(req.StartTime), 0)
+	}
+	if req.EndTime != 0 {
+		end = time.Unix(int64(req.EndTime), 0)
+	}
 
-	tokens, err := s.impl.LsatStore.AllTokens()
+	tenant, err := s.tenants.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcTokens := make([]*looprpc.LsatToken, len(tokens))
-	idx := 0
-	for key, token := range tokens {
-		macBytes, err := token.BaseMacaroon().MarshalBinary()
-		if err != nil {
-			return nil, err
-		}
-		rpcTokens[idx] = &looprpc.LsatToken{
-			BaseMacaroon:       macBytes,
-			PaymentHash:        token.PaymentHash[:],
-			PaymentPreimage:    token.Preimage[:],
-			AmountPaidMsat:     int64(token.AmountPaid),
-			RoutingFeePaidMsat: int64(token.RoutingFeePaid),
-			TimeCreated:        token.TimeCreated.Unix(),
-			Expired:            !token.IsValid(),
-			StorageName:        key,
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	if err := s.loadSwaps(); err != nil {
+		return nil, err
+	}
+
+	swaps := make(map[lntypes.Hash]loop.SwapInfo, len(s.swaps))
+	for hash, swp := range s.swaps {
+		swp := swp
+		if visibleTo(&swp, tenant) {
+			swaps[hash] = swp
 		}
-		idx++
 	}
 
-	return &looprpc.TokensResponse{Tokens: rpcTokens}, nil
+	return &looprpc.CostStatement{
+		Costs: aggregateCosts(swaps, groupBy, start, end),
+	}, nil
 }
 
 // GetLiquidityParams gets our current liquidity manager's parameters.
@@ -704,8 +2196,26 @@ func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 		Rules: make(
 			[]*looprpc.LiquidityRule, 0, totalRules,
 		),
-		MinSwapAmount: uint64(cfg.ClientRestrictions.Minimum),
-		MaxSwapAmount: uint64(cfg.ClientRestrictions.Maximum),
+		MinSwapAmount:         uint64(cfg.ClientRestrictions.Minimum),
+		MaxSwapAmount:         uint64(cfg.ClientRestrictions.Maximum),
+		AutoloopLabelTemplate: cfg.AutoloopLabelTemplate,
+		AllowedPeers:          marshallPeerSet(cfg.AllowedPeers),
+		ExcludedPeers:         marshallPeerSet(cfg.ExcludedPeers),
+		MinUptimePercent:      cfg.MinUptimePercent,
+		MaxPeerFeeIncreasePercent: uint64(
+			cfg.MaxPeerFeeIncreasePercent,
+		),
+		UnrestrictedMode: looprpc.UnrestrictedSwapMode(
+			cfg.UnrestrictedMode,
+		),
+		AggregateDeficits:   cfg.AggregateDeficits,
+		Priority:            looprpc.SuggestionPriority(cfg.Priority),
+		PeerWeights:         marshallPeerWeights(cfg.PeerWeights),
+		AmountJitterPercent: cfg.AmountJitterPercent,
+		CircularMode:        looprpc.CircularMode(cfg.CircularMode),
+		AutoloopBudgetRefreshPeriodSec: uint64(
+			cfg.AutoFeeRefreshPeriod.Seconds(),
+		),
 	}
 
 	switch f := cfg.FeeLimit.(type) {
@@ -749,6 +2259,88 @@ func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 	return rpcCfg, nil
 }
 
+// marshallPeerSet converts a set of peers to a list of serialized pubkeys.
+func marshallPeerSet(peers map[route.Vertex]struct{}) [][]byte {
+	rpcPeers := make([][]byte, 0, len(peers))
+	for peer := range peers {
+		peer := peer
+		rpcPeers = append(rpcPeers, peer[:])
+	}
+
+	return rpcPeers
+}
+
+// unmarshallPeerSet converts a list of serialized pubkeys to a set of peers,
+// failing if any peer is duplicated.
+func unmarshallPeerSet(rpcPeers [][]byte) (map[route.Vertex]struct{},
+	error) {
+
+	if len(rpcPeers) == 0 {
+		return nil, nil
+	}
+
+	peers := make(map[route.Vertex]struct{}, len(rpcPeers))
+	for _, rpcPeer := range rpcPeers {
+		peer, err := route.NewVertexFromBytes(rpcPeer)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := peers[peer]; ok {
+			return nil, fmt.Errorf("peer: %v listed more than "+
+				"once", peer)
+		}
+
+		peers[peer] = struct{}{}
+	}
+
+	return peers, nil
+}
+
+// marshallPeerWeights converts a set of peer weights to their rpc
+// representation.
+func marshallPeerWeights(
+	weights map[route.Vertex]uint64) []*looprpc.PeerWeight {
+
+	rpcWeights := make([]*looprpc.PeerWeight, 0, len(weights))
+	for peer, weight := range weights {
+		peer := peer
+		rpcWeights = append(rpcWeights, &looprpc.PeerWeight{
+			Pubkey: peer[:],
+			Weight: weight,
+		})
+	}
+
+	return rpcWeights
+}
+
+// unmarshallPeerWeights converts a set of rpc peer weights to a map of peer
+// to weight, failing if any peer is duplicated.
+func unmarshallPeerWeights(rpcWeights []*looprpc.PeerWeight) (
+	map[route.Vertex]uint64, error) {
+
+	if len(rpcWeights) == 0 {
+		return nil, nil
+	}
+
+	weights := make(map[route.Vertex]uint64, len(rpcWeights))
+	for _, rpcWeight := range rpcWeights {
+		peer, err := route.NewVertexFromBytes(rpcWeight.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := weights[peer]; ok {
+			return nil, fmt.Errorf("peer: %v weight set more "+
+				"than once", peer)
+		}
+
+		weights[peer] = rpcWeight.Weight
+	}
+
+	return weights, nil
+}
+
 func newRPCRule(channelID uint64, peer []byte,
 	rule *liquidity.ThresholdRule) *looprpc.LiquidityRule {
 
@@ -758,6 +2350,9 @@ func newRPCRule(channelID uint64, peer []byte,
 		Type:              looprpc.LiquidityRuleType_THRESHOLD,
 		IncomingThreshold: uint32(rule.MinimumIncoming),
 		OutgoingThreshold: uint32(rule.MinimumOutgoing),
+		SuccessCooldownSec: uint64(
+			rule.SuccessCooldown / time.Second,
+		),
 	}
 }
 
@@ -777,19 +2372,51 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 		SweepConfTarget: in.Parameters.SweepConfTarget,
 		FailureBackOff: time.Duration(in.Parameters.FailureBackoffSec) *
 			time.Second,
-		Autoloop:        in.Parameters.Autoloop,
-		AutoFeeBudget:   btcutil.Amount(in.Parameters.AutoloopBudgetSat),
+		Autoloop:      in.Parameters.Autoloop,
+		AutoFeeBudget: btcutil.Amount(in.Parameters.AutoloopBudgetSat),
+		AutoFeeRefreshPeriod: time.Duration(
+			in.Parameters.AutoloopBudgetRefreshPeriodSec,
+		) * time.Second,
 		MaxAutoInFlight: int(in.Parameters.AutoMaxInFlight),
-		ChannelRules: make(
-			map[lnwire.ShortChannelID]*liquidity.ThresholdRule,
-		),
-		PeerRules: make(
-			map[route.Vertex]*liquidity.ThresholdRule,
-		),
 		ClientRestrictions: liquidity.Restrictions{
 			Minimum: btcutil.Amount(in.Parameters.MinSwapAmount),
 			Maximum: btcutil.Amount(in.Parameters.MaxSwapAmount),
 		},
+		AutoloopLabelTemplate: in.Parameters.AutoloopLabelTemplate,
+		MinUptimePercent:      in.Parameters.MinUptimePercent,
+		MaxPeerFeeIncreasePercent: uint64(
+			in.Parameters.MaxPeerFeeIncreasePercent,
+		),
+		UnrestrictedMode: liquidity.UnrestrictedMode(
+			in.Parameters.UnrestrictedMode,
+		),
+		AggregateDeficits:   in.Parameters.AggregateDeficits,
+		Priority:            liquidity.Priority(in.Parameters.Priority),
+		AmountJitterPercent: in.Parameters.AmountJitterPercent,
+		CircularMode: liquidity.CircularMode(
+			in.Parameters.CircularMode,
+		),
+	}
+
+	params.AllowedPeers, err = unmarshallPeerSet(
+		in.Parameters.AllowedPeers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	params.ExcludedPeers, err = unmarshallPeerSet(
+		in.Parameters.ExcludedPeers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	params.PeerWeights, err = unmarshallPeerWeights(
+		in.Parameters.PeerWeights,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	// Zero unix time is different to zero golang time.
@@ -799,55 +2426,79 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 		)
 	}
 
-	for _, rule := range in.Parameters.Rules {
+	params.ChannelRules, params.PeerRules, err = rpcToRules(
+		in.Parameters.Rules,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.liquidityMgr.SetParameters(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &looprpc.SetLiquidityParamsResponse{}, nil
+}
+
+// rpcToRules converts a set of rpc rules to our channel and peer rules,
+// failing if a rule does not target exactly one channel or peer, or if
+// multiple rules target the same channel or peer.
+func rpcToRules(rules []*looprpc.LiquidityRule) (
+	map[lnwire.ShortChannelID]*liquidity.ThresholdRule,
+	map[route.Vertex]*liquidity.ThresholdRule, error) {
+
+	var (
+		chanRules = make(
+			map[lnwire.ShortChannelID]*liquidity.ThresholdRule,
+		)
+		peerRules = make(map[route.Vertex]*liquidity.ThresholdRule)
+	)
+
+	for _, rule := range rules {
 		peerRule := rule.Pubkey != nil
 		chanRule := rule.ChannelId != 0
 
 		liquidityRule, err := rpcToRule(rule)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		switch {
 		case peerRule && chanRule:
-			return nil, fmt.Errorf("cannot set channel: %v and "+
-				"peer: %v fields in rule", rule.ChannelId,
+			return nil, nil, fmt.Errorf("cannot set channel: %v "+
+				"and peer: %v fields in rule", rule.ChannelId,
 				rule.Pubkey)
 
 		case peerRule:
 			pubkey, err := route.NewVertexFromBytes(rule.Pubkey)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
-			if _, ok := params.PeerRules[pubkey]; ok {
-				return nil, fmt.Errorf("multiple rules set "+
-					"for peer: %v", pubkey)
+			if _, ok := peerRules[pubkey]; ok {
+				return nil, nil, fmt.Errorf("multiple rules "+
+					"set for peer: %v", pubkey)
 			}
 
-			params.PeerRules[pubkey] = liquidityRule
+			peerRules[pubkey] = liquidityRule
 
 		case chanRule:
 			shortID := lnwire.NewShortChanIDFromInt(rule.ChannelId)
 
-			if _, ok := params.ChannelRules[shortID]; ok {
-				return nil, fmt.Errorf("multiple rules set "+
-					"for channel: %v", shortID)
+			if _, ok := chanRules[shortID]; ok {
+				return nil, nil, fmt.Errorf("multiple rules "+
+					"set for channel: %v", shortID)
 			}
 
-			params.ChannelRules[shortID] = liquidityRule
+			chanRules[shortID] = liquidityRule
 
 		default:
-			return nil, errors.New("please set channel id or " +
-				"pubkey for rule")
+			return nil, nil, errors.New("please set channel id " +
+				"or pubkey for rule")
 		}
 	}
 
-	if err := s.liquidityMgr.SetParameters(ctx, params); err != nil {
-		return nil, err
-	}
-
-	return &looprpc.SetLiquidityParamsResponse{}, nil
+	return chanRules, peerRules, nil
 }
 
 // rpcToFee converts the values provided over rpc to a fee limit interface,
@@ -896,10 +2547,15 @@ func rpcToRule(rule *looprpc.LiquidityRule) (*liquidity.ThresholdRule, error) {
 		return nil, fmt.Errorf("rule type field must be set")
 
 	case looprpc.LiquidityRuleType_THRESHOLD:
-		return liquidity.NewThresholdRule(
+		threshold := liquidity.NewThresholdRule(
 			int(rule.IncomingThreshold),
 			int(rule.OutgoingThreshold),
-		), nil
+		)
+		threshold.SuccessCooldown = time.Duration(
+			rule.SuccessCooldownSec,
+		) * time.Second
+
+		return threshold, nil
 
 	default:
 		return nil, fmt.Errorf("unknown rule: %T", rule)
@@ -910,9 +2566,15 @@ func rpcToRule(rule *looprpc.LiquidityRule) (*liquidity.ThresholdRule, error) {
 // SuggestSwaps provides a list of suggested swaps based on lnd's current
 // channel balances and rules set by the liquidity manager.
 func (s *swapClientServer) SuggestSwaps(ctx context.Context,
-	_ *looprpc.SuggestSwapsRequest) (*looprpc.SuggestSwapsResponse, error) {
+	req *looprpc.SuggestSwapsRequest) (*looprpc.SuggestSwapsResponse,
+	error) {
 
-	suggestions, err := s.liquidityMgr.SuggestSwaps(ctx, false)
+	opts, err := rpcToSuggestOptions(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	suggestions, err := s.liquidityMgr.SuggestSwapsWithOptions(ctx, opts)
 	switch err {
 	case liquidity.ErrNoRules:
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
@@ -925,20 +2587,19 @@ func (s *swapClientServer) SuggestSwaps(ctx context.Context,
 
 	var (
 		loopOut      []*looprpc.LoopOutRequest
+		elided       []*looprpc.LoopOutRequest
 		disqualified []*looprpc.Disqualified
+		comparisons  []*looprpc.CircularComparison
+		circular     []*looprpc.CircularRebalance
+		channels     []*looprpc.ChannelComparison
 	)
 
 	for _, swap := range suggestions.OutSwaps {
-		loopOut = append(loopOut, &looprpc.LoopOutRequest{
-			Amt:                 int64(swap.Amount),
-			OutgoingChanSet:     swap.OutgoingChanSet,
-			MaxSwapFee:          int64(swap.MaxSwapFee),
-			MaxMinerFee:         int64(swap.MaxMinerFee),
-			MaxPrepayAmt:        int64(swap.MaxPrepayAmount),
-			MaxSwapRoutingFee:   int64(swap.MaxSwapRoutingFee),
-			MaxPrepayRoutingFee: int64(swap.MaxPrepayRoutingFee),
-			SweepConfTarget:     swap.SweepConfTarget,
-		})
+		loopOut = append(loopOut, rpcSuggestedLoopOut(swap))
+	}
+
+	for _, swap := range suggestions.ElidedOutSwaps {
+		elided = append(elided, rpcSuggestedLoopOut(swap))
 	}
 
 	for id, reason := range suggestions.DisqualifiedChans {
@@ -969,12 +2630,251 @@ func (s *swapClientServer) SuggestSwaps(ctx context.Context,
 		disqualified = append(disqualified, exclChan)
 	}
 
+	for _, comparison := range suggestions.CircularComparisons {
+		rpcComparison := &looprpc.CircularComparison{
+			LoopOut:        rpcSuggestedLoopOut(comparison.LoopOut),
+			LoopOutFeeSat:  int64(comparison.LoopOutFees),
+			CircularChosen: comparison.UseCircular,
+		}
+
+		if comparison.Rebalance != nil {
+			rpcComparison.Rebalance = rpcCircularRebalance(
+				comparison.Rebalance,
+			)
+		}
+
+		comparisons = append(comparisons, rpcComparison)
+	}
+
+	for _, rebalance := range suggestions.CircularRebalances {
+		rebalance := rebalance
+		circular = append(circular, rpcCircularRebalance(&rebalance))
+	}
+
+	for _, comparison := range suggestions.ChannelComparisons {
+		rpcComparison := &looprpc.ChannelComparison{
+			LoopOut:        rpcSuggestedLoopOut(comparison.LoopOut),
+			LoopOutFeeSat:  int64(comparison.LoopOutFees),
+			ChannelCheaper: comparison.ChannelCheaper,
+		}
+
+		for _, quote := range comparison.Quotes {
+			rpcComparison.Quotes = append(
+				rpcComparison.Quotes, &looprpc.ChannelQuote{
+					Provider:    quote.Provider,
+					CapacitySat: int64(quote.Capacity),
+					FeeSat:      int64(quote.Fee),
+					LeaseBlocks: quote.LeaseBlocks,
+				},
+			)
+		}
+
+		channels = append(channels, rpcComparison)
+	}
+
 	return &looprpc.SuggestSwapsResponse{
-		LoopOut:      loopOut,
-		Disqualified: disqualified,
+		LoopOut:             loopOut,
+		Disqualified:        disqualified,
+		BudgetElided:        elided,
+		CircularComparisons: comparisons,
+		CircularRebalances:  circular,
+		ChannelComparisons:  channels,
 	}, nil
 }
 
+// QuoteSuggestions returns the current quote for each loop out in a bundle of
+// suggestions, along with the totals of the bundle.
+func (s *swapClientServer) QuoteSuggestions(ctx context.Context,
+	req *looprpc.QuoteSuggestionsRequest) (
+	*looprpc.QuoteSuggestionsResponse, error) {
+
+	swaps := make([]loop.OutRequest, len(req.LoopOut))
+	for i, loopOut := range req.LoopOut {
+		if loopOut.Amt <= 0 {
+			return nil, status.Error(codes.InvalidArgument,
+				"loop out amount must be positive")
+		}
+
+		swaps[i] = rpcToSuggestedLoopOut(loopOut)
+	}
+
+	bundle, err := s.liquidityMgr.QuoteSuggestions(ctx, swaps)
+	switch err {
+	case liquidity.ErrNoRules:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case nil:
+
+	default:
+		return nil, err
+	}
+
+	resp := &looprpc.QuoteSuggestionsResponse{
+		Quotes: make(
+			[]*looprpc.SuggestionQuote, len(bundle.Swaps),
+		),
+		TotalAmtSat:    int64(bundle.TotalAmount),
+		TotalFeeSat:    int64(bundle.TotalFees),
+		TotalMaxFeeSat: int64(bundle.TotalMaxFees),
+	}
+
+	for i, quote := range bundle.Swaps {
+		resp.Quotes[i] = &looprpc.SuggestionQuote{
+			LoopOut:         rpcSuggestedLoopOut(quote.Request),
+			SwapFeeSat:      int64(quote.Quote.SwapFee),
+			PrepayAmtSat:    int64(quote.Quote.PrepayAmount),
+			HtlcSweepFeeSat: int64(quote.Quote.MinerFee),
+			FeeSat:          int64(quote.Fees()),
+			MaxFeeSat:       int64(quote.MaxFees),
+		}
+	}
+
+	return resp, nil
+}
+
+// ApplySuggestions dispatches a selected subset of our current loop out
+// suggestions.
+func (s *swapClientServer) ApplySuggestions(ctx context.Context,
+	req *looprpc.ApplySuggestionsRequest) (
+	*looprpc.ApplySuggestionsResponse, error) {
+
+	selection := &liquidity.SuggestionSelection{
+		Indexes: make([]int, len(req.Indexes)),
+	}
+
+	for i, index := range req.Indexes {
+		selection.Indexes[i] = int(index)
+	}
+
+	if len(req.Channels) != 0 {
+		selection.Channels = make(
+			map[lnwire.ShortChannelID]struct{}, len(req.Channels),
+		)
+	}
+
+	for _, channel := range req.Channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel)
+		selection.Channels[chanID] = struct{}{}
+	}
+
+	applied, err := s.liquidityMgr.ApplySuggestions(ctx, selection)
+	switch err {
+	case liquidity.ErrNoSelection:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+
+	case liquidity.ErrNoRules, liquidity.ErrAutoloopEnabled:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case nil:
+
+	default:
+		return nil, err
+	}
+
+	resp := &looprpc.ApplySuggestionsResponse{
+		Swaps: make([]*looprpc.AppliedSuggestion, len(applied)),
+	}
+
+	for i, swap := range applied {
+		rpcSwap := &looprpc.AppliedSuggestion{
+			LoopOut: rpcSuggestedLoopOut(swap.Request),
+		}
+
+		if swap.Err != nil {
+			rpcSwap.Error = swap.Err.Error()
+		} else {
+			rpcSwap.Id = swap.Swap.SwapHash.String()
+			rpcSwap.HtlcAddress = swap.Swap.HtlcAddressP2WSH.String()
+		}
+
+		resp.Swaps[i] = rpcSwap
+	}
+
+	return resp, nil
+}
+
+// rpcToSuggestedLoopOut converts a suggested loop out's rpc request to the
+// request that it was suggested as.
+func rpcToSuggestedLoopOut(req *looprpc.LoopOutRequest) loop.OutRequest {
+	return loop.OutRequest{
+		Amount:              btcutil.Amount(req.Amt),
+		OutgoingChanSet:     req.OutgoingChanSet,
+		MaxSwapFee:          btcutil.Amount(req.MaxSwapFee),
+		MaxMinerFee:         btcutil.Amount(req.MaxMinerFee),
+		MaxPrepayAmount:     btcutil.Amount(req.MaxPrepayAmt),
+		MaxSwapRoutingFee:   btcutil.Amount(req.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: btcutil.Amount(req.MaxPrepayRoutingFee),
+		SweepConfTarget:     req.SweepConfTarget,
+	}
+}
+
+// rpcToSuggestOptions converts the overrides of a suggest swaps request to
+// the options that our liquidity manager applies to a single set of
+// suggestions.
+func rpcToSuggestOptions(
+	req *looprpc.SuggestSwapsRequest) (*liquidity.SuggestOptions, error) {
+
+	opts := &liquidity.SuggestOptions{
+		MaxSwaps: int(req.MaxSwaps),
+	}
+
+	if len(req.ExcludeChannels) != 0 {
+		opts.ExcludedChannels = make(
+			map[lnwire.ShortChannelID]struct{},
+			len(req.ExcludeChannels),
+		)
+	}
+
+	for _, channel := range req.ExcludeChannels {
+		chanID := lnwire.NewShortChanIDFromInt(channel)
+		opts.ExcludedChannels[chanID] = struct{}{}
+	}
+
+	var err error
+	opts.ExcludedPeers, err = unmarshallPeerSet(req.ExcludePeers)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Rules) == 0 {
+		return opts, nil
+	}
+
+	opts.ChannelRules, opts.PeerRules, err = rpcToRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// rpcCircularRebalance converts a suggested circular rebalance to its rpc
+// representation.
+func rpcCircularRebalance(
+	rebalance *liquidity.CircularRebalance) *looprpc.CircularRebalance {
+
+	return &looprpc.CircularRebalance{
+		Amt:            int64(rebalance.Amount),
+		OutgoingChanId: rebalance.OutgoingChannel.ToUint64(),
+		LastHop:        rebalance.LastHop[:],
+		FeeSat:         int64(rebalance.Fee),
+	}
+}
+
+// rpcSuggestedLoopOut converts a suggested loop out to its rpc request.
+func rpcSuggestedLoopOut(swap loop.OutRequest) *looprpc.LoopOutRequest {
+	return &looprpc.LoopOutRequest{
+		Amt:                 int64(swap.Amount),
+		OutgoingChanSet:     swap.OutgoingChanSet,
+		MaxSwapFee:          int64(swap.MaxSwapFee),
+		MaxMinerFee:         int64(swap.MaxMinerFee),
+		MaxPrepayAmt:        int64(swap.MaxPrepayAmount),
+		MaxSwapRoutingFee:   int64(swap.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: int64(swap.MaxPrepayRoutingFee),
+		SweepConfTarget:     swap.SweepConfTarget,
+	}
+}
+
 func rpcAutoloopReason(reason liquidity.Reason) (looprpc.AutoReason, error) {
 	switch reason {
 	case liquidity.ReasonNone:
@@ -1019,11 +2919,86 @@ func rpcAutoloopReason(reason liquidity.Reason) (looprpc.AutoReason, error) {
 	case liquidity.ReasonFeePPMInsufficient:
 		return looprpc.AutoReason_AUTO_REASON_SWAP_FEE, nil
 
+	case liquidity.ReasonPeerExcluded:
+		return looprpc.AutoReason_AUTO_REASON_PEER_EXCLUDED, nil
+
+	case liquidity.ReasonChannelInactive:
+		return looprpc.AutoReason_AUTO_REASON_CHANNEL_INACTIVE, nil
+
+	case liquidity.ReasonLowUptime:
+		return looprpc.AutoReason_AUTO_REASON_LOW_UPTIME, nil
+
+	case liquidity.ReasonUnrestrictedSwap:
+		return looprpc.AutoReason_AUTO_REASON_UNRESTRICTED_SWAP, nil
+
+	case liquidity.ReasonChannelClosing:
+		return looprpc.AutoReason_AUTO_REASON_CHANNEL_CLOSING, nil
+
+	case liquidity.ReasonSuccessCooldown:
+		return looprpc.AutoReason_AUTO_REASON_SUCCESS_COOLDOWN, nil
+
+	case liquidity.ReasonPeerFeeIncrease:
+		return looprpc.AutoReason_AUTO_REASON_PEER_FEE_INCREASE, nil
+
+	case liquidity.ReasonChannelExcluded:
+		return looprpc.AutoReason_AUTO_REASON_CHANNEL_EXCLUDED, nil
+
 	default:
 		return 0, fmt.Errorf("unknown autoloop reason: %v", reason)
 	}
 }
 
+// loadSwaps reads the swaps that were in a final state when we started into
+// our in-memory swaps, if they have not been read yet. The swaps that we
+// already know of are at least as recent as the swaps in our store, so they
+// are not replaced.
+//
+// NOTE: The swaps lock must be held when calling this function.
+func (s *swapClientServer) loadSwaps() error {
+	if !s.historyDeferred {
+		return nil
+	}
+
+	swaps, err := s.impl.FetchSwaps()
+	if err != nil {
+		return err
+	}
+
+	for _, swp := range swaps {
+		if _, ok := s.swaps[swp.SwapHash]; ok {
+			continue
+		}
+
+		s.swaps[swp.SwapHash] = *swp
+	}
+
+	s.historyDeferred = false
+
+	return nil
+}
+
+// knownSwap returns the swap with the hash provided, and whether we know of
+// it. Our swap history is only read if the swap is not one of the swaps that
+// we have read already.
+func (s *swapClientServer) knownSwap(hash lntypes.Hash) (loop.SwapInfo, bool,
+	error) {
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	if swp, ok := s.swaps[hash]; ok {
+		return swp, true, nil
+	}
+
+	if err := s.loadSwaps(); err != nil {
+		return loop.SwapInfo{}, false, err
+	}
+
+	swp, ok := s.swaps[hash]
+
+	return swp, ok, nil
+}
+
 // processStatusUpdates reads updates on the status channel and processes them.
 //
 // NOTE: This must run inside a goroutine as it blocks until the main context
@@ -1035,12 +3010,12 @@ func (s *swapClientServer) processStatusUpdates(mainCtx context.Context) {
 		// subscribers about the changes.
 		case swp := <-s.statusChan:
 			s.swapsLock.Lock()
+			swp = withSwapEvents(s.swaps[swp.SwapHash].Events, swp)
 			s.swaps[swp.SwapHash] = swp
 
 			for _, subscriber := range s.subscribers {
-				select {
-				case subscriber <- swp:
-				case <-mainCtx.Done():
+				err := subscriber.push(mainCtx, swp)
+				if err != nil {
 					s.swapsLock.Unlock()
 					return
 				}
@@ -1048,6 +3023,11 @@ func (s *swapClientServer) processStatusUpdates(mainCtx context.Context) {
 
 			s.swapsLock.Unlock()
 
+			s.recordSwapMetrics(mainCtx, &swp)
+			s.reconcileSwapFees(&swp)
+			s.recordSwapSLA(&swp)
+			s.restoreChannelFees(mainCtx, &swp)
+
 		// Server is shutting down.
 		case <-mainCtx.Done():
 			return
@@ -1168,11 +3148,13 @@ func validateLoopOutRequest(ctx context.Context, lnd lndclient.LightningClient,
 func hasBandwidth(channels []lndclient.ChannelInfo, amt btcutil.Amount,
 	maxParts int) (bool, int) {
 
+	// We can only send the portion of our channels' local balances that
+	// lnd does not hold for our channel reserve and commitment fees.
 	scratch := make([]btcutil.Amount, len(channels))
 	var totalBandwidth btcutil.Amount
 	for i, channel := range channels {
-		scratch[i] = channel.LocalBalance
-		totalBandwidth += channel.LocalBalance
+		scratch[i] = liquidity.SpendableBalance(channel)
+		totalBandwidth += scratch[i]
 	}
 
 	if totalBandwidth < amt {
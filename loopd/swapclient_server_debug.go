@@ -5,11 +5,16 @@ package loopd
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gopkg.in/macaroon-bakery.v2/bakery"
 
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/faults"
 	"github.com/lightninglabs/loop/looprpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -18,6 +23,26 @@ var (
 			Entity: "debug",
 			Action: "write",
 		}},
+		"/looprpc.Debug/InjectServerFault": {{
+			Entity: "debug",
+			Action: "write",
+		}},
+		"/looprpc.Debug/DropConfirmations": {{
+			Entity: "debug",
+			Action: "write",
+		}},
+		"/looprpc.Debug/SimulateReorg": {{
+			Entity: "debug",
+			Action: "write",
+		}},
+		"/looprpc.Debug/ListFaults": {{
+			Entity: "debug",
+			Action: "write",
+		}},
+		"/looprpc.Debug/ClearFaults": {{
+			Entity: "debug",
+			Action: "write",
+		}},
 	}
 
 	debugPermissions = []bakery.Op{
@@ -26,6 +51,15 @@ var (
 			Action: "write",
 		},
 	}
+
+	// faultInjector injects faults into the swap server calls and chain
+	// notifications of our client, as instructed by our debug server.
+	faultInjector = faults.NewInjector()
+
+	// errFaultsMainnet is returned when faults are injected on mainnet.
+	errFaultsMainnet = status.Error(
+		codes.PermissionDenied, "fault injection not allowed on mainnet",
+	)
 )
 
 // registerDebugServer registers the debug server.
@@ -33,6 +67,23 @@ func (d *Daemon) registerDebugServer() {
 	looprpc.RegisterDebugServer(d.grpcServer, d)
 }
 
+// installFaultHooks installs the hooks that inject faults into the swap server
+// calls and chain notifications of our client. Faults are never injected on
+// mainnet.
+func installFaultHooks(config *Config, clientConfig *loop.ClientConfig) {
+	if lndclient.Network(config.Network) == lndclient.NetworkMainnet {
+		return
+	}
+
+	lnd := *clientConfig.Lnd
+	lnd.ChainNotifier = faultInjector.ChainNotifier(lnd.ChainNotifier)
+	clientConfig.Lnd = &lnd
+
+	clientConfig.ServerDialOptions = append(
+		clientConfig.ServerDialOptions, faultInjector.DialOptions()...,
+	)
+}
+
 // ForceAutoLoop triggers our liquidity manager to dispatch an automated swap,
 // if one is suggested. This endpoint is only for testing purposes and cannot be
 // used on mainnet.
@@ -46,3 +97,120 @@ func (s *swapClientServer) ForceAutoLoop(ctx context.Context,
 	err := s.liquidityMgr.ForceAutoLoop(ctx)
 	return &looprpc.ForceAutoLoopResponse{}, err
 }
+
+// InjectServerFault delays or fails calls to a swap server method. This
+// endpoint is only for testing purposes and cannot be used on mainnet.
+func (s *swapClientServer) InjectServerFault(_ context.Context,
+	req *looprpc.InjectServerFaultRequest) (
+	*looprpc.InjectServerFaultResponse, error) {
+
+	if s.network == lndclient.NetworkMainnet {
+		return nil, errFaultsMainnet
+	}
+
+	if req.Fault == nil {
+		return nil, status.Error(
+			codes.InvalidArgument, "fault required",
+		)
+	}
+
+	err := faultInjector.SetServerFault(faults.ServerFault{
+		Method: req.Fault.Method,
+		Delay:  time.Duration(req.Fault.DelayMs) * time.Millisecond,
+		Fail:   req.Fault.Fail,
+		Count:  req.Fault.Count,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Warnf("Injected server fault: %v", req.Fault)
+
+	return &looprpc.InjectServerFaultResponse{}, nil
+}
+
+// DropConfirmations drops the next confirmation notifications that lnd
+// delivers. This endpoint is only for testing purposes and cannot be used on
+// mainnet.
+func (s *swapClientServer) DropConfirmations(_ context.Context,
+	req *looprpc.DropConfirmationsRequest) (
+	*looprpc.DropConfirmationsResponse, error) {
+
+	if s.network == lndclient.NetworkMainnet {
+		return nil, errFaultsMainnet
+	}
+
+	faultInjector.DropConfirmations(req.Count)
+
+	log.Warnf("Dropping next %v confirmation notifications", req.Count)
+
+	return &looprpc.DropConfirmationsResponse{}, nil
+}
+
+// SimulateReorg notifies our block subscribers of a reorg. This endpoint is
+// only for testing purposes and cannot be used on mainnet.
+func (s *swapClientServer) SimulateReorg(_ context.Context,
+	req *looprpc.SimulateReorgRequest) (*looprpc.SimulateReorgResponse,
+	error) {
+
+	if s.network == lndclient.NetworkMainnet {
+		return nil, errFaultsMainnet
+	}
+
+	notified, err := faultInjector.SimulateReorg(int32(req.Depth))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Warnf("Simulated reorg of depth %v for %v block subscribers",
+		req.Depth, notified)
+
+	return &looprpc.SimulateReorgResponse{
+		Subscribers: uint32(notified),
+	}, nil
+}
+
+// ListFaults lists the faults that are currently injected. This endpoint is
+// only for testing purposes and cannot be used on mainnet.
+func (s *swapClientServer) ListFaults(_ context.Context,
+	_ *looprpc.ListFaultsRequest) (*looprpc.ListFaultsResponse, error) {
+
+	if s.network == lndclient.NetworkMainnet {
+		return nil, errFaultsMainnet
+	}
+
+	state := faultInjector.State()
+
+	resp := &looprpc.ListFaultsResponse{
+		DropConfirmations: state.DropConfirmations,
+	}
+
+	for _, fault := range state.ServerFaults {
+		resp.ServerFaults = append(
+			resp.ServerFaults, &looprpc.ServerFault{
+				Method:  fault.Method,
+				DelayMs: uint32(fault.Delay / time.Millisecond),
+				Fail:    fault.Fail,
+				Count:   fault.Count,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// ClearFaults removes all of the faults that are injected. This endpoint is
+// only for testing purposes and cannot be used on mainnet.
+func (s *swapClientServer) ClearFaults(_ context.Context,
+	_ *looprpc.ClearFaultsRequest) (*looprpc.ClearFaultsResponse, error) {
+
+	if s.network == lndclient.NetworkMainnet {
+		return nil, errFaultsMainnet
+	}
+
+	faultInjector.Clear()
+
+	log.Infof("Cleared all injected faults")
+
+	return &looprpc.ClearFaultsResponse{}, nil
+}
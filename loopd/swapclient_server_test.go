@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
 	mock_lnd "github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
@@ -568,3 +572,99 @@ func TestHasBandwidth(t *testing.T) {
 		})
 	}
 }
+
+// TestSwapTransactions tests that we only include the transactions that our
+// wallet published for a swap in its proof.
+func TestSwapTransactions(t *testing.T) {
+	var (
+		swapHash  = lntypes.Hash{1}
+		otherHash = lntypes.Hash{2}
+
+		sweepTx = lndclient.Transaction{
+			Tx:     wire.NewMsgTx(2),
+			TxHash: "sweep",
+			Label: labels.LoopOutSweepSuccess(
+				swap.ShortHash(&swapHash),
+			),
+		}
+
+		otherTx = lndclient.Transaction{
+			Tx:     wire.NewMsgTx(2),
+			TxHash: "other",
+			Label: labels.LoopOutSweepSuccess(
+				swap.ShortHash(&otherHash),
+			),
+		}
+
+		unlabelledTx = lndclient.Transaction{
+			Tx:     wire.NewMsgTx(2),
+			TxHash: "unlabelled",
+		}
+	)
+
+	lnd := mock_lnd.NewMockLnd()
+	lnd.Transactions = []lndclient.Transaction{
+		sweepTx, otherTx, unlabelledTx,
+	}
+
+	server := &swapClientServer{
+		lnd: &lnd.LndServices,
+	}
+
+	txns, err := server.swapTransactions(context.Background(), swapHash)
+	require.NoError(t, err)
+	require.Len(t, txns, 1)
+	require.Equal(t, sweepTx.TxHash, txns[0].Txid)
+	require.Equal(t, sweepTx.Label, txns[0].Label)
+}
+
+// TestMarshallReservation tests conversion of swap reservations to their rpc
+// representation.
+func TestMarshallReservation(t *testing.T) {
+	expiry := time.Unix(1000, 0)
+
+	reservation := &loop.SwapReservation{
+		SwapHash:          lntypes.Hash{1},
+		SwapType:          swap.TypeIn,
+		Amount:            100000,
+		SwapFee:           100,
+		MaxMinerFee:       200,
+		CltvExpiry:        600,
+		HtlcAddressP2WSH:  testnetAddr,
+		HtlcAddressNP2WSH: mainnetAddr,
+		Expiry:            expiry,
+	}
+
+	var (
+		hash   = lntypes.Hash{1}
+		p2wsh  = looprpc.HtlcOutputType_HTLC_OUTPUT_TYPE_P2WSH
+		np2wsh = looprpc.HtlcOutputType_HTLC_OUTPUT_TYPE_NP2WSH
+	)
+
+	// The nested segwit address of a loop in reservation is only set for
+	// external htlcs, so it is the primary address.
+	require.Equal(t, &looprpc.SwapReservation{
+		Id:                hash[:],
+		Type:              looprpc.SwapType_LOOP_IN,
+		Amt:               100000,
+		SwapFeeSat:        100,
+		MaxMinerFee:       200,
+		CltvExpiry:        600,
+		HtlcAddressP2Wsh:  testnetAddr.String(),
+		HtlcAddressNp2Wsh: mainnetAddr.String(),
+		ExpiryUnix:        1000,
+		HtlcAddresses: &looprpc.HtlcAddresses{
+			Addresses: []*looprpc.HtlcAddress{
+				{
+					OutputType: p2wsh,
+					Address:    testnetAddr.String(),
+				},
+				{
+					OutputType: np2wsh,
+					Address:    mainnetAddr.String(),
+					Primary:    true,
+				},
+			},
+		},
+	}, marshallReservation(reservation))
+}
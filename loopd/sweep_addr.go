@@ -0,0 +1,71 @@
+package loopd
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc"
+)
+
+// sweepAddrTypes maps the values of our sweepaddrtype option to the type of
+// address that lnd generates for our sweeps.
+var sweepAddrTypes = map[string]lnrpc.AddressType{
+	"p2wkh":  lnrpc.AddressType_WITNESS_PUBKEY_HASH,
+	"np2wkh": lnrpc.AddressType_NESTED_PUBKEY_HASH,
+}
+
+// lndSweepAddrs generates addresses of a fixed type from lnd's wallet to sweep
+// to. The version of lndclient that we use only generates addresses of lnd's
+// default type, so we use a separate connection to lnd's main rpc.
+type lndSweepAddrs struct {
+	conn     *grpc.ClientConn
+	client   lnrpc.LightningClient
+	addrType lnrpc.AddressType
+	params   *chaincfg.Params
+}
+
+// newLndSweepAddrs connects to the lnd instance in the config provided to
+// generate addresses of the type provided. The macaroon used must hold the
+// address:write permission.
+func newLndSweepAddrs(cfg *lndConfig, network string,
+	addrType lnrpc.AddressType, params *chaincfg.Params) (*lndSweepAddrs,
+	error) {
+
+	conn, err := lndclient.NewBasicConn(
+		cfg.Host, cfg.TLSPath, filepath.Dir(cfg.MacaroonPath), network,
+		lndclient.MacFilename(filepath.Base(cfg.MacaroonPath)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lndSweepAddrs{
+		conn:     conn,
+		client:   lnrpc.NewLightningClient(conn),
+		addrType: addrType,
+		params:   params,
+	}, nil
+}
+
+// nextAddr returns a new address of our type from lnd's wallet.
+func (l *lndSweepAddrs) nextAddr(ctx context.Context) (btcutil.Address,
+	error) {
+
+	resp, err := l.client.NewAddress(ctx, &lnrpc.NewAddressRequest{
+		Type: l.addrType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return btcutil.DecodeAddress(resp.Address, l.params)
+}
+
+// close closes our connection to lnd.
+func (l *lndSweepAddrs) close() error {
+	return l.conn.Close()
+}
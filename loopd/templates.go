@@ -0,0 +1,245 @@
+package loopd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// errNoTemplateChannels is returned when a template that sets a target
+	// local balance has no active channels to calculate its amount from.
+	errNoTemplateChannels = errors.New("no active channels for template")
+
+	// errTemplateAtTarget is returned when the channels of a template that
+	// sets a target local balance do not need a swap to reach it.
+	errTemplateAtTarget = errors.New("channels already at target local " +
+		"balance")
+)
+
+// unmarshallSwapTemplate validates a swap template received over rpc and
+// converts it to the template that we store.
+func unmarshallSwapTemplate(template *looprpc.SwapTemplate,
+	chainParams *chaincfg.Params) (*loopdb.SwapTemplate, error) {
+
+	if template == nil {
+		return nil, errors.New("template required")
+	}
+
+	switch {
+	case template.Name == "":
+		return nil, errors.New("template name required")
+
+	case len(template.Name) > loopdb.MaxSwapTemplateNameLength:
+		return nil, fmt.Errorf("template name exceeds maximum length "+
+			"of %v", loopdb.MaxSwapTemplateNameLength)
+
+	case template.TargetLocalPercent > 100:
+		return nil, errors.New("target local percent may not exceed " +
+			"100")
+
+	case template.Amt != 0 && template.TargetLocalPercent != 0:
+		return nil, errors.New("amount and target local percent " +
+			"cannot both be set")
+
+	case template.Amt <= 0 && template.TargetLocalPercent == 0:
+		return nil, errors.New("template amount must be positive")
+
+	case template.ConfTarget < 0 || template.HtlcConfirmations < 0:
+		return nil, errors.New("confirmation targets may not be " +
+			"negative")
+	}
+
+	limits := []int64{
+		template.MaxSwapFee, template.MaxMinerFee,
+		template.MaxPrepayAmt, template.MaxSwapRoutingFee,
+		template.MaxPrepayRoutingFee,
+	}
+	for _, limit := range limits {
+		if limit < 0 {
+			return nil, errors.New("fee limits may not be negative")
+		}
+	}
+
+	if err := labels.Validate(template.Label); err != nil {
+		return nil, err
+	}
+
+	chanSet, err := loopdb.NewChannelSet(template.OutgoingChanSet)
+	if err != nil {
+		return nil, err
+	}
+
+	swapTemplate := &loopdb.SwapTemplate{
+		Name:                template.Name,
+		Amount:              btcutil.Amount(template.Amt),
+		DestAddr:            template.Dest,
+		MaxSwapFee:          btcutil.Amount(template.MaxSwapFee),
+		MaxMinerFee:         btcutil.Amount(template.MaxMinerFee),
+		MaxPrepayAmount:     btcutil.Amount(template.MaxPrepayAmt),
+		MaxSwapRoutingFee:   btcutil.Amount(template.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: btcutil.Amount(template.MaxPrepayRoutingFee),
+		ConfTarget:          template.ConfTarget,
+		HtlcConfirmations:   template.HtlcConfirmations,
+		Fast:                template.Fast,
+		OutgoingChanSet:     chanSet,
+		ExternalHtlc:        template.ExternalHtlc,
+		Label:               template.Label,
+		TargetLocalPercent:  template.TargetLocalPercent,
+	}
+
+	switch template.Type {
+	case looprpc.SwapType_LOOP_OUT:
+		swapTemplate.Type = swap.TypeOut
+
+		if len(template.LastHop) != 0 || template.ExternalHtlc {
+			return nil, errors.New("last hop and external htlc " +
+				"are not supported for loop out")
+		}
+
+		if template.Dest != "" {
+			addr, err := btcutil.DecodeAddress(
+				template.Dest, chainParams,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("decode address: %v",
+					err)
+			}
+
+			if !addr.IsForNet(chainParams) {
+				return nil, fmt.Errorf("%w: Current active "+
+					"network is %s", errIncorrectChain,
+					chainParams.Name)
+			}
+		}
+
+	case looprpc.SwapType_LOOP_IN:
+		swapTemplate.Type = swap.TypeIn
+
+		if template.Dest != "" || len(template.OutgoingChanSet) != 0 ||
+			template.Fast || template.HtlcConfirmations != 0 {
+
+			return nil, errors.New("destination, outgoing " +
+				"channels, fast and htlc confirmations are " +
+				"not supported for loop in")
+		}
+
+		if template.ExternalHtlc && template.ConfTarget != 0 {
+			return nil, errors.New("external and conf target " +
+				"cannot both be set")
+		}
+
+		if len(template.LastHop) != 0 {
+			lastHop, err := route.NewVertexFromBytes(
+				template.LastHop,
+			)
+			if err != nil {
+				return nil, err
+			}
+			swapTemplate.LastHop = &lastHop
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown swap type: %v", template.Type)
+	}
+
+	return swapTemplate, nil
+}
+
+// marshallSwapTemplate converts a stored swap template to its rpc
+// representation.
+func marshallSwapTemplate(template *loopdb.SwapTemplate) *looprpc.SwapTemplate {
+	rpcTemplate := &looprpc.SwapTemplate{
+		Name:                template.Name,
+		Type:                looprpc.SwapType_LOOP_OUT,
+		Amt:                 int64(template.Amount),
+		Dest:                template.DestAddr,
+		MaxSwapFee:          int64(template.MaxSwapFee),
+		MaxMinerFee:         int64(template.MaxMinerFee),
+		MaxPrepayAmt:        int64(template.MaxPrepayAmount),
+		MaxSwapRoutingFee:   int64(template.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: int64(template.MaxPrepayRoutingFee),
+		ConfTarget:          template.ConfTarget,
+		HtlcConfirmations:   template.HtlcConfirmations,
+		Fast:                template.Fast,
+		OutgoingChanSet:     template.OutgoingChanSet,
+		ExternalHtlc:        template.ExternalHtlc,
+		Label:               template.Label,
+		TargetLocalPercent:  template.TargetLocalPercent,
+	}
+
+	if template.Type == swap.TypeIn {
+		rpcTemplate.Type = looprpc.SwapType_LOOP_IN
+	}
+
+	if template.LastHop != nil {
+		rpcTemplate.LastHop = template.LastHop[:]
+	}
+
+	return rpcTemplate
+}
+
+// templateAmount returns the amount of the swap dispatched from a template.
+// Templates that set a target local balance swap the difference between the
+// local balance of their active channels and the target percentage of the
+// channels' balance. Loop outs are restricted to the template's outgoing
+// channel set, and loop ins to the channels with its last hop, if they are
+// set.
+func templateAmount(template *loopdb.SwapTemplate,
+	channels []lndclient.ChannelInfo) (btcutil.Amount, error) {
+
+	if template.TargetLocalPercent == 0 {
+		return template.Amount, nil
+	}
+
+	chanSet := make(map[uint64]bool)
+	for _, chanID := range template.OutgoingChanSet {
+		chanSet[chanID] = true
+	}
+
+	var local, total btcutil.Amount
+	for _, channel := range channels {
+		if !channel.Active {
+			continue
+		}
+
+		if len(chanSet) != 0 && !chanSet[channel.ChannelID] {
+			continue
+		}
+
+		if template.LastHop != nil &&
+			channel.PubKeyBytes != *template.LastHop {
+
+			continue
+		}
+
+		local += channel.LocalBalance
+		total += channel.LocalBalance + channel.RemoteBalance
+	}
+
+	if total == 0 {
+		return 0, errNoTemplateChannels
+	}
+
+	target := total * btcutil.Amount(template.TargetLocalPercent) / 100
+
+	amount := local - target
+	if template.Type == swap.TypeIn {
+		amount = target - local
+	}
+
+	if amount <= 0 {
+		return 0, fmt.Errorf("%w: local balance %v, target %v",
+			errTemplateAtTarget, local, target)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,263 @@
+package loopd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapTemplateConversion tests validation of swap templates and that
+// valid templates are returned unchanged after they have been stored.
+func TestSwapTemplateConversion(t *testing.T) {
+	lastHop := route.Vertex{1}
+
+	tests := []struct {
+		name     string
+		template *looprpc.SwapTemplate
+		valid    bool
+	}{
+		{
+			name: "loop out",
+			template: &looprpc.SwapTemplate{
+				Name:              "coldstore",
+				Type:              looprpc.SwapType_LOOP_OUT,
+				Amt:               100000,
+				Dest:              testnetAddr.String(),
+				MaxSwapFee:        100,
+				ConfTarget:        6,
+				HtlcConfirmations: 2,
+				Fast:              true,
+				OutgoingChanSet:   []uint64{1, 2},
+				Label:             "label",
+			},
+			valid: true,
+		},
+		{
+			name: "loop in",
+			template: &looprpc.SwapTemplate{
+				Name:         "inbound",
+				Type:         looprpc.SwapType_LOOP_IN,
+				Amt:          100000,
+				MaxMinerFee:  200,
+				LastHop:      lastHop[:],
+				ExternalHtlc: true,
+			},
+			valid: true,
+		},
+		{
+			name: "target local percent",
+			template: &looprpc.SwapTemplate{
+				Name:               "rebalance",
+				Type:               looprpc.SwapType_LOOP_OUT,
+				OutgoingChanSet:    []uint64{1},
+				TargetLocalPercent: 50,
+			},
+			valid: true,
+		},
+		{
+			name: "amount and target local percent",
+			template: &looprpc.SwapTemplate{
+				Name:               "template",
+				Amt:                100000,
+				TargetLocalPercent: 50,
+			},
+		},
+		{
+			name: "target local percent too high",
+			template: &looprpc.SwapTemplate{
+				Name:               "template",
+				TargetLocalPercent: 101,
+			},
+		},
+		{
+			name: "no name",
+			template: &looprpc.SwapTemplate{
+				Amt: 100000,
+			},
+		},
+		{
+			name: "no amount",
+			template: &looprpc.SwapTemplate{
+				Name: "template",
+			},
+		},
+		{
+			name: "negative limit",
+			template: &looprpc.SwapTemplate{
+				Name:       "template",
+				Amt:        100000,
+				MaxSwapFee: -1,
+			},
+		},
+		{
+			name: "wrong network",
+			template: &looprpc.SwapTemplate{
+				Name: "template",
+				Amt:  100000,
+				Dest: mainnetAddr.String(),
+			},
+		},
+		{
+			name: "duplicate channels",
+			template: &looprpc.SwapTemplate{
+				Name:            "template",
+				Amt:             100000,
+				OutgoingChanSet: []uint64{1, 1},
+			},
+		},
+		{
+			name: "loop out last hop",
+			template: &looprpc.SwapTemplate{
+				Name:    "template",
+				Amt:     100000,
+				LastHop: lastHop[:],
+			},
+		},
+		{
+			name: "loop in destination",
+			template: &looprpc.SwapTemplate{
+				Name: "template",
+				Type: looprpc.SwapType_LOOP_IN,
+				Amt:  100000,
+				Dest: testnetAddr.String(),
+			},
+		},
+		{
+			name: "loop in external conf target",
+			template: &looprpc.SwapTemplate{
+				Name:         "template",
+				Type:         looprpc.SwapType_LOOP_IN,
+				Amt:          100000,
+				ExternalHtlc: true,
+				ConfTarget:   6,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			template, err := unmarshallSwapTemplate(
+				testCase.template, &chaincfg.TestNet3Params,
+			)
+			if !testCase.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(
+				t, testCase.template,
+				marshallSwapTemplate(template),
+			)
+		})
+	}
+}
+
+// TestTemplateAmount tests calculating the amount of swaps dispatched from
+// templates that set a fixed amount or a target local balance.
+func TestTemplateAmount(t *testing.T) {
+	channels := []lndclient.ChannelInfo{
+		{
+			Active:        true,
+			ChannelID:     1,
+			PubKeyBytes:   peer1,
+			LocalBalance:  80000,
+			RemoteBalance: 20000,
+		},
+		{
+			Active:        true,
+			ChannelID:     2,
+			PubKeyBytes:   peer2,
+			LocalBalance:  10000,
+			RemoteBalance: 90000,
+		},
+		{
+			Active:        false,
+			ChannelID:     3,
+			PubKeyBytes:   peer2,
+			LocalBalance:  100000,
+			RemoteBalance: 0,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template *loopdb.SwapTemplate
+		amount   btcutil.Amount
+		err      error
+	}{
+		{
+			name: "fixed amount",
+			template: &loopdb.SwapTemplate{
+				Type:   swap.TypeOut,
+				Amount: 5000,
+			},
+			amount: 5000,
+		},
+		{
+			name: "loop out all channels",
+			template: &loopdb.SwapTemplate{
+				Type:               swap.TypeOut,
+				TargetLocalPercent: 30,
+			},
+			amount: 30000,
+		},
+		{
+			name: "loop out channel set",
+			template: &loopdb.SwapTemplate{
+				Type:               swap.TypeOut,
+				OutgoingChanSet:    loopdb.ChannelSet{1},
+				TargetLocalPercent: 50,
+			},
+			amount: 30000,
+		},
+		{
+			name: "loop in last hop",
+			template: &loopdb.SwapTemplate{
+				Type:               swap.TypeIn,
+				LastHop:            &peer2,
+				TargetLocalPercent: 50,
+			},
+			amount: 40000,
+		},
+		{
+			name: "at target",
+			template: &loopdb.SwapTemplate{
+				Type:               swap.TypeIn,
+				TargetLocalPercent: 30,
+			},
+			err: errTemplateAtTarget,
+		},
+		{
+			name: "no active channels",
+			template: &loopdb.SwapTemplate{
+				Type:               swap.TypeOut,
+				OutgoingChanSet:    loopdb.ChannelSet{3},
+				TargetLocalPercent: 50,
+			},
+			err: errNoTemplateChannels,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			amount, err := templateAmount(
+				testCase.template, channels,
+			)
+			require.True(t, errors.Is(err, testCase.err))
+			require.Equal(t, testCase.amount, amount)
+		})
+	}
+}
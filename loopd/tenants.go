@@ -0,0 +1,253 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/looprpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// tenantMethods is the set of rpc methods that tenants may call in
+// multi-tenant mode. All other methods are reserved for the operator, since
+// they expose or change state that is shared by all tenants.
+//
+// This includes the liquidity rpcs (GetLiquidityParams, SetLiquidityParams,
+// SuggestSwaps, GetLiquiditySummary and ListLiquiditySnapshots), which are
+// not namespaced per tenant. Tenants have no channels of their own: their
+// loop outs are paid over the operator's channels, and their loop ins are
+// funded from the operator's wallet and received on its channels. Liquidity
+// rules and autoloop therefore act on channels that all tenants share, and
+// only the operator may manage them.
+var tenantMethods = map[string]bool{
+	"/looprpc.SwapClient/LoopOut":          true,
+	"/looprpc.SwapClient/LoopIn":           true,
+	"/looprpc.SwapClient/Monitor":          true,
+	"/looprpc.SwapClient/ListSwaps":        true,
+	"/looprpc.SwapClient/SwapInfo":         true,
+	"/looprpc.SwapClient/GetSwapStats":     true,
+	"/looprpc.SwapClient/GetSwapProof":     true,
+	"/looprpc.SwapClient/PreviewSweep":     true,
+	"/looprpc.SwapClient/GetInfo":          true,
+	"/looprpc.SwapClient/LoopOutTerms":     true,
+	"/looprpc.SwapClient/LoopOutQuote":     true,
+	"/looprpc.SwapClient/GetLoopInTerms":   true,
+	"/looprpc.SwapClient/GetLoopInQuote":   true,
+	"/looprpc.SwapClient/Probe":            true,
+	"/looprpc.SwapClient/GetCostStatement": true,
+	"/looprpc.SwapClient/GetStatusLink":    true,
+}
+
+// errOperatorOnly is returned when a tenant calls a method that is reserved
+// for the operator.
+var errOperatorOnly = status.Error(
+	codes.PermissionDenied, "method is only available to the operator",
+)
+
+// errNoTenant is returned in multi-tenant mode when a call is made without a
+// macaroon that we can identify its tenant by.
+var errNoTenant = status.Error(
+	codes.Unauthenticated, "a macaroon is required to identify the tenant",
+)
+
+// tenantPolicy separates the swaps of the callers of our rpc server in
+// multi-tenant mode. Each macaroon identity is a tenant, except for the
+// identities of our own macaroons, which belong to the operator.
+type tenantPolicy struct {
+	// operator is the identity of the operator's macaroon.
+	operator string
+
+	// approver is the identity of the operator's macaroon for approving
+	// swaps, if it has one.
+	approver string
+
+	// bake bakes a macaroon with the permissions provided. It is nil if
+	// our calls are authenticated with lnd's macaroons, in which case
+	// tenants are given macaroons that are baked by lnd.
+	bake func(permissions []bakery.Op) (*macaroon.Macaroon, error)
+}
+
+// newTenantPolicy creates a tenant policy whose operator is identified by the
+// macaroon at the path provided, and by the approver macaroon at the path
+// provided if it is set.
+func newTenantPolicy(macPath, approverMacPath string) (*tenantPolicy,
+	error) {
+
+	operator, err := readMacaroonIdentity(macPath)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &tenantPolicy{
+		operator: operator,
+	}
+
+	if approverMacPath != "" {
+		policy.approver, err = readMacaroonIdentity(approverMacPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policy, nil
+}
+
+// readMacaroonIdentity returns the identity of the operator macaroon at the
+// path provided.
+func readMacaroonIdentity(macPath string) (string, error) {
+	macBytes, err := ioutil.ReadFile(macPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to read operator macaroon: %v",
+			err)
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return "", fmt.Errorf("unable to decode operator macaroon: %v",
+			err)
+	}
+
+	return MacaroonIdentity(mac), nil
+}
+
+// tenant returns the tenant that the call with the context provided was made
+// by. An empty tenant is returned for the operator, and for all callers if
+// multi-tenant mode is disabled.
+func (p *tenantPolicy) tenant(ctx context.Context) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+
+	identity, err := identityFromContext(ctx)
+	if err != nil {
+		return "", errNoTenant
+	}
+
+	if identity == p.operator || identity == p.approver {
+		return "", nil
+	}
+
+	return identity, nil
+}
+
+// checkMethod returns an error if the call with the context provided may not
+// use the method provided.
+func (p *tenantPolicy) checkMethod(ctx context.Context,
+	fullMethod string) error {
+
+	tenant, err := p.tenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if tenant != "" && !tenantMethods[fullMethod] {
+		return errOperatorOnly
+	}
+
+	return nil
+}
+
+// unaryInterceptor restricts unary calls to the methods that their tenant
+// may use.
+func (p *tenantPolicy) unaryInterceptor(ctx context.Context,
+	req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	if err := p.checkMethod(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// streamInterceptor restricts streaming calls to the methods that their
+// tenant may use.
+func (p *tenantPolicy) streamInterceptor(srv interface{},
+	stream grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	err := p.checkMethod(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+// tenantPermissions returns the permissions that tenants require to call all
+// of the methods that they may use.
+func tenantPermissions() []bakery.Op {
+	seen := make(map[bakery.Op]bool)
+	var permissions []bakery.Op
+	for method := range tenantMethods {
+		for _, op := range RequiredPermissions[method] {
+			if seen[op] {
+				continue
+			}
+
+			seen[op] = true
+			permissions = append(permissions, op)
+		}
+	}
+
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].Entity != permissions[j].Entity {
+			return permissions[i].Entity < permissions[j].Entity
+		}
+
+		return permissions[i].Action < permissions[j].Action
+	})
+
+	return permissions
+}
+
+// BakeTenantMacaroon bakes a macaroon for a new tenant. Since every macaroon
+// that we bake has a new id, every call creates a separate tenant.
+func (s *swapClientServer) BakeTenantMacaroon(_ context.Context,
+	_ *looprpc.BakeTenantMacaroonRequest) (
+	*looprpc.BakeTenantMacaroonResponse, error) {
+
+	if s.tenants == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition, "multi-tenant mode is not "+
+				"enabled",
+		)
+	}
+
+	if s.tenants.bake == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition, "calls are authenticated "+
+				"with lnd's macaroons, tenant macaroons must "+
+				"be baked by lnd",
+		)
+	}
+
+	mac, err := s.tenants.bake(tenantPermissions())
+	if err != nil {
+		return nil, err
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.BakeTenantMacaroonResponse{
+		Macaroon: hex.EncodeToString(macBytes),
+		Tenant:   MacaroonIdentity(mac),
+	}, nil
+}
+
+// visibleTo returns a boolean indicating whether a swap is visible to the
+// tenant provided. The operator, whose tenant is empty, sees all swaps.
+func visibleTo(info *loop.SwapInfo, tenant string) bool {
+	return tenant == "" || info.Tenant == tenant
+}
@@ -0,0 +1,298 @@
+package loopd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// macaroonContext creates a macaroon with the id provided, and returns the
+// macaroon along with an incoming context that carries it.
+func macaroonContext(t *testing.T, id string) (*macaroon.Macaroon,
+	context.Context) {
+
+	mac, err := macaroon.New(
+		[]byte("root key"), []byte(id), "loop", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.Pairs(
+			"macaroon", hex.EncodeToString(macBytes),
+		),
+	)
+
+	return mac, ctx
+}
+
+// TestTenantPolicy tests identification of tenants and the methods that they
+// may call.
+func TestTenantPolicy(t *testing.T) {
+	operatorMac, operatorCtx := macaroonContext(t, "operator")
+	tenantMac, tenantCtx := macaroonContext(t, "tenant")
+
+	dir, err := ioutil.TempDir("", "tenants")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	macBytes, err := operatorMac.MarshalBinary()
+	require.NoError(t, err)
+
+	macPath := filepath.Join(dir, "loop.macaroon")
+	require.NoError(t, ioutil.WriteFile(macPath, macBytes, 0644))
+
+	approverMac, approverCtx := macaroonContext(t, "approver")
+
+	approverBytes, err := approverMac.MarshalBinary()
+	require.NoError(t, err)
+
+	approverPath := filepath.Join(dir, "approver.macaroon")
+	err = ioutil.WriteFile(approverPath, approverBytes, 0644)
+	require.NoError(t, err)
+
+	policy, err := newTenantPolicy(macPath, approverPath)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		policy *tenantPolicy
+		ctx    context.Context
+		method string
+		tenant string
+		err    error
+	}{
+		{
+			name:   "disabled",
+			ctx:    tenantCtx,
+			method: "/looprpc.SwapClient/SetLiquidityParams",
+		},
+		{
+			name:   "operator",
+			policy: policy,
+			ctx:    operatorCtx,
+			method: "/looprpc.SwapClient/SetLiquidityParams",
+		},
+		{
+			name:   "approver",
+			policy: policy,
+			ctx:    approverCtx,
+			method: "/looprpc.SwapClient/ApproveSwap",
+		},
+		{
+			name:   "tenant swap",
+			policy: policy,
+			ctx:    tenantCtx,
+			method: "/looprpc.SwapClient/LoopOut",
+			tenant: MacaroonIdentity(tenantMac),
+		},
+		{
+			name:   "tenant liquidity params",
+			policy: policy,
+			ctx:    tenantCtx,
+			method: "/looprpc.SwapClient/SetLiquidityParams",
+			tenant: MacaroonIdentity(tenantMac),
+			err:    errOperatorOnly,
+		},
+		{
+			name:   "no macaroon",
+			policy: policy,
+			ctx:    context.Background(),
+			method: "/looprpc.SwapClient/LoopOut",
+			err:    errNoTenant,
+		},
+	}
+
+	// Liquidity management acts on the operator's channels, which all
+	// tenants share, so it is not available to tenants.
+	for _, method := range []string{
+		"/looprpc.SwapClient/GetLiquidityParams",
+		"/looprpc.SwapClient/SetLiquidityParams",
+		"/looprpc.SwapClient/SuggestSwaps",
+		"/looprpc.SwapClient/GetLiquiditySummary",
+		"/looprpc.SwapClient/ListLiquiditySnapshots",
+	} {
+		err := policy.checkMethod(tenantCtx, method)
+		require.Equal(t, errOperatorOnly, err)
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			tenant, err := test.policy.tenant(test.ctx)
+			require.Equal(t, test.tenant, tenant)
+
+			if test.err == errNoTenant {
+				require.Equal(t, errNoTenant, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = test.policy.checkMethod(test.ctx, test.method)
+			require.Equal(t, test.err, err)
+		})
+	}
+}
+
+// TestTenantSwaps tests that tenants only see the swaps that they created,
+// while the operator sees all swaps.
+func TestTenantSwaps(t *testing.T) {
+	operatorMac, operatorCtx := macaroonContext(t, "operator")
+	tenantMac, tenantCtx := macaroonContext(t, "tenant")
+
+	operatorHash := lntypes.Hash{1}
+	tenantHash := lntypes.Hash{2}
+
+	server := &swapClientServer{
+		swaps: map[lntypes.Hash]loop.SwapInfo{
+			operatorHash: {
+				SwapHash:         operatorHash,
+				SwapType:         swap.TypeOut,
+				HtlcAddressP2WSH: testnetAddr,
+				SwapStateData: loopdb.SwapStateData{
+					State: loopdb.StateInitiated,
+				},
+			},
+			tenantHash: {
+				SwapHash:         tenantHash,
+				SwapType:         swap.TypeOut,
+				HtlcAddressP2WSH: testnetAddr,
+				SwapContract: loopdb.SwapContract{
+					Tenant: MacaroonIdentity(tenantMac),
+				},
+				SwapStateData: loopdb.SwapStateData{
+					State: loopdb.StateInitiated,
+				},
+			},
+		},
+		tenants: &tenantPolicy{
+			operator: MacaroonIdentity(operatorMac),
+		},
+	}
+
+	resp, err := server.ListSwaps(operatorCtx, &looprpc.ListSwapsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Swaps, 2)
+
+	resp, err = server.ListSwaps(tenantCtx, &looprpc.ListSwapsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Swaps, 1)
+	require.Equal(t, tenantHash[:], resp.Swaps[0].IdBytes)
+
+	_, err = server.SwapInfo(tenantCtx, &looprpc.SwapInfoRequest{
+		Id: tenantHash[:],
+	})
+	require.NoError(t, err)
+
+	// Swaps of other tenants are reported as unknown.
+	_, err = server.SwapInfo(tenantCtx, &looprpc.SwapInfoRequest{
+		Id: operatorHash[:],
+	})
+	require.Error(t, err)
+
+	_, err = server.SwapInfo(operatorCtx, &looprpc.SwapInfoRequest{
+		Id: tenantHash[:],
+	})
+	require.NoError(t, err)
+}
+
+// TestBakeTenantMacaroon tests that every tenant macaroon that the operator
+// bakes identifies a separate tenant, which may only use the tenant methods.
+func TestBakeTenantMacaroon(t *testing.T) {
+	operatorMac, _ := macaroonContext(t, "operator")
+
+	// Our macaroon service bakes macaroons with random ids, which we
+	// replace with a counter.
+	var (
+		baked       int
+		permissions []bakery.Op
+	)
+	bake := func(ops []bakery.Op) (*macaroon.Macaroon, error) {
+		baked++
+		permissions = ops
+
+		mac, _ := macaroonContext(t, fmt.Sprintf("tenant %v", baked))
+		return mac, nil
+	}
+
+	server := &swapClientServer{}
+	ctx := context.Background()
+	req := &looprpc.BakeTenantMacaroonRequest{}
+
+	// Tenant macaroons can only be baked in multi-tenant mode, and only
+	// if we authenticate calls with our own macaroons.
+	_, err := server.BakeTenantMacaroon(ctx, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	server.tenants = &tenantPolicy{
+		operator: MacaroonIdentity(operatorMac),
+	}
+	_, err = server.BakeTenantMacaroon(ctx, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	server.tenants.bake = bake
+
+	type bakeResp = looprpc.BakeTenantMacaroonResponse
+	tenantCtx := func(resp *bakeResp) context.Context {
+		return metadata.NewIncomingContext(
+			ctx, metadata.Pairs("macaroon", resp.Macaroon),
+		)
+	}
+
+	first, err := server.BakeTenantMacaroon(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, tenantPermissions(), permissions)
+
+	second, err := server.BakeTenantMacaroon(ctx, req)
+	require.NoError(t, err)
+	require.NotEqual(t, first.Tenant, second.Tenant)
+
+	for _, resp := range []*bakeResp{first, second} {
+		tenant, err := server.tenants.tenant(tenantCtx(resp))
+		require.NoError(t, err)
+		require.Equal(t, resp.Tenant, tenant)
+
+		err = server.tenants.checkMethod(
+			tenantCtx(resp), "/looprpc.SwapClient/LoopOut",
+		)
+		require.NoError(t, err)
+
+		// Tenants cannot bake macaroons for other tenants.
+		err = server.tenants.checkMethod(
+			tenantCtx(resp),
+			"/looprpc.SwapClient/BakeTenantMacaroon",
+		)
+		require.Equal(t, errOperatorOnly, err)
+	}
+}
+
+// TestTenantPermissions tests that tenant macaroons hold the permissions of
+// the methods that tenants may use, and no others.
+func TestTenantPermissions(t *testing.T) {
+	require.Equal(t, []bakery.Op{
+		{Entity: "loop", Action: "in"},
+		{Entity: "loop", Action: "out"},
+		{Entity: "swap", Action: "execute"},
+		{Entity: "swap", Action: "read"},
+		{Entity: "terms", Action: "read"},
+	}, tenantPermissions())
+}
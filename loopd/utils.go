@@ -7,14 +7,39 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/metrics"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/ticker"
 )
 
-// getClient returns an instance of the swap client.
-func getClient(config *Config, lnd *lndclient.LndServices) (*loop.Client,
-	func(), error) {
+// getPaymentPacing returns the pacing that is applied to swap payments, or nil
+// if payments are not paced.
+func getPaymentPacing(config *Config) *loop.PaymentPacing {
+	if config.PaymentMaxShard == 0 {
+		return nil
+	}
+
+	return &loop.PaymentPacing{
+		MaxShardSize: btcutil.Amount(config.PaymentMaxShard),
+		ShardDelay:   config.PaymentShardDelay,
+	}
+}
+
+// getClient returns an instance of the swap client. If connections to lnd's
+// mission control or htlc funding are provided, they are closed by the cleanup
+// function returned.
+func getClient(config *Config, lnd *lndclient.LndServices,
+	missionControl *lndMissionControl,
+	htlcFunder *lndHtlcFunder) (*loop.Client, func(), error) {
+
+	amountTiers, err := getAmountTiers(config.Tiers)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	clientConfig := &loop.ClientConfig{
 		ServerAddress:   config.Server.Host,
@@ -25,17 +50,93 @@ func getClient(config *Config, lnd *lndclient.LndServices) (*loop.Client,
 		MaxLsatCost:     btcutil.Amount(config.MaxLSATCost),
 		MaxLsatFee:      btcutil.Amount(config.MaxLSATFee),
 		LoopOutMaxParts: config.LoopOutMaxParts,
+		VolumeLimits:    getVolumeLimits(config.Limits),
+
+		RoutingFailurePeriod: config.RoutingFailurePeriod,
+		MaxSweepDelay:        config.MaxSweepDelay,
+		MatchWalletSweeps:    config.MatchWalletSweeps,
+		MinSweepOutput:       btcutil.Amount(config.MinSweepOutput),
+
+		StrictServerValidation: config.StrictServerValidation,
+		AmountTiers:            amountTiers,
+		AgentName:              config.AgentName,
+		Initiator:              config.Initiator,
+		SecretsDir:             config.SecretsDir,
+		SecretsKeyFile:         config.SecretsKeyFile,
 	}
 
+	// closeConns closes the connections to lnd that we own.
+	closeConns := func() {
+		if missionControl != nil {
+			if err := missionControl.close(); err != nil {
+				log.Errorf("Error closing mission control "+
+					"connection: %v", err)
+			}
+		}
+
+		if htlcFunder != nil {
+			if err := htlcFunder.close(); err != nil {
+				log.Errorf("Error closing htlc funding "+
+					"connection: %v", err)
+			}
+		}
+	}
+
+	if missionControl != nil {
+		clientConfig.MissionControl = missionControl
+	}
+
+	if htlcFunder != nil {
+		clientConfig.HtlcFunder = htlcFunder
+	}
+
+	// Payments that are paid with AMP, carry custom records or are paced
+	// are dispatched over the same connection to lnd as mission control.
+	pacing := getPaymentPacing(config)
+	switch {
+	case missionControl != nil:
+		clientConfig.Payer = &lndPayer{
+			router:    missionControl.router,
+			lightning: lnrpc.NewLightningClient(missionControl.conn),
+		}
+		clientConfig.AmpPrepay = config.AmpPrepay
+		clientConfig.PaymentPacing = pacing
+
+	case config.AmpPrepay || pacing != nil:
+		log.Warnf("Amp prepays and payment pacing unavailable: no " +
+			"connection to lnd's router rpc")
+	}
+
+	// Faults are only injected into our client in dev builds.
+	installFaultHooks(config, clientConfig)
+
 	swapClient, cleanUp, err := loop.NewClient(config.DataDir, clientConfig)
 	if err != nil {
+		closeConns()
 		return nil, nil, err
 	}
 
-	return swapClient, cleanUp, nil
+	return swapClient, func() {
+		cleanUp()
+		closeConns()
+	}, nil
 }
 
-func getLiquidityManager(client *loop.Client) *liquidity.Manager {
+// getVolumeLimits returns the swap volume limits set in our config.
+func getVolumeLimits(config *limitsConfig) *loop.VolumeLimits {
+	return &loop.VolumeLimits{
+		DailyAmount:  btcutil.Amount(config.MaxDailyVolume),
+		WeeklyAmount: btcutil.Amount(config.MaxWeeklyVolume),
+		DailySwaps:   config.MaxDailySwaps,
+		WeeklySwaps:  config.MaxWeeklySwaps,
+	}
+}
+
+func getLiquidityManager(config *Config, client *loop.Client,
+	exporter *metrics.InfluxExporter, missionControl *lndMissionControl,
+	sweepAddrs *lndSweepAddrs, circular *lndCircular,
+	channelFees *lndChannelFees) *liquidity.Manager {
+
 	mngrCfg := &liquidity.Config{
 		AutoloopTicker: ticker.NewForce(liquidity.DefaultAutoloopTicker),
 		LoopOut:        client.LoopOut,
@@ -68,7 +169,100 @@ func getLiquidityManager(client *loop.Client) *liquidity.Manager {
 		ListLoopOut:          client.Store.FetchLoopOutSwaps,
 		ListLoopIn:           client.Store.FetchLoopInSwaps,
 		MinimumConfirmations: minConfTarget,
+		SnapshotRetention:    config.SnapshotRetention,
+		CreateSnapshot: func(snapshot *loopdb.LiquiditySnapshot) error {
+			err := client.Store.CreateLiquiditySnapshot(snapshot)
+			if err != nil {
+				return err
+			}
+
+			if exporter == nil {
+				return nil
+			}
+
+			points := newSnapshotPoints(snapshot)
+			for _, point := range points {
+				exporter.Record(point)
+			}
+
+			return nil
+		},
+		ListSnapshots:  client.Store.FetchLiquiditySnapshots,
+		PruneSnapshots: client.Store.PruneLiquiditySnapshots,
+		RecordFee:      client.Store.CreateFeeRecord,
+		ListFeeRecords: client.Store.FetchFeeRecords,
+		RecordDispatch: client.Store.CreateDispatchRecord,
+		RandomAmount:   liquidity.RandomAmount,
+		AmountTiers:    client.AmountTiers,
+	}
+
+	if missionControl != nil && config.MissionControlBatch > 0 {
+		mngrCfg.MissionControlBatch = int(config.MissionControlBatch)
+		mngrCfg.SnapshotMissionControl = func(ctx context.Context) error {
+			_, err := missionControl.snapshot(ctx)
+			return err
+		}
+	}
+
+	if sweepAddrs != nil {
+		mngrCfg.SweepAddr = sweepAddrs.nextAddr
+	}
+
+	if circular != nil {
+		mngrCfg.EstimateCircular = circular.estimate
+		mngrCfg.CircularRebalance = circular.rebalance
+	}
+
+	if channelFees != nil {
+		mngrCfg.AdjustChannelFees = channelFees.adjust
+	}
+
+	if len(config.LSPEndpoints) > 0 {
+		quoter, err := newLSPQuoter(config.LSPEndpoints)
+		if err != nil {
+			log.Warnf("Channel quotes unavailable: %v", err)
+		} else {
+			mngrCfg.QuoteChannels = quoter.quote
+		}
+	}
+
+	if config.SnapshotInterval != 0 {
+		mngrCfg.SnapshotTicker = ticker.New(config.SnapshotInterval)
 	}
 
 	return liquidity.NewManager(mngrCfg)
 }
+
+// getMetricsExporter returns an influx exporter if our config has an influx
+// server set, or nil if metrics export is disabled.
+func getMetricsExporter(config *metricsConfig) (*metrics.InfluxExporter,
+	error) {
+
+	if config.InfluxURL == "" {
+		return nil, nil
+	}
+
+	return metrics.NewInfluxExporter(&metrics.InfluxConfig{
+		URL:           config.InfluxURL,
+		Token:         config.InfluxToken,
+		Org:           config.InfluxOrg,
+		Bucket:        config.InfluxBucket,
+		FlushInterval: config.FlushInterval,
+	})
+}
+
+// getTraceExporter returns an otlp exporter if our config has a collector
+// endpoint set, or nil if tracing is disabled.
+func getTraceExporter(config *tracingConfig) (*tracing.OTLPExporter,
+	error) {
+
+	if config.OTLPEndpoint == "" {
+		return nil, nil
+	}
+
+	return tracing.NewOTLPExporter(&tracing.OTLPConfig{
+		Endpoint:      config.OTLPEndpoint,
+		ServiceName:   config.ServiceName,
+		FlushInterval: config.FlushInterval,
+	})
+}
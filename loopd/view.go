@@ -1,6 +1,7 @@
 package loopd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -20,13 +21,22 @@ func view(config *Config, lisCfg *listenerCfg) error {
 	}
 	defer lnd.Close()
 
-	swapClient, cleanup, err := getClient(config, &lnd.LndServices)
+	// We check that lnd runs on our network before we open our swap
+	// database, which only holds the swaps of our network.
+	err = checkLndNetwork(
+		context.Background(), lnd.Client, config.Network,
+	)
+	if err != nil {
+		return err
+	}
+
+	swapClient, cleanup, err := getClient(config, &lnd.LndServices, nil, nil)
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 
-	chainParams, err := network.ChainParams()
+	chainParams, err := ChainParams(config.Network)
 	if err != nil {
 		return err
 	}
@@ -73,10 +83,19 @@ func viewOut(swapClient *loop.Client, chainParams *chaincfg.Params) error {
 		fmt.Printf("   Amt: %v, Expiry: %v\n",
 			s.Contract.AmountRequested, s.Contract.CltvExpiry,
 		)
+		for _, record := range s.Contract.PaymentRecords {
+			fmt.Printf("   Payment record: %v=%x (prepay: %v)\n",
+				loop.PaymentRecordType(record.Type),
+				record.Value, record.Prepay,
+			)
+		}
 		for i, e := range s.Events {
 			fmt.Printf("   Update %v, Time %v, State: %v",
 				i, e.Time, e.State,
 			)
+			if e.HtlcTxHash != nil {
+				fmt.Printf(", Htlc tx: %v", e.HtlcTxHash)
+			}
 			if e.State.Type() != loopdb.StateTypePending {
 				fmt.Printf(", Cost: server=%v, onchain=%v, "+
 					"offchain=%v",
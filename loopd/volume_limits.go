@@ -0,0 +1,66 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/loop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// volumeOverridePermission is the permission that is required to initiate a
+// swap that exceeds our configured volume limits.
+var volumeOverridePermission = bakery.Op{
+	Entity: "limits",
+	Action: "override",
+}
+
+// macaroonValidator validates that the macaroon supplied with a call holds
+// the permissions provided.
+type macaroonValidator func(ctx context.Context,
+	requiredPermissions []bakery.Op, fullMethod string) error
+
+// checkVolumeOverride checks that the caller is permitted to override our
+// volume limits, if it requested to do so. If we have no macaroon validator,
+// overrides are not checked.
+func (s *swapClientServer) checkVolumeOverride(ctx context.Context,
+	override bool) error {
+
+	if !override || s.validateMacaroon == nil {
+		return nil
+	}
+
+	method, _ := grpc.Method(ctx)
+	err := s.validateMacaroon(
+		ctx, []bakery.Op{volumeOverridePermission}, method,
+	)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "overriding "+
+			"volume limits requires the %v:%v permission: %v",
+			volumeOverridePermission.Entity,
+			volumeOverridePermission.Action, err)
+	}
+
+	return nil
+}
+
+// volumeLimitError converts errors that are returned because a swap would
+// exceed our volume limits or a fee budget, or because our wallet cannot fund
+// a loop in, to a grpc status error. Other errors are returned unchanged.
+func volumeLimitError(err error) error {
+	if errors.Is(err, loop.ErrVolumeLimitExceeded) ||
+		errors.Is(err, loop.ErrFeeBudgetExceeded) {
+
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	var fundsErr *loop.InsufficientFundsError
+	if errors.As(err, &fundsErr) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,47 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// TestCheckVolumeOverride tests that overriding our volume limits requires
+// the override permission.
+func TestCheckVolumeOverride(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		errDenied = errors.New("permission denied")
+	)
+
+	var checked []bakery.Op
+	server := &swapClientServer{
+		validateMacaroon: func(_ context.Context, ops []bakery.Op,
+			_ string) error {
+
+			checked = ops
+			return errDenied
+		},
+	}
+
+	// Requests that do not override our limits should not be checked.
+	require.NoError(t, server.checkVolumeOverride(ctx, false))
+	require.Nil(t, checked)
+
+	err := server.checkVolumeOverride(ctx, true)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, []bakery.Op{volumeOverridePermission}, checked)
+
+	// Once our caller holds the permission, the override is allowed.
+	server.validateMacaroon = func(context.Context, []bakery.Op,
+		string) error {
+
+		return nil
+	}
+	require.NoError(t, server.checkVolumeOverride(ctx, true))
+}
@@ -0,0 +1,239 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// defaultReplicaInterval is the default interval at which the database replica
+// is written, and at which it is read in watch mode.
+const defaultReplicaInterval = 10 * time.Second
+
+// errWatchSubserver is returned when loopd is started as a subserver in watch
+// mode.
+var errWatchSubserver = errors.New("watch mode is not supported when " +
+	"running as a subserver")
+
+// watchServer serves the read-only subset of the swap client RPCs from a
+// watch mode daemon. All other RPCs return an unimplemented error.
+type watchServer struct {
+	looprpc.UnimplementedSwapClientServer
+
+	server *swapClientServer
+}
+
+// Monitor streams swap updates read from the database replica.
+func (w *watchServer) Monitor(in *looprpc.MonitorRequest,
+	server looprpc.SwapClient_MonitorServer) error {
+
+	return w.server.Monitor(in, server)
+}
+
+// ListSwaps returns all swaps read from the database replica.
+func (w *watchServer) ListSwaps(ctx context.Context,
+	in *looprpc.ListSwapsRequest) (*looprpc.ListSwapsResponse, error) {
+
+	return w.server.ListSwaps(ctx, in)
+}
+
+// SwapInfo returns a single swap read from the database replica.
+func (w *watchServer) SwapInfo(ctx context.Context,
+	in *looprpc.SwapInfoRequest) (*looprpc.SwapStatus, error) {
+
+	return w.server.SwapInfo(ctx, in)
+}
+
+// GetSwapStats returns the outcomes of the swaps read from the database
+// replica.
+func (w *watchServer) GetSwapStats(ctx context.Context,
+	in *looprpc.SwapStatsRequest) (*looprpc.SwapStatsResponse, error) {
+
+	return w.server.GetSwapStats(ctx, in)
+}
+
+// GetInfo returns basic information about the watch mode daemon.
+func (w *watchServer) GetInfo(ctx context.Context,
+	in *looprpc.GetInfoRequest) (*looprpc.GetInfoResponse, error) {
+
+	return w.server.GetInfo(ctx, in)
+}
+
+// initializeWatch sets up the swap client RPC server for watch mode, where
+// swaps are read from a replica of another daemon's database rather than
+// executed. No connection to lnd or the swap server is made. If this method
+// fails with an error then no goroutine was started yet.
+func (d *Daemon) initializeWatch() error {
+	chainParams, err := ChainParams(d.cfg.Network)
+	if err != nil {
+		return err
+	}
+
+	d.mainCtx, d.mainCtxCancel = context.WithCancel(context.Background())
+
+	err = d.startMacaroonService()
+	if err != nil {
+		return err
+	}
+
+	d.swapClientServer = swapClientServer{
+		network:     lndclient.Network(d.cfg.Network),
+		swaps:       make(map[lntypes.Hash]loop.SwapInfo),
+		requests:    make(map[requestKey]*clientRequest),
+		subscribers: make(map[int]*subscriberQueue),
+		statusChan:  make(chan loop.SwapInfo),
+		mainCtx:     d.mainCtx,
+		profileDir:  filepath.Join(d.cfg.DataDir, defaultProfileDirname),
+		reload:      d.reloadConfig,
+		paths:       marshallDaemonPaths(d.cfg),
+
+		monitorBuffer:   d.cfg.MonitorBuffer,
+		monitorOverflow: d.cfg.MonitorOverflow,
+	}
+
+	// Read the replica once before we start so that we fail early if it
+	// cannot be opened.
+	swaps, err := readReplica(d.cfg.Replica.Path, chainParams)
+	if err != nil {
+		if err := d.stopMacaroonService(); err != nil {
+			log.Errorf("Error shutting down macaroon service: %v",
+				err)
+		}
+		return err
+	}
+
+	for _, s := range swaps {
+		d.swaps[s.SwapHash] = *s
+	}
+
+	log.Infof("Watching swap database replica %v", d.cfg.Replica.Path)
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		log.Infof("Waiting for updates")
+		d.processStatusUpdates(d.mainCtx)
+	}()
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		d.watchReplica(chainParams)
+	}()
+
+	d.startErrorHandler()
+
+	return nil
+}
+
+// watchReplica periodically reads the database replica and passes any swaps
+// that changed since the last read on to our status update handler. Failures
+// to read the replica are logged rather than shutting down, since the active
+// daemon may be replacing it.
+func (d *Daemon) watchReplica(chainParams *chaincfg.Params) {
+	ticker := time.NewTicker(d.cfg.Replica.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			swaps, err := readReplica(
+				d.cfg.Replica.Path, chainParams,
+			)
+			if err != nil {
+				log.Errorf("Unable to read replica: %v", err)
+				continue
+			}
+
+			d.swapsLock.Lock()
+			updates := changedSwaps(d.swaps, swaps)
+			d.swapsLock.Unlock()
+
+			for _, swap := range updates {
+				select {
+				case d.statusChan <- swap:
+				case <-d.mainCtx.Done():
+					return
+				}
+			}
+
+		case <-d.mainCtx.Done():
+			return
+		}
+	}
+}
+
+// writeReplica periodically writes a replica of our swap database that a
+// daemon in watch mode can read from. Failures are logged rather than shutting
+// down, because the replica is not required to execute swaps.
+func (d *Daemon) writeReplica() {
+	ticker := time.NewTicker(d.cfg.Replica.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := d.impl.Store.WriteReplica(d.cfg.Replica.Path)
+		if err != nil {
+			log.Errorf("Unable to write replica: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-d.mainCtx.Done():
+			return
+		}
+	}
+}
+
+// readReplica opens the database replica at the path provided and returns all
+// of the swaps it holds. The replica is closed again once it is read so that
+// the active daemon can replace it.
+func readReplica(path string, chainParams *chaincfg.Params) ([]*loop.SwapInfo,
+	error) {
+
+	store, err := loopdb.NewReadOnlySwapStore(path, chainParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open replica: %v", err)
+	}
+
+	swaps, err := loop.FetchSwapInfo(store, chainParams)
+	if closeErr := store.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return swaps, nil
+}
+
+// changedSwaps returns the swaps read from a replica that are not yet known or
+// have been updated since they were last read.
+func changedSwaps(known map[lntypes.Hash]loop.SwapInfo,
+	swaps []*loop.SwapInfo) []loop.SwapInfo {
+
+	var changed []loop.SwapInfo
+	for _, swap := range swaps {
+		current, ok := known[swap.SwapHash]
+		if ok && current.State == swap.State &&
+			current.LastUpdate.Equal(swap.LastUpdate) {
+
+			continue
+		}
+
+		changed = append(changed, *swap)
+	}
+
+	return changed
+}
@@ -0,0 +1,59 @@
+package loopd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChangedSwaps tests that only new or updated swaps read from a replica
+// are passed on as updates.
+func TestChangedSwaps(t *testing.T) {
+	var (
+		now       = time.Unix(1000, 0)
+		hash1     = lntypes.Hash{1}
+		hash2     = lntypes.Hash{2}
+		hash3     = lntypes.Hash{3}
+		unchanged = loop.SwapInfo{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateInitiated,
+			},
+			SwapHash:   hash1,
+			LastUpdate: now,
+		}
+		updated = loop.SwapInfo{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateInitiated,
+			},
+			SwapHash:   hash2,
+			LastUpdate: now,
+		}
+	)
+
+	known := map[lntypes.Hash]loop.SwapInfo{
+		hash1: unchanged,
+		hash2: updated,
+	}
+
+	// Our first swap is unchanged, the second has progressed and the third
+	// is new.
+	progressed := updated
+	progressed.State = loopdb.StateSuccess
+	progressed.LastUpdate = now.Add(time.Second)
+
+	added := unchanged
+	added.SwapHash = hash3
+
+	unchangedCopy := unchanged
+	changed := changedSwaps(known, []*loop.SwapInfo{
+		&unchangedCopy, &progressed, &added,
+	})
+	require.Equal(t, []loop.SwapInfo{progressed, added}, changed)
+
+	// Nothing has changed if we read the same swaps again.
+	require.Empty(t, changedSwaps(known, []*loop.SwapInfo{&unchangedCopy}))
+}
@@ -0,0 +1,476 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// MaxApproverLength is the maximum length of the identities that we
+	// record for swaps that require approval.
+	MaxApproverLength = 256
+
+	// MaxDenialReasonLength is the maximum length of the reason given for
+	// denying a swap.
+	MaxDenialReasonLength = 256
+)
+
+var (
+	// approvalKey is the key that stores the approval of a swap that had
+	// to be approved before it was executed. If a swap was created before
+	// we started storing approvals, or did not require approval, this key
+	// will not be present.
+	//
+	// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> approvalKey
+	//
+	// value: time in unix nanoseconds || varstring approver ||
+	// varstring requester
+	//
+	// The requester was added after the approver, so it is absent from
+	// approvals that were stored before.
+	approvalKey = []byte("approval")
+
+	// pendingApprovalBucketKey is a bucket that contains the swaps that
+	// are awaiting approval. These swaps are only stored with our other
+	// swaps once they are approved, so we track them here to release the
+	// resources that they hold if we restart before they are approved.
+	//
+	// maps: swap hash -> swap type || amount || expiry in unix nanoseconds
+	// || varstring requester
+	pendingApprovalBucketKey = []byte("pending-approvals")
+
+	// swapDenialBucketKey is a bucket that contains the swaps that
+	// required approval, but were denied or expired instead.
+	//
+	// maps: swap hash -> swap type || amount || time in unix nanoseconds ||
+	// expired || varstring requester || varstring denier || varstring
+	// reason
+	swapDenialBucketKey = []byte("swap-denials")
+
+	// ErrApproverTooLong is returned when the identity of a swap's
+	// requester, approver or denier exceeds our maximum length.
+	ErrApproverTooLong = errors.New("approver identity too long")
+
+	// ErrDenialReasonTooLong is returned when the reason for denying a
+	// swap exceeds our maximum length.
+	ErrDenialReasonTooLong = errors.New("denial reason too long")
+)
+
+// SwapApproval records the approval of a swap that exceeded the client's
+// approval threshold.
+type SwapApproval struct {
+	// Approver identifies the credential that approved the swap.
+	Approver string
+
+	// Requester identifies the credential that requested the swap. It
+	// is empty for swaps that were approved before we recorded it.
+	Requester string
+
+	// Time is the time at which the swap was approved.
+	Time time.Time
+}
+
+// putApproval writes a swap's approval to the bucket provided if it is
+// non-nil.
+func putApproval(bucket *bbolt.Bucket, approval *SwapApproval) error {
+	if approval == nil {
+		return nil
+	}
+
+	if len(approval.Approver) > MaxApproverLength ||
+		len(approval.Requester) > MaxApproverLength {
+
+		return ErrApproverTooLong
+	}
+
+	var b bytes.Buffer
+	err := binary.Write(&b, byteOrder, approval.Time.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(&b, 0, approval.Approver); err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(&b, 0, approval.Requester); err != nil {
+		return err
+	}
+
+	return bucket.Put(approvalKey, b.Bytes())
+}
+
+// getApproval returns the approval stored in a bucket, or nil if no approval
+// is present.
+func getApproval(bucket *bbolt.Bucket) (*SwapApproval, error) {
+	value := bucket.Get(approvalKey)
+	if value == nil {
+		return nil, nil
+	}
+
+	r := bytes.NewReader(value)
+
+	var unixNano int64
+	if err := binary.Read(r, byteOrder, &unixNano); err != nil {
+		return nil, err
+	}
+
+	approver, err := wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := &SwapApproval{
+		Approver: approver,
+		Time:     time.Unix(0, unixNano),
+	}
+
+	// Approvals that were stored before we recorded requesters end here.
+	if r.Len() == 0 {
+		return approval, nil
+	}
+
+	approval.Requester, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+// PendingApproval describes a swap that is awaiting approval.
+type PendingApproval struct {
+	// Hash is the hash of the swap.
+	Hash lntypes.Hash
+
+	// SwapType is the type of the swap.
+	SwapType swap.Type
+
+	// Amount is the amount of the swap.
+	Amount btcutil.Amount
+
+	// Expiry is the time after which the swap can no longer be approved.
+	Expiry time.Time
+
+	// Requester identifies the credential that requested the swap.
+	Requester string
+}
+
+// PutPendingApproval stores a swap that is awaiting approval.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) PutPendingApproval(approval *PendingApproval) error {
+	if len(approval.Requester) > MaxApproverLength {
+		return ErrApproverTooLong
+	}
+
+	var b bytes.Buffer
+	err := binary.Write(&b, byteOrder, uint8(approval.SwapType))
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(&b, byteOrder, approval.Amount); err != nil {
+		return err
+	}
+
+	err = binary.Write(&b, byteOrder, approval.Expiry.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(&b, 0, approval.Requester); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(
+			pendingApprovalBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(approval.Hash[:], b.Bytes())
+	})
+}
+
+// deserializePendingApproval deserializes the pending approval of the swap
+// with the hash provided.
+func deserializePendingApproval(hash lntypes.Hash,
+	r io.Reader) (*PendingApproval, error) {
+
+	approval := &PendingApproval{
+		Hash: hash,
+	}
+
+	var swapType uint8
+	if err := binary.Read(r, byteOrder, &swapType); err != nil {
+		return nil, err
+	}
+	approval.SwapType = swap.Type(swapType)
+
+	if err := binary.Read(r, byteOrder, &approval.Amount); err != nil {
+		return nil, err
+	}
+
+	var unixNano int64
+	if err := binary.Read(r, byteOrder, &unixNano); err != nil {
+		return nil, err
+	}
+	approval.Expiry = time.Unix(0, unixNano)
+
+	var err error
+	approval.Requester, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+// FetchPendingApprovals returns all swaps that are awaiting approval, ordered
+// by swap hash.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchPendingApprovals() ([]*PendingApproval, error) {
+	var approvals []*PendingApproval
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(pendingApprovalBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+
+			approval, err := deserializePendingApproval(
+				hash, bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			approvals = append(approvals, approval)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return approvals, nil
+}
+
+// DeletePendingApproval removes the swap with the hash provided from the
+// swaps that are awaiting approval. It is a no-op if the swap is not awaiting
+// approval.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) DeletePendingApproval(hash lntypes.Hash) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(pendingApprovalBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.Delete(hash[:])
+	})
+}
+
+// SwapDenial records a swap that exceeded the client's approval threshold,
+// and was not executed because it was denied or its approval expired.
+type SwapDenial struct {
+	// Hash is the hash of the swap.
+	Hash lntypes.Hash
+
+	// SwapType is the type of the swap.
+	SwapType swap.Type
+
+	// Amount is the amount of the swap.
+	Amount btcutil.Amount
+
+	// Requester identifies the credential that requested the swap.
+	Requester string
+
+	// Denier identifies the credential that denied the swap. It is empty
+	// if the swap expired.
+	Denier string
+
+	// Expired indicates that the swap was not approved before its approval
+	// expired, rather than being denied.
+	Expired bool
+
+	// Reason is the reason that the denier gave for denying the swap.
+	Reason string
+
+	// Time is the time at which the swap was denied or expired.
+	Time time.Time
+}
+
+// serializeSwapDenial serializes a swap denial. The hash is stored as the
+// denial's key, so it is not included.
+func serializeSwapDenial(w io.Writer, denial *SwapDenial) error {
+	err := binary.Write(w, byteOrder, uint8(denial.SwapType))
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, denial.Amount); err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, denial.Time.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, denial.Expired); err != nil {
+		return err
+	}
+
+	strings := []string{denial.Requester, denial.Denier, denial.Reason}
+	for _, str := range strings {
+		if err := wire.WriteVarString(w, 0, str); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeSwapDenial deserializes the denial of the swap with the hash
+// provided.
+func deserializeSwapDenial(hash lntypes.Hash, r io.Reader) (*SwapDenial,
+	error) {
+
+	denial := &SwapDenial{
+		Hash: hash,
+	}
+
+	var swapType uint8
+	if err := binary.Read(r, byteOrder, &swapType); err != nil {
+		return nil, err
+	}
+	denial.SwapType = swap.Type(swapType)
+
+	if err := binary.Read(r, byteOrder, &denial.Amount); err != nil {
+		return nil, err
+	}
+
+	var unixNano int64
+	if err := binary.Read(r, byteOrder, &unixNano); err != nil {
+		return nil, err
+	}
+	denial.Time = time.Unix(0, unixNano)
+
+	if err := binary.Read(r, byteOrder, &denial.Expired); err != nil {
+		return nil, err
+	}
+
+	strings := []*string{&denial.Requester, &denial.Denier, &denial.Reason}
+	for _, str := range strings {
+		var err error
+		*str, err = wire.ReadVarString(r, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return denial, nil
+}
+
+// CreateSwapDenial stores the denial of a swap, and removes the swap from the
+// swaps that are awaiting approval in the same transaction.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CreateSwapDenial(denial *SwapDenial) error {
+	if len(denial.Requester) > MaxApproverLength ||
+		len(denial.Denier) > MaxApproverLength {
+
+		return ErrApproverTooLong
+	}
+
+	if len(denial.Reason) > MaxDenialReasonLength {
+		return ErrDenialReasonTooLong
+	}
+
+	var b bytes.Buffer
+	if err := serializeSwapDenial(&b, denial); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(swapDenialBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if err := bucket.Put(denial.Hash[:], b.Bytes()); err != nil {
+			return err
+		}
+
+		pending := tx.Bucket(pendingApprovalBucketKey)
+		if pending == nil {
+			return nil
+		}
+
+		return pending.Delete(denial.Hash[:])
+	})
+}
+
+// FetchSwapDenials returns all swap denials in chronological order.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchSwapDenials() ([]*SwapDenial, error) {
+	var denials []*SwapDenial
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(swapDenialBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+
+			denial, err := deserializeSwapDenial(
+				hash, bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			denials = append(denials, denial)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(denials, func(i, j int) bool {
+		return denials[i].Time.Before(denials[j].Time)
+	})
+
+	return denials, nil
+}
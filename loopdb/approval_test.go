@@ -0,0 +1,118 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingApprovals tests storing and deleting swaps that are awaiting
+// approval.
+func TestPendingApprovals(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	approvals, err := store.FetchPendingApprovals()
+	require.NoError(t, err)
+	require.Len(t, approvals, 0)
+
+	// Deleting a swap that is not awaiting approval is a no-op.
+	require.NoError(t, store.DeletePendingApproval(lntypes.Hash{1}))
+
+	loopOut := &PendingApproval{
+		Hash:      lntypes.Hash{1},
+		SwapType:  swap.TypeOut,
+		Amount:    100,
+		Expiry:    time.Unix(0, 1000),
+		Requester: "requester",
+	}
+	loopIn := &PendingApproval{
+		Hash:     lntypes.Hash{2},
+		SwapType: swap.TypeIn,
+		Amount:   200,
+		Expiry:   time.Unix(0, 2000),
+	}
+
+	require.NoError(t, store.PutPendingApproval(loopIn))
+	require.NoError(t, store.PutPendingApproval(loopOut))
+
+	approvals, err = store.FetchPendingApprovals()
+	require.NoError(t, err)
+	require.Equal(t, []*PendingApproval{loopOut, loopIn}, approvals)
+
+	require.NoError(t, store.DeletePendingApproval(loopOut.Hash))
+
+	approvals, err = store.FetchPendingApprovals()
+	require.NoError(t, err)
+	require.Equal(t, []*PendingApproval{loopIn}, approvals)
+}
+
+// TestSwapDenials tests storing swap denials, and that they remove the swap
+// from the swaps that are awaiting approval.
+func TestSwapDenials(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	denials, err := store.FetchSwapDenials()
+	require.NoError(t, err)
+	require.Len(t, denials, 0)
+
+	pending := &PendingApproval{
+		Hash:      lntypes.Hash{1},
+		SwapType:  swap.TypeOut,
+		Amount:    100,
+		Expiry:    time.Unix(0, 1000),
+		Requester: "requester",
+	}
+	require.NoError(t, store.PutPendingApproval(pending))
+
+	denied := &SwapDenial{
+		Hash:      pending.Hash,
+		SwapType:  pending.SwapType,
+		Amount:    pending.Amount,
+		Requester: pending.Requester,
+		Denier:    "denier",
+		Reason:    "too large",
+		Time:      time.Unix(0, 3000),
+	}
+	expired := &SwapDenial{
+		Hash:     lntypes.Hash{2},
+		SwapType: swap.TypeIn,
+		Amount:   200,
+		Expired:  true,
+		Time:     time.Unix(0, 2000),
+	}
+
+	require.NoError(t, store.CreateSwapDenial(denied))
+	require.NoError(t, store.CreateSwapDenial(expired))
+
+	approvals, err := store.FetchPendingApprovals()
+	require.NoError(t, err)
+	require.Len(t, approvals, 0)
+
+	denials, err = store.FetchSwapDenials()
+	require.NoError(t, err)
+	require.Equal(t, []*SwapDenial{expired, denied}, denials)
+
+	tooLong := *denied
+	tooLong.Reason = string(make([]byte, MaxDenialReasonLength+1))
+	require.Equal(
+		t, ErrDenialReasonTooLong, store.CreateSwapDenial(&tooLong),
+	)
+}
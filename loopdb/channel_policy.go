@@ -0,0 +1,168 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// channelPolicyBucketKey is a bucket that contains the original fee
+	// policies of our channels that we replaced while swaps drained them.
+	//
+	// maps: short channel id -> policy
+	channelPolicyBucketKey = []byte("channel-policies")
+
+	// ErrChannelPolicyNotFound is returned when a channel policy that
+	// does not exist is deleted.
+	ErrChannelPolicyNotFound = errors.New("channel policy not found")
+)
+
+// ChannelPolicy is the original forwarding policy of one of our channels,
+// which we replaced while a swap drained the channel. It is stored so that
+// the policy can be restored once the swap completes, even if we restart in
+// the meantime.
+type ChannelPolicy struct {
+	// ChannelID is the short channel ID of the channel.
+	ChannelID uint64
+
+	// SwapHash is the hash of the swap that the policy was replaced for.
+	SwapHash lntypes.Hash
+
+	// BaseFeeMsat is the channel's original base fee.
+	BaseFeeMsat int64
+
+	// FeeRatePPM is the channel's original fee rate, expressed in parts
+	// per million.
+	FeeRatePPM uint64
+
+	// TimeLockDelta is the channel's original time lock delta.
+	TimeLockDelta uint32
+}
+
+// serializeChannelPolicy serializes a channel policy. The channel ID is
+// stored as the policy's key, so it is not included.
+func serializeChannelPolicy(w io.Writer, policy *ChannelPolicy) error {
+	if _, err := w.Write(policy.SwapHash[:]); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, policy.BaseFeeMsat); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, policy.FeeRatePPM); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, policy.TimeLockDelta)
+}
+
+// deserializeChannelPolicy deserializes the channel policy stored under the
+// key provided.
+func deserializeChannelPolicy(key []byte, r io.Reader) (*ChannelPolicy,
+	error) {
+
+	if len(key) != 8 {
+		return nil, errors.New("invalid channel policy key")
+	}
+
+	policy := &ChannelPolicy{
+		ChannelID: byteOrder.Uint64(key),
+	}
+
+	if _, err := io.ReadFull(r, policy.SwapHash[:]); err != nil {
+		return nil, err
+	}
+
+	err := binary.Read(r, byteOrder, &policy.BaseFeeMsat)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &policy.FeeRatePPM); err != nil {
+		return nil, err
+	}
+
+	err = binary.Read(r, byteOrder, &policy.TimeLockDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// PutChannelPolicy stores the original policy of a channel, replacing any
+// policy that was stored for the same channel.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) PutChannelPolicy(policy *ChannelPolicy) error {
+	var b bytes.Buffer
+	if err := serializeChannelPolicy(&b, policy); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(
+			channelPolicyBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(itob(policy.ChannelID), b.Bytes())
+	})
+}
+
+// FetchChannelPolicies returns all stored channel policies, ordered by
+// channel ID.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchChannelPolicies() ([]*ChannelPolicy, error) {
+	var policies []*ChannelPolicy
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(channelPolicyBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			policy, err := deserializeChannelPolicy(
+				k, bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			policies = append(policies, policy)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// DeleteChannelPolicy deletes the stored policy of the channel provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) DeleteChannelPolicy(channelID uint64) error {
+	key := itob(channelID)
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(channelPolicyBucketKey)
+		if bucket == nil || bucket.Get(key) == nil {
+			return ErrChannelPolicyNotFound
+		}
+
+		return bucket.Delete(key)
+	})
+}
@@ -0,0 +1,66 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelPolicies tests storing, replacing and deleting the original
+// policies of channels.
+func TestChannelPolicies(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	policies, err := store.FetchChannelPolicies()
+	require.NoError(t, err)
+	require.Len(t, policies, 0)
+
+	policy1 := &ChannelPolicy{
+		ChannelID:     2,
+		SwapHash:      lntypes.Hash{1},
+		BaseFeeMsat:   1000,
+		FeeRatePPM:    100,
+		TimeLockDelta: 40,
+	}
+
+	policy2 := &ChannelPolicy{
+		ChannelID:     1,
+		SwapHash:      lntypes.Hash{2},
+		TimeLockDelta: 144,
+	}
+
+	require.NoError(t, store.PutChannelPolicy(policy1))
+	require.NoError(t, store.PutChannelPolicy(policy2))
+
+	// Policies are returned ordered by channel ID.
+	policies, err = store.FetchChannelPolicies()
+	require.NoError(t, err)
+	require.Equal(t, []*ChannelPolicy{policy2, policy1}, policies)
+
+	// Storing a policy for the same channel replaces it.
+	policy1.FeeRatePPM = 200
+	require.NoError(t, store.PutChannelPolicy(policy1))
+
+	policies, err = store.FetchChannelPolicies()
+	require.NoError(t, err)
+	require.Equal(t, []*ChannelPolicy{policy2, policy1}, policies)
+
+	require.NoError(t, store.DeleteChannelPolicy(1))
+
+	policies, err = store.FetchChannelPolicies()
+	require.NoError(t, err)
+	require.Equal(t, []*ChannelPolicy{policy1}, policies)
+
+	err = store.DeleteChannelPolicy(1)
+	require.Equal(t, ErrChannelPolicyNotFound, err)
+}
@@ -0,0 +1,324 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// dispatchRecordBucketKey is a bucket that contains a record of each
+	// suggested swap that the liquidity manager dispatched. Records are
+	// keyed by their timestamp so that they can be iterated in
+	// chronological order, followed by the hash of their swap so that
+	// swaps dispatched at the same time don't collide.
+	//
+	// maps: timestamp (unix nano) || swap hash -> record
+	dispatchRecordBucketKey = []byte("dispatch-records")
+
+	// errUnknownDispatchSource is returned when a record with an unknown
+	// source is stored.
+	errUnknownDispatchSource = errors.New("unknown dispatch source")
+)
+
+// DispatchSource is the way in which a suggested swap was dispatched.
+type DispatchSource uint8
+
+const (
+	// DispatchSourceAutoloop indicates that a swap was dispatched by
+	// autoloop.
+	DispatchSourceAutoloop DispatchSource = 0
+
+	// DispatchSourceApplied indicates that a swap was dispatched because
+	// the user applied the suggestion.
+	DispatchSourceApplied DispatchSource = 1
+)
+
+// String returns the string representation of a dispatch source.
+func (d DispatchSource) String() string {
+	switch d {
+	case DispatchSourceAutoloop:
+		return "Autoloop"
+
+	case DispatchSourceApplied:
+		return "Applied"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// DispatchRule is a liquidity rule that a dispatched swap was suggested for.
+type DispatchRule struct {
+	// ChannelID is the channel that the rule is set on. It is zero for
+	// peer rules.
+	ChannelID uint64
+
+	// Peer is the peer that the rule is set on. It is empty for channel
+	// rules.
+	Peer route.Vertex
+
+	// MinimumIncoming is the percentage of incoming liquidity that the
+	// rule required.
+	MinimumIncoming uint32
+
+	// MinimumOutgoing is the percentage of outgoing liquidity that the
+	// rule required.
+	MinimumOutgoing uint32
+}
+
+// DispatchRecord links a suggested swap that was dispatched to the rules
+// that it was suggested for, and to the suggestion that was made.
+type DispatchRecord struct {
+	// Time is the time that the swap was dispatched.
+	Time time.Time
+
+	// SwapHash is the hash of the swap that was dispatched.
+	SwapHash lntypes.Hash
+
+	// Source is the way in which the swap was dispatched.
+	Source DispatchSource
+
+	// Rules are the liquidity rules that the swap was suggested for.
+	Rules []DispatchRule
+
+	// Amount is the suggested swap amount.
+	Amount btcutil.Amount
+
+	// MaxSwapFee is the suggested swap fee limit.
+	MaxSwapFee btcutil.Amount
+
+	// MaxMinerFee is the suggested miner fee limit.
+	MaxMinerFee btcutil.Amount
+
+	// MaxSwapRoutingFee is the suggested limit for the routing fees of
+	// the swap payment.
+	MaxSwapRoutingFee btcutil.Amount
+
+	// MaxPrepayRoutingFee is the suggested limit for the routing fees of
+	// the prepay payment.
+	MaxPrepayRoutingFee btcutil.Amount
+
+	// SweepConfTarget is the suggested confirmation target for the sweep.
+	SweepConfTarget int32
+
+	// Channels contains the balances of the swap's channels when it was
+	// dispatched.
+	Channels []ChannelSnapshot
+}
+
+// dispatchRecordKey returns the key that a record is stored under.
+func dispatchRecordKey(record *DispatchRecord) []byte {
+	key := make([]byte, 8+lntypes.HashSize)
+	copy(key, timestampKey(record.Time))
+	copy(key[8:], record.SwapHash[:])
+
+	return key
+}
+
+// serializeDispatchRecord serializes a dispatch record. Its time and swap
+// hash are stored in its key, so they are not included.
+func serializeDispatchRecord(record *DispatchRecord) ([]byte, error) {
+	var b bytes.Buffer
+
+	fields := []interface{}{
+		uint8(record.Source), int64(record.Amount),
+		int64(record.MaxSwapFee), int64(record.MaxMinerFee),
+		int64(record.MaxSwapRoutingFee),
+		int64(record.MaxPrepayRoutingFee), record.SweepConfTarget,
+		uint32(len(record.Rules)),
+	}
+
+	for _, rule := range record.Rules {
+		fields = append(
+			fields, rule.ChannelID, rule.Peer, rule.MinimumIncoming,
+			rule.MinimumOutgoing,
+		)
+	}
+
+	fields = append(fields, uint32(len(record.Channels)))
+	for _, channel := range record.Channels {
+		fields = append(
+			fields, channel.ChannelID, channel.Peer,
+			int64(channel.Capacity), int64(channel.LocalBalance),
+			int64(channel.RemoteBalance),
+		)
+	}
+
+	for _, field := range fields {
+		if err := binary.Write(&b, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeDispatchRecord deserializes the dispatch record stored under the
+// key provided.
+func deserializeDispatchRecord(key, value []byte) (*DispatchRecord, error) {
+	if len(key) != 8+lntypes.HashSize {
+		return nil, errors.New("invalid dispatch record key")
+	}
+
+	record := &DispatchRecord{
+		Time: time.Unix(0, int64(byteOrder.Uint64(key[:8]))),
+	}
+	copy(record.SwapHash[:], key[8:])
+
+	var (
+		source                                 uint8
+		amount, swapFee, minerFee, swapRouting int64
+		prepayRouting                          int64
+		ruleCount                              uint32
+	)
+
+	r := bytes.NewReader(value)
+	err := readFields(
+		r, &source, &amount, &swapFee, &minerFee, &swapRouting,
+		&prepayRouting, &record.SweepConfTarget, &ruleCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Source = DispatchSource(source)
+	record.Amount = btcutil.Amount(amount)
+	record.MaxSwapFee = btcutil.Amount(swapFee)
+	record.MaxMinerFee = btcutil.Amount(minerFee)
+	record.MaxSwapRoutingFee = btcutil.Amount(swapRouting)
+	record.MaxPrepayRoutingFee = btcutil.Amount(prepayRouting)
+
+	if ruleCount > 0 {
+		record.Rules = make([]DispatchRule, ruleCount)
+	}
+
+	for i := range record.Rules {
+		rule := &record.Rules[i]
+
+		err := readFields(
+			r, &rule.ChannelID, &rule.Peer, &rule.MinimumIncoming,
+			&rule.MinimumOutgoing,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var channelCount uint32
+	if err := readFields(r, &channelCount); err != nil {
+		return nil, err
+	}
+
+	if channelCount > 0 {
+		record.Channels = make([]ChannelSnapshot, channelCount)
+	}
+
+	for i := range record.Channels {
+		channel := &record.Channels[i]
+
+		var capacity, local, remote int64
+		err := readFields(
+			r, &channel.ChannelID, &channel.Peer, &capacity,
+			&local, &remote,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		channel.Capacity = btcutil.Amount(capacity)
+		channel.LocalBalance = btcutil.Amount(local)
+		channel.RemoteBalance = btcutil.Amount(remote)
+	}
+
+	return record, nil
+}
+
+// readFields reads each of the fields provided from the reader, in order.
+func readFields(r io.Reader, fields ...interface{}) error {
+	for _, field := range fields {
+		if err := binary.Read(r, byteOrder, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateDispatchRecord stores a record of a suggested swap that was
+// dispatched.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CreateDispatchRecord(record *DispatchRecord) error {
+	if record.Source > DispatchSourceApplied {
+		return errUnknownDispatchSource
+	}
+
+	value, err := serializeDispatchRecord(record)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(
+			dispatchRecordBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(dispatchRecordKey(record), value)
+	})
+}
+
+// FetchDispatchRecords returns all dispatch records made between the start
+// and end time provided, inclusive, in chronological order. A zero start or
+// end time leaves that side of the range unbounded.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchDispatchRecords(start,
+	end time.Time) ([]*DispatchRecord, error) {
+
+	var records []*DispatchRecord
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(dispatchRecordBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		cursor := bucket.Cursor()
+
+		k, v := cursor.First()
+		if !start.IsZero() {
+			k, v = cursor.Seek(timestampKey(start))
+		}
+
+		for ; k != nil; k, v = cursor.Next() {
+			record, err := deserializeDispatchRecord(k, v)
+			if err != nil {
+				return err
+			}
+
+			if !end.IsZero() && record.Time.After(end) {
+				break
+			}
+
+			records = append(records, record)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
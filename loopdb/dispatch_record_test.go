@@ -0,0 +1,131 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDispatchRecords tests storing and querying dispatch records.
+func TestDispatchRecords(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	// Before we have any records stored, we expect an empty result.
+	records, err := store.FetchDispatchRecords(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, records, 0)
+
+	var (
+		start = time.Unix(1000, 0)
+		hour1 = start.Add(time.Hour)
+
+		peer1 = route.Vertex{1}
+		peer2 = route.Vertex{2}
+	)
+
+	// Our first swap is suggested for a channel rule.
+	channelSwap := &DispatchRecord{
+		Time:     start,
+		SwapHash: lntypes.Hash{1},
+		Source:   DispatchSourceAutoloop,
+		Rules: []DispatchRule{
+			{
+				ChannelID:       123,
+				MinimumIncoming: 20,
+				MinimumOutgoing: 10,
+			},
+		},
+		Amount:              50000,
+		MaxSwapFee:          100,
+		MaxMinerFee:         2000,
+		MaxSwapRoutingFee:   50,
+		MaxPrepayRoutingFee: 10,
+		SweepConfTarget:     100,
+		Channels: []ChannelSnapshot{
+			{
+				ChannelID:     123,
+				Peer:          peer1,
+				Capacity:      100000,
+				LocalBalance:  90000,
+				RemoteBalance: 10000,
+			},
+		},
+	}
+
+	// Swaps dispatched at the same time are stored separately, and a peer
+	// rule may cover multiple channels.
+	peerSwap := &DispatchRecord{
+		Time:     start,
+		SwapHash: lntypes.Hash{2},
+		Source:   DispatchSourceApplied,
+		Rules: []DispatchRule{
+			{
+				Peer:            peer2,
+				MinimumIncoming: 30,
+			},
+		},
+		Amount:          80000,
+		SweepConfTarget: 6,
+		Channels: []ChannelSnapshot{
+			{
+				ChannelID:    456,
+				Peer:         peer2,
+				Capacity:     100000,
+				LocalBalance: 100000,
+			},
+			{
+				ChannelID:     789,
+				Peer:          peer2,
+				Capacity:      50000,
+				LocalBalance:  40000,
+				RemoteBalance: 10000,
+			},
+		},
+	}
+
+	laterSwap := &DispatchRecord{
+		Time:     hour1,
+		SwapHash: lntypes.Hash{3},
+		Source:   DispatchSourceAutoloop,
+		Amount:   20000,
+	}
+
+	for _, record := range []*DispatchRecord{
+		channelSwap, peerSwap, laterSwap,
+	} {
+		require.NoError(t, store.CreateDispatchRecord(record))
+	}
+
+	// Records with unknown sources are rejected.
+	err = store.CreateDispatchRecord(&DispatchRecord{
+		Time:   hour1,
+		Source: DispatchSourceApplied + 1,
+	})
+	require.Equal(t, errUnknownDispatchSource, err)
+
+	records, err = store.FetchDispatchRecords(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Equal(
+		t, []*DispatchRecord{channelSwap, peerSwap, laterSwap}, records,
+	)
+
+	records, err = store.FetchDispatchRecords(hour1, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, []*DispatchRecord{laterSwap}, records)
+
+	records, err = store.FetchDispatchRecords(time.Time{}, start)
+	require.NoError(t, err)
+	require.Equal(t, []*DispatchRecord{channelSwap, peerSwap}, records)
+}
@@ -0,0 +1,73 @@
+package loopdb
+
+import (
+	"errors"
+
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// extensionBucketKey is the top level bucket that holds a bucket for
+	// each swap protocol extension, keyed by the extension's name.
+	extensionBucketKey = []byte("extensions")
+
+	// ErrExtensionName is returned when extension storage is accessed
+	// without an extension name.
+	ErrExtensionName = errors.New("extension name required")
+)
+
+// UpdateExtension calls f with the bucket of the extension provided in a
+// read-write transaction, creating the bucket if it does not exist yet. The
+// transaction is rolled back if f fails.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateExtension(name string,
+	f func(bucket *bbolt.Bucket) error) error {
+
+	if name == "" {
+		return ErrExtensionName
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket, err := tx.CreateBucketIfNotExists(
+			extensionBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		bucket, err := rootBucket.CreateBucketIfNotExists([]byte(name))
+		if err != nil {
+			return err
+		}
+
+		return f(bucket)
+	})
+}
+
+// ViewExtension calls f with the bucket of the extension provided in a
+// read-only transaction. If the extension has not stored anything yet, f is
+// not called.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) ViewExtension(name string,
+	f func(bucket *bbolt.Bucket) error) error {
+
+	if name == "" {
+		return ErrExtensionName
+	}
+
+	return s.db.View(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(extensionBucketKey)
+		if rootBucket == nil {
+			return nil
+		}
+
+		bucket := rootBucket.Bucket([]byte(name))
+		if bucket == nil {
+			return nil
+		}
+
+		return f(bucket)
+	})
+}
@@ -0,0 +1,63 @@
+package loopdb
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/coreos/bbolt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExtensionStorage tests that swap extensions store their data in their
+// own buckets.
+func TestExtensionStorage(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	key := []byte("swap")
+	get := func(name string) []byte {
+		var value []byte
+		err := store.ViewExtension(name, func(b *bbolt.Bucket) error {
+			value = b.Get(key)
+			return nil
+		})
+		require.NoError(t, err)
+
+		return value
+	}
+
+	// Extensions that have not stored anything have no data.
+	require.Nil(t, get("hyperloop"))
+
+	err = store.UpdateExtension("hyperloop", func(b *bbolt.Bucket) error {
+		return b.Put(key, []byte{1})
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, get("hyperloop"))
+	require.Nil(t, get("taproot"))
+
+	// Failed updates are rolled back.
+	errUpdate := errors.New("update failed")
+	err = store.UpdateExtension("hyperloop", func(b *bbolt.Bucket) error {
+		if err := b.Put(key, []byte{2}); err != nil {
+			return err
+		}
+
+		return errUpdate
+	})
+	require.Equal(t, errUpdate, err)
+	require.Equal(t, []byte{1}, get("hyperloop"))
+
+	err = store.UpdateExtension("", func(*bbolt.Bucket) error {
+		return nil
+	})
+	require.Equal(t, ErrExtensionName, err)
+}
@@ -0,0 +1,191 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// feeBudgetBucketKey is a bucket that contains the fee budgets of
+	// tenants and labels.
+	//
+	// maps: namespace || name -> budget
+	feeBudgetBucketKey = []byte("fee-budgets")
+
+	// ErrFeeBudgetNotFound is returned when a fee budget that does not
+	// exist is deleted.
+	ErrFeeBudgetNotFound = errors.New("fee budget not found")
+)
+
+// MaxFeeBudgetNameLength is the maximum length of the name that a fee budget
+// applies to.
+const MaxFeeBudgetNameLength = 128
+
+// BudgetNamespace is the kind of name that a fee budget applies to.
+type BudgetNamespace uint8
+
+const (
+	// BudgetNamespaceTenant indicates that a budget applies to the swaps
+	// of a tenant in multi-tenant mode.
+	BudgetNamespaceTenant BudgetNamespace = iota
+
+	// BudgetNamespaceLabel indicates that a budget applies to the swaps
+	// with a label.
+	BudgetNamespaceLabel
+)
+
+// String returns a string representation of the namespace.
+func (n BudgetNamespace) String() string {
+	switch n {
+	case BudgetNamespaceTenant:
+		return "tenant"
+
+	case BudgetNamespaceLabel:
+		return "label"
+
+	default:
+		return "unknown"
+	}
+}
+
+// FeeBudget caps the fees that the swaps of a tenant or label may spend over
+// a rolling period.
+type FeeBudget struct {
+	// Namespace is the kind of name that the budget applies to.
+	Namespace BudgetNamespace
+
+	// Name is the tenant or label that the budget applies to.
+	Name string
+
+	// Budget is the maximum total fees that the swaps may spend.
+	Budget btcutil.Amount
+
+	// Period is the rolling period that the budget is enforced over. If
+	// it is zero, all swaps count towards the budget.
+	Period time.Duration
+}
+
+// feeBudgetKey returns the key that a budget is stored under.
+func feeBudgetKey(namespace BudgetNamespace, name string) []byte {
+	return append([]byte{byte(namespace)}, name...)
+}
+
+// serializeFeeBudget serializes a fee budget. The namespace and name are
+// stored as the budget's key, so they are not included.
+func serializeFeeBudget(w io.Writer, budget *FeeBudget) error {
+	if err := binary.Write(w, byteOrder, budget.Budget); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, int64(budget.Period))
+}
+
+// deserializeFeeBudget deserializes the fee budget stored under the key
+// provided.
+func deserializeFeeBudget(key []byte, r io.Reader) (*FeeBudget, error) {
+	if len(key) == 0 {
+		return nil, errors.New("empty fee budget key")
+	}
+
+	budget := &FeeBudget{
+		Namespace: BudgetNamespace(key[0]),
+		Name:      string(key[1:]),
+	}
+
+	if err := binary.Read(r, byteOrder, &budget.Budget); err != nil {
+		return nil, err
+	}
+
+	var period int64
+	if err := binary.Read(r, byteOrder, &period); err != nil {
+		return nil, err
+	}
+	budget.Period = time.Duration(period)
+
+	return budget, nil
+}
+
+// PutFeeBudget stores a fee budget, replacing any existing budget for the
+// same namespace and name.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) PutFeeBudget(budget *FeeBudget) error {
+	if len(budget.Name) > MaxFeeBudgetNameLength {
+		return fmt.Errorf("fee budget name exceeds maximum length of "+
+			"%v", MaxFeeBudgetNameLength)
+	}
+
+	var b bytes.Buffer
+	if err := serializeFeeBudget(&b, budget); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(feeBudgetBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(
+			feeBudgetKey(budget.Namespace, budget.Name), b.Bytes(),
+		)
+	})
+}
+
+// FetchFeeBudgets returns all stored fee budgets, ordered by namespace and
+// name.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchFeeBudgets() ([]*FeeBudget, error) {
+	var budgets []*FeeBudget
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(feeBudgetBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			budget, err := deserializeFeeBudget(
+				k, bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			budgets = append(budgets, budget)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+// DeleteFeeBudget deletes the fee budget for the namespace and name provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) DeleteFeeBudget(namespace BudgetNamespace,
+	name string) error {
+
+	key := feeBudgetKey(namespace, name)
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(feeBudgetBucketKey)
+		if bucket == nil || bucket.Get(key) == nil {
+			return ErrFeeBudgetNotFound
+		}
+
+		return bucket.Delete(key)
+	})
+}
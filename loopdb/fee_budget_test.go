@@ -0,0 +1,67 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeBudgets tests storing, replacing and deleting fee budgets.
+func TestFeeBudgets(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	budgets, err := store.FetchFeeBudgets()
+	require.NoError(t, err)
+	require.Len(t, budgets, 0)
+
+	label := &FeeBudget{
+		Namespace: BudgetNamespaceLabel,
+		Name:      "treasury",
+		Budget:    5000,
+		Period:    time.Hour * 24,
+	}
+
+	// A tenant with the same name as a label has a separate budget.
+	tenant := &FeeBudget{
+		Namespace: BudgetNamespaceTenant,
+		Name:      "treasury",
+		Budget:    1000,
+	}
+
+	require.NoError(t, store.PutFeeBudget(label))
+	require.NoError(t, store.PutFeeBudget(tenant))
+
+	// Budgets are returned ordered by namespace and name.
+	budgets, err = store.FetchFeeBudgets()
+	require.NoError(t, err)
+	require.Equal(t, []*FeeBudget{tenant, label}, budgets)
+
+	// Storing a budget for the same name replaces it.
+	tenant.Budget = 2000
+	require.NoError(t, store.PutFeeBudget(tenant))
+
+	budgets, err = store.FetchFeeBudgets()
+	require.NoError(t, err)
+	require.Equal(t, []*FeeBudget{tenant, label}, budgets)
+
+	require.NoError(t, store.DeleteFeeBudget(
+		BudgetNamespaceTenant, "treasury",
+	))
+
+	budgets, err = store.FetchFeeBudgets()
+	require.NoError(t, err)
+	require.Equal(t, []*FeeBudget{label}, budgets)
+
+	err = store.DeleteFeeBudget(BudgetNamespaceTenant, "treasury")
+	require.Equal(t, ErrFeeBudgetNotFound, err)
+}
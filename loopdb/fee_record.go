@@ -0,0 +1,288 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// feeRecordBucketKey is a bucket that contains the fee estimates that
+	// we observed when we made fee sensitive decisions. Records are keyed
+	// by their timestamp so that they can be iterated in chronological
+	// order, followed by the hash of their swap so that the records of
+	// different swaps made at the same time don't collide.
+	//
+	// maps: timestamp (unix nano) || swap hash -> record
+	feeRecordBucketKey = []byte("fee-records")
+
+	// errUnknownFeeDecision is returned when a record with an unknown
+	// decision is stored.
+	errUnknownFeeDecision = errors.New("unknown fee decision")
+)
+
+// FeeDecision is a point at which we make a decision based on the current
+// chain fee estimate.
+type FeeDecision uint8
+
+const (
+	// FeeDecisionSuggestion is the check of the sweep fee estimate
+	// against our fee limit before we suggest any swaps. These records
+	// are not associated with a swap.
+	FeeDecisionSuggestion FeeDecision = 0
+
+	// FeeDecisionHtlc is the publication of the htlc of a loop in.
+	FeeDecisionHtlc FeeDecision = 1
+
+	// FeeDecisionSweep is the publication of the sweep of an htlc, which
+	// is the success sweep of a loop out or the timeout sweep of a loop
+	// in.
+	FeeDecisionSweep FeeDecision = 2
+)
+
+// String returns the string representation of a fee decision.
+func (f FeeDecision) String() string {
+	switch f {
+	case FeeDecisionSuggestion:
+		return "Suggestion"
+
+	case FeeDecisionHtlc:
+		return "Htlc"
+
+	case FeeDecisionSweep:
+		return "Sweep"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// FeeRecord is a record of the fee estimate that we observed when we made a
+// fee sensitive decision.
+type FeeRecord struct {
+	// Time is the time that the decision was made.
+	Time time.Time
+
+	// Decision is the decision that was made.
+	Decision FeeDecision
+
+	// SwapHash is the hash of the swap that the decision was made for.
+	// It is not set for suggestions.
+	SwapHash lntypes.Hash
+
+	// ConfTarget is the confirmation target that the fee was estimated
+	// for.
+	ConfTarget int32
+
+	// FeeRate is the fee rate that was estimated.
+	FeeRate chainfee.SatPerKWeight
+
+	// Fee is the fee that the transaction would pay at the estimated fee
+	// rate. It is not set for suggestions.
+	Fee btcutil.Amount
+
+	// Allowed is true if our fee limits allowed us to go ahead with the
+	// decision, which means that we suggested swaps or published the
+	// transaction.
+	Allowed bool
+
+	// RealizedFeeRate is the fee rate that the transaction which
+	// confirmed paid. It is zero until the transaction confirms, and is
+	// never set for suggestions.
+	RealizedFeeRate chainfee.SatPerKWeight
+}
+
+// feeRecordKey returns the key that a record is stored under.
+func feeRecordKey(record *FeeRecord) []byte {
+	key := make([]byte, 8+lntypes.HashSize)
+	copy(key, timestampKey(record.Time))
+	copy(key[8:], record.SwapHash[:])
+
+	return key
+}
+
+// serializeFeeRecord serializes a fee record. Its time and swap hash are
+// stored in its key, so they are not included.
+func serializeFeeRecord(record *FeeRecord) ([]byte, error) {
+	var b bytes.Buffer
+
+	fields := []interface{}{
+		uint8(record.Decision), record.ConfTarget,
+		int64(record.FeeRate), int64(record.Fee), record.Allowed,
+		int64(record.RealizedFeeRate),
+	}
+
+	for _, field := range fields {
+		if err := binary.Write(&b, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeFeeRecord deserializes the fee record stored under the key
+// provided.
+func deserializeFeeRecord(key, value []byte) (*FeeRecord, error) {
+	if len(key) != 8+lntypes.HashSize {
+		return nil, errors.New("invalid fee record key")
+	}
+
+	record := &FeeRecord{
+		Time: time.Unix(0, int64(byteOrder.Uint64(key[:8]))),
+	}
+	copy(record.SwapHash[:], key[8:])
+
+	var (
+		decision                      uint8
+		feeRate, fee, realizedFeeRate int64
+	)
+
+	r := bytes.NewReader(value)
+	fields := []interface{}{
+		&decision, &record.ConfTarget, &feeRate, &fee, &record.Allowed,
+		&realizedFeeRate,
+	}
+
+	for _, field := range fields {
+		if err := binary.Read(r, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	record.Decision = FeeDecision(decision)
+	record.FeeRate = chainfee.SatPerKWeight(feeRate)
+	record.Fee = btcutil.Amount(fee)
+	record.RealizedFeeRate = chainfee.SatPerKWeight(realizedFeeRate)
+
+	return record, nil
+}
+
+// CreateFeeRecord stores a record of a fee sensitive decision.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CreateFeeRecord(record *FeeRecord) error {
+	if record.Decision > FeeDecisionSweep {
+		return errUnknownFeeDecision
+	}
+
+	value, err := serializeFeeRecord(record)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(feeRecordBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(feeRecordKey(record), value)
+	})
+}
+
+// SetRealizedFeeRate sets the fee rate that the confirmed transaction of a
+// swap paid on all of the swap's records for the decision provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) SetRealizedFeeRate(hash lntypes.Hash,
+	decision FeeDecision, feeRate chainfee.SatPerKWeight) error {
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(feeRecordBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		// We can't modify the bucket while iterating over it, so we
+		// collect the records to update first.
+		var updated []*FeeRecord
+		err := bucket.ForEach(func(k, v []byte) error {
+			record, err := deserializeFeeRecord(k, v)
+			if err != nil {
+				return err
+			}
+
+			if record.SwapHash != hash {
+				return nil
+			}
+
+			if record.Decision != decision {
+				return nil
+			}
+
+			record.RealizedFeeRate = feeRate
+			updated = append(updated, record)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, record := range updated {
+			value, err := serializeFeeRecord(record)
+			if err != nil {
+				return err
+			}
+
+			err = bucket.Put(feeRecordKey(record), value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchFeeRecords returns all fee records made between the start and end
+// time provided, inclusive, in chronological order. A zero start or end time
+// leaves that side of the range unbounded.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchFeeRecords(start, end time.Time) ([]*FeeRecord,
+	error) {
+
+	var records []*FeeRecord
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(feeRecordBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		cursor := bucket.Cursor()
+
+		k, v := cursor.First()
+		if !start.IsZero() {
+			k, v = cursor.Seek(timestampKey(start))
+		}
+
+		for ; k != nil; k, v = cursor.Next() {
+			record, err := deserializeFeeRecord(k, v)
+			if err != nil {
+				return err
+			}
+
+			if !end.IsZero() && record.Time.After(end) {
+				break
+			}
+
+			records = append(records, record)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,125 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeRecords tests storing and querying fee records, and setting the fee
+// rates that their transactions were realized with.
+func TestFeeRecords(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	// Before we have any records stored, we expect an empty result.
+	records, err := store.FetchFeeRecords(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, records, 0)
+
+	var (
+		start = time.Unix(1000, 0)
+		hour1 = start.Add(time.Hour)
+		hour2 = start.Add(time.Hour * 2)
+
+		hash1 = lntypes.Hash{1}
+		hash2 = lntypes.Hash{2}
+	)
+
+	suggestion := &FeeRecord{
+		Time:       start,
+		Decision:   FeeDecisionSuggestion,
+		ConfTarget: 100,
+		FeeRate:    250,
+		Allowed:    true,
+	}
+
+	// Records of different swaps that are made at the same time are
+	// stored separately.
+	sweep1 := &FeeRecord{
+		Time:       hour1,
+		Decision:   FeeDecisionSweep,
+		SwapHash:   hash1,
+		ConfTarget: 9,
+		FeeRate:    2000,
+		Fee:        1100,
+	}
+
+	htlc2 := &FeeRecord{
+		Time:       hour1,
+		Decision:   FeeDecisionHtlc,
+		SwapHash:   hash2,
+		ConfTarget: 6,
+		FeeRate:    1000,
+		Fee:        500,
+		Allowed:    true,
+	}
+
+	sweep2 := &FeeRecord{
+		Time:       hour2,
+		Decision:   FeeDecisionSweep,
+		SwapHash:   hash1,
+		ConfTarget: 9,
+		FeeRate:    1500,
+		Fee:        800,
+		Allowed:    true,
+	}
+
+	for _, record := range []*FeeRecord{
+		suggestion, sweep1, htlc2, sweep2,
+	} {
+		require.NoError(t, store.CreateFeeRecord(record))
+	}
+
+	// Records with unknown decisions are rejected.
+	err = store.CreateFeeRecord(&FeeRecord{
+		Time:     hour2,
+		Decision: FeeDecisionSweep + 1,
+	})
+	require.Equal(t, errUnknownFeeDecision, err)
+
+	records, err = store.FetchFeeRecords(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Equal(
+		t, []*FeeRecord{suggestion, sweep1, htlc2, sweep2}, records,
+	)
+
+	records, err = store.FetchFeeRecords(hour1, hour1)
+	require.NoError(t, err)
+	require.Equal(t, []*FeeRecord{sweep1, htlc2}, records)
+
+	// Once the sweep of our first swap confirms, its realized fee rate is
+	// set on all of its sweep records, and no other records.
+	err = store.SetRealizedFeeRate(hash1, FeeDecisionSweep, 1600)
+	require.NoError(t, err)
+
+	sweep1.RealizedFeeRate = 1600
+	sweep2.RealizedFeeRate = 1600
+
+	records, err = store.FetchFeeRecords(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Equal(
+		t, []*FeeRecord{suggestion, sweep1, htlc2, sweep2}, records,
+	)
+
+	// Setting a realized fee rate for a decision that the swap has no
+	// records for does not change any records.
+	err = store.SetRealizedFeeRate(hash2, FeeDecisionSweep, 1600)
+	require.NoError(t, err)
+
+	records, err = store.FetchFeeRecords(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Equal(
+		t, []*FeeRecord{suggestion, sweep1, htlc2, sweep2}, records,
+	)
+}
@@ -0,0 +1,120 @@
+package loopdb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// htlcFundingKey is the key that stores the optional restrictions on the
+// coins that a loop in htlc may be funded with. If a swap was created before
+// we started storing these restrictions, or was created without any, this key
+// will not be present.
+//
+// path: loopInBucket -> swapBucket[hash] -> htlcFundingKey
+//
+// value: account || outpoint count || (txid || index)*
+var htlcFundingKey = []byte("htlc-funding")
+
+// HtlcFunding restricts the coins in lnd's wallet that a loop in htlc may be
+// funded with, so that coins from separate wallet compartments are not linked
+// by the htlc transaction.
+type HtlcFunding struct {
+	// Account is the name of the lnd wallet account that the htlc is
+	// funded from, and that change is returned to. If empty, lnd's
+	// default account is used.
+	Account string
+
+	// Outpoints is an optional set of coins that the htlc must be funded
+	// with. If empty, coins are selected from the account.
+	Outpoints []wire.OutPoint
+}
+
+// serializeHtlcFunding serializes a set of htlc funding restrictions.
+func serializeHtlcFunding(w io.Writer, funding *HtlcFunding) error {
+	if err := wire.WriteVarString(w, 0, funding.Account); err != nil {
+		return err
+	}
+
+	err := wire.WriteVarInt(w, 0, uint64(len(funding.Outpoints)))
+	if err != nil {
+		return err
+	}
+
+	for _, outpoint := range funding.Outpoints {
+		if _, err := w.Write(outpoint.Hash[:]); err != nil {
+			return err
+		}
+
+		err := wire.WriteVarInt(w, 0, uint64(outpoint.Index))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeHtlcFunding deserializes a set of htlc funding restrictions.
+func deserializeHtlcFunding(r io.Reader) (*HtlcFunding, error) {
+	account, err := wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	funding := &HtlcFunding{
+		Account: account,
+	}
+
+	for i := uint64(0); i < count; i++ {
+		var hash chainhash.Hash
+		if _, err := io.ReadFull(r, hash[:]); err != nil {
+			return nil, err
+		}
+
+		index, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		funding.Outpoints = append(
+			funding.Outpoints, *wire.NewOutPoint(&hash, uint32(index)),
+		)
+	}
+
+	return funding, nil
+}
+
+// putHtlcFunding stores optional htlc funding restrictions in a swap bucket.
+// If the restrictions are nil, nothing is stored.
+func putHtlcFunding(bucket *bbolt.Bucket, funding *HtlcFunding) error {
+	if funding == nil {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := serializeHtlcFunding(&b, funding); err != nil {
+		return err
+	}
+
+	return bucket.Put(htlcFundingKey, b.Bytes())
+}
+
+// getHtlcFunding returns the htlc funding restrictions stored in a swap
+// bucket, or nil if none are present.
+func getHtlcFunding(bucket *bbolt.Bucket) (*HtlcFunding, error) {
+	fundingBytes := bucket.Get(htlcFundingKey)
+	if fundingBytes == nil {
+		return nil, nil
+	}
+
+	return deserializeHtlcFunding(bytes.NewReader(fundingBytes))
+}
@@ -0,0 +1,104 @@
+package loopdb
+
+import (
+	"errors"
+
+	"github.com/coreos/bbolt"
+)
+
+// StoreExists returns true if a swap store was created in the directory
+// provided.
+func StoreExists(dbPath string) bool {
+	return fileExists(StorePath(dbPath))
+}
+
+// ImportSwaps stores the swaps provided along with all of their events, which
+// are typically read from another store. All swaps are imported in a single
+// transaction, so if any of the swaps already exists, none are imported.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) ImportSwaps(loopOuts []*LoopOut,
+	loopIns []*LoopIn) error {
+
+	for _, swap := range loopOuts {
+		if swap.Hash != swap.Contract.Preimage.Hash() {
+			return errors.New("hash and preimage do not match")
+		}
+	}
+
+	for _, swap := range loopIns {
+		if swap.Hash != swap.Contract.Preimage.Hash() {
+			return errors.New("hash and preimage do not match")
+		}
+	}
+
+	// Our swaps are only imported if the transaction succeeds, but we
+	// don't need to know whether it did to invalidate them.
+	defer func() {
+		for _, swap := range loopOuts {
+			s.cache.invalidate(loopOutBucketKey, swap.Hash)
+		}
+
+		for _, swap := range loopIns {
+			s.cache.invalidate(loopInBucketKey, swap.Hash)
+		}
+	}()
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		for _, swap := range loopOuts {
+			err := createLoopOut(tx, swap.Hash, swap.Contract)
+			if err != nil {
+				return err
+			}
+
+			_, err = s.separateSecret(
+				tx, loopOutBucketKey, swap.Hash,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = importEvents(tx, loopOutBucketKey, &swap.Loop)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, swap := range loopIns {
+			err := createLoopIn(tx, swap.Hash, swap.Contract)
+			if err != nil {
+				return err
+			}
+
+			_, err = s.separateSecret(
+				tx, loopInBucketKey, swap.Hash,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = importEvents(tx, loopInBucketKey, &swap.Loop)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// importEvents appends all events of the swap provided to its event log, in
+// the order that they occurred in.
+func importEvents(tx *bbolt.Tx, bucketKey []byte, swap *Loop) error {
+	for _, event := range swap.Events {
+		err := addLoopEvent(
+			tx, bucketKey, swap.Hash, event.Time,
+			event.SwapStateData,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
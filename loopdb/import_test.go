@@ -0,0 +1,111 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// newImportTestStore creates a swap store in a temporary directory.
+func newImportTestStore(t *testing.T) (*boltSwapStore, func()) {
+	dir, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+
+	store, err := NewBoltSwapStore(dir, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	return store, func() {
+		store.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// TestImportSwaps tests importing swaps along with their events from another
+// store.
+func TestImportSwaps(t *testing.T) {
+	from, cleanup := newImportTestStore(t)
+	defer cleanup()
+
+	store, cleanup := newImportTestStore(t)
+	defer cleanup()
+
+	contract := SwapContract{
+		AmountRequested:  100,
+		Preimage:         testPreimage,
+		CltvExpiry:       144,
+		SenderKey:        senderKey,
+		ReceiverKey:      receiverKey,
+		InitiationHeight: 99,
+		InitiationTime:   time.Unix(0, testTime.UnixNano()),
+		Label:            "label",
+	}
+
+	hash := testPreimage.Hash()
+	err := from.CreateLoopOut(hash, &LoopOutContract{
+		SwapContract:            contract,
+		DestAddr:                test.GetDestAddr(t, 0),
+		SwapInvoice:             "swapinvoice",
+		PrepayInvoice:           "prepayinvoice",
+		OutgoingChanSet:         ChannelSet{1, 2},
+		HtlcConfirmations:       2,
+		SwapPublicationDeadline: time.Unix(0, testTime.UnixNano()),
+	})
+	require.NoError(t, err)
+
+	err = from.CreateLoopIn(hash, &LoopInContract{
+		SwapContract:   contract,
+		HtlcConfTarget: 2,
+	})
+	require.NoError(t, err)
+
+	for i, state := range []SwapState{StatePreimageRevealed, StateSuccess} {
+		err := from.UpdateLoopOut(
+			hash, testTime.Add(time.Duration(i)*time.Minute),
+			SwapStateData{
+				State: state,
+				Cost: SwapCost{
+					Server: 10,
+				},
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	err = from.UpdateLoopIn(hash, testTime, SwapStateData{
+		State: StateFailTimeout,
+	})
+	require.NoError(t, err)
+
+	loopOuts, err := from.FetchLoopOutSwaps()
+	require.NoError(t, err)
+
+	loopIns, err := from.FetchLoopInSwaps()
+	require.NoError(t, err)
+
+	require.NoError(t, store.ImportSwaps(loopOuts, loopIns))
+
+	imported, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Equal(t, loopOuts, imported)
+
+	importedIn, err := store.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Equal(t, loopIns, importedIn)
+
+	// Importing swaps that already exist fails, without importing any of
+	// the other swaps.
+	empty, cleanup := newImportTestStore(t)
+	defer cleanup()
+
+	require.NoError(t, empty.ImportSwaps(nil, loopIns))
+	require.Error(t, empty.ImportSwaps(loopOuts, loopIns))
+
+	imported, err = empty.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Empty(t, imported)
+}
@@ -3,7 +3,9 @@ package loopdb
 import (
 	"time"
 
+	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // SwapStore is the primary database interface used by the loopd system. It
@@ -21,9 +23,17 @@ type SwapStore interface {
 	UpdateLoopOut(hash lntypes.Hash, time time.Time,
 		state SwapStateData) error
 
+	// FetchPendingLoopOuts returns the loop out swaps in the store that
+	// are not in a final state.
+	FetchPendingLoopOuts() ([]*LoopOut, error)
+
 	// FetchLoopInSwaps returns all swaps currently in the store.
 	FetchLoopInSwaps() ([]*LoopIn, error)
 
+	// FetchPendingLoopIns returns the loop in swaps in the store that are
+	// not in a final state.
+	FetchPendingLoopIns() ([]*LoopIn, error)
+
 	// CreateLoopIn adds an initiated swap to the store.
 	CreateLoopIn(hash lntypes.Hash, swap *LoopInContract) error
 
@@ -33,6 +43,159 @@ type SwapStore interface {
 	UpdateLoopIn(hash lntypes.Hash, time time.Time,
 		state SwapStateData) error
 
+	// UpdateLoopOuts stores a batch of loop out swap updates in a single
+	// transaction. If any of the updates can't be stored, none are.
+	UpdateLoopOuts(updates []*SwapUpdate) error
+
+	// UpdateLoopIns stores a batch of loop in swap updates in a single
+	// transaction. If any of the updates can't be stored, none are.
+	UpdateLoopIns(updates []*SwapUpdate) error
+
+	// FetchLoopOutStates returns the latest update of each of the loop out
+	// swaps provided. Swaps that have not been updated since they were
+	// created are not included in the map returned.
+	FetchLoopOutStates(hashes []lntypes.Hash) (map[lntypes.Hash]*LoopEvent,
+		error)
+
+	// FetchLoopInStates returns the latest update of each of the loop in
+	// swaps provided. Swaps that have not been updated since they were
+	// created are not included in the map returned.
+	FetchLoopInStates(hashes []lntypes.Hash) (map[lntypes.Hash]*LoopEvent,
+		error)
+
+	// CreateLiquiditySnapshot stores a snapshot of our balances.
+	CreateLiquiditySnapshot(snapshot *LiquiditySnapshot) error
+
+	// FetchLiquiditySnapshots returns all snapshots taken between the
+	// start and end time provided, inclusive, in chronological order. A
+	// zero start or end time leaves that side of the range unbounded.
+	FetchLiquiditySnapshots(start, end time.Time) ([]*LiquiditySnapshot,
+		error)
+
+	// PruneLiquiditySnapshots deletes all snapshots that were taken before
+	// the time provided.
+	PruneLiquiditySnapshots(before time.Time) error
+
+	// CreateFeeRecord stores a record of a fee sensitive decision.
+	CreateFeeRecord(record *FeeRecord) error
+
+	// SetRealizedFeeRate sets the fee rate that the confirmed transaction
+	// of a swap paid on all of the swap's records for the decision
+	// provided.
+	SetRealizedFeeRate(hash lntypes.Hash, decision FeeDecision,
+		feeRate chainfee.SatPerKWeight) error
+
+	// FetchFeeRecords returns all fee records made between the start and
+	// end time provided, inclusive, in chronological order. A zero start
+	// or end time leaves that side of the range unbounded.
+	FetchFeeRecords(start, end time.Time) ([]*FeeRecord, error)
+
+	// CreateDispatchRecord stores a record of a suggested swap that was
+	// dispatched.
+	CreateDispatchRecord(record *DispatchRecord) error
+
+	// FetchDispatchRecords returns all dispatch records made between the
+	// start and end time provided, inclusive, in chronological order. A
+	// zero start or end time leaves that side of the range unbounded.
+	FetchDispatchRecords(start, end time.Time) ([]*DispatchRecord, error)
+
+	// PutRoutingFailures stores the routing failures provided, replacing
+	// any failures that were previously stored for the same node pairs.
+	PutRoutingFailures(failures []RoutingFailure) error
+
+	// FetchRoutingFailures returns all routing failures that occurred at
+	// or after the time provided.
+	FetchRoutingFailures(since time.Time) ([]RoutingFailure, error)
+
+	// PruneRoutingFailures deletes all routing failures that occurred
+	// before the time provided.
+	PruneRoutingFailures(before time.Time) error
+
+	// PutSwapTemplate stores a swap template, replacing any existing
+	// template with the same name.
+	PutSwapTemplate(template *SwapTemplate) error
+
+	// FetchSwapTemplates returns all stored swap templates, ordered by
+	// name.
+	FetchSwapTemplates() ([]*SwapTemplate, error)
+
+	// DeleteSwapTemplate deletes the swap template with the name provided.
+	DeleteSwapTemplate(name string) error
+
+	// PutFeeBudget stores a fee budget, replacing any existing budget
+	// for the same namespace and name.
+	PutFeeBudget(budget *FeeBudget) error
+
+	// FetchFeeBudgets returns all stored fee budgets, ordered by
+	// namespace and name.
+	FetchFeeBudgets() ([]*FeeBudget, error)
+
+	// DeleteFeeBudget deletes the fee budget for the namespace and name
+	// provided.
+	DeleteFeeBudget(namespace BudgetNamespace, name string) error
+
+	// PutChannelPolicy stores the original policy of a channel, replacing
+	// any policy that was stored for the same channel.
+	PutChannelPolicy(policy *ChannelPolicy) error
+
+	// FetchChannelPolicies returns all stored channel policies, ordered
+	// by channel ID.
+	FetchChannelPolicies() ([]*ChannelPolicy, error)
+
+	// DeleteChannelPolicy deletes the stored policy of the channel
+	// provided.
+	DeleteChannelPolicy(channelID uint64) error
+
+	// CreateSwapPlan stores a new swap plan, assigning it a unique id.
+	CreateSwapPlan(plan *SwapPlan) error
+
+	// UpdateSwapPlan replaces the stored swap plan with the id of the
+	// plan provided.
+	UpdateSwapPlan(plan *SwapPlan) error
+
+	// FetchSwapPlans returns all stored swap plans, ordered by id.
+	FetchSwapPlans() ([]*SwapPlan, error)
+
+	// UpdateExtension calls f with the bucket of the swap protocol
+	// extension provided in a read-write transaction, creating the bucket
+	// if it does not exist yet.
+	UpdateExtension(name string, f func(bucket *bbolt.Bucket) error) error
+
+	// ViewExtension calls f with the bucket of the swap protocol extension
+	// provided in a read-only transaction. If the extension has not stored
+	// anything yet, f is not called.
+	ViewExtension(name string, f func(bucket *bbolt.Bucket) error) error
+
+	// ImportSwaps stores the swaps provided along with all of their
+	// events. If any of the swaps already exists, none are imported.
+	ImportSwaps(loopOuts []*LoopOut, loopIns []*LoopIn) error
+
+	// RedactSwap removes the metadata that may identify the people behind
+	// a swap in a final state, while keeping its amounts and
+	// transactions.
+	RedactSwap(hash lntypes.Hash) error
+
+	// PutPendingApproval stores a swap that is awaiting approval.
+	PutPendingApproval(approval *PendingApproval) error
+
+	// FetchPendingApprovals returns all swaps that are awaiting approval.
+	FetchPendingApprovals() ([]*PendingApproval, error)
+
+	// DeletePendingApproval removes the swap with the hash provided from
+	// the swaps that are awaiting approval.
+	DeletePendingApproval(hash lntypes.Hash) error
+
+	// CreateSwapDenial stores the denial of a swap that was awaiting
+	// approval, and removes it from the swaps that are awaiting approval.
+	CreateSwapDenial(denial *SwapDenial) error
+
+	// FetchSwapDenials returns all swap denials in chronological order.
+	FetchSwapDenials() ([]*SwapDenial, error)
+
+	// WriteReplica writes a consistent copy of the database to the file
+	// provided, which may be opened read-only by another process.
+	WriteReplica(path string) error
+
 	// Close closes the underlying database.
 	Close() error
 }
@@ -0,0 +1,280 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// liquiditySnapshotBucketKey is a bucket that contains periodic
+	// snapshots of our node's balances. Snapshots are keyed by their
+	// timestamp so that they can be iterated in chronological order.
+	//
+	// maps: timestamp (unix nano) -> snapshot
+	liquiditySnapshotBucketKey = []byte("liquidity-snapshots")
+)
+
+// LiquiditySnapshot is a record of our node's balances at a point in time.
+type LiquiditySnapshot struct {
+	// Timestamp is the time that the snapshot was taken.
+	Timestamp time.Time
+
+	// ConfirmedBalance is our confirmed on chain wallet balance.
+	ConfirmedBalance btcutil.Amount
+
+	// Channels contains the balances of each of our channels.
+	Channels []ChannelSnapshot
+}
+
+// ChannelSnapshot is a record of a single channel's balances.
+type ChannelSnapshot struct {
+	// ChannelID is the short channel ID of the channel.
+	ChannelID uint64
+
+	// Peer is the pubkey of the channel's peer.
+	Peer route.Vertex
+
+	// Capacity is the total capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our local balance in the channel.
+	LocalBalance btcutil.Amount
+
+	// RemoteBalance is our peer's balance in the channel.
+	RemoteBalance btcutil.Amount
+}
+
+// LocalBalance returns our total local balance across all channels in the
+// snapshot.
+func (l *LiquiditySnapshot) LocalBalance() btcutil.Amount {
+	var total btcutil.Amount
+	for _, channel := range l.Channels {
+		total += channel.LocalBalance
+	}
+
+	return total
+}
+
+// RemoteBalance returns our total remote balance across all channels in the
+// snapshot.
+func (l *LiquiditySnapshot) RemoteBalance() btcutil.Amount {
+	var total btcutil.Amount
+	for _, channel := range l.Channels {
+		total += channel.RemoteBalance
+	}
+
+	return total
+}
+
+// serializeLiquiditySnapshot serializes a snapshot's balances. The timestamp
+// is stored as the snapshot's key, so it is not included.
+func serializeLiquiditySnapshot(snapshot *LiquiditySnapshot) ([]byte,
+	error) {
+
+	var b bytes.Buffer
+
+	err := binary.Write(&b, byteOrder, snapshot.ConfirmedBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	err = binary.Write(&b, byteOrder, uint32(len(snapshot.Channels)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range snapshot.Channels {
+		err := binary.Write(&b, byteOrder, channel.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := b.Write(channel.Peer[:]); err != nil {
+			return nil, err
+		}
+
+		err = binary.Write(&b, byteOrder, channel.Capacity)
+		if err != nil {
+			return nil, err
+		}
+
+		err = binary.Write(&b, byteOrder, channel.LocalBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		err = binary.Write(&b, byteOrder, channel.RemoteBalance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeLiquiditySnapshot deserializes a snapshot stored under the
+// timestamp provided.
+func deserializeLiquiditySnapshot(timestamp time.Time, value []byte) (
+	*LiquiditySnapshot, error) {
+
+	r := bytes.NewReader(value)
+
+	snapshot := &LiquiditySnapshot{
+		Timestamp: timestamp,
+	}
+
+	err := binary.Read(r, byteOrder, &snapshot.ConfirmedBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	var count uint32
+	if err := binary.Read(r, byteOrder, &count); err != nil {
+		return nil, err
+	}
+
+	snapshot.Channels = make([]ChannelSnapshot, count)
+	for i := range snapshot.Channels {
+		channel := &snapshot.Channels[i]
+
+		err := binary.Read(r, byteOrder, &channel.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := io.ReadFull(r, channel.Peer[:]); err != nil {
+			return nil, err
+		}
+
+		err = binary.Read(r, byteOrder, &channel.Capacity)
+		if err != nil {
+			return nil, err
+		}
+
+		err = binary.Read(r, byteOrder, &channel.LocalBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		err = binary.Read(r, byteOrder, &channel.RemoteBalance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot, nil
+}
+
+// timestampKey returns the key that a snapshot taken at the time provided is
+// stored under.
+func timestampKey(timestamp time.Time) []byte {
+	return itob(uint64(timestamp.UnixNano()))
+}
+
+// CreateLiquiditySnapshot stores a snapshot of our balances.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CreateLiquiditySnapshot(
+	snapshot *LiquiditySnapshot) error {
+
+	value, err := serializeLiquiditySnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(
+			liquiditySnapshotBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(timestampKey(snapshot.Timestamp), value)
+	})
+}
+
+// FetchLiquiditySnapshots returns all snapshots taken between the start and
+// end time provided, inclusive, in chronological order. A zero start or end
+// time leaves that side of the range unbounded.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchLiquiditySnapshots(start,
+	end time.Time) ([]*LiquiditySnapshot, error) {
+
+	var snapshots []*LiquiditySnapshot
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(liquiditySnapshotBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		cursor := bucket.Cursor()
+
+		k, v := cursor.First()
+		if !start.IsZero() {
+			k, v = cursor.Seek(timestampKey(start))
+		}
+
+		for ; k != nil; k, v = cursor.Next() {
+			timestamp := time.Unix(0, int64(byteOrder.Uint64(k)))
+			if !end.IsZero() && timestamp.After(end) {
+				break
+			}
+
+			snapshot, err := deserializeLiquiditySnapshot(
+				timestamp, v,
+			)
+			if err != nil {
+				return err
+			}
+
+			snapshots = append(snapshots, snapshot)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// PruneLiquiditySnapshots deletes all snapshots that were taken before the
+// time provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) PruneLiquiditySnapshots(before time.Time) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(liquiditySnapshotBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		cutoff := timestampKey(before)
+
+		// We restart from the first key after each deletion, because
+		// bbolt cursors may skip keys when they are advanced after a
+		// delete.
+		cursor := bucket.Cursor()
+		for k, _ := cursor.First(); k != nil; k, _ = cursor.First() {
+			if bytes.Compare(k, cutoff) >= 0 {
+				break
+			}
+
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
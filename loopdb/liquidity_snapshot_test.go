@@ -0,0 +1,128 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLiquiditySnapshots tests storing, querying and pruning of liquidity
+// snapshots.
+func TestLiquiditySnapshots(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	// Before we have any snapshots stored, we expect an empty result.
+	snapshots, err := store.FetchLiquiditySnapshots(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 0)
+
+	var (
+		start = time.Unix(1000, 0)
+		hour1 = start.Add(time.Hour)
+		hour2 = start.Add(time.Hour * 2)
+	)
+
+	snapshot1 := &LiquiditySnapshot{
+		Timestamp:        start,
+		ConfirmedBalance: 1000,
+		Channels: []ChannelSnapshot{
+			{
+				ChannelID:     1,
+				Peer:          senderKey,
+				Capacity:      100,
+				LocalBalance:  80,
+				RemoteBalance: 20,
+			},
+			{
+				ChannelID:     2,
+				Peer:          receiverKey,
+				Capacity:      200,
+				LocalBalance:  50,
+				RemoteBalance: 150,
+			},
+		},
+	}
+
+	snapshot2 := &LiquiditySnapshot{
+		Timestamp:        hour1,
+		ConfirmedBalance: 1010,
+		Channels: []ChannelSnapshot{
+			{
+				ChannelID:     1,
+				Peer:          senderKey,
+				Capacity:      100,
+				LocalBalance:  60,
+				RemoteBalance: 40,
+			},
+		},
+	}
+
+	snapshot3 := &LiquiditySnapshot{
+		Timestamp:        hour2,
+		ConfirmedBalance: 1020,
+		Channels:         []ChannelSnapshot{},
+	}
+
+	// Store our snapshots out of order to check that we return them in
+	// chronological order.
+	for _, snapshot := range []*LiquiditySnapshot{
+		snapshot2, snapshot3, snapshot1,
+	} {
+		require.NoError(t, store.CreateLiquiditySnapshot(snapshot))
+	}
+
+	require.Equal(t, btcutil.Amount(130), snapshot1.LocalBalance())
+	require.Equal(t, btcutil.Amount(170), snapshot1.RemoteBalance())
+
+	// assertSnapshots fetches the snapshots within the range provided and
+	// asserts they match our expected set.
+	assertSnapshots := func(start, end time.Time,
+		expected []*LiquiditySnapshot) {
+
+		t.Helper()
+
+		snapshots, err := store.FetchLiquiditySnapshots(start, end)
+		require.NoError(t, err)
+		require.Len(t, snapshots, len(expected))
+
+		for i, snapshot := range snapshots {
+			require.True(
+				t, expected[i].Timestamp.Equal(snapshot.Timestamp),
+			)
+			require.Equal(
+				t, expected[i].ConfirmedBalance,
+				snapshot.ConfirmedBalance,
+			)
+			require.Equal(t, expected[i].Channels, snapshot.Channels)
+		}
+	}
+
+	assertSnapshots(time.Time{}, time.Time{}, []*LiquiditySnapshot{
+		snapshot1, snapshot2, snapshot3,
+	})
+	assertSnapshots(hour1, time.Time{}, []*LiquiditySnapshot{
+		snapshot2, snapshot3,
+	})
+	assertSnapshots(time.Time{}, hour1, []*LiquiditySnapshot{
+		snapshot1, snapshot2,
+	})
+	assertSnapshots(hour1, hour1, []*LiquiditySnapshot{snapshot2})
+
+	// Prune all snapshots before our second snapshot, and assert that
+	// only the first one was removed.
+	require.NoError(t, store.PruneLiquiditySnapshots(hour1))
+	assertSnapshots(time.Time{}, time.Time{}, []*LiquiditySnapshot{
+		snapshot2, snapshot3,
+	})
+}
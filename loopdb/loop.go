@@ -50,6 +50,33 @@ type SwapContract struct {
 	// ProtocolVersion stores the protocol version when the swap was
 	// created.
 	ProtocolVersion ProtocolVersion
+
+	// ServerMessage is an optional structured message that the server
+	// provided when the swap was initiated.
+	ServerMessage *ServerMessage
+
+	// RequestID is an optional ID that the client provided when it
+	// requested the swap, used to detect retries of the same request.
+	RequestID string
+
+	// Tenant is the identity of the caller that created the swap when
+	// loopd runs in multi-tenant mode. It is empty for swaps that were
+	// created by the daemon's operator or outside of that mode.
+	Tenant string
+
+	// Approval records who approved the swap and when, if the swap had to
+	// be approved before it was executed.
+	Approval *SwapApproval
+
+	// Quote contains the fees that were quoted for the swap when it was
+	// initiated. It is nil for swaps that were created before we started
+	// storing quotes.
+	Quote *SwapQuote
+
+	// SLA records the server that the swap was made with and the duration
+	// that it was expected to complete within. It is nil for swaps that
+	// were created before we started storing expectations.
+	SLA *SwapSLA
 }
 
 // Loop contains fields shared between LoopIn and LoopOut
@@ -27,6 +27,11 @@ type LoopInContract struct {
 	// source.
 	ExternalHtlc bool
 
+	// HtlcFunding optionally restricts the coins that our htlc is funded
+	// with. Note that this field is stored separately to the rest of the
+	// contract on disk.
+	HtlcFunding *HtlcFunding
+
 	// Label contains an optional label for the swap. Note that this field
 	// is stored separately to the rest of the contract on disk.
 	Label string
@@ -57,6 +57,9 @@ type LoopOutContract struct {
 	// allow the server to delay the publication in exchange for possibly
 	// lower fees.
 	SwapPublicationDeadline time.Time
+	// PaymentRecords are the custom records that are attached to the swap
+	// and prepay payments of the swap.
+	PaymentRecords []PaymentRecord
 }
 
 // ChannelSet stores a set of channels.
@@ -17,9 +17,17 @@ var (
 	// current database version.
 	dbVersionKey = []byte("dbp")
 
+	// networkKey is a boltdb key that stores the name of the network that
+	// the database was created for.
+	networkKey = []byte("network")
+
 	// ErrDBReversion is returned when detecting an attempt to revert to a
 	// prior database version.
 	ErrDBReversion = fmt.Errorf("channel db cannot revert to prior version")
+
+	// ErrNetworkMismatch is returned when the database was created for a
+	// different network than the one that it is opened for.
+	ErrNetworkMismatch = errors.New("database network mismatch")
 )
 
 // migration is a function which takes a prior outdated version of the database
@@ -79,6 +87,32 @@ func setDBVersion(tx *bbolt.Tx, version uint32) error {
 	return metaBucket.Put(dbVersionKey, scratch)
 }
 
+// checkNetwork checks that the database was created for the network provided.
+// Databases that do not have a network set yet are assigned the network if
+// the transaction is writable, since earlier versions did not store it.
+func checkNetwork(tx *bbolt.Tx, chainParams *chaincfg.Params) error {
+	metaBucket := tx.Bucket(metaBucketKey)
+	if metaBucket == nil {
+		return errors.New("bucket does not exist")
+	}
+
+	network := metaBucket.Get(networkKey)
+	if network == nil {
+		if !tx.Writable() {
+			return nil
+		}
+
+		return metaBucket.Put(networkKey, []byte(chainParams.Name))
+	}
+
+	if string(network) != chainParams.Name {
+		return fmt.Errorf("%w: database created for %v, opened for %v",
+			ErrNetworkMismatch, string(network), chainParams.Name)
+	}
+
+	return nil
+}
+
 // syncVersions function is used for safe db version synchronization. It
 // applies migration functions to the current database and recovers the
 // previous state of db if at least one error/panic appeared during migration.
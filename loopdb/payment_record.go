@@ -0,0 +1,131 @@
+package loopdb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// MaxPaymentRecordSize is the largest value that we accept for a single
+// payment record, so that records leave room for the rest of the payment's
+// onion payload.
+const MaxPaymentRecordSize = 256
+
+var (
+	// paymentRecordsKey is the key that stores the custom records that we
+	// attach to the swap and prepay payments of a loop out. If the server
+	// did not request any records, or the swap was created before we
+	// supported them, this key will not be present.
+	//
+	// path: loopOutBucket -> swapBucket[hash] -> paymentRecordsKey
+	//
+	// value: record count || (type || prepay || value)*
+	paymentRecordsKey = []byte("payment-records")
+)
+
+// PaymentRecord is a custom TLV record that is attached to one of the
+// payments of a loop out swap, so that the server can act on it.
+type PaymentRecord struct {
+	// Type is the custom record type.
+	Type uint64
+
+	// Value is the value of the record.
+	Value []byte
+
+	// Prepay is true if the record is attached to the prepay payment
+	// rather than the swap payment.
+	Prepay bool
+}
+
+// serializePaymentRecords serializes a set of payment records.
+func serializePaymentRecords(w io.Writer, records []PaymentRecord) error {
+	err := wire.WriteVarInt(w, 0, uint64(len(records)))
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if err := wire.WriteVarInt(w, 0, record.Type); err != nil {
+			return err
+		}
+
+		var prepay uint8
+		if record.Prepay {
+			prepay = 1
+		}
+
+		if err := wire.WriteVarInt(w, 0, uint64(prepay)); err != nil {
+			return err
+		}
+
+		if err := wire.WriteVarBytes(w, 0, record.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializePaymentRecords deserializes a set of payment records.
+func deserializePaymentRecords(r io.Reader) ([]PaymentRecord, error) {
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]PaymentRecord, count)
+	for i := range records {
+		recordType, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		prepay, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := wire.ReadVarBytes(
+			r, 0, MaxPaymentRecordSize, "payment record",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		records[i] = PaymentRecord{
+			Type:   recordType,
+			Value:  value,
+			Prepay: prepay == 1,
+		}
+	}
+
+	return records, nil
+}
+
+// putPaymentRecords stores the payment records of a swap in its bucket. If
+// there are no records, nothing is stored.
+func putPaymentRecords(bucket *bbolt.Bucket, records []PaymentRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := serializePaymentRecords(&b, records); err != nil {
+		return err
+	}
+
+	return bucket.Put(paymentRecordsKey, b.Bytes())
+}
+
+// getPaymentRecords returns the payment records stored in a swap bucket, or
+// nil if no records are present.
+func getPaymentRecords(bucket *bbolt.Bucket) ([]PaymentRecord, error) {
+	recordBytes := bucket.Get(paymentRecordsKey)
+	if recordBytes == nil {
+		return nil, nil
+	}
+
+	return deserializePaymentRecords(bytes.NewReader(recordBytes))
+}
@@ -0,0 +1,103 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+)
+
+// prepayKey is the key in a swap update's bucket that stores the outcome of
+// a loop out swap's prepay payment, if it was known when the update was
+// written.
+//
+// path: loopOutBucket -> swapBucket[hash] -> updatesBucket -> update[id] ->
+// prepayKey
+//
+// value: amount || routing fee || settled
+var prepayKey = []byte{2}
+
+// PrepayPayment is the outcome of a loop out swap's prepay payment.
+type PrepayPayment struct {
+	// Amount is the amount that the server received for the prepay,
+	// excluding routing fees.
+	Amount btcutil.Amount
+
+	// RoutingFee is the amount that we paid in routing fees for the
+	// prepay.
+	RoutingFee btcutil.Amount
+
+	// Settled indicates whether the server settled the prepay. If it is
+	// false, the prepay payment failed and we paid nothing for it.
+	Settled bool
+}
+
+// Cost returns the total amount that we paid for the prepay.
+func (p *PrepayPayment) Cost() btcutil.Amount {
+	if p == nil || !p.Settled {
+		return 0
+	}
+
+	return p.Amount + p.RoutingFee
+}
+
+// PrepayLost returns the amount of the prepay that was kept by the server for
+// a swap that did not succeed. A swap's prepay is only lost once the swap has
+// failed, because it counts towards the swap fee of successful swaps.
+func (s SwapStateData) PrepayLost() btcutil.Amount {
+	if s.State.Type() != StateTypeFail {
+		return 0
+	}
+
+	return s.Prepay.Cost()
+}
+
+// putPrepay writes the outcome of a prepay payment to the update bucket
+// provided if it is non-nil.
+func putPrepay(bucket *bbolt.Bucket, prepay *PrepayPayment) error {
+	if prepay == nil {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := binary.Write(&b, byteOrder, prepay.Amount); err != nil {
+		return err
+	}
+
+	if err := binary.Write(&b, byteOrder, prepay.RoutingFee); err != nil {
+		return err
+	}
+
+	if err := binary.Write(&b, byteOrder, prepay.Settled); err != nil {
+		return err
+	}
+
+	return bucket.Put(prepayKey, b.Bytes())
+}
+
+// getPrepay returns the outcome of a prepay payment stored in an update
+// bucket, or nil if none is present.
+func getPrepay(bucket *bbolt.Bucket) (*PrepayPayment, error) {
+	value := bucket.Get(prepayKey)
+	if value == nil {
+		return nil, nil
+	}
+
+	r := bytes.NewReader(value)
+
+	var prepay PrepayPayment
+	if err := binary.Read(r, byteOrder, &prepay.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &prepay.RoutingFee); err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &prepay.Settled); err != nil {
+		return nil, err
+	}
+
+	return &prepay, nil
+}
@@ -0,0 +1,96 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrepayStore tests that the outcome of a prepay payment is stored with a
+// swap's updates, and that it is only counted as lost once the swap fails.
+func TestPrepayStore(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	contract := &LoopOutContract{
+		SwapContract: SwapContract{
+			AmountRequested: 100,
+			Preimage:        testPreimage,
+			CltvExpiry:      144,
+			SenderKey:       senderKey,
+			ReceiverKey:     receiverKey,
+			InitiationTime:  time.Unix(0, testTime.UnixNano()),
+		},
+		DestAddr:                test.GetDestAddr(t, 0),
+		SwapPublicationDeadline: time.Unix(0, testTime.UnixNano()),
+	}
+	hash := contract.Preimage.Hash()
+	require.NoError(t, store.CreateLoopOut(hash, contract))
+
+	prepay := &PrepayPayment{
+		Amount:     1000,
+		RoutingFee: 3,
+		Settled:    true,
+	}
+
+	lastState := func() SwapStateData {
+		t.Helper()
+
+		swaps, err := store.FetchLoopOutSwaps()
+		require.NoError(t, err)
+		require.Len(t, swaps, 1)
+
+		return swaps[0].State()
+	}
+
+	// Updates that were written without a prepay outcome should not have
+	// one when they are read back.
+	err = store.UpdateLoopOut(hash, testTime, SwapStateData{
+		State: StateHtlcPublished,
+	})
+	require.NoError(t, err)
+
+	state := lastState()
+	require.Nil(t, state.Prepay)
+	require.Zero(t, state.PrepayLost())
+
+	// A settled prepay of a pending swap is not lost, because it may still
+	// count towards the swap fee.
+	err = store.UpdateLoopOut(hash, testTime, SwapStateData{
+		State:  StatePreimageRevealed,
+		Prepay: prepay,
+	})
+	require.NoError(t, err)
+
+	state = lastState()
+	require.Equal(t, prepay, state.Prepay)
+	require.Zero(t, state.PrepayLost())
+
+	// Once the swap fails, the prepay should be reported as lost.
+	err = store.UpdateLoopOut(hash, testTime, SwapStateData{
+		State:  StateFailTimeout,
+		Prepay: prepay,
+	})
+	require.NoError(t, err)
+
+	state = lastState()
+	require.Equal(t, prepay, state.Prepay)
+	require.Equal(t, prepay.Amount+prepay.RoutingFee, state.PrepayLost())
+
+	// A prepay that was not settled is never lost.
+	prepay.Settled = false
+	require.Zero(t, SwapStateData{
+		State:  StateFailTimeout,
+		Prepay: prepay,
+	}.PrepayLost())
+}
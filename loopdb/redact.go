@@ -0,0 +1,107 @@
+package loopdb
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// ErrSwapNotFinal is returned when a swap that is not in a final state is
+// redacted.
+var ErrSwapNotFinal = errors.New("swap is not in a final state")
+
+// redactLabel returns the label that a swap with the label provided keeps
+// once it is redacted. Labels that were set by loopd itself are kept, because
+// they don't identify anyone and our automated swaps are recognized by them.
+func redactLabel(label string) string {
+	if strings.HasPrefix(label, labels.Reserved) {
+		return label
+	}
+
+	return ""
+}
+
+// RedactContract removes the metadata that may identify the people behind a
+// swap from the contract provided, which is its label, its request ID and the
+// identities of its requester and approver. The amounts, fees and time of the
+// swap are kept, as is its tenant, which our fee budgets and access to the
+// swap depend on.
+func RedactContract(contract *SwapContract) {
+	contract.Label = redactLabel(contract.Label)
+	contract.RequestID = ""
+
+	if contract.Approval != nil {
+		contract.Approval = &SwapApproval{
+			Time: contract.Approval.Time,
+		}
+	}
+}
+
+// RedactSwap removes the metadata that may identify the people behind a swap
+// in a final state, as described by RedactContract. It returns ErrSwapNotFound
+// if the swap is unknown, and ErrSwapNotFinal if it is still in progress.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) RedactSwap(hash lntypes.Hash) error {
+	var bucketKey []byte
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		var swapBucket *bbolt.Bucket
+		for _, key := range [][]byte{loopOutBucketKey, loopInBucketKey} {
+			rootBucket := tx.Bucket(key)
+			if rootBucket == nil {
+				return errors.New("bucket does not exist")
+			}
+
+			swapBucket = rootBucket.Bucket(hash[:])
+			if swapBucket != nil {
+				bucketKey = key
+				break
+			}
+		}
+
+		if swapBucket == nil {
+			return ErrSwapNotFound
+		}
+
+		update, err := latestUpdate(swapBucket)
+		if err != nil {
+			return err
+		}
+
+		if update == nil || update.State.Type() == StateTypePending {
+			return ErrSwapNotFinal
+		}
+
+		label := redactLabel(getLabel(swapBucket))
+		if label == "" {
+			if err := swapBucket.Delete(labelKey); err != nil {
+				return err
+			}
+		}
+
+		if err := swapBucket.Delete(requestIDKey); err != nil {
+			return err
+		}
+
+		approval, err := getApproval(swapBucket)
+		if err != nil {
+			return err
+		}
+
+		if approval == nil {
+			return nil
+		}
+
+		return putApproval(swapBucket, &SwapApproval{
+			Time: approval.Time,
+		})
+	})
+	if bucketKey != nil {
+		s.cache.invalidate(bucketKey, hash)
+	}
+
+	return err
+}
@@ -0,0 +1,113 @@
+package loopdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRedactSwap tests that redacting a swap removes its identifying metadata
+// while keeping the rest of the swap, and that only swaps in a final state
+// are redacted.
+func TestRedactSwap(t *testing.T) {
+	store, cleanup := newImportTestStore(t)
+	defer cleanup()
+
+	swapTime := time.Unix(0, testTime.UnixNano())
+	newContract := func(preimage lntypes.Preimage,
+		label string) *LoopOutContract {
+
+		return &LoopOutContract{
+			SwapContract: SwapContract{
+				AmountRequested:  100,
+				Preimage:         preimage,
+				CltvExpiry:       144,
+				SenderKey:        senderKey,
+				ReceiverKey:      receiverKey,
+				InitiationHeight: 99,
+				InitiationTime:   swapTime,
+				Label:            label,
+				RequestID:        "request",
+				Tenant:           "tenant",
+				Approval: &SwapApproval{
+					Approver:  "approver",
+					Requester: "requester",
+					Time:      swapTime,
+				},
+			},
+			DestAddr:                test.GetDestAddr(t, 0),
+			SwapPublicationDeadline: swapTime,
+		}
+	}
+
+	autoloop := labels.AutoloopLabel(swap.TypeOut)
+	userPreimage := lntypes.Preimage{1}
+	autoPreimage := lntypes.Preimage{2}
+
+	userHash := userPreimage.Hash()
+	autoHash := autoPreimage.Hash()
+
+	err := store.CreateLoopOut(userHash, newContract(userPreimage, "user"))
+	require.NoError(t, err)
+
+	err = store.CreateLoopOut(autoHash, newContract(autoPreimage, autoloop))
+	require.NoError(t, err)
+
+	// Swaps that are unknown or still in progress can't be redacted.
+	require.Equal(t, ErrSwapNotFound, store.RedactSwap(lntypes.Hash{3}))
+	require.Equal(t, ErrSwapNotFinal, store.RedactSwap(userHash))
+
+	err = store.UpdateLoopOut(userHash, testTime, SwapStateData{
+		State: StatePreimageRevealed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ErrSwapNotFinal, store.RedactSwap(userHash))
+
+	// Read our swaps before we redact them, so that we can check that
+	// only their identifying metadata changed.
+	for _, hash := range []lntypes.Hash{userHash, autoHash} {
+		err = store.UpdateLoopOut(hash, testTime, SwapStateData{
+			State: StateSuccess,
+			Cost: SwapCost{
+				Server:  10,
+				Onchain: 20,
+			},
+			HtlcTxHash: &chainhash.Hash{1},
+		})
+		require.NoError(t, err)
+	}
+
+	before, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+
+	require.NoError(t, store.RedactSwap(userHash))
+	require.NoError(t, store.RedactSwap(autoHash))
+
+	after, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Len(t, after, len(before))
+
+	for i, loopOut := range before {
+		RedactContract(&loopOut.Contract.SwapContract)
+		require.Equal(t, loopOut, after[i])
+
+		require.Empty(t, after[i].Contract.RequestID)
+		require.Equal(t, "tenant", after[i].Contract.Tenant)
+		require.Equal(t, &SwapApproval{
+			Time: swapTime,
+		}, after[i].Contract.Approval)
+
+		// Labels that loopd set itself are kept.
+		label := ""
+		if loopOut.Hash == autoHash {
+			label = autoloop
+		}
+		require.Equal(t, label, after[i].Contract.Label)
+	}
+}
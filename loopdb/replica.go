@@ -0,0 +1,74 @@
+package loopdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/coreos/bbolt"
+)
+
+// WriteReplica writes a consistent copy of the database to the file provided,
+// replacing any existing file atomically so that readers never observe a
+// partially written replica.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) WriteReplica(path string) error {
+	tmpPath := path + ".tmp"
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.CopyFile(tmpPath, 0600)
+	})
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// NewReadOnlySwapStore opens a replica of the swap database that was written
+// with WriteReplica. The replica is opened read-only, so it is never migrated
+// and must have been written by a daemon that runs the same database version.
+func NewReadOnlySwapStore(path string, chainParams *chaincfg.Params) (
+	*boltSwapStore, error) {
+
+	if !fileExists(path) {
+		return nil, fmt.Errorf("replica %v does not exist", path)
+	}
+
+	bdb, err := bbolt.Open(filepath.Clean(path), 0600, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  DefaultLoopDBTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := getDBVersion(bdb)
+	if err != nil {
+		_ = bdb.Close()
+		return nil, err
+	}
+
+	if version != latestDBVersion {
+		_ = bdb.Close()
+		return nil, fmt.Errorf("replica has database version %v, "+
+			"expected %v", version, latestDBVersion)
+	}
+
+	err = bdb.View(func(tx *bbolt.Tx) error {
+		return checkNetwork(tx, chainParams)
+	})
+	if err != nil {
+		_ = bdb.Close()
+		return nil, err
+	}
+
+	return &boltSwapStore{
+		db:          bdb,
+		chainParams: chainParams,
+		cache:       newSwapCache(),
+	}, nil
+}
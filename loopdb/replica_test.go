@@ -0,0 +1,84 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReplica tests that a replica of the swap store can be opened read-only
+// while the store itself is still open, and that it reflects updates once it
+// is written again.
+func TestReplica(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	replicaPath := filepath.Join(tempDirName, "replica.db")
+
+	// We can't open a replica that was never written.
+	_, err = NewReadOnlySwapStore(replicaPath, &chaincfg.MainNetParams)
+	require.Error(t, err)
+
+	contract := &LoopOutContract{
+		SwapContract: SwapContract{
+			AmountRequested: 100,
+			Preimage:        testPreimage,
+			CltvExpiry:      144,
+			SenderKey:       senderKey,
+			ReceiverKey:     receiverKey,
+			InitiationTime:  time.Unix(0, testTime.UnixNano()),
+		},
+		DestAddr:                test.GetDestAddr(t, 0),
+		SwapPublicationDeadline: time.Unix(0, testTime.UnixNano()),
+	}
+	hash := contract.Preimage.Hash()
+	require.NoError(t, store.CreateLoopOut(hash, contract))
+
+	readSwaps := func() []*LoopOut {
+		t.Helper()
+
+		replica, err := NewReadOnlySwapStore(
+			replicaPath, &chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+		defer replica.Close()
+
+		swaps, err := replica.FetchLoopOutSwaps()
+		require.NoError(t, err)
+
+		return swaps
+	}
+
+	require.NoError(t, store.WriteReplica(replicaPath))
+
+	swaps := readSwaps()
+	require.Len(t, swaps, 1)
+	require.Equal(t, contract, swaps[0].Contract)
+	require.Equal(t, StateInitiated, swaps[0].State().State)
+
+	// Update our swap, the replica should only reflect the update once it
+	// is written again.
+	err = store.UpdateLoopOut(
+		hash, testTime, SwapStateData{State: StateSuccess},
+	)
+	require.NoError(t, err)
+
+	swaps = readSwaps()
+	require.Equal(t, StateInitiated, swaps[0].State().State)
+
+	require.NoError(t, store.WriteReplica(replicaPath))
+
+	swaps = readSwaps()
+	require.Equal(t, StateSuccess, swaps[0].State().State)
+}
@@ -0,0 +1,52 @@
+package loopdb
+
+import (
+	"errors"
+
+	"github.com/coreos/bbolt"
+)
+
+// MaxRequestIDLength is the maximum length of the request ID that a client
+// may provide when initiating a swap.
+const MaxRequestIDLength = 64
+
+var (
+	// requestIDKey is the key that stores the optional request ID that a
+	// client provided when initiating a swap. If a swap was created before
+	// we started storing request IDs, or was created without one, this key
+	// will not be present.
+	//
+	// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> requestIDKey
+	//
+	// value: string request id
+	requestIDKey = []byte("request-id")
+
+	// ErrRequestIDTooLong is returned when a request ID exceeds our
+	// maximum length.
+	ErrRequestIDTooLong = errors.New("request id too long")
+)
+
+// putRequestID validates a request ID and writes it to the bucket provided if
+// it is non-empty.
+func putRequestID(bucket *bbolt.Bucket, requestID string) error {
+	if len(requestID) == 0 {
+		return nil
+	}
+
+	if len(requestID) > MaxRequestIDLength {
+		return ErrRequestIDTooLong
+	}
+
+	return bucket.Put(requestIDKey, []byte(requestID))
+}
+
+// getRequestID returns the request ID stored in a bucket, or an empty string
+// if no request ID is present.
+func getRequestID(bucket *bbolt.Bucket) string {
+	requestID := bucket.Get(requestIDKey)
+	if requestID == nil {
+		return ""
+	}
+
+	return string(requestID)
+}
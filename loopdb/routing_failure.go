@@ -0,0 +1,197 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// routingFailureBucketKey is a bucket that contains the node pairs
+	// that our off-chain swap payments failed to route over. Only the
+	// most recent failure for each pair is stored.
+	//
+	// maps: node from || node to -> fail time (unix nano) || amount (msat)
+	routingFailureBucketKey = []byte("routing-failures")
+)
+
+// RoutingFailure records a failure to forward an off-chain swap payment from
+// one node to the next.
+type RoutingFailure struct {
+	// From is the node that failed to forward the payment.
+	From route.Vertex
+
+	// To is the node that the payment failed to be forwarded to.
+	To route.Vertex
+
+	// Amount is the amount that failed to be forwarded.
+	Amount lnwire.MilliSatoshi
+
+	// Time is the time at which the failure occurred.
+	Time time.Time
+}
+
+// routingFailureKey returns the key that the failure of the pair provided is
+// stored under.
+func routingFailureKey(from, to route.Vertex) []byte {
+	key := make([]byte, 2*len(route.Vertex{}))
+	copy(key, from[:])
+	copy(key[len(from):], to[:])
+
+	return key
+}
+
+// PutRoutingFailures stores the routing failures provided, replacing any
+// failures that were previously stored for the same node pairs.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) PutRoutingFailures(failures []RoutingFailure) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(
+			routingFailureBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, failure := range failures {
+			var b bytes.Buffer
+
+			err := binary.Write(
+				&b, byteOrder, failure.Time.UnixNano(),
+			)
+			if err != nil {
+				return err
+			}
+
+			err = binary.Write(&b, byteOrder, failure.Amount)
+			if err != nil {
+				return err
+			}
+
+			err = bucket.Put(
+				routingFailureKey(failure.From, failure.To),
+				b.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchRoutingFailures returns all routing failures that occurred at or after
+// the time provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchRoutingFailures(since time.Time) (
+	[]RoutingFailure, error) {
+
+	var failures []RoutingFailure
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(routingFailureBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			failure, err := deserializeRoutingFailure(k, v)
+			if err != nil {
+				return err
+			}
+
+			if failure.Time.Before(since) {
+				return nil
+			}
+
+			failures = append(failures, *failure)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return failures, nil
+}
+
+// PruneRoutingFailures deletes all routing failures that occurred before the
+// time provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) PruneRoutingFailures(before time.Time) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(routingFailureBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		// We can't delete keys while iterating over the bucket, so we
+		// collect the keys to delete first.
+		var pruned [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			failure, err := deserializeRoutingFailure(k, v)
+			if err != nil {
+				return err
+			}
+
+			if failure.Time.Before(before) {
+				pruned = append(pruned, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range pruned {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// deserializeRoutingFailure deserializes a routing failure that is stored
+// under the key provided.
+func deserializeRoutingFailure(k, v []byte) (*RoutingFailure, error) {
+	var failure RoutingFailure
+
+	from, err := route.NewVertexFromBytes(k[:len(failure.From)])
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := route.NewVertexFromBytes(k[len(failure.From):])
+	if err != nil {
+		return nil, err
+	}
+
+	failure.From = from
+	failure.To = to
+
+	r := bytes.NewReader(v)
+
+	var failTime int64
+	if err := binary.Read(r, byteOrder, &failTime); err != nil {
+		return nil, err
+	}
+	failure.Time = time.Unix(0, failTime)
+
+	if err := binary.Read(r, byteOrder, &failure.Amount); err != nil {
+		return nil, err
+	}
+
+	return &failure, nil
+}
@@ -0,0 +1,78 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRoutingFailures tests storing, fetching and pruning of routing
+// failures.
+func TestRoutingFailures(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	// An empty store should have no failures.
+	failures, err := store.FetchRoutingFailures(time.Time{})
+	require.NoError(t, err)
+	require.Empty(t, failures)
+	require.NoError(t, store.PruneRoutingFailures(testTime))
+
+	var (
+		node1 = route.Vertex{1}
+		node2 = route.Vertex{2}
+		node3 = route.Vertex{3}
+	)
+
+	failure1 := RoutingFailure{
+		From:   node1,
+		To:     node2,
+		Amount: 1000,
+		Time:   time.Unix(0, testTime.UnixNano()),
+	}
+	failure2 := RoutingFailure{
+		From:   node2,
+		To:     node3,
+		Amount: 2000,
+		Time:   failure1.Time.Add(time.Hour),
+	}
+
+	err = store.PutRoutingFailures([]RoutingFailure{failure1, failure2})
+	require.NoError(t, err)
+
+	failures, err = store.FetchRoutingFailures(time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, []RoutingFailure{failure1, failure2}, failures)
+
+	// Only failures at or after the time provided should be returned.
+	failures, err = store.FetchRoutingFailures(failure2.Time)
+	require.NoError(t, err)
+	require.Equal(t, []RoutingFailure{failure2}, failures)
+
+	// A new failure for the same pair should replace the existing one.
+	failure1.Amount = 500
+	failure1.Time = failure2.Time.Add(time.Hour)
+	require.NoError(t, store.PutRoutingFailures([]RoutingFailure{failure1}))
+
+	failures, err = store.FetchRoutingFailures(time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, []RoutingFailure{failure1, failure2}, failures)
+
+	// Pruning should remove failures that occurred before the time
+	// provided.
+	require.NoError(t, store.PruneRoutingFailures(failure1.Time))
+
+	failures, err = store.FetchRoutingFailures(time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, []RoutingFailure{failure1}, failures)
+}
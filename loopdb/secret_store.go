@@ -0,0 +1,399 @@
+package loopdb
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// secretsFileName is the name of the file that our secret store keeps
+	// its encrypted secrets in.
+	secretsFileName = "secrets.db"
+
+	// secretsKeyLength is the length of the key that our secrets are
+	// encrypted with, which selects AES-256.
+	secretsKeyLength = 32
+
+	// preimageOffset is the offset of the preimage in the serialized
+	// contracts of both loop outs and loop ins, which follows their
+	// initiation time.
+	preimageOffset = 8
+)
+
+var (
+	// ErrSecretNotFound is returned when a secret is not in our secret
+	// store.
+	ErrSecretNotFound = errors.New("secret not found")
+
+	// ErrSecretsKeyInStore is returned when the key that our secrets are
+	// encrypted with would be kept in the directory of our secret store,
+	// where it would be copied or shared along with the secrets that it
+	// protects.
+	ErrSecretsKeyInStore = errors.New("secrets key must not be kept in " +
+		"the secret store's directory")
+
+	// preimagesBucketKey is the bucket that holds our encrypted preimages,
+	// keyed by swap hash.
+	preimagesBucketKey = []byte("preimages")
+)
+
+// SecretStore stores the secret material of our swaps separately from the
+// rest of our swap data, so that our swap data can be replicated or shared
+// without exposing the secrets that our swaps' funds depend on.
+type SecretStore interface {
+	// PutPreimage stores the preimage of the swap with the hash provided.
+	PutPreimage(hash lntypes.Hash, preimage lntypes.Preimage) error
+
+	// FetchPreimage returns the preimage of the swap with the hash
+	// provided, or ErrSecretNotFound if it is not stored.
+	FetchPreimage(hash lntypes.Hash) (lntypes.Preimage, error)
+
+	// Close closes the secret store.
+	Close() error
+}
+
+// boltSecretStore is a secret store that encrypts our secrets with AES-GCM
+// and keeps them in a bolt database of its own. The key that our secrets are
+// encrypted with is kept in a file outside of the store's directory, so that
+// a copy of the directory does not expose our secrets.
+type boltSecretStore struct {
+	db   *bbolt.DB
+	aead cipher.AEAD
+}
+
+// A compile-time flag to ensure that boltSecretStore implements the
+// SecretStore interface.
+var _ SecretStore = (*boltSecretStore)(nil)
+
+// NewBoltSecretStore opens the secret store in the directory provided, which
+// is encrypted with the key in the key file provided. The directory, its
+// database and the key file are created if they do not exist yet, and are
+// only accessible by our user. The key file must be outside of the directory.
+func NewBoltSecretStore(dir, keyFile string) (*boltSecretStore, error) {
+	within, err := pathWithin(keyFile, dir)
+	if err != nil {
+		return nil, err
+	}
+	if within {
+		return nil, ErrSecretsKeyInStore
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	key, err := secretsKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, secretsFileName)
+	bdb, err := bbolt.Open(path, 0600, &bbolt.Options{
+		Timeout: DefaultLoopDBTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = bdb.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(preimagesBucketKey)
+		return err
+	})
+	if err != nil {
+		_ = bdb.Close()
+		return nil, err
+	}
+
+	return &boltSecretStore{
+		db:   bdb,
+		aead: aead,
+	}, nil
+}
+
+// pathWithin returns a boolean indicating whether the path provided is the
+// directory provided or is within it.
+func pathWithin(path, dir string) (bool, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false, err
+	}
+
+	rel, err := filepath.Rel(absDir, absPath)
+	if err != nil {
+		return false, nil
+	}
+
+	return rel != ".." && !strings.HasPrefix(
+		rel, ".."+string(filepath.Separator),
+	), nil
+}
+
+// secretsKey reads our encryption key from the file provided, creating the
+// file and its directory with a new random key if it does not exist.
+func secretsKey(path string) ([]byte, error) {
+	if fileExists(path) {
+		key, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(key) != secretsKeyLength {
+			return nil, fmt.Errorf("secrets key %v has length %v, "+
+				"expected %v", path, len(key), secretsKeyLength)
+		}
+
+		return key, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, secretsKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(path, key, 0400); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// PutPreimage stores the preimage of the swap with the hash provided. The
+// preimage is encrypted with the swap hash as additional data, so that it
+// can't be moved to another swap undetected.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (s *boltSecretStore) PutPreimage(hash lntypes.Hash,
+	preimage lntypes.Preimage) error {
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	sealed := s.aead.Seal(nonce, nonce, preimage[:], hash[:])
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(preimagesBucketKey)
+		if bucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		return bucket.Put(hash[:], sealed)
+	})
+}
+
+// FetchPreimage returns the preimage of the swap with the hash provided.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (s *boltSecretStore) FetchPreimage(hash lntypes.Hash) (lntypes.Preimage,
+	error) {
+
+	var preimage lntypes.Preimage
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(preimagesBucketKey)
+		if bucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		sealed := bucket.Get(hash[:])
+		if sealed == nil {
+			return ErrSecretNotFound
+		}
+
+		nonceSize := s.aead.NonceSize()
+		if len(sealed) < nonceSize {
+			return fmt.Errorf("preimage of swap %v is invalid", hash)
+		}
+
+		plain, err := s.aead.Open(
+			nil, sealed[:nonceSize], sealed[nonceSize:], hash[:],
+		)
+		if err != nil {
+			return fmt.Errorf("could not decrypt preimage of swap "+
+				"%v: %v", hash, err)
+		}
+
+		if len(plain) != len(preimage) {
+			return fmt.Errorf("preimage of swap %v is invalid", hash)
+		}
+		copy(preimage[:], plain)
+
+		return nil
+	})
+
+	return preimage, err
+}
+
+// Close closes the secret store's database.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (s *boltSecretStore) Close() error {
+	return s.db.Close()
+}
+
+// UseSecretStore moves the preimages of our swaps into the secret store
+// provided, and stores the preimages of all swaps that are created from now
+// on in it, so that they are no longer part of our swap data and its
+// replicas. The secret store is closed when our store is closed. It must be
+// set before any swaps are fetched or created, and the secret store must be
+// kept and backed up along with our store from then on.
+func (s *boltSwapStore) UseSecretStore(secrets SecretStore) error {
+	s.secrets = secrets
+
+	var moved int
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		for _, bucketKey := range [][]byte{
+			loopOutBucketKey, loopInBucketKey,
+		} {
+			rootBucket := tx.Bucket(bucketKey)
+			if rootBucket == nil {
+				return errors.New("bucket does not exist")
+			}
+
+			// We collect our swaps before we move their
+			// preimages, because bolt buckets must not be
+			// modified while they are iterated over.
+			var hashes []lntypes.Hash
+			err := rootBucket.ForEach(func(k, v []byte) error {
+				// Only go into things that we know are
+				// sub-bucket keys.
+				if v == nil {
+					var hash lntypes.Hash
+					copy(hash[:], k)
+					hashes = append(hashes, hash)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, hash := range hashes {
+				ok, err := s.separateSecret(tx, bucketKey, hash)
+				if err != nil {
+					return err
+				}
+
+				if ok {
+					moved++
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Bolt does not clear the pages that it no longer uses, so the
+	// preimages that we moved may remain in our database file until their
+	// pages are reused.
+	if moved > 0 {
+		log.Warnf("Moved the preimages of %v swaps to our secret "+
+			"store, they may remain in unused pages of the swap "+
+			"database until they are overwritten", moved)
+	}
+
+	return nil
+}
+
+// separateSecret moves the preimage of the swap with the hash provided from
+// its contract into our secret store, if we use one. The preimage is stored
+// in our secret store before it is removed from the swap, so that the swap
+// never refers to a preimage that was not stored. It returns true if a
+// preimage was moved.
+func (s *boltSwapStore) separateSecret(tx *bbolt.Tx, bucketKey []byte,
+	hash lntypes.Hash) (bool, error) {
+
+	if s.secrets == nil {
+		return false, nil
+	}
+
+	rootBucket := tx.Bucket(bucketKey)
+	if rootBucket == nil {
+		return false, errors.New("bucket does not exist")
+	}
+
+	swapBucket := rootBucket.Bucket(hash[:])
+	if swapBucket == nil {
+		return false, fmt.Errorf("swap bucket %v not found", hash)
+	}
+
+	value := swapBucket.Get(contractKey)
+	if len(value) < preimageOffset+lntypes.PreimageSize {
+		return false, fmt.Errorf("contract of swap %v is invalid", hash)
+	}
+
+	// Values read from bolt are only valid for the life of the
+	// transaction and must not be modified, so we work on a copy.
+	contract := make([]byte, len(value))
+	copy(contract, value)
+
+	var preimage lntypes.Preimage
+	copy(preimage[:], contract[preimageOffset:])
+	if preimage == (lntypes.Preimage{}) {
+		return false, nil
+	}
+
+	if err := s.secrets.PutPreimage(hash, preimage); err != nil {
+		return false, err
+	}
+
+	copy(
+		contract[preimageOffset:preimageOffset+lntypes.PreimageSize],
+		make([]byte, lntypes.PreimageSize),
+	)
+
+	return true, swapBucket.Put(contractKey, contract)
+}
+
+// restoreSecret sets the preimage of the contract provided from our secret
+// store if it was moved there.
+func (s *boltSwapStore) restoreSecret(hash lntypes.Hash,
+	contract *SwapContract) error {
+
+	if s.secrets == nil || contract.Preimage != (lntypes.Preimage{}) {
+		return nil
+	}
+
+	preimage, err := s.secrets.FetchPreimage(hash)
+	if err != nil {
+		return fmt.Errorf("preimage of swap %v: %w", hash, err)
+	}
+
+	contract.Preimage = preimage
+
+	return nil
+}
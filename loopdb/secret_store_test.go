@@ -0,0 +1,232 @@
+package loopdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSecretStore tests that the preimages of our swaps are moved to our
+// secret store, and that they are left out of our swap data and its replicas
+// while our swaps are still read with their preimages.
+func TestSecretStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dataDir := filepath.Join(dir, "data")
+	secretsDir := filepath.Join(dir, "secrets")
+	keyFile := filepath.Join(dir, "keys", "secrets.key")
+
+	store, err := NewBoltSwapStore(dataDir, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	swapTime := time.Unix(0, testTime.UnixNano())
+	newLoopOut := func(preimage lntypes.Preimage) *LoopOutContract {
+		return &LoopOutContract{
+			SwapContract: SwapContract{
+				AmountRequested: 100,
+				Preimage:        preimage,
+				CltvExpiry:      144,
+				SenderKey:       senderKey,
+				ReceiverKey:     receiverKey,
+				InitiationTime:  swapTime,
+			},
+			DestAddr:                test.GetDestAddr(t, 0),
+			SwapPublicationDeadline: swapTime,
+		}
+	}
+
+	// Our preimages are filled with a byte that is unlikely to repeat
+	// in our databases, so that we can check that they are not in them.
+	newPreimage := func(b byte) lntypes.Preimage {
+		var preimage lntypes.Preimage
+		for i := range preimage {
+			preimage[i] = b
+		}
+
+		return preimage
+	}
+
+	// Create a swap before we use a secret store, which holds its
+	// preimage in our swap data.
+	existing := newPreimage(0xa1)
+	err = store.CreateLoopOut(existing.Hash(), newLoopOut(existing))
+	require.NoError(t, err)
+
+	// Our key may not be kept with the secrets that it protects.
+	_, err = NewBoltSecretStore(
+		secretsDir, filepath.Join(secretsDir, "secrets.key"),
+	)
+	require.Equal(t, ErrSecretsKeyInStore, err)
+
+	secrets, err := NewBoltSecretStore(secretsDir, keyFile)
+	require.NoError(t, err)
+	require.NoError(t, store.UseSecretStore(secrets))
+
+	// Swaps that are created once we use our secret store, as well as
+	// imported swaps, have their preimages stored in it.
+	created := newPreimage(0xa2)
+	err = store.CreateLoopOut(created.Hash(), newLoopOut(created))
+	require.NoError(t, err)
+
+	loopIn := newPreimage(0xa3)
+	err = store.CreateLoopIn(loopIn.Hash(), &LoopInContract{
+		SwapContract: SwapContract{
+			AmountRequested: 100,
+			Preimage:        loopIn,
+			CltvExpiry:      144,
+			SenderKey:       senderKey,
+			ReceiverKey:     receiverKey,
+			InitiationTime:  swapTime,
+		},
+		HtlcConfTarget: 2,
+	})
+	require.NoError(t, err)
+
+	imported := newPreimage(0xa4)
+	err = store.ImportSwaps([]*LoopOut{{
+		Loop: Loop{
+			Hash: imported.Hash(),
+		},
+		Contract: newLoopOut(imported),
+	}}, nil)
+	require.NoError(t, err)
+
+	preimages := []lntypes.Preimage{existing, created, loopIn, imported}
+	for _, preimage := range preimages {
+		stored, err := secrets.FetchPreimage(preimage.Hash())
+		require.NoError(t, err)
+		require.Equal(t, preimage, stored)
+	}
+
+	_, err = secrets.FetchPreimage(lntypes.Hash{5})
+	require.Equal(t, ErrSecretNotFound, err)
+
+	// Our swaps are read with their preimages, both from disk and from
+	// our swap cache.
+	pending, err := store.FetchPendingLoopOuts()
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+
+	loopOuts, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Equal(t, pending, loopOuts)
+
+	for _, swap := range loopOuts {
+		require.Equal(t, swap.Hash, swap.Contract.Preimage.Hash())
+	}
+
+	loopIns, err := store.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Len(t, loopIns, 1)
+	require.Equal(t, loopIn, loopIns[0].Contract.Preimage)
+
+	// Neither our swap data nor its replicas hold our preimages, and our
+	// secret store only holds them encrypted. The preimages that we moved
+	// may remain in pages that our swap data no longer uses, so we only
+	// check that the pages of our swap data don't hold the preimages that
+	// were never written to it.
+	replicaPath := filepath.Join(dir, "replica.db")
+	require.NoError(t, store.WriteReplica(replicaPath))
+
+	replica, err := NewReadOnlySwapStore(
+		replicaPath, &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	replicaSwaps, err := replica.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Len(t, replicaSwaps, 3)
+
+	for _, swap := range replicaSwaps {
+		require.Equal(t, lntypes.Preimage{}, swap.Contract.Preimage)
+	}
+	require.NoError(t, replica.Close())
+
+	require.NoError(t, store.Close())
+
+	data, err := ioutil.ReadFile(StorePath(dataDir))
+	require.NoError(t, err)
+
+	for _, preimage := range preimages[1:] {
+		require.False(t, bytes.Contains(data, preimage[:]))
+	}
+
+	db, err := ioutil.ReadFile(filepath.Join(secretsDir, secretsFileName))
+	require.NoError(t, err)
+
+	for _, preimage := range preimages {
+		require.False(t, bytes.Contains(db, preimage[:]))
+	}
+
+	// Our secrets are only readable with our key.
+	info, err := os.Stat(keyFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0400), info.Mode().Perm())
+
+	// A copy of our secret store that is opened without our key can't
+	// decrypt our secrets.
+	otherDir := filepath.Join(dir, "other")
+	require.NoError(t, os.MkdirAll(otherDir, 0700))
+
+	err = ioutil.WriteFile(
+		filepath.Join(otherDir, secretsFileName), db, 0600,
+	)
+	require.NoError(t, err)
+
+	other, err := NewBoltSecretStore(
+		otherDir, filepath.Join(dir, "keys", "other.key"),
+	)
+	require.NoError(t, err)
+
+	_, err = other.FetchPreimage(existing.Hash())
+	require.Error(t, err)
+	require.NotEqual(t, ErrSecretNotFound, err)
+	require.NoError(t, other.Close())
+
+	// Once we reopen our store with our secret store, our swaps are read
+	// with their preimages again.
+	store, err = NewBoltSwapStore(dataDir, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	secrets, err = NewBoltSecretStore(secretsDir, keyFile)
+	require.NoError(t, err)
+	require.NoError(t, store.UseSecretStore(secrets))
+
+	reopened, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Equal(t, loopOuts, reopened)
+}
+
+// TestPathWithin tests detection of paths that are within a directory.
+func TestPathWithin(t *testing.T) {
+	testCases := []struct {
+		path   string
+		dir    string
+		within bool
+	}{
+		{"/secrets/secrets.key", "/secrets", true},
+		{"/secrets/sub/secrets.key", "/secrets", true},
+		{"/secrets", "/secrets", true},
+		{"/secrets/../keys/secrets.key", "/secrets", false},
+		{"/keys/secrets.key", "/secrets", false},
+		{"/secrets.key", "/secrets", false},
+		{"/..secrets/secrets.key", "/", true},
+	}
+
+	for _, testCase := range testCases {
+		within, err := pathWithin(testCase.path, testCase.dir)
+		require.NoError(t, err)
+		require.Equal(t, testCase.within, within, testCase.path)
+	}
+}
@@ -0,0 +1,125 @@
+package loopdb
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// serverMessageKey is the key that stores an optional structured
+	// message that the server provided when the swap was initiated. If a
+	// swap was created before we started storing server messages, or the
+	// server did not provide one, this key will not be present.
+	//
+	// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> serverMessageKey
+	//
+	// value: code || param count || (key || value)*
+	serverMessageKey = []byte("server-message")
+)
+
+// ServerMessage is a structured message that the server provides on swap
+// initiation. It allows clients to act on specific server advisories, or
+// display localized messages, rather than relying on free text.
+type ServerMessage struct {
+	// Code identifies the advisory that the server sent.
+	Code string
+
+	// Params contains the details of the advisory.
+	Params map[string]string
+}
+
+// serializeServerMessage serializes a server message. Parameters are written
+// in key order so that serialization is deterministic.
+func serializeServerMessage(w io.Writer, msg *ServerMessage) error {
+	if err := wire.WriteVarString(w, 0, msg.Code); err != nil {
+		return err
+	}
+
+	err := wire.WriteVarInt(w, 0, uint64(len(msg.Params)))
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(msg.Params))
+	for key := range msg.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := wire.WriteVarString(w, 0, key); err != nil {
+			return err
+		}
+
+		err := wire.WriteVarString(w, 0, msg.Params[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeServerMessage deserializes a server message.
+func deserializeServerMessage(r io.Reader) (*ServerMessage, error) {
+	code, err := wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &ServerMessage{
+		Code:   code,
+		Params: make(map[string]string),
+	}
+
+	for i := uint64(0); i < count; i++ {
+		key, err := wire.ReadVarString(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := wire.ReadVarString(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Params[key] = value
+	}
+
+	return msg, nil
+}
+
+// putServerMessage stores an optional server message in a swap bucket. If the
+// message is nil, nothing is stored.
+func putServerMessage(bucket *bbolt.Bucket, msg *ServerMessage) error {
+	if msg == nil {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := serializeServerMessage(&b, msg); err != nil {
+		return err
+	}
+
+	return bucket.Put(serverMessageKey, b.Bytes())
+}
+
+// getServerMessage returns the server message stored in a swap bucket, or nil
+// if no message is present.
+func getServerMessage(bucket *bbolt.Bucket) (*ServerMessage, error) {
+	msgBytes := bucket.Get(serverMessageKey)
+	if msgBytes == nil {
+		return nil, nil
+	}
+
+	return deserializeServerMessage(bytes.NewReader(msgBytes))
+}
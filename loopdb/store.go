@@ -112,6 +112,11 @@ const (
 	DefaultLoopDBTimeout = 5 * time.Second
 )
 
+// StorePath returns the path of the swap database in the directory provided.
+func StorePath(dbPath string) string {
+	return filepath.Join(dbPath, dbFileName)
+}
+
 // fileExists returns true if the file exists, and false otherwise.
 func fileExists(path string) bool {
 	if _, err := os.Stat(path); err != nil {
@@ -127,6 +132,13 @@ func fileExists(path string) bool {
 type boltSwapStore struct {
 	db          *bbolt.DB
 	chainParams *chaincfg.Params
+
+	// cache holds the swaps in our store in memory.
+	cache *swapCache
+
+	// secrets holds the preimages of our swaps if they are stored apart
+	// from the rest of our swap data, and is nil otherwise.
+	secrets SecretStore
 }
 
 // A compile-time flag to ensure that boltSwapStore implements the SwapStore
@@ -147,7 +159,7 @@ func NewBoltSwapStore(dbPath string, chainParams *chaincfg.Params) (
 
 	// Now that we know that path exists, we'll open up bolt, which
 	// implements our default swap store.
-	path := filepath.Join(dbPath, dbFileName)
+	path := StorePath(dbPath)
 	bdb, err := bbolt.Open(path, 0600, &bbolt.Options{
 		Timeout: DefaultLoopDBTimeout,
 	})
@@ -190,9 +202,12 @@ func NewBoltSwapStore(dbPath string, chainParams *chaincfg.Params) (
 			return err
 		}
 
-		return nil
+		// Make sure that we do not read swaps that were created for
+		// another network, since their addresses would not decode.
+		return checkNetwork(tx, chainParams)
 	})
 	if err != nil {
+		_ = bdb.Close()
 		return nil, err
 	}
 
@@ -206,135 +221,149 @@ func NewBoltSwapStore(dbPath string, chainParams *chaincfg.Params) (
 	return &boltSwapStore{
 		db:          bdb,
 		chainParams: chainParams,
+		cache:       newSwapCache(),
 	}, nil
 }
 
-// FetchLoopOutSwaps returns all loop out swaps currently in the store.
+// FetchLoopOutSwaps returns all loop out swaps currently in the store, ordered
+// by swap hash. Swaps are served from our swap cache, so the contracts of the
+// swaps returned are shared with other callers and must not be modified.
 //
 // NOTE: Part of the loopdb.SwapStore interface.
 func (s *boltSwapStore) FetchLoopOutSwaps() ([]*LoopOut, error) {
-	var swaps []*LoopOut
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
 
-	err := s.db.View(func(tx *bbolt.Tx) error {
-		// First, we'll grab our main loop in bucket key.
-		rootBucket := tx.Bucket(loopOutBucketKey)
-		if rootBucket == nil {
-			return errors.New("bucket does not exist")
-		}
+	if err := s.refreshCache(); err != nil {
+		return nil, err
+	}
 
-		// We'll now traverse the root bucket for all active swaps. The
-		// primary key is the swap hash itself.
-		return rootBucket.ForEach(func(swapHash, v []byte) error {
-			// Only go into things that we know are sub-bucket
-			// keys.
-			if v != nil {
-				return nil
-			}
+	return s.cache.fetchLoopOuts(), nil
+}
 
-			// From the root bucket, we'll grab the next swap
-			// bucket for this swap from its swaphash.
-			swapBucket := rootBucket.Bucket(swapHash)
-			if swapBucket == nil {
-				return fmt.Errorf("swap bucket %x not found",
-					swapHash)
-			}
+// deserializeLoopOut deserializes the loop out swap that is stored in the swap
+// bucket provided.
+func deserializeLoopOut(swapBucket *bbolt.Bucket, swapHash []byte,
+	chainParams *chaincfg.Params) (*LoopOut, error) {
 
-			// With the main swap bucket obtained, we'll grab the
-			// raw swap contract bytes and decode it.
-			contractBytes := swapBucket.Get(contractKey)
-			if contractBytes == nil {
-				return errors.New("contract not found")
+	// With the main swap bucket obtained, we'll grab the raw swap contract
+	// bytes and decode it.
+	contractBytes := swapBucket.Get(contractKey)
+	if contractBytes == nil {
+		return nil, errors.New("contract not found")
+	}
+
+	contract, err := deserializeLoopOutContract(
+		contractBytes, chainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get our label for this swap, if it is present.
+	contract.Label = getLabel(swapBucket)
+
+	// Read the list of concatenated outgoing channel ids that form the
+	// outgoing set.
+	setBytes := swapBucket.Get(outgoingChanSetKey)
+	if outgoingChanSetKey != nil {
+		r := bytes.NewReader(setBytes)
+	readLoop:
+		for {
+			var chanID uint64
+			err := binary.Read(r, byteOrder, &chanID)
+			switch {
+			case err == io.EOF:
+				break readLoop
+			case err != nil:
+				return nil, err
 			}
 
-			contract, err := deserializeLoopOutContract(
-				contractBytes, s.chainParams,
+			contract.OutgoingChanSet = append(
+				contract.OutgoingChanSet,
+				chanID,
 			)
-			if err != nil {
-				return err
-			}
+		}
+	}
 
-			// Get our label for this swap, if it is present.
-			contract.Label = getLabel(swapBucket)
-
-			// Read the list of concatenated outgoing channel ids
-			// that form the outgoing set.
-			setBytes := swapBucket.Get(outgoingChanSetKey)
-			if outgoingChanSetKey != nil {
-				r := bytes.NewReader(setBytes)
-			readLoop:
-				for {
-					var chanID uint64
-					err := binary.Read(r, byteOrder, &chanID)
-					switch {
-					case err == io.EOF:
-						break readLoop
-					case err != nil:
-						return err
-					}
-
-					contract.OutgoingChanSet = append(
-						contract.OutgoingChanSet,
-						chanID,
-					)
-				}
-			}
+	// Set our default number of confirmations for the swap.
+	contract.HtlcConfirmations = DefaultLoopOutHtlcConfirmations
 
-			// Set our default number of confirmations for the swap.
-			contract.HtlcConfirmations = DefaultLoopOutHtlcConfirmations
-
-			// If we have the number of confirmations stored for
-			// this swap, we overwrite our default with the stored
-			// value.
-			confBytes := swapBucket.Get(confirmationsKey)
-			if confBytes != nil {
-				r := bytes.NewReader(confBytes)
-				err := binary.Read(
-					r, byteOrder, &contract.HtlcConfirmations,
-				)
-				if err != nil {
-					return err
-				}
-			}
+	// If we have the number of confirmations stored for this swap, we
+	// overwrite our default with the stored value.
+	confBytes := swapBucket.Get(confirmationsKey)
+	if confBytes != nil {
+		r := bytes.NewReader(confBytes)
+		err := binary.Read(
+			r, byteOrder, &contract.HtlcConfirmations,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-			updates, err := deserializeUpdates(swapBucket)
-			if err != nil {
-				return err
-			}
+	// Get the server message for this swap, if present.
+	contract.ServerMessage, err = getServerMessage(
+		swapBucket,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-			// Try to unmarshal the protocol version for the swap.
-			// If the protocol version is not stored (which is
-			// the case for old clients), we'll assume the
-			// ProtocolVersionUnrecorded instead.
-			contract.ProtocolVersion, err =
-				UnmarshalProtocolVersion(
-					swapBucket.Get(protocolVersionKey),
-				)
-			if err != nil {
-				return err
-			}
+	contract.RequestID = getRequestID(swapBucket)
+	contract.Tenant = getTenant(swapBucket)
 
-			loop := LoopOut{
-				Loop: Loop{
-					Events: updates,
-				},
-				Contract: contract,
-			}
+	contract.Approval, err = getApproval(swapBucket)
+	if err != nil {
+		return nil, err
+	}
 
-			loop.Hash, err = lntypes.MakeHash(swapHash)
-			if err != nil {
-				return err
-			}
+	contract.Quote, err = getSwapQuote(swapBucket)
+	if err != nil {
+		return nil, err
+	}
 
-			swaps = append(swaps, &loop)
+	contract.SLA, err = getSwapSLA(swapBucket)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil
-		})
-	})
+	contract.PaymentRecords, err = getPaymentRecords(
+		swapBucket,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return swaps, nil
+	updates, err := deserializeUpdates(swapBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// Try to unmarshal the protocol version for the swap. If the protocol
+	// version is not stored (which is the case for old clients), we'll
+	// assume the ProtocolVersionUnrecorded instead.
+	contract.ProtocolVersion, err =
+		UnmarshalProtocolVersion(
+			swapBucket.Get(protocolVersionKey),
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	loop := LoopOut{
+		Loop: Loop{
+			Events: updates,
+		},
+		Contract: contract,
+	}
+
+	loop.Hash, err = lntypes.MakeHash(swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loop, nil
 }
 
 // deserializeUpdates deserializes the list of swap updates that are stored as a
@@ -356,26 +385,11 @@ func deserializeUpdates(swapBucket *bbolt.Bucket) ([]*LoopEvent, error) {
 			return fmt.Errorf("expected state sub-bucket for %x", k)
 		}
 
-		basicState := updateBucket.Get(basicStateKey)
-		if basicState == nil {
-			return errors.New("no basic state for update")
-		}
-
-		event, err := deserializeLoopEvent(basicState)
+		event, err := deserializeUpdate(updateBucket)
 		if err != nil {
 			return err
 		}
 
-		// Deserialize htlc tx hash if this updates contains one.
-		htlcTxHashBytes := updateBucket.Get(htlcTxHashKey)
-		if htlcTxHashBytes != nil {
-			htlcTxHash, err := chainhash.NewHash(htlcTxHashBytes)
-			if err != nil {
-				return err
-			}
-			event.HtlcTxHash = htlcTxHash
-		}
-
 		updates = append(updates, event)
 		return nil
 	})
@@ -386,92 +400,134 @@ func deserializeUpdates(swapBucket *bbolt.Bucket) ([]*LoopEvent, error) {
 	return updates, nil
 }
 
-// FetchLoopInSwaps returns all loop in swaps currently in the store.
+// deserializeUpdate deserializes a single swap update from its update bucket.
+func deserializeUpdate(updateBucket *bbolt.Bucket) (*LoopEvent, error) {
+	basicState := updateBucket.Get(basicStateKey)
+	if basicState == nil {
+		return nil, errors.New("no basic state for update")
+	}
+
+	event, err := deserializeLoopEvent(basicState)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deserialize htlc tx hash if this updates contains one.
+	htlcTxHashBytes := updateBucket.Get(htlcTxHashKey)
+	if htlcTxHashBytes != nil {
+		htlcTxHash, err := chainhash.NewHash(htlcTxHashBytes)
+		if err != nil {
+			return nil, err
+		}
+		event.HtlcTxHash = htlcTxHash
+	}
+
+	event.Prepay, err = getPrepay(updateBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// FetchLoopInSwaps returns all loop in swaps currently in the store, ordered
+// by swap hash. Swaps are served from our swap cache, so the contracts of the
+// swaps returned are shared with other callers and must not be modified.
 //
 // NOTE: Part of the loopdb.SwapStore interface.
 func (s *boltSwapStore) FetchLoopInSwaps() ([]*LoopIn, error) {
-	var swaps []*LoopIn
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
 
-	err := s.db.View(func(tx *bbolt.Tx) error {
-		// First, we'll grab our main loop in bucket key.
-		rootBucket := tx.Bucket(loopInBucketKey)
-		if rootBucket == nil {
-			return errors.New("bucket does not exist")
-		}
+	if err := s.refreshCache(); err != nil {
+		return nil, err
+	}
 
-		// We'll now traverse the root bucket for all active swaps. The
-		// primary key is the swap hash itself.
-		return rootBucket.ForEach(func(swapHash, v []byte) error {
-			// Only go into things that we know are sub-bucket
-			// keys.
-			if v != nil {
-				return nil
-			}
+	return s.cache.fetchLoopIns(), nil
+}
 
-			// From the root bucket, we'll grab the next swap
-			// bucket for this swap from its swaphash.
-			swapBucket := rootBucket.Bucket(swapHash)
-			if swapBucket == nil {
-				return fmt.Errorf("swap bucket %x not found",
-					swapHash)
-			}
+// deserializeLoopIn deserializes the loop in swap that is stored in the swap
+// bucket provided.
+func deserializeLoopIn(swapBucket *bbolt.Bucket, swapHash []byte) (*LoopIn,
+	error) {
 
-			// With the main swap bucket obtained, we'll grab the
-			// raw swap contract bytes and decode it.
-			contractBytes := swapBucket.Get(contractKey)
-			if contractBytes == nil {
-				return errors.New("contract not found")
-			}
+	// With the main swap bucket obtained, we'll grab the raw swap contract
+	// bytes and decode it.
+	contractBytes := swapBucket.Get(contractKey)
+	if contractBytes == nil {
+		return nil, errors.New("contract not found")
+	}
 
-			contract, err := deserializeLoopInContract(
-				contractBytes,
-			)
-			if err != nil {
-				return err
-			}
+	contract, err := deserializeLoopInContract(
+		contractBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-			// Get our label for this swap, if it is present.
-			contract.Label = getLabel(swapBucket)
+	// Get our label for this swap, if it is present.
+	contract.Label = getLabel(swapBucket)
 
-			updates, err := deserializeUpdates(swapBucket)
-			if err != nil {
-				return err
-			}
+	// Get the server message for this swap, if present.
+	contract.ServerMessage, err = getServerMessage(
+		swapBucket,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-			// Try to unmarshal the protocol version for the swap.
-			// If the protocol version is not stored (which is
-			// the case for old clients), we'll assume the
-			// ProtocolVersionUnrecorded instead.
-			contract.ProtocolVersion, err =
-				UnmarshalProtocolVersion(
-					swapBucket.Get(protocolVersionKey),
-				)
-			if err != nil {
-				return err
-			}
+	contract.RequestID = getRequestID(swapBucket)
+	contract.Tenant = getTenant(swapBucket)
 
-			loop := LoopIn{
-				Loop: Loop{
-					Events: updates,
-				},
-				Contract: contract,
-			}
+	contract.Approval, err = getApproval(swapBucket)
+	if err != nil {
+		return nil, err
+	}
 
-			loop.Hash, err = lntypes.MakeHash(swapHash)
-			if err != nil {
-				return err
-			}
+	contract.Quote, err = getSwapQuote(swapBucket)
+	if err != nil {
+		return nil, err
+	}
 
-			swaps = append(swaps, &loop)
+	contract.SLA, err = getSwapSLA(swapBucket)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil
-		})
-	})
+	contract.HtlcFunding, err = getHtlcFunding(swapBucket)
 	if err != nil {
 		return nil, err
 	}
 
-	return swaps, nil
+	updates, err := deserializeUpdates(swapBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// Try to unmarshal the protocol version for the swap. If the protocol
+	// version is not stored (which is the case for old clients), we'll
+	// assume the ProtocolVersionUnrecorded instead.
+	contract.ProtocolVersion, err =
+		UnmarshalProtocolVersion(
+			swapBucket.Get(protocolVersionKey),
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	loop := LoopIn{
+		Loop: Loop{
+			Events: updates,
+		},
+		Contract: contract,
+	}
+
+	loop.Hash, err = lntypes.MakeHash(swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loop, nil
 }
 
 // createLoopBucket creates the bucket for a particular swap.
@@ -509,71 +565,116 @@ func (s *boltSwapStore) CreateLoopOut(hash lntypes.Hash,
 	}
 
 	// Otherwise, we'll create a new swap within the database.
-	return s.db.Update(func(tx *bbolt.Tx) error {
-		// Create the swap bucket.
-		swapBucket, err := createLoopBucket(tx, loopOutBucketKey, hash)
-		if err != nil {
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		if err := createLoopOut(tx, hash, swap); err != nil {
 			return err
 		}
 
-		// With the swap bucket created, we'll store the swap itself.
-		contractBytes, err := serializeLoopOutContract(swap)
-		if err != nil {
-			return err
-		}
+		_, err := s.separateSecret(tx, loopOutBucketKey, hash)
+		return err
+	})
+	s.cache.invalidate(loopOutBucketKey, hash)
 
-		err = swapBucket.Put(contractKey, contractBytes)
-		if err != nil {
-			return err
-		}
+	return err
+}
 
-		if err := putLabel(swapBucket, swap.Label); err != nil {
-			return err
-		}
+// createLoopOut stores a new loop out swap in the transaction provided.
+func createLoopOut(tx *bbolt.Tx, hash lntypes.Hash,
+	swap *LoopOutContract) error {
 
-		// Write the outgoing channel set.
-		var b bytes.Buffer
-		for _, chanID := range swap.OutgoingChanSet {
-			err := binary.Write(&b, byteOrder, chanID)
-			if err != nil {
-				return err
-			}
-		}
-		err = swapBucket.Put(outgoingChanSetKey, b.Bytes())
-		if err != nil {
-			return err
-		}
+	// Create the swap bucket.
+	swapBucket, err := createLoopBucket(tx, loopOutBucketKey, hash)
+	if err != nil {
+		return err
+	}
 
-		// Write label to disk if we have one.
-		if err := putLabel(swapBucket, swap.Label); err != nil {
-			return err
-		}
+	// With the swap bucket created, we'll store the swap itself.
+	contractBytes, err := serializeLoopOutContract(swap)
+	if err != nil {
+		return err
+	}
 
-		// Write our confirmation target under its own key.
-		var buf bytes.Buffer
-		err = binary.Write(&buf, byteOrder, swap.HtlcConfirmations)
-		if err != nil {
-			return err
-		}
+	err = swapBucket.Put(contractKey, contractBytes)
+	if err != nil {
+		return err
+	}
 
-		err = swapBucket.Put(confirmationsKey, buf.Bytes())
-		if err != nil {
-			return err
-		}
+	if err := putLabel(swapBucket, swap.Label); err != nil {
+		return err
+	}
 
-		// Store the current protocol version.
-		err = swapBucket.Put(protocolVersionKey,
-			MarshalProtocolVersion(swap.ProtocolVersion),
-		)
+	// Write the outgoing channel set.
+	var b bytes.Buffer
+	for _, chanID := range swap.OutgoingChanSet {
+		err := binary.Write(&b, byteOrder, chanID)
 		if err != nil {
 			return err
 		}
+	}
+	err = swapBucket.Put(outgoingChanSetKey, b.Bytes())
+	if err != nil {
+		return err
+	}
 
-		// Finally, we'll create an empty updates bucket for this swap
-		// to track any future updates to the swap itself.
-		_, err = swapBucket.CreateBucket(updatesBucketKey)
+	// Write label to disk if we have one.
+	if err := putLabel(swapBucket, swap.Label); err != nil {
 		return err
-	})
+	}
+
+	// Write our confirmation target under its own key.
+	var buf bytes.Buffer
+	err = binary.Write(&buf, byteOrder, swap.HtlcConfirmations)
+	if err != nil {
+		return err
+	}
+
+	err = swapBucket.Put(confirmationsKey, buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	err = putServerMessage(swapBucket, swap.ServerMessage)
+	if err != nil {
+		return err
+	}
+
+	if err := putRequestID(swapBucket, swap.RequestID); err != nil {
+		return err
+	}
+
+	if err := putTenant(swapBucket, swap.Tenant); err != nil {
+		return err
+	}
+
+	if err := putApproval(swapBucket, swap.Approval); err != nil {
+		return err
+	}
+
+	if err := putSwapQuote(swapBucket, swap.Quote); err != nil {
+		return err
+	}
+
+	if err := putSwapSLA(swapBucket, swap.SLA); err != nil {
+		return err
+	}
+
+	err = putPaymentRecords(swapBucket, swap.PaymentRecords)
+	if err != nil {
+		return err
+	}
+
+	// Store the current protocol version.
+	err = swapBucket.Put(protocolVersionKey,
+		MarshalProtocolVersion(swap.ProtocolVersion),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll create an empty updates bucket for this swap
+	// to track any future updates to the swap itself.
+	_, err = swapBucket.CreateBucket(updatesBucketKey)
+	return err
 }
 
 // CreateLoopIn adds an initiated swap to the store.
@@ -589,42 +690,87 @@ func (s *boltSwapStore) CreateLoopIn(hash lntypes.Hash,
 	}
 
 	// Otherwise, we'll create a new swap within the database.
-	return s.db.Update(func(tx *bbolt.Tx) error {
-		// Create the swap bucket.
-		swapBucket, err := createLoopBucket(tx, loopInBucketKey, hash)
-		if err != nil {
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		if err := createLoopIn(tx, hash, swap); err != nil {
 			return err
 		}
 
-		// With the swap bucket created, we'll store the swap itself.
-		contractBytes, err := serializeLoopInContract(swap)
-		if err != nil {
-			return err
-		}
+		_, err := s.separateSecret(tx, loopInBucketKey, hash)
+		return err
+	})
+	s.cache.invalidate(loopInBucketKey, hash)
 
-		err = swapBucket.Put(contractKey, contractBytes)
-		if err != nil {
-			return err
-		}
+	return err
+}
 
-		// Store the current protocol version.
-		err = swapBucket.Put(protocolVersionKey,
-			MarshalProtocolVersion(swap.ProtocolVersion),
-		)
-		if err != nil {
-			return err
-		}
+// createLoopIn stores a new loop in swap in the transaction provided.
+func createLoopIn(tx *bbolt.Tx, hash lntypes.Hash,
+	swap *LoopInContract) error {
 
-		// Write label to disk if we have one.
-		if err := putLabel(swapBucket, swap.Label); err != nil {
-			return err
-		}
+	// Create the swap bucket.
+	swapBucket, err := createLoopBucket(tx, loopInBucketKey, hash)
+	if err != nil {
+		return err
+	}
+
+	// With the swap bucket created, we'll store the swap itself.
+	contractBytes, err := serializeLoopInContract(swap)
+	if err != nil {
+		return err
+	}
 
-		// Finally, we'll create an empty updates bucket for this swap
-		// to track any future updates to the swap itself.
-		_, err = swapBucket.CreateBucket(updatesBucketKey)
+	err = swapBucket.Put(contractKey, contractBytes)
+	if err != nil {
 		return err
-	})
+	}
+
+	// Store the current protocol version.
+	err = swapBucket.Put(protocolVersionKey,
+		MarshalProtocolVersion(swap.ProtocolVersion),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Write label to disk if we have one.
+	if err := putLabel(swapBucket, swap.Label); err != nil {
+		return err
+	}
+
+	err = putServerMessage(swapBucket, swap.ServerMessage)
+	if err != nil {
+		return err
+	}
+
+	if err := putRequestID(swapBucket, swap.RequestID); err != nil {
+		return err
+	}
+
+	if err := putTenant(swapBucket, swap.Tenant); err != nil {
+		return err
+	}
+
+	if err := putApproval(swapBucket, swap.Approval); err != nil {
+		return err
+	}
+
+	if err := putSwapQuote(swapBucket, swap.Quote); err != nil {
+		return err
+	}
+
+	if err := putSwapSLA(swapBucket, swap.SLA); err != nil {
+		return err
+	}
+
+	err = putHtlcFunding(swapBucket, swap.HtlcFunding)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll create an empty updates bucket for this swap
+	// to track any future updates to the swap itself.
+	_, err = swapBucket.CreateBucket(updatesBucketKey)
+	return err
 }
 
 // updateLoop saves a new swap state transition to the store. It takes in a
@@ -632,58 +778,69 @@ func (s *boltSwapStore) CreateLoopIn(hash lntypes.Hash,
 func (s *boltSwapStore) updateLoop(bucketKey []byte, hash lntypes.Hash,
 	time time.Time, state SwapStateData) error {
 
-	return s.db.Update(func(tx *bbolt.Tx) error {
-		// Starting from the root bucket, we'll traverse the bucket
-		// hierarchy all the way down to the swap bucket, and the
-		// update sub-bucket within that.
-		rootBucket := tx.Bucket(bucketKey)
-		if rootBucket == nil {
-			return errors.New("bucket does not exist")
-		}
-		swapBucket := rootBucket.Bucket(hash[:])
-		if swapBucket == nil {
-			return errors.New("swap not found")
-		}
-		updatesBucket := swapBucket.Bucket(updatesBucketKey)
-		if updatesBucket == nil {
-			return errors.New("udpate bucket not found")
-		}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return addLoopEvent(tx, bucketKey, hash, time, state)
+	})
+	s.cache.invalidate(bucketKey, hash)
 
-		// Each update for this swap will get a new monotonically
-		// increasing ID number that we'll obtain now.
-		id, err := updatesBucket.NextSequence()
-		if err != nil {
-			return err
-		}
+	return err
+}
 
-		nextUpdateBucket, err := updatesBucket.CreateBucket(itob(id))
-		if err != nil {
-			return fmt.Errorf("cannot create update bucket")
-		}
+// addLoopEvent appends a swap state transition to the event log of a swap in
+// the transaction provided.
+func addLoopEvent(tx *bbolt.Tx, bucketKey []byte, hash lntypes.Hash,
+	time time.Time, state SwapStateData) error {
 
-		// With the ID obtained, we'll write out this new update value.
-		updateValue, err := serializeLoopEvent(time, state)
-		if err != nil {
-			return err
-		}
+	// Starting from the root bucket, we'll traverse the bucket
+	// hierarchy all the way down to the swap bucket, and the
+	// update sub-bucket within that.
+	rootBucket := tx.Bucket(bucketKey)
+	if rootBucket == nil {
+		return errors.New("bucket does not exist")
+	}
+	swapBucket := rootBucket.Bucket(hash[:])
+	if swapBucket == nil {
+		return ErrSwapNotFound
+	}
+	updatesBucket := swapBucket.Bucket(updatesBucketKey)
+	if updatesBucket == nil {
+		return errors.New("udpate bucket not found")
+	}
+
+	// Each update for this swap will get a new monotonically
+	// increasing ID number that we'll obtain now.
+	id, err := updatesBucket.NextSequence()
+	if err != nil {
+		return err
+	}
 
-		err = nextUpdateBucket.Put(basicStateKey, updateValue)
+	nextUpdateBucket, err := updatesBucket.CreateBucket(itob(id))
+	if err != nil {
+		return fmt.Errorf("cannot create update bucket")
+	}
+
+	// With the ID obtained, we'll write out this new update value.
+	updateValue, err := serializeLoopEvent(time, state)
+	if err != nil {
+		return err
+	}
+
+	err = nextUpdateBucket.Put(basicStateKey, updateValue)
+	if err != nil {
+		return err
+	}
+
+	// Write the htlc tx hash if available.
+	if state.HtlcTxHash != nil {
+		err := nextUpdateBucket.Put(
+			htlcTxHashKey, state.HtlcTxHash[:],
+		)
 		if err != nil {
 			return err
 		}
+	}
 
-		// Write the htlc tx hash if available.
-		if state.HtlcTxHash != nil {
-			err := nextUpdateBucket.Put(
-				htlcTxHashKey, state.HtlcTxHash[:],
-			)
-			if err != nil {
-				return err
-			}
-		}
-
-		return nil
-	})
+	return putPrepay(nextUpdateBucket, state.Prepay)
 }
 
 // UpdateLoopOut stores a swap update. This appends to the event log for
@@ -710,5 +867,11 @@ func (s *boltSwapStore) UpdateLoopIn(hash lntypes.Hash, time time.Time,
 //
 // NOTE: Part of the loopdb.SwapStore interface.
 func (s *boltSwapStore) Close() error {
+	if s.secrets != nil {
+		if err := s.secrets.Close(); err != nil {
+			log.Errorf("Error closing secret store: %v", err)
+		}
+	}
+
 	return s.db.Close()
 }
@@ -2,6 +2,7 @@ package loopdb
 
 import (
 	"crypto/sha256"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/coreos/bbolt"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -91,6 +93,73 @@ func TestLoopOutStore(t *testing.T) {
 		testLoopOutStore(t, &labelledSwap)
 	})
 
+	messageSwap := unrestrictedSwap
+	messageSwap.ServerMessage = &ServerMessage{
+		Code: "fee_discount",
+		Params: map[string]string{
+			"discount_ppm": "100",
+			"expiry":       "1605000000",
+		},
+	}
+	t.Run("server message", func(t *testing.T) {
+		testLoopOutStore(t, &messageSwap)
+	})
+
+	requestSwap := unrestrictedSwap
+	requestSwap.RequestID = "request-1"
+	t.Run("request id", func(t *testing.T) {
+		testLoopOutStore(t, &requestSwap)
+	})
+
+	tenantSwap := unrestrictedSwap
+	tenantSwap.Tenant = "macaroon:0102030405060708"
+	t.Run("tenant", func(t *testing.T) {
+		testLoopOutStore(t, &tenantSwap)
+	})
+
+	quoteSwap := unrestrictedSwap
+	quoteSwap.Quote = &SwapQuote{
+		SwapFee:  60,
+		MinerFee: 10,
+	}
+	t.Run("quote", func(t *testing.T) {
+		testLoopOutStore(t, &quoteSwap)
+	})
+
+	slaSwap := unrestrictedSwap
+	slaSwap.SLA = &SwapSLA{
+		Server:           "swap.server:11010",
+		ExpectedDuration: 3 * time.Hour,
+	}
+	t.Run("sla", func(t *testing.T) {
+		testLoopOutStore(t, &slaSwap)
+	})
+
+	approvedSwap := unrestrictedSwap
+	approvedSwap.Approval = &SwapApproval{
+		Approver:  "approver",
+		Requester: "requester",
+		Time:      time.Unix(0, 1605000000000000001),
+	}
+	t.Run("approval", func(t *testing.T) {
+		testLoopOutStore(t, &approvedSwap)
+	})
+
+	recordSwap := unrestrictedSwap
+	recordSwap.PaymentRecords = []PaymentRecord{
+		{
+			Type:  1819242352,
+			Value: []byte{1, 2, 3},
+		},
+		{
+			Type:   1819242353,
+			Value:  []byte{1},
+			Prepay: true,
+		},
+	}
+	t.Run("payment records", func(t *testing.T) {
+		testLoopOutStore(t, &recordSwap)
+	})
 }
 
 // testLoopOutStore tests the basic functionality of the current bbolt
@@ -239,6 +308,58 @@ func TestLoopInStore(t *testing.T) {
 	t.Run("loop in with label", func(t *testing.T) {
 		testLoopInStore(t, labelledSwap)
 	})
+
+	messageSwap := pendingSwap
+	messageSwap.ServerMessage = &ServerMessage{
+		Code:   "maintenance",
+		Params: map[string]string{},
+	}
+	t.Run("loop in with server message", func(t *testing.T) {
+		testLoopInStore(t, messageSwap)
+	})
+
+	requestSwap := pendingSwap
+	requestSwap.RequestID = "request-1"
+	t.Run("loop in with request id", func(t *testing.T) {
+		testLoopInStore(t, requestSwap)
+	})
+
+	tenantSwap := pendingSwap
+	tenantSwap.Tenant = "macaroon:0102030405060708"
+	t.Run("loop in with tenant", func(t *testing.T) {
+		testLoopInStore(t, tenantSwap)
+	})
+
+	quoteSwap := pendingSwap
+	quoteSwap.Quote = &SwapQuote{
+		SwapFee:  20,
+		MinerFee: 10,
+	}
+	t.Run("loop in with quote", func(t *testing.T) {
+		testLoopInStore(t, quoteSwap)
+	})
+
+	slaSwap := pendingSwap
+	slaSwap.SLA = &SwapSLA{
+		Server:           "swap.server:11010",
+		ExpectedDuration: time.Hour,
+	}
+	t.Run("loop in with sla", func(t *testing.T) {
+		testLoopInStore(t, slaSwap)
+	})
+
+	fundingSwap := pendingSwap
+	fundingSwap.ExternalHtlc = false
+	fundingSwap.HtlcFunding = &HtlcFunding{
+		Account: "savings",
+		Outpoints: []wire.OutPoint{
+			{Hash: chainhash.Hash{1}, Index: 2},
+			{Hash: chainhash.Hash{3}, Index: 4},
+		},
+	}
+	t.Run("loop in with htlc funding", func(t *testing.T) {
+		testLoopInStore(t, fundingSwap)
+	})
 }
 
 func testLoopInStore(t *testing.T, pendingSwap LoopInContract) {
@@ -366,6 +487,27 @@ func TestVersionNew(t *testing.T) {
 	}
 }
 
+// TestNetworkMismatch tests that a database can only be opened for the network
+// that it was created for.
+func TestNetworkMismatch(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	_, err = NewBoltSwapStore(tempDirName, &chaincfg.SigNetParams)
+	require.True(t, errors.Is(err, ErrNetworkMismatch))
+
+	// The database is closed when we fail, so we can reopen it for the
+	// network that it was created for.
+	store, err = NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+}
+
 // TestVersionNew tests that an existing version zero database is migrated to
 // the latest version.
 func TestVersionMigrated(t *testing.T) {
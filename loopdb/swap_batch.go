@@ -0,0 +1,160 @@
+package loopdb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// ErrSwapNotFound is returned when a swap is not in our store.
+	ErrSwapNotFound = errors.New("swap not found")
+)
+
+// SwapUpdate is a state transition of a single swap, which can be stored
+// along with the updates of other swaps in a single batch.
+type SwapUpdate struct {
+	// Hash is the hash of the swap that the update is for.
+	Hash lntypes.Hash
+
+	// Time is the time of the update.
+	Time time.Time
+
+	// State is the state that the swap transitioned to.
+	State SwapStateData
+}
+
+// UpdateLoopOuts stores a batch of loop out swap updates in a single
+// transaction. If any of the updates can't be stored, none are.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateLoopOuts(updates []*SwapUpdate) error {
+	return s.updateLoops(loopOutBucketKey, updates)
+}
+
+// UpdateLoopIns stores a batch of loop in swap updates in a single
+// transaction. If any of the updates can't be stored, none are.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateLoopIns(updates []*SwapUpdate) error {
+	return s.updateLoops(loopInBucketKey, updates)
+}
+
+// updateLoops appends a batch of swap updates to the event logs of swaps in
+// the swap type bucket provided. Updates for the same swap are appended in the
+// order that they are provided.
+func (s *boltSwapStore) updateLoops(bucketKey []byte,
+	updates []*SwapUpdate) error {
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		for _, update := range updates {
+			err := addLoopEvent(
+				tx, bucketKey, update.Hash, update.Time,
+				update.State,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	for _, update := range updates {
+		s.cache.invalidate(bucketKey, update.Hash)
+	}
+
+	return err
+}
+
+// FetchLoopOutStates returns the latest update of each of the loop out swaps
+// provided, read in a single transaction. Swaps that have not been updated
+// since they were created are not included in the map returned. If any of the
+// swaps is not in our store, ErrSwapNotFound is returned.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchLoopOutStates(hashes []lntypes.Hash) (
+	map[lntypes.Hash]*LoopEvent, error) {
+
+	return s.fetchLatestUpdates(loopOutBucketKey, hashes)
+}
+
+// FetchLoopInStates returns the latest update of each of the loop in swaps
+// provided, read in a single transaction. Swaps that have not been updated
+// since they were created are not included in the map returned. If any of the
+// swaps is not in our store, ErrSwapNotFound is returned.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchLoopInStates(hashes []lntypes.Hash) (
+	map[lntypes.Hash]*LoopEvent, error) {
+
+	return s.fetchLatestUpdates(loopInBucketKey, hashes)
+}
+
+// fetchLatestUpdates returns the latest update of each of the swaps provided
+// in the swap type bucket provided. Only the latest update of each swap is
+// deserialized, so this is much cheaper than reading the swaps in full.
+func (s *boltSwapStore) fetchLatestUpdates(bucketKey []byte,
+	hashes []lntypes.Hash) (map[lntypes.Hash]*LoopEvent, error) {
+
+	latest := make(map[lntypes.Hash]*LoopEvent, len(hashes))
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(bucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		for _, hash := range hashes {
+			swapBucket := rootBucket.Bucket(hash[:])
+			if swapBucket == nil {
+				return ErrSwapNotFound
+			}
+
+			event, err := latestUpdate(swapBucket)
+			if err != nil {
+				return err
+			}
+
+			if event != nil {
+				latest[hash] = event
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return latest, nil
+}
+
+// latestUpdate returns the latest update of the swap in the swap bucket
+// provided, or nil if the swap has not been updated since it was created.
+func latestUpdate(swapBucket *bbolt.Bucket) (*LoopEvent, error) {
+	updatesBucket := swapBucket.Bucket(updatesBucketKey)
+	if updatesBucket == nil {
+		return nil, errors.New("updates bucket not found")
+	}
+
+	// Our updates are keyed by their big endian sequence number, so the
+	// last key is our latest update.
+	id, _ := updatesBucket.Cursor().Last()
+	if id == nil {
+		return nil, nil
+	}
+
+	updateBucket := updatesBucket.Bucket(id)
+	if updateBucket == nil {
+		return nil, errors.New("update bucket not found")
+	}
+
+	return deserializeUpdate(updateBucket)
+}
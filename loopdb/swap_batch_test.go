@@ -0,0 +1,121 @@
+package loopdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapBatches tests storing and fetching the states of swaps in batches.
+func TestSwapBatches(t *testing.T) {
+	store, cleanup := newImportTestStore(t)
+	defer cleanup()
+
+	var (
+		swapTime = time.Unix(0, testTime.UnixNano())
+		hashes   []lntypes.Hash
+	)
+
+	for i := byte(1); i <= 3; i++ {
+		preimage := lntypes.Preimage{i}
+		hash := preimage.Hash()
+		hashes = append(hashes, hash)
+
+		err := store.CreateLoopOut(hash, &LoopOutContract{
+			SwapContract: SwapContract{
+				AmountRequested:  100,
+				Preimage:         preimage,
+				CltvExpiry:       144,
+				SenderKey:        senderKey,
+				ReceiverKey:      receiverKey,
+				InitiationHeight: 99,
+				InitiationTime:   swapTime,
+			},
+			DestAddr:                test.GetDestAddr(t, 0),
+			SwapInvoice:             "swapinvoice",
+			PrepayInvoice:           "prepayinvoice",
+			HtlcConfirmations:       2,
+			SwapPublicationDeadline: swapTime,
+		})
+		require.NoError(t, err)
+	}
+
+	// Before any of our swaps are updated, none have a latest state.
+	states, err := store.FetchLoopOutStates(hashes)
+	require.NoError(t, err)
+	require.Empty(t, states)
+
+	// Fetch our swaps so that our swap cache is built, and we test that
+	// our batched updates are picked up by it.
+	_, err = store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+
+	// Update our first swap twice and our second swap once in a single
+	// batch.
+	err = store.UpdateLoopOuts([]*SwapUpdate{
+		{
+			Hash:  hashes[0],
+			Time:  testTime,
+			State: SwapStateData{State: StatePreimageRevealed},
+		},
+		{
+			Hash:  hashes[1],
+			Time:  testTime,
+			State: SwapStateData{State: StateFailTimeout},
+		},
+		{
+			Hash:  hashes[0],
+			Time:  testTime.Add(time.Minute),
+			State: SwapStateData{State: StateSuccess},
+		},
+	})
+	require.NoError(t, err)
+
+	swaps, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+
+	expected := make(map[lntypes.Hash]*LoopEvent)
+	for _, swap := range swaps {
+		if lastUpdate := swap.LastUpdate(); lastUpdate != nil {
+			expected[swap.Hash] = lastUpdate
+		}
+	}
+	require.Len(t, expected, 2)
+	require.Equal(t, StateSuccess, expected[hashes[0]].State)
+	require.Equal(t, StateFailTimeout, expected[hashes[1]].State)
+
+	states, err = store.FetchLoopOutStates(hashes)
+	require.NoError(t, err)
+	require.Equal(t, expected, states)
+
+	// A batch that updates a swap that does not exist is not stored at
+	// all.
+	err = store.UpdateLoopOuts([]*SwapUpdate{
+		{
+			Hash:  hashes[2],
+			Time:  testTime,
+			State: SwapStateData{State: StateSuccess},
+		},
+		{
+			Hash:  lntypes.Hash{1},
+			Time:  testTime,
+			State: SwapStateData{State: StateSuccess},
+		},
+	})
+	require.Equal(t, ErrSwapNotFound, err)
+
+	states, err = store.FetchLoopOutStates(hashes)
+	require.NoError(t, err)
+	require.Equal(t, expected, states)
+
+	// Fetching the state of a swap that does not exist fails.
+	_, err = store.FetchLoopOutStates([]lntypes.Hash{{1}})
+	require.Equal(t, ErrSwapNotFound, err)
+
+	// Our loop in states are kept separately from our loop outs.
+	_, err = store.FetchLoopInStates(hashes)
+	require.Equal(t, ErrSwapNotFound, err)
+}
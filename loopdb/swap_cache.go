@@ -0,0 +1,367 @@
+package loopdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// swapCache is an in-memory index of the swaps in our store. Our swap history
+// only ever grows, so deserializing all of it from disk every time that our
+// swaps are listed becomes expensive on long-lived nodes. The cache is built
+// from disk the first time that swaps are fetched after the store is opened.
+// Every write to a swap marks the swap as stale, and only stale swaps are read
+// from disk again when swaps are next fetched.
+type swapCache struct {
+	// loaded is true once our swaps have been read from disk.
+	loaded bool
+
+	// loopOut holds our loop outs, keyed by hash.
+	loopOut map[lntypes.Hash]*LoopOut
+
+	// loopOutHashes holds the hashes of our loop outs in the order that
+	// bolt stores them in.
+	loopOutHashes []lntypes.Hash
+
+	// loopIn holds our loop ins, keyed by hash.
+	loopIn map[lntypes.Hash]*LoopIn
+
+	// loopInHashes holds the hashes of our loop ins in the order that bolt
+	// stores them in.
+	loopInHashes []lntypes.Hash
+
+	// staleOut and staleIn hold the hashes of the swaps that have been
+	// written since they were last read from disk.
+	staleOut map[lntypes.Hash]struct{}
+	staleIn  map[lntypes.Hash]struct{}
+
+	mu sync.Mutex
+}
+
+// newSwapCache creates a swap cache that has not been built yet.
+func newSwapCache() *swapCache {
+	return &swapCache{
+		loopOut:  make(map[lntypes.Hash]*LoopOut),
+		loopIn:   make(map[lntypes.Hash]*LoopIn),
+		staleOut: make(map[lntypes.Hash]struct{}),
+		staleIn:  make(map[lntypes.Hash]struct{}),
+	}
+}
+
+// invalidate marks a swap in the swap type bucket provided as stale. It must
+// be called once a write to the swap has been committed, so that a concurrent
+// fetch can't read the swap before the write and then clear its stale mark.
+func (c *swapCache) invalidate(swapTypeKey []byte, hash lntypes.Hash) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// If we have not read our swaps yet, they will all be read from disk
+	// when they are first fetched.
+	if !c.loaded {
+		return
+	}
+
+	if bytes.Equal(swapTypeKey, loopOutBucketKey) {
+		c.staleOut[hash] = struct{}{}
+	} else {
+		c.staleIn[hash] = struct{}{}
+	}
+}
+
+// refreshCache builds our swap cache from disk if it has not been built yet,
+// and otherwise reads our stale swaps from disk again. The caller must hold
+// the cache's mutex.
+func (s *boltSwapStore) refreshCache() error {
+	c := s.cache
+	if c.loaded && len(c.staleOut) == 0 && len(c.staleIn) == 0 {
+		return nil
+	}
+
+	var (
+		loopOuts []*LoopOut
+		loopIns  []*LoopIn
+
+		// removedOut and removedIn hold stale swaps that are not on
+		// disk, which is the case if the write to create them failed.
+		removedOut []lntypes.Hash
+		removedIn  []lntypes.Hash
+	)
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		outBucket := tx.Bucket(loopOutBucketKey)
+		if outBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		inBucket := tx.Bucket(loopInBucketKey)
+		if inBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		if !c.loaded {
+			var err error
+			loopOuts, err = loadLoopOuts(outBucket, s.chainParams)
+			if err != nil {
+				return err
+			}
+
+			loopIns, err = loadLoopIns(inBucket)
+			return err
+		}
+
+		for hash := range c.staleOut {
+			swapBucket := outBucket.Bucket(hash[:])
+			if swapBucket == nil {
+				removedOut = append(removedOut, hash)
+				continue
+			}
+
+			swap, err := deserializeLoopOut(
+				swapBucket, hash[:], s.chainParams,
+			)
+			if err != nil {
+				return err
+			}
+
+			loopOuts = append(loopOuts, swap)
+		}
+
+		for hash := range c.staleIn {
+			swapBucket := inBucket.Bucket(hash[:])
+			if swapBucket == nil {
+				removedIn = append(removedIn, hash)
+				continue
+			}
+
+			swap, err := deserializeLoopIn(swapBucket, hash[:])
+			if err != nil {
+				return err
+			}
+
+			loopIns = append(loopIns, swap)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// If our preimages are stored apart from our swaps, we add them to
+	// the swaps that we read.
+	for _, swap := range loopOuts {
+		err := s.restoreSecret(swap.Hash, &swap.Contract.SwapContract)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, swap := range loopIns {
+		err := s.restoreSecret(swap.Hash, &swap.Contract.SwapContract)
+		if err != nil {
+			return err
+		}
+	}
+
+	// When we first build our cache, our swaps are read in the order that
+	// bolt stores them in, so we can take their hashes in order.
+	if !c.loaded {
+		for _, swap := range loopOuts {
+			c.loopOut[swap.Hash] = swap
+			c.loopOutHashes = append(c.loopOutHashes, swap.Hash)
+		}
+
+		for _, swap := range loopIns {
+			c.loopIn[swap.Hash] = swap
+			c.loopInHashes = append(c.loopInHashes, swap.Hash)
+		}
+
+		c.loaded = true
+
+		return nil
+	}
+
+	for _, swap := range loopOuts {
+		if _, ok := c.loopOut[swap.Hash]; !ok {
+			c.loopOutHashes = insertHash(c.loopOutHashes, swap.Hash)
+		}
+
+		c.loopOut[swap.Hash] = swap
+	}
+
+	for _, swap := range loopIns {
+		if _, ok := c.loopIn[swap.Hash]; !ok {
+			c.loopInHashes = insertHash(c.loopInHashes, swap.Hash)
+		}
+
+		c.loopIn[swap.Hash] = swap
+	}
+
+	for _, hash := range removedOut {
+		if _, ok := c.loopOut[hash]; ok {
+			delete(c.loopOut, hash)
+			c.loopOutHashes = removeHash(c.loopOutHashes, hash)
+		}
+	}
+
+	for _, hash := range removedIn {
+		if _, ok := c.loopIn[hash]; ok {
+			delete(c.loopIn, hash)
+			c.loopInHashes = removeHash(c.loopInHashes, hash)
+		}
+	}
+
+	c.staleOut = make(map[lntypes.Hash]struct{})
+	c.staleIn = make(map[lntypes.Hash]struct{})
+
+	return nil
+}
+
+// fetchLoopOuts returns copies of our cached loop outs, in the order that
+// bolt stores them in. The caller must hold the cache's mutex.
+func (c *swapCache) fetchLoopOuts() []*LoopOut {
+	var swaps []*LoopOut
+	for _, hash := range c.loopOutHashes {
+		swap := *c.loopOut[hash]
+		swap.Events = copyEvents(swap.Events)
+
+		swaps = append(swaps, &swap)
+	}
+
+	return swaps
+}
+
+// fetchLoopIns returns copies of our cached loop ins, in the order that bolt
+// stores them in. The caller must hold the cache's mutex.
+func (c *swapCache) fetchLoopIns() []*LoopIn {
+	var swaps []*LoopIn
+	for _, hash := range c.loopInHashes {
+		swap := *c.loopIn[hash]
+		swap.Events = copyEvents(swap.Events)
+
+		swaps = append(swaps, &swap)
+	}
+
+	return swaps
+}
+
+// copyEvents returns a copy of a swap's event log, so that callers can't
+// modify the log of a cached swap.
+func copyEvents(events []*LoopEvent) []*LoopEvent {
+	if events == nil {
+		return nil
+	}
+
+	eventsCopy := make([]*LoopEvent, len(events))
+	copy(eventsCopy, events)
+
+	return eventsCopy
+}
+
+// loadLoopOuts reads all of the loop outs in the loop out bucket provided.
+func loadLoopOuts(rootBucket *bbolt.Bucket,
+	chainParams *chaincfg.Params) ([]*LoopOut, error) {
+
+	var swaps []*LoopOut
+
+	// We'll traverse the root bucket for all swaps. The primary key is the
+	// swap hash itself.
+	err := rootBucket.ForEach(func(swapHash, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		// From the root bucket, we'll grab the swap bucket for this
+		// swap from its swap hash.
+		swapBucket := rootBucket.Bucket(swapHash)
+		if swapBucket == nil {
+			return fmt.Errorf("swap bucket %x not found", swapHash)
+		}
+
+		swap, err := deserializeLoopOut(
+			swapBucket, swapHash, chainParams,
+		)
+		if err != nil {
+			return err
+		}
+
+		swaps = append(swaps, swap)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swaps, nil
+}
+
+// loadLoopIns reads all of the loop ins in the loop in bucket provided.
+func loadLoopIns(rootBucket *bbolt.Bucket) ([]*LoopIn, error) {
+	var swaps []*LoopIn
+
+	// We'll traverse the root bucket for all swaps. The primary key is the
+	// swap hash itself.
+	err := rootBucket.ForEach(func(swapHash, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		// From the root bucket, we'll grab the swap bucket for this
+		// swap from its swap hash.
+		swapBucket := rootBucket.Bucket(swapHash)
+		if swapBucket == nil {
+			return fmt.Errorf("swap bucket %x not found", swapHash)
+		}
+
+		swap, err := deserializeLoopIn(swapBucket, swapHash)
+		if err != nil {
+			return err
+		}
+
+		swaps = append(swaps, swap)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swaps, nil
+}
+
+// insertHash inserts a hash into a set of hashes that is sorted in ascending
+// order, keeping the set sorted.
+func insertHash(hashes []lntypes.Hash, hash lntypes.Hash) []lntypes.Hash {
+	i := sort.Search(len(hashes), func(i int) bool {
+		return bytes.Compare(hashes[i][:], hash[:]) >= 0
+	})
+
+	hashes = append(hashes, lntypes.Hash{})
+	copy(hashes[i+1:], hashes[i:])
+	hashes[i] = hash
+
+	return hashes
+}
+
+// removeHash removes a hash from a set of hashes that is sorted in ascending
+// order.
+func removeHash(hashes []lntypes.Hash, hash lntypes.Hash) []lntypes.Hash {
+	i := sort.Search(len(hashes), func(i int) bool {
+		return bytes.Compare(hashes[i][:], hash[:]) >= 0
+	})
+
+	if i == len(hashes) || hashes[i] != hash {
+		return hashes
+	}
+
+	return append(hashes[:i], hashes[i+1:]...)
+}
@@ -0,0 +1,150 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/coreos/bbolt"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapCache tests that the swaps served from our swap cache match the
+// swaps on disk as swaps are written, and after the store is reopened.
+func TestSwapCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewBoltSwapStore(dir, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	var (
+		destAddr = test.GetDestAddr(t, 0)
+		swapTime = time.Unix(0, testTime.UnixNano())
+	)
+
+	newContract := func(preimage lntypes.Preimage) SwapContract {
+		return SwapContract{
+			AmountRequested:  100,
+			Preimage:         preimage,
+			CltvExpiry:       144,
+			SenderKey:        senderKey,
+			ReceiverKey:      receiverKey,
+			InitiationHeight: 99,
+			InitiationTime:   swapTime,
+		}
+	}
+
+	createLoopOut := func(preimage lntypes.Preimage) lntypes.Hash {
+		hash := preimage.Hash()
+		err := store.CreateLoopOut(hash, &LoopOutContract{
+			SwapContract:            newContract(preimage),
+			DestAddr:                destAddr,
+			SwapInvoice:             "swapinvoice",
+			PrepayInvoice:           "prepayinvoice",
+			HtlcConfirmations:       2,
+			SwapPublicationDeadline: swapTime,
+		})
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	// assertCached asserts that the swaps that we fetch match the swaps
+	// that are on disk.
+	assertCached := func(store *boltSwapStore) []*LoopOut {
+		t.Helper()
+
+		var (
+			diskOut []*LoopOut
+			diskIn  []*LoopIn
+		)
+		err := store.db.View(func(tx *bbolt.Tx) error {
+			var err error
+			diskOut, err = loadLoopOuts(
+				tx.Bucket(loopOutBucketKey), store.chainParams,
+			)
+			if err != nil {
+				return err
+			}
+
+			diskIn, err = loadLoopIns(tx.Bucket(loopInBucketKey))
+			return err
+		})
+		require.NoError(t, err)
+
+		loopOuts, err := store.FetchLoopOutSwaps()
+		require.NoError(t, err)
+		require.Equal(t, diskOut, loopOuts)
+
+		loopIns, err := store.FetchLoopInSwaps()
+		require.NoError(t, err)
+		require.Equal(t, diskIn, loopIns)
+
+		return loopOuts
+	}
+
+	// Our cache is built the first time that we fetch our swaps.
+	hash := createLoopOut(lntypes.Preimage{1})
+	require.Len(t, assertCached(store), 1)
+
+	// Swaps that are created or updated once our cache is built are
+	// read from disk again, and kept in the order of our store.
+	for i := 2; i < 10; i++ {
+		createLoopOut(lntypes.Preimage{byte(i)})
+	}
+
+	err = store.UpdateLoopOut(hash, testTime, SwapStateData{
+		State: StateSuccess,
+	})
+	require.NoError(t, err)
+
+	loopInPreimage := lntypes.Preimage{10}
+	err = store.CreateLoopIn(loopInPreimage.Hash(), &LoopInContract{
+		SwapContract:   newContract(loopInPreimage),
+		HtlcConfTarget: 2,
+	})
+	require.NoError(t, err)
+
+	loopOuts := assertCached(store)
+	require.Len(t, loopOuts, 9)
+
+	// Modifying the event log of a swap that we fetched does not modify
+	// our cache.
+	loopOuts[0].Events = append(loopOuts[0].Events, &LoopEvent{})
+	assertCached(store)
+
+	// Writes that fail leave our cache unchanged.
+	require.Error(t, store.UpdateLoopOut(
+		lntypes.Hash{1}, testTime, SwapStateData{},
+	))
+	loopOuts = assertCached(store)
+
+	// When our store is reopened, our cache is built from disk again.
+	require.NoError(t, store.Close())
+
+	store, err = NewBoltSwapStore(dir, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.Equal(t, loopOuts, assertCached(store))
+}
+
+// TestSortedHashes tests insertion and removal of hashes from a sorted set.
+func TestSortedHashes(t *testing.T) {
+	var hashes []lntypes.Hash
+	for _, b := range []byte{3, 1, 2, 1} {
+		hashes = insertHash(hashes, lntypes.Hash{b})
+	}
+
+	require.Equal(t, []lntypes.Hash{{1}, {1}, {2}, {3}}, hashes)
+
+	hashes = removeHash(hashes, lntypes.Hash{2})
+	hashes = removeHash(hashes, lntypes.Hash{4})
+	require.Equal(t, []lntypes.Hash{{1}, {1}, {3}}, hashes)
+}
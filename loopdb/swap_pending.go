@@ -0,0 +1,153 @@
+package loopdb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coreos/bbolt"
+)
+
+// FetchPendingLoopOuts returns the loop out swaps in our store that are not
+// in a final state, ordered by swap hash. Only the latest update of swaps in
+// a final state is read, so this is much cheaper than fetching all of our
+// swaps on nodes with a long swap history.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchPendingLoopOuts() ([]*LoopOut, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+
+	// If our swap cache has already been built, we serve our pending
+	// swaps from it rather than reading them from disk again.
+	if s.cache.loaded {
+		if err := s.refreshCache(); err != nil {
+			return nil, err
+		}
+
+		var pending []*LoopOut
+		for _, swap := range s.cache.fetchLoopOuts() {
+			if swap.State().State.Type() == StateTypePending {
+				pending = append(pending, swap)
+			}
+		}
+
+		return pending, nil
+	}
+
+	var pending []*LoopOut
+	load := func(swapHash []byte, swapBucket *bbolt.Bucket) error {
+		swap, err := deserializeLoopOut(
+			swapBucket, swapHash, s.chainParams,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = s.restoreSecret(swap.Hash, &swap.Contract.SwapContract)
+		if err != nil {
+			return err
+		}
+
+		pending = append(pending, swap)
+
+		return nil
+	}
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return forEachPendingSwap(tx, loopOutBucketKey, load)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pending, nil
+}
+
+// FetchPendingLoopIns returns the loop in swaps in our store that are not in a
+// final state, ordered by swap hash. Only the latest update of swaps in a
+// final state is read, so this is much cheaper than fetching all of our swaps
+// on nodes with a long swap history.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchPendingLoopIns() ([]*LoopIn, error) {
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+
+	// If our swap cache has already been built, we serve our pending
+	// swaps from it rather than reading them from disk again.
+	if s.cache.loaded {
+		if err := s.refreshCache(); err != nil {
+			return nil, err
+		}
+
+		var pending []*LoopIn
+		for _, swap := range s.cache.fetchLoopIns() {
+			if swap.State().State.Type() == StateTypePending {
+				pending = append(pending, swap)
+			}
+		}
+
+		return pending, nil
+	}
+
+	var pending []*LoopIn
+	load := func(swapHash []byte, swapBucket *bbolt.Bucket) error {
+		swap, err := deserializeLoopIn(swapBucket, swapHash)
+		if err != nil {
+			return err
+		}
+
+		err = s.restoreSecret(swap.Hash, &swap.Contract.SwapContract)
+		if err != nil {
+			return err
+		}
+
+		pending = append(pending, swap)
+
+		return nil
+	}
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return forEachPendingSwap(tx, loopInBucketKey, load)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pending, nil
+}
+
+// forEachPendingSwap calls f with the hash and bucket of each swap in the swap
+// type bucket provided that is not in a final state. Swaps that have not been
+// updated since they were created are pending.
+func forEachPendingSwap(tx *bbolt.Tx, bucketKey []byte,
+	f func(swapHash []byte, swapBucket *bbolt.Bucket) error) error {
+
+	rootBucket := tx.Bucket(bucketKey)
+	if rootBucket == nil {
+		return errors.New("bucket does not exist")
+	}
+
+	return rootBucket.ForEach(func(swapHash, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		swapBucket := rootBucket.Bucket(swapHash)
+		if swapBucket == nil {
+			return fmt.Errorf("swap bucket %x not found", swapHash)
+		}
+
+		update, err := latestUpdate(swapBucket)
+		if err != nil {
+			return err
+		}
+
+		if update != nil && update.State.Type() != StateTypePending {
+			return nil
+		}
+
+		return f(swapHash, swapBucket)
+	})
+}
@@ -0,0 +1,131 @@
+package loopdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFetchPendingSwaps tests that only swaps that are not in a final state
+// are fetched, both before and after our swap cache is built.
+func TestFetchPendingSwaps(t *testing.T) {
+	store, cleanup := newImportTestStore(t)
+	defer cleanup()
+
+	swapTime := time.Unix(0, testTime.UnixNano())
+	newContract := func(preimage lntypes.Preimage) SwapContract {
+		return SwapContract{
+			AmountRequested:  100,
+			Preimage:         preimage,
+			CltvExpiry:       144,
+			SenderKey:        senderKey,
+			ReceiverKey:      receiverKey,
+			InitiationHeight: 99,
+			InitiationTime:   swapTime,
+		}
+	}
+
+	// Create a loop out and a loop in in each of the states provided.
+	var outHashes, inHashes []lntypes.Hash
+	for i, state := range []SwapState{
+		StateInitiated, StatePreimageRevealed, StateSuccess,
+		StateFailTimeout,
+	} {
+		outPreimage := lntypes.Preimage{byte(i), 1}
+		outHash := outPreimage.Hash()
+		outHashes = append(outHashes, outHash)
+
+		err := store.CreateLoopOut(outHash, &LoopOutContract{
+			SwapContract:            newContract(outPreimage),
+			DestAddr:                test.GetDestAddr(t, 0),
+			SwapInvoice:             "swapinvoice",
+			PrepayInvoice:           "prepayinvoice",
+			HtlcConfirmations:       2,
+			SwapPublicationDeadline: swapTime,
+		})
+		require.NoError(t, err)
+
+		inPreimage := lntypes.Preimage{byte(i), 2}
+		inHash := inPreimage.Hash()
+		inHashes = append(inHashes, inHash)
+
+		err = store.CreateLoopIn(inHash, &LoopInContract{
+			SwapContract:   newContract(inPreimage),
+			HtlcConfTarget: 2,
+		})
+		require.NoError(t, err)
+
+		// Swaps in the initiated state are left without updates.
+		if state == StateInitiated {
+			continue
+		}
+
+		update := SwapStateData{State: state}
+		err = store.UpdateLoopOut(outHash, testTime, update)
+		require.NoError(t, err)
+
+		err = store.UpdateLoopIn(inHash, testTime, update)
+		require.NoError(t, err)
+	}
+
+	// Fetch our pending swaps from disk before our cache is built, and
+	// check that fetching them does not build our cache.
+	diskOut, err := store.FetchPendingLoopOuts()
+	require.NoError(t, err)
+
+	diskIn, err := store.FetchPendingLoopIns()
+	require.NoError(t, err)
+	require.False(t, store.cache.loaded)
+
+	// Our pending swaps must match the first two of our swaps, which are
+	// not in a final state, when we fetch all of our swaps.
+	loopOuts, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+
+	var expectedOut []*LoopOut
+	for _, swap := range loopOuts {
+		if swap.Hash == outHashes[0] || swap.Hash == outHashes[1] {
+			expectedOut = append(expectedOut, swap)
+		}
+	}
+
+	loopIns, err := store.FetchLoopInSwaps()
+	require.NoError(t, err)
+
+	var expectedIn []*LoopIn
+	for _, swap := range loopIns {
+		if swap.Hash == inHashes[0] || swap.Hash == inHashes[1] {
+			expectedIn = append(expectedIn, swap)
+		}
+	}
+
+	require.Len(t, expectedOut, 2)
+	require.Equal(t, expectedOut, diskOut)
+	require.Len(t, expectedIn, 2)
+	require.Equal(t, expectedIn, diskIn)
+
+	// Once our cache is built, our pending swaps are served from it.
+	require.True(t, store.cache.loaded)
+
+	cachedOut, err := store.FetchPendingLoopOuts()
+	require.NoError(t, err)
+	require.Equal(t, expectedOut, cachedOut)
+
+	cachedIn, err := store.FetchPendingLoopIns()
+	require.NoError(t, err)
+	require.Equal(t, expectedIn, cachedIn)
+
+	// Swaps that complete are no longer fetched as pending.
+	err = store.UpdateLoopOut(outHashes[1], testTime, SwapStateData{
+		State: StateSuccess,
+	})
+	require.NoError(t, err)
+
+	cachedOut, err = store.FetchPendingLoopOuts()
+	require.NoError(t, err)
+	require.Len(t, cachedOut, 1)
+	require.Equal(t, outHashes[0], cachedOut[0].Hash)
+}
@@ -0,0 +1,493 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// swapPlanBucketKey is a bucket that contains the plans that split
+	// large liquidity changes into sequences of swaps.
+	//
+	// maps: id -> plan
+	swapPlanBucketKey = []byte("swap-plans")
+
+	// ErrSwapPlanNotFound is returned when a swap plan that does not exist
+	// is updated.
+	ErrSwapPlanNotFound = errors.New("swap plan not found")
+)
+
+// SwapPlanState is the state of a swap plan.
+type SwapPlanState uint8
+
+const (
+	// SwapPlanPaused indicates that the steps of a plan are not
+	// dispatched. Plans are created paused.
+	SwapPlanPaused SwapPlanState = iota
+
+	// SwapPlanRunning indicates that the steps of a plan are dispatched
+	// once they are due.
+	SwapPlanRunning
+
+	// SwapPlanComplete indicates that all of the steps of a plan
+	// succeeded.
+	SwapPlanComplete
+
+	// SwapPlanFailed indicates that one of the steps of a plan failed.
+	SwapPlanFailed
+
+	// SwapPlanCancelled indicates that a plan was cancelled before it
+	// completed.
+	SwapPlanCancelled
+)
+
+// String returns a string representation of the plan state.
+func (s SwapPlanState) String() string {
+	switch s {
+	case SwapPlanPaused:
+		return "Paused"
+
+	case SwapPlanRunning:
+		return "Running"
+
+	case SwapPlanComplete:
+		return "Complete"
+
+	case SwapPlanFailed:
+		return "Failed"
+
+	case SwapPlanCancelled:
+		return "Cancelled"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// Final returns a boolean indicating whether a plan in this state will not
+// dispatch any further steps.
+func (s SwapPlanState) Final() bool {
+	return s == SwapPlanComplete || s == SwapPlanFailed ||
+		s == SwapPlanCancelled
+}
+
+// PlanStepState is the state of a single step of a swap plan.
+type PlanStepState uint8
+
+const (
+	// PlanStepPending indicates that the step's swap has not been
+	// dispatched yet.
+	PlanStepPending PlanStepState = iota
+
+	// PlanStepDispatched indicates that the step's swap was dispatched
+	// and has not reached a final state yet.
+	PlanStepDispatched
+
+	// PlanStepSucceeded indicates that the step's swap succeeded.
+	PlanStepSucceeded
+
+	// PlanStepFailed indicates that the step's swap failed.
+	PlanStepFailed
+)
+
+// String returns a string representation of the step state.
+func (s PlanStepState) String() string {
+	switch s {
+	case PlanStepPending:
+		return "Pending"
+
+	case PlanStepDispatched:
+		return "Dispatched"
+
+	case PlanStepSucceeded:
+		return "Succeeded"
+
+	case PlanStepFailed:
+		return "Failed"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// PlanStep is a single swap of a swap plan.
+type PlanStep struct {
+	// Amount is the amount of the step's swap.
+	Amount btcutil.Amount
+
+	// NotBefore is the earliest time that the step's swap may be
+	// dispatched.
+	NotBefore time.Time
+
+	// EstimatedFees is the fee that was quoted for the step's swap when
+	// the plan was created.
+	EstimatedFees btcutil.Amount
+
+	// State is the state of the step.
+	State PlanStepState
+
+	// SwapHash is the hash of the step's swap. It is set once the swap is
+	// dispatched.
+	SwapHash lntypes.Hash
+}
+
+// SwapPlan is a liquidity change that is split into a sequence of swaps
+// which are dispatched over time.
+type SwapPlan struct {
+	// ID is the unique id of the plan, which is assigned when the plan is
+	// stored.
+	ID uint64
+
+	// Type is the type of the plan's swaps.
+	Type swap.Type
+
+	// Amount is the total amount of the plan's swaps.
+	Amount btcutil.Amount
+
+	// MaxFees is the budget for the fees of all of the plan's swaps.
+	MaxFees btcutil.Amount
+
+	// OutgoingChanSet restricts the channels that loop out swaps may be
+	// paid over.
+	OutgoingChanSet ChannelSet
+
+	// LastHop is the optional last hop that loop in swaps are paid via.
+	LastHop *route.Vertex
+
+	// ConfTarget is the sweep confirmation target for loop out swaps, and
+	// the htlc confirmation target for loop in swaps.
+	ConfTarget int32
+
+	// Interval is the time between the dispatch of consecutive steps.
+	// Loop out swaps allow the server to delay the publication of their
+	// htlc by up to this interval.
+	Interval time.Duration
+
+	// Label is the label that the plan's swaps are created with.
+	Label string
+
+	// CreationTime is the time that the plan was created.
+	CreationTime time.Time
+
+	// State is the state of the plan.
+	State SwapPlanState
+
+	// Steps are the swaps of the plan, in the order that they are
+	// dispatched.
+	Steps []*PlanStep
+}
+
+// serializeSwapPlan serializes a swap plan. The id is stored as the plan's
+// key, so it is not included.
+func serializeSwapPlan(w io.Writer, plan *SwapPlan) error {
+	err := binary.Write(w, byteOrder, uint8(plan.Type))
+	if err != nil {
+		return err
+	}
+
+	for _, amt := range []btcutil.Amount{plan.Amount, plan.MaxFees} {
+		if err := binary.Write(w, byteOrder, amt); err != nil {
+			return err
+		}
+	}
+
+	err = wire.WriteVarInt(w, 0, uint64(len(plan.OutgoingChanSet)))
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range plan.OutgoingChanSet {
+		if err := binary.Write(w, byteOrder, chanID); err != nil {
+			return err
+		}
+	}
+
+	var lastHop []byte
+	if plan.LastHop != nil {
+		lastHop = plan.LastHop[:]
+	}
+	if err := wire.WriteVarBytes(w, 0, lastHop); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, plan.ConfTarget); err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, int64(plan.Interval))
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(w, 0, plan.Label); err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, plan.CreationTime.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, uint8(plan.State)); err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarInt(w, 0, uint64(len(plan.Steps))); err != nil {
+		return err
+	}
+
+	for _, step := range plan.Steps {
+		if err := serializePlanStep(w, step); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serializePlanStep serializes a single step of a swap plan.
+func serializePlanStep(w io.Writer, step *PlanStep) error {
+	if err := binary.Write(w, byteOrder, step.Amount); err != nil {
+		return err
+	}
+
+	err := binary.Write(w, byteOrder, step.NotBefore.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, step.EstimatedFees)
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, uint8(step.State)); err != nil {
+		return err
+	}
+
+	_, err = w.Write(step.SwapHash[:])
+	return err
+}
+
+// deserializeSwapPlan deserializes the swap plan stored under the id
+// provided.
+func deserializeSwapPlan(id uint64, r io.Reader) (*SwapPlan, error) {
+	plan := &SwapPlan{
+		ID: id,
+	}
+
+	var swapType uint8
+	if err := binary.Read(r, byteOrder, &swapType); err != nil {
+		return nil, err
+	}
+	plan.Type = swap.Type(swapType)
+
+	for _, amt := range []*btcutil.Amount{&plan.Amount, &plan.MaxFees} {
+		if err := binary.Read(r, byteOrder, amt); err != nil {
+			return nil, err
+		}
+	}
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		var chanID uint64
+		if err := binary.Read(r, byteOrder, &chanID); err != nil {
+			return nil, err
+		}
+
+		plan.OutgoingChanSet = append(plan.OutgoingChanSet, chanID)
+	}
+
+	lastHop, err := wire.ReadVarBytes(r, 0, route.VertexSize, "last hop")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lastHop) != 0 {
+		vertex, err := route.NewVertexFromBytes(lastHop)
+		if err != nil {
+			return nil, err
+		}
+		plan.LastHop = &vertex
+	}
+
+	if err := binary.Read(r, byteOrder, &plan.ConfTarget); err != nil {
+		return nil, err
+	}
+
+	var interval int64
+	if err := binary.Read(r, byteOrder, &interval); err != nil {
+		return nil, err
+	}
+	plan.Interval = time.Duration(interval)
+
+	plan.Label, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var created int64
+	if err := binary.Read(r, byteOrder, &created); err != nil {
+		return nil, err
+	}
+	plan.CreationTime = time.Unix(0, created)
+
+	var state uint8
+	if err := binary.Read(r, byteOrder, &state); err != nil {
+		return nil, err
+	}
+	plan.State = SwapPlanState(state)
+
+	count, err = wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		step, err := deserializePlanStep(r)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	return plan, nil
+}
+
+// deserializePlanStep deserializes a single step of a swap plan.
+func deserializePlanStep(r io.Reader) (*PlanStep, error) {
+	step := &PlanStep{}
+
+	if err := binary.Read(r, byteOrder, &step.Amount); err != nil {
+		return nil, err
+	}
+
+	var notBefore int64
+	if err := binary.Read(r, byteOrder, &notBefore); err != nil {
+		return nil, err
+	}
+	step.NotBefore = time.Unix(0, notBefore)
+
+	err := binary.Read(r, byteOrder, &step.EstimatedFees)
+	if err != nil {
+		return nil, err
+	}
+
+	var state uint8
+	if err := binary.Read(r, byteOrder, &state); err != nil {
+		return nil, err
+	}
+	step.State = PlanStepState(state)
+
+	if _, err := io.ReadFull(r, step.SwapHash[:]); err != nil {
+		return nil, err
+	}
+
+	return step, nil
+}
+
+// CreateSwapPlan stores a new swap plan, assigning it a unique id.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CreateSwapPlan(plan *SwapPlan) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(swapPlanBucketKey)
+		if err != nil {
+			return err
+		}
+
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeSwapPlan(&b, plan); err != nil {
+			return err
+		}
+
+		if err := bucket.Put(planKey(id), b.Bytes()); err != nil {
+			return err
+		}
+
+		plan.ID = id
+
+		return nil
+	})
+}
+
+// UpdateSwapPlan replaces the stored swap plan with the id of the plan
+// provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateSwapPlan(plan *SwapPlan) error {
+	var b bytes.Buffer
+	if err := serializeSwapPlan(&b, plan); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(swapPlanBucketKey)
+		if bucket == nil || bucket.Get(planKey(plan.ID)) == nil {
+			return ErrSwapPlanNotFound
+		}
+
+		return bucket.Put(planKey(plan.ID), b.Bytes())
+	})
+}
+
+// FetchSwapPlans returns all stored swap plans, ordered by id.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchSwapPlans() ([]*SwapPlan, error) {
+	var plans []*SwapPlan
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(swapPlanBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			plan, err := deserializeSwapPlan(
+				byteOrder.Uint64(k), bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			plans = append(plans, plan)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// planKey returns the key that the plan with the id provided is stored
+// under. Ids are stored big endian so that plans are ordered by id.
+func planKey(id uint64) []byte {
+	var key [8]byte
+	byteOrder.PutUint64(key[:], id)
+
+	return key[:]
+}
@@ -0,0 +1,92 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapPlans tests creating and updating swap plans.
+func TestSwapPlans(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	plans, err := store.FetchSwapPlans()
+	require.NoError(t, err)
+	require.Len(t, plans, 0)
+
+	created := time.Unix(0, 1000000)
+	lastHop := route.Vertex{2}
+
+	out := &SwapPlan{
+		Type:            swap.TypeOut,
+		Amount:          3000000,
+		MaxFees:         30000,
+		OutgoingChanSet: ChannelSet{1, 2},
+		ConfTarget:      50,
+		Interval:        time.Hour,
+		Label:           "rebalance",
+		CreationTime:    created,
+		Steps: []*PlanStep{
+			{
+				Amount:        1500000,
+				NotBefore:     created,
+				EstimatedFees: 9000,
+				State:         PlanStepSucceeded,
+				SwapHash:      lntypes.Hash{1},
+			},
+			{
+				Amount:        1500000,
+				NotBefore:     created.Add(time.Hour),
+				EstimatedFees: 9000,
+			},
+		},
+	}
+	require.NoError(t, store.CreateSwapPlan(out))
+	require.Equal(t, uint64(1), out.ID)
+
+	in := &SwapPlan{
+		Type:         swap.TypeIn,
+		Amount:       1000000,
+		LastHop:      &lastHop,
+		CreationTime: created,
+		Steps: []*PlanStep{
+			{
+				Amount:    1000000,
+				NotBefore: created,
+			},
+		},
+	}
+	require.NoError(t, store.CreateSwapPlan(in))
+	require.Equal(t, uint64(2), in.ID)
+
+	plans, err = store.FetchSwapPlans()
+	require.NoError(t, err)
+	require.Equal(t, []*SwapPlan{out, in}, plans)
+
+	// Updates replace the stored plan.
+	out.State = SwapPlanRunning
+	out.Steps[1].State = PlanStepDispatched
+	out.Steps[1].SwapHash = lntypes.Hash{2}
+	require.NoError(t, store.UpdateSwapPlan(out))
+
+	plans, err = store.FetchSwapPlans()
+	require.NoError(t, err)
+	require.Equal(t, []*SwapPlan{out, in}, plans)
+
+	// Plans that were never created cannot be updated.
+	err = store.UpdateSwapPlan(&SwapPlan{ID: 3})
+	require.Equal(t, ErrSwapPlanNotFound, err)
+}
@@ -0,0 +1,71 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+)
+
+// swapQuoteKey is the key that stores the fees that were quoted for a swap
+// when it was initiated. If a swap was created before we started storing
+// quotes, this key will not be present.
+//
+// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> swapQuoteKey
+//
+// value: swap fee || miner fee
+var swapQuoteKey = []byte("quote")
+
+// SwapQuote contains the fees that were quoted for a swap when it was
+// initiated, so that they can be compared to the fees that it realized.
+type SwapQuote struct {
+	// SwapFee is the fee that the server charges for the swap.
+	SwapFee btcutil.Amount
+
+	// MinerFee is the estimated on chain fee for the swap. It is zero if
+	// no estimate could be made, or if the swap does not publish any
+	// transactions of its own.
+	MinerFee btcutil.Amount
+}
+
+// putSwapQuote stores an optional swap quote in a swap bucket. If the quote is
+// nil, nothing is stored.
+func putSwapQuote(bucket *bbolt.Bucket, quote *SwapQuote) error {
+	if quote == nil {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := binary.Write(&b, byteOrder, quote.SwapFee); err != nil {
+		return err
+	}
+
+	if err := binary.Write(&b, byteOrder, quote.MinerFee); err != nil {
+		return err
+	}
+
+	return bucket.Put(swapQuoteKey, b.Bytes())
+}
+
+// getSwapQuote returns the swap quote stored in a swap bucket, or nil if no
+// quote is present.
+func getSwapQuote(bucket *bbolt.Bucket) (*SwapQuote, error) {
+	quoteBytes := bucket.Get(swapQuoteKey)
+	if quoteBytes == nil {
+		return nil, nil
+	}
+
+	var quote SwapQuote
+	r := bytes.NewReader(quoteBytes)
+
+	if err := binary.Read(r, byteOrder, &quote.SwapFee); err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &quote.MinerFee); err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
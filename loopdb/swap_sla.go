@@ -0,0 +1,78 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// swapSLAKey is the key that stores the server that a swap was made with and
+// the duration that the swap was expected to complete within. If a swap was
+// created before we started storing expectations, this key will not be
+// present.
+//
+// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> swapSLAKey
+//
+// value: expected duration in nanoseconds || varstring server
+var swapSLAKey = []byte("sla")
+
+// SwapSLA describes the completion time that we expected for a swap when it
+// was initiated, so that it can be compared to the time the swap took.
+type SwapSLA struct {
+	// Server is the address of the swap server that the swap was made
+	// with.
+	Server string
+
+	// ExpectedDuration is the amount of time after its initiation that
+	// we expected the swap to complete within.
+	ExpectedDuration time.Duration
+}
+
+// putSwapSLA stores an optional swap expectation in a swap bucket. If the
+// expectation is nil, nothing is stored.
+func putSwapSLA(bucket *bbolt.Bucket, sla *SwapSLA) error {
+	if sla == nil {
+		return nil
+	}
+
+	var b bytes.Buffer
+	err := binary.Write(&b, byteOrder, int64(sla.ExpectedDuration))
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(&b, 0, sla.Server); err != nil {
+		return err
+	}
+
+	return bucket.Put(swapSLAKey, b.Bytes())
+}
+
+// getSwapSLA returns the swap expectation stored in a swap bucket, or nil if
+// none is present.
+func getSwapSLA(bucket *bbolt.Bucket) (*SwapSLA, error) {
+	value := bucket.Get(swapSLAKey)
+	if value == nil {
+		return nil, nil
+	}
+
+	r := bytes.NewReader(value)
+
+	var expected int64
+	if err := binary.Read(r, byteOrder, &expected); err != nil {
+		return nil, err
+	}
+
+	server, err := wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SwapSLA{
+		Server:           server,
+		ExpectedDuration: time.Duration(expected),
+	}, nil
+}
@@ -0,0 +1,340 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// swapTemplateBucketKey is a bucket that contains named swap
+	// configurations which can be reused to dispatch swaps.
+	//
+	// maps: name -> template
+	swapTemplateBucketKey = []byte("swap-templates")
+
+	// ErrSwapTemplateNotFound is returned when a swap template that does
+	// not exist is deleted.
+	ErrSwapTemplateNotFound = errors.New("swap template not found")
+)
+
+// MaxSwapTemplateNameLength is the maximum length of a swap template's name.
+const MaxSwapTemplateNameLength = 64
+
+// SwapTemplate is a named set of swap parameters that can be used to dispatch
+// swaps without specifying them each time. Fee limits that are zero are not
+// set by the template, and should be obtained from a quote when the template
+// is used. A template either sets a fixed amount, or a target local balance
+// that the amount is calculated from each time the template is used.
+type SwapTemplate struct {
+	// Name is the unique name of the template.
+	Name string
+
+	// Type is the type of swap that the template dispatches.
+	Type swap.Type
+
+	// Amount is the amount of the swap. It is zero if the template sets a
+	// target local balance instead.
+	Amount btcutil.Amount
+
+	// DestAddr is the address that loop out swaps sweep to. If it is
+	// empty, the swap sweeps to our wallet.
+	DestAddr string
+
+	// MaxSwapFee is the maximum swap server fee that we accept.
+	MaxSwapFee btcutil.Amount
+
+	// MaxMinerFee is the maximum on-chain fee that we accept.
+	MaxMinerFee btcutil.Amount
+
+	// MaxPrepayAmount is the maximum prepay amount that we accept for
+	// loop out swaps.
+	MaxPrepayAmount btcutil.Amount
+
+	// MaxSwapRoutingFee is the maximum off-chain fee that we pay for the
+	// swap payment of loop out swaps.
+	MaxSwapRoutingFee btcutil.Amount
+
+	// MaxPrepayRoutingFee is the maximum off-chain fee that we pay for the
+	// prepay payment of loop out swaps.
+	MaxPrepayRoutingFee btcutil.Amount
+
+	// ConfTarget is the sweep confirmation target for loop out swaps, and
+	// the htlc confirmation target for loop in swaps.
+	ConfTarget int32
+
+	// HtlcConfirmations is the number of confirmations that we require
+	// for the server's htlc in loop out swaps.
+	HtlcConfirmations int32
+
+	// Fast indicates that loop out swaps should not allow the server to
+	// delay publishing the htlc.
+	Fast bool
+
+	// OutgoingChanSet restricts the channels that loop out swaps may be
+	// paid over.
+	OutgoingChanSet ChannelSet
+
+	// LastHop is the optional last hop that loop in swaps are paid via.
+	LastHop *route.Vertex
+
+	// ExternalHtlc indicates that the htlc of loop in swaps is published
+	// by an external wallet.
+	ExternalHtlc bool
+
+	// Label is the label that swaps are created with.
+	Label string
+
+	// TargetLocalPercent is the percentage of the balance of the
+	// template's channels that should be local after a swap. If it is
+	// non-zero, the amount of each swap is calculated from our channel
+	// balances when the template is used.
+	TargetLocalPercent uint32
+}
+
+// serializeSwapTemplate serializes a swap template. The name is stored as
+// the template's key, so it is not included.
+func serializeSwapTemplate(w io.Writer, template *SwapTemplate) error {
+	err := binary.Write(w, byteOrder, uint8(template.Type))
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, template.Amount)
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(w, 0, template.DestAddr); err != nil {
+		return err
+	}
+
+	limits := []btcutil.Amount{
+		template.MaxSwapFee, template.MaxMinerFee,
+		template.MaxPrepayAmount, template.MaxSwapRoutingFee,
+		template.MaxPrepayRoutingFee,
+	}
+	for _, limit := range limits {
+		if err := binary.Write(w, byteOrder, limit); err != nil {
+			return err
+		}
+	}
+
+	err = binary.Write(w, byteOrder, template.ConfTarget)
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, template.HtlcConfirmations)
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, template.Fast); err != nil {
+		return err
+	}
+
+	err = wire.WriteVarInt(w, 0, uint64(len(template.OutgoingChanSet)))
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range template.OutgoingChanSet {
+		if err := binary.Write(w, byteOrder, chanID); err != nil {
+			return err
+		}
+	}
+
+	var lastHop []byte
+	if template.LastHop != nil {
+		lastHop = template.LastHop[:]
+	}
+	if err := wire.WriteVarBytes(w, 0, lastHop); err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, template.ExternalHtlc)
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(w, 0, template.Label); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, template.TargetLocalPercent)
+}
+
+// deserializeSwapTemplate deserializes the swap template stored under the
+// name provided.
+func deserializeSwapTemplate(name string, r io.Reader) (*SwapTemplate,
+	error) {
+
+	template := &SwapTemplate{
+		Name: name,
+	}
+
+	var swapType uint8
+	if err := binary.Read(r, byteOrder, &swapType); err != nil {
+		return nil, err
+	}
+	template.Type = swap.Type(swapType)
+
+	err := binary.Read(r, byteOrder, &template.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	template.DestAddr, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := []*btcutil.Amount{
+		&template.MaxSwapFee, &template.MaxMinerFee,
+		&template.MaxPrepayAmount, &template.MaxSwapRoutingFee,
+		&template.MaxPrepayRoutingFee,
+	}
+	for _, limit := range limits {
+		if err := binary.Read(r, byteOrder, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	err = binary.Read(r, byteOrder, &template.ConfTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	err = binary.Read(r, byteOrder, &template.HtlcConfirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &template.Fast); err != nil {
+		return nil, err
+	}
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		var chanID uint64
+		if err := binary.Read(r, byteOrder, &chanID); err != nil {
+			return nil, err
+		}
+
+		template.OutgoingChanSet = append(
+			template.OutgoingChanSet, chanID,
+		)
+	}
+
+	lastHop, err := wire.ReadVarBytes(r, 0, route.VertexSize, "last hop")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lastHop) != 0 {
+		vertex, err := route.NewVertexFromBytes(lastHop)
+		if err != nil {
+			return nil, err
+		}
+		template.LastHop = &vertex
+	}
+
+	err = binary.Read(r, byteOrder, &template.ExternalHtlc)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Label, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Templates that were stored before target local balances were added
+	// end after their label.
+	err = binary.Read(r, byteOrder, &template.TargetLocalPercent)
+	switch err {
+	case nil, io.EOF:
+		return template, nil
+
+	default:
+		return nil, err
+	}
+}
+
+// PutSwapTemplate stores a swap template, replacing any existing template
+// with the same name.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) PutSwapTemplate(template *SwapTemplate) error {
+	var b bytes.Buffer
+	if err := serializeSwapTemplate(&b, template); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(swapTemplateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(template.Name), b.Bytes())
+	})
+}
+
+// FetchSwapTemplates returns all stored swap templates, ordered by name.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchSwapTemplates() ([]*SwapTemplate, error) {
+	var templates []*SwapTemplate
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(swapTemplateBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			template, err := deserializeSwapTemplate(
+				string(k), bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			templates = append(templates, template)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// DeleteSwapTemplate deletes the swap template with the name provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) DeleteSwapTemplate(name string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(swapTemplateBucketKey)
+		if bucket == nil || bucket.Get([]byte(name)) == nil {
+			return ErrSwapTemplateNotFound
+		}
+
+		return bucket.Delete([]byte(name))
+	})
+}
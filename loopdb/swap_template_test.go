@@ -0,0 +1,105 @@
+package loopdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapTemplates tests storing, replacing and deleting swap templates.
+func TestSwapTemplates(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+	defer store.Close()
+
+	templates, err := store.FetchSwapTemplates()
+	require.NoError(t, err)
+	require.Len(t, templates, 0)
+
+	lastHop := route.Vertex{2}
+	loopIn := &SwapTemplate{
+		Name:         "inbound",
+		Type:         swap.TypeIn,
+		Amount:       200000,
+		MaxSwapFee:   1000,
+		MaxMinerFee:  2000,
+		ConfTarget:   6,
+		LastHop:      &lastHop,
+		ExternalHtlc: true,
+		Label:        "in label",
+	}
+
+	loopOut := &SwapTemplate{
+		Name:                "coldstore",
+		Type:                swap.TypeOut,
+		TargetLocalPercent:  40,
+		DestAddr:            "tb1qaddr",
+		MaxSwapRoutingFee:   30,
+		MaxPrepayRoutingFee: 40,
+		ConfTarget:          9,
+		HtlcConfirmations:   2,
+		Fast:                true,
+		OutgoingChanSet:     ChannelSet{1, 2},
+	}
+
+	require.NoError(t, store.PutSwapTemplate(loopIn))
+	require.NoError(t, store.PutSwapTemplate(loopOut))
+
+	// Templates are returned ordered by name.
+	templates, err = store.FetchSwapTemplates()
+	require.NoError(t, err)
+	require.Equal(t, []*SwapTemplate{loopOut, loopIn}, templates)
+
+	// Storing a template with an existing name should replace it.
+	updated := *loopOut
+	updated.Amount = 150000
+	updated.TargetLocalPercent = 0
+	updated.OutgoingChanSet = nil
+	require.NoError(t, store.PutSwapTemplate(&updated))
+
+	templates, err = store.FetchSwapTemplates()
+	require.NoError(t, err)
+	require.Equal(t, []*SwapTemplate{&updated, loopIn}, templates)
+
+	require.NoError(t, store.DeleteSwapTemplate(loopIn.Name))
+	require.Equal(
+		t, ErrSwapTemplateNotFound, store.DeleteSwapTemplate(loopIn.Name),
+	)
+
+	templates, err = store.FetchSwapTemplates()
+	require.NoError(t, err)
+	require.Equal(t, []*SwapTemplate{&updated}, templates)
+}
+
+// TestSwapTemplateWithoutTarget tests that templates that were stored before
+// target local balances were added can still be read.
+func TestSwapTemplateWithoutTarget(t *testing.T) {
+	template := &SwapTemplate{
+		Name:   "legacy",
+		Type:   swap.TypeOut,
+		Amount: 100000,
+		Label:  "label",
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, serializeSwapTemplate(&b, template))
+
+	// Strip the target local percentage that is appended after the label.
+	legacy := b.Bytes()[:b.Len()-4]
+
+	read, err := deserializeSwapTemplate(
+		template.Name, bytes.NewReader(legacy),
+	)
+	require.NoError(t, err)
+	require.Equal(t, template, read)
+}
@@ -166,4 +166,9 @@ type SwapStateData struct {
 
 	// HtlcTxHash is the tx id of the confirmed htlc.
 	HtlcTxHash *chainhash.Hash
+
+	// Prepay is the outcome of a loop out swap's prepay payment. It is nil
+	// for loop in swaps, and for loop out swaps whose prepay payment has
+	// not yet completed.
+	Prepay *PrepayPayment
 }
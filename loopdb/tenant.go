@@ -0,0 +1,34 @@
+package loopdb
+
+import (
+	"github.com/coreos/bbolt"
+)
+
+// tenantKey is the key that stores the identity of the tenant that created a
+// swap when loopd runs in multi-tenant mode. Swaps that were created outside
+// of that mode, or by the daemon's operator, do not have this key.
+//
+// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> tenantKey
+//
+// value: string tenant identity
+var tenantKey = []byte("tenant")
+
+// putTenant writes the tenant provided to the bucket if it is non-empty.
+func putTenant(bucket *bbolt.Bucket, tenant string) error {
+	if tenant == "" {
+		return nil
+	}
+
+	return bucket.Put(tenantKey, []byte(tenant))
+}
+
+// getTenant returns the tenant stored in a bucket, or an empty string if no
+// tenant is present.
+func getTenant(bucket *bbolt.Bucket) string {
+	tenant := bucket.Get(tenantKey)
+	if tenant == nil {
+		return ""
+	}
+
+	return string(tenant)
+}
@@ -17,6 +17,9 @@ import (
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/swapfsm"
+	"github.com/lightninglabs/loop/sweep"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
@@ -71,15 +74,43 @@ type loopInSwap struct {
 
 // loopInInitResult contains information about a just-initiated loop in swap.
 type loopInInitResult struct {
-	swap          *loopInSwap
-	serverMessage string
+	swap              *loopInSwap
+	serverMessage     string
+	structuredMessage *loopdb.ServerMessage
+
+	// swapFee is the fee charged by the server for the swap.
+	swapFee btcutil.Amount
 }
 
-// newLoopInSwap initiates a new loop in swap.
+// newLoopInSwap initiates a new loop in swap and persists it to our store.
 func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	currentHeight int32, request *LoopInRequest) (*loopInInitResult,
 	error) {
 
+	initResult, err := initLoopInSwap(
+		globalCtx, cfg, currentHeight, request,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := initResult.swap.persist(); err != nil {
+		return nil, err
+	}
+
+	return initResult, nil
+}
+
+// initLoopInSwap initiates a new loop in swap without persisting it. The
+// caller must call persist before the swap is executed.
+func initLoopInSwap(globalCtx context.Context, cfg *swapConfig,
+	currentHeight int32, request *LoopInRequest) (*loopInInitResult,
+	error) {
+
+	if err := cfg.amountTiers.CheckLoopInFees(request); err != nil {
+		return nil, err
+	}
+
 	// Request current server loop in terms and use these to calculate the
 	// swap fee that we should subtract from the swap amount in the payment
 	// request that we send to the server. We pass nil as optional route
@@ -116,6 +147,13 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	}
 	swapHash := lntypes.Hash(sha256.Sum256(swapPreimage[:]))
 
+	globalCtx, span := tracing.Start(
+		tracing.WithTrace(globalCtx, tracing.SwapTraceID(swapHash)),
+		"initiate loop in",
+	)
+	span.SetAttribute("swap.hash", swapHash.String())
+	defer span.End()
+
 	// Derive a sender key for this swap.
 	keyDesc, err := cfg.lnd.WalletKit.DeriveNextKey(
 		globalCtx, swap.KeyFamily,
@@ -190,7 +228,10 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 
 	// Validate the response parameters the prevent us continuing with a
 	// swap that is based on parameters outside our allowed range.
-	err = validateLoopInContract(cfg.lnd, currentHeight, request, swapResp)
+	err = validateLoopInContract(
+		cfg.validator, currentHeight, request, swapHash, senderKey,
+		swapResp,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +244,7 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 		HtlcConfTarget: request.HtlcConfTarget,
 		LastHop:        request.LastHop,
 		ExternalHtlc:   request.ExternalHtlc,
+		HtlcFunding:    request.HtlcFunding,
 		SwapContract: loopdb.SwapContract{
 			InitiationHeight: currentHeight,
 			InitiationTime:   initiationTime,
@@ -215,6 +257,9 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 			MaxSwapFee:       request.MaxSwapFee,
 			Label:            request.Label,
 			ProtocolVersion:  loopdb.CurrentInternalProtocolVersion,
+			ServerMessage:    swapResp.structuredMessage,
+			RequestID:        request.RequestID,
+			Tenant:           request.Tenant,
 		},
 	}
 
@@ -225,6 +270,36 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 
 	swapKit.lastUpdateTime = initiationTime
 
+	// Record the fees that we expect to pay for this swap, so that they
+	// can be compared to the fees that it realizes once it completes.
+	// External htlcs are not published by us, so we have no miner fee to
+	// estimate. The quote is informational, so we only log estimation
+	// errors.
+	contract.Quote = &loopdb.SwapQuote{
+		SwapFee: swapFee,
+	}
+
+	if !request.ExternalHtlc {
+		minerFee, err := cfg.lnd.Client.EstimateFeeToP2WSH(
+			globalCtx, request.Amount, request.HtlcConfTarget,
+		)
+		if err != nil {
+			swapKit.log.Warnf("Could not estimate htlc fee: %v",
+				err)
+		}
+
+		contract.Quote.MinerFee = minerFee
+	}
+
+	// Record the time that we expect the swap to complete within, so
+	// that slow swaps can be detected.
+	contract.SLA = &loopdb.SwapSLA{
+		Server: cfg.serverAddress,
+		ExpectedDuration: expectedLoopInDuration(
+			request.HtlcConfTarget, request.ExternalHtlc,
+		),
+	}
+
 	swap := &loopInSwap{
 		LoopInContract: contract,
 		swapKit:        *swapKit,
@@ -234,23 +309,35 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 		return nil, err
 	}
 
-	// Persist the data before exiting this function, so that the caller can
-	// trust that this swap will be resumed on restart.
-	err = cfg.store.CreateLoopIn(swapHash, &swap.LoopInContract)
-	if err != nil {
-		return nil, fmt.Errorf("cannot store swap: %v", err)
-	}
-
 	if swapResp.serverMessage != "" {
 		swap.log.Infof("Server message: %v", swapResp.serverMessage)
 	}
 
+	if swapResp.structuredMessage != nil {
+		swap.log.Infof("Server message code: %v, params: %v",
+			swapResp.structuredMessage.Code,
+			swapResp.structuredMessage.Params)
+	}
+
 	return &loopInInitResult{
-		swap:          swap,
-		serverMessage: swapResp.serverMessage,
+		swap:              swap,
+		serverMessage:     swapResp.serverMessage,
+		structuredMessage: swapResp.structuredMessage,
+		swapFee:           swapFee,
 	}, nil
 }
 
+// persist stores the swap's contract, so that it will be resumed on restart.
+// The swap must be persisted before it is executed.
+func (s *loopInSwap) persist() error {
+	err := s.store.CreateLoopIn(s.hash, &s.LoopInContract)
+	if err != nil {
+		return fmt.Errorf("cannot store swap: %v", err)
+	}
+
+	return nil
+}
+
 // awaitProbe waits for a probe payment to arrive and cancels it. This is a
 // workaround for the current lack of multi-path probing.
 func awaitProbe(ctx context.Context, lnd lndclient.LndServices,
@@ -345,6 +432,7 @@ func resumeLoopInSwap(_ context.Context, cfg *swapConfig,
 		swap.lastUpdateTime = pend.Contract.InitiationTime
 	} else {
 		swap.state = lastUpdate.State
+		swap.log.SetState(lastUpdate.State)
 		swap.lastUpdateTime = lastUpdate.Time
 		swap.htlcTxHash = lastUpdate.HtlcTxHash
 		swap.cost = lastUpdate.Cost
@@ -354,10 +442,10 @@ func resumeLoopInSwap(_ context.Context, cfg *swapConfig,
 }
 
 // validateLoopInContract validates the contract parameters against our
-// request.
-func validateLoopInContract(lnd *lndclient.LndServices,
-	height int32,
-	request *LoopInRequest,
+// request. Parameters that prevent the swap from completing safely always
+// fail the swap, while anomalies are handled by the validator provided.
+func validateLoopInContract(validator *serverValidator, height int32,
+	request *LoopInRequest, swapHash lntypes.Hash, senderKey [33]byte,
 	response *newLoopInResponse) error {
 
 	// Verify that we are not forced to publish an htlc that locks up our
@@ -366,6 +454,35 @@ func validateLoopInContract(lnd *lndclient.LndServices,
 		return ErrExpiryTooFar
 	}
 
+	// An htlc that expires before we are allowed to publish it can't be
+	// used for the swap.
+	if response.expiry-height < MinLoopInPublishDelta {
+		return invalidParam(ParamHtlcExpiry, "%v too close to "+
+			"current height %v", response.expiry, height)
+	}
+
+	// The server's htlc key must be usable in our htlc script.
+	err := validateServerKey(response.receiverKey, senderKey)
+	if err != nil {
+		return err
+	}
+
+	// If the htlc is unlikely to confirm before it can no longer be
+	// published, the swap will likely fail.
+	confTarget := request.HtlcConfTarget
+	if confTarget == 0 {
+		confTarget = DefaultHtlcConfTarget
+	}
+
+	minExpiry := height + confTarget + MinLoopInPublishDelta
+	if response.expiry < minExpiry {
+		return validator.anomaly(swapHash, anomalousParam(
+			ParamHtlcExpiry, "%v leaves too little time to "+
+				"confirm htlc, minimum %v", response.expiry,
+			minExpiry,
+		))
+	}
+
 	return nil
 }
 
@@ -400,6 +517,11 @@ func (s *loopInSwap) sendUpdate(ctx context.Context) error {
 	info.HtlcAddressP2WSH = s.htlcP2WSH.Address
 	info.HtlcAddressNP2WSH = s.htlcNP2WSH.Address
 	info.ExternalHtlc = s.ExternalHtlc
+	info.LastHop = s.LastHop
+
+	s.log.SetState(s.state)
+	s.traceState(ctx)
+	s.subscriptions.notify(*info)
 
 	select {
 	case s.statusChan <- *info:
@@ -420,6 +542,12 @@ func (s *loopInSwap) execute(mainCtx context.Context,
 	s.executeConfig = *cfg
 	s.height = height
 
+	var err error
+	mainCtx, endTrace := s.startTrace(mainCtx)
+	defer func() {
+		endTrace(err)
+	}()
+
 	// Create context for our state subscription which we will cancel once
 	// swap execution has completed, ensuring that we kill the subscribe
 	// goroutine.
@@ -435,7 +563,7 @@ func (s *loopInSwap) execute(mainCtx context.Context,
 	}()
 
 	// Announce swap by sending out an initial update.
-	err := s.sendUpdate(mainCtx)
+	err = s.sendUpdate(mainCtx)
 	if err != nil {
 		return err
 	}
@@ -456,7 +584,10 @@ func (s *loopInSwap) execute(mainCtx context.Context,
 	// permanently and losing funds.
 	if err != nil {
 		s.log.Errorf("Swap error: %v", err)
-		s.setState(loopdb.StateFailTemporary)
+
+		// A temporary failure may occur in any state, so we don't
+		// need to check the transition.
+		_ = s.fire(swapfsm.EventTemporaryFailure)
 
 		// If we cannot send out this update, there is nothing we can do.
 		_ = s.sendUpdate(mainCtx)
@@ -487,7 +618,11 @@ func (s *loopInSwap) executeSwap(globalCtx context.Context) error {
 			// If an external htlc was indicated, we can move to the
 			// HtlcPublished state directly and wait for
 			// confirmation.
-			s.setState(loopdb.StateHtlcPublished)
+			err = s.fire(swapfsm.EventHtlcPublished)
+			if err != nil {
+				return err
+			}
+
 			err = s.persistAndAnnounceState(globalCtx)
 			if err != nil {
 				return err
@@ -503,6 +638,9 @@ func (s *loopInSwap) executeSwap(globalCtx context.Context) error {
 		}
 	}
 
+	// The tx that pays an external htlc is unknown until it confirms.
+	htlcTxKnown := s.htlcTxHash != nil
+
 	// Wait for the htlc to confirm. After a restart this will pick up a
 	// previously published tx.
 	conf, err := s.waitForHtlcConf(globalCtx)
@@ -510,6 +648,14 @@ func (s *loopInSwap) executeSwap(globalCtx context.Context) error {
 		return err
 	}
 
+	// Our htlc paid the on chain costs that we recorded when we published
+	// it.
+	if !s.ExternalHtlc {
+		s.recordRealizedFee(
+			loopdb.FeeDecisionHtlc, conf.Tx, s.cost.Onchain,
+		)
+	}
+
 	// Determine the htlc outpoint by inspecting the htlc tx.
 	htlcOutpoint, htlcValue, err := swap.GetScriptOutput(
 		conf.Tx, s.htlc.PkScript,
@@ -521,10 +667,23 @@ func (s *loopInSwap) executeSwap(globalCtx context.Context) error {
 	// Verify that the confirmed (external) htlc value matches the swap
 	// amount. Otherwise fail the swap immediately.
 	if htlcValue != s.LoopInContract.AmountRequested {
-		s.setState(loopdb.StateFailIncorrectHtlcAmt)
+		err = s.fire(swapfsm.EventHtlcValueMismatch)
+		if err != nil {
+			return err
+		}
+
 		return s.persistAndAnnounceState(globalCtx)
 	}
 
+	// Persist and announce the tx of an external htlc once it confirms,
+	// so that it is known after a restart and clients can see that the
+	// swap was funded.
+	if s.ExternalHtlc && !htlcTxKnown {
+		if err := s.persistAndAnnounceState(globalCtx); err != nil {
+			return err
+		}
+	}
+
 	// The server is expected to see the htlc on-chain and knowing that it
 	// can sweep that htlc with the preimage, it should pay our swap
 	// invoice, receive the preimage and sweep the htlc. We are waiting for
@@ -632,12 +791,22 @@ func (s *loopInSwap) waitForHtlcConf(globalCtx context.Context) (
 func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 	var err error
 
+	ctx, span := tracing.Start(ctx, "publish htlc")
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
 	blocksRemaining := s.CltvExpiry - s.height
 	s.log.Infof("Blocks left until on-chain expiry: %v", blocksRemaining)
 
 	// Verify whether it still makes sense to publish the htlc.
 	if blocksRemaining < MinLoopInPublishDelta {
-		s.setState(loopdb.StateFailTimeout)
+		err = s.fire(swapfsm.EventExpired)
+		if err != nil {
+			return false, err
+		}
+
 		return false, s.persistAndAnnounceState(ctx)
 	}
 
@@ -651,7 +820,11 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 
 	// Transition to state HtlcPublished before calling SendOutputs to
 	// prevent us from ever paying multiple times after a crash.
-	s.setState(loopdb.StateHtlcPublished)
+	err = s.fire(swapfsm.EventHtlcPublished)
+	if err != nil {
+		return false, err
+	}
+
 	err = s.persistAndAnnounceState(ctx)
 	if err != nil {
 		return false, err
@@ -660,19 +833,20 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 	s.log.Infof("Publishing on chain HTLC with fee rate %v", feeRate)
 
 	// Internal loop-in is always P2WSH.
-	tx, err := s.lnd.WalletKit.SendOutputs(
-		ctx, []*wire.TxOut{{
-			PkScript: s.htlcP2WSH.PkScript,
-			Value:    int64(s.LoopInContract.AmountRequested),
-		}}, feeRate, labels.LoopInHtlcLabel(swap.ShortHash(&s.hash)),
-	)
+	var tx *wire.MsgTx
+	tx, err = s.fundHtlc(ctx, feeRate)
 	if err != nil {
-		return false, fmt.Errorf("send outputs: %v", err)
+		return false, err
 	}
 
 	txHash := tx.TxHash()
 	fee := getTxFee(tx, feeRate.FeePerKVByte())
 
+	s.recordFee(
+		loopdb.FeeDecisionHtlc, s.LoopInContract.HtlcConfTarget,
+		feeRate, fee, true,
+	)
+
 	s.log.Infof("Published on chain HTLC tx %v, fee: %v", txHash, fee)
 
 	// Persist the htlc hash so that after a restart we are still waiting
@@ -697,6 +871,54 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 
 }
 
+// fundHtlc funds and publishes our htlc from lnd's wallet. The funding of our
+// htlc is serialized with the htlcs of other swaps, so that they do not compete
+// for the same coins.
+func (s *loopInSwap) fundHtlc(ctx context.Context,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+
+	if s.walletLock != nil {
+		s.walletLock.Lock()
+		defer s.walletLock.Unlock()
+	}
+
+	label := labels.LoopInHtlcLabel(swap.ShortHash(&s.hash))
+
+	if s.HtlcFunding != nil {
+		// We never fall back to funding our htlc with any of lnd's
+		// coins, because that could link the coins that our funding
+		// restrictions are meant to keep separate.
+		if s.htlcFunder == nil {
+			return nil, ErrNoHtlcFunder
+		}
+
+		s.log.Infof("Funding HTLC from account %q with %v outpoints",
+			s.HtlcFunding.Account, len(s.HtlcFunding.Outpoints))
+
+		tx, err := s.htlcFunder.FundHtlc(
+			ctx, s.htlcP2WSH.Address, s.AmountRequested, feeRate,
+			s.HtlcFunding, label,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("fund htlc: %v", err)
+		}
+
+		return tx, nil
+	}
+
+	tx, err := s.actions.SendOutputs(
+		ctx, []*wire.TxOut{{
+			PkScript: s.htlcP2WSH.PkScript,
+			Value:    int64(s.LoopInContract.AmountRequested),
+		}}, feeRate, label,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("send outputs: %v", err)
+	}
+
+	return tx, nil
+}
+
 // getTxFee calculates our fee for a transaction that we have broadcast. We use
 // sat per kvbyte because this is what lnd uses, and we will run into rounding
 // issues if we do not use the same fee rate as lnd.
@@ -764,11 +986,17 @@ func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
 		case notification := <-s.blockEpochChan:
 			s.height = notification.(int32)
 
-			sweepFee, err = checkTimeout()
+			fee, err := checkTimeout()
 			if err != nil {
 				return err
 			}
 
+			// If we did not publish a timeout tx at this height,
+			// the fee of the last one we published still applies.
+			if fee != 0 {
+				sweepFee = fee
+			}
+
 		// The htlc spend is confirmed. Inspect the spending tx to
 		// determine the final swap state.
 		case spendDetails := <-spendChan:
@@ -825,8 +1053,14 @@ func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
 				// but still incomplete with regards to
 				// accounting data.
 				if s.state == loopdb.StateHtlcPublished {
-					s.setState(loopdb.StateInvoiceSettled)
-					err := s.persistAndAnnounceState(ctx)
+					err := s.fire(
+						swapfsm.EventInvoiceSettled,
+					)
+					if err != nil {
+						return err
+					}
+
+					err = s.persistAndAnnounceState(ctx)
 					if err != nil {
 						return err
 					}
@@ -857,23 +1091,39 @@ func (s *loopInSwap) processHtlcSpend(ctx context.Context,
 	htlcInput := spend.SpendingTx.TxIn[spend.SpenderInputIndex]
 
 	if s.htlc.IsSuccessWitness(htlcInput.Witness) {
-		s.setState(loopdb.StateSuccess)
+		if err := s.fire(swapfsm.EventHtlcSuccess); err != nil {
+			return err
+		}
 
 		// Server swept the htlc. The htlc value can be added to the
 		// server cost balance.
 		s.cost.Server += htlcValue
 	} else {
+		if err := s.fire(swapfsm.EventHtlcTimeout); err != nil {
+			return err
+		}
+
 		// We needed another on chain tx to sweep the timeout clause,
 		// which we now include in our costs.
 		s.cost.Onchain += sweepFee
-		s.setState(loopdb.StateFailTimeout)
+
+		// Our timeout tx only spends the htlc, so it pays the part of
+		// the htlc's value that it does not sweep.
+		timeoutFee := htlcValue
+		for _, txOut := range spend.SpendingTx.TxOut {
+			timeoutFee -= btcutil.Amount(txOut.Value)
+		}
+
+		s.recordRealizedFee(
+			loopdb.FeeDecisionSweep, spend.SpendingTx, timeoutFee,
+		)
 
 		// Now that the timeout tx confirmed, we can safely cancel the
 		// swap invoice. We still need to query the final invoice state.
 		// This is not a hodl invoice, so it may be that the invoice was
 		// already settled. This means that the server didn't succeed in
 		// sweeping the htlc after paying the invoice.
-		err := s.lnd.Invoices.CancelInvoice(ctx, s.hash)
+		err := s.actions.CancelInvoice(ctx, s.hash)
 		if err != nil && err != channeldb.ErrInvoiceAlreadySettled {
 			return err
 		}
@@ -899,7 +1149,7 @@ func (s *loopInSwap) publishTimeoutTx(ctx context.Context,
 	}
 
 	// Calculate sweep tx fee
-	fee, err := s.sweeper.GetSweepFee(
+	fee, feeRate, err := s.sweeper.EstimateSweepFee(
 		ctx, s.htlc.AddTimeoutToEstimator, s.timeoutAddr,
 		TimeoutTxConfTarget,
 	)
@@ -920,11 +1170,32 @@ func (s *loopInSwap) publishTimeoutTx(ctx context.Context,
 		return 0, err
 	}
 
+	// If our refund is not worth creating at the current fee rate, we
+	// wait for fees to drop. The htlc can only be swept by the server
+	// with our preimage, which it never obtained for a timed out swap.
+	err = s.sweeper.CheckOutput(timeoutTx, fee, s.timeoutAddr)
+	if _, ok := err.(*sweep.ErrUneconomicalOutput); ok {
+		s.log.Warnf("Not publishing timeout tx: %v", err)
+		s.recordFee(
+			loopdb.FeeDecisionSweep, TimeoutTxConfTarget, feeRate,
+			fee, false,
+		)
+
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	s.recordFee(
+		loopdb.FeeDecisionSweep, TimeoutTxConfTarget, feeRate, fee, true,
+	)
+
 	timeoutTxHash := timeoutTx.TxHash()
 	s.log.Infof("Publishing timeout tx %v with fee %v to addr %v",
 		timeoutTxHash, fee, s.timeoutAddr)
 
-	err = s.lnd.WalletKit.PublishTransaction(
+	err = s.actions.PublishTx(
 		ctx, timeoutTx,
 		labels.LoopInSweepTimeout(swap.ShortHash(&s.hash)),
 	)
@@ -959,8 +1230,17 @@ func (s *loopInSwap) persistState() error {
 	)
 }
 
-// setState updates the swap state and last update timestamp.
-func (s *loopInSwap) setState(state loopdb.SwapState) {
+// fire advances the swap's state with the event provided and updates its last
+// update timestamp. An error is returned if the event is not expected in the
+// swap's current state.
+func (s *loopInSwap) fire(event swapfsm.Event) error {
+	state, err := swapfsm.LoopIn.Step(s.state, event)
+	if err != nil {
+		return err
+	}
+
 	s.lastUpdateTime = time.Now()
 	s.state = state
+
+	return nil
 }
@@ -245,6 +245,14 @@ func testLoopInTimeout(t *testing.T,
 		return
 	}
 
+	// Expect the tx of an external htlc to be written once it confirms.
+	if externalValue != 0 {
+		ctx.assertState(loopdb.StateHtlcPublished)
+		state := ctx.store.assertLoopInState(loopdb.StateHtlcPublished)
+		require.NotNil(t, state.HtlcTxHash)
+		require.Equal(t, htlcTx.TxHash(), *state.HtlcTxHash)
+	}
+
 	// Client starts listening for spend of htlc.
 	<-ctx.lnd.RegisterSpendChannel
 
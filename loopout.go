@@ -17,11 +17,14 @@ import (
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/swapfsm"
 	"github.com/lightninglabs/loop/sweep"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -56,6 +59,10 @@ var (
 	// paymentTimeout is the timeout for the loop out payment loop as
 	// communicated to lnd.
 	paymentTimeout = time.Minute * 30
+
+	// minPrepayTimeout is the shortest timeout that we give lnd to pay a
+	// prepay invoice, even if the swap's publication deadline is sooner.
+	minPrepayTimeout = time.Minute
 )
 
 // loopOutSwap contains all the in-memory state related to a pending loop out
@@ -75,6 +82,10 @@ type loopOutSwap struct {
 	swapPaymentChan chan paymentResult
 	prePaymentChan  chan paymentResult
 
+	// prepay is the outcome of our prepay payment, or nil if it has not
+	// yet completed.
+	prepay *loopdb.PrepayPayment
+
 	wg sync.WaitGroup
 }
 
@@ -86,19 +97,92 @@ type executeConfig struct {
 	timerFactory    func(d time.Duration) <-chan time.Time
 	loopOutMaxParts uint32
 	cancelSwap      func(context.Context, *outCancelDetails) error
+	routingHints    *routingHints
+
+	// sweepDelay returns a random delay that is applied before our first
+	// sweep attempt. If nil, we sweep without delay.
+	sweepDelay func() (time.Duration, error)
+
+	// htlcFunder funds loop in htlcs with specific coins from lnd's
+	// wallet. If nil, htlcs with funding restrictions cannot be published.
+	htlcFunder HtlcFunder
+
+	// payer dispatches swap payments that are paid with AMP, carry
+	// custom records or are paced. If nil, all payments are regular
+	// payments.
+	payer Payer
+
+	// ampPrepay pays prepay invoices with AMP if the server signals
+	// support for it.
+	ampPrepay bool
+
+	// paymentPacing limits the size and rate of the shards of our
+	// payments. If nil, shards are not limited.
+	paymentPacing *PaymentPacing
+
+	// subscriptions receives the swap's updates for callers within the
+	// client that wait for the swap. If nil, updates are only sent on the
+	// status channel.
+	subscriptions *swapSubscriptions
+
+	// sweepPreviews receives requests for previews of the swap's sweep
+	// while it waits to sweep. If nil, sweeps cannot be previewed.
+	sweepPreviews *sweepPreviews
+
+	// walletLock serializes the funding of htlcs from lnd's wallet, so
+	// that swaps which publish their htlcs concurrently, for example when
+	// they are resumed, do not compete for the same coins. If nil, htlc
+	// funding is not serialized.
+	walletLock *sync.Mutex
 }
 
 // loopOutInitResult contains information about a just-initiated loop out swap.
 type loopOutInitResult struct {
-	swap          *loopOutSwap
-	serverMessage string
+	swap              *loopOutSwap
+	serverMessage     string
+	structuredMessage *loopdb.ServerMessage
+
+	// swapFee is the fee charged by the server for the swap.
+	swapFee btcutil.Amount
+
+	// prepayAmount is the amount of the prepay invoice.
+	prepayAmount btcutil.Amount
 }
 
 // newLoopOutSwap initiates a new swap with the server and returns a
-// corresponding swap object.
+// corresponding swap object, which has been persisted to our store.
 func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 	currentHeight int32, request *OutRequest) (*loopOutInitResult, error) {
 
+	initResult, err := initLoopOutSwap(
+		globalCtx, cfg, currentHeight, request,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := initResult.swap.persist(); err != nil {
+		return nil, err
+	}
+
+	return initResult, nil
+}
+
+// initLoopOutSwap initiates a new swap with the server and returns a
+// corresponding swap object. The swap is not persisted, so the caller must
+// call persist before the swap is executed.
+func initLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
+	currentHeight int32, request *OutRequest) (*loopOutInitResult, error) {
+
+	// Apply the confirmation requirements of the swap's amount tier and
+	// check its fee limits before we contact the server. The tiered
+	// parameters are recorded in the swap's contract, so that the swap
+	// keeps to them if our tiers change before it completes.
+	if err := cfg.amountTiers.CheckLoopOutFees(request); err != nil {
+		return nil, err
+	}
+	request = cfg.amountTiers.ApplyLoopOut(request)
+
 	// Generate random preimage.
 	var swapPreimage [32]byte
 	if _, err := rand.Read(swapPreimage[:]); err != nil {
@@ -106,6 +190,13 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 	}
 	swapHash := lntypes.Hash(sha256.Sum256(swapPreimage[:]))
 
+	globalCtx, span := tracing.Start(
+		tracing.WithTrace(globalCtx, tracing.SwapTraceID(swapHash)),
+		"initiate loop out",
+	)
+	span.SetAttribute("swap.hash", swapHash.String())
+	defer span.End()
+
 	// Derive a receiver key for this swap.
 	keyDesc, err := cfg.lnd.WalletKit.DeriveNextKey(
 		globalCtx, swap.KeyFamily,
@@ -126,13 +217,26 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 	swapResp, err := cfg.server.NewLoopOutSwap(
 		globalCtx, swapHash, request.Amount, request.Expiry,
 		receiverKey, request.SwapPublicationDeadline, request.Initiator,
+		cfg.paymentRecords,
 	)
 	if err != nil {
 		return nil, wrapGrpcError("cannot initiate swap", err)
 	}
 
-	err = validateLoopOutContract(
-		cfg.lnd, currentHeight, request, swapHash, swapResp,
+	swapFee, prepayAmount, err := validateLoopOutContract(
+		cfg.lnd, cfg.validator, currentHeight, request, swapHash,
+		receiverKey, swapResp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the custom records that the server requested for our payments
+	// that we know how to attach. They are stored with the swap, so that
+	// we send the same records if the swap is resumed.
+	records, err := filterPaymentRecords(
+		cfg.validator, swapHash, cfg.paymentRecords,
+		swapResp.paymentRecords,
 	)
 	if err != nil {
 		return nil, err
@@ -178,8 +282,12 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 			MaxSwapFee:       request.MaxSwapFee,
 			Label:            request.Label,
 			ProtocolVersion:  loopdb.CurrentInternalProtocolVersion,
+			ServerMessage:    swapResp.structuredMessage,
+			RequestID:        request.RequestID,
+			Tenant:           request.Tenant,
 		},
 		OutgoingChanSet: chanSet,
+		PaymentRecords:  records,
 	}
 
 	swapKit := newSwapKit(
@@ -198,29 +306,69 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 	// Log htlc address for debugging.
 	swapKit.log.Infof("Htlc address: %v", htlc.Address)
 
+	// Record the fees that we expect to pay for this swap, so that they
+	// can be compared to the fees that it realizes once it completes. The
+	// quote is informational, so we only log estimation errors.
+	sweeper := &sweep.Sweeper{Lnd: cfg.lnd}
+	minerFee, err := sweeper.GetSweepFee(
+		globalCtx, htlc.AddSuccessToEstimator, request.DestAddr,
+		request.SweepConfTarget,
+	)
+	if err != nil {
+		swapKit.log.Warnf("Could not estimate sweep fee: %v", err)
+	}
+
+	contract.Quote = &loopdb.SwapQuote{
+		SwapFee:  swapFee,
+		MinerFee: minerFee,
+	}
+
+	// Record the time that we expect the swap to complete within, so
+	// that slow swaps can be detected.
+	contract.SLA = &loopdb.SwapSLA{
+		Server: cfg.serverAddress,
+		ExpectedDuration: expectedLoopOutDuration(
+			initiationTime, request.SwapPublicationDeadline,
+			confs, request.SweepConfTarget,
+		),
+	}
+
 	swap := &loopOutSwap{
 		LoopOutContract: contract,
 		swapKit:         *swapKit,
 		htlc:            htlc,
 	}
 
-	// Persist the data before exiting this function, so that the caller
-	// can trust that this swap will be resumed on restart.
-	err = cfg.store.CreateLoopOut(swapHash, &swap.LoopOutContract)
-	if err != nil {
-		return nil, fmt.Errorf("cannot store swap: %v", err)
-	}
-
 	if swapResp.serverMessage != "" {
 		swap.log.Infof("Server message: %v", swapResp.serverMessage)
 	}
 
+	if swapResp.structuredMessage != nil {
+		swap.log.Infof("Server message code: %v, params: %v",
+			swapResp.structuredMessage.Code,
+			swapResp.structuredMessage.Params)
+	}
+
 	return &loopOutInitResult{
-		swap:          swap,
-		serverMessage: swapResp.serverMessage,
+		swap:              swap,
+		serverMessage:     swapResp.serverMessage,
+		structuredMessage: swapResp.structuredMessage,
+		swapFee:           swapFee,
+		prepayAmount:      prepayAmount,
 	}, nil
 }
 
+// persist stores the swap's contract, so that it will be resumed on restart.
+// The swap must be persisted before it is executed.
+func (s *loopOutSwap) persist() error {
+	err := s.store.CreateLoopOut(s.hash, &s.LoopOutContract)
+	if err != nil {
+		return fmt.Errorf("cannot store swap: %v", err)
+	}
+
+	return nil
+}
+
 // resumeLoopOutSwap returns a swap object representing a pending swap that has
 // been restored from the database.
 func resumeLoopOutSwap(reqContext context.Context, cfg *swapConfig,
@@ -256,8 +404,10 @@ func resumeLoopOutSwap(reqContext context.Context, cfg *swapConfig,
 		swap.lastUpdateTime = pend.Contract.InitiationTime
 	} else {
 		swap.state = lastUpdate.State
+		swap.log.SetState(lastUpdate.State)
 		swap.lastUpdateTime = lastUpdate.Time
 		swap.htlcTxHash = lastUpdate.HtlcTxHash
+		swap.prepay = lastUpdate.Prepay
 	}
 
 	return swap, nil
@@ -269,6 +419,14 @@ func (s *loopOutSwap) sendUpdate(ctx context.Context) error {
 	s.log.Infof("Loop out swap state: %v", info.State)
 
 	info.HtlcAddressP2WSH = s.htlc.Address
+	info.OutgoingChanSet = s.OutgoingChanSet
+	info.HtlcConfirmations = s.HtlcConfirmations
+	info.HtlcTxHash = s.htlcTxHash
+	info.Prepay = s.prepay
+
+	s.log.SetState(s.state)
+	s.traceState(ctx)
+	s.subscriptions.notify(*info)
 
 	select {
 	case s.statusChan <- *info:
@@ -289,6 +447,8 @@ func (s *loopOutSwap) execute(mainCtx context.Context,
 	s.executeConfig = *cfg
 	s.height = height
 
+	mainCtx, endTrace := s.startTrace(mainCtx)
+
 	// Create context for our state subscription which we will cancel once
 	// swap execution has completed, ensuring that we kill the subscribe
 	// goroutine.
@@ -305,6 +465,7 @@ func (s *loopOutSwap) execute(mainCtx context.Context,
 
 	// Execute swap.
 	err := s.executeAndFinalize(mainCtx)
+	defer endTrace(err)
 
 	// If an unexpected error happened, report a temporary failure.
 	// Otherwise for example a connection error could lead to abandoning
@@ -312,7 +473,9 @@ func (s *loopOutSwap) execute(mainCtx context.Context,
 	if err != nil {
 		s.log.Errorf("Swap error: %v", err)
 
-		s.state = loopdb.StateFailTemporary
+		// A temporary failure may occur in any state, so we don't
+		// need to check the transition.
+		_ = s.fire(swapfsm.EventTemporaryFailure)
 
 		// If we cannot send out this update, there is nothing we can
 		// do.
@@ -368,7 +531,7 @@ func (s *loopOutSwap) executeAndFinalize(globalCtx context.Context) error {
 		case result := <-s.prePaymentChan:
 			s.prePaymentChan = nil
 
-			err := s.handlePaymentResult(result)
+			err := s.handlePrepayResult(result)
 			if err != nil {
 				return err
 			}
@@ -419,6 +582,30 @@ func (s *loopOutSwap) handlePaymentResult(result paymentResult) error {
 	}
 }
 
+// handlePrepayResult accounts for the result of our prepay payment, and
+// records its outcome so that prepays kept by the server for failed swaps
+// can be tracked.
+func (s *loopOutSwap) handlePrepayResult(result paymentResult) error {
+	if err := s.handlePaymentResult(result); err != nil {
+		return err
+	}
+
+	// If the payment errored, we don't know its outcome.
+	if result.err != nil {
+		return nil
+	}
+
+	s.prepay = &loopdb.PrepayPayment{
+		Settled: result.status.State == lnrpc.Payment_SUCCEEDED,
+	}
+	if s.prepay.Settled {
+		s.prepay.Amount = result.status.Value.ToSatoshis()
+		s.prepay.RoutingFee = result.status.Fee.ToSatoshis()
+	}
+
+	return nil
+}
+
 // executeSwap executes the swap, but returns as soon as the swap outcome is
 // final. At that point, there may still be pending off-chain payment(s).
 func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
@@ -463,8 +650,7 @@ func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
 		log.Warnf("Swap amount too low, expected %v but received %v",
 			s.AmountRequested, htlcValue)
 
-		s.state = loopdb.StateFailInsufficientValue
-		return nil
+		return s.fire(swapfsm.EventHtlcValueMismatch)
 	}
 
 	// Try to spend htlc and continue (rbf) until a spend has confirmed.
@@ -473,6 +659,9 @@ func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
 		func() error {
 			return s.sweep(globalCtx, *htlcOutpoint, htlcValue)
 		},
+		func(ctx context.Context) (*SweepPreview, error) {
+			return s.previewSweep(ctx, *htlcOutpoint, htlcValue)
+		},
 	)
 	if err != nil {
 		return err
@@ -495,17 +684,32 @@ func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
 	}
 
 	sweepSuccessful := s.htlc.IsSuccessWitness(htlcInput.Witness)
-	if sweepSuccessful {
-		s.cost.Server -= htlcValue
+	if !sweepSuccessful {
+		return s.fire(swapfsm.EventHtlcTimeout)
+	}
 
-		s.cost.Onchain = htlcValue -
-			btcutil.Amount(spendDetails.SpendingTx.TxOut[0].Value)
+	s.cost.Server -= htlcValue
 
-		s.state = loopdb.StateSuccess
-	} else {
-		s.state = loopdb.StateFailSweepTimeout
+	s.cost.Onchain = htlcValue -
+		btcutil.Amount(spendDetails.SpendingTx.TxOut[0].Value)
+
+	s.recordRealizedFee(
+		loopdb.FeeDecisionSweep, spendDetails.SpendingTx, s.cost.Onchain,
+	)
+
+	return s.fire(swapfsm.EventHtlcSuccess)
+}
+
+// fire advances the swap's state with the event provided. An error is
+// returned if the event is not expected in the swap's current state.
+func (s *loopOutSwap) fire(event swapfsm.Event) error {
+	state, err := swapfsm.LoopOut.Step(s.state, event)
+	if err != nil {
+		return err
 	}
 
+	s.state = state
+
 	return nil
 }
 
@@ -522,6 +726,7 @@ func (s *loopOutSwap) persistState(ctx context.Context) error {
 			State:      s.state,
 			Cost:       s.cost,
 			HtlcTxHash: s.htlcTxHash,
+			Prepay:     s.prepay,
 		},
 	)
 	if err != nil {
@@ -534,22 +739,111 @@ func (s *loopOutSwap) persistState(ctx context.Context) error {
 
 // payInvoices pays both swap invoices.
 func (s *loopOutSwap) payInvoices(ctx context.Context) {
-	// Pay the swap invoice.
-	s.log.Infof("Sending swap payment %v", s.SwapInvoice)
+	// Before we pay the swap invoice, we let lnd know about the routes
+	// that previous swap payments failed over.
+	if err := s.routingHints.apply(ctx); err != nil {
+		s.log.Warnf("Could not apply routing hints: %v", err)
+	}
 
+	// Pay the swap invoice. The swap invoice is never paid with AMP, even
+	// if the server signals support for it. The shards of an AMP payment
+	// are locked to hashes of preimages that the server learns once it
+	// has received all of them, so the server could settle the swap
+	// payment without our swap preimage, and the swap would no longer be
+	// atomic.
+	s.log.Infof("Sending swap payment %v", s.SwapInvoice)
 	s.swapPaymentChan = s.payInvoice(
 		ctx, s.SwapInvoice, s.MaxSwapRoutingFee,
-		s.LoopOutContract.OutgoingChanSet,
+		s.LoopOutContract.OutgoingChanSet, paymentTimeout,
+		s.invoicePaymentOptions(s.SwapInvoice, false),
 	)
 
-	// Pay the prepay invoice.
+	// Pay the prepay invoice. The server will not publish the htlc if it
+	// has not received the prepay by the swap's publication deadline, so
+	// we don't have lnd keep trying to pay it beyond that. The prepay is
+	// not tied to our swap preimage, so we may pay it with AMP.
 	s.log.Infof("Sending prepayment %v", s.PrepayInvoice)
 	s.prePaymentChan = s.payInvoice(
 		ctx, s.PrepayInvoice, s.MaxPrepayRoutingFee,
-		nil,
+		nil, prepayTimeout(time.Now(), s.SwapPublicationDeadline),
+		s.invoicePaymentOptions(s.PrepayInvoice, true),
 	)
 }
 
+// paymentOptions holds the options of a swap payment that require our payer.
+type paymentOptions struct {
+	// amp pays the invoice with AMP.
+	amp bool
+
+	// records are the custom records that are attached to the payment.
+	records map[uint64][]byte
+
+	// pacing limits the size and rate of the payment's shards.
+	pacing *PaymentPacing
+}
+
+// usePayer returns true if the payment must be dispatched by our payer.
+func (p paymentOptions) usePayer() bool {
+	return p.amp || len(p.records) > 0 || p.pacing != nil
+}
+
+// invoicePaymentOptions returns the options for paying the swap or prepay
+// invoice of the swap. The prepay invoice is paid with AMP if it is enabled
+// and the invoice signals support for it.
+func (s *loopOutSwap) invoicePaymentOptions(invoice string,
+	prepay bool) paymentOptions {
+
+	opts := paymentOptions{
+		records: paymentRecords(s.PaymentRecords, prepay),
+		pacing:  s.executeConfig.paymentPacing,
+	}
+
+	if s.executeConfig.payer == nil {
+		if len(opts.records) > 0 {
+			s.log.Warnf("Payer unavailable, sending payment "+
+				"without custom records: %v", opts.records)
+		}
+
+		return paymentOptions{}
+	}
+
+	if !prepay || !s.executeConfig.ampPrepay {
+		return opts
+	}
+
+	amp, err := ampSupported(s.lnd.ChainParams, invoice)
+	if err != nil {
+		s.log.Warnf("Could not check amp support of invoice: %v", err)
+		return opts
+	}
+	opts.amp = amp
+
+	return opts
+}
+
+// prepayTimeout returns the timeout that lnd should try to pay our prepay
+// invoice for, given the swap's publication deadline. The timeout is at least
+// minPrepayTimeout so that lnd has a chance to pay the invoice. If the deadline
+// has already passed, we are most likely resuming a prepay that was already
+// dispatched, so we use our regular payment timeout.
+func prepayTimeout(now, deadline time.Time) time.Duration {
+	if !deadline.After(now) {
+		return paymentTimeout
+	}
+
+	timeout := deadline.Sub(now)
+	switch {
+	case timeout < minPrepayTimeout:
+		return minPrepayTimeout
+
+	case timeout > paymentTimeout:
+		return paymentTimeout
+
+	default:
+		return timeout
+	}
+}
+
 // paymentResult contains the response for a failed or settled payment, and
 // any errors that occurred if the payment unexpectedly failed.
 type paymentResult struct {
@@ -573,8 +867,8 @@ func (p paymentResult) failure() error {
 
 // payInvoice pays a single invoice.
 func (s *loopOutSwap) payInvoice(ctx context.Context, invoice string,
-	maxFee btcutil.Amount,
-	outgoingChanIds loopdb.ChannelSet) chan paymentResult {
+	maxFee btcutil.Amount, outgoingChanIds loopdb.ChannelSet,
+	timeout time.Duration, opts paymentOptions) chan paymentResult {
 
 	resultChan := make(chan paymentResult)
 	sendResult := func(result paymentResult) {
@@ -587,9 +881,13 @@ func (s *loopOutSwap) payInvoice(ctx context.Context, invoice string,
 	go func() {
 		var result paymentResult
 
+		payCtx, span := tracing.Start(ctx, "pay invoice")
 		status, err := s.payInvoiceAsync(
-			ctx, invoice, maxFee, outgoingChanIds,
+			payCtx, invoice, maxFee, outgoingChanIds, timeout, opts,
 		)
+		span.RecordError(err)
+		span.End()
+
 		if err != nil {
 			result.err = err
 			sendResult(result)
@@ -615,8 +913,9 @@ func (s *loopOutSwap) payInvoice(ctx context.Context, invoice string,
 
 // payInvoiceAsync is the asynchronously executed part of paying an invoice.
 func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
-	invoice string, maxFee btcutil.Amount,
-	outgoingChanIds loopdb.ChannelSet) (*lndclient.PaymentStatus, error) {
+	invoice string, maxFee btcutil.Amount, outgoingChanIds loopdb.ChannelSet,
+	timeout time.Duration, opts paymentOptions) (*lndclient.PaymentStatus,
+	error) {
 
 	// Extract hash from payment request. Unfortunately the request
 	// components aren't available directly.
@@ -630,7 +929,7 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 		MaxFee:          maxFee,
 		Invoice:         invoice,
 		OutgoingChanIds: outgoingChanIds,
-		Timeout:         paymentTimeout,
+		Timeout:         timeout,
 		MaxParts:        s.executeConfig.loopOutMaxParts,
 	}
 
@@ -638,9 +937,30 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 	paymentStateCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	payStatusChan, payErrChan, err := s.lnd.Router.SendPayment(
-		paymentStateCtx, req,
-	)
+	// Payments that are paid with AMP, carry custom records or are paced
+	// need to be dispatched by our payer, since lndclient does not support
+	// them.
+	sendPayment := s.lnd.Router.SendPayment
+	if opts.usePayer() {
+		s.log.Infof("Paying invoice %v with amp=%v, custom records: %v",
+			hash, opts.amp, opts.records)
+
+		sendPayment = func(ctx context.Context,
+			req lndclient.SendPaymentRequest) (
+			chan lndclient.PaymentStatus, chan error, error) {
+
+			return s.executeConfig.payer.SendPayment(
+				ctx, PaymentRequest{
+					SendPaymentRequest: req,
+					Amp:                opts.amp,
+					CustomRecords:      opts.records,
+					Pacing:             opts.pacing,
+				},
+			)
+		}
+	}
+
+	payStatusChan, payErrChan, err := sendPayment(paymentStateCtx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -718,6 +1038,23 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 		return nil, err
 	}
 
+	// If we are tracking a specific htlc tx, the server may have replaced
+	// it since we recorded it, for example after a reorg. We also watch
+	// for any tx producing the swap script output so that we do not wait
+	// for a tx that will never confirm.
+	var replacementConfChan chan *chainntnfs.TxConfirmation
+	var replacementErrChan chan error
+	if s.htlcTxHash != nil {
+		replacementConfChan, replacementErrChan, err =
+			s.lnd.ChainNotifier.RegisterConfirmationsNtfn(
+				ctx, nil, s.htlc.PkScript,
+				int32(s.HtlcConfirmations), s.InitiationHeight,
+			)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var txConf *chainntnfs.TxConfirmation
 	if s.state == loopdb.StateInitiated {
 		// Check if it is already too late to start this swap. If we
@@ -726,29 +1063,28 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 		maxPreimageRevealHeight := s.CltvExpiry -
 			MinLoopOutPreimageRevealDelta
 
-		checkMaxRevealHeightExceeded := func() bool {
-			s.log.Infof("Checking preimage reveal height %v "+
-				"exceeded (height %v)",
+		checkMaxRevealHeightExceeded := func() (bool, error) {
+			s.log.ThrottledInfof("Checking preimage reveal "+
+				"height %v exceeded (height %v)",
 				maxPreimageRevealHeight, s.height)
 
 			if s.height <= maxPreimageRevealHeight {
-				return false
+				return false, nil
 			}
 
 			s.log.Infof("Max preimage reveal height %v "+
 				"exceeded (height %v)",
 				maxPreimageRevealHeight, s.height)
 
-			s.state = loopdb.StateFailTimeout
-
-			return true
+			return true, s.fire(swapfsm.EventExpired)
 		}
 
 		// First check, because after resume we may otherwise reveal the
 		// preimage after the max height (depending on order in which
 		// events are received in the select loop below).
-		if checkMaxRevealHeightExceeded() {
-			return nil, nil
+		exceeded, err := checkMaxRevealHeightExceeded()
+		if err != nil || exceeded {
+			return nil, err
 		}
 		s.log.Infof("Waiting for either htlc on-chain confirmation or " +
 			" off-chain payment failure")
@@ -769,11 +1105,11 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 					s.log.Infof("Failed swap payment: %v",
 						result.failure())
 
-					s.failOffChain(
+					err := s.failOffChain(
 						ctx, paymentTypeInvoice,
 						result.status,
 					)
-					return nil, nil
+					return nil, err
 				}
 
 			// If the prepay fails, abandon the swap. Because we
@@ -782,7 +1118,7 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 			case result := <-s.prePaymentChan:
 				s.prePaymentChan = nil
 
-				err := s.handlePaymentResult(result)
+				err := s.handlePrepayResult(result)
 				if err != nil {
 					return nil, err
 				}
@@ -791,12 +1127,12 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 					s.log.Infof("Failed prepayment: %v",
 						result.failure())
 
-					s.failOffChain(
+					err := s.failOffChain(
 						ctx, paymentTypeInvoice,
 						result.status,
 					)
 
-					return nil, nil
+					return nil, err
 				}
 
 			// Unexpected error on the confirm channel happened,
@@ -815,8 +1151,9 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 
 				log.Infof("Received block %v", s.height)
 
-				if checkMaxRevealHeightExceeded() {
-					return nil, nil
+				exceeded, err := checkMaxRevealHeightExceeded()
+				if err != nil || exceeded {
+					return nil, err
 				}
 
 			// Client quit.
@@ -834,6 +1171,10 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 			return nil, err
 		case htlcConfNtfn := <-htlcConfChan:
 			txConf = htlcConfNtfn
+		case err := <-replacementErrChan:
+			return nil, err
+		case htlcConfNtfn := <-replacementConfChan:
+			txConf = htlcConfNtfn
 		case <-globalCtx.Done():
 			return nil, globalCtx.Err()
 		}
@@ -842,8 +1183,24 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 	htlcTxHash := txConf.Tx.TxHash()
 	s.log.Infof("Htlc tx %v at height %v", htlcTxHash, txConf.BlockHeight)
 
+	// If the htlc tx that confirmed is not the one that we were tracking,
+	// the server replaced it. We re-anchor the swap to the replacement so
+	// that we sweep its output, and record an update so that the
+	// replacement shows in the swap's events.
+	replaced := s.htlcTxHash != nil && *s.htlcTxHash != htlcTxHash
+	if replaced {
+		s.log.Warnf("Htlc tx %v replaced by %v", s.htlcTxHash,
+			htlcTxHash)
+	}
+
 	s.htlcTxHash = &htlcTxHash
 
+	if replaced {
+		if err := s.persistState(globalCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	return txConf, nil
 }
 
@@ -855,8 +1212,9 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 // sweep offchain. So we must make sure we sweep successfully before on-chain
 // timeout.
 func (s *loopOutSwap) waitForHtlcSpendConfirmed(globalCtx context.Context,
-	htlc wire.OutPoint, spendFunc func() error) (*chainntnfs.SpendDetail,
-	error) {
+	htlc wire.OutPoint, spendFunc func() error,
+	previewFunc func(context.Context) (*SweepPreview, error)) (
+	*chainntnfs.SpendDetail, error) {
 
 	// Register the htlc spend notification.
 	ctx, cancel := context.WithCancel(globalCtx)
@@ -882,9 +1240,34 @@ func (s *loopOutSwap) waitForHtlcSpendConfirmed(globalCtx context.Context,
 	// to decide whether we need to push our preimage to the server.
 	var paymentComplete bool
 
-	timerChan := s.timerFactory(republishDelay)
+	// If our preimage has not been revealed yet, we wait for a random
+	// amount of time before our first sweep attempt.
+	delay, err := s.initialSweepDelay()
+	if err != nil {
+		return nil, err
+	}
+	sweepTime := time.Now().Add(delay)
+
+	if delay > 0 {
+		s.log.Infof("Delaying sweep by %v", delay)
+	}
+
+	// While we wait to sweep, we preview our sweep on request. Previews
+	// are created by this loop so that they use the same state as our
+	// sweeps.
+	previews, unregister := s.sweepPreviews.register(s.hash)
+	defer unregister()
+
+	timerChan := s.timerFactory(republishDelay + delay)
 	for {
 		select {
+		case request := <-previews:
+			preview, err := previewFunc(request.ctx)
+			request.response <- previewResponse{
+				preview: preview,
+				err:     err,
+			}
+
 		// Htlc spend, break loop.
 		case spendDetails := <-spendChan:
 			s.log.Infof("Htlc spend by tx: %v", spendDetails.SpenderTxHash)
@@ -935,10 +1318,13 @@ func (s *loopOutSwap) waitForHtlcSpendConfirmed(globalCtx context.Context,
 			}
 
 		// New block arrived, update height and restart the republish
-		// timer.
+		// timer. If we're still delaying our first sweep, the timer
+		// fires once our delay has passed.
 		case notification := <-s.blockEpochChan:
 			s.height = notification.(int32)
-			timerChan = s.timerFactory(republishDelay)
+			timerChan = s.timerFactory(
+				republishDelay + s.remainingSweepDelay(sweepTime),
+			)
 
 		// Some time after start or after arrival of a new block, try
 		// to spend again.
@@ -984,7 +1370,7 @@ func (s *loopOutSwap) pushPreimage(ctx context.Context) {
 	// Push the preimage to the server, just log server errors since we rely
 	// on our payment state rather than the server response to judge the
 	// outcome of our preimage push.
-	if err := s.server.PushLoopOutPreimage(ctx, s.Preimage); err != nil {
+	if err := s.actions.PushPreimage(ctx, s.Preimage); err != nil {
 		s.log.Warnf("Could not push preimage: %v", err)
 	}
 }
@@ -992,22 +1378,30 @@ func (s *loopOutSwap) pushPreimage(ctx context.Context) {
 // failOffChain updates a swap's state when it has failed due to a routing
 // failure and notifies the server of the failure.
 func (s *loopOutSwap) failOffChain(ctx context.Context, paymentType paymentType,
-	status lndclient.PaymentStatus) {
+	status lndclient.PaymentStatus) error {
+
+	// Set our state to failed off chain payments.
+	if err := s.fire(swapfsm.EventOffchainFailed); err != nil {
+		return err
+	}
 
-	// Set our state to failed off chain timeout.
-	s.state = loopdb.StateFailOffchainPayments
+	// Record the hops that our payment failed at, so that they can be
+	// avoided by our next swap payments.
+	if err := s.routingHints.record(status.Htlcs); err != nil {
+		s.log.Warnf("Could not record routing failures: %v", err)
+	}
 
 	swapPayReq, err := zpay32.Decode(
 		s.LoopOutContract.SwapInvoice, s.swapConfig.lnd.ChainParams,
 	)
 	if err != nil {
 		s.log.Errorf("could not decode swap invoice: %v", err)
-		return
+		return nil
 	}
 
 	if swapPayReq.PaymentAddr == nil {
 		s.log.Errorf("expected payment address for invoice")
-		return
+		return nil
 	}
 
 	details := &outCancelDetails{
@@ -1079,6 +1473,8 @@ func (s *loopOutSwap) failOffChain(ctx context.Context, paymentType paymentType,
 	if err := s.cancelSwap(ctx, details); err != nil {
 		s.log.Warnf("Could not report failure: %v", err)
 	}
+
+	return nil
 }
 
 // sweep tries to sweep the given htlc to a destination address. It takes into
@@ -1092,10 +1488,6 @@ func (s *loopOutSwap) sweep(ctx context.Context,
 	htlcOutpoint wire.OutPoint,
 	htlcValue btcutil.Amount) error {
 
-	witnessFunc := func(sig []byte) (wire.TxWitness, error) {
-		return s.htlc.GenSuccessWitness(sig, s.Preimage)
-	}
-
 	remainingBlocks := s.CltvExpiry - s.height
 	blocksToLastReveal := remainingBlocks - MinLoopOutPreimageRevealDelta
 	preimageRevealed := s.state == loopdb.StatePreimageRevealed
@@ -1110,61 +1502,61 @@ func (s *loopOutSwap) sweep(ctx context.Context,
 			"expires at: %v, current height: %v", s.CltvExpiry,
 			s.height)
 
-		s.state = loopdb.StateFailTimeout
-		return nil
+		return s.fire(swapfsm.EventExpired)
 	}
 
-	// Calculate the transaction fee based on the confirmation target
-	// required to sweep the HTLC before the timeout. We'll use the
-	// confirmation target provided by the client unless we've come too
-	// close to the expiration height, in which case we'll use the default
-	// if it is better than what the client provided.
-	confTarget := s.SweepConfTarget
-	if remainingBlocks <= DefaultSweepConfTargetDelta &&
-		confTarget > DefaultSweepConfTarget {
-
-		confTarget = DefaultSweepConfTarget
-	}
-
-	fee, err := s.sweeper.GetSweepFee(
-		ctx, s.htlc.AddSuccessToEstimator, s.DestAddr, confTarget,
-	)
+	attempt, err := s.createSweep(ctx, htlcOutpoint, htlcValue)
 	if err != nil {
 		return err
 	}
 
-	// Ensure it doesn't exceed our maximum fee allowed.
-	if fee > s.MaxMinerFee {
-		s.log.Warnf("Required fee %v exceeds max miner fee of %v",
-			fee, s.MaxMinerFee)
-
-		if preimageRevealed {
-			// The currently required fee exceeds the max, but we
-			// already revealed the preimage. The best we can do now
-			// is to republish with the max fee.
-			fee = s.MaxMinerFee
-		} else {
-			s.log.Warnf("Not revealing preimage")
+	recordFee := func(allowed bool) {
+		s.recordFee(
+			loopdb.FeeDecisionSweep, attempt.confTarget,
+			attempt.feeRate, attempt.requiredFee, allowed,
+		)
+	}
+
+	// Ensure it doesn't exceed our maximum fee allowed. If it does, but
+	// we already revealed our preimage, the best we can do now is to
+	// republish with the max fee, which our sweep was created with.
+	if attempt.requiredFee > s.MaxMinerFee {
+		s.log.ThrottledWarnf("Required fee %v exceeds max miner fee "+
+			"of %v", attempt.requiredFee, s.MaxMinerFee)
+
+		if !preimageRevealed {
+			s.log.ThrottledWarnf("Not revealing preimage")
+			recordFee(false)
+
 			return nil
 		}
 	}
 
-	// Create sweep tx.
-	sweepTx, err := s.sweeper.CreateSweepTx(
-		ctx, s.height, s.htlc.SuccessSequence(), s.htlc, htlcOutpoint,
-		s.ReceiverKey, witnessFunc, htlcValue, fee, s.DestAddr,
-	)
-	if err != nil {
-		return err
+	// If our sweep's output is not worth creating at the current fee rate,
+	// we wait for fees to drop rather than revealing our preimage. Once
+	// our preimage is revealed, we have to sweep regardless.
+	if attempt.uneconomical != nil {
+		s.log.ThrottledWarnf("Not revealing preimage: %v",
+			attempt.uneconomical)
+		recordFee(false)
+
+		return nil
 	}
 
+	recordFee(true)
+
+	sweepTx, fee := attempt.tx, attempt.fee
+
 	// Before publishing the tx, already mark the preimage as revealed. This
 	// is a precaution in case the publish call never returns and would
 	// leave us thinking we didn't reveal yet.
 	if s.state != loopdb.StatePreimageRevealed {
-		s.state = loopdb.StatePreimageRevealed
+		err := s.fire(swapfsm.EventPreimageRevealed)
+		if err != nil {
+			return err
+		}
 
-		err := s.persistState(ctx)
+		err = s.persistState(ctx)
 		if err != nil {
 			return err
 		}
@@ -1174,7 +1566,7 @@ func (s *loopOutSwap) sweep(ctx context.Context,
 	s.log.Infof("Sweep on chain HTLC to address %v with fee %v (tx %v)",
 		s.DestAddr, fee, sweepTx.TxHash())
 
-	err = s.lnd.WalletKit.PublishTransaction(
+	err = s.actions.PublishTx(
 		ctx, sweepTx,
 		labels.LoopOutSweepSuccess(swap.ShortHash(&s.hash)),
 	)
@@ -1185,49 +1577,218 @@ func (s *loopOutSwap) sweep(ctx context.Context,
 	return nil
 }
 
+// sweepAttempt is the transaction that we sweep our htlc with at the current
+// height and fee rate.
+type sweepAttempt struct {
+	// tx is our signed sweep transaction. It is nil if the fee required
+	// exceeds our maximum miner fee and we have not revealed our preimage
+	// yet, since we don't sweep in that case.
+	tx *wire.MsgTx
+
+	// fee is the fee that our sweep pays, which is capped at our maximum
+	// miner fee.
+	fee btcutil.Amount
+
+	// requiredFee is the fee that is required to confirm our sweep within
+	// our confirmation target, which may exceed our maximum miner fee.
+	requiredFee btcutil.Amount
+
+	// feeRate is the fee rate that was estimated for our confirmation
+	// target.
+	feeRate chainfee.SatPerKWeight
+
+	// confTarget is the confirmation target that our fee was estimated
+	// for.
+	confTarget int32
+
+	// uneconomical is set if we have not revealed our preimage yet, and
+	// our sweep's output is not worth creating at the current fee rate.
+	uneconomical error
+}
+
+// createSweep creates the transaction that sweeps our htlc to our destination
+// address at the current height and fee rate, without publishing it.
+func (s *loopOutSwap) createSweep(ctx context.Context,
+	htlcOutpoint wire.OutPoint, htlcValue btcutil.Amount) (*sweepAttempt,
+	error) {
+
+	witnessFunc := func(sig []byte) (wire.TxWitness, error) {
+		return s.htlc.GenSuccessWitness(sig, s.Preimage)
+	}
+
+	remainingBlocks := s.CltvExpiry - s.height
+	preimageRevealed := s.state == loopdb.StatePreimageRevealed
+
+	// Calculate the transaction fee based on the confirmation target
+	// required to sweep the HTLC before the timeout. We'll use the
+	// confirmation target provided by the client unless we've come too
+	// close to the expiration height, in which case we'll use the default
+	// if it is better than what the client provided.
+	confTarget := s.SweepConfTarget
+	if remainingBlocks <= DefaultSweepConfTargetDelta &&
+		confTarget > DefaultSweepConfTarget {
+
+		confTarget = DefaultSweepConfTarget
+	}
+
+	fee, feeRate, err := s.sweeper.EstimateSweepFee(
+		ctx, s.htlc.AddSuccessToEstimator, s.DestAddr, confTarget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := &sweepAttempt{
+		fee:         fee,
+		requiredFee: fee,
+		feeRate:     feeRate,
+		confTarget:  confTarget,
+	}
+
+	// If the required fee exceeds our maximum, we only sweep with our
+	// maximum fee if we already revealed our preimage. Otherwise, we don't
+	// sweep at all, so we don't create a transaction.
+	if fee > s.MaxMinerFee {
+		if !preimageRevealed {
+			return attempt, nil
+		}
+
+		attempt.fee = s.MaxMinerFee
+	}
+
+	// The success path of our htlc does not require a minimum lock time,
+	// so our sweep's lock time may be set in the past.
+	lockTime, err := s.sweeper.LockTime(s.height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create sweep tx.
+	attempt.tx, err = s.sweeper.CreateSweepTx(
+		ctx, lockTime, s.htlc.SuccessSequence(), s.htlc, htlcOutpoint,
+		s.ReceiverKey, witnessFunc, htlcValue, attempt.fee, s.DestAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !preimageRevealed {
+		err := s.sweeper.CheckOutput(attempt.tx, attempt.fee, s.DestAddr)
+		if _, ok := err.(*sweep.ErrUneconomicalOutput); ok {
+			attempt.uneconomical = err
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return attempt, nil
+}
+
 // validateLoopOutContract validates the contract parameters against our
-// request.
+// request. Parameters that prevent the swap from completing safely always
+// fail the swap, while anomalies are handled by the validator provided.
 func validateLoopOutContract(lnd *lndclient.LndServices,
-	height int32, request *OutRequest, swapHash lntypes.Hash,
-	response *newLoopOutResponse) error {
+	validator *serverValidator, height int32, request *OutRequest,
+	swapHash lntypes.Hash, receiverKey [33]byte,
+	response *newLoopOutResponse) (btcutil.Amount, btcutil.Amount, error) {
 
 	// Check invoice amounts.
 	chainParams := lnd.ChainParams
 
-	swapInvoiceHash, swapInvoiceAmt, err := swap.DecodeInvoice(
-		chainParams, response.swapInvoice,
+	swapInvoice, err := decodeServerInvoice(
+		chainParams, ParamSwapInvoice, response.swapInvoice,
 	)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
-	if swapInvoiceHash != swapHash {
-		return fmt.Errorf(
-			"cannot initiate swap, swap invoice hash %v not equal generated swap hash %v",
-			swapInvoiceHash, swapHash)
+	if swapInvoice.hash != swapHash {
+		return 0, 0, invalidParam(ParamSwapInvoice, "hash %v not "+
+			"equal generated swap hash %v", swapInvoice.hash,
+			swapHash)
 	}
 
-	_, prepayInvoiceAmt, err := swap.DecodeInvoice(
-		chainParams, response.prepayInvoice,
+	prepayInvoice, err := decodeServerInvoice(
+		chainParams, ParamPrepayInvoice, response.prepayInvoice,
 	)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
+	swapInvoiceAmt, prepayInvoiceAmt := swapInvoice.amount,
+		prepayInvoice.amount
+
 	swapFee := swapInvoiceAmt + prepayInvoiceAmt - request.Amount
 	if swapFee > request.MaxSwapFee {
 		log.Warnf("Swap fee %v exceeding maximum of %v",
 			swapFee, request.MaxSwapFee)
 
-		return ErrSwapFeeTooHigh
+		return 0, 0, ErrSwapFeeTooHigh
 	}
 
 	if prepayInvoiceAmt > request.MaxPrepayAmount {
 		log.Warnf("Prepay amount %v exceeding maximum of %v",
 			prepayInvoiceAmt, request.MaxPrepayAmount)
 
-		return ErrPrepayAmountTooHigh
+		return 0, 0, ErrPrepayAmountTooHigh
 	}
 
-	return nil
+	// The server's htlc key must be usable in our htlc script.
+	err = validateServerKey(response.senderKey, receiverKey)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The remaining checks cover parameters that are unexpected, but
+	// don't put our funds at risk.
+	var anomalies []*ServerParamError
+
+	if swapFee < 0 {
+		anomalies = append(anomalies, anomalousParam(
+			ParamSwapInvoice, "invoices total %v, less than swap "+
+				"amount %v", swapInvoiceAmt+prepayInvoiceAmt,
+			request.Amount,
+		))
+	}
+
+	// The server settles the prepay with a preimage of its own, so an
+	// invoice that is locked to our swap hash can't be settled.
+	if prepayInvoice.hash == swapHash {
+		anomalies = append(anomalies, anomalousParam(
+			ParamPrepayInvoice, "hash equals swap hash %v",
+			swapHash,
+		))
+	}
+
+	if prepayInvoice.payee != swapInvoice.payee {
+		anomalies = append(anomalies, anomalousParam(
+			ParamPrepayInvoice, "payee %v differs from swap "+
+				"invoice payee %v", prepayInvoice.payee,
+			swapInvoice.payee,
+		))
+	}
+
+	// If the htlc expires before its confirmation and our sweep could
+	// reasonably complete, we will likely have to abandon the swap.
+	minExpiry := height + int32(request.HtlcConfirmations) +
+		request.SweepConfTarget + MinLoopOutPreimageRevealDelta
+	if request.Expiry < minExpiry {
+		anomalies = append(anomalies, anomalousParam(
+			ParamHtlcExpiry, "%v leaves too little time to sweep, "+
+				"minimum %v", request.Expiry, minExpiry,
+		))
+	}
+
+	for _, anomaly := range anomalies {
+		if err := validator.anomaly(swapHash, anomaly); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	err = validator.checkNodeKey(swapHash, swapInvoice.payee)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return swapFee, prepayInvoiceAmt, nil
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/stretchr/testify/require"
 )
@@ -39,11 +40,7 @@ func TestLoopOutPaymentParameters(t *testing.T) {
 
 	height := int32(600)
 
-	cfg := &swapConfig{
-		lnd:    &lnd.LndServices,
-		store:  store,
-		server: server,
-	}
+	cfg := newSwapConfig(&lnd.LndServices, store, server)
 
 	sweeper := &sweep.Sweeper{Lnd: &lnd.LndServices}
 
@@ -803,11 +800,13 @@ func TestFailedOffChainCancelation(t *testing.T) {
 
 	successUpdate := lndclient.PaymentStatus{
 		State: lnrpc.Payment_SUCCEEDED,
+		Value: lnwire.NewMSatFromSatoshis(testFixedPrepayAmount),
+		Fee:   lnwire.NewMSatFromSatoshis(2),
 	}
 
 	// We want to fail our swap payment and succeed the prepush, so we send
-	// a failure update to the payment that has the larger amount.
-	if pmt1.Amount > pmt2.Amount {
+	// a failure update to the payment for our swap invoice.
+	if pmt1.Invoice == swap.SwapInvoice {
 		pmt1.TrackPaymentMessage.Updates <- failUpdate
 		pmt2.TrackPaymentMessage.Updates <- successUpdate
 	} else {
@@ -842,4 +841,62 @@ func TestFailedOffChainCancelation(t *testing.T) {
 	state = <-statusChan
 	require.Equal(t, state.State, loopdb.StateFailOffchainPayments)
 	require.NoError(t, <-errChan)
+
+	// Our prepay was settled by the server, so it should be recorded as
+	// lost.
+	require.Equal(t, &loopdb.PrepayPayment{
+		Amount:     testFixedPrepayAmount,
+		RoutingFee: 2,
+		Settled:    true,
+	}, state.Prepay)
+	require.Equal(t, testFixedPrepayAmount+2, state.PrepayLost())
+}
+
+// TestPrepayTimeout tests that the time that lnd is given to pay our prepay is
+// bounded by the swap's publication deadline.
+func TestPrepayTimeout(t *testing.T) {
+	now := time.Unix(10000, 0)
+
+	tests := []struct {
+		name     string
+		deadline time.Time
+		expected time.Duration
+	}{
+		{
+			name:     "deadline passed",
+			deadline: now.Add(-time.Hour),
+			expected: paymentTimeout,
+		},
+		{
+			name:     "deadline now",
+			deadline: now,
+			expected: paymentTimeout,
+		},
+		{
+			name:     "deadline soon",
+			deadline: now.Add(time.Second),
+			expected: minPrepayTimeout,
+		},
+		{
+			name:     "deadline before payment timeout",
+			deadline: now.Add(time.Minute * 10),
+			expected: time.Minute * 10,
+		},
+		{
+			name:     "deadline after payment timeout",
+			deadline: now.Add(paymentTimeout * 2),
+			expected: paymentTimeout,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(
+				t, test.expected,
+				prepayTimeout(now, test.deadline),
+			)
+		})
+	}
 }
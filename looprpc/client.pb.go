@@ -20,6 +20,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HtlcOutputType int32
+
+const (
+	// The output script type of the address is not known.
+	HtlcOutputType_HTLC_OUTPUT_TYPE_UNKNOWN HtlcOutputType = 0
+	// A native segwit (P2WSH) htlc output.
+	HtlcOutputType_HTLC_OUTPUT_TYPE_P2WSH HtlcOutputType = 1
+	// A nested segwit (NP2WSH) htlc output.
+	HtlcOutputType_HTLC_OUTPUT_TYPE_NP2WSH HtlcOutputType = 2
+	//
+	//A taproot (P2TR) htlc output. This type is reserved for future script
+	//versions and is not yet used by the daemon.
+	HtlcOutputType_HTLC_OUTPUT_TYPE_P2TR HtlcOutputType = 3
+)
+
+// Enum value maps for HtlcOutputType.
+var (
+	HtlcOutputType_name = map[int32]string{
+		0: "HTLC_OUTPUT_TYPE_UNKNOWN",
+		1: "HTLC_OUTPUT_TYPE_P2WSH",
+		2: "HTLC_OUTPUT_TYPE_NP2WSH",
+		3: "HTLC_OUTPUT_TYPE_P2TR",
+	}
+	HtlcOutputType_value = map[string]int32{
+		"HTLC_OUTPUT_TYPE_UNKNOWN": 0,
+		"HTLC_OUTPUT_TYPE_P2WSH":   1,
+		"HTLC_OUTPUT_TYPE_NP2WSH":  2,
+		"HTLC_OUTPUT_TYPE_P2TR":    3,
+	}
+)
+
+func (x HtlcOutputType) Enum() *HtlcOutputType {
+	p := new(HtlcOutputType)
+	*p = x
+	return p
+}
+
+func (x HtlcOutputType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HtlcOutputType) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[0].Descriptor()
+}
+
+func (HtlcOutputType) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[0]
+}
+
+func (x HtlcOutputType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HtlcOutputType.Descriptor instead.
+func (HtlcOutputType) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{0}
+}
+
 type SwapType int32
 
 const (
@@ -52,11 +110,11 @@ func (x SwapType) String() string {
 }
 
 func (SwapType) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[0].Descriptor()
+	return file_client_proto_enumTypes[1].Descriptor()
 }
 
 func (SwapType) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[0]
+	return &file_client_proto_enumTypes[1]
 }
 
 func (x SwapType) Number() protoreflect.EnumNumber {
@@ -65,7 +123,7 @@ func (x SwapType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SwapType.Descriptor instead.
 func (SwapType) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{0}
+	return file_client_proto_rawDescGZIP(), []int{1}
 }
 
 type SwapState int32
@@ -132,11 +190,11 @@ func (x SwapState) String() string {
 }
 
 func (SwapState) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[1].Descriptor()
+	return file_client_proto_enumTypes[2].Descriptor()
 }
 
 func (SwapState) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[1]
+	return &file_client_proto_enumTypes[2]
 }
 
 func (x SwapState) Number() protoreflect.EnumNumber {
@@ -145,7 +203,7 @@ func (x SwapState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SwapState.Descriptor instead.
 func (SwapState) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{1}
+	return file_client_proto_rawDescGZIP(), []int{2}
 }
 
 type FailureReason int32
@@ -217,11 +275,11 @@ func (x FailureReason) String() string {
 }
 
 func (FailureReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[2].Descriptor()
+	return file_client_proto_enumTypes[3].Descriptor()
 }
 
 func (FailureReason) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[2]
+	return &file_client_proto_enumTypes[3]
 }
 
 func (x FailureReason) Number() protoreflect.EnumNumber {
@@ -230,7 +288,242 @@ func (x FailureReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FailureReason.Descriptor instead.
 func (FailureReason) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{2}
+	return file_client_proto_rawDescGZIP(), []int{3}
+}
+
+type SwapHealth int32
+
+const (
+	//
+	//SWAP_HEALTH_UNKNOWN is set for swaps that are not pending, or that have
+	//not been checked yet.
+	SwapHealth_SWAP_HEALTH_UNKNOWN SwapHealth = 0
+	//
+	//SWAP_HEALTH_HEALTHY indicates that the swap is progressing as expected.
+	SwapHealth_SWAP_HEALTH_HEALTHY SwapHealth = 1
+	//
+	//SWAP_HEALTH_SLOW indicates that the swap has spent longer than expected in
+	//its current state, but may still progress without intervention.
+	SwapHealth_SWAP_HEALTH_SLOW SwapHealth = 2
+	//
+	//SWAP_HEALTH_STUCK indicates that the swap is unlikely to progress without
+	//intervention.
+	SwapHealth_SWAP_HEALTH_STUCK SwapHealth = 3
+)
+
+// Enum value maps for SwapHealth.
+var (
+	SwapHealth_name = map[int32]string{
+		0: "SWAP_HEALTH_UNKNOWN",
+		1: "SWAP_HEALTH_HEALTHY",
+		2: "SWAP_HEALTH_SLOW",
+		3: "SWAP_HEALTH_STUCK",
+	}
+	SwapHealth_value = map[string]int32{
+		"SWAP_HEALTH_UNKNOWN": 0,
+		"SWAP_HEALTH_HEALTHY": 1,
+		"SWAP_HEALTH_SLOW":    2,
+		"SWAP_HEALTH_STUCK":   3,
+	}
+)
+
+func (x SwapHealth) Enum() *SwapHealth {
+	p := new(SwapHealth)
+	*p = x
+	return p
+}
+
+func (x SwapHealth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwapHealth) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[4].Descriptor()
+}
+
+func (SwapHealth) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[4]
+}
+
+func (x SwapHealth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwapHealth.Descriptor instead.
+func (SwapHealth) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{4}
+}
+
+type SuggestionPriority int32
+
+const (
+	//
+	//Suggestions with the largest amount are allocated budget first.
+	SuggestionPriority_SUGGESTION_PRIORITY_AMOUNT SuggestionPriority = 0
+	//
+	//Suggestions are allocated budget in descending order of their amount
+	//multiplied by the weight set for their peer.
+	SuggestionPriority_SUGGESTION_PRIORITY_WEIGHTED SuggestionPriority = 1
+	//
+	//Suggestions are allocated budget one peer at a time, largest amount first,
+	//so that a single peer cannot consume the whole budget.
+	SuggestionPriority_SUGGESTION_PRIORITY_ROUND_ROBIN SuggestionPriority = 2
+)
+
+// Enum value maps for SuggestionPriority.
+var (
+	SuggestionPriority_name = map[int32]string{
+		0: "SUGGESTION_PRIORITY_AMOUNT",
+		1: "SUGGESTION_PRIORITY_WEIGHTED",
+		2: "SUGGESTION_PRIORITY_ROUND_ROBIN",
+	}
+	SuggestionPriority_value = map[string]int32{
+		"SUGGESTION_PRIORITY_AMOUNT":      0,
+		"SUGGESTION_PRIORITY_WEIGHTED":    1,
+		"SUGGESTION_PRIORITY_ROUND_ROBIN": 2,
+	}
+)
+
+func (x SuggestionPriority) Enum() *SuggestionPriority {
+	p := new(SuggestionPriority)
+	*p = x
+	return p
+}
+
+func (x SuggestionPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestionPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[5].Descriptor()
+}
+
+func (SuggestionPriority) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[5]
+}
+
+func (x SuggestionPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestionPriority.Descriptor instead.
+func (SuggestionPriority) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{5}
+}
+
+type UnrestrictedSwapMode int32
+
+const (
+	//
+	//Pending unrestricted swaps do not affect swap suggestions.
+	UnrestrictedSwapMode_UNRESTRICTED_SWAP_MODE_IGNORE UnrestrictedSwapMode = 0
+	//
+	//No swaps are suggested while an unrestricted swap is pending.
+	UnrestrictedSwapMode_UNRESTRICTED_SWAP_MODE_FREEZE UnrestrictedSwapMode = 1
+	//
+	//The channels with the largest relevant balances are excluded from
+	//suggestions until the total amount of pending unrestricted swaps is
+	//covered, assuming the worst case for the channels that these swaps could
+	//use.
+	UnrestrictedSwapMode_UNRESTRICTED_SWAP_MODE_RESERVE UnrestrictedSwapMode = 2
+)
+
+// Enum value maps for UnrestrictedSwapMode.
+var (
+	UnrestrictedSwapMode_name = map[int32]string{
+		0: "UNRESTRICTED_SWAP_MODE_IGNORE",
+		1: "UNRESTRICTED_SWAP_MODE_FREEZE",
+		2: "UNRESTRICTED_SWAP_MODE_RESERVE",
+	}
+	UnrestrictedSwapMode_value = map[string]int32{
+		"UNRESTRICTED_SWAP_MODE_IGNORE":  0,
+		"UNRESTRICTED_SWAP_MODE_FREEZE":  1,
+		"UNRESTRICTED_SWAP_MODE_RESERVE": 2,
+	}
+)
+
+func (x UnrestrictedSwapMode) Enum() *UnrestrictedSwapMode {
+	p := new(UnrestrictedSwapMode)
+	*p = x
+	return p
+}
+
+func (x UnrestrictedSwapMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnrestrictedSwapMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[6].Descriptor()
+}
+
+func (UnrestrictedSwapMode) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[6]
+}
+
+func (x UnrestrictedSwapMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnrestrictedSwapMode.Descriptor instead.
+func (UnrestrictedSwapMode) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{6}
+}
+
+type CircularMode int32
+
+const (
+	//
+	//Circular rebalances are not estimated.
+	CircularMode_CIRCULAR_MODE_DISABLED CircularMode = 0
+	//
+	//The cost of a circular rebalance is estimated for each suggested loop
+	//out and reported, but the loop out is always suggested.
+	CircularMode_CIRCULAR_MODE_COMPARE CircularMode = 1
+	//
+	//A circular rebalance is suggested, and dispatched by autoloop, in place
+	//of a loop out whenever it is cheaper.
+	CircularMode_CIRCULAR_MODE_ALLOW CircularMode = 2
+)
+
+// Enum value maps for CircularMode.
+var (
+	CircularMode_name = map[int32]string{
+		0: "CIRCULAR_MODE_DISABLED",
+		1: "CIRCULAR_MODE_COMPARE",
+		2: "CIRCULAR_MODE_ALLOW",
+	}
+	CircularMode_value = map[string]int32{
+		"CIRCULAR_MODE_DISABLED": 0,
+		"CIRCULAR_MODE_COMPARE":  1,
+		"CIRCULAR_MODE_ALLOW":    2,
+	}
+)
+
+func (x CircularMode) Enum() *CircularMode {
+	p := new(CircularMode)
+	*p = x
+	return p
+}
+
+func (x CircularMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircularMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[7].Descriptor()
+}
+
+func (CircularMode) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[7]
+}
+
+func (x CircularMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircularMode.Descriptor instead.
+func (CircularMode) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{7}
 }
 
 type LiquidityRuleType int32
@@ -263,11 +556,11 @@ func (x LiquidityRuleType) String() string {
 }
 
 func (LiquidityRuleType) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[3].Descriptor()
+	return file_client_proto_enumTypes[8].Descriptor()
 }
 
 func (LiquidityRuleType) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[3]
+	return &file_client_proto_enumTypes[8]
 }
 
 func (x LiquidityRuleType) Number() protoreflect.EnumNumber {
@@ -276,7 +569,7 @@ func (x LiquidityRuleType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LiquidityRuleType.Descriptor instead.
 func (LiquidityRuleType) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{3}
+	return file_client_proto_rawDescGZIP(), []int{8}
 }
 
 type AutoReason int32
@@ -334,6 +627,42 @@ const (
 	//Fee insufficient indicates that the fee estimate for a swap is higher than
 	//the portion of total swap amount that we allow fees to consume.
 	AutoReason_AUTO_REASON_FEE_INSUFFICIENT AutoReason = 13
+	//
+	//Peer excluded indicates that a target is not eligible for swaps because
+	//its peer is excluded by our peer allow or deny lists.
+	AutoReason_AUTO_REASON_PEER_EXCLUDED AutoReason = 14
+	//
+	//Channel inactive indicates that a channel is not eligible for swaps
+	//because it is currently inactive, which is the case when our peer is
+	//offline.
+	AutoReason_AUTO_REASON_CHANNEL_INACTIVE AutoReason = 15
+	//
+	//Low uptime indicates that a channel is not eligible for swaps because our
+	//peer's observed uptime is below our configured minimum.
+	AutoReason_AUTO_REASON_LOW_UPTIME AutoReason = 16
+	//
+	//Unrestricted swap indicates that no swaps are suggested because a swap
+	//that may use any of our channels is pending, and we are configured to
+	//freeze suggestions while such swaps are in flight.
+	AutoReason_AUTO_REASON_UNRESTRICTED_SWAP AutoReason = 17
+	//
+	//Channel closing indicates that a channel is not eligible for swaps because
+	//it is pending close.
+	AutoReason_AUTO_REASON_CHANNEL_CLOSING AutoReason = 18
+	//
+	//Success cooldown indicates that a channel was recently part of a
+	//successful automatically dispatched swap, and the cooldown period has not
+	//yet passed.
+	AutoReason_AUTO_REASON_SUCCESS_COOLDOWN AutoReason = 19
+	//
+	//Peer fee increase indicates that a channel is not eligible for swaps
+	//because our peer recently raised the fee that it charges to forward over
+	//the channel by more than our configured maximum.
+	AutoReason_AUTO_REASON_PEER_FEE_INCREASE AutoReason = 20
+	//
+	//Channel excluded indicates that a channel is not eligible for swaps
+	//because it was excluded from a single set of suggestions.
+	AutoReason_AUTO_REASON_CHANNEL_EXCLUDED AutoReason = 21
 )
 
 // Enum value maps for AutoReason.
@@ -353,6 +682,14 @@ var (
 		11: "AUTO_REASON_LIQUIDITY_OK",
 		12: "AUTO_REASON_BUDGET_INSUFFICIENT",
 		13: "AUTO_REASON_FEE_INSUFFICIENT",
+		14: "AUTO_REASON_PEER_EXCLUDED",
+		15: "AUTO_REASON_CHANNEL_INACTIVE",
+		16: "AUTO_REASON_LOW_UPTIME",
+		17: "AUTO_REASON_UNRESTRICTED_SWAP",
+		18: "AUTO_REASON_CHANNEL_CLOSING",
+		19: "AUTO_REASON_SUCCESS_COOLDOWN",
+		20: "AUTO_REASON_PEER_FEE_INCREASE",
+		21: "AUTO_REASON_CHANNEL_EXCLUDED",
 	}
 	AutoReason_value = map[string]int32{
 		"AUTO_REASON_UNKNOWN":             0,
@@ -369,6 +706,14 @@ var (
 		"AUTO_REASON_LIQUIDITY_OK":        11,
 		"AUTO_REASON_BUDGET_INSUFFICIENT": 12,
 		"AUTO_REASON_FEE_INSUFFICIENT":    13,
+		"AUTO_REASON_PEER_EXCLUDED":       14,
+		"AUTO_REASON_CHANNEL_INACTIVE":    15,
+		"AUTO_REASON_LOW_UPTIME":          16,
+		"AUTO_REASON_UNRESTRICTED_SWAP":   17,
+		"AUTO_REASON_CHANNEL_CLOSING":     18,
+		"AUTO_REASON_SUCCESS_COOLDOWN":    19,
+		"AUTO_REASON_PEER_FEE_INCREASE":   20,
+		"AUTO_REASON_CHANNEL_EXCLUDED":    21,
 	}
 )
 
@@ -383,11 +728,11 @@ func (x AutoReason) String() string {
 }
 
 func (AutoReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[4].Descriptor()
+	return file_client_proto_enumTypes[9].Descriptor()
 }
 
 func (AutoReason) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[4]
+	return &file_client_proto_enumTypes[9]
 }
 
 func (x AutoReason) Number() protoreflect.EnumNumber {
@@ -396,594 +741,807 @@ func (x AutoReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AutoReason.Descriptor instead.
 func (AutoReason) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{4}
+	return file_client_proto_rawDescGZIP(), []int{9}
 }
 
-type LoopOutRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type FeeDecision int32
 
+const (
 	//
-	//Requested swap amount in sat. This does not include the swap and miner fee.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Base58 encoded destination address for the swap.
-	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	//The check of the sweep fee estimate against the fee limit of the liquidity
+	//manager before it suggests any swaps.
+	FeeDecision_FEE_DECISION_SUGGESTION FeeDecision = 0
 	//
-	//Maximum off-chain fee in sat that may be paid for swap payment to the
-	//server. This limit is applied during path finding. Typically this value is
-	//taken from the response of the GetQuote call.
-	MaxSwapRoutingFee int64 `protobuf:"varint,3,opt,name=max_swap_routing_fee,json=maxSwapRoutingFee,proto3" json:"max_swap_routing_fee,omitempty"`
+	//The publication of the htlc of a loop in.
+	FeeDecision_FEE_DECISION_HTLC FeeDecision = 1
 	//
-	//Maximum off-chain fee in sat that may be paid for the prepay to the server.
-	//This limit is applied during path finding. Typically this value is taken
-	//from the response of the GetQuote call.
-	MaxPrepayRoutingFee int64 `protobuf:"varint,4,opt,name=max_prepay_routing_fee,json=maxPrepayRoutingFee,proto3" json:"max_prepay_routing_fee,omitempty"`
+	//The publication of the sweep of an htlc, which is the success sweep of a
+	//loop out or the timeout sweep of a loop in.
+	FeeDecision_FEE_DECISION_SWEEP FeeDecision = 2
+)
+
+// Enum value maps for FeeDecision.
+var (
+	FeeDecision_name = map[int32]string{
+		0: "FEE_DECISION_SUGGESTION",
+		1: "FEE_DECISION_HTLC",
+		2: "FEE_DECISION_SWEEP",
+	}
+	FeeDecision_value = map[string]int32{
+		"FEE_DECISION_SUGGESTION": 0,
+		"FEE_DECISION_HTLC":       1,
+		"FEE_DECISION_SWEEP":      2,
+	}
+)
+
+func (x FeeDecision) Enum() *FeeDecision {
+	p := new(FeeDecision)
+	*p = x
+	return p
+}
+
+func (x FeeDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[10].Descriptor()
+}
+
+func (FeeDecision) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[10]
+}
+
+func (x FeeDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeDecision.Descriptor instead.
+func (FeeDecision) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{10}
+}
+
+type DispatchSource int32
+
+const (
 	//
-	//Maximum we are willing to pay the server for the swap. This value is not
-	//disclosed in the swap initiation call, but if the server asks for a
-	//higher fee, we abort the swap. Typically this value is taken from the
-	//response of the GetQuote call. It includes the prepay amount.
-	MaxSwapFee int64 `protobuf:"varint,5,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//The swap was dispatched by autoloop.
+	DispatchSource_DISPATCH_SOURCE_AUTOLOOP DispatchSource = 0
 	//
-	//Maximum amount of the swap fee that may be charged as a prepayment.
-	MaxPrepayAmt int64 `protobuf:"varint,6,opt,name=max_prepay_amt,json=maxPrepayAmt,proto3" json:"max_prepay_amt,omitempty"`
+	//The swap was dispatched because the suggestion was applied with
+	//ApplySuggestions.
+	DispatchSource_DISPATCH_SOURCE_APPLIED DispatchSource = 1
+)
+
+// Enum value maps for DispatchSource.
+var (
+	DispatchSource_name = map[int32]string{
+		0: "DISPATCH_SOURCE_AUTOLOOP",
+		1: "DISPATCH_SOURCE_APPLIED",
+	}
+	DispatchSource_value = map[string]int32{
+		"DISPATCH_SOURCE_AUTOLOOP": 0,
+		"DISPATCH_SOURCE_APPLIED":  1,
+	}
+)
+
+func (x DispatchSource) Enum() *DispatchSource {
+	p := new(DispatchSource)
+	*p = x
+	return p
+}
+
+func (x DispatchSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DispatchSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[11].Descriptor()
+}
+
+func (DispatchSource) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[11]
+}
+
+func (x DispatchSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DispatchSource.Descriptor instead.
+func (DispatchSource) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{11}
+}
+
+type ProfileType int32
+
+const (
 	//
-	//Maximum in on-chain fees that we are willing to spend. If we want to
-	//sweep the on-chain htlc and the fee estimate turns out higher than this
-	//value, we cancel the swap. If the fee estimate is lower, we publish the
-	//sweep tx.
+	//The stack traces of all current goroutines, written as text.
+	ProfileType_GOROUTINE ProfileType = 0
 	//
-	//If the sweep tx is not confirmed, we are forced to ratchet up fees until it
-	//is swept. Possibly even exceeding max_miner_fee if we get close to the htlc
-	//timeout. Because the initial publication revealed the preimage, we have no
-	//other choice. The server may already have pulled the off-chain htlc. Only
-	//when the fee becomes higher than the swap amount, we can only wait for fees
-	//to come down and hope - if we are past the timeout - that the server is not
-	//publishing the revocation.
-	//
-	//max_miner_fee is typically taken from the response of the GetQuote call.
-	MaxMinerFee int64 `protobuf:"varint,7,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
-	//
-	//Deprecated, use outgoing_chan_set. The channel to loop out, the channel
-	//to loop out is selected based on the lowest routing fee for the swap
-	//payment to the server.
-	//
-	// Deprecated: Do not use.
-	LoopOutChannel uint64 `protobuf:"varint,8,opt,name=loop_out_channel,json=loopOutChannel,proto3" json:"loop_out_channel,omitempty"`
-	//
-	//A restriction on the channel set that may be used to loop out. The actual
-	//channel(s) that will be used are selected based on the lowest routing fee
-	//for the swap payment to the server.
-	OutgoingChanSet []uint64 `protobuf:"varint,11,rep,packed,name=outgoing_chan_set,json=outgoingChanSet,proto3" json:"outgoing_chan_set,omitempty"`
-	//
-	//The number of blocks from the on-chain HTLC's confirmation height that it
-	//should be swept within.
-	SweepConfTarget int32 `protobuf:"varint,9,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
-	//
-	//The number of confirmations that we require for the on chain htlc that will
-	//be published by the server before we reveal the preimage.
-	HtlcConfirmations int32 `protobuf:"varint,13,opt,name=htlc_confirmations,json=htlcConfirmations,proto3" json:"htlc_confirmations,omitempty"`
-	//
-	//The latest time (in unix seconds) we allow the server to wait before
-	//publishing the HTLC on chain. Setting this to a larger value will give the
-	//server the opportunity to batch multiple swaps together, and wait for
-	//low-fee periods before publishing the HTLC, potentially resulting in a
-	//lower total swap fee.
-	SwapPublicationDeadline uint64 `protobuf:"varint,10,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
-	//
-	//An optional label for this swap. This field is limited to 500 characters
-	//and may not start with the prefix [reserved], which is used to tag labels
-	//produced by the daemon.
-	Label string `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
-	//
-	//An optional identification string that will be appended to the user agent
-	//string sent to the server to give information about the usage of loop. This
-	//initiator part is meant for user interfaces to add their name to give the
-	//full picture of the binary used (loopd, LiT) and the method used for
-	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
-	Initiator string `protobuf:"bytes,14,opt,name=initiator,proto3" json:"initiator,omitempty"`
-}
+	//A sampling of the daemon's memory allocations, written in the format
+	//expected by the pprof tool.
+	ProfileType_HEAP ProfileType = 1
+)
 
-func (x *LoopOutRequest) Reset() {
-	*x = LoopOutRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ProfileType.
+var (
+	ProfileType_name = map[int32]string{
+		0: "GOROUTINE",
+		1: "HEAP",
 	}
-}
+	ProfileType_value = map[string]int32{
+		"GOROUTINE": 0,
+		"HEAP":      1,
+	}
+)
 
-func (x *LoopOutRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ProfileType) Enum() *ProfileType {
+	p := new(ProfileType)
+	*p = x
+	return p
 }
 
-func (*LoopOutRequest) ProtoMessage() {}
+func (x ProfileType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *LoopOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ProfileType) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[12].Descriptor()
 }
 
-// Deprecated: Use LoopOutRequest.ProtoReflect.Descriptor instead.
-func (*LoopOutRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{0}
+func (ProfileType) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[12]
 }
 
-func (x *LoopOutRequest) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
+func (x ProfileType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopOutRequest) GetDest() string {
-	if x != nil {
-		return x.Dest
-	}
-	return ""
+// Deprecated: Use ProfileType.Descriptor instead.
+func (ProfileType) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LoopOutRequest) GetMaxSwapRoutingFee() int64 {
-	if x != nil {
-		return x.MaxSwapRoutingFee
+type BudgetNamespace int32
+
+const (
+	//
+	//The budget applies to the swaps of a tenant in multi-tenant mode, which
+	//is identified by its macaroon identity.
+	BudgetNamespace_BUDGET_NAMESPACE_TENANT BudgetNamespace = 0
+	//
+	//The budget applies to the swaps with a label.
+	BudgetNamespace_BUDGET_NAMESPACE_LABEL BudgetNamespace = 1
+)
+
+// Enum value maps for BudgetNamespace.
+var (
+	BudgetNamespace_name = map[int32]string{
+		0: "BUDGET_NAMESPACE_TENANT",
+		1: "BUDGET_NAMESPACE_LABEL",
 	}
-	return 0
+	BudgetNamespace_value = map[string]int32{
+		"BUDGET_NAMESPACE_TENANT": 0,
+		"BUDGET_NAMESPACE_LABEL":  1,
+	}
+)
+
+func (x BudgetNamespace) Enum() *BudgetNamespace {
+	p := new(BudgetNamespace)
+	*p = x
+	return p
 }
 
-func (x *LoopOutRequest) GetMaxPrepayRoutingFee() int64 {
-	if x != nil {
-		return x.MaxPrepayRoutingFee
-	}
-	return 0
+func (x BudgetNamespace) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoopOutRequest) GetMaxSwapFee() int64 {
-	if x != nil {
-		return x.MaxSwapFee
-	}
-	return 0
+func (BudgetNamespace) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[13].Descriptor()
 }
 
-func (x *LoopOutRequest) GetMaxPrepayAmt() int64 {
-	if x != nil {
-		return x.MaxPrepayAmt
-	}
-	return 0
+func (BudgetNamespace) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[13]
 }
 
-func (x *LoopOutRequest) GetMaxMinerFee() int64 {
-	if x != nil {
-		return x.MaxMinerFee
-	}
-	return 0
+func (x BudgetNamespace) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *LoopOutRequest) GetLoopOutChannel() uint64 {
-	if x != nil {
-		return x.LoopOutChannel
-	}
-	return 0
+// Deprecated: Use BudgetNamespace.Descriptor instead.
+func (BudgetNamespace) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LoopOutRequest) GetOutgoingChanSet() []uint64 {
-	if x != nil {
-		return x.OutgoingChanSet
+type DrainState int32
+
+const (
+	//
+	//DRAIN_SWAPPING indicates that swaps are still being dispatched.
+	DrainState_DRAIN_SWAPPING DrainState = 0
+	//
+	//DRAIN_CLOSING indicates that the channel was drained and that its
+	//closing transaction was published.
+	DrainState_DRAIN_CLOSING DrainState = 1
+	//
+	//DRAIN_COMPLETE indicates that the channel was drained and left open.
+	DrainState_DRAIN_COMPLETE DrainState = 2
+)
+
+// Enum value maps for DrainState.
+var (
+	DrainState_name = map[int32]string{
+		0: "DRAIN_SWAPPING",
+		1: "DRAIN_CLOSING",
+		2: "DRAIN_COMPLETE",
 	}
-	return nil
+	DrainState_value = map[string]int32{
+		"DRAIN_SWAPPING": 0,
+		"DRAIN_CLOSING":  1,
+		"DRAIN_COMPLETE": 2,
+	}
+)
+
+func (x DrainState) Enum() *DrainState {
+	p := new(DrainState)
+	*p = x
+	return p
 }
 
-func (x *LoopOutRequest) GetSweepConfTarget() int32 {
-	if x != nil {
-		return x.SweepConfTarget
-	}
-	return 0
+func (x DrainState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoopOutRequest) GetHtlcConfirmations() int32 {
-	if x != nil {
-		return x.HtlcConfirmations
-	}
-	return 0
+func (DrainState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[14].Descriptor()
 }
 
-func (x *LoopOutRequest) GetSwapPublicationDeadline() uint64 {
-	if x != nil {
-		return x.SwapPublicationDeadline
-	}
-	return 0
+func (DrainState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[14]
 }
 
-func (x *LoopOutRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+func (x DrainState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopOutRequest) GetInitiator() string {
-	if x != nil {
-		return x.Initiator
-	}
-	return ""
+// Deprecated: Use DrainState.Descriptor instead.
+func (DrainState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{14}
 }
 
-type LoopInRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type FillState int32
 
+const (
 	//
-	//Requested swap amount in sat. This does not include the swap and miner
-	//fee.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Maximum we are willing to pay the server for the swap. This value is not
-	//disclosed in the swap initiation call, but if the server asks for a
-	//higher fee, we abort the swap. Typically this value is taken from the
-	//response of the GetQuote call.
-	MaxSwapFee int64 `protobuf:"varint,2,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
-	//
-	//Maximum in on-chain fees that we are willing to spend. If we want to
-	//publish the on-chain htlc and the fee estimate turns out higher than this
-	//value, we cancel the swap.
-	//
-	//max_miner_fee is typically taken from the response of the GetQuote call.
-	MaxMinerFee int64 `protobuf:"varint,3,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
-	//
-	//The last hop to use for the loop in swap. If empty, the last hop is selected
-	//based on the lowest routing fee for the swap payment from the server.
-	LastHop []byte `protobuf:"bytes,4,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
-	//
-	//If external_htlc is true, we expect the htlc to be published by an external
-	//actor.
-	ExternalHtlc bool `protobuf:"varint,5,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
-	//
-	//The number of blocks that the on chain htlc should confirm within.
-	HtlcConfTarget int32 `protobuf:"varint,6,opt,name=htlc_conf_target,json=htlcConfTarget,proto3" json:"htlc_conf_target,omitempty"`
+	//FILL_WAITING indicates that the channel is not yet open and active.
+	FillState_FILL_WAITING FillState = 0
 	//
-	//An optional label for this swap. This field is limited to 500 characters
-	//and may not be one of the reserved values in loop/labels Reserved list.
-	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	//FILL_SWAPPING indicates that swaps are still being dispatched.
+	FillState_FILL_SWAPPING FillState = 1
 	//
-	//An optional identification string that will be appended to the user agent
-	//string sent to the server to give information about the usage of loop. This
-	//initiator part is meant for user interfaces to add their name to give the
-	//full picture of the binary used (loopd, LiT) and the method used for
-	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
-	Initiator string `protobuf:"bytes,8,opt,name=initiator,proto3" json:"initiator,omitempty"`
-}
+	//FILL_COMPLETE indicates that the channel is as close to its target
+	//balance as swaps can bring it.
+	FillState_FILL_COMPLETE FillState = 2
+)
 
-func (x *LoopInRequest) Reset() {
-	*x = LoopInRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for FillState.
+var (
+	FillState_name = map[int32]string{
+		0: "FILL_WAITING",
+		1: "FILL_SWAPPING",
+		2: "FILL_COMPLETE",
 	}
+	FillState_value = map[string]int32{
+		"FILL_WAITING":  0,
+		"FILL_SWAPPING": 1,
+		"FILL_COMPLETE": 2,
+	}
+)
+
+func (x FillState) Enum() *FillState {
+	p := new(FillState)
+	*p = x
+	return p
 }
 
-func (x *LoopInRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x FillState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*LoopInRequest) ProtoMessage() {}
+func (FillState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[15].Descriptor()
+}
 
-func (x *LoopInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (FillState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[15]
 }
 
-// Deprecated: Use LoopInRequest.ProtoReflect.Descriptor instead.
-func (*LoopInRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{1}
+func (x FillState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopInRequest) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
+// Deprecated: Use FillState.Descriptor instead.
+func (FillState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LoopInRequest) GetMaxSwapFee() int64 {
-	if x != nil {
-		return x.MaxSwapFee
-	}
-	return 0
-}
+type SwapPlanState int32
 
-func (x *LoopInRequest) GetMaxMinerFee() int64 {
-	if x != nil {
-		return x.MaxMinerFee
+const (
+	//
+	//PLAN_PAUSED indicates that the plan's steps are not dispatched.
+	SwapPlanState_PLAN_PAUSED SwapPlanState = 0
+	//
+	//PLAN_RUNNING indicates that the plan's steps are dispatched once they are
+	//due.
+	SwapPlanState_PLAN_RUNNING SwapPlanState = 1
+	//
+	//PLAN_COMPLETE indicates that all of the plan's steps succeeded.
+	SwapPlanState_PLAN_COMPLETE SwapPlanState = 2
+	//
+	//PLAN_FAILED indicates that one of the plan's steps failed.
+	SwapPlanState_PLAN_FAILED SwapPlanState = 3
+	//
+	//PLAN_CANCELLED indicates that the plan was cancelled.
+	SwapPlanState_PLAN_CANCELLED SwapPlanState = 4
+)
+
+// Enum value maps for SwapPlanState.
+var (
+	SwapPlanState_name = map[int32]string{
+		0: "PLAN_PAUSED",
+		1: "PLAN_RUNNING",
+		2: "PLAN_COMPLETE",
+		3: "PLAN_FAILED",
+		4: "PLAN_CANCELLED",
+	}
+	SwapPlanState_value = map[string]int32{
+		"PLAN_PAUSED":    0,
+		"PLAN_RUNNING":   1,
+		"PLAN_COMPLETE":  2,
+		"PLAN_FAILED":    3,
+		"PLAN_CANCELLED": 4,
 	}
-	return 0
+)
+
+func (x SwapPlanState) Enum() *SwapPlanState {
+	p := new(SwapPlanState)
+	*p = x
+	return p
 }
 
-func (x *LoopInRequest) GetLastHop() []byte {
-	if x != nil {
-		return x.LastHop
-	}
-	return nil
+func (x SwapPlanState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoopInRequest) GetExternalHtlc() bool {
-	if x != nil {
-		return x.ExternalHtlc
-	}
-	return false
+func (SwapPlanState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[16].Descriptor()
 }
 
-func (x *LoopInRequest) GetHtlcConfTarget() int32 {
-	if x != nil {
-		return x.HtlcConfTarget
-	}
-	return 0
+func (SwapPlanState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[16]
 }
 
-func (x *LoopInRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+func (x SwapPlanState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopInRequest) GetInitiator() string {
-	if x != nil {
-		return x.Initiator
-	}
-	return ""
+// Deprecated: Use SwapPlanState.Descriptor instead.
+func (SwapPlanState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{16}
 }
 
-type SwapResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type PlanStepState int32
 
+const (
 	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	//DEPRECATED: To make the API more consistent, this field is deprecated in
-	//favor of id_bytes and will be removed in a future release.
-	//
-	// Deprecated: Do not use.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	IdBytes []byte `protobuf:"bytes,3,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
-	//
-	//DEPRECATED. This field stores the address of the onchain htlc, but
-	//depending on the request, the semantics are different.
-	//- For internal loop-in htlc_address contains the address of the
-	//native segwit (P2WSH) htlc.
-	//- For external loop-in htlc_address contains the address of the
-	//nested segwit (NP2WSH) htlc.
-	//- For loop-out htlc_address always contains the native segwit (P2WSH)
-	//htlc address.
+	//STEP_PENDING indicates that the step's swap has not been dispatched.
+	PlanStepState_STEP_PENDING PlanStepState = 0
 	//
-	// Deprecated: Do not use.
-	HtlcAddress string `protobuf:"bytes,2,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
+	//STEP_DISPATCHED indicates that the step's swap is in flight.
+	PlanStepState_STEP_DISPATCHED PlanStepState = 1
 	//
-	//The nested segwit address of the on-chain htlc.
-	//This field remains empty for loop-out.
-	HtlcAddressNp2Wsh string `protobuf:"bytes,4,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
+	//STEP_SUCCEEDED indicates that the step's swap succeeded.
+	PlanStepState_STEP_SUCCEEDED PlanStepState = 2
 	//
-	//The native segwit address of the on-chain htlc.
-	//Used for both loop-in and loop-out.
-	HtlcAddressP2Wsh string `protobuf:"bytes,5,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
-	// A human-readable message received from the loop server.
-	ServerMessage string `protobuf:"bytes,6,opt,name=server_message,json=serverMessage,proto3" json:"server_message,omitempty"`
-}
+	//STEP_FAILED indicates that the step's swap failed.
+	PlanStepState_STEP_FAILED PlanStepState = 3
+)
 
-func (x *SwapResponse) Reset() {
-	*x = SwapResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for PlanStepState.
+var (
+	PlanStepState_name = map[int32]string{
+		0: "STEP_PENDING",
+		1: "STEP_DISPATCHED",
+		2: "STEP_SUCCEEDED",
+		3: "STEP_FAILED",
+	}
+	PlanStepState_value = map[string]int32{
+		"STEP_PENDING":    0,
+		"STEP_DISPATCHED": 1,
+		"STEP_SUCCEEDED":  2,
+		"STEP_FAILED":     3,
 	}
+)
+
+func (x PlanStepState) Enum() *PlanStepState {
+	p := new(PlanStepState)
+	*p = x
+	return p
 }
 
-func (x *SwapResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PlanStepState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*SwapResponse) ProtoMessage() {}
+func (PlanStepState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[17].Descriptor()
+}
 
-func (x *SwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (PlanStepState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[17]
 }
 
-// Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
-func (*SwapResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{2}
+func (x PlanStepState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *SwapResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use PlanStepState.Descriptor instead.
+func (PlanStepState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SwapResponse) GetIdBytes() []byte {
-	if x != nil {
-		return x.IdBytes
+type SwapPlanAction int32
+
+const (
+	//
+	//PLAN_RESUME starts dispatching the plan's steps again.
+	SwapPlanAction_PLAN_RESUME SwapPlanAction = 0
+	//
+	//PLAN_PAUSE stops dispatching the plan's steps until it is resumed.
+	SwapPlanAction_PLAN_PAUSE SwapPlanAction = 1
+	//
+	//PLAN_CANCEL stops dispatching the plan's steps permanently.
+	SwapPlanAction_PLAN_CANCEL SwapPlanAction = 2
+)
+
+// Enum value maps for SwapPlanAction.
+var (
+	SwapPlanAction_name = map[int32]string{
+		0: "PLAN_RESUME",
+		1: "PLAN_PAUSE",
+		2: "PLAN_CANCEL",
 	}
-	return nil
+	SwapPlanAction_value = map[string]int32{
+		"PLAN_RESUME": 0,
+		"PLAN_PAUSE":  1,
+		"PLAN_CANCEL": 2,
+	}
+)
+
+func (x SwapPlanAction) Enum() *SwapPlanAction {
+	p := new(SwapPlanAction)
+	*p = x
+	return p
 }
 
-// Deprecated: Do not use.
-func (x *SwapResponse) GetHtlcAddress() string {
-	if x != nil {
-		return x.HtlcAddress
-	}
-	return ""
+func (x SwapPlanAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SwapResponse) GetHtlcAddressNp2Wsh() string {
-	if x != nil {
-		return x.HtlcAddressNp2Wsh
-	}
-	return ""
+func (SwapPlanAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[18].Descriptor()
 }
 
-func (x *SwapResponse) GetHtlcAddressP2Wsh() string {
-	if x != nil {
-		return x.HtlcAddressP2Wsh
-	}
-	return ""
+func (SwapPlanAction) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[18]
 }
 
-func (x *SwapResponse) GetServerMessage() string {
-	if x != nil {
-		return x.ServerMessage
-	}
-	return ""
+func (x SwapPlanAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-type MonitorRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use SwapPlanAction.Descriptor instead.
+func (SwapPlanAction) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MonitorRequest) Reset() {
-	*x = MonitorRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type ExternalLoopInState int32
+
+const (
+	//
+	//EXTERNAL_AWAITING_PAYMENT indicates that the swap was initiated and the
+	//external wallet should pay the htlc.
+	ExternalLoopInState_EXTERNAL_AWAITING_PAYMENT ExternalLoopInState = 0
+	//
+	//EXTERNAL_PAYMENT_CONFIRMED indicates that a payment to the htlc confirmed
+	//on chain, and we are waiting for the server to pay our swap invoice.
+	ExternalLoopInState_EXTERNAL_PAYMENT_CONFIRMED ExternalLoopInState = 1
+	//
+	//EXTERNAL_COMPLETE indicates that the swap completed successfully.
+	ExternalLoopInState_EXTERNAL_COMPLETE ExternalLoopInState = 2
+	//
+	//EXTERNAL_FAILED indicates that the swap failed.
+	ExternalLoopInState_EXTERNAL_FAILED ExternalLoopInState = 3
+)
+
+// Enum value maps for ExternalLoopInState.
+var (
+	ExternalLoopInState_name = map[int32]string{
+		0: "EXTERNAL_AWAITING_PAYMENT",
+		1: "EXTERNAL_PAYMENT_CONFIRMED",
+		2: "EXTERNAL_COMPLETE",
+		3: "EXTERNAL_FAILED",
+	}
+	ExternalLoopInState_value = map[string]int32{
+		"EXTERNAL_AWAITING_PAYMENT":  0,
+		"EXTERNAL_PAYMENT_CONFIRMED": 1,
+		"EXTERNAL_COMPLETE":          2,
+		"EXTERNAL_FAILED":            3,
 	}
+)
+
+func (x ExternalLoopInState) Enum() *ExternalLoopInState {
+	p := new(ExternalLoopInState)
+	*p = x
+	return p
 }
 
-func (x *MonitorRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ExternalLoopInState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*MonitorRequest) ProtoMessage() {}
+func (ExternalLoopInState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[19].Descriptor()
+}
 
-func (x *MonitorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ExternalLoopInState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[19]
 }
 
-// Deprecated: Use MonitorRequest.ProtoReflect.Descriptor instead.
-func (*MonitorRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{3}
+func (x ExternalLoopInState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-type SwapStatus struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use ExternalLoopInState.Descriptor instead.
+func (ExternalLoopInState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{19}
+}
 
+type ServerConnectionState int32
+
+const (
 	//
-	//Requested swap amount in sat. This does not include the swap and miner
-	//fee.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	//DEPRECATED: To make the API more consistent, this field is deprecated in
-	//favor of id_bytes and will be removed in a future release.
-	//
-	// Deprecated: Do not use.
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	IdBytes []byte `protobuf:"bytes,11,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
-	//
-	//The type of the swap.
-	Type SwapType `protobuf:"varint,3,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
-	//
-	//State the swap is currently in, see State enum.
-	State SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=looprpc.SwapState" json:"state,omitempty"`
-	//
-	//A failure reason for the swap, only set if the swap has failed.
-	FailureReason FailureReason `protobuf:"varint,14,opt,name=failure_reason,json=failureReason,proto3,enum=looprpc.FailureReason" json:"failure_reason,omitempty"`
+	//There is no connection to the server, one will be established once a
+	//request is made.
+	ServerConnectionState_SERVER_CONNECTION_IDLE ServerConnectionState = 0
 	//
-	//Initiation time of the swap.
-	InitiationTime int64 `protobuf:"varint,5,opt,name=initiation_time,json=initiationTime,proto3" json:"initiation_time,omitempty"`
+	//The daemon is connecting to the server.
+	ServerConnectionState_SERVER_CONNECTION_CONNECTING ServerConnectionState = 1
 	//
-	//Initiation time of the swap.
-	LastUpdateTime int64 `protobuf:"varint,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+	//The daemon is connected to the server.
+	ServerConnectionState_SERVER_CONNECTION_READY ServerConnectionState = 2
 	//
-	//DEPRECATED:  This field stores the address of the onchain htlc.
-	//- For internal loop-in htlc_address contains the address of the
-	//native segwit (P2WSH) htlc.
-	//- For external loop-in htlc_address contains the nested segwit (NP2WSH)
-	//address.
-	//- For loop-out htlc_address always contains the native segwit (P2WSH)
-	//htlc address.
+	//The connection to the server failed, the daemon will reconnect after
+	//backing off.
+	ServerConnectionState_SERVER_CONNECTION_TRANSIENT_FAILURE ServerConnectionState = 3
 	//
-	// Deprecated: Do not use.
-	HtlcAddress string `protobuf:"bytes,7,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
-	// HTLC address (native segwit), used in loop-in and loop-out swaps.
-	HtlcAddressP2Wsh string `protobuf:"bytes,12,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
-	// HTLC address (nested segwit), used in loop-in swaps only.
-	HtlcAddressNp2Wsh string `protobuf:"bytes,13,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
-	// Swap server cost
-	CostServer int64 `protobuf:"varint,8,opt,name=cost_server,json=costServer,proto3" json:"cost_server,omitempty"`
-	// On-chain transaction cost
-	CostOnchain int64 `protobuf:"varint,9,opt,name=cost_onchain,json=costOnchain,proto3" json:"cost_onchain,omitempty"`
-	// Off-chain routing fees
-	CostOffchain int64 `protobuf:"varint,10,opt,name=cost_offchain,json=costOffchain,proto3" json:"cost_offchain,omitempty"`
-	// An optional label given to the swap on creation.
-	Label string `protobuf:"bytes,15,opt,name=label,proto3" json:"label,omitempty"`
+	//The connection to the server was closed.
+	ServerConnectionState_SERVER_CONNECTION_SHUTDOWN ServerConnectionState = 4
+)
+
+// Enum value maps for ServerConnectionState.
+var (
+	ServerConnectionState_name = map[int32]string{
+		0: "SERVER_CONNECTION_IDLE",
+		1: "SERVER_CONNECTION_CONNECTING",
+		2: "SERVER_CONNECTION_READY",
+		3: "SERVER_CONNECTION_TRANSIENT_FAILURE",
+		4: "SERVER_CONNECTION_SHUTDOWN",
+	}
+	ServerConnectionState_value = map[string]int32{
+		"SERVER_CONNECTION_IDLE":              0,
+		"SERVER_CONNECTION_CONNECTING":        1,
+		"SERVER_CONNECTION_READY":             2,
+		"SERVER_CONNECTION_TRANSIENT_FAILURE": 3,
+		"SERVER_CONNECTION_SHUTDOWN":          4,
+	}
+)
+
+func (x ServerConnectionState) Enum() *ServerConnectionState {
+	p := new(ServerConnectionState)
+	*p = x
+	return p
 }
 
-func (x *SwapStatus) Reset() {
-	*x = SwapStatus{}
+func (x ServerConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[20].Descriptor()
+}
+
+func (ServerConnectionState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[20]
+}
+
+func (x ServerConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServerConnectionState.Descriptor instead.
+func (ServerConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{20}
+}
+
+type LndConnectionState int32
+
+const (
+	//
+	//The daemon is subscribed to lnd.
+	LndConnectionState_LND_CONNECTION_CONNECTED LndConnectionState = 0
+	//
+	//The daemon's subscriptions to lnd failed, for example because lnd
+	//restarted, and the daemon is resubscribing with a backoff.
+	LndConnectionState_LND_CONNECTION_DISCONNECTED LndConnectionState = 1
+)
+
+// Enum value maps for LndConnectionState.
+var (
+	LndConnectionState_name = map[int32]string{
+		0: "LND_CONNECTION_CONNECTED",
+		1: "LND_CONNECTION_DISCONNECTED",
+	}
+	LndConnectionState_value = map[string]int32{
+		"LND_CONNECTION_CONNECTED":    0,
+		"LND_CONNECTION_DISCONNECTED": 1,
+	}
+)
+
+func (x LndConnectionState) Enum() *LndConnectionState {
+	p := new(LndConnectionState)
+	*p = x
+	return p
+}
+
+func (x LndConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LndConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[21].Descriptor()
+}
+
+func (LndConnectionState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[21]
+}
+
+func (x LndConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LndConnectionState.Descriptor instead.
+func (LndConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{21}
+}
+
+type LoopOutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Requested swap amount in sat. This does not include the swap and miner fee.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Base58 encoded destination address for the swap.
+	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	//
+	//Maximum off-chain fee in sat that may be paid for swap payment to the
+	//server. This limit is applied during path finding. Typically this value is
+	//taken from the response of the GetQuote call.
+	MaxSwapRoutingFee int64 `protobuf:"varint,3,opt,name=max_swap_routing_fee,json=maxSwapRoutingFee,proto3" json:"max_swap_routing_fee,omitempty"`
+	//
+	//Maximum off-chain fee in sat that may be paid for the prepay to the server.
+	//This limit is applied during path finding. Typically this value is taken
+	//from the response of the GetQuote call.
+	MaxPrepayRoutingFee int64 `protobuf:"varint,4,opt,name=max_prepay_routing_fee,json=maxPrepayRoutingFee,proto3" json:"max_prepay_routing_fee,omitempty"`
+	//
+	//Maximum we are willing to pay the server for the swap. This value is not
+	//disclosed in the swap initiation call, but if the server asks for a
+	//higher fee, we abort the swap. Typically this value is taken from the
+	//response of the GetQuote call. It includes the prepay amount.
+	MaxSwapFee int64 `protobuf:"varint,5,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//
+	//Maximum amount of the swap fee that may be charged as a prepayment.
+	MaxPrepayAmt int64 `protobuf:"varint,6,opt,name=max_prepay_amt,json=maxPrepayAmt,proto3" json:"max_prepay_amt,omitempty"`
+	//
+	//Maximum in on-chain fees that we are willing to spend. If we want to
+	//sweep the on-chain htlc and the fee estimate turns out higher than this
+	//value, we cancel the swap. If the fee estimate is lower, we publish the
+	//sweep tx.
+	//
+	//If the sweep tx is not confirmed, we are forced to ratchet up fees until it
+	//is swept. Possibly even exceeding max_miner_fee if we get close to the htlc
+	//timeout. Because the initial publication revealed the preimage, we have no
+	//other choice. The server may already have pulled the off-chain htlc. Only
+	//when the fee becomes higher than the swap amount, we can only wait for fees
+	//to come down and hope - if we are past the timeout - that the server is not
+	//publishing the revocation.
+	//
+	//max_miner_fee is typically taken from the response of the GetQuote call.
+	MaxMinerFee int64 `protobuf:"varint,7,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//
+	//Deprecated, use outgoing_chan_set. The channel to loop out, the channel
+	//to loop out is selected based on the lowest routing fee for the swap
+	//payment to the server.
+	//
+	// Deprecated: Do not use.
+	LoopOutChannel uint64 `protobuf:"varint,8,opt,name=loop_out_channel,json=loopOutChannel,proto3" json:"loop_out_channel,omitempty"`
+	//
+	//A restriction on the channel set that may be used to loop out. The actual
+	//channel(s) that will be used are selected based on the lowest routing fee
+	//for the swap payment to the server.
+	OutgoingChanSet []uint64 `protobuf:"varint,11,rep,packed,name=outgoing_chan_set,json=outgoingChanSet,proto3" json:"outgoing_chan_set,omitempty"`
+	//
+	//The number of blocks from the on-chain HTLC's confirmation height that it
+	//should be swept within.
+	SweepConfTarget int32 `protobuf:"varint,9,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//
+	//The number of confirmations that we require for the on chain htlc that will
+	//be published by the server before we reveal the preimage.
+	HtlcConfirmations int32 `protobuf:"varint,13,opt,name=htlc_confirmations,json=htlcConfirmations,proto3" json:"htlc_confirmations,omitempty"`
+	//
+	//The latest time (in unix seconds) we allow the server to wait before
+	//publishing the HTLC on chain. Setting this to a larger value will give the
+	//server the opportunity to batch multiple swaps together, and wait for
+	//low-fee periods before publishing the HTLC, potentially resulting in a
+	//lower total swap fee.
+	SwapPublicationDeadline uint64 `protobuf:"varint,10,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
+	//
+	//An optional label for this swap. This field is limited to 500 characters
+	//and may not start with the prefix [reserved], which is used to tag labels
+	//produced by the daemon.
+	Label string `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of loop. This
+	//initiator part is meant for user interfaces to add their name to give the
+	//full picture of the binary used (loopd, LiT) and the method used for
+	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
+	Initiator string `protobuf:"bytes,14,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//An optional client provided ID for this request, limited to 64
+	//characters. If a swap has already been created for a request with the
+	//same ID, the existing swap is returned instead of creating a new one, so
+	//that requests can be retried safely after a timeout.
+	RequestId string `protobuf:"bytes,15,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	//
+	//Set to true to initiate the swap even if it exceeds the daemon's
+	//configured daily or weekly swap volume limits. This requires a macaroon
+	//with the limits:override permission.
+	OverrideVolumeLimits bool `protobuf:"varint,16,opt,name=override_volume_limits,json=overrideVolumeLimits,proto3" json:"override_volume_limits,omitempty"`
+}
+
+func (x *LoopOutRequest) Reset() {
+	*x = LoopOutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[4]
+		mi := &file_client_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwapStatus) String() string {
+func (x *LoopOutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapStatus) ProtoMessage() {}
+func (*LoopOutRequest) ProtoMessage() {}
 
-func (x *SwapStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[4]
+func (x *LoopOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,141 +1552,209 @@ func (x *SwapStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapStatus.ProtoReflect.Descriptor instead.
-func (*SwapStatus) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LoopOutRequest.ProtoReflect.Descriptor instead.
+func (*LoopOutRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *SwapStatus) GetAmt() int64 {
+func (x *LoopOutRequest) GetAmt() int64 {
 	if x != nil {
 		return x.Amt
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (x *SwapStatus) GetId() string {
+func (x *LoopOutRequest) GetDest() string {
 	if x != nil {
-		return x.Id
+		return x.Dest
 	}
 	return ""
 }
 
-func (x *SwapStatus) GetIdBytes() []byte {
+func (x *LoopOutRequest) GetMaxSwapRoutingFee() int64 {
 	if x != nil {
-		return x.IdBytes
+		return x.MaxSwapRoutingFee
 	}
-	return nil
+	return 0
 }
 
-func (x *SwapStatus) GetType() SwapType {
+func (x *LoopOutRequest) GetMaxPrepayRoutingFee() int64 {
 	if x != nil {
-		return x.Type
+		return x.MaxPrepayRoutingFee
 	}
-	return SwapType_LOOP_OUT
+	return 0
 }
 
-func (x *SwapStatus) GetState() SwapState {
+func (x *LoopOutRequest) GetMaxSwapFee() int64 {
 	if x != nil {
-		return x.State
+		return x.MaxSwapFee
 	}
-	return SwapState_INITIATED
+	return 0
 }
 
-func (x *SwapStatus) GetFailureReason() FailureReason {
+func (x *LoopOutRequest) GetMaxPrepayAmt() int64 {
 	if x != nil {
-		return x.FailureReason
+		return x.MaxPrepayAmt
 	}
-	return FailureReason_FAILURE_REASON_NONE
+	return 0
 }
 
-func (x *SwapStatus) GetInitiationTime() int64 {
+func (x *LoopOutRequest) GetMaxMinerFee() int64 {
 	if x != nil {
-		return x.InitiationTime
+		return x.MaxMinerFee
 	}
 	return 0
 }
 
-func (x *SwapStatus) GetLastUpdateTime() int64 {
+// Deprecated: Do not use.
+func (x *LoopOutRequest) GetLoopOutChannel() uint64 {
 	if x != nil {
-		return x.LastUpdateTime
+		return x.LoopOutChannel
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (x *SwapStatus) GetHtlcAddress() string {
+func (x *LoopOutRequest) GetOutgoingChanSet() []uint64 {
 	if x != nil {
-		return x.HtlcAddress
+		return x.OutgoingChanSet
 	}
-	return ""
+	return nil
 }
 
-func (x *SwapStatus) GetHtlcAddressP2Wsh() string {
+func (x *LoopOutRequest) GetSweepConfTarget() int32 {
 	if x != nil {
-		return x.HtlcAddressP2Wsh
+		return x.SweepConfTarget
 	}
-	return ""
+	return 0
 }
 
-func (x *SwapStatus) GetHtlcAddressNp2Wsh() string {
+func (x *LoopOutRequest) GetHtlcConfirmations() int32 {
 	if x != nil {
-		return x.HtlcAddressNp2Wsh
+		return x.HtlcConfirmations
 	}
-	return ""
+	return 0
 }
 
-func (x *SwapStatus) GetCostServer() int64 {
+func (x *LoopOutRequest) GetSwapPublicationDeadline() uint64 {
 	if x != nil {
-		return x.CostServer
+		return x.SwapPublicationDeadline
 	}
 	return 0
 }
 
-func (x *SwapStatus) GetCostOnchain() int64 {
+func (x *LoopOutRequest) GetLabel() string {
 	if x != nil {
-		return x.CostOnchain
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *SwapStatus) GetCostOffchain() int64 {
+func (x *LoopOutRequest) GetInitiator() string {
 	if x != nil {
-		return x.CostOffchain
+		return x.Initiator
 	}
-	return 0
+	return ""
 }
 
-func (x *SwapStatus) GetLabel() string {
+func (x *LoopOutRequest) GetRequestId() string {
 	if x != nil {
-		return x.Label
+		return x.RequestId
 	}
 	return ""
 }
 
-type ListSwapsRequest struct {
+func (x *LoopOutRequest) GetOverrideVolumeLimits() bool {
+	if x != nil {
+		return x.OverrideVolumeLimits
+	}
+	return false
+}
+
+type LoopInRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *ListSwapsRequest) Reset() {
-	*x = ListSwapsRequest{}
+	//
+	//Requested swap amount in sat. This does not include the swap and miner
+	//fee.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Maximum we are willing to pay the server for the swap. This value is not
+	//disclosed in the swap initiation call, but if the server asks for a
+	//higher fee, we abort the swap. Typically this value is taken from the
+	//response of the GetQuote call.
+	MaxSwapFee int64 `protobuf:"varint,2,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//
+	//Maximum in on-chain fees that we are willing to spend. If we want to
+	//publish the on-chain htlc and the fee estimate turns out higher than this
+	//value, we cancel the swap.
+	//
+	//max_miner_fee is typically taken from the response of the GetQuote call.
+	MaxMinerFee int64 `protobuf:"varint,3,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//
+	//The last hop to use for the loop in swap. If empty, the last hop is selected
+	//based on the lowest routing fee for the swap payment from the server.
+	LastHop []byte `protobuf:"bytes,4,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//If external_htlc is true, we expect the htlc to be published by an external
+	//actor.
+	ExternalHtlc bool `protobuf:"varint,5,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
+	//
+	//The number of blocks that the on chain htlc should confirm within.
+	HtlcConfTarget int32 `protobuf:"varint,6,opt,name=htlc_conf_target,json=htlcConfTarget,proto3" json:"htlc_conf_target,omitempty"`
+	//
+	//An optional label for this swap. This field is limited to 500 characters
+	//and may not be one of the reserved values in loop/labels Reserved list.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of loop. This
+	//initiator part is meant for user interfaces to add their name to give the
+	//full picture of the binary used (loopd, LiT) and the method used for
+	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
+	Initiator string `protobuf:"bytes,8,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//An optional client provided ID for this request, limited to 64
+	//characters. If a swap has already been created for a request with the
+	//same ID, the existing swap is returned instead of creating a new one, so
+	//that requests can be retried safely after a timeout.
+	RequestId string `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	//
+	//Set to true to initiate the swap even if it exceeds the daemon's
+	//configured daily or weekly swap volume limits. This requires a macaroon
+	//with the limits:override permission.
+	OverrideVolumeLimits bool `protobuf:"varint,10,opt,name=override_volume_limits,json=overrideVolumeLimits,proto3" json:"override_volume_limits,omitempty"`
+	//
+	//The name of the lnd wallet account that the htlc is funded from and that
+	//change is returned to, so that coins from separate wallet compartments
+	//are not linked by the htlc transaction. If empty and funding_outpoints is
+	//set, lnd's default account is used. May not be set for external htlcs.
+	FundingAccount string `protobuf:"bytes,11,opt,name=funding_account,json=fundingAccount,proto3" json:"funding_account,omitempty"`
+	//
+	//An optional set of wallet outputs, in the format txid:index, that the htlc
+	//must be funded with. If empty, lnd selects coins from the funding
+	//account. May not be set for external htlcs.
+	FundingOutpoints []string `protobuf:"bytes,12,rep,name=funding_outpoints,json=fundingOutpoints,proto3" json:"funding_outpoints,omitempty"`
+}
+
+func (x *LoopInRequest) Reset() {
+	*x = LoopInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[5]
+		mi := &file_client_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSwapsRequest) String() string {
+func (x *LoopInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSwapsRequest) ProtoMessage() {}
+func (*LoopInRequest) ProtoMessage() {}
 
-func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[5]
+func (x *LoopInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,133 +1765,177 @@ func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
-func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use LoopInRequest.ProtoReflect.Descriptor instead.
+func (*LoopInRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{1}
 }
 
-type ListSwapsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The list of all currently known swaps and their status.
-	Swaps []*SwapStatus `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+func (x *LoopInRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
 }
 
-func (x *ListSwapsResponse) Reset() {
-	*x = ListSwapsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LoopInRequest) GetMaxSwapFee() int64 {
+	if x != nil {
+		return x.MaxSwapFee
 	}
+	return 0
 }
 
-func (x *ListSwapsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoopInRequest) GetMaxMinerFee() int64 {
+	if x != nil {
+		return x.MaxMinerFee
+	}
+	return 0
 }
 
-func (*ListSwapsResponse) ProtoMessage() {}
-
-func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LoopInRequest) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
-func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{6}
+func (x *LoopInRequest) GetExternalHtlc() bool {
+	if x != nil {
+		return x.ExternalHtlc
+	}
+	return false
 }
 
-func (x *ListSwapsResponse) GetSwaps() []*SwapStatus {
+func (x *LoopInRequest) GetHtlcConfTarget() int32 {
 	if x != nil {
-		return x.Swaps
+		return x.HtlcConfTarget
 	}
-	return nil
+	return 0
 }
 
-type SwapInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The swap identifier which currently is the hash that locks the HTLCs. When
-	//using REST, this field must be encoded as URL safe base64.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *LoopInRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (x *SwapInfoRequest) Reset() {
-	*x = SwapInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LoopInRequest) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
 	}
+	return ""
 }
 
-func (x *SwapInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoopInRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
 }
 
-func (*SwapInfoRequest) ProtoMessage() {}
-
-func (x *SwapInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LoopInRequest) GetOverrideVolumeLimits() bool {
+	if x != nil {
+		return x.OverrideVolumeLimits
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use SwapInfoRequest.ProtoReflect.Descriptor instead.
-func (*SwapInfoRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{7}
+func (x *LoopInRequest) GetFundingAccount() string {
+	if x != nil {
+		return x.FundingAccount
+	}
+	return ""
 }
 
-func (x *SwapInfoRequest) GetId() []byte {
+func (x *LoopInRequest) GetFundingOutpoints() []string {
 	if x != nil {
-		return x.Id
+		return x.FundingOutpoints
 	}
 	return nil
 }
 
-type TermsRequest struct {
+type SwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	//DEPRECATED: To make the API more consistent, this field is deprecated in
+	//favor of id_bytes and will be removed in a future release.
+	//
+	// Deprecated: Do not use.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	IdBytes []byte `protobuf:"bytes,3,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+	//
+	//DEPRECATED. This field stores the address of the onchain htlc, but
+	//depending on the request, the semantics are different.
+	//- For internal loop-in htlc_address contains the address of the
+	//native segwit (P2WSH) htlc.
+	//- For external loop-in htlc_address contains the address of the
+	//nested segwit (NP2WSH) htlc.
+	//- For loop-out htlc_address always contains the native segwit (P2WSH)
+	//htlc address.
+	//
+	// Deprecated: Do not use.
+	HtlcAddress string `protobuf:"bytes,2,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
+	//
+	//The nested segwit address of the on-chain htlc.
+	//This field remains empty for loop-out.
+	HtlcAddressNp2Wsh string `protobuf:"bytes,4,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
+	//
+	//The native segwit address of the on-chain htlc.
+	//Used for both loop-in and loop-out.
+	HtlcAddressP2Wsh string `protobuf:"bytes,5,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
+	// A human-readable message received from the loop server.
+	ServerMessage string `protobuf:"bytes,6,opt,name=server_message,json=serverMessage,proto3" json:"server_message,omitempty"`
+	//
+	//A structured message received from the loop server, which contains a code
+	//and set of parameters which clients may use to localize or act on the
+	//server's advisory.
+	StructuredServerMessage *StructuredServerMessage `protobuf:"bytes,7,opt,name=structured_server_message,json=structuredServerMessage,proto3" json:"structured_server_message,omitempty"`
+	//
+	//Set if the request's ID matched a swap that had already been created, in
+	//which case that swap is returned and no new swap is created. The
+	//human-readable server message is not set for existing swaps.
+	ExistingSwap bool `protobuf:"varint,8,opt,name=existing_swap,json=existingSwap,proto3" json:"existing_swap,omitempty"`
+	//
+	//Set if the swap's amount exceeds the daemon's approval threshold, in
+	//which case the swap is only executed once it is approved with
+	//ApproveSwap before approval_expiry_unix.
+	PendingApproval bool `protobuf:"varint,9,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
+	//
+	//The unix timestamp after which a swap that is pending approval can no
+	//longer be approved.
+	ApprovalExpiryUnix int64 `protobuf:"varint,10,opt,name=approval_expiry_unix,json=approvalExpiryUnix,proto3" json:"approval_expiry_unix,omitempty"`
+	//
+	//The addresses of the on-chain htlc for each output script type that the
+	//swap supports, with the address that the htlc is published to flagged as
+	//primary.
+	HtlcAddresses *HtlcAddresses `protobuf:"bytes,11,opt,name=htlc_addresses,json=htlcAddresses,proto3" json:"htlc_addresses,omitempty"`
 }
 
-func (x *TermsRequest) Reset() {
-	*x = TermsRequest{}
+func (x *SwapResponse) Reset() {
+	*x = SwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[8]
+		mi := &file_client_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TermsRequest) String() string {
+func (x *SwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TermsRequest) ProtoMessage() {}
+func (*SwapResponse) ProtoMessage() {}
 
-func (x *TermsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[8]
+func (x *SwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,104 +1946,123 @@ func (x *TermsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TermsRequest.ProtoReflect.Descriptor instead.
-func (*TermsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
+func (*SwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{2}
 }
 
-type InTermsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//Minimum swap amount (sat)
-	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
-	//
-	//Maximum swap amount (sat)
-	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+// Deprecated: Do not use.
+func (x *SwapResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *InTermsResponse) Reset() {
-	*x = InTermsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SwapResponse) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
 	}
+	return nil
 }
 
-func (x *InTermsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Do not use.
+func (x *SwapResponse) GetHtlcAddress() string {
+	if x != nil {
+		return x.HtlcAddress
+	}
+	return ""
 }
 
-func (*InTermsResponse) ProtoMessage() {}
+func (x *SwapResponse) GetHtlcAddressNp2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressNp2Wsh
+	}
+	return ""
+}
 
-func (x *InTermsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SwapResponse) GetHtlcAddressP2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressP2Wsh
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use InTermsResponse.ProtoReflect.Descriptor instead.
-func (*InTermsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{9}
+func (x *SwapResponse) GetServerMessage() string {
+	if x != nil {
+		return x.ServerMessage
+	}
+	return ""
 }
 
-func (x *InTermsResponse) GetMinSwapAmount() int64 {
+func (x *SwapResponse) GetStructuredServerMessage() *StructuredServerMessage {
 	if x != nil {
-		return x.MinSwapAmount
+		return x.StructuredServerMessage
 	}
-	return 0
+	return nil
 }
 
-func (x *InTermsResponse) GetMaxSwapAmount() int64 {
+func (x *SwapResponse) GetExistingSwap() bool {
 	if x != nil {
-		return x.MaxSwapAmount
+		return x.ExistingSwap
+	}
+	return false
+}
+
+func (x *SwapResponse) GetPendingApproval() bool {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return false
+}
+
+func (x *SwapResponse) GetApprovalExpiryUnix() int64 {
+	if x != nil {
+		return x.ApprovalExpiryUnix
 	}
 	return 0
 }
 
-type OutTermsResponse struct {
+func (x *SwapResponse) GetHtlcAddresses() *HtlcAddresses {
+	if x != nil {
+		return x.HtlcAddresses
+	}
+	return nil
+}
+
+type HtlcAddress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The output script type of the address.
+	OutputType HtlcOutputType `protobuf:"varint,1,opt,name=output_type,json=outputType,proto3,enum=looprpc.HtlcOutputType" json:"output_type,omitempty"`
+	// The address of the on-chain htlc for the output type.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	//
-	//Minimum swap amount (sat)
-	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
-	//
-	//Maximum swap amount (sat)
-	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
-	// The minimally accepted cltv delta of the on-chain htlc.
-	MinCltvDelta int32 `protobuf:"varint,8,opt,name=min_cltv_delta,json=minCltvDelta,proto3" json:"min_cltv_delta,omitempty"`
-	// The maximally accepted cltv delta of the on-chain htlc.
-	MaxCltvDelta int32 `protobuf:"varint,9,opt,name=max_cltv_delta,json=maxCltvDelta,proto3" json:"max_cltv_delta,omitempty"`
+	//Set for the address that the swap's htlc is published to. For loop out
+	//and internal loop in swaps this is the native segwit address, for
+	//external loop in swaps it is the nested segwit address.
+	Primary bool `protobuf:"varint,3,opt,name=primary,proto3" json:"primary,omitempty"`
 }
 
-func (x *OutTermsResponse) Reset() {
-	*x = OutTermsResponse{}
+func (x *HtlcAddress) Reset() {
+	*x = HtlcAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[10]
+		mi := &file_client_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OutTermsResponse) String() string {
+func (x *HtlcAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutTermsResponse) ProtoMessage() {}
+func (*HtlcAddress) ProtoMessage() {}
 
-func (x *OutTermsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[10]
+func (x *HtlcAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,91 +2073,58 @@ func (x *OutTermsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutTermsResponse.ProtoReflect.Descriptor instead.
-func (*OutTermsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *OutTermsResponse) GetMinSwapAmount() int64 {
-	if x != nil {
-		return x.MinSwapAmount
-	}
-	return 0
+// Deprecated: Use HtlcAddress.ProtoReflect.Descriptor instead.
+func (*HtlcAddress) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OutTermsResponse) GetMaxSwapAmount() int64 {
+func (x *HtlcAddress) GetOutputType() HtlcOutputType {
 	if x != nil {
-		return x.MaxSwapAmount
+		return x.OutputType
 	}
-	return 0
+	return HtlcOutputType_HTLC_OUTPUT_TYPE_UNKNOWN
 }
 
-func (x *OutTermsResponse) GetMinCltvDelta() int32 {
+func (x *HtlcAddress) GetAddress() string {
 	if x != nil {
-		return x.MinCltvDelta
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *OutTermsResponse) GetMaxCltvDelta() int32 {
+func (x *HtlcAddress) GetPrimary() bool {
 	if x != nil {
-		return x.MaxCltvDelta
+		return x.Primary
 	}
-	return 0
+	return false
 }
 
-type QuoteRequest struct {
+type HtlcAddresses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The amount to swap in satoshis.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//The confirmation target that should be used either for the sweep of the
-	//on-chain HTLC broadcast by the swap server in the case of a Loop Out, or for
-	//the confirmation of the on-chain HTLC broadcast by the swap client in the
-	//case of a Loop In.
-	ConfTarget int32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
-	//
-	//If external_htlc is true, we expect the htlc to be published by an external
-	//actor.
-	ExternalHtlc bool `protobuf:"varint,3,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
-	//
-	//The latest time (in unix seconds) we allow the server to wait before
-	//publishing the HTLC on chain. Setting this to a larger value will give the
-	//server the opportunity to batch multiple swaps together, and wait for
-	//low-fee periods before publishing the HTLC, potentially resulting in a
-	//lower total swap fee. This only has an effect on loop out quotes.
-	SwapPublicationDeadline uint64 `protobuf:"varint,4,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
-	//
-	//Optionally the client can specify the last hop pubkey when requesting a
-	//loop-in quote. This is useful to get better off-chain routing fee from the
-	//server.
-	LoopInLastHop []byte `protobuf:"bytes,5,opt,name=loop_in_last_hop,json=loopInLastHop,proto3" json:"loop_in_last_hop,omitempty"`
-	//
-	//Optional route hints to reach the destination through private channels.
-	LoopInRouteHints []*RouteHint `protobuf:"bytes,6,rep,name=loop_in_route_hints,json=loopInRouteHints,proto3" json:"loop_in_route_hints,omitempty"`
+	// The addresses of the on-chain htlc, exactly one of which is primary.
+	Addresses []*HtlcAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (x *QuoteRequest) Reset() {
-	*x = QuoteRequest{}
+func (x *HtlcAddresses) Reset() {
+	*x = HtlcAddresses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[11]
+		mi := &file_client_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuoteRequest) String() string {
+func (x *HtlcAddresses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteRequest) ProtoMessage() {}
+func (*HtlcAddresses) ProtoMessage() {}
 
-func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[11]
+func (x *HtlcAddresses) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,94 +2135,41 @@ func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
-func (*QuoteRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *QuoteRequest) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
-}
-
-func (x *QuoteRequest) GetConfTarget() int32 {
-	if x != nil {
-		return x.ConfTarget
-	}
-	return 0
-}
-
-func (x *QuoteRequest) GetExternalHtlc() bool {
-	if x != nil {
-		return x.ExternalHtlc
-	}
-	return false
-}
-
-func (x *QuoteRequest) GetSwapPublicationDeadline() uint64 {
-	if x != nil {
-		return x.SwapPublicationDeadline
-	}
-	return 0
-}
-
-func (x *QuoteRequest) GetLoopInLastHop() []byte {
-	if x != nil {
-		return x.LoopInLastHop
-	}
-	return nil
+// Deprecated: Use HtlcAddresses.ProtoReflect.Descriptor instead.
+func (*HtlcAddresses) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *QuoteRequest) GetLoopInRouteHints() []*RouteHint {
+func (x *HtlcAddresses) GetAddresses() []*HtlcAddress {
 	if x != nil {
-		return x.LoopInRouteHints
+		return x.Addresses
 	}
 	return nil
 }
 
-type InQuoteResponse struct {
+type MonitorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//
-	//The fee that the swap server is charging for the swap.
-	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
-	//
-	//An estimate of the on-chain fee that needs to be paid to publish the HTLC
-	//If a miner fee of 0 is returned, it means the external_htlc flag was set for
-	//a loop in and the fee estimation was skipped. If a miner fee of -1 is
-	//returned, it means lnd's wallet tried to estimate the fee but was unable to
-	//create a sample estimation transaction because not enough funds are
-	//available. An information message should be shown to the user in this case.
-	HtlcPublishFeeSat int64 `protobuf:"varint,3,opt,name=htlc_publish_fee_sat,json=htlcPublishFeeSat,proto3" json:"htlc_publish_fee_sat,omitempty"`
-	//
-	//On-chain cltv expiry delta
-	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
-	//
-	//The confirmation target to be used to publish the on-chain HTLC.
-	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
 }
 
-func (x *InQuoteResponse) Reset() {
-	*x = InQuoteResponse{}
+func (x *MonitorRequest) Reset() {
+	*x = MonitorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[12]
+		mi := &file_client_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InQuoteResponse) String() string {
+func (x *MonitorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InQuoteResponse) ProtoMessage() {}
+func (*MonitorRequest) ProtoMessage() {}
 
-func (x *InQuoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[12]
+func (x *MonitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,83 +2180,148 @@ func (x *InQuoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InQuoteResponse.ProtoReflect.Descriptor instead.
-func (*InQuoteResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *InQuoteResponse) GetSwapFeeSat() int64 {
-	if x != nil {
-		return x.SwapFeeSat
-	}
-	return 0
-}
-
-func (x *InQuoteResponse) GetHtlcPublishFeeSat() int64 {
-	if x != nil {
-		return x.HtlcPublishFeeSat
-	}
-	return 0
-}
-
-func (x *InQuoteResponse) GetCltvDelta() int32 {
-	if x != nil {
-		return x.CltvDelta
-	}
-	return 0
-}
-
-func (x *InQuoteResponse) GetConfTarget() int32 {
-	if x != nil {
-		return x.ConfTarget
-	}
-	return 0
+// Deprecated: Use MonitorRequest.ProtoReflect.Descriptor instead.
+func (*MonitorRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{5}
 }
 
-type OutQuoteResponse struct {
+type SwapStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The fee that the swap server is charging for the swap.
-	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//Requested swap amount in sat. This does not include the swap and miner
+	//fee.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
 	//
-	//The part of the swap fee that is requested as a prepayment.
-	PrepayAmtSat int64 `protobuf:"varint,2,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	//DEPRECATED: To make the API more consistent, this field is deprecated in
+	//favor of id_bytes and will be removed in a future release.
 	//
-	//An estimate of the on-chain fee that needs to be paid to sweep the HTLC for
-	//a loop out.
-	HtlcSweepFeeSat int64 `protobuf:"varint,3,opt,name=htlc_sweep_fee_sat,json=htlcSweepFeeSat,proto3" json:"htlc_sweep_fee_sat,omitempty"`
+	// Deprecated: Do not use.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	//
-	//The node pubkey where the swap payment needs to be paid
-	//to. This can be used to test connectivity before initiating the swap.
-	SwapPaymentDest []byte `protobuf:"bytes,4,opt,name=swap_payment_dest,json=swapPaymentDest,proto3" json:"swap_payment_dest,omitempty"`
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	IdBytes []byte `protobuf:"bytes,11,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
 	//
-	//On-chain cltv expiry delta
-	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,3,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
 	//
-	//The confirmation target to be used for the sweep of the on-chain HTLC.
-	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//State the swap is currently in, see State enum.
+	State SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=looprpc.SwapState" json:"state,omitempty"`
+	//
+	//A failure reason for the swap, only set if the swap has failed.
+	FailureReason FailureReason `protobuf:"varint,14,opt,name=failure_reason,json=failureReason,proto3,enum=looprpc.FailureReason" json:"failure_reason,omitempty"`
+	//
+	//Initiation time of the swap.
+	InitiationTime int64 `protobuf:"varint,5,opt,name=initiation_time,json=initiationTime,proto3" json:"initiation_time,omitempty"`
+	//
+	//Initiation time of the swap.
+	LastUpdateTime int64 `protobuf:"varint,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+	//
+	//DEPRECATED:  This field stores the address of the onchain htlc.
+	//- For internal loop-in htlc_address contains the address of the
+	//native segwit (P2WSH) htlc.
+	//- For external loop-in htlc_address contains the nested segwit (NP2WSH)
+	//address.
+	//- For loop-out htlc_address always contains the native segwit (P2WSH)
+	//htlc address.
+	//
+	// Deprecated: Do not use.
+	HtlcAddress string `protobuf:"bytes,7,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
+	// HTLC address (native segwit), used in loop-in and loop-out swaps.
+	HtlcAddressP2Wsh string `protobuf:"bytes,12,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
+	// HTLC address (nested segwit), used in loop-in swaps only.
+	HtlcAddressNp2Wsh string `protobuf:"bytes,13,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
+	// Swap server cost
+	CostServer int64 `protobuf:"varint,8,opt,name=cost_server,json=costServer,proto3" json:"cost_server,omitempty"`
+	// On-chain transaction cost
+	CostOnchain int64 `protobuf:"varint,9,opt,name=cost_onchain,json=costOnchain,proto3" json:"cost_onchain,omitempty"`
+	// Off-chain routing fees
+	CostOffchain int64 `protobuf:"varint,10,opt,name=cost_offchain,json=costOffchain,proto3" json:"cost_offchain,omitempty"`
+	// An optional label given to the swap on creation.
+	Label string `protobuf:"bytes,15,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//The structured message that the server provided when the swap was
+	//initiated, if any.
+	StructuredServerMessage *StructuredServerMessage `protobuf:"bytes,16,opt,name=structured_server_message,json=structuredServerMessage,proto3" json:"structured_server_message,omitempty"`
+	// The request ID that the client provided when creating the swap, if any.
+	RequestId string `protobuf:"bytes,17,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	//
+	//The identity of the macaroon that approved the swap, if the swap exceeded
+	//the daemon's approval threshold.
+	ApprovedBy string `protobuf:"bytes,18,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	//
+	//The time at which the swap was approved in unix nano, if it exceeded the
+	//daemon's approval threshold.
+	ApprovalTime int64 `protobuf:"varint,19,opt,name=approval_time,json=approvalTime,proto3" json:"approval_time,omitempty"`
+	//
+	//The amount paid for a loop out swap's prepay in sat, including routing
+	//fees. This is included in cost_server and cost_offchain, and is only set
+	//once the prepay has been settled by the server.
+	CostPrepay int64 `protobuf:"varint,20,opt,name=cost_prepay,json=costPrepay,proto3" json:"cost_prepay,omitempty"`
+	//
+	//Set if the swap failed and its prepay was kept by the server.
+	PrepayLost bool `protobuf:"varint,21,opt,name=prepay_lost,json=prepayLost,proto3" json:"prepay_lost,omitempty"`
+	//
+	//The time in unix nano by which the swap was expected to complete. This is
+	//only set for swaps that recorded an expected duration when they were
+	//initiated.
+	ExpectedCompletionTime int64 `protobuf:"varint,22,opt,name=expected_completion_time,json=expectedCompletionTime,proto3" json:"expected_completion_time,omitempty"`
+	//
+	//The health of a pending swap, as classified by the daemon's periodic
+	//check of how long the swap has spent in its current state. This is not
+	//set for swaps that have completed.
+	Health SwapHealth `protobuf:"varint,23,opt,name=health,proto3,enum=looprpc.SwapHealth" json:"health,omitempty"`
+	//
+	//A description of why the swap is considered slow or stuck, if it is.
+	HealthReason string `protobuf:"bytes,24,opt,name=health_reason,json=healthReason,proto3" json:"health_reason,omitempty"`
+	//
+	//A suggested action that may help a slow or stuck swap to progress, if
+	//any.
+	SuggestedAction string `protobuf:"bytes,25,opt,name=suggested_action,json=suggestedAction,proto3" json:"suggested_action,omitempty"`
+	//
+	//The number of confirmations of the server's htlc that a loop out swap
+	//waits for before revealing its preimage. This may exceed the requested
+	//number of confirmations if the daemon's amount tiers require more
+	//confirmations for the swap's amount.
+	HtlcConfirmations uint32 `protobuf:"varint,26,opt,name=htlc_confirmations,json=htlcConfirmations,proto3" json:"htlc_confirmations,omitempty"`
+	//
+	//The id of the transaction that holds the swap's htlc, once it is known.
+	//If the server replaced the htlc transaction of a loop out swap after we
+	//first saw it confirm, this is the id of the replacement.
+	HtlcTxid string `protobuf:"bytes,27,opt,name=htlc_txid,json=htlcTxid,proto3" json:"htlc_txid,omitempty"`
+	//
+	//The addresses of the on-chain htlc for each output script type that the
+	//swap supports, with the address that the htlc is published to flagged as
+	//primary.
+	HtlcAddresses *HtlcAddresses `protobuf:"bytes,28,opt,name=htlc_addresses,json=htlcAddresses,proto3" json:"htlc_addresses,omitempty"`
+	//
+	//The identity of the macaroon that requested the swap, if the swap exceeded
+	//the daemon's approval threshold.
+	RequestedBy string `protobuf:"bytes,29,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 }
 
-func (x *OutQuoteResponse) Reset() {
-	*x = OutQuoteResponse{}
+func (x *SwapStatus) Reset() {
+	*x = SwapStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[13]
+		mi := &file_client_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OutQuoteResponse) String() string {
+func (x *SwapStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutQuoteResponse) ProtoMessage() {}
+func (*SwapStatus) ProtoMessage() {}
 
-func (x *OutQuoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[13]
+func (x *SwapStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,183 +2332,239 @@ func (x *OutQuoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutQuoteResponse.ProtoReflect.Descriptor instead.
-func (*OutQuoteResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SwapStatus.ProtoReflect.Descriptor instead.
+func (*SwapStatus) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *OutQuoteResponse) GetSwapFeeSat() int64 {
+func (x *SwapStatus) GetAmt() int64 {
 	if x != nil {
-		return x.SwapFeeSat
+		return x.Amt
 	}
 	return 0
 }
 
-func (x *OutQuoteResponse) GetPrepayAmtSat() int64 {
+// Deprecated: Do not use.
+func (x *SwapStatus) GetId() string {
 	if x != nil {
-		return x.PrepayAmtSat
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *OutQuoteResponse) GetHtlcSweepFeeSat() int64 {
+func (x *SwapStatus) GetIdBytes() []byte {
 	if x != nil {
-		return x.HtlcSweepFeeSat
+		return x.IdBytes
 	}
-	return 0
+	return nil
 }
 
-func (x *OutQuoteResponse) GetSwapPaymentDest() []byte {
+func (x *SwapStatus) GetType() SwapType {
 	if x != nil {
-		return x.SwapPaymentDest
+		return x.Type
 	}
-	return nil
+	return SwapType_LOOP_OUT
 }
 
-func (x *OutQuoteResponse) GetCltvDelta() int32 {
+func (x *SwapStatus) GetState() SwapState {
 	if x != nil {
-		return x.CltvDelta
+		return x.State
 	}
-	return 0
+	return SwapState_INITIATED
 }
 
-func (x *OutQuoteResponse) GetConfTarget() int32 {
+func (x *SwapStatus) GetFailureReason() FailureReason {
 	if x != nil {
-		return x.ConfTarget
+		return x.FailureReason
 	}
-	return 0
+	return FailureReason_FAILURE_REASON_NONE
 }
 
-type ProbeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SwapStatus) GetInitiationTime() int64 {
+	if x != nil {
+		return x.InitiationTime
+	}
+	return 0
+}
 
-	//
-	//The amount to probe.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Optional last hop of the route to probe.
-	LastHop []byte `protobuf:"bytes,2,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
-	//
-	//Optional route hints to reach the destination through private channels.
-	RouteHints []*RouteHint `protobuf:"bytes,3,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+func (x *SwapStatus) GetLastUpdateTime() int64 {
+	if x != nil {
+		return x.LastUpdateTime
+	}
+	return 0
 }
 
-func (x *ProbeRequest) Reset() {
-	*x = ProbeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Do not use.
+func (x *SwapStatus) GetHtlcAddress() string {
+	if x != nil {
+		return x.HtlcAddress
 	}
+	return ""
 }
 
-func (x *ProbeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwapStatus) GetHtlcAddressP2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressP2Wsh
+	}
+	return ""
 }
 
-func (*ProbeRequest) ProtoMessage() {}
+func (x *SwapStatus) GetHtlcAddressNp2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressNp2Wsh
+	}
+	return ""
+}
 
-func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SwapStatus) GetCostServer() int64 {
+	if x != nil {
+		return x.CostServer
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ProbeRequest.ProtoReflect.Descriptor instead.
-func (*ProbeRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{14}
+func (x *SwapStatus) GetCostOnchain() int64 {
+	if x != nil {
+		return x.CostOnchain
+	}
+	return 0
 }
 
-func (x *ProbeRequest) GetAmt() int64 {
+func (x *SwapStatus) GetCostOffchain() int64 {
 	if x != nil {
-		return x.Amt
+		return x.CostOffchain
 	}
 	return 0
 }
 
-func (x *ProbeRequest) GetLastHop() []byte {
+func (x *SwapStatus) GetLabel() string {
 	if x != nil {
-		return x.LastHop
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *ProbeRequest) GetRouteHints() []*RouteHint {
+func (x *SwapStatus) GetStructuredServerMessage() *StructuredServerMessage {
 	if x != nil {
-		return x.RouteHints
+		return x.StructuredServerMessage
 	}
 	return nil
 }
 
-type ProbeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SwapStatus) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
 }
 
-func (x *ProbeResponse) Reset() {
-	*x = ProbeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SwapStatus) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
 	}
+	return ""
 }
 
-func (x *ProbeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwapStatus) GetApprovalTime() int64 {
+	if x != nil {
+		return x.ApprovalTime
+	}
+	return 0
 }
 
-func (*ProbeResponse) ProtoMessage() {}
-
-func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SwapStatus) GetCostPrepay() int64 {
+	if x != nil {
+		return x.CostPrepay
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ProbeResponse.ProtoReflect.Descriptor instead.
-func (*ProbeResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{15}
+func (x *SwapStatus) GetPrepayLost() bool {
+	if x != nil {
+		return x.PrepayLost
+	}
+	return false
 }
 
-type TokensRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SwapStatus) GetExpectedCompletionTime() int64 {
+	if x != nil {
+		return x.ExpectedCompletionTime
+	}
+	return 0
 }
 
-func (x *TokensRequest) Reset() {
-	*x = TokensRequest{}
+func (x *SwapStatus) GetHealth() SwapHealth {
+	if x != nil {
+		return x.Health
+	}
+	return SwapHealth_SWAP_HEALTH_UNKNOWN
+}
+
+func (x *SwapStatus) GetHealthReason() string {
+	if x != nil {
+		return x.HealthReason
+	}
+	return ""
+}
+
+func (x *SwapStatus) GetSuggestedAction() string {
+	if x != nil {
+		return x.SuggestedAction
+	}
+	return ""
+}
+
+func (x *SwapStatus) GetHtlcConfirmations() uint32 {
+	if x != nil {
+		return x.HtlcConfirmations
+	}
+	return 0
+}
+
+func (x *SwapStatus) GetHtlcTxid() string {
+	if x != nil {
+		return x.HtlcTxid
+	}
+	return ""
+}
+
+func (x *SwapStatus) GetHtlcAddresses() *HtlcAddresses {
+	if x != nil {
+		return x.HtlcAddresses
+	}
+	return nil
+}
+
+func (x *SwapStatus) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type ListSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSwapsRequest) Reset() {
+	*x = ListSwapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[16]
+		mi := &file_client_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokensRequest) String() string {
+func (x *ListSwapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokensRequest) ProtoMessage() {}
+func (*ListSwapsRequest) ProtoMessage() {}
 
-func (x *TokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[16]
+func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,38 +2575,38 @@ func (x *TokensRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
-func (*TokensRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{7}
 }
 
-type TokensResponse struct {
+type ListSwapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//List of all tokens the daemon knows of, including old/expired tokens.
-	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	//The list of all currently known swaps and their status.
+	Swaps []*SwapStatus `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
 }
 
-func (x *TokensResponse) Reset() {
-	*x = TokensResponse{}
+func (x *ListSwapsResponse) Reset() {
+	*x = ListSwapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[17]
+		mi := &file_client_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokensResponse) String() string {
+func (x *ListSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokensResponse) ProtoMessage() {}
+func (*ListSwapsResponse) ProtoMessage() {}
 
-func (x *TokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[17]
+func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1893,69 +2617,46 @@ func (x *TokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
-func (*TokensResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TokensResponse) GetTokens() []*LsatToken {
+func (x *ListSwapsResponse) GetSwaps() []*SwapStatus {
 	if x != nil {
-		return x.Tokens
+		return x.Swaps
 	}
 	return nil
 }
 
-type LsatToken struct {
+type SwapInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The base macaroon that was baked by the auth server.
-	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
-	//
-	//The payment hash of the payment that was paid to obtain the token.
-	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
-	//
-	//The preimage of the payment hash, knowledge of this is proof that the
-	//payment has been paid. If the preimage is set to all zeros, this means the
-	//payment is still pending and the token is not yet fully valid.
-	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
-	//
-	//The amount of millisatoshis that was paid to get the token.
-	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
-	//
-	//The amount of millisatoshis paid in routing fee to pay for the token.
-	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
-	//
-	//The creation time of the token as UNIX timestamp in seconds.
-	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
-	//
-	//Indicates whether the token is expired or still valid.
-	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
-	//
-	//Identifying attribute of this token in the store. Currently represents the
-	//file name of the token where it's stored on the file system.
-	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	//The swap identifier which currently is the hash that locks the HTLCs. When
+	//using REST, this field must be encoded as URL safe base64.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *LsatToken) Reset() {
-	*x = LsatToken{}
+func (x *SwapInfoRequest) Reset() {
+	*x = SwapInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[18]
+		mi := &file_client_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsatToken) String() string {
+func (x *SwapInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsatToken) ProtoMessage() {}
+func (*SwapInfoRequest) ProtoMessage() {}
 
-func (x *LsatToken) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[18]
+func (x *SwapInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,90 +2667,91 @@ func (x *LsatToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
-func (*LsatToken) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SwapInfoRequest.ProtoReflect.Descriptor instead.
+func (*SwapInfoRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LsatToken) GetBaseMacaroon() []byte {
+func (x *SwapInfoRequest) GetId() []byte {
 	if x != nil {
-		return x.BaseMacaroon
+		return x.Id
 	}
 	return nil
 }
 
-func (x *LsatToken) GetPaymentHash() []byte {
-	if x != nil {
-		return x.PaymentHash
-	}
-	return nil
-}
+type RedactSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *LsatToken) GetPaymentPreimage() []byte {
-	if x != nil {
-		return x.PaymentPreimage
-	}
-	return nil
+	//
+	//The swap identifier which currently is the hash that locks the HTLCs. When
+	//using REST, this field must be encoded as URL safe base64.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *LsatToken) GetAmountPaidMsat() int64 {
-	if x != nil {
-		return x.AmountPaidMsat
+func (x *RedactSwapRequest) Reset() {
+	*x = RedactSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
-	if x != nil {
-		return x.RoutingFeePaidMsat
-	}
-	return 0
+func (x *RedactSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LsatToken) GetTimeCreated() int64 {
-	if x != nil {
-		return x.TimeCreated
+func (*RedactSwapRequest) ProtoMessage() {}
+
+func (x *RedactSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LsatToken) GetExpired() bool {
-	if x != nil {
-		return x.Expired
-	}
-	return false
+// Deprecated: Use RedactSwapRequest.ProtoReflect.Descriptor instead.
+func (*RedactSwapRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LsatToken) GetStorageName() string {
+func (x *RedactSwapRequest) GetId() []byte {
 	if x != nil {
-		return x.StorageName
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-type GetLiquidityParamsRequest struct {
+type RedactSwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetLiquidityParamsRequest) Reset() {
-	*x = GetLiquidityParamsRequest{}
+func (x *RedactSwapResponse) Reset() {
+	*x = RedactSwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[19]
+		mi := &file_client_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLiquidityParamsRequest) String() string {
+func (x *RedactSwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiquidityParamsRequest) ProtoMessage() {}
+func (*RedactSwapResponse) ProtoMessage() {}
 
-func (x *GetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[19]
+func (x *RedactSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2060,109 +2762,43 @@ func (x *GetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
-func (*GetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RedactSwapResponse.ProtoReflect.Descriptor instead.
+func (*RedactSwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{11}
 }
 
-type LiquidityParameters struct {
+type StatusLinkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//A set of liquidity rules that describe the desired liquidity balance.
-	Rules []*LiquidityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	//
-	//The parts per million of swap amount that is allowed to be allocated to swap
-	//fees. This value is applied across swap categories and may not be set in
-	//conjunction with sweep fee rate, swap fee ppm, routing fee ppm, prepay
-	//routing, max prepay and max miner fee.
-	FeePpm uint64 `protobuf:"varint,16,opt,name=fee_ppm,json=feePpm,proto3" json:"fee_ppm,omitempty"`
-	//
-	//The limit we place on our estimated sweep cost for a swap in sat/vByte. If
-	//the estimated fee for our sweep transaction within the specified
-	//confirmation target is above this value, we will not suggest any swaps.
-	SweepFeeRateSatPerVbyte uint64 `protobuf:"varint,2,opt,name=sweep_fee_rate_sat_per_vbyte,json=sweepFeeRateSatPerVbyte,proto3" json:"sweep_fee_rate_sat_per_vbyte,omitempty"`
-	//
-	//The maximum fee paid to the server for facilitating the swap, expressed
-	//as parts per million of the swap volume.
-	MaxSwapFeePpm uint64 `protobuf:"varint,3,opt,name=max_swap_fee_ppm,json=maxSwapFeePpm,proto3" json:"max_swap_fee_ppm,omitempty"`
-	//
-	//The maximum fee paid to route the swap invoice off chain, expressed as
-	//parts per million of the volume being routed.
-	MaxRoutingFeePpm uint64 `protobuf:"varint,4,opt,name=max_routing_fee_ppm,json=maxRoutingFeePpm,proto3" json:"max_routing_fee_ppm,omitempty"`
-	//
-	//The maximum fee paid to route the prepay invoice off chain, expressed as
-	//parts per million of the volume being routed.
-	MaxPrepayRoutingFeePpm uint64 `protobuf:"varint,5,opt,name=max_prepay_routing_fee_ppm,json=maxPrepayRoutingFeePpm,proto3" json:"max_prepay_routing_fee_ppm,omitempty"`
-	//
-	//The maximum no-show penalty in satoshis paid for a swap.
-	MaxPrepaySat uint64 `protobuf:"varint,6,opt,name=max_prepay_sat,json=maxPrepaySat,proto3" json:"max_prepay_sat,omitempty"`
-	//
-	//The maximum miner fee we will pay to sweep the swap on chain. Note that we
-	//will not suggest a swap if the estimate is above the sweep limit set by
-	//these parameters, and we use the current fee estimate to sweep on chain so
-	//this value is only a cap placed on the amount we spend on fees in the case
-	//where the swap needs to be claimed on chain, but fees have suddenly spiked.
-	MaxMinerFeeSat uint64 `protobuf:"varint,7,opt,name=max_miner_fee_sat,json=maxMinerFeeSat,proto3" json:"max_miner_fee_sat,omitempty"`
+	//The swap identifier which currently is the hash that locks the HTLCs. When
+	//using REST, this field must be encoded as URL safe base64.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	//
-	//The number of blocks from the on-chain HTLC's confirmation height that it
-	//should be swept within.
-	SweepConfTarget int32 `protobuf:"varint,8,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
-	//
-	//The amount of time we require pass since a channel was part of a failed
-	//swap due to off chain payment failure until it will be considered for swap
-	//suggestions again, expressed in seconds.
-	FailureBackoffSec uint64 `protobuf:"varint,9,opt,name=failure_backoff_sec,json=failureBackoffSec,proto3" json:"failure_backoff_sec,omitempty"`
-	//
-	//Set to true to enable automatic dispatch of swaps. All swaps will be limited
-	//to the fee categories set by these parameters, and total expenditure will
-	//be limited to the autoloop budget.
-	Autoloop bool `protobuf:"varint,10,opt,name=autoloop,proto3" json:"autoloop,omitempty"`
-	//
-	//The total budget for automatically dispatched swaps since the budget start
-	//time, expressed in satoshis.
-	AutoloopBudgetSat uint64 `protobuf:"varint,11,opt,name=autoloop_budget_sat,json=autoloopBudgetSat,proto3" json:"autoloop_budget_sat,omitempty"`
-	//
-	//The start time for autoloop budget, expressed as a unix timestamp in
-	//seconds. If this value is 0, the budget will be applied for all
-	//automatically dispatched swaps. Swaps that were completed before this date
-	//will not be included in budget calculations.
-	AutoloopBudgetStartSec uint64 `protobuf:"varint,12,opt,name=autoloop_budget_start_sec,json=autoloopBudgetStartSec,proto3" json:"autoloop_budget_start_sec,omitempty"`
-	//
-	//The maximum number of automatically dispatched swaps that we allow to be in
-	//flight at any point in time.
-	AutoMaxInFlight uint64 `protobuf:"varint,13,opt,name=auto_max_in_flight,json=autoMaxInFlight,proto3" json:"auto_max_in_flight,omitempty"`
-	//
-	//The minimum amount, expressed in satoshis, that the autoloop client will
-	//dispatch a swap for. This value is subject to the server-side limits
-	//specified by the LoopOutTerms endpoint.
-	MinSwapAmount uint64 `protobuf:"varint,14,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
-	//
-	//The maximum amount, expressed in satoshis, that the autoloop client will
-	//dispatch a swap for. This value is subject to the server-side limits
-	//specified by the LoopOutTerms endpoint.
-	MaxSwapAmount uint64 `protobuf:"varint,15,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+	//The number of seconds that the link is valid for. If zero, the link is
+	//valid for a day. Links may not be valid for more than 30 days.
+	ExpirySec uint64 `protobuf:"varint,2,opt,name=expiry_sec,json=expirySec,proto3" json:"expiry_sec,omitempty"`
 }
 
-func (x *LiquidityParameters) Reset() {
-	*x = LiquidityParameters{}
+func (x *StatusLinkRequest) Reset() {
+	*x = StatusLinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[20]
+		mi := &file_client_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiquidityParameters) String() string {
+func (x *StatusLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiquidityParameters) ProtoMessage() {}
+func (*StatusLinkRequest) ProtoMessage() {}
 
-func (x *LiquidityParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[20]
+func (x *StatusLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2173,169 +2809,163 @@ func (x *LiquidityParameters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiquidityParameters.ProtoReflect.Descriptor instead.
-func (*LiquidityParameters) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use StatusLinkRequest.ProtoReflect.Descriptor instead.
+func (*StatusLinkRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LiquidityParameters) GetRules() []*LiquidityRule {
+func (x *StatusLinkRequest) GetId() []byte {
 	if x != nil {
-		return x.Rules
+		return x.Id
 	}
 	return nil
 }
 
-func (x *LiquidityParameters) GetFeePpm() uint64 {
+func (x *StatusLinkRequest) GetExpirySec() uint64 {
 	if x != nil {
-		return x.FeePpm
+		return x.ExpirySec
 	}
 	return 0
 }
 
-func (x *LiquidityParameters) GetSweepFeeRateSatPerVbyte() uint64 {
-	if x != nil {
-		return x.SweepFeeRateSatPerVbyte
-	}
-	return 0
+type StatusLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The signed token that grants read-only access to the swap's status.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	//
+	//The path on the REST proxy that the swap's status is served at with the
+	//token, to be appended to the proxy's base url.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	//
+	//The unix timestamp in seconds after which the link is no longer valid.
+	ExpiryUnix int64 `protobuf:"varint,3,opt,name=expiry_unix,json=expiryUnix,proto3" json:"expiry_unix,omitempty"`
 }
 
-func (x *LiquidityParameters) GetMaxSwapFeePpm() uint64 {
-	if x != nil {
-		return x.MaxSwapFeePpm
+func (x *StatusLinkResponse) Reset() {
+	*x = StatusLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LiquidityParameters) GetMaxRoutingFeePpm() uint64 {
-	if x != nil {
-		return x.MaxRoutingFeePpm
-	}
-	return 0
+func (x *StatusLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LiquidityParameters) GetMaxPrepayRoutingFeePpm() uint64 {
-	if x != nil {
-		return x.MaxPrepayRoutingFeePpm
+func (*StatusLinkResponse) ProtoMessage() {}
+
+func (x *StatusLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiquidityParameters) GetMaxPrepaySat() uint64 {
-	if x != nil {
-		return x.MaxPrepaySat
-	}
-	return 0
+// Deprecated: Use StatusLinkResponse.ProtoReflect.Descriptor instead.
+func (*StatusLinkResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LiquidityParameters) GetMaxMinerFeeSat() uint64 {
+func (x *StatusLinkResponse) GetToken() string {
 	if x != nil {
-		return x.MaxMinerFeeSat
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *LiquidityParameters) GetSweepConfTarget() int32 {
+func (x *StatusLinkResponse) GetPath() string {
 	if x != nil {
-		return x.SweepConfTarget
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *LiquidityParameters) GetFailureBackoffSec() uint64 {
+func (x *StatusLinkResponse) GetExpiryUnix() int64 {
 	if x != nil {
-		return x.FailureBackoffSec
+		return x.ExpiryUnix
 	}
 	return 0
 }
 
-func (x *LiquidityParameters) GetAutoloop() bool {
-	if x != nil {
-		return x.Autoloop
-	}
-	return false
+type TermsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LiquidityParameters) GetAutoloopBudgetSat() uint64 {
-	if x != nil {
-		return x.AutoloopBudgetSat
+func (x *TermsRequest) Reset() {
+	*x = TermsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LiquidityParameters) GetAutoloopBudgetStartSec() uint64 {
-	if x != nil {
-		return x.AutoloopBudgetStartSec
-	}
-	return 0
+func (x *TermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LiquidityParameters) GetAutoMaxInFlight() uint64 {
-	if x != nil {
-		return x.AutoMaxInFlight
-	}
-	return 0
-}
+func (*TermsRequest) ProtoMessage() {}
 
-func (x *LiquidityParameters) GetMinSwapAmount() uint64 {
-	if x != nil {
-		return x.MinSwapAmount
+func (x *TermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiquidityParameters) GetMaxSwapAmount() uint64 {
-	if x != nil {
-		return x.MaxSwapAmount
-	}
-	return 0
+// Deprecated: Use TermsRequest.ProtoReflect.Descriptor instead.
+func (*TermsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{14}
 }
 
-type LiquidityRule struct {
+type InTermsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The short channel ID of the channel that this rule should be applied to.
-	//This field may not be set when the pubkey field is set.
-	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	//
-	//The public key of the peer that this rule should be applied to. This field
-	//may not be set when the channel id field is set.
-	Pubkey []byte `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	//
-	//Type indicates the type of rule that this message rule represents. Setting
-	//this value will determine which fields are used in the message. The comments
-	//on each field in this message will be prefixed with the LiquidityRuleType
-	//they belong to.
-	Type LiquidityRuleType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.LiquidityRuleType" json:"type,omitempty"`
-	//
-	//THRESHOLD: The percentage of total capacity that incoming capacity should
-	//not drop beneath.
-	IncomingThreshold uint32 `protobuf:"varint,3,opt,name=incoming_threshold,json=incomingThreshold,proto3" json:"incoming_threshold,omitempty"`
+	//Minimum swap amount (sat)
+	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
 	//
-	//THRESHOLD: The percentage of total capacity that outgoing capacity should
-	//not drop beneath.
-	OutgoingThreshold uint32 `protobuf:"varint,4,opt,name=outgoing_threshold,json=outgoingThreshold,proto3" json:"outgoing_threshold,omitempty"`
+	//Maximum swap amount (sat)
+	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
 }
 
-func (x *LiquidityRule) Reset() {
-	*x = LiquidityRule{}
+func (x *InTermsResponse) Reset() {
+	*x = InTermsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[21]
+		mi := &file_client_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiquidityRule) String() string {
+func (x *InTermsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiquidityRule) ProtoMessage() {}
+func (*InTermsResponse) ProtoMessage() {}
 
-func (x *LiquidityRule) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[21]
+func (x *InTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,76 +2976,59 @@ func (x *LiquidityRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiquidityRule.ProtoReflect.Descriptor instead.
-func (*LiquidityRule) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *LiquidityRule) GetChannelId() uint64 {
-	if x != nil {
-		return x.ChannelId
-	}
-	return 0
-}
-
-func (x *LiquidityRule) GetPubkey() []byte {
-	if x != nil {
-		return x.Pubkey
-	}
-	return nil
-}
-
-func (x *LiquidityRule) GetType() LiquidityRuleType {
-	if x != nil {
-		return x.Type
-	}
-	return LiquidityRuleType_UNKNOWN
+// Deprecated: Use InTermsResponse.ProtoReflect.Descriptor instead.
+func (*InTermsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LiquidityRule) GetIncomingThreshold() uint32 {
+func (x *InTermsResponse) GetMinSwapAmount() int64 {
 	if x != nil {
-		return x.IncomingThreshold
+		return x.MinSwapAmount
 	}
 	return 0
 }
 
-func (x *LiquidityRule) GetOutgoingThreshold() uint32 {
+func (x *InTermsResponse) GetMaxSwapAmount() int64 {
 	if x != nil {
-		return x.OutgoingThreshold
+		return x.MaxSwapAmount
 	}
 	return 0
 }
 
-type SetLiquidityParamsRequest struct {
+type OutTermsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//Parameters is the desired new set of parameters for the liquidity management
-	//subsystem. Note that the current set of parameters will be completely
-	//overwritten by the parameters provided (if they are valid), so the full set
-	//of parameters should be provided for each call.
-	Parameters *LiquidityParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	//Minimum swap amount (sat)
+	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
+	//
+	//Maximum swap amount (sat)
+	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+	// The minimally accepted cltv delta of the on-chain htlc.
+	MinCltvDelta int32 `protobuf:"varint,8,opt,name=min_cltv_delta,json=minCltvDelta,proto3" json:"min_cltv_delta,omitempty"`
+	// The maximally accepted cltv delta of the on-chain htlc.
+	MaxCltvDelta int32 `protobuf:"varint,9,opt,name=max_cltv_delta,json=maxCltvDelta,proto3" json:"max_cltv_delta,omitempty"`
 }
 
-func (x *SetLiquidityParamsRequest) Reset() {
-	*x = SetLiquidityParamsRequest{}
+func (x *OutTermsResponse) Reset() {
+	*x = OutTermsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[22]
+		mi := &file_client_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLiquidityParamsRequest) String() string {
+func (x *OutTermsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLiquidityParamsRequest) ProtoMessage() {}
+func (*OutTermsResponse) ProtoMessage() {}
 
-func (x *SetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[22]
+func (x *OutTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,41 +3039,91 @@ func (x *SetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
-func (*SetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use OutTermsResponse.ProtoReflect.Descriptor instead.
+func (*OutTermsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SetLiquidityParamsRequest) GetParameters() *LiquidityParameters {
+func (x *OutTermsResponse) GetMinSwapAmount() int64 {
 	if x != nil {
-		return x.Parameters
+		return x.MinSwapAmount
 	}
-	return nil
+	return 0
 }
 
-type SetLiquidityParamsResponse struct {
+func (x *OutTermsResponse) GetMaxSwapAmount() int64 {
+	if x != nil {
+		return x.MaxSwapAmount
+	}
+	return 0
+}
+
+func (x *OutTermsResponse) GetMinCltvDelta() int32 {
+	if x != nil {
+		return x.MinCltvDelta
+	}
+	return 0
+}
+
+func (x *OutTermsResponse) GetMaxCltvDelta() int32 {
+	if x != nil {
+		return x.MaxCltvDelta
+	}
+	return 0
+}
+
+type QuoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount to swap in satoshis.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The confirmation target that should be used either for the sweep of the
+	//on-chain HTLC broadcast by the swap server in the case of a Loop Out, or for
+	//the confirmation of the on-chain HTLC broadcast by the swap client in the
+	//case of a Loop In.
+	ConfTarget int32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//If external_htlc is true, we expect the htlc to be published by an external
+	//actor.
+	ExternalHtlc bool `protobuf:"varint,3,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
+	//
+	//The latest time (in unix seconds) we allow the server to wait before
+	//publishing the HTLC on chain. Setting this to a larger value will give the
+	//server the opportunity to batch multiple swaps together, and wait for
+	//low-fee periods before publishing the HTLC, potentially resulting in a
+	//lower total swap fee. This only has an effect on loop out quotes.
+	SwapPublicationDeadline uint64 `protobuf:"varint,4,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
+	//
+	//Optionally the client can specify the last hop pubkey when requesting a
+	//loop-in quote. This is useful to get better off-chain routing fee from the
+	//server.
+	LoopInLastHop []byte `protobuf:"bytes,5,opt,name=loop_in_last_hop,json=loopInLastHop,proto3" json:"loop_in_last_hop,omitempty"`
+	//
+	//Optional route hints to reach the destination through private channels.
+	LoopInRouteHints []*RouteHint `protobuf:"bytes,6,rep,name=loop_in_route_hints,json=loopInRouteHints,proto3" json:"loop_in_route_hints,omitempty"`
 }
 
-func (x *SetLiquidityParamsResponse) Reset() {
-	*x = SetLiquidityParamsResponse{}
+func (x *QuoteRequest) Reset() {
+	*x = QuoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[23]
+		mi := &file_client_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLiquidityParamsResponse) String() string {
+func (x *QuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLiquidityParamsResponse) ProtoMessage() {}
+func (*QuoteRequest) ProtoMessage() {}
 
-func (x *SetLiquidityParamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[23]
+func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,34 +3134,94 @@ func (x *SetLiquidityParamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLiquidityParamsResponse.ProtoReflect.Descriptor instead.
-func (*SetLiquidityParamsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{17}
 }
 
-type SuggestSwapsRequest struct {
+func (x *QuoteRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *QuoteRequest) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *QuoteRequest) GetExternalHtlc() bool {
+	if x != nil {
+		return x.ExternalHtlc
+	}
+	return false
+}
+
+func (x *QuoteRequest) GetSwapPublicationDeadline() uint64 {
+	if x != nil {
+		return x.SwapPublicationDeadline
+	}
+	return 0
+}
+
+func (x *QuoteRequest) GetLoopInLastHop() []byte {
+	if x != nil {
+		return x.LoopInLastHop
+	}
+	return nil
+}
+
+func (x *QuoteRequest) GetLoopInRouteHints() []*RouteHint {
+	if x != nil {
+		return x.LoopInRouteHints
+	}
+	return nil
+}
+
+type InQuoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The fee that the swap server is charging for the swap.
+	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//An estimate of the on-chain fee that needs to be paid to publish the HTLC
+	//If a miner fee of 0 is returned, it means the external_htlc flag was set for
+	//a loop in and the fee estimation was skipped. If a miner fee of -1 is
+	//returned, it means lnd's wallet tried to estimate the fee but was unable to
+	//create a sample estimation transaction because not enough funds are
+	//available. An information message should be shown to the user in this case.
+	HtlcPublishFeeSat int64 `protobuf:"varint,3,opt,name=htlc_publish_fee_sat,json=htlcPublishFeeSat,proto3" json:"htlc_publish_fee_sat,omitempty"`
+	//
+	//On-chain cltv expiry delta
+	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
+	//
+	//The confirmation target to be used to publish the on-chain HTLC.
+	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
 }
 
-func (x *SuggestSwapsRequest) Reset() {
-	*x = SuggestSwapsRequest{}
+func (x *InQuoteResponse) Reset() {
+	*x = InQuoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[24]
+		mi := &file_client_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestSwapsRequest) String() string {
+func (x *InQuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestSwapsRequest) ProtoMessage() {}
+func (*InQuoteResponse) ProtoMessage() {}
 
-func (x *SuggestSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[24]
+func (x *InQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2509,668 +3232,12162 @@ func (x *SuggestSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestSwapsRequest.ProtoReflect.Descriptor instead.
-func (*SuggestSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use InQuoteResponse.ProtoReflect.Descriptor instead.
+func (*InQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{18}
 }
 
-type Disqualified struct {
+func (x *InQuoteResponse) GetSwapFeeSat() int64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *InQuoteResponse) GetHtlcPublishFeeSat() int64 {
+	if x != nil {
+		return x.HtlcPublishFeeSat
+	}
+	return 0
+}
+
+func (x *InQuoteResponse) GetCltvDelta() int32 {
+	if x != nil {
+		return x.CltvDelta
+	}
+	return 0
+}
+
+func (x *InQuoteResponse) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+type OutQuoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The short channel ID of the channel that was excluded from our suggestions.
-	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//The fee that the swap server is charging for the swap.
+	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
 	//
-	//The public key of the peer that was excluded from our suggestions.
-	Pubkey []byte `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//The part of the swap fee that is requested as a prepayment.
+	PrepayAmtSat int64 `protobuf:"varint,2,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
 	//
-	//The reason that we excluded the channel from the our suggestions.
-	Reason AutoReason `protobuf:"varint,2,opt,name=reason,proto3,enum=looprpc.AutoReason" json:"reason,omitempty"`
+	//An estimate of the on-chain fee that needs to be paid to sweep the HTLC for
+	//a loop out.
+	HtlcSweepFeeSat int64 `protobuf:"varint,3,opt,name=htlc_sweep_fee_sat,json=htlcSweepFeeSat,proto3" json:"htlc_sweep_fee_sat,omitempty"`
+	//
+	//The node pubkey where the swap payment needs to be paid
+	//to. This can be used to test connectivity before initiating the swap.
+	SwapPaymentDest []byte `protobuf:"bytes,4,opt,name=swap_payment_dest,json=swapPaymentDest,proto3" json:"swap_payment_dest,omitempty"`
+	//
+	//On-chain cltv expiry delta
+	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
+	//
+	//The confirmation target to be used for the sweep of the on-chain HTLC.
+	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
 }
 
-func (x *Disqualified) Reset() {
-	*x = Disqualified{}
+func (x *OutQuoteResponse) Reset() {
+	*x = OutQuoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[25]
+		mi := &file_client_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Disqualified) String() string {
+func (x *OutQuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Disqualified) ProtoMessage() {}
+func (*OutQuoteResponse) ProtoMessage() {}
 
-func (x *Disqualified) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[25]
+func (x *OutQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Disqualified.ProtoReflect.Descriptor instead.
-func (*Disqualified) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *Disqualified) GetChannelId() uint64 {
-	if x != nil {
-		return x.ChannelId
-	}
-	return 0
-}
-
-func (x *Disqualified) GetPubkey() []byte {
-	if x != nil {
-		return x.Pubkey
-	}
-	return nil
-}
-
-func (x *Disqualified) GetReason() AutoReason {
-	if x != nil {
-		return x.Reason
-	}
-	return AutoReason_AUTO_REASON_UNKNOWN
-}
-
-type SuggestSwapsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The set of recommended loop outs.
-	LoopOut []*LoopOutRequest `protobuf:"bytes,1,rep,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
-	//
-	//Disqualified contains the set of channels that swaps are not recommended
-	//for.
-	Disqualified []*Disqualified `protobuf:"bytes,2,rep,name=disqualified,proto3" json:"disqualified,omitempty"`
-}
-
-func (x *SuggestSwapsResponse) Reset() {
-	*x = SuggestSwapsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SuggestSwapsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SuggestSwapsResponse) ProtoMessage() {}
-
-func (x *SuggestSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutQuoteResponse.ProtoReflect.Descriptor instead.
+func (*OutQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OutQuoteResponse) GetSwapFeeSat() int64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetPrepayAmtSat() int64 {
+	if x != nil {
+		return x.PrepayAmtSat
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetHtlcSweepFeeSat() int64 {
+	if x != nil {
+		return x.HtlcSweepFeeSat
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetSwapPaymentDest() []byte {
+	if x != nil {
+		return x.SwapPaymentDest
+	}
+	return nil
+}
+
+func (x *OutQuoteResponse) GetCltvDelta() int32 {
+	if x != nil {
+		return x.CltvDelta
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+type ProbeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount to probe.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Optional last hop of the route to probe.
+	LastHop []byte `protobuf:"bytes,2,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//Optional route hints to reach the destination through private channels.
+	RouteHints []*RouteHint `protobuf:"bytes,3,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+}
+
+func (x *ProbeRequest) Reset() {
+	*x = ProbeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeRequest) ProtoMessage() {}
+
+func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeRequest.ProtoReflect.Descriptor instead.
+func (*ProbeRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProbeRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *ProbeRequest) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *ProbeRequest) GetRouteHints() []*RouteHint {
+	if x != nil {
+		return x.RouteHints
+	}
+	return nil
+}
+
+type ProbeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProbeResponse) Reset() {
+	*x = ProbeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeResponse) ProtoMessage() {}
+
+func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeResponse.ProtoReflect.Descriptor instead.
+func (*ProbeResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{21}
+}
+
+type TokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TokensRequest) Reset() {
+	*x = TokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensRequest) ProtoMessage() {}
+
+func (x *TokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
+func (*TokensRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{22}
+}
+
+type TokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//List of all tokens the daemon knows of, including old/expired tokens.
+	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *TokensResponse) Reset() {
+	*x = TokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensResponse) ProtoMessage() {}
+
+func (x *TokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
+func (*TokensResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TokensResponse) GetTokens() []*LsatToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type LsatToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The base macaroon that was baked by the auth server.
+	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
+	//
+	//The payment hash of the payment that was paid to obtain the token.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//
+	//The preimage of the payment hash, knowledge of this is proof that the
+	//payment has been paid. If the preimage is set to all zeros, this means the
+	//payment is still pending and the token is not yet fully valid.
+	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
+	//
+	//The amount of millisatoshis that was paid to get the token.
+	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
+	//
+	//The amount of millisatoshis paid in routing fee to pay for the token.
+	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
+	//
+	//The creation time of the token as UNIX timestamp in seconds.
+	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
+	//
+	//Indicates whether the token is expired or still valid.
+	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	//
+	//Identifying attribute of this token in the store. Currently represents the
+	//file name of the token where it's stored on the file system.
+	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+}
+
+func (x *LsatToken) Reset() {
+	*x = LsatToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsatToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsatToken) ProtoMessage() {}
+
+func (x *LsatToken) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
+func (*LsatToken) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LsatToken) GetBaseMacaroon() []byte {
+	if x != nil {
+		return x.BaseMacaroon
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentPreimage() []byte {
+	if x != nil {
+		return x.PaymentPreimage
+	}
+	return nil
+}
+
+func (x *LsatToken) GetAmountPaidMsat() int64 {
+	if x != nil {
+		return x.AmountPaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
+	if x != nil {
+		return x.RoutingFeePaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetTimeCreated() int64 {
+	if x != nil {
+		return x.TimeCreated
+	}
+	return 0
+}
+
+func (x *LsatToken) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *LsatToken) GetStorageName() string {
+	if x != nil {
+		return x.StorageName
+	}
+	return ""
+}
+
+type GetLiquidityParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLiquidityParamsRequest) Reset() {
+	*x = GetLiquidityParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLiquidityParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiquidityParamsRequest) ProtoMessage() {}
+
+func (x *GetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
+func (*GetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{25}
+}
+
+type LiquidityParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//A set of liquidity rules that describe the desired liquidity balance.
+	Rules []*LiquidityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	//
+	//The parts per million of swap amount that is allowed to be allocated to swap
+	//fees. This value is applied across swap categories and may not be set in
+	//conjunction with sweep fee rate, swap fee ppm, routing fee ppm, prepay
+	//routing, max prepay and max miner fee.
+	FeePpm uint64 `protobuf:"varint,16,opt,name=fee_ppm,json=feePpm,proto3" json:"fee_ppm,omitempty"`
+	//
+	//The limit we place on our estimated sweep cost for a swap in sat/vByte. If
+	//the estimated fee for our sweep transaction within the specified
+	//confirmation target is above this value, we will not suggest any swaps.
+	SweepFeeRateSatPerVbyte uint64 `protobuf:"varint,2,opt,name=sweep_fee_rate_sat_per_vbyte,json=sweepFeeRateSatPerVbyte,proto3" json:"sweep_fee_rate_sat_per_vbyte,omitempty"`
+	//
+	//The maximum fee paid to the server for facilitating the swap, expressed
+	//as parts per million of the swap volume.
+	MaxSwapFeePpm uint64 `protobuf:"varint,3,opt,name=max_swap_fee_ppm,json=maxSwapFeePpm,proto3" json:"max_swap_fee_ppm,omitempty"`
+	//
+	//The maximum fee paid to route the swap invoice off chain, expressed as
+	//parts per million of the volume being routed.
+	MaxRoutingFeePpm uint64 `protobuf:"varint,4,opt,name=max_routing_fee_ppm,json=maxRoutingFeePpm,proto3" json:"max_routing_fee_ppm,omitempty"`
+	//
+	//The maximum fee paid to route the prepay invoice off chain, expressed as
+	//parts per million of the volume being routed.
+	MaxPrepayRoutingFeePpm uint64 `protobuf:"varint,5,opt,name=max_prepay_routing_fee_ppm,json=maxPrepayRoutingFeePpm,proto3" json:"max_prepay_routing_fee_ppm,omitempty"`
+	//
+	//The maximum no-show penalty in satoshis paid for a swap.
+	MaxPrepaySat uint64 `protobuf:"varint,6,opt,name=max_prepay_sat,json=maxPrepaySat,proto3" json:"max_prepay_sat,omitempty"`
+	//
+	//The maximum miner fee we will pay to sweep the swap on chain. Note that we
+	//will not suggest a swap if the estimate is above the sweep limit set by
+	//these parameters, and we use the current fee estimate to sweep on chain so
+	//this value is only a cap placed on the amount we spend on fees in the case
+	//where the swap needs to be claimed on chain, but fees have suddenly spiked.
+	MaxMinerFeeSat uint64 `protobuf:"varint,7,opt,name=max_miner_fee_sat,json=maxMinerFeeSat,proto3" json:"max_miner_fee_sat,omitempty"`
+	//
+	//The number of blocks from the on-chain HTLC's confirmation height that it
+	//should be swept within.
+	SweepConfTarget int32 `protobuf:"varint,8,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//
+	//The amount of time we require pass since a channel was part of a failed
+	//swap due to off chain payment failure until it will be considered for swap
+	//suggestions again, expressed in seconds.
+	FailureBackoffSec uint64 `protobuf:"varint,9,opt,name=failure_backoff_sec,json=failureBackoffSec,proto3" json:"failure_backoff_sec,omitempty"`
+	//
+	//Set to true to enable automatic dispatch of swaps. All swaps will be limited
+	//to the fee categories set by these parameters, and total expenditure will
+	//be limited to the autoloop budget.
+	Autoloop bool `protobuf:"varint,10,opt,name=autoloop,proto3" json:"autoloop,omitempty"`
+	//
+	//The total budget for automatically dispatched swaps since the budget start
+	//time, expressed in satoshis.
+	AutoloopBudgetSat uint64 `protobuf:"varint,11,opt,name=autoloop_budget_sat,json=autoloopBudgetSat,proto3" json:"autoloop_budget_sat,omitempty"`
+	//
+	//The start time for autoloop budget, expressed as a unix timestamp in
+	//seconds. If this value is 0, the budget will be applied for all
+	//automatically dispatched swaps. Swaps that were completed before this date
+	//will not be included in budget calculations.
+	AutoloopBudgetStartSec uint64 `protobuf:"varint,12,opt,name=autoloop_budget_start_sec,json=autoloopBudgetStartSec,proto3" json:"autoloop_budget_start_sec,omitempty"`
+	//
+	//The maximum number of automatically dispatched swaps that we allow to be in
+	//flight at any point in time.
+	AutoMaxInFlight uint64 `protobuf:"varint,13,opt,name=auto_max_in_flight,json=autoMaxInFlight,proto3" json:"auto_max_in_flight,omitempty"`
+	//
+	//The minimum amount, expressed in satoshis, that the autoloop client will
+	//dispatch a swap for. This value is subject to the server-side limits
+	//specified by the LoopOutTerms endpoint.
+	MinSwapAmount uint64 `protobuf:"varint,14,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
+	//
+	//The maximum amount, expressed in satoshis, that the autoloop client will
+	//dispatch a swap for. This value is subject to the server-side limits
+	//specified by the LoopOutTerms endpoint.
+	MaxSwapAmount uint64 `protobuf:"varint,15,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+	//
+	//An optional template that is rendered and appended to the reserved label
+	//of automatically dispatched swaps. The placeholders {type}, {peer},
+	//{peer_alias}, {channels} and {date} are substituted with the details of
+	//the swap at dispatch time.
+	AutoloopLabelTemplate string `protobuf:"bytes,17,opt,name=autoloop_label_template,json=autoloopLabelTemplate,proto3" json:"autoloop_label_template,omitempty"`
+	//
+	//An optional set of peer pubkeys that liquidity management is restricted
+	//to. If this list is non-empty, channels with peers that are not in the list
+	//will never be used for swaps.
+	AllowedPeers [][]byte `protobuf:"bytes,18,rep,name=allowed_peers,json=allowedPeers,proto3" json:"allowed_peers,omitempty"`
+	//
+	//A set of peer pubkeys whose channels will never be used for swaps, even if
+	//a rule covers them.
+	ExcludedPeers [][]byte `protobuf:"bytes,19,rep,name=excluded_peers,json=excludedPeers,proto3" json:"excluded_peers,omitempty"`
+	//
+	//The minimum percentage of time that a peer must have been observed online
+	//for its channels to be used for swaps. Channels that lnd has not yet
+	//monitored are not subject to this requirement. If zero, no uptime
+	//requirement is applied.
+	MinUptimePercent float64 `protobuf:"fixed64,20,opt,name=min_uptime_percent,json=minUptimePercent,proto3" json:"min_uptime_percent,omitempty"`
+	//
+	//The way that the liquidity manager accounts for pending swaps that are not
+	//restricted to specific channels or peers.
+	UnrestrictedMode UnrestrictedSwapMode `protobuf:"varint,21,opt,name=unrestricted_mode,json=unrestrictedMode,proto3,enum=looprpc.UnrestrictedSwapMode" json:"unrestricted_mode,omitempty"`
+	//
+	//Set to true to combine the swap amounts required by channel rules for
+	//several of a peer's channels into a single swap when each amount on its own
+	//is below the server's minimum swap amount.
+	AggregateDeficits bool `protobuf:"varint,22,opt,name=aggregate_deficits,json=aggregateDeficits,proto3" json:"aggregate_deficits,omitempty"`
+	//
+	//The order in which autoloop budget is allocated to swap suggestions when
+	//it cannot cover all of them.
+	Priority SuggestionPriority `protobuf:"varint,23,opt,name=priority,proto3,enum=looprpc.SuggestionPriority" json:"priority,omitempty"`
+	//
+	//An optional set of weights for peers, used to order swap suggestions when
+	//weighted priority is set. Peers without a weight have a weight of 1.
+	PeerWeights []*PeerWeight `protobuf:"bytes,24,rep,name=peer_weights,json=peerWeights,proto3" json:"peer_weights,omitempty"`
+	//
+	//The percentage of a swap's amount that the amounts of automatically
+	//dispatched swaps are randomly increased or decreased by, within the swap
+	//server's limits. This prevents observers from correlating repeated swaps
+	//of the same amount. If zero, amounts are not randomized. Must be at most
+	//10.
+	AmountJitterPercent float64 `protobuf:"fixed64,26,opt,name=amount_jitter_percent,json=amountJitterPercent,proto3" json:"amount_jitter_percent,omitempty"`
+	//
+	//Whether suggested loop outs are compared with circular rebalances, which
+	//shift the same liquidity off-chain by paying ourselves through another
+	//channel, and whether the rebalances are suggested in their place when
+	//they are cheaper.
+	CircularMode CircularMode `protobuf:"varint,27,opt,name=circular_mode,json=circularMode,proto3,enum=looprpc.CircularMode" json:"circular_mode,omitempty"`
+	//
+	//The largest percentage that a peer may have raised the fee that it charges
+	//to forward over a channel to us by within the last day for the channel to
+	//be used for swaps. Fees are tracked from the time that this value is set,
+	//and are not kept across restarts. If zero, peer fees are not checked.
+	MaxPeerFeeIncreasePercent uint64 `protobuf:"varint,28,opt,name=max_peer_fee_increase_percent,json=maxPeerFeeIncreasePercent,proto3" json:"max_peer_fee_increase_percent,omitempty"`
+	//
+	//The interval in seconds at which the autoloop budget is replenished. If
+	//set, the budget applies to the swaps that complete within the current
+	//period only, where periods are counted from the budget start time, which
+	//must be set. If this value is 0, the budget applies to all swaps since the
+	//budget start time.
+	AutoloopBudgetRefreshPeriodSec uint64 `protobuf:"varint,29,opt,name=autoloop_budget_refresh_period_sec,json=autoloopBudgetRefreshPeriodSec,proto3" json:"autoloop_budget_refresh_period_sec,omitempty"`
+}
+
+func (x *LiquidityParameters) Reset() {
+	*x = LiquidityParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityParameters) ProtoMessage() {}
+
+func (x *LiquidityParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityParameters.ProtoReflect.Descriptor instead.
+func (*LiquidityParameters) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LiquidityParameters) GetRules() []*LiquidityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *LiquidityParameters) GetFeePpm() uint64 {
+	if x != nil {
+		return x.FeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetSweepFeeRateSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SweepFeeRateSatPerVbyte
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxSwapFeePpm() uint64 {
+	if x != nil {
+		return x.MaxSwapFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxRoutingFeePpm() uint64 {
+	if x != nil {
+		return x.MaxRoutingFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxPrepayRoutingFeePpm() uint64 {
+	if x != nil {
+		return x.MaxPrepayRoutingFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxPrepaySat() uint64 {
+	if x != nil {
+		return x.MaxPrepaySat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxMinerFeeSat() uint64 {
+	if x != nil {
+		return x.MaxMinerFeeSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetSweepConfTarget() int32 {
+	if x != nil {
+		return x.SweepConfTarget
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetFailureBackoffSec() uint64 {
+	if x != nil {
+		return x.FailureBackoffSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloop() bool {
+	if x != nil {
+		return x.Autoloop
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetSat() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetStartSec() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetStartSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoMaxInFlight() uint64 {
+	if x != nil {
+		return x.AutoMaxInFlight
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMinSwapAmount() uint64 {
+	if x != nil {
+		return x.MinSwapAmount
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxSwapAmount() uint64 {
+	if x != nil {
+		return x.MaxSwapAmount
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopLabelTemplate() string {
+	if x != nil {
+		return x.AutoloopLabelTemplate
+	}
+	return ""
+}
+
+func (x *LiquidityParameters) GetAllowedPeers() [][]byte {
+	if x != nil {
+		return x.AllowedPeers
+	}
+	return nil
+}
+
+func (x *LiquidityParameters) GetExcludedPeers() [][]byte {
+	if x != nil {
+		return x.ExcludedPeers
+	}
+	return nil
+}
+
+func (x *LiquidityParameters) GetMinUptimePercent() float64 {
+	if x != nil {
+		return x.MinUptimePercent
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetUnrestrictedMode() UnrestrictedSwapMode {
+	if x != nil {
+		return x.UnrestrictedMode
+	}
+	return UnrestrictedSwapMode_UNRESTRICTED_SWAP_MODE_IGNORE
+}
+
+func (x *LiquidityParameters) GetAggregateDeficits() bool {
+	if x != nil {
+		return x.AggregateDeficits
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetPriority() SuggestionPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return SuggestionPriority_SUGGESTION_PRIORITY_AMOUNT
+}
+
+func (x *LiquidityParameters) GetPeerWeights() []*PeerWeight {
+	if x != nil {
+		return x.PeerWeights
+	}
+	return nil
+}
+
+func (x *LiquidityParameters) GetAmountJitterPercent() float64 {
+	if x != nil {
+		return x.AmountJitterPercent
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetCircularMode() CircularMode {
+	if x != nil {
+		return x.CircularMode
+	}
+	return CircularMode_CIRCULAR_MODE_DISABLED
+}
+
+func (x *LiquidityParameters) GetMaxPeerFeeIncreasePercent() uint64 {
+	if x != nil {
+		return x.MaxPeerFeeIncreasePercent
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetRefreshPeriodSec() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetRefreshPeriodSec
+	}
+	return 0
+}
+
+type PeerWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The pubkey of the peer that the weight applies to.
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//The weight of the peer, which must be non-zero.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *PeerWeight) Reset() {
+	*x = PeerWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerWeight) ProtoMessage() {}
+
+func (x *PeerWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerWeight.ProtoReflect.Descriptor instead.
+func (*PeerWeight) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PeerWeight) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *PeerWeight) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type LiquidityRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel ID of the channel that this rule should be applied to.
+	//This field may not be set when the pubkey field is set.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//
+	//The public key of the peer that this rule should be applied to. This field
+	//may not be set when the channel id field is set.
+	Pubkey []byte `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//Type indicates the type of rule that this message rule represents. Setting
+	//this value will determine which fields are used in the message. The comments
+	//on each field in this message will be prefixed with the LiquidityRuleType
+	//they belong to.
+	Type LiquidityRuleType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.LiquidityRuleType" json:"type,omitempty"`
+	//
+	//THRESHOLD: The percentage of total capacity that incoming capacity should
+	//not drop beneath.
+	IncomingThreshold uint32 `protobuf:"varint,3,opt,name=incoming_threshold,json=incomingThreshold,proto3" json:"incoming_threshold,omitempty"`
+	//
+	//THRESHOLD: The percentage of total capacity that outgoing capacity should
+	//not drop beneath.
+	OutgoingThreshold uint32 `protobuf:"varint,4,opt,name=outgoing_threshold,json=outgoingThreshold,proto3" json:"outgoing_threshold,omitempty"`
+	//
+	//The amount of time we require pass since one of the rule's channels was
+	//part of a successful automatically dispatched swap until the rule will be
+	//considered for swap suggestions again, expressed in seconds. If zero, no
+	//cooldown is applied.
+	SuccessCooldownSec uint64 `protobuf:"varint,6,opt,name=success_cooldown_sec,json=successCooldownSec,proto3" json:"success_cooldown_sec,omitempty"`
+}
+
+func (x *LiquidityRule) Reset() {
+	*x = LiquidityRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityRule) ProtoMessage() {}
+
+func (x *LiquidityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityRule.ProtoReflect.Descriptor instead.
+func (*LiquidityRule) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LiquidityRule) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *LiquidityRule) GetType() LiquidityRuleType {
+	if x != nil {
+		return x.Type
+	}
+	return LiquidityRuleType_UNKNOWN
+}
+
+func (x *LiquidityRule) GetIncomingThreshold() uint32 {
+	if x != nil {
+		return x.IncomingThreshold
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetOutgoingThreshold() uint32 {
+	if x != nil {
+		return x.OutgoingThreshold
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetSuccessCooldownSec() uint64 {
+	if x != nil {
+		return x.SuccessCooldownSec
+	}
+	return 0
+}
+
+type SetLiquidityParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Parameters is the desired new set of parameters for the liquidity management
+	//subsystem. Note that the current set of parameters will be completely
+	//overwritten by the parameters provided (if they are valid), so the full set
+	//of parameters should be provided for each call.
+	Parameters *LiquidityParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *SetLiquidityParamsRequest) Reset() {
+	*x = SetLiquidityParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLiquidityParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLiquidityParamsRequest) ProtoMessage() {}
+
+func (x *SetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
+func (*SetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetLiquidityParamsRequest) GetParameters() *LiquidityParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type SetLiquidityParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLiquidityParamsResponse) Reset() {
+	*x = SetLiquidityParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLiquidityParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLiquidityParamsResponse) ProtoMessage() {}
+
+func (x *SetLiquidityParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLiquidityParamsResponse.ProtoReflect.Descriptor instead.
+func (*SetLiquidityParamsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{30}
+}
+
+type SuggestSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Channels that are not eligible for swaps in this set of suggestions, in
+	//addition to the channels that the liquidity parameters exclude.
+	ExcludeChannels []uint64 `protobuf:"varint,1,rep,packed,name=exclude_channels,json=excludeChannels,proto3" json:"exclude_channels,omitempty"`
+	//
+	//Peers whose channels are not eligible for swaps in this set of
+	//suggestions, in addition to the peers that the liquidity parameters
+	//exclude.
+	ExcludePeers [][]byte `protobuf:"bytes,2,rep,name=exclude_peers,json=excludePeers,proto3" json:"exclude_peers,omitempty"`
+	//
+	//The maximum number of loop outs to suggest. The loop outs that would be
+	//dispatched first are kept. If zero, the number of suggestions is not
+	//capped.
+	MaxSwaps uint32 `protobuf:"varint,3,opt,name=max_swaps,json=maxSwaps,proto3" json:"max_swaps,omitempty"`
+	//
+	//A hypothetical set of rules that replaces the current rules for this set
+	//of suggestions. The rules are validated, but are never stored.
+	Rules []*LiquidityRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *SuggestSwapsRequest) Reset() {
+	*x = SuggestSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSwapsRequest) ProtoMessage() {}
+
+func (x *SuggestSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSwapsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SuggestSwapsRequest) GetExcludeChannels() []uint64 {
+	if x != nil {
+		return x.ExcludeChannels
+	}
+	return nil
+}
+
+func (x *SuggestSwapsRequest) GetExcludePeers() [][]byte {
+	if x != nil {
+		return x.ExcludePeers
+	}
+	return nil
+}
+
+func (x *SuggestSwapsRequest) GetMaxSwaps() uint32 {
+	if x != nil {
+		return x.MaxSwaps
+	}
+	return 0
+}
+
+func (x *SuggestSwapsRequest) GetRules() []*LiquidityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Disqualified struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel ID of the channel that was excluded from our suggestions.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//
+	//The public key of the peer that was excluded from our suggestions.
+	Pubkey []byte `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//The reason that we excluded the channel from the our suggestions.
+	Reason AutoReason `protobuf:"varint,2,opt,name=reason,proto3,enum=looprpc.AutoReason" json:"reason,omitempty"`
+}
+
+func (x *Disqualified) Reset() {
+	*x = Disqualified{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Disqualified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Disqualified) ProtoMessage() {}
+
+func (x *Disqualified) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Disqualified.ProtoReflect.Descriptor instead.
+func (*Disqualified) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Disqualified) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Disqualified) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *Disqualified) GetReason() AutoReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AutoReason_AUTO_REASON_UNKNOWN
+}
+
+type SuggestSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The set of recommended loop outs.
+	LoopOut []*LoopOutRequest `protobuf:"bytes,1,rep,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	//
+	//Disqualified contains the set of channels that swaps are not recommended
+	//for.
+	Disqualified []*Disqualified `protobuf:"bytes,2,rep,name=disqualified,proto3" json:"disqualified,omitempty"`
+	//
+	//The set of loop outs that would have been recommended, but were skipped
+	//because they did not fit in the remaining autoloop budget.
+	BudgetElided []*LoopOutRequest `protobuf:"bytes,3,rep,name=budget_elided,json=budgetElided,proto3" json:"budget_elided,omitempty"`
+	//
+	//A comparison of each loop out that would be recommended with the
+	//cheapest circular rebalance found for the same liquidity shift, set if
+	//circular rebalances are compared.
+	CircularComparisons []*CircularComparison `protobuf:"bytes,4,rep,name=circular_comparisons,json=circularComparisons,proto3" json:"circular_comparisons,omitempty"`
+	//
+	//The set of circular rebalances that are recommended in place of more
+	//expensive loop outs.
+	CircularRebalances []*CircularRebalance `protobuf:"bytes,5,rep,name=circular_rebalances,json=circularRebalances,proto3" json:"circular_rebalances,omitempty"`
+	//
+	//A comparison of each recommended loop out with buying the same inbound
+	//liquidity as a channel from the configured liquidity service providers,
+	//set if any providers are configured.
+	ChannelComparisons []*ChannelComparison `protobuf:"bytes,6,rep,name=channel_comparisons,json=channelComparisons,proto3" json:"channel_comparisons,omitempty"`
+}
+
+func (x *SuggestSwapsResponse) Reset() {
+	*x = SuggestSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSwapsResponse) ProtoMessage() {}
+
+func (x *SuggestSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSwapsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SuggestSwapsResponse) GetLoopOut() []*LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetDisqualified() []*Disqualified {
+	if x != nil {
+		return x.Disqualified
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetBudgetElided() []*LoopOutRequest {
+	if x != nil {
+		return x.BudgetElided
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetCircularComparisons() []*CircularComparison {
+	if x != nil {
+		return x.CircularComparisons
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetCircularRebalances() []*CircularRebalance {
+	if x != nil {
+		return x.CircularRebalances
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetChannelComparisons() []*ChannelComparison {
+	if x != nil {
+		return x.ChannelComparisons
+	}
+	return nil
+}
+
+type QuoteSuggestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The loop outs to quote, as returned by SuggestSwaps. If empty, the loop
+	//outs that are currently suggested are quoted.
+	LoopOut []*LoopOutRequest `protobuf:"bytes,1,rep,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+}
+
+func (x *QuoteSuggestionsRequest) Reset() {
+	*x = QuoteSuggestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSuggestionsRequest) ProtoMessage() {}
+
+func (x *QuoteSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*QuoteSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QuoteSuggestionsRequest) GetLoopOut() []*LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+type SuggestionQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The loop out that was quoted.
+	LoopOut *LoopOutRequest `protobuf:"bytes,1,opt,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	//
+	//The fee that the swap server currently charges for the swap.
+	SwapFeeSat int64 `protobuf:"varint,2,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//The no show penalty that is paid upfront for the swap.
+	PrepayAmtSat int64 `protobuf:"varint,3,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
+	//
+	//An estimate of the on-chain fee that is paid to sweep the htlc.
+	HtlcSweepFeeSat int64 `protobuf:"varint,4,opt,name=htlc_sweep_fee_sat,json=htlcSweepFeeSat,proto3" json:"htlc_sweep_fee_sat,omitempty"`
+	//
+	//The fee that the swap pays if it completes at its quote, which is its
+	//swap and sweep fee. Routing fees are not quoted.
+	FeeSat int64 `protobuf:"varint,5,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	//
+	//The most that the swap may pay in fees with the limits set in its
+	//request, including routing fees.
+	MaxFeeSat int64 `protobuf:"varint,6,opt,name=max_fee_sat,json=maxFeeSat,proto3" json:"max_fee_sat,omitempty"`
+}
+
+func (x *SuggestionQuote) Reset() {
+	*x = SuggestionQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestionQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionQuote) ProtoMessage() {}
+
+func (x *SuggestionQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionQuote.ProtoReflect.Descriptor instead.
+func (*SuggestionQuote) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SuggestionQuote) GetLoopOut() *LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *SuggestionQuote) GetSwapFeeSat() int64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *SuggestionQuote) GetPrepayAmtSat() int64 {
+	if x != nil {
+		return x.PrepayAmtSat
+	}
+	return 0
+}
+
+func (x *SuggestionQuote) GetHtlcSweepFeeSat() int64 {
+	if x != nil {
+		return x.HtlcSweepFeeSat
+	}
+	return 0
+}
+
+func (x *SuggestionQuote) GetFeeSat() int64 {
+	if x != nil {
+		return x.FeeSat
+	}
+	return 0
+}
+
+func (x *SuggestionQuote) GetMaxFeeSat() int64 {
+	if x != nil {
+		return x.MaxFeeSat
+	}
+	return 0
+}
+
+type QuoteSuggestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The quote for each loop out in the bundle.
+	Quotes []*SuggestionQuote `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	//
+	//The total amount of the loop outs in the bundle.
+	TotalAmtSat int64 `protobuf:"varint,2,opt,name=total_amt_sat,json=totalAmtSat,proto3" json:"total_amt_sat,omitempty"`
+	//
+	//The total fee that the bundle pays if every loop out completes at its
+	//quote.
+	TotalFeeSat int64 `protobuf:"varint,3,opt,name=total_fee_sat,json=totalFeeSat,proto3" json:"total_fee_sat,omitempty"`
+	//
+	//The most that the bundle may pay in fees with the limits set in its
+	//requests.
+	TotalMaxFeeSat int64 `protobuf:"varint,4,opt,name=total_max_fee_sat,json=totalMaxFeeSat,proto3" json:"total_max_fee_sat,omitempty"`
+}
+
+func (x *QuoteSuggestionsResponse) Reset() {
+	*x = QuoteSuggestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSuggestionsResponse) ProtoMessage() {}
+
+func (x *QuoteSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*QuoteSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QuoteSuggestionsResponse) GetQuotes() []*SuggestionQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+func (x *QuoteSuggestionsResponse) GetTotalAmtSat() int64 {
+	if x != nil {
+		return x.TotalAmtSat
+	}
+	return 0
+}
+
+func (x *QuoteSuggestionsResponse) GetTotalFeeSat() int64 {
+	if x != nil {
+		return x.TotalFeeSat
+	}
+	return 0
+}
+
+func (x *QuoteSuggestionsResponse) GetTotalMaxFeeSat() int64 {
+	if x != nil {
+		return x.TotalMaxFeeSat
+	}
+	return 0
+}
+
+type ApplySuggestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The indexes of the loop outs to dispatch in the loop_out list of the
+	//current SuggestSwaps output. Suggestions are recomputed when they are
+	//applied, so indexes should be taken from suggestions that were just
+	//reviewed.
+	Indexes []uint32 `protobuf:"varint,1,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	//
+	//Short channel ids that select every suggested loop out that uses any of
+	//the channels.
+	Channels []uint64 `protobuf:"varint,2,rep,packed,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ApplySuggestionsRequest) Reset() {
+	*x = ApplySuggestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySuggestionsRequest) ProtoMessage() {}
+
+func (x *ApplySuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ApplySuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ApplySuggestionsRequest) GetIndexes() []uint32 {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *ApplySuggestionsRequest) GetChannels() []uint64 {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type AppliedSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The loop out that was dispatched.
+	LoopOut *LoopOutRequest `protobuf:"bytes,1,opt,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	//
+	//The id of the swap that was created. It is not set if dispatch failed.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The htlc address of the swap that was created. It is not set if dispatch
+	//failed.
+	HtlcAddress string `protobuf:"bytes,3,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
+	//
+	//The error that dispatch failed with, if any.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AppliedSuggestion) Reset() {
+	*x = AppliedSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppliedSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedSuggestion) ProtoMessage() {}
+
+func (x *AppliedSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedSuggestion.ProtoReflect.Descriptor instead.
+func (*AppliedSuggestion) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AppliedSuggestion) GetLoopOut() *LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *AppliedSuggestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppliedSuggestion) GetHtlcAddress() string {
+	if x != nil {
+		return x.HtlcAddress
+	}
+	return ""
+}
+
+func (x *AppliedSuggestion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ApplySuggestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The outcome of dispatching each selected loop out. A failure to dispatch
+	//one loop out does not prevent the others from being dispatched.
+	Swaps []*AppliedSuggestion `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *ApplySuggestionsResponse) Reset() {
+	*x = ApplySuggestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySuggestionsResponse) ProtoMessage() {}
+
+func (x *ApplySuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*ApplySuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ApplySuggestionsResponse) GetSwaps() []*AppliedSuggestion {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+type ParameterAdviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ParameterAdviceRequest) Reset() {
+	*x = ParameterAdviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParameterAdviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterAdviceRequest) ProtoMessage() {}
+
+func (x *ParameterAdviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterAdviceRequest.ProtoReflect.Descriptor instead.
+func (*ParameterAdviceRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{40}
+}
+
+type ParameterAdvice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the parameter that the advice is for, which is the name of
+	//the loop setparams flag that sets it.
+	Parameter string `protobuf:"bytes,1,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	//
+	//The current value of the parameter.
+	Current string `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	//
+	//The recommended value of the parameter.
+	Recommended string `protobuf:"bytes,3,opt,name=recommended,proto3" json:"recommended,omitempty"`
+	//
+	//The swap and fee history that the recommendation is based on.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ParameterAdvice) Reset() {
+	*x = ParameterAdvice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParameterAdvice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterAdvice) ProtoMessage() {}
+
+func (x *ParameterAdvice) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterAdvice.ProtoReflect.Descriptor instead.
+func (*ParameterAdvice) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ParameterAdvice) GetParameter() string {
+	if x != nil {
+		return x.Parameter
+	}
+	return ""
+}
+
+func (x *ParameterAdvice) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *ParameterAdvice) GetRecommended() string {
+	if x != nil {
+		return x.Recommended
+	}
+	return ""
+}
+
+func (x *ParameterAdvice) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ParameterAdviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The recommended changes to the liquidity manager's parameters.
+	Advice []*ParameterAdvice `protobuf:"bytes,1,rep,name=advice,proto3" json:"advice,omitempty"`
+}
+
+func (x *ParameterAdviceResponse) Reset() {
+	*x = ParameterAdviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParameterAdviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterAdviceResponse) ProtoMessage() {}
+
+func (x *ParameterAdviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterAdviceResponse.ProtoReflect.Descriptor instead.
+func (*ParameterAdviceResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ParameterAdviceResponse) GetAdvice() []*ParameterAdvice {
+	if x != nil {
+		return x.Advice
+	}
+	return nil
+}
+
+type CircularRebalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount of liquidity that is shifted.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The short channel id of the channel that the payment leaves over.
+	OutgoingChanId uint64 `protobuf:"varint,2,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	//
+	//The pubkey of the peer that the payment returns to us from.
+	LastHop []byte `protobuf:"bytes,3,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//The routing fee of the cheapest route found for the payment.
+	FeeSat int64 `protobuf:"varint,4,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+}
+
+func (x *CircularRebalance) Reset() {
+	*x = CircularRebalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircularRebalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircularRebalance) ProtoMessage() {}
+
+func (x *CircularRebalance) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircularRebalance.ProtoReflect.Descriptor instead.
+func (*CircularRebalance) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CircularRebalance) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *CircularRebalance) GetOutgoingChanId() uint64 {
+	if x != nil {
+		return x.OutgoingChanId
+	}
+	return 0
+}
+
+func (x *CircularRebalance) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *CircularRebalance) GetFeeSat() int64 {
+	if x != nil {
+		return x.FeeSat
+	}
+	return 0
+}
+
+type CircularComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The loop out that would be recommended.
+	LoopOut *LoopOutRequest `protobuf:"bytes,1,opt,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	//
+	//The quoted swap fee and estimated on-chain fee of the loop out. Off-chain
+	//routing fees are not quoted, so they are not included.
+	LoopOutFeeSat int64 `protobuf:"varint,2,opt,name=loop_out_fee_sat,json=loopOutFeeSat,proto3" json:"loop_out_fee_sat,omitempty"`
+	//
+	//The cheapest circular rebalance found for the same liquidity shift, if
+	//any route was found.
+	Rebalance *CircularRebalance `protobuf:"bytes,3,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	//
+	//Whether the circular rebalance is recommended in place of the loop out.
+	CircularChosen bool `protobuf:"varint,4,opt,name=circular_chosen,json=circularChosen,proto3" json:"circular_chosen,omitempty"`
+}
+
+func (x *CircularComparison) Reset() {
+	*x = CircularComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircularComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircularComparison) ProtoMessage() {}
+
+func (x *CircularComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircularComparison.ProtoReflect.Descriptor instead.
+func (*CircularComparison) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CircularComparison) GetLoopOut() *LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *CircularComparison) GetLoopOutFeeSat() int64 {
+	if x != nil {
+		return x.LoopOutFeeSat
+	}
+	return 0
+}
+
+func (x *CircularComparison) GetRebalance() *CircularRebalance {
+	if x != nil {
+		return x.Rebalance
+	}
+	return nil
+}
+
+func (x *CircularComparison) GetCircularChosen() bool {
+	if x != nil {
+		return x.CircularChosen
+	}
+	return false
+}
+
+type ChannelQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The liquidity service provider that quoted the channel.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	//
+	//The inbound capacity of the quoted channel.
+	CapacitySat int64 `protobuf:"varint,2,opt,name=capacity_sat,json=capacitySat,proto3" json:"capacity_sat,omitempty"`
+	//
+	//The total fee that the provider charges for the channel.
+	FeeSat int64 `protobuf:"varint,3,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	//
+	//The number of blocks that the provider commits to keeping the channel
+	//open for, or zero if it makes no commitment.
+	LeaseBlocks uint32 `protobuf:"varint,4,opt,name=lease_blocks,json=leaseBlocks,proto3" json:"lease_blocks,omitempty"`
+}
+
+func (x *ChannelQuote) Reset() {
+	*x = ChannelQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelQuote) ProtoMessage() {}
+
+func (x *ChannelQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelQuote.ProtoReflect.Descriptor instead.
+func (*ChannelQuote) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ChannelQuote) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ChannelQuote) GetCapacitySat() int64 {
+	if x != nil {
+		return x.CapacitySat
+	}
+	return 0
+}
+
+func (x *ChannelQuote) GetFeeSat() int64 {
+	if x != nil {
+		return x.FeeSat
+	}
+	return 0
+}
+
+func (x *ChannelQuote) GetLeaseBlocks() uint32 {
+	if x != nil {
+		return x.LeaseBlocks
+	}
+	return 0
+}
+
+type ChannelComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The recommended loop out.
+	LoopOut *LoopOutRequest `protobuf:"bytes,1,opt,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	//
+	//The quoted swap fee and estimated on-chain fee of the loop out. Off-chain
+	//routing fees are not quoted, so they are not included.
+	LoopOutFeeSat int64 `protobuf:"varint,2,opt,name=loop_out_fee_sat,json=loopOutFeeSat,proto3" json:"loop_out_fee_sat,omitempty"`
+	//
+	//The quotes that the liquidity service providers returned for a channel
+	//with the loop out's amount of inbound capacity, cheapest first.
+	Quotes []*ChannelQuote `protobuf:"bytes,3,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	//
+	//Whether the cheapest quoted channel costs less than the loop out's fees.
+	ChannelCheaper bool `protobuf:"varint,4,opt,name=channel_cheaper,json=channelCheaper,proto3" json:"channel_cheaper,omitempty"`
+}
+
+func (x *ChannelComparison) Reset() {
+	*x = ChannelComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelComparison) ProtoMessage() {}
+
+func (x *ChannelComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelComparison.ProtoReflect.Descriptor instead.
+func (*ChannelComparison) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChannelComparison) GetLoopOut() *LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *ChannelComparison) GetLoopOutFeeSat() int64 {
+	if x != nil {
+		return x.LoopOutFeeSat
+	}
+	return 0
+}
+
+func (x *ChannelComparison) GetQuotes() []*ChannelQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+func (x *ChannelComparison) GetChannelCheaper() bool {
+	if x != nil {
+		return x.ChannelCheaper
+	}
+	return false
+}
+
+type LiquiditySummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LiquiditySummaryRequest) Reset() {
+	*x = LiquiditySummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquiditySummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquiditySummaryRequest) ProtoMessage() {}
+
+func (x *LiquiditySummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquiditySummaryRequest.ProtoReflect.Descriptor instead.
+func (*LiquiditySummaryRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{47}
+}
+
+type LiquiditySummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The total amount of loop outs that have completed off chain, but have not
+	//yet had their sweep confirmed. These funds will be available on chain once
+	//the sweep confirms, less the sweep's miner fee.
+	PendingSweepSat uint64 `protobuf:"varint,1,opt,name=pending_sweep_sat,json=pendingSweepSat,proto3" json:"pending_sweep_sat,omitempty"`
+	//
+	//The portion of pending_sweep_sat that belongs to automatically dispatched
+	//swaps.
+	AutoloopPendingSweepSat uint64 `protobuf:"varint,2,opt,name=autoloop_pending_sweep_sat,json=autoloopPendingSweepSat,proto3" json:"autoloop_pending_sweep_sat,omitempty"`
+	//
+	//The node's confirmed on chain wallet balance.
+	ConfirmedBalanceSat uint64 `protobuf:"varint,3,opt,name=confirmed_balance_sat,json=confirmedBalanceSat,proto3" json:"confirmed_balance_sat,omitempty"`
+	//
+	//The node's unconfirmed on chain wallet balance.
+	UnconfirmedBalanceSat uint64 `protobuf:"varint,4,opt,name=unconfirmed_balance_sat,json=unconfirmedBalanceSat,proto3" json:"unconfirmed_balance_sat,omitempty"`
+	//
+	//The total local balance across the channels that are covered by liquidity
+	//rules.
+	LocalBalanceSat uint64 `protobuf:"varint,5,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	//
+	//The total remote balance across the channels that are covered by liquidity
+	//rules.
+	RemoteBalanceSat uint64 `protobuf:"varint,6,opt,name=remote_balance_sat,json=remoteBalanceSat,proto3" json:"remote_balance_sat,omitempty"`
+	//
+	//The total amount of pending loop out swaps.
+	PendingLoopOutSat uint64 `protobuf:"varint,7,opt,name=pending_loop_out_sat,json=pendingLoopOutSat,proto3" json:"pending_loop_out_sat,omitempty"`
+	//
+	//The total amount of pending loop in swaps.
+	PendingLoopInSat uint64 `protobuf:"varint,8,opt,name=pending_loop_in_sat,json=pendingLoopInSat,proto3" json:"pending_loop_in_sat,omitempty"`
+	//
+	//The distance between the current balance of each channel or peer that has
+	//a liquidity rule and the rule's thresholds.
+	Targets []*LiquidityTarget `protobuf:"bytes,9,rep,name=targets,proto3" json:"targets,omitempty"`
+}
+
+func (x *LiquiditySummary) Reset() {
+	*x = LiquiditySummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquiditySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquiditySummary) ProtoMessage() {}
+
+func (x *LiquiditySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquiditySummary.ProtoReflect.Descriptor instead.
+func (*LiquiditySummary) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LiquiditySummary) GetPendingSweepSat() uint64 {
+	if x != nil {
+		return x.PendingSweepSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetAutoloopPendingSweepSat() uint64 {
+	if x != nil {
+		return x.AutoloopPendingSweepSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetConfirmedBalanceSat() uint64 {
+	if x != nil {
+		return x.ConfirmedBalanceSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetUnconfirmedBalanceSat() uint64 {
+	if x != nil {
+		return x.UnconfirmedBalanceSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetLocalBalanceSat() uint64 {
+	if x != nil {
+		return x.LocalBalanceSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetRemoteBalanceSat() uint64 {
+	if x != nil {
+		return x.RemoteBalanceSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetPendingLoopOutSat() uint64 {
+	if x != nil {
+		return x.PendingLoopOutSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetPendingLoopInSat() uint64 {
+	if x != nil {
+		return x.PendingLoopInSat
+	}
+	return 0
+}
+
+func (x *LiquiditySummary) GetTargets() []*LiquidityTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type LiquidityTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel ID of the channel that the rule applies to. This field
+	//is only set for channel rules.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//
+	//The pubkey of the peer that the rule applies to. This field is only set
+	//for peer rules.
+	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//The amount of incoming liquidity required to reach the rule's minimum
+	//incoming threshold.
+	IncomingDeficitSat uint64 `protobuf:"varint,3,opt,name=incoming_deficit_sat,json=incomingDeficitSat,proto3" json:"incoming_deficit_sat,omitempty"`
+	//
+	//The amount of outgoing liquidity required to reach the rule's minimum
+	//outgoing threshold.
+	OutgoingDeficitSat uint64 `protobuf:"varint,4,opt,name=outgoing_deficit_sat,json=outgoingDeficitSat,proto3" json:"outgoing_deficit_sat,omitempty"`
+}
+
+func (x *LiquidityTarget) Reset() {
+	*x = LiquidityTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityTarget) ProtoMessage() {}
+
+func (x *LiquidityTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityTarget.ProtoReflect.Descriptor instead.
+func (*LiquidityTarget) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LiquidityTarget) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *LiquidityTarget) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *LiquidityTarget) GetIncomingDeficitSat() uint64 {
+	if x != nil {
+		return x.IncomingDeficitSat
+	}
+	return 0
+}
+
+func (x *LiquidityTarget) GetOutgoingDeficitSat() uint64 {
+	if x != nil {
+		return x.OutgoingDeficitSat
+	}
+	return 0
+}
+
+type SwapProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the completed swap to produce a proof for.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SwapProofRequest) Reset() {
+	*x = SwapProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapProofRequest) ProtoMessage() {}
+
+func (x *SwapProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapProofRequest.ProtoReflect.Descriptor instead.
+func (*SwapProofRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SwapProofRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type SwapProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the swap, which is the sha256 hash of the preimage.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The final state of the swap.
+	State SwapState `protobuf:"varint,3,opt,name=state,proto3,enum=looprpc.SwapState" json:"state,omitempty"`
+	//
+	//The failure reason of the swap, if it failed.
+	FailureReason FailureReason `protobuf:"varint,4,opt,name=failure_reason,json=failureReason,proto3,enum=looprpc.FailureReason" json:"failure_reason,omitempty"`
+	//
+	//The amount of the swap, expressed in satoshis.
+	Amt int64 `protobuf:"varint,5,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The preimage of the swap, which hashes to the swap hash. Revealing the
+	//preimage proves payment of the swap invoice.
+	Preimage []byte `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//
+	//The swap invoice. For loop out, this is the invoice that was paid to the
+	//server. For loop in, this is the invoice that the server paid to us.
+	SwapInvoice string `protobuf:"bytes,7,opt,name=swap_invoice,json=swapInvoice,proto3" json:"swap_invoice,omitempty"`
+	//
+	//The prepay invoice that was paid to the server. This field is only set for
+	//loop out swaps.
+	PrepayInvoice string `protobuf:"bytes,8,opt,name=prepay_invoice,json=prepayInvoice,proto3" json:"prepay_invoice,omitempty"`
+	//
+	//The version of the htlc script that was used for the swap.
+	HtlcScriptVersion uint32 `protobuf:"varint,9,opt,name=htlc_script_version,json=htlcScriptVersion,proto3" json:"htlc_script_version,omitempty"`
+	//
+	//The htlc witness script, which commits to the swap hash, sender and
+	//receiver keys and the cltv expiry of the swap.
+	HtlcScript []byte `protobuf:"bytes,10,opt,name=htlc_script,json=htlcScript,proto3" json:"htlc_script,omitempty"`
+	//
+	//The native segwit (P2WSH) address of the htlc.
+	HtlcAddressP2Wsh string `protobuf:"bytes,11,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
+	//
+	//The nested segwit (NP2WSH) address of the htlc. This field is only set for
+	//external loop in swaps.
+	HtlcAddressNp2Wsh string `protobuf:"bytes,12,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
+	//
+	//The key of the sender of the on chain htlc.
+	SenderKey []byte `protobuf:"bytes,13,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	//
+	//The key of the receiver of the on chain htlc.
+	ReceiverKey []byte `protobuf:"bytes,14,opt,name=receiver_key,json=receiverKey,proto3" json:"receiver_key,omitempty"`
+	//
+	//The absolute block height at which the on chain htlc expires.
+	CltvExpiry int32 `protobuf:"varint,15,opt,name=cltv_expiry,json=cltvExpiry,proto3" json:"cltv_expiry,omitempty"`
+	//
+	//The block height at which the swap was initiated.
+	InitiationHeight int32 `protobuf:"varint,16,opt,name=initiation_height,json=initiationHeight,proto3" json:"initiation_height,omitempty"`
+	//
+	//The txid of the confirmed htlc transaction, if it was recorded.
+	HtlcTxid string `protobuf:"bytes,17,opt,name=htlc_txid,json=htlcTxid,proto3" json:"htlc_txid,omitempty"`
+	//
+	//The set of on chain transactions that our wallet published for this swap.
+	//These transactions contain the signatures that spend or fund the htlc.
+	Transactions []*SwapProofTransaction `protobuf:"bytes,18,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	//
+	//The amount paid to the server, expressed in satoshis.
+	CostServer int64 `protobuf:"varint,19,opt,name=cost_server,json=costServer,proto3" json:"cost_server,omitempty"`
+	//
+	//The on chain fees paid for the swap, expressed in satoshis.
+	CostOnchain int64 `protobuf:"varint,20,opt,name=cost_onchain,json=costOnchain,proto3" json:"cost_onchain,omitempty"`
+	//
+	//The off chain routing fees paid for the swap, expressed in satoshis.
+	CostOffchain int64 `protobuf:"varint,21,opt,name=cost_offchain,json=costOffchain,proto3" json:"cost_offchain,omitempty"`
+}
+
+func (x *SwapProof) Reset() {
+	*x = SwapProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapProof) ProtoMessage() {}
+
+func (x *SwapProof) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapProof.ProtoReflect.Descriptor instead.
+func (*SwapProof) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SwapProof) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SwapProof) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapProof) GetState() SwapState {
+	if x != nil {
+		return x.State
+	}
+	return SwapState_INITIATED
+}
+
+func (x *SwapProof) GetFailureReason() FailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return FailureReason_FAILURE_REASON_NONE
+}
+
+func (x *SwapProof) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SwapProof) GetPreimage() []byte {
+	if x != nil {
+		return x.Preimage
+	}
+	return nil
+}
+
+func (x *SwapProof) GetSwapInvoice() string {
+	if x != nil {
+		return x.SwapInvoice
+	}
+	return ""
+}
+
+func (x *SwapProof) GetPrepayInvoice() string {
+	if x != nil {
+		return x.PrepayInvoice
+	}
+	return ""
+}
+
+func (x *SwapProof) GetHtlcScriptVersion() uint32 {
+	if x != nil {
+		return x.HtlcScriptVersion
+	}
+	return 0
+}
+
+func (x *SwapProof) GetHtlcScript() []byte {
+	if x != nil {
+		return x.HtlcScript
+	}
+	return nil
+}
+
+func (x *SwapProof) GetHtlcAddressP2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressP2Wsh
+	}
+	return ""
+}
+
+func (x *SwapProof) GetHtlcAddressNp2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressNp2Wsh
+	}
+	return ""
+}
+
+func (x *SwapProof) GetSenderKey() []byte {
+	if x != nil {
+		return x.SenderKey
+	}
+	return nil
+}
+
+func (x *SwapProof) GetReceiverKey() []byte {
+	if x != nil {
+		return x.ReceiverKey
+	}
+	return nil
+}
+
+func (x *SwapProof) GetCltvExpiry() int32 {
+	if x != nil {
+		return x.CltvExpiry
+	}
+	return 0
+}
+
+func (x *SwapProof) GetInitiationHeight() int32 {
+	if x != nil {
+		return x.InitiationHeight
+	}
+	return 0
+}
+
+func (x *SwapProof) GetHtlcTxid() string {
+	if x != nil {
+		return x.HtlcTxid
+	}
+	return ""
+}
+
+func (x *SwapProof) GetTransactions() []*SwapProofTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *SwapProof) GetCostServer() int64 {
+	if x != nil {
+		return x.CostServer
+	}
+	return 0
+}
+
+func (x *SwapProof) GetCostOnchain() int64 {
+	if x != nil {
+		return x.CostOnchain
+	}
+	return 0
+}
+
+func (x *SwapProof) GetCostOffchain() int64 {
+	if x != nil {
+		return x.CostOffchain
+	}
+	return 0
+}
+
+type SwapProofTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The txid of the transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	//
+	//The label that the transaction was published with.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//The raw serialized transaction, including witness data.
+	RawTx []byte `protobuf:"bytes,3,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+}
+
+func (x *SwapProofTransaction) Reset() {
+	*x = SwapProofTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapProofTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapProofTransaction) ProtoMessage() {}
+
+func (x *SwapProofTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapProofTransaction.ProtoReflect.Descriptor instead.
+func (*SwapProofTransaction) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SwapProofTransaction) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SwapProofTransaction) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SwapProofTransaction) GetRawTx() []byte {
+	if x != nil {
+		return x.RawTx
+	}
+	return nil
+}
+
+type PreviewSweepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the pending loop out to preview the sweep of.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PreviewSweepRequest) Reset() {
+	*x = PreviewSweepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewSweepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSweepRequest) ProtoMessage() {}
+
+func (x *PreviewSweepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSweepRequest.ProtoReflect.Descriptor instead.
+func (*PreviewSweepRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PreviewSweepRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type PreviewSweepResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The raw serialized sweep transaction, without witness data so that the
+	//preview does not reveal the preimage of the swap. This field is not set if
+	//the swap would not create a sweep at all, because the fee required
+	//exceeds the maximum miner fee of the swap.
+	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	//
+	//The txid of the sweep transaction.
+	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	//
+	//The inputs that the sweep transaction spends.
+	Inputs []*SweepInput `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	//
+	//The outputs of the sweep transaction.
+	Outputs []*SweepOutput `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	//
+	//The fee that the sweep transaction pays, expressed in satoshis.
+	FeeSat int64 `protobuf:"varint,5,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	//
+	//The fee that is required to confirm the sweep within the confirmation
+	//target, expressed in satoshis. This fee may exceed the maximum miner fee
+	//of the swap, in which case the fee paid is capped.
+	RequiredFeeSat int64 `protobuf:"varint,6,opt,name=required_fee_sat,json=requiredFeeSat,proto3" json:"required_fee_sat,omitempty"`
+	//
+	//The weight of the signed sweep transaction, in weight units.
+	Weight int64 `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	//
+	//The fee rate of the sweep transaction, expressed in satoshis per
+	//kilo-weight.
+	SatPerKw int64 `protobuf:"varint,8,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
+	//
+	//The confirmation target that the fee was estimated for.
+	ConfTarget int32 `protobuf:"varint,9,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//Whether the swap would publish the sweep transaction now.
+	Publish bool `protobuf:"varint,10,opt,name=publish,proto3" json:"publish,omitempty"`
+	//
+	//The reason that the swap would not publish the sweep transaction, if it
+	//would not.
+	Reason string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PreviewSweepResponse) Reset() {
+	*x = PreviewSweepResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewSweepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSweepResponse) ProtoMessage() {}
+
+func (x *PreviewSweepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSweepResponse.ProtoReflect.Descriptor instead.
+func (*PreviewSweepResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PreviewSweepResponse) GetRawTx() []byte {
+	if x != nil {
+		return x.RawTx
+	}
+	return nil
+}
+
+func (x *PreviewSweepResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *PreviewSweepResponse) GetInputs() []*SweepInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *PreviewSweepResponse) GetOutputs() []*SweepOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *PreviewSweepResponse) GetFeeSat() int64 {
+	if x != nil {
+		return x.FeeSat
+	}
+	return 0
+}
+
+func (x *PreviewSweepResponse) GetRequiredFeeSat() int64 {
+	if x != nil {
+		return x.RequiredFeeSat
+	}
+	return 0
+}
+
+func (x *PreviewSweepResponse) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PreviewSweepResponse) GetSatPerKw() int64 {
+	if x != nil {
+		return x.SatPerKw
+	}
+	return 0
+}
+
+func (x *PreviewSweepResponse) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *PreviewSweepResponse) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+func (x *PreviewSweepResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SweepInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The outpoint that the input spends, formatted as txid:index.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The value of the output that the input spends, expressed in satoshis.
+	ValueSat int64 `protobuf:"varint,2,opt,name=value_sat,json=valueSat,proto3" json:"value_sat,omitempty"`
+}
+
+func (x *SweepInput) Reset() {
+	*x = SweepInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepInput) ProtoMessage() {}
+
+func (x *SweepInput) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepInput.ProtoReflect.Descriptor instead.
+func (*SweepInput) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SweepInput) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *SweepInput) GetValueSat() int64 {
+	if x != nil {
+		return x.ValueSat
+	}
+	return 0
+}
+
+type SweepOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The address that the output pays to, if its script is a standard script.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//
+	//The value of the output, expressed in satoshis.
+	ValueSat int64 `protobuf:"varint,2,opt,name=value_sat,json=valueSat,proto3" json:"value_sat,omitempty"`
+}
+
+func (x *SweepOutput) Reset() {
+	*x = SweepOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepOutput) ProtoMessage() {}
+
+func (x *SweepOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepOutput.ProtoReflect.Descriptor instead.
+func (*SweepOutput) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SweepOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SweepOutput) GetValueSat() int64 {
+	if x != nil {
+		return x.ValueSat
+	}
+	return 0
+}
+
+type ListLiquiditySnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds from which snapshots should be returned,
+	//inclusive. If this value is zero, snapshots are returned from the
+	//earliest snapshot recorded.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//The unix timestamp in seconds until which snapshots should be returned,
+	//inclusive. If this value is zero, snapshots are returned up until the
+	//latest snapshot recorded.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListLiquiditySnapshotsRequest) Reset() {
+	*x = ListLiquiditySnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLiquiditySnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiquiditySnapshotsRequest) ProtoMessage() {}
+
+func (x *ListLiquiditySnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiquiditySnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListLiquiditySnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListLiquiditySnapshotsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListLiquiditySnapshotsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type ListLiquiditySnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The snapshots recorded within the requested time range, in chronological
+	//order.
+	Snapshots []*LiquiditySnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListLiquiditySnapshotsResponse) Reset() {
+	*x = ListLiquiditySnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLiquiditySnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiquiditySnapshotsResponse) ProtoMessage() {}
+
+func (x *ListLiquiditySnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiquiditySnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListLiquiditySnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListLiquiditySnapshotsResponse) GetSnapshots() []*LiquiditySnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type LiquiditySnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds at which the snapshot was recorded.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	//
+	//The node's confirmed on chain wallet balance.
+	ConfirmedBalanceSat uint64 `protobuf:"varint,2,opt,name=confirmed_balance_sat,json=confirmedBalanceSat,proto3" json:"confirmed_balance_sat,omitempty"`
+	//
+	//The total local balance across all of the node's channels.
+	LocalBalanceSat uint64 `protobuf:"varint,3,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	//
+	//The total remote balance across all of the node's channels.
+	RemoteBalanceSat uint64 `protobuf:"varint,4,opt,name=remote_balance_sat,json=remoteBalanceSat,proto3" json:"remote_balance_sat,omitempty"`
+	//
+	//The balances of each of the node's channels.
+	Channels []*ChannelBalanceSnapshot `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *LiquiditySnapshot) Reset() {
+	*x = LiquiditySnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquiditySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquiditySnapshot) ProtoMessage() {}
+
+func (x *LiquiditySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquiditySnapshot.ProtoReflect.Descriptor instead.
+func (*LiquiditySnapshot) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LiquiditySnapshot) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LiquiditySnapshot) GetConfirmedBalanceSat() uint64 {
+	if x != nil {
+		return x.ConfirmedBalanceSat
+	}
+	return 0
+}
+
+func (x *LiquiditySnapshot) GetLocalBalanceSat() uint64 {
+	if x != nil {
+		return x.LocalBalanceSat
+	}
+	return 0
+}
+
+func (x *LiquiditySnapshot) GetRemoteBalanceSat() uint64 {
+	if x != nil {
+		return x.RemoteBalanceSat
+	}
+	return 0
+}
+
+func (x *LiquiditySnapshot) GetChannels() []*ChannelBalanceSnapshot {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ListFeeRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds from which records should be returned,
+	//inclusive. If this value is zero, records are returned from the earliest
+	//record.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//The unix timestamp in seconds until which records should be returned,
+	//inclusive. If this value is zero, records are returned up until the latest
+	//record.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListFeeRecordsRequest) Reset() {
+	*x = ListFeeRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeeRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRecordsRequest) ProtoMessage() {}
+
+func (x *ListFeeRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeeRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListFeeRecordsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListFeeRecordsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type ListFeeRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The records made within the requested time range, in chronological order.
+	Records []*FeeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ListFeeRecordsResponse) Reset() {
+	*x = ListFeeRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeeRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeRecordsResponse) ProtoMessage() {}
+
+func (x *ListFeeRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeeRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListFeeRecordsResponse) GetRecords() []*FeeRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type FeeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds at which the decision was made.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	//
+	//The decision that was made.
+	Decision FeeDecision `protobuf:"varint,2,opt,name=decision,proto3,enum=looprpc.FeeDecision" json:"decision,omitempty"`
+	//
+	//The swap hash of the swap that the decision was made for. This field is
+	//not set for suggestions.
+	Id []byte `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The confirmation target that the fee was estimated for.
+	ConfTarget int32 `protobuf:"varint,4,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//The fee rate that was estimated, expressed in satoshis per kilo-weight.
+	SatPerKw int64 `protobuf:"varint,5,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
+	//
+	//The fee that the transaction would pay at the estimated fee rate,
+	//expressed in satoshis. This field is not set for suggestions.
+	FeeSat int64 `protobuf:"varint,6,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	//
+	//Whether the fee limits allowed the decision to go ahead, which means that
+	//swaps were suggested or the transaction was published.
+	Allowed bool `protobuf:"varint,7,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	//
+	//The fee rate that the confirmed transaction paid, expressed in satoshis
+	//per kilo-weight. This field is zero until the transaction confirms, and is
+	//never set for suggestions.
+	RealizedSatPerKw int64 `protobuf:"varint,8,opt,name=realized_sat_per_kw,json=realizedSatPerKw,proto3" json:"realized_sat_per_kw,omitempty"`
+}
+
+func (x *FeeRecord) Reset() {
+	*x = FeeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRecord) ProtoMessage() {}
+
+func (x *FeeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRecord.ProtoReflect.Descriptor instead.
+func (*FeeRecord) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FeeRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FeeRecord) GetDecision() FeeDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return FeeDecision_FEE_DECISION_SUGGESTION
+}
+
+func (x *FeeRecord) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *FeeRecord) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *FeeRecord) GetSatPerKw() int64 {
+	if x != nil {
+		return x.SatPerKw
+	}
+	return 0
+}
+
+func (x *FeeRecord) GetFeeSat() int64 {
+	if x != nil {
+		return x.FeeSat
+	}
+	return 0
+}
+
+func (x *FeeRecord) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *FeeRecord) GetRealizedSatPerKw() int64 {
+	if x != nil {
+		return x.RealizedSatPerKw
+	}
+	return 0
+}
+
+type ListDispatchRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds from which records should be returned,
+	//inclusive. If this value is zero, records are returned from the earliest
+	//record.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//The unix timestamp in seconds until which records should be returned,
+	//inclusive. If this value is zero, records are returned up until the latest
+	//record.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	//
+	//An optional swap hash. If set, only the record of this swap is returned.
+	Id []byte `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListDispatchRecordsRequest) Reset() {
+	*x = ListDispatchRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDispatchRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDispatchRecordsRequest) ProtoMessage() {}
+
+func (x *ListDispatchRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDispatchRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListDispatchRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListDispatchRecordsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListDispatchRecordsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListDispatchRecordsRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type ListDispatchRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The records made within the requested time range, in chronological order.
+	Records []*DispatchRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ListDispatchRecordsResponse) Reset() {
+	*x = ListDispatchRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDispatchRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDispatchRecordsResponse) ProtoMessage() {}
+
+func (x *ListDispatchRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDispatchRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListDispatchRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListDispatchRecordsResponse) GetRecords() []*DispatchRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type DispatchRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds at which the swap was dispatched.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	//
+	//The swap hash of the swap that was dispatched.
+	Id []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The way in which the swap was dispatched.
+	Source DispatchSource `protobuf:"varint,3,opt,name=source,proto3,enum=looprpc.DispatchSource" json:"source,omitempty"`
+	//
+	//The liquidity rules that the swap was suggested for, as they were set when
+	//the swap was dispatched.
+	Rules []*LiquidityRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	//
+	//The suggested swap amount, expressed in satoshis.
+	Amt uint64 `protobuf:"varint,5,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The suggested swap fee limit, expressed in satoshis.
+	MaxSwapFee uint64 `protobuf:"varint,6,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//
+	//The suggested miner fee limit, expressed in satoshis.
+	MaxMinerFee uint64 `protobuf:"varint,7,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//
+	//The suggested limit for the routing fees of the swap payment, expressed in
+	//satoshis.
+	MaxSwapRoutingFee uint64 `protobuf:"varint,8,opt,name=max_swap_routing_fee,json=maxSwapRoutingFee,proto3" json:"max_swap_routing_fee,omitempty"`
+	//
+	//The suggested limit for the routing fees of the prepay payment, expressed
+	//in satoshis.
+	MaxPrepayRoutingFee uint64 `protobuf:"varint,9,opt,name=max_prepay_routing_fee,json=maxPrepayRoutingFee,proto3" json:"max_prepay_routing_fee,omitempty"`
+	//
+	//The suggested confirmation target for the sweep.
+	SweepConfTarget int32 `protobuf:"varint,10,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//
+	//The balances of the swap's channels when it was dispatched.
+	Channels []*ChannelBalanceSnapshot `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *DispatchRecord) Reset() {
+	*x = DispatchRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchRecord) ProtoMessage() {}
+
+func (x *DispatchRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchRecord.ProtoReflect.Descriptor instead.
+func (*DispatchRecord) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DispatchRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DispatchRecord) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DispatchRecord) GetSource() DispatchSource {
+	if x != nil {
+		return x.Source
+	}
+	return DispatchSource_DISPATCH_SOURCE_AUTOLOOP
+}
+
+func (x *DispatchRecord) GetRules() []*LiquidityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *DispatchRecord) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *DispatchRecord) GetMaxSwapFee() uint64 {
+	if x != nil {
+		return x.MaxSwapFee
+	}
+	return 0
+}
+
+func (x *DispatchRecord) GetMaxMinerFee() uint64 {
+	if x != nil {
+		return x.MaxMinerFee
+	}
+	return 0
+}
+
+func (x *DispatchRecord) GetMaxSwapRoutingFee() uint64 {
+	if x != nil {
+		return x.MaxSwapRoutingFee
+	}
+	return 0
+}
+
+func (x *DispatchRecord) GetMaxPrepayRoutingFee() uint64 {
+	if x != nil {
+		return x.MaxPrepayRoutingFee
+	}
+	return 0
+}
+
+func (x *DispatchRecord) GetSweepConfTarget() int32 {
+	if x != nil {
+		return x.SweepConfTarget
+	}
+	return 0
+}
+
+func (x *DispatchRecord) GetChannels() []*ChannelBalanceSnapshot {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ChannelBalanceSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel ID of the channel.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//
+	//The pubkey of the channel's peer.
+	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//The total capacity of the channel.
+	CapacitySat uint64 `protobuf:"varint,3,opt,name=capacity_sat,json=capacitySat,proto3" json:"capacity_sat,omitempty"`
+	//
+	//The local balance of the channel.
+	LocalBalanceSat uint64 `protobuf:"varint,4,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	//
+	//The remote balance of the channel.
+	RemoteBalanceSat uint64 `protobuf:"varint,5,opt,name=remote_balance_sat,json=remoteBalanceSat,proto3" json:"remote_balance_sat,omitempty"`
+}
+
+func (x *ChannelBalanceSnapshot) Reset() {
+	*x = ChannelBalanceSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelBalanceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelBalanceSnapshot) ProtoMessage() {}
+
+func (x *ChannelBalanceSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelBalanceSnapshot.ProtoReflect.Descriptor instead.
+func (*ChannelBalanceSnapshot) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ChannelBalanceSnapshot) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *ChannelBalanceSnapshot) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *ChannelBalanceSnapshot) GetCapacitySat() uint64 {
+	if x != nil {
+		return x.CapacitySat
+	}
+	return 0
+}
+
+func (x *ChannelBalanceSnapshot) GetLocalBalanceSat() uint64 {
+	if x != nil {
+		return x.LocalBalanceSat
+	}
+	return 0
+}
+
+func (x *ChannelBalanceSnapshot) GetRemoteBalanceSat() uint64 {
+	if x != nil {
+		return x.RemoteBalanceSat
+	}
+	return 0
+}
+
+type DebugLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If set, the subsystems that are available are returned and no levels are
+	//changed.
+	Show bool `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	//
+	//The log level to set for all subsystems, or a comma separated list of
+	//<subsystem>=<level> pairs to set the levels of individual subsystems.
+	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+}
+
+func (x *DebugLevelRequest) Reset() {
+	*x = DebugLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelRequest) ProtoMessage() {}
+
+func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DebugLevelRequest) GetShow() bool {
+	if x != nil {
+		return x.Show
+	}
+	return false
+}
+
+func (x *DebugLevelRequest) GetLevelSpec() string {
+	if x != nil {
+		return x.LevelSpec
+	}
+	return ""
+}
+
+type DebugLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//A space separated list of the subsystems that are available, if show was
+	//set in the request.
+	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+}
+
+func (x *DebugLevelResponse) Reset() {
+	*x = DebugLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelResponse) ProtoMessage() {}
+
+func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DebugLevelResponse) GetSubSystems() string {
+	if x != nil {
+		return x.SubSystems
+	}
+	return ""
+}
+
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRequest) ProtoMessage() {}
+
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{69}
+}
+
+type ReloadConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The names of the reloadable options that were changed by the reload.
+	ChangedOptions []string `protobuf:"bytes,1,rep,name=changed_options,json=changedOptions,proto3" json:"changed_options,omitempty"`
+}
+
+func (x *ReloadConfigResponse) Reset() {
+	*x = ReloadConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigResponse) ProtoMessage() {}
+
+func (x *ReloadConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReloadConfigResponse) GetChangedOptions() []string {
+	if x != nil {
+		return x.ChangedOptions
+	}
+	return nil
+}
+
+type BakeTenantMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BakeTenantMacaroonRequest) Reset() {
+	*x = BakeTenantMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeTenantMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeTenantMacaroonRequest) ProtoMessage() {}
+
+func (x *BakeTenantMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeTenantMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*BakeTenantMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{71}
+}
+
+type BakeTenantMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The hex encoded macaroon of the new tenant.
+	Macaroon string `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	//
+	//The identity of the macaroon, which the tenant's swaps are tagged with.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *BakeTenantMacaroonResponse) Reset() {
+	*x = BakeTenantMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeTenantMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeTenantMacaroonResponse) ProtoMessage() {}
+
+func (x *BakeTenantMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeTenantMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*BakeTenantMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BakeTenantMacaroonResponse) GetMacaroon() string {
+	if x != nil {
+		return x.Macaroon
+	}
+	return ""
+}
+
+func (x *BakeTenantMacaroonResponse) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type SnapshotMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SnapshotMissionControlRequest) Reset() {
+	*x = SnapshotMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotMissionControlRequest) ProtoMessage() {}
+
+func (x *SnapshotMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{73}
+}
+
+type MissionControlSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds at which the snapshot was taken.
+	SnapshotTime int64 `protobuf:"varint,1,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
+	//
+	//The number of node pairs that lnd's mission control held results for
+	//when the snapshot was taken.
+	Pairs uint32 `protobuf:"varint,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *MissionControlSnapshot) Reset() {
+	*x = MissionControlSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissionControlSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionControlSnapshot) ProtoMessage() {}
+
+func (x *MissionControlSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionControlSnapshot.ProtoReflect.Descriptor instead.
+func (*MissionControlSnapshot) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MissionControlSnapshot) GetSnapshotTime() int64 {
+	if x != nil {
+		return x.SnapshotTime
+	}
+	return 0
+}
+
+func (x *MissionControlSnapshot) GetPairs() uint32 {
+	if x != nil {
+		return x.Pairs
+	}
+	return 0
+}
+
+type ResetMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetMissionControlRequest) Reset() {
+	*x = ResetMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetMissionControlRequest) ProtoMessage() {}
+
+func (x *ResetMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*ResetMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{75}
+}
+
+type ResetMissionControlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetMissionControlResponse) Reset() {
+	*x = ResetMissionControlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetMissionControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetMissionControlResponse) ProtoMessage() {}
+
+func (x *ResetMissionControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*ResetMissionControlResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{76}
+}
+
+type RestoreMissionControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreMissionControlRequest) Reset() {
+	*x = RestoreMissionControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreMissionControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMissionControlRequest) ProtoMessage() {}
+
+func (x *RestoreMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{77}
+}
+
+type CaptureProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The profiles to capture. If no profiles are set, all profiles are
+	//captured.
+	ProfileTypes []ProfileType `protobuf:"varint,1,rep,packed,name=profile_types,json=profileTypes,proto3,enum=looprpc.ProfileType" json:"profile_types,omitempty"`
+}
+
+func (x *CaptureProfileRequest) Reset() {
+	*x = CaptureProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureProfileRequest) ProtoMessage() {}
+
+func (x *CaptureProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureProfileRequest.ProtoReflect.Descriptor instead.
+func (*CaptureProfileRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CaptureProfileRequest) GetProfileTypes() []ProfileType {
+	if x != nil {
+		return x.ProfileTypes
+	}
+	return nil
+}
+
+type CaptureProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The paths of the files that the captured profiles were written to.
+	Files []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *CaptureProfileResponse) Reset() {
+	*x = CaptureProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureProfileResponse) ProtoMessage() {}
+
+func (x *CaptureProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureProfileResponse.ProtoReflect.Descriptor instead.
+func (*CaptureProfileResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CaptureProfileResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type SwapReservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap identifier, which is used to confirm the reservation. Currently
+	//this is the hash that locks the htlcs.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of the reserved swap.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The amount of the swap in satoshis.
+	Amt int64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The final fee charged by the server for the swap, in satoshis. For loop
+	//out swaps this includes the prepay amount.
+	SwapFeeSat int64 `protobuf:"varint,4,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//The amount of the prepay invoice that is paid to the server when the
+	//swap is executed, in satoshis. This field remains empty for loop in.
+	PrepayAmtSat int64 `protobuf:"varint,5,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
+	//
+	//The maximum on-chain fee that will be paid for the swap, in satoshis.
+	MaxMinerFee int64 `protobuf:"varint,6,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//
+	//The expiry height of the on-chain htlc.
+	CltvExpiry int32 `protobuf:"varint,7,opt,name=cltv_expiry,json=cltvExpiry,proto3" json:"cltv_expiry,omitempty"`
+	//
+	//The native segwit address of the on-chain htlc.
+	HtlcAddressP2Wsh string `protobuf:"bytes,8,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
+	//
+	//The nested segwit address of the on-chain htlc. This field is only set
+	//for loop in swaps with an external htlc.
+	HtlcAddressNp2Wsh string `protobuf:"bytes,9,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
+	//
+	//The unix timestamp in seconds after which the reservation can no longer
+	//be confirmed.
+	ExpiryUnix int64 `protobuf:"varint,10,opt,name=expiry_unix,json=expiryUnix,proto3" json:"expiry_unix,omitempty"`
+	// A human-readable message received from the loop server.
+	ServerMessage string `protobuf:"bytes,11,opt,name=server_message,json=serverMessage,proto3" json:"server_message,omitempty"`
+	//
+	//A structured message received from the loop server, which contains a code
+	//and set of parameters which clients may use to localize or act on the
+	//server's advisory.
+	StructuredServerMessage *StructuredServerMessage `protobuf:"bytes,12,opt,name=structured_server_message,json=structuredServerMessage,proto3" json:"structured_server_message,omitempty"`
+	//
+	//Set if the swap's amount exceeds the daemon's approval threshold, in
+	//which case it must be executed with ApproveSwap rather than
+	//ConfirmReservation.
+	RequiresApproval bool `protobuf:"varint,13,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	//
+	//The addresses of the on-chain htlc for each output script type that the
+	//swap supports, with the address that the htlc is published to flagged as
+	//primary.
+	HtlcAddresses *HtlcAddresses `protobuf:"bytes,14,opt,name=htlc_addresses,json=htlcAddresses,proto3" json:"htlc_addresses,omitempty"`
+	//
+	//The identity of the macaroon that requested the swap, if it requires
+	//approval. The swap may not be approved with the same macaroon.
+	RequestedBy string `protobuf:"bytes,15,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+}
+
+func (x *SwapReservation) Reset() {
+	*x = SwapReservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapReservation) ProtoMessage() {}
+
+func (x *SwapReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapReservation.ProtoReflect.Descriptor instead.
+func (*SwapReservation) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SwapReservation) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SwapReservation) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapReservation) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SwapReservation) GetSwapFeeSat() int64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *SwapReservation) GetPrepayAmtSat() int64 {
+	if x != nil {
+		return x.PrepayAmtSat
+	}
+	return 0
+}
+
+func (x *SwapReservation) GetMaxMinerFee() int64 {
+	if x != nil {
+		return x.MaxMinerFee
+	}
+	return 0
+}
+
+func (x *SwapReservation) GetCltvExpiry() int32 {
+	if x != nil {
+		return x.CltvExpiry
+	}
+	return 0
+}
+
+func (x *SwapReservation) GetHtlcAddressP2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressP2Wsh
+	}
+	return ""
+}
+
+func (x *SwapReservation) GetHtlcAddressNp2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressNp2Wsh
+	}
+	return ""
+}
+
+func (x *SwapReservation) GetExpiryUnix() int64 {
+	if x != nil {
+		return x.ExpiryUnix
+	}
+	return 0
+}
+
+func (x *SwapReservation) GetServerMessage() string {
+	if x != nil {
+		return x.ServerMessage
+	}
+	return ""
+}
+
+func (x *SwapReservation) GetStructuredServerMessage() *StructuredServerMessage {
+	if x != nil {
+		return x.StructuredServerMessage
+	}
+	return nil
+}
+
+func (x *SwapReservation) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *SwapReservation) GetHtlcAddresses() *HtlcAddresses {
+	if x != nil {
+		return x.HtlcAddresses
+	}
+	return nil
+}
+
+func (x *SwapReservation) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type ConfirmReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The identifier of the reserved swap that should be executed.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ConfirmReservationRequest) Reset() {
+	*x = ConfirmReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReservationRequest) ProtoMessage() {}
+
+func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ConfirmReservationRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type ListPendingApprovalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPendingApprovalsRequest) Reset() {
+	*x = ListPendingApprovalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingApprovalsRequest) ProtoMessage() {}
+
+func (x *ListPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{82}
+}
+
+type ListPendingApprovalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swaps that are awaiting approval, ordered by their expiry.
+	Approvals []*SwapReservation `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (x *ListPendingApprovalsResponse) Reset() {
+	*x = ListPendingApprovalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingApprovalsResponse) ProtoMessage() {}
+
+func (x *ListPendingApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListPendingApprovalsResponse) GetApprovals() []*SwapReservation {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type ApproveSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The identifier of the swap that should be approved.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ApproveSwapRequest) Reset() {
+	*x = ApproveSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSwapRequest) ProtoMessage() {}
+
+func (x *ApproveSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSwapRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSwapRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ApproveSwapRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type DenySwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The identifier of the swap that should be denied.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The reason that the swap is denied, which is recorded with the denial.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DenySwapRequest) Reset() {
+	*x = DenySwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenySwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenySwapRequest) ProtoMessage() {}
+
+func (x *DenySwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenySwapRequest.ProtoReflect.Descriptor instead.
+func (*DenySwapRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DenySwapRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DenySwapRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DenySwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DenySwapResponse) Reset() {
+	*x = DenySwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenySwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenySwapResponse) ProtoMessage() {}
+
+func (x *DenySwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenySwapResponse.ProtoReflect.Descriptor instead.
+func (*DenySwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{86}
+}
+
+type ListSwapDenialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSwapDenialsRequest) Reset() {
+	*x = ListSwapDenialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapDenialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapDenialsRequest) ProtoMessage() {}
+
+func (x *ListSwapDenialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapDenialsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapDenialsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{87}
+}
+
+type ListSwapDenialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swaps that were denied or expired, in chronological order.
+	Denials []*SwapDenial `protobuf:"bytes,1,rep,name=denials,proto3" json:"denials,omitempty"`
+}
+
+func (x *ListSwapDenialsResponse) Reset() {
+	*x = ListSwapDenialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapDenialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapDenialsResponse) ProtoMessage() {}
+
+func (x *ListSwapDenialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapDenialsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapDenialsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListSwapDenialsResponse) GetDenials() []*SwapDenial {
+	if x != nil {
+		return x.Denials
+	}
+	return nil
+}
+
+type SwapDenial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The identifier of the swap.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The amount of the swap in sat.
+	Amt int64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The identity of the macaroon that requested the swap.
+	RequestedBy string `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	//
+	//The identity of the macaroon that denied the swap. This is not set if the
+	//swap expired.
+	DeniedBy string `protobuf:"bytes,5,opt,name=denied_by,json=deniedBy,proto3" json:"denied_by,omitempty"`
+	//
+	//Set if the swap was not approved before its approval expired, rather than
+	//being denied.
+	Expired bool `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	//
+	//The reason that the swap was denied, if one was given.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	//
+	//The time at which the swap was denied or expired in unix nano.
+	DenialTime int64 `protobuf:"varint,8,opt,name=denial_time,json=denialTime,proto3" json:"denial_time,omitempty"`
+}
+
+func (x *SwapDenial) Reset() {
+	*x = SwapDenial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapDenial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapDenial) ProtoMessage() {}
+
+func (x *SwapDenial) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapDenial.ProtoReflect.Descriptor instead.
+func (*SwapDenial) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SwapDenial) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SwapDenial) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapDenial) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SwapDenial) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *SwapDenial) GetDeniedBy() string {
+	if x != nil {
+		return x.DeniedBy
+	}
+	return ""
+}
+
+func (x *SwapDenial) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *SwapDenial) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SwapDenial) GetDenialTime() int64 {
+	if x != nil {
+		return x.DenialTime
+	}
+	return 0
+}
+
+type SwapTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unique name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The type of swap that the template dispatches.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The amount of the swap in satoshis. Must not be set if the template sets a
+	//target local balance.
+	Amt int64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The address that loop out swaps sweep to. If empty, swaps sweep to the
+	//backing lnd's wallet.
+	Dest string `protobuf:"bytes,4,opt,name=dest,proto3" json:"dest,omitempty"`
+	//
+	//The maximum swap server fee in satoshis. Fee limits that are not set are
+	//obtained from a quote when the template is dispatched.
+	MaxSwapFee int64 `protobuf:"varint,5,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//
+	//The maximum on-chain fee in satoshis.
+	MaxMinerFee int64 `protobuf:"varint,6,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//
+	//The maximum prepay amount in satoshis for loop out swaps.
+	MaxPrepayAmt int64 `protobuf:"varint,7,opt,name=max_prepay_amt,json=maxPrepayAmt,proto3" json:"max_prepay_amt,omitempty"`
+	//
+	//The maximum off-chain routing fee in satoshis for the swap payment of loop
+	//out swaps.
+	MaxSwapRoutingFee int64 `protobuf:"varint,8,opt,name=max_swap_routing_fee,json=maxSwapRoutingFee,proto3" json:"max_swap_routing_fee,omitempty"`
+	//
+	//The maximum off-chain routing fee in satoshis for the prepay payment of
+	//loop out swaps.
+	MaxPrepayRoutingFee int64 `protobuf:"varint,9,opt,name=max_prepay_routing_fee,json=maxPrepayRoutingFee,proto3" json:"max_prepay_routing_fee,omitempty"`
+	//
+	//The sweep confirmation target for loop out swaps, or the htlc
+	//confirmation target for loop in swaps. The default is used if not set.
+	ConfTarget int32 `protobuf:"varint,10,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//The number of confirmations required for the server's htlc in loop out
+	//swaps. The default is used if not set.
+	HtlcConfirmations int32 `protobuf:"varint,11,opt,name=htlc_confirmations,json=htlcConfirmations,proto3" json:"htlc_confirmations,omitempty"`
+	//
+	//Set if loop out swaps should not allow the server to delay publishing the
+	//htlc in exchange for a lower swap fee.
+	Fast bool `protobuf:"varint,12,opt,name=fast,proto3" json:"fast,omitempty"`
+	//
+	//The channels that loop out swaps may be paid over. Any channel may be used
+	//if not set.
+	OutgoingChanSet []uint64 `protobuf:"varint,13,rep,packed,name=outgoing_chan_set,json=outgoingChanSet,proto3" json:"outgoing_chan_set,omitempty"`
+	//
+	//The optional last hop that loop in swaps are paid via.
+	LastHop []byte `protobuf:"bytes,14,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//Set if the htlc of loop in swaps is published by an external wallet.
+	ExternalHtlc bool `protobuf:"varint,15,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
+	//
+	//The label that swaps are created with.
+	Label string `protobuf:"bytes,16,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//The percentage of the balance of the template's channels that should be
+	//local after a swap. If set, the amount of each swap is calculated from our
+	//channel balances when the template is dispatched. Loop out templates use
+	//the channels in their outgoing channel set, and loop in templates use the
+	//channels with their last hop, or all active channels if these are not set.
+	TargetLocalPercent uint32 `protobuf:"varint,17,opt,name=target_local_percent,json=targetLocalPercent,proto3" json:"target_local_percent,omitempty"`
+}
+
+func (x *SwapTemplate) Reset() {
+	*x = SwapTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapTemplate) ProtoMessage() {}
+
+func (x *SwapTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapTemplate.ProtoReflect.Descriptor instead.
+func (*SwapTemplate) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SwapTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SwapTemplate) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapTemplate) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *SwapTemplate) GetMaxSwapFee() int64 {
+	if x != nil {
+		return x.MaxSwapFee
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetMaxMinerFee() int64 {
+	if x != nil {
+		return x.MaxMinerFee
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetMaxPrepayAmt() int64 {
+	if x != nil {
+		return x.MaxPrepayAmt
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetMaxSwapRoutingFee() int64 {
+	if x != nil {
+		return x.MaxSwapRoutingFee
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetMaxPrepayRoutingFee() int64 {
+	if x != nil {
+		return x.MaxPrepayRoutingFee
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetHtlcConfirmations() int32 {
+	if x != nil {
+		return x.HtlcConfirmations
+	}
+	return 0
+}
+
+func (x *SwapTemplate) GetFast() bool {
+	if x != nil {
+		return x.Fast
+	}
+	return false
+}
+
+func (x *SwapTemplate) GetOutgoingChanSet() []uint64 {
+	if x != nil {
+		return x.OutgoingChanSet
+	}
+	return nil
+}
+
+func (x *SwapTemplate) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *SwapTemplate) GetExternalHtlc() bool {
+	if x != nil {
+		return x.ExternalHtlc
+	}
+	return false
+}
+
+func (x *SwapTemplate) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SwapTemplate) GetTargetLocalPercent() uint32 {
+	if x != nil {
+		return x.TargetLocalPercent
+	}
+	return 0
+}
+
+type SetSwapTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The template to store.
+	Template *SwapTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *SetSwapTemplateRequest) Reset() {
+	*x = SetSwapTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSwapTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSwapTemplateRequest) ProtoMessage() {}
+
+func (x *SetSwapTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSwapTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetSwapTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SetSwapTemplateRequest) GetTemplate() *SwapTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SetSwapTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetSwapTemplateResponse) Reset() {
+	*x = SetSwapTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSwapTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSwapTemplateResponse) ProtoMessage() {}
+
+func (x *SetSwapTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSwapTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SetSwapTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{92}
+}
+
+type ListSwapTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSwapTemplatesRequest) Reset() {
+	*x = ListSwapTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapTemplatesRequest) ProtoMessage() {}
+
+func (x *ListSwapTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{93}
+}
+
+type ListSwapTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The stored swap templates, ordered by name.
+	Templates []*SwapTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListSwapTemplatesResponse) Reset() {
+	*x = ListSwapTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapTemplatesResponse) ProtoMessage() {}
+
+func (x *ListSwapTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListSwapTemplatesResponse) GetTemplates() []*SwapTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteSwapTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the template to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteSwapTemplateRequest) Reset() {
+	*x = DeleteSwapTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSwapTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSwapTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteSwapTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSwapTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSwapTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteSwapTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteSwapTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSwapTemplateResponse) Reset() {
+	*x = DeleteSwapTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSwapTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSwapTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteSwapTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSwapTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSwapTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{96}
+}
+
+type ResolveSwapTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the template to resolve.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ResolveSwapTemplateRequest) Reset() {
+	*x = ResolveSwapTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveSwapTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSwapTemplateRequest) ProtoMessage() {}
+
+func (x *ResolveSwapTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSwapTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ResolveSwapTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ResolveSwapTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResolveSwapTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The template, with its amount set to the amount that the swap should be
+	//dispatched with.
+	Template *SwapTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *ResolveSwapTemplateResponse) Reset() {
+	*x = ResolveSwapTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveSwapTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSwapTemplateResponse) ProtoMessage() {}
+
+func (x *ResolveSwapTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSwapTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ResolveSwapTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ResolveSwapTemplateResponse) GetTemplate() *SwapTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type FeeBudget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The kind of name that the budget applies to.
+	Namespace BudgetNamespace `protobuf:"varint,1,opt,name=namespace,proto3,enum=looprpc.BudgetNamespace" json:"namespace,omitempty"`
+	//
+	//The tenant or label that the budget applies to.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The maximum total fees in satoshis that the swaps may spend.
+	BudgetSat int64 `protobuf:"varint,3,opt,name=budget_sat,json=budgetSat,proto3" json:"budget_sat,omitempty"`
+	//
+	//The rolling period in seconds that the budget is enforced over. If it is
+	//zero, all swaps count towards the budget.
+	PeriodSec uint64 `protobuf:"varint,4,opt,name=period_sec,json=periodSec,proto3" json:"period_sec,omitempty"`
+}
+
+func (x *FeeBudget) Reset() {
+	*x = FeeBudget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBudget) ProtoMessage() {}
+
+func (x *FeeBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBudget.ProtoReflect.Descriptor instead.
+func (*FeeBudget) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FeeBudget) GetNamespace() BudgetNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return BudgetNamespace_BUDGET_NAMESPACE_TENANT
+}
+
+func (x *FeeBudget) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeeBudget) GetBudgetSat() int64 {
+	if x != nil {
+		return x.BudgetSat
+	}
+	return 0
+}
+
+func (x *FeeBudget) GetPeriodSec() uint64 {
+	if x != nil {
+		return x.PeriodSec
+	}
+	return 0
+}
+
+type SetFeeBudgetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The budget to set.
+	Budget *FeeBudget `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (x *SetFeeBudgetRequest) Reset() {
+	*x = SetFeeBudgetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFeeBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeBudgetRequest) ProtoMessage() {}
+
+func (x *SetFeeBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetFeeBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SetFeeBudgetRequest) GetBudget() *FeeBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type SetFeeBudgetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetFeeBudgetResponse) Reset() {
+	*x = SetFeeBudgetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFeeBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeBudgetResponse) ProtoMessage() {}
+
+func (x *SetFeeBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetFeeBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{101}
+}
+
+type ListFeeBudgetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFeeBudgetsRequest) Reset() {
+	*x = ListFeeBudgetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeeBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeBudgetsRequest) ProtoMessage() {}
+
+func (x *ListFeeBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeeBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{102}
+}
+
+type FeeBudgetStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The budget.
+	Budget *FeeBudget `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	//
+	//The fees in satoshis that have been spent from the budget within its
+	//period. Completed swaps count with their actual cost, and pending swaps
+	//with the maximum fees that they were initiated with.
+	SpentSat int64 `protobuf:"varint,2,opt,name=spent_sat,json=spentSat,proto3" json:"spent_sat,omitempty"`
+	//
+	//The fees in satoshis that remain in the budget.
+	RemainingSat int64 `protobuf:"varint,3,opt,name=remaining_sat,json=remainingSat,proto3" json:"remaining_sat,omitempty"`
+}
+
+func (x *FeeBudgetStatus) Reset() {
+	*x = FeeBudgetStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBudgetStatus) ProtoMessage() {}
+
+func (x *FeeBudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBudgetStatus.ProtoReflect.Descriptor instead.
+func (*FeeBudgetStatus) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *FeeBudgetStatus) GetBudget() *FeeBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *FeeBudgetStatus) GetSpentSat() int64 {
+	if x != nil {
+		return x.SpentSat
+	}
+	return 0
+}
+
+func (x *FeeBudgetStatus) GetRemainingSat() int64 {
+	if x != nil {
+		return x.RemainingSat
+	}
+	return 0
+}
+
+type ListFeeBudgetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The fee budgets, ordered by namespace and name.
+	Budgets []*FeeBudgetStatus `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+}
+
+func (x *ListFeeBudgetsResponse) Reset() {
+	*x = ListFeeBudgetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeeBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeBudgetsResponse) ProtoMessage() {}
+
+func (x *ListFeeBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeeBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListFeeBudgetsResponse) GetBudgets() []*FeeBudgetStatus {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type DeleteFeeBudgetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The kind of name that the budget applies to.
+	Namespace BudgetNamespace `protobuf:"varint,1,opt,name=namespace,proto3,enum=looprpc.BudgetNamespace" json:"namespace,omitempty"`
+	//
+	//The tenant or label of the budget to delete.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteFeeBudgetRequest) Reset() {
+	*x = DeleteFeeBudgetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFeeBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeeBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteFeeBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeeBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeeBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteFeeBudgetRequest) GetNamespace() BudgetNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return BudgetNamespace_BUDGET_NAMESPACE_TENANT
+}
+
+func (x *DeleteFeeBudgetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteFeeBudgetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFeeBudgetResponse) Reset() {
+	*x = DeleteFeeBudgetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFeeBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeeBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteFeeBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeeBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeeBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{106}
+}
+
+type CostStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The kind of name that costs are aggregated by.
+	GroupBy BudgetNamespace `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=looprpc.BudgetNamespace" json:"group_by,omitempty"`
+	//
+	//The unix timestamp in seconds from which swaps are included, based on
+	//their initiation time. If it is zero, all swaps up to the end time are
+	//included.
+	StartTime uint64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//The unix timestamp in seconds up to which swaps are included. If it is
+	//zero, all swaps from the start time are included.
+	EndTime uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *CostStatementRequest) Reset() {
+	*x = CostStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CostStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostStatementRequest) ProtoMessage() {}
+
+func (x *CostStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostStatementRequest.ProtoReflect.Descriptor instead.
+func (*CostStatementRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CostStatementRequest) GetGroupBy() BudgetNamespace {
+	if x != nil {
+		return x.GroupBy
+	}
+	return BudgetNamespace_BUDGET_NAMESPACE_TENANT
+}
+
+func (x *CostStatementRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CostStatementRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type NamespaceCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The tenant or label that the costs are aggregated for. Swaps without a
+	//tenant or label are aggregated under an empty name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The number of swaps that succeeded.
+	SwapsSucceeded uint32 `protobuf:"varint,2,opt,name=swaps_succeeded,json=swapsSucceeded,proto3" json:"swaps_succeeded,omitempty"`
+	//
+	//The number of swaps that failed.
+	SwapsFailed uint32 `protobuf:"varint,3,opt,name=swaps_failed,json=swapsFailed,proto3" json:"swaps_failed,omitempty"`
+	//
+	//The number of swaps that are still pending. Their costs are only included
+	//once they have completed.
+	SwapsPending uint32 `protobuf:"varint,4,opt,name=swaps_pending,json=swapsPending,proto3" json:"swaps_pending,omitempty"`
+	//
+	//The total amount in satoshis of the swaps that succeeded.
+	AmountSat int64 `protobuf:"varint,5,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	//
+	//The total fees in satoshis that were paid to the server.
+	CostServer int64 `protobuf:"varint,6,opt,name=cost_server,json=costServer,proto3" json:"cost_server,omitempty"`
+	//
+	//The total on-chain fees in satoshis.
+	CostOnchain int64 `protobuf:"varint,7,opt,name=cost_onchain,json=costOnchain,proto3" json:"cost_onchain,omitempty"`
+	//
+	//The total off-chain routing fees in satoshis.
+	CostOffchain int64 `protobuf:"varint,8,opt,name=cost_offchain,json=costOffchain,proto3" json:"cost_offchain,omitempty"`
+	//
+	//The total cost in satoshis of the swaps.
+	TotalCost int64 `protobuf:"varint,9,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+}
+
+func (x *NamespaceCost) Reset() {
+	*x = NamespaceCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceCost) ProtoMessage() {}
+
+func (x *NamespaceCost) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceCost.ProtoReflect.Descriptor instead.
+func (*NamespaceCost) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *NamespaceCost) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamespaceCost) GetSwapsSucceeded() uint32 {
+	if x != nil {
+		return x.SwapsSucceeded
+	}
+	return 0
+}
+
+func (x *NamespaceCost) GetSwapsFailed() uint32 {
+	if x != nil {
+		return x.SwapsFailed
+	}
+	return 0
+}
+
+func (x *NamespaceCost) GetSwapsPending() uint32 {
+	if x != nil {
+		return x.SwapsPending
+	}
+	return 0
+}
+
+func (x *NamespaceCost) GetAmountSat() int64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+func (x *NamespaceCost) GetCostServer() int64 {
+	if x != nil {
+		return x.CostServer
+	}
+	return 0
+}
+
+func (x *NamespaceCost) GetCostOnchain() int64 {
+	if x != nil {
+		return x.CostOnchain
+	}
+	return 0
+}
+
+func (x *NamespaceCost) GetCostOffchain() int64 {
+	if x != nil {
+		return x.CostOffchain
+	}
+	return 0
+}
+
+func (x *NamespaceCost) GetTotalCost() int64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+type CostStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The aggregated costs, ordered by name.
+	Costs []*NamespaceCost `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty"`
+}
+
+func (x *CostStatement) Reset() {
+	*x = CostStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CostStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostStatement) ProtoMessage() {}
+
+func (x *CostStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostStatement.ProtoReflect.Descriptor instead.
+func (*CostStatement) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CostStatement) GetCosts() []*NamespaceCost {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+type DrainChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel id of the channel to drain.
+	Channel uint64 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	//
+	//The address that the swaps are swept to. If not set, a new address from
+	//the backing lnd's wallet is used for each swap.
+	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	//
+	//The confirmation target for the sweeps of the swaps.
+	SweepConfTarget int32 `protobuf:"varint,3,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//
+	//The portion of each swap's amount that may be spent on fees, expressed in
+	//parts per million. If not set, 2% of the swap amount is allowed.
+	FeePpm uint64 `protobuf:"varint,4,opt,name=fee_ppm,json=feePpm,proto3" json:"fee_ppm,omitempty"`
+	//
+	//The local balance that is left in the channel. If not set, 1% of the
+	//channel's capacity is left, which is lnd's default channel reserve.
+	ReserveSat uint64 `protobuf:"varint,5,opt,name=reserve_sat,json=reserveSat,proto3" json:"reserve_sat,omitempty"`
+	//
+	//The maximum number of swaps to dispatch. If zero, swaps are dispatched
+	//until the channel is drained.
+	MaxSwaps uint32 `protobuf:"varint,6,opt,name=max_swaps,json=maxSwaps,proto3" json:"max_swaps,omitempty"`
+	//
+	//Whether to cooperatively close the channel once it is drained, if its
+	//local balance is below close_threshold_sat.
+	Close bool `protobuf:"varint,7,opt,name=close,proto3" json:"close,omitempty"`
+	//
+	//The local balance below which the channel is closed. Required if close is
+	//set.
+	CloseThresholdSat uint64 `protobuf:"varint,8,opt,name=close_threshold_sat,json=closeThresholdSat,proto3" json:"close_threshold_sat,omitempty"`
+	//
+	//The fee rate of the closing transaction in sat/vbyte. If not set, lnd
+	//estimates the fee rate.
+	CloseSatPerVbyte uint64 `protobuf:"varint,9,opt,name=close_sat_per_vbyte,json=closeSatPerVbyte,proto3" json:"close_sat_per_vbyte,omitempty"`
+	//
+	//An optional label for the swaps.
+	Label string `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *DrainChannelRequest) Reset() {
+	*x = DrainChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainChannelRequest) ProtoMessage() {}
+
+func (x *DrainChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainChannelRequest.ProtoReflect.Descriptor instead.
+func (*DrainChannelRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DrainChannelRequest) GetChannel() uint64 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *DrainChannelRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *DrainChannelRequest) GetSweepConfTarget() int32 {
+	if x != nil {
+		return x.SweepConfTarget
+	}
+	return 0
+}
+
+func (x *DrainChannelRequest) GetFeePpm() uint64 {
+	if x != nil {
+		return x.FeePpm
+	}
+	return 0
+}
+
+func (x *DrainChannelRequest) GetReserveSat() uint64 {
+	if x != nil {
+		return x.ReserveSat
+	}
+	return 0
+}
+
+func (x *DrainChannelRequest) GetMaxSwaps() uint32 {
+	if x != nil {
+		return x.MaxSwaps
+	}
+	return 0
+}
+
+func (x *DrainChannelRequest) GetClose() bool {
+	if x != nil {
+		return x.Close
+	}
+	return false
+}
+
+func (x *DrainChannelRequest) GetCloseThresholdSat() uint64 {
+	if x != nil {
+		return x.CloseThresholdSat
+	}
+	return 0
+}
+
+func (x *DrainChannelRequest) GetCloseSatPerVbyte() uint64 {
+	if x != nil {
+		return x.CloseSatPerVbyte
+	}
+	return 0
+}
+
+func (x *DrainChannelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type DrainUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state of the drain.
+	State DrainState `protobuf:"varint,1,opt,name=state,proto3,enum=looprpc.DrainState" json:"state,omitempty"`
+	//
+	//The local balance of the channel.
+	LocalBalanceSat uint64 `protobuf:"varint,2,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	//
+	//The swap that has just completed, if any.
+	Swap *SwapStatus `protobuf:"bytes,3,opt,name=swap,proto3" json:"swap,omitempty"`
+	//
+	//The number of swaps that have completed.
+	SwapsCompleted uint32 `protobuf:"varint,4,opt,name=swaps_completed,json=swapsCompleted,proto3" json:"swaps_completed,omitempty"`
+	//
+	//The txid of the channel's closing transaction, set if the state is
+	//DRAIN_CLOSING.
+	ClosingTxid string `protobuf:"bytes,5,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+}
+
+func (x *DrainUpdate) Reset() {
+	*x = DrainUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainUpdate) ProtoMessage() {}
+
+func (x *DrainUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainUpdate.ProtoReflect.Descriptor instead.
+func (*DrainUpdate) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DrainUpdate) GetState() DrainState {
+	if x != nil {
+		return x.State
+	}
+	return DrainState_DRAIN_SWAPPING
+}
+
+func (x *DrainUpdate) GetLocalBalanceSat() uint64 {
+	if x != nil {
+		return x.LocalBalanceSat
+	}
+	return 0
+}
+
+func (x *DrainUpdate) GetSwap() *SwapStatus {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *DrainUpdate) GetSwapsCompleted() uint32 {
+	if x != nil {
+		return x.SwapsCompleted
+	}
+	return 0
+}
+
+func (x *DrainUpdate) GetClosingTxid() string {
+	if x != nil {
+		return x.ClosingTxid
+	}
+	return ""
+}
+
+type FillChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel id of the channel to fill. Mutually exclusive with
+	//channel_point.
+	Channel uint64 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	//
+	//The funding outpoint of the channel to fill, in the format txid:index. If
+	//the channel is not yet open and active, it is waited for. Mutually
+	//exclusive with channel.
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	//
+	//The target local balance of the channel, expressed as a percentage of its
+	//capacity.
+	LocalPercent uint32 `protobuf:"varint,3,opt,name=local_percent,json=localPercent,proto3" json:"local_percent,omitempty"`
+	//
+	//The address that loop outs are swept to. If not set, a new address from
+	//the backing lnd's wallet is used for each swap.
+	Dest string `protobuf:"bytes,4,opt,name=dest,proto3" json:"dest,omitempty"`
+	//
+	//The confirmation target for the sweeps of loop outs.
+	SweepConfTarget int32 `protobuf:"varint,5,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//
+	//The confirmation target for the htlcs of loop ins.
+	HtlcConfTarget int32 `protobuf:"varint,6,opt,name=htlc_conf_target,json=htlcConfTarget,proto3" json:"htlc_conf_target,omitempty"`
+	//
+	//The portion of each swap's amount that may be spent on fees, expressed in
+	//parts per million. If not set, 2% of the swap amount is allowed.
+	FeePpm uint64 `protobuf:"varint,7,opt,name=fee_ppm,json=feePpm,proto3" json:"fee_ppm,omitempty"`
+	//
+	//The maximum number of swaps to dispatch. If zero, swaps are dispatched
+	//until the channel reaches its target balance.
+	MaxSwaps uint32 `protobuf:"varint,8,opt,name=max_swaps,json=maxSwaps,proto3" json:"max_swaps,omitempty"`
+	//
+	//An optional label for the swaps.
+	Label string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *FillChannelRequest) Reset() {
+	*x = FillChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillChannelRequest) ProtoMessage() {}
+
+func (x *FillChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillChannelRequest.ProtoReflect.Descriptor instead.
+func (*FillChannelRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *FillChannelRequest) GetChannel() uint64 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *FillChannelRequest) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *FillChannelRequest) GetLocalPercent() uint32 {
+	if x != nil {
+		return x.LocalPercent
+	}
+	return 0
+}
+
+func (x *FillChannelRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *FillChannelRequest) GetSweepConfTarget() int32 {
+	if x != nil {
+		return x.SweepConfTarget
+	}
+	return 0
+}
+
+func (x *FillChannelRequest) GetHtlcConfTarget() int32 {
+	if x != nil {
+		return x.HtlcConfTarget
+	}
+	return 0
+}
+
+func (x *FillChannelRequest) GetFeePpm() uint64 {
+	if x != nil {
+		return x.FeePpm
+	}
+	return 0
+}
+
+func (x *FillChannelRequest) GetMaxSwaps() uint32 {
+	if x != nil {
+		return x.MaxSwaps
+	}
+	return 0
+}
+
+func (x *FillChannelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type FillUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state of the fill.
+	State FillState `protobuf:"varint,1,opt,name=state,proto3,enum=looprpc.FillState" json:"state,omitempty"`
+	//
+	//The short channel id of the channel, set once it is open.
+	Channel uint64 `protobuf:"varint,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	//
+	//The local balance of the channel.
+	LocalBalanceSat uint64 `protobuf:"varint,3,opt,name=local_balance_sat,json=localBalanceSat,proto3" json:"local_balance_sat,omitempty"`
+	//
+	//The remote balance of the channel.
+	RemoteBalanceSat uint64 `protobuf:"varint,4,opt,name=remote_balance_sat,json=remoteBalanceSat,proto3" json:"remote_balance_sat,omitempty"`
+	//
+	//The swap that has just completed, if any.
+	Swap *SwapStatus `protobuf:"bytes,5,opt,name=swap,proto3" json:"swap,omitempty"`
+	//
+	//The number of swaps that have completed.
+	SwapsCompleted uint32 `protobuf:"varint,6,opt,name=swaps_completed,json=swapsCompleted,proto3" json:"swaps_completed,omitempty"`
+}
+
+func (x *FillUpdate) Reset() {
+	*x = FillUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillUpdate) ProtoMessage() {}
+
+func (x *FillUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillUpdate.ProtoReflect.Descriptor instead.
+func (*FillUpdate) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *FillUpdate) GetState() FillState {
+	if x != nil {
+		return x.State
+	}
+	return FillState_FILL_WAITING
+}
+
+func (x *FillUpdate) GetChannel() uint64 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *FillUpdate) GetLocalBalanceSat() uint64 {
+	if x != nil {
+		return x.LocalBalanceSat
+	}
+	return 0
+}
+
+func (x *FillUpdate) GetRemoteBalanceSat() uint64 {
+	if x != nil {
+		return x.RemoteBalanceSat
+	}
+	return 0
+}
+
+func (x *FillUpdate) GetSwap() *SwapStatus {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *FillUpdate) GetSwapsCompleted() uint32 {
+	if x != nil {
+		return x.SwapsCompleted
+	}
+	return 0
+}
+
+type CreateSwapPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The type of the plan's swaps.
+	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The total amount of the plan's swaps.
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The budget for the fees of all of the plan's swaps. Each swap may spend
+	//the same portion of its amount on fees.
+	MaxFeeSat int64 `protobuf:"varint,3,opt,name=max_fee_sat,json=maxFeeSat,proto3" json:"max_fee_sat,omitempty"`
+	//
+	//The channels that loop outs may be paid over. If not set, any channel may
+	//be used.
+	OutgoingChanSet []uint64 `protobuf:"varint,4,rep,packed,name=outgoing_chan_set,json=outgoingChanSet,proto3" json:"outgoing_chan_set,omitempty"`
+	//
+	//The pubkey of the last hop that loop ins are paid via.
+	LastHop []byte `protobuf:"bytes,5,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//The confirmation target for the sweeps of loop outs, or for the htlcs of
+	//loop ins.
+	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//The maximum number of swaps that the change may be split into. If not
+	//set, 10 swaps are allowed.
+	MaxSwaps uint32 `protobuf:"varint,7,opt,name=max_swaps,json=maxSwaps,proto3" json:"max_swaps,omitempty"`
+	//
+	//The number of seconds between the dispatch of consecutive swaps. Loop
+	//outs allow the server to delay the publication of their htlcs by up to
+	//this interval, so that they can be batched at a lower fee. If not set, an
+	//interval of one hour is used.
+	IntervalSec uint64 `protobuf:"varint,8,opt,name=interval_sec,json=intervalSec,proto3" json:"interval_sec,omitempty"`
+	//
+	//The unix timestamp at which the first swap may be dispatched. If not set,
+	//it may be dispatched immediately.
+	StartTime int64 `protobuf:"varint,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//An optional label for the plan's swaps.
+	Label string `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//Whether to start executing the plan immediately, rather than creating it
+	//paused.
+	Start bool `protobuf:"varint,11,opt,name=start,proto3" json:"start,omitempty"`
+}
+
+func (x *CreateSwapPlanRequest) Reset() {
+	*x = CreateSwapPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSwapPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSwapPlanRequest) ProtoMessage() {}
+
+func (x *CreateSwapPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSwapPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwapPlanRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateSwapPlanRequest) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *CreateSwapPlanRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *CreateSwapPlanRequest) GetMaxFeeSat() int64 {
+	if x != nil {
+		return x.MaxFeeSat
+	}
+	return 0
+}
+
+func (x *CreateSwapPlanRequest) GetOutgoingChanSet() []uint64 {
+	if x != nil {
+		return x.OutgoingChanSet
+	}
+	return nil
+}
+
+func (x *CreateSwapPlanRequest) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *CreateSwapPlanRequest) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *CreateSwapPlanRequest) GetMaxSwaps() uint32 {
+	if x != nil {
+		return x.MaxSwaps
+	}
+	return 0
+}
+
+func (x *CreateSwapPlanRequest) GetIntervalSec() uint64 {
+	if x != nil {
+		return x.IntervalSec
+	}
+	return 0
+}
+
+func (x *CreateSwapPlanRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CreateSwapPlanRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateSwapPlanRequest) GetStart() bool {
+	if x != nil {
+		return x.Start
+	}
+	return false
+}
+
+type PlanStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount of the step's swap.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The unix timestamp before which the step's swap is not dispatched.
+	NotBefore int64 `protobuf:"varint,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	//
+	//The fees that were quoted for the step's swap when the plan was created.
+	EstimatedFeeSat int64 `protobuf:"varint,3,opt,name=estimated_fee_sat,json=estimatedFeeSat,proto3" json:"estimated_fee_sat,omitempty"`
+	//
+	//The state of the step.
+	State PlanStepState `protobuf:"varint,4,opt,name=state,proto3,enum=looprpc.PlanStepState" json:"state,omitempty"`
+	//
+	//The hash of the step's swap, set once it is dispatched.
+	IdBytes []byte `protobuf:"bytes,5,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+}
+
+func (x *PlanStep) Reset() {
+	*x = PlanStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanStep) ProtoMessage() {}
+
+func (x *PlanStep) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanStep.ProtoReflect.Descriptor instead.
+func (*PlanStep) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PlanStep) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *PlanStep) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *PlanStep) GetEstimatedFeeSat() int64 {
+	if x != nil {
+		return x.EstimatedFeeSat
+	}
+	return 0
+}
+
+func (x *PlanStep) GetState() PlanStepState {
+	if x != nil {
+		return x.State
+	}
+	return PlanStepState_STEP_PENDING
+}
+
+func (x *PlanStep) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
+	}
+	return nil
+}
+
+type SwapPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The id of the plan.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of the plan's swaps.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The total amount of the plan's swaps.
+	Amt int64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The budget for the fees of all of the plan's swaps.
+	MaxFeeSat int64 `protobuf:"varint,4,opt,name=max_fee_sat,json=maxFeeSat,proto3" json:"max_fee_sat,omitempty"`
+	//
+	//The total fees that were quoted for the plan's swaps.
+	EstimatedFeeSat int64 `protobuf:"varint,5,opt,name=estimated_fee_sat,json=estimatedFeeSat,proto3" json:"estimated_fee_sat,omitempty"`
+	//
+	//The state of the plan.
+	State SwapPlanState `protobuf:"varint,6,opt,name=state,proto3,enum=looprpc.SwapPlanState" json:"state,omitempty"`
+	//
+	//The label of the plan's swaps.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//The unix timestamp at which the plan was created.
+	CreationTime int64 `protobuf:"varint,8,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	//
+	//The steps of the plan, in the order that they are dispatched.
+	Steps []*PlanStep `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *SwapPlan) Reset() {
+	*x = SwapPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapPlan) ProtoMessage() {}
+
+func (x *SwapPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapPlan.ProtoReflect.Descriptor instead.
+func (*SwapPlan) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SwapPlan) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SwapPlan) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapPlan) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SwapPlan) GetMaxFeeSat() int64 {
+	if x != nil {
+		return x.MaxFeeSat
+	}
+	return 0
+}
+
+func (x *SwapPlan) GetEstimatedFeeSat() int64 {
+	if x != nil {
+		return x.EstimatedFeeSat
+	}
+	return 0
+}
+
+func (x *SwapPlan) GetState() SwapPlanState {
+	if x != nil {
+		return x.State
+	}
+	return SwapPlanState_PLAN_PAUSED
+}
+
+func (x *SwapPlan) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SwapPlan) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *SwapPlan) GetSteps() []*PlanStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type ListSwapPlansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSwapPlansRequest) Reset() {
+	*x = ListSwapPlansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapPlansRequest) ProtoMessage() {}
+
+func (x *ListSwapPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapPlansRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{117}
+}
+
+type ListSwapPlansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//All swap plans, ordered by id.
+	Plans []*SwapPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+}
+
+func (x *ListSwapPlansResponse) Reset() {
+	*x = ListSwapPlansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapPlansResponse) ProtoMessage() {}
+
+func (x *ListSwapPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapPlansResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListSwapPlansResponse) GetPlans() []*SwapPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type UpdateSwapPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The id of the plan to update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The action to apply to the plan.
+	Action SwapPlanAction `protobuf:"varint,2,opt,name=action,proto3,enum=looprpc.SwapPlanAction" json:"action,omitempty"`
+}
+
+func (x *UpdateSwapPlanRequest) Reset() {
+	*x = UpdateSwapPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSwapPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSwapPlanRequest) ProtoMessage() {}
+
+func (x *UpdateSwapPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSwapPlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSwapPlanRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *UpdateSwapPlanRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSwapPlanRequest) GetAction() SwapPlanAction {
+	if x != nil {
+		return x.Action
+	}
+	return SwapPlanAction_PLAN_RESUME
+}
+
+type ExternalLoopInRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount that the external wallet will pay to the htlc, in satoshis.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The maximum swap fee that may be paid, in satoshis. If it is zero, the
+	//server's quoted swap fee is accepted.
+	MaxSwapFee int64 `protobuf:"varint,2,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//
+	//The optional last hop that the off-chain payment of the swap must use.
+	LastHop []byte `protobuf:"bytes,3,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//An optional label for the swap, which is also set as the label of the
+	//payment uri.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of loop.
+	Initiator string `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//Whether the wallet should pay to the nested segwit htlc address rather
+	//than the native segwit address, for wallets that cannot pay to native
+	//segwit addresses.
+	NestedSegwit bool `protobuf:"varint,6,opt,name=nested_segwit,json=nestedSegwit,proto3" json:"nested_segwit,omitempty"`
+}
+
+func (x *ExternalLoopInRequest) Reset() {
+	*x = ExternalLoopInRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalLoopInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalLoopInRequest) ProtoMessage() {}
+
+func (x *ExternalLoopInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalLoopInRequest.ProtoReflect.Descriptor instead.
+func (*ExternalLoopInRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ExternalLoopInRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *ExternalLoopInRequest) GetMaxSwapFee() int64 {
+	if x != nil {
+		return x.MaxSwapFee
+	}
+	return 0
+}
+
+func (x *ExternalLoopInRequest) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *ExternalLoopInRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ExternalLoopInRequest) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
+}
+
+func (x *ExternalLoopInRequest) GetNestedSegwit() bool {
+	if x != nil {
+		return x.NestedSegwit
+	}
+	return false
+}
+
+type ExternalLoopInUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state of the external loop in.
+	State ExternalLoopInState `protobuf:"varint,1,opt,name=state,proto3,enum=looprpc.ExternalLoopInState" json:"state,omitempty"`
+	//
+	//The swap hash of the loop in.
+	IdBytes []byte `protobuf:"bytes,2,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+	//
+	//The htlc address that the external wallet must pay.
+	HtlcAddress string `protobuf:"bytes,3,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
+	//
+	//The exact amount that the external wallet must pay, in satoshis. Payments
+	//of any other amount fail the swap.
+	Amt int64 `protobuf:"varint,4,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The swap fee that was accepted for the swap, in satoshis.
+	SwapFeeSat int64 `protobuf:"varint,5,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//A BIP21 uri that requests payment of the amount to the htlc address, which
+	//may be displayed as a QR code.
+	PaymentUri string `protobuf:"bytes,6,opt,name=payment_uri,json=paymentUri,proto3" json:"payment_uri,omitempty"`
+	//
+	//The txid of the transaction that paid the htlc, set once the payment has
+	//confirmed.
+	HtlcTxid string `protobuf:"bytes,7,opt,name=htlc_txid,json=htlcTxid,proto3" json:"htlc_txid,omitempty"`
+	//
+	//The current status of the swap.
+	Swap *SwapStatus `protobuf:"bytes,8,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (x *ExternalLoopInUpdate) Reset() {
+	*x = ExternalLoopInUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalLoopInUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalLoopInUpdate) ProtoMessage() {}
+
+func (x *ExternalLoopInUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalLoopInUpdate.ProtoReflect.Descriptor instead.
+func (*ExternalLoopInUpdate) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ExternalLoopInUpdate) GetState() ExternalLoopInState {
+	if x != nil {
+		return x.State
+	}
+	return ExternalLoopInState_EXTERNAL_AWAITING_PAYMENT
+}
+
+func (x *ExternalLoopInUpdate) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
+	}
+	return nil
+}
+
+func (x *ExternalLoopInUpdate) GetHtlcAddress() string {
+	if x != nil {
+		return x.HtlcAddress
+	}
+	return ""
+}
+
+func (x *ExternalLoopInUpdate) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *ExternalLoopInUpdate) GetSwapFeeSat() int64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *ExternalLoopInUpdate) GetPaymentUri() string {
+	if x != nil {
+		return x.PaymentUri
+	}
+	return ""
+}
+
+func (x *ExternalLoopInUpdate) GetHtlcTxid() string {
+	if x != nil {
+		return x.HtlcTxid
+	}
+	return ""
+}
+
+func (x *ExternalLoopInUpdate) GetSwap() *SwapStatus {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type SwapStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SwapStatsRequest) Reset() {
+	*x = SwapStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapStatsRequest) ProtoMessage() {}
+
+func (x *SwapStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapStatsRequest.ProtoReflect.Descriptor instead.
+func (*SwapStatsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{122}
+}
+
+type SwapStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The number of loop out swaps that succeeded.
+	LoopOutSucceeded uint32 `protobuf:"varint,1,opt,name=loop_out_succeeded,json=loopOutSucceeded,proto3" json:"loop_out_succeeded,omitempty"`
+	//
+	//The number of loop out swaps that failed.
+	LoopOutFailed uint32 `protobuf:"varint,2,opt,name=loop_out_failed,json=loopOutFailed,proto3" json:"loop_out_failed,omitempty"`
+	//
+	//The number of loop in swaps that succeeded.
+	LoopInSucceeded uint32 `protobuf:"varint,3,opt,name=loop_in_succeeded,json=loopInSucceeded,proto3" json:"loop_in_succeeded,omitempty"`
+	//
+	//The number of loop in swaps that failed.
+	LoopInFailed uint32 `protobuf:"varint,4,opt,name=loop_in_failed,json=loopInFailed,proto3" json:"loop_in_failed,omitempty"`
+	//
+	//The total cost in sat of all swaps that have completed, including the
+	//prepays of failed swaps.
+	TotalCost int64 `protobuf:"varint,5,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	//
+	//The number of failed loop out swaps whose prepay was kept by the server.
+	PrepaysLost uint32 `protobuf:"varint,6,opt,name=prepays_lost,json=prepaysLost,proto3" json:"prepays_lost,omitempty"`
+	//
+	//The total amount in sat, including routing fees, of the prepays that were
+	//kept by the server for failed loop out swaps.
+	PrepayLoss int64 `protobuf:"varint,7,opt,name=prepay_loss,json=prepayLoss,proto3" json:"prepay_loss,omitempty"`
+	//
+	//The expected and actual completion times of successful swaps, aggregated
+	//by the server that the swaps were made with and their type.
+	SlaStats []*SwapSlaStats `protobuf:"bytes,8,rep,name=sla_stats,json=slaStats,proto3" json:"sla_stats,omitempty"`
+}
+
+func (x *SwapStatsResponse) Reset() {
+	*x = SwapStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapStatsResponse) ProtoMessage() {}
+
+func (x *SwapStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapStatsResponse.ProtoReflect.Descriptor instead.
+func (*SwapStatsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *SwapStatsResponse) GetLoopOutSucceeded() uint32 {
+	if x != nil {
+		return x.LoopOutSucceeded
+	}
+	return 0
+}
+
+func (x *SwapStatsResponse) GetLoopOutFailed() uint32 {
+	if x != nil {
+		return x.LoopOutFailed
+	}
+	return 0
+}
+
+func (x *SwapStatsResponse) GetLoopInSucceeded() uint32 {
+	if x != nil {
+		return x.LoopInSucceeded
+	}
+	return 0
+}
+
+func (x *SwapStatsResponse) GetLoopInFailed() uint32 {
+	if x != nil {
+		return x.LoopInFailed
+	}
+	return 0
+}
+
+func (x *SwapStatsResponse) GetTotalCost() int64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *SwapStatsResponse) GetPrepaysLost() uint32 {
+	if x != nil {
+		return x.PrepaysLost
+	}
+	return 0
+}
+
+func (x *SwapStatsResponse) GetPrepayLoss() int64 {
+	if x != nil {
+		return x.PrepayLoss
+	}
+	return 0
+}
+
+func (x *SwapStatsResponse) GetSlaStats() []*SwapSlaStats {
+	if x != nil {
+		return x.SlaStats
+	}
+	return nil
+}
+
+type SwapSlaStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The address of the swap server that the swaps were made with.
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	//
+	//The type of the swaps.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The number of successful swaps that recorded an expected duration when
+	//they were initiated.
+	Swaps uint32 `protobuf:"varint,3,opt,name=swaps,proto3" json:"swaps,omitempty"`
+	//
+	//The number of swaps that took longer than expected to complete.
+	LateSwaps uint32 `protobuf:"varint,4,opt,name=late_swaps,json=lateSwaps,proto3" json:"late_swaps,omitempty"`
+	//
+	//The average time in seconds that the swaps were expected to complete
+	//within.
+	AvgExpectedDurationSec int64 `protobuf:"varint,5,opt,name=avg_expected_duration_sec,json=avgExpectedDurationSec,proto3" json:"avg_expected_duration_sec,omitempty"`
+	//
+	//The average time in seconds that the swaps took to complete.
+	AvgActualDurationSec int64 `protobuf:"varint,6,opt,name=avg_actual_duration_sec,json=avgActualDurationSec,proto3" json:"avg_actual_duration_sec,omitempty"`
+	//
+	//The average time that the swaps spent in each of their states, in the
+	//order that the states were first reached.
+	Phases []*SwapPhaseDuration `protobuf:"bytes,7,rep,name=phases,proto3" json:"phases,omitempty"`
+}
+
+func (x *SwapSlaStats) Reset() {
+	*x = SwapSlaStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapSlaStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSlaStats) ProtoMessage() {}
+
+func (x *SwapSlaStats) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSlaStats.ProtoReflect.Descriptor instead.
+func (*SwapSlaStats) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SwapSlaStats) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *SwapSlaStats) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapSlaStats) GetSwaps() uint32 {
+	if x != nil {
+		return x.Swaps
+	}
+	return 0
+}
+
+func (x *SwapSlaStats) GetLateSwaps() uint32 {
+	if x != nil {
+		return x.LateSwaps
+	}
+	return 0
+}
+
+func (x *SwapSlaStats) GetAvgExpectedDurationSec() int64 {
+	if x != nil {
+		return x.AvgExpectedDurationSec
+	}
+	return 0
+}
+
+func (x *SwapSlaStats) GetAvgActualDurationSec() int64 {
+	if x != nil {
+		return x.AvgActualDurationSec
+	}
+	return 0
+}
+
+func (x *SwapSlaStats) GetPhases() []*SwapPhaseDuration {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+type SwapPhaseDuration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state that the swaps were in during the phase.
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	//
+	//The average time in seconds that the swaps spent in the state.
+	AvgDurationSec int64 `protobuf:"varint,2,opt,name=avg_duration_sec,json=avgDurationSec,proto3" json:"avg_duration_sec,omitempty"`
+}
+
+func (x *SwapPhaseDuration) Reset() {
+	*x = SwapPhaseDuration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapPhaseDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapPhaseDuration) ProtoMessage() {}
+
+func (x *SwapPhaseDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapPhaseDuration.ProtoReflect.Descriptor instead.
+func (*SwapPhaseDuration) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SwapPhaseDuration) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SwapPhaseDuration) GetAvgDurationSec() int64 {
+	if x != nil {
+		return x.AvgDurationSec
+	}
+	return 0
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{126}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The version of the daemon.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	//
+	//The network that the daemon is running on.
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	//
+	//The state of the daemon's connection to the swap server.
+	ServerConnection *ServerConnection `protobuf:"bytes,3,opt,name=server_connection,json=serverConnection,proto3" json:"server_connection,omitempty"`
+	//
+	//The user agent that the daemon sends to the swap server for swaps that
+	//are requested without an initiator. It holds the daemon's agent name,
+	//version and commit, and its default initiator if one is configured. This
+	//field is not set in watch mode.
+	UserAgent string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	//
+	//The state of the daemon's subscriptions to lnd. This field is not set in
+	//watch mode.
+	LndConnection *LndConnection `protobuf:"bytes,5,opt,name=lnd_connection,json=lndConnection,proto3" json:"lnd_connection,omitempty"`
+	//
+	//The resolved locations of the files that the daemon uses, which are
+	//namespaced by the daemon's network.
+	Paths *DaemonPaths `protobuf:"bytes,6,opt,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetServerConnection() *ServerConnection {
+	if x != nil {
+		return x.ServerConnection
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetLndConnection() *LndConnection {
+	if x != nil {
+		return x.LndConnection
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetPaths() *DaemonPaths {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type DaemonPaths struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The directory that the daemon stores the data of its network in.
+	DataDir string `protobuf:"bytes,1,opt,name=data_dir,json=dataDir,proto3" json:"data_dir,omitempty"`
+	//
+	//The file that the daemon writes its logs to.
+	LogFile string `protobuf:"bytes,2,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
+	//
+	//The config file that the daemon reads its options from, which does not
+	//need to exist.
+	ConfigFile string `protobuf:"bytes,3,opt,name=config_file,json=configFile,proto3" json:"config_file,omitempty"`
+	//
+	//The swap database of the daemon. In watch mode, this is the replica that
+	//swaps are read from.
+	Database string `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	//
+	//The TLS certificate of the daemon's rpc server.
+	TlsCertPath string `protobuf:"bytes,5,opt,name=tls_cert_path,json=tlsCertPath,proto3" json:"tls_cert_path,omitempty"`
+	//
+	//The admin macaroon of the daemon's rpc server.
+	MacaroonPath string `protobuf:"bytes,6,opt,name=macaroon_path,json=macaroonPath,proto3" json:"macaroon_path,omitempty"`
+}
+
+func (x *DaemonPaths) Reset() {
+	*x = DaemonPaths{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DaemonPaths) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaemonPaths) ProtoMessage() {}
+
+func (x *DaemonPaths) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaemonPaths.ProtoReflect.Descriptor instead.
+func (*DaemonPaths) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *DaemonPaths) GetDataDir() string {
+	if x != nil {
+		return x.DataDir
+	}
+	return ""
+}
+
+func (x *DaemonPaths) GetLogFile() string {
+	if x != nil {
+		return x.LogFile
+	}
+	return ""
+}
+
+func (x *DaemonPaths) GetConfigFile() string {
+	if x != nil {
+		return x.ConfigFile
+	}
+	return ""
+}
+
+func (x *DaemonPaths) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DaemonPaths) GetTlsCertPath() string {
+	if x != nil {
+		return x.TlsCertPath
+	}
+	return ""
+}
+
+func (x *DaemonPaths) GetMacaroonPath() string {
+	if x != nil {
+		return x.MacaroonPath
+	}
+	return ""
+}
+
+type ServerConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The current state of the connection.
+	State ServerConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=looprpc.ServerConnectionState" json:"state,omitempty"`
+	//
+	//The time in unix nanoseconds at which the connection entered its current
+	//state.
+	StateSince int64 `protobuf:"varint,2,opt,name=state_since,json=stateSince,proto3" json:"state_since,omitempty"`
+	//
+	//The number of times that the daemon reconnected to the server after its
+	//connection was lost.
+	Reconnects uint32 `protobuf:"varint,3,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+	//
+	//The most recent changes in the connection's state, ordered from oldest to
+	//newest.
+	Events []*ServerConnectionEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ServerConnection) Reset() {
+	*x = ServerConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConnection) ProtoMessage() {}
+
+func (x *ServerConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConnection.ProtoReflect.Descriptor instead.
+func (*ServerConnection) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ServerConnection) GetState() ServerConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return ServerConnectionState_SERVER_CONNECTION_IDLE
+}
+
+func (x *ServerConnection) GetStateSince() int64 {
+	if x != nil {
+		return x.StateSince
+	}
+	return 0
+}
+
+func (x *ServerConnection) GetReconnects() uint32 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
+func (x *ServerConnection) GetEvents() []*ServerConnectionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ServerConnectionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state that the connection changed to.
+	State ServerConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=looprpc.ServerConnectionState" json:"state,omitempty"`
+	//
+	//The time in unix nanoseconds at which the connection changed state.
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ServerConnectionEvent) Reset() {
+	*x = ServerConnectionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConnectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConnectionEvent) ProtoMessage() {}
+
+func (x *ServerConnectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConnectionEvent.ProtoReflect.Descriptor instead.
+func (*ServerConnectionEvent) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ServerConnectionEvent) GetState() ServerConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return ServerConnectionState_SERVER_CONNECTION_IDLE
+}
+
+func (x *ServerConnectionEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type LndConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The current state of the connection.
+	State LndConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=looprpc.LndConnectionState" json:"state,omitempty"`
+	//
+	//The time in unix nanoseconds at which the connection entered its current
+	//state.
+	StateSince int64 `protobuf:"varint,2,opt,name=state_since,json=stateSince,proto3" json:"state_since,omitempty"`
+	//
+	//The number of times that the daemon resubscribed to lnd after its
+	//subscriptions failed.
+	Reconnects uint32 `protobuf:"varint,3,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+	//
+	//The most recent changes in the connection's state, ordered from oldest to
+	//newest.
+	Events []*LndConnectionEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *LndConnection) Reset() {
+	*x = LndConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LndConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LndConnection) ProtoMessage() {}
+
+func (x *LndConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LndConnection.ProtoReflect.Descriptor instead.
+func (*LndConnection) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *LndConnection) GetState() LndConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return LndConnectionState_LND_CONNECTION_CONNECTED
+}
+
+func (x *LndConnection) GetStateSince() int64 {
+	if x != nil {
+		return x.StateSince
+	}
+	return 0
+}
+
+func (x *LndConnection) GetReconnects() uint32 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
+func (x *LndConnection) GetEvents() []*LndConnectionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type LndConnectionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state that the connection changed to.
+	State LndConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=looprpc.LndConnectionState" json:"state,omitempty"`
+	//
+	//The time in unix nanoseconds at which the connection changed state.
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	//
+	//The number of pending swaps that were interrupted while the daemon was
+	//disconnected, and were resumed once it resubscribed to lnd. This field is
+	//only set for reconnects.
+	ResumedSwaps uint32 `protobuf:"varint,3,opt,name=resumed_swaps,json=resumedSwaps,proto3" json:"resumed_swaps,omitempty"`
+}
+
+func (x *LndConnectionEvent) Reset() {
+	*x = LndConnectionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LndConnectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LndConnectionEvent) ProtoMessage() {}
+
+func (x *LndConnectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LndConnectionEvent.ProtoReflect.Descriptor instead.
+func (*LndConnectionEvent) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *LndConnectionEvent) GetState() LndConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return LndConnectionState_LND_CONNECTION_CONNECTED
+}
+
+func (x *LndConnectionEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LndConnectionEvent) GetResumedSwaps() uint32 {
+	if x != nil {
+		return x.ResumedSwaps
+	}
+	return 0
+}
+
+var File_client_proto protoreflect.FileDescriptor
+
+var file_client_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x05, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12,
+	0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53,
+	0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x12, 0x22, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2a,
+	0x0a, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xb0, 0x03, 0x0a, 0x0d, 0x4c,
+	0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x20,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x74, 0x6c, 0x63,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x48, 0x74, 0x6c, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x88, 0x04,
+	0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x70,
+	0x32, 0x77, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x32, 0x77,
+	0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x5c, 0x0a, 0x19, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x17,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12, 0x29, 0x0a, 0x10,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x3d, 0x0a, 0x0e, 0x68, 0x74, 0x6c,
+	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x74, 0x6c, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x0d, 0x68, 0x74, 0x6c, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x0b, 0x48, 0x74, 0x6c, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x74, 0x6c, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x43, 0x0a, 0x0d, 0x48, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x48, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x09, 0x0a,
+	0x0a, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x12, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50,
+	0x32, 0x77, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x70,
+	0x32, 0x77, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x73,
+	0x74, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x63, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x5c, 0x0a, 0x19, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x17, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x73,
+	0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x23, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2d, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x68, 0x74, 0x6c,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x74, 0x6c, 0x63, 0x54, 0x78, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x0e, 0x68,
+	0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x74,
+	0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x0d, 0x68, 0x74, 0x6c,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x12, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x22, 0x21, 0x0a, 0x0f, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x42, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x53, 0x65, 0x63, 0x22, 0x5f, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x55, 0x6e, 0x69, 0x78, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
+	0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a,
+	0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69,
+	0x6e, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x74,
+	0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04,
+	0x08, 0x07, 0x10, 0x08, 0x22, 0x8e, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x74, 0x6c, 0x63, 0x12, 0x3a, 0x0a,
+	0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x6f, 0x6f,
+	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x48,
+	0x6f, 0x70, 0x12, 0x41, 0x0a, 0x13, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48,
+	0x69, 0x6e, 0x74, 0x52, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x48, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x68,
+	0x74, 0x6c, 0x63, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xf3, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41,
+	0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x68, 0x74, 0x6c, 0x63, 0x53, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65, 0x53,
+	0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73,
+	0x77, 0x61, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x70,
+	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x33, 0x0a, 0x0b, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x48, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0xbb, 0x02, 0x0a, 0x09, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1b,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfc, 0x0a, 0x0a, 0x13,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x66, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x3d, 0x0a, 0x1c, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x17, 0x73, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61,
+	0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50,
+	0x70, 0x6d, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x70,
+	0x6d, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x24, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75,
+	0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x75,
+	0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
+	0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x53, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
+	0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x6c,
+	0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65,
+	0x63, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e,
+	0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61,
+	0x75, 0x74, 0x6f, 0x4d, 0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36,
+	0x0a, 0x17, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x13, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x6d, 0x69, 0x6e, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x4a, 0x0a, 0x11, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x10, 0x75, 0x6e, 0x72, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x63, 0x69,
+	0x74, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x69, 0x63, 0x69, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x0b, 0x70, 0x65, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x1d,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x65, 0x72, 0x46, 0x65, 0x65, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x4a,
+	0x0a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e, 0x61, 0x75, 0x74, 0x6f,
+	0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x22, 0x3c, 0x0a, 0x0a, 0x50, 0x65,
+	0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x0d, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x30, 0x0a, 0x14, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64,
+	0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65,
+	0x63, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
+	0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12,
+	0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x72, 0x0a,
+	0x0c, 0x44, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xad, 0x03, 0x0a, 0x14, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f,
+	0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x39,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x0c, 0x64, 0x69, 0x73,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x5f, 0x65, 0x6c, 0x69, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x45, 0x6c, 0x69, 0x64, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x14, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x6c, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
+	0x6f, 0x6e, 0x52, 0x13, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x6c, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x12, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x73, 0x22, 0x4d, 0x0a, 0x17, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08,
+	0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74,
+	0x22, 0xf3, 0x01, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x2b, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x74,
+	0x6c, 0x63, 0x53, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x66, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a,
+	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x22, 0x4f, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70,
+	0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70,
+	0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x18,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x17, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x06, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73,
+	0x74, 0x48, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x65, 0x65, 0x53, 0x61, 0x74, 0x22, 0xd4, 0x01,
+	0x0a, 0x12, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70,
+	0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x46, 0x65, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x68,
+	0x6f, 0x73, 0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x53, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0xc8, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x6f,
+	0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x46, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x68,
+	0x65, 0x61, 0x70, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x68, 0x65, 0x61, 0x70, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd5, 0x03, 0x0a, 0x10, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x77, 0x65, 0x65, 0x70, 0x53, 0x61, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x61, 0x75, 0x74, 0x6f, 0x6c,
+	0x6f, 0x6f, 0x70, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x61, 0x75, 0x74,
+	0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x65, 0x65,
+	0x70, 0x53, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x6e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x75, 0x6e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x53, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xb0,
+	0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x14, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x63, 0x69,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x66, 0x69, 0x63, 0x69, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x30, 0x0a, 0x14, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x66, 0x69, 0x63, 0x69, 0x74, 0x53, 0x61,
+	0x74, 0x22, 0x22, 0x0a, 0x10, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0xab, 0x06, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x68,
+	0x74, 0x6c, 0x63, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x68,
+	0x74, 0x6c, 0x63, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x68, 0x74, 0x6c, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32, 0x77,
+	0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x32, 0x77, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74,
+	0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73,
+	0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6c, 0x74, 0x76, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x2b, 0x0a,
+	0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x74,
+	0x6c, 0x63, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x74, 0x6c, 0x63, 0x54, 0x78, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x22, 0x57, 0x0a, 0x14, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x25, 0x0a, 0x13,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xea, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61,
+	0x77, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x65, 0x65, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1c, 0x0a, 0x0a, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x45, 0x0a, 0x0a, 0x53, 0x77, 0x65, 0x65, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x22, 0x44, 0x0a, 0x0b, 0x53, 0x77, 0x65, 0x65, 0x70,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x22, 0x59, 0x0a,
+	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x22, 0x51, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x8c,
+	0x02, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a,
+	0x0a, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x65,
+	0x65, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x2d,
+	0x0a, 0x13, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x66, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xc4, 0x03, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12,
+	0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d,
+	0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65,
+	0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xd0,
+	0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61,
+	0x74, 0x22, 0x46, 0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x42, 0x61, 0x6b, 0x65,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x1a, 0x42, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x16, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x1c, 0x0a,
+	0x1a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x2e,
+	0x0a, 0x16, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xfa,
+	0x04, 0x0a, 0x0f, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74,
+	0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x74, 0x76, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6c,
+	0x74, 0x76, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x50, 0x32, 0x77, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x5c,
+	0x0a, 0x19, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x17, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x0e, 0x68, 0x74, 0x6c,
+	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x74, 0x6c, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x0d, 0x68, 0x74, 0x6c, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x2b, 0x0a, 0x19, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22,
+	0x24, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x44, 0x65, 0x6e, 0x79, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x12, 0x0a, 0x10, 0x44, 0x65, 0x6e, 0x79, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x65, 0x6e,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52,
+	0x07, 0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x0a, 0x53, 0x77, 0x61,
+	0x70, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xd9, 0x04, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d,
+	0x74, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46,
+	0x65, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x61, 0x73, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x61, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x43, 0x68, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x68, 0x6f, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48,
+	0x6f, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68,
+	0x74, 0x6c, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x48, 0x74, 0x6c, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x30, 0x0a,
+	0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22,
+	0x4b, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x19, 0x0a, 0x17,
+	0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x46, 0x65, 0x65,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x61,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63,
+	0x22, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x61, 0x74, 0x22, 0x4c, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xbb, 0x02, 0x0a,
+	0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x73, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x4f,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6f,
+	0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63,
+	0x6f, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0d, 0x43, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x63,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x13, 0x44, 0x72,
+	0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x65,
+	0x65, 0x50, 0x70, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x53, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x61, 0x74, 0x50,
+	0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xd9, 0x01,
+	0x0a, 0x0b, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x73, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x22, 0xae, 0x02, 0x0a, 0x12, 0x46, 0x69,
+	0x6c, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x66, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xfc, 0x01, 0x0a, 0x0a, 0x46,
+	0x69, 0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2a, 0x0a,
+	0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xe3, 0x02, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1e, 0x0a, 0x0b,
+	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x73, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x68, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74,
+	0x48, 0x6f, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x53, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22,
+	0xb0, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x46, 0x65,
+	0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73,
+	0x22, 0x58, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x15, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x68, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74,
+	0x48, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x67, 0x77, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x67, 0x77, 0x69, 0x74, 0x22, 0xa3, 0x02, 0x0a,
+	0x14, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x69, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x74, 0x6c, 0x63, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x74, 0x6c, 0x63, 0x54, 0x78, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x77, 0x61,
+	0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x73, 0x77,
+	0x61, 0x70, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd2, 0x02, 0x0a, 0x11, 0x53, 0x77, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75,
+	0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f,
+	0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6c,
+	0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x73, 0x5f, 0x6c,
+	0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x73, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x79, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x6c, 0x61, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x08, 0x73, 0x6c, 0x61, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x0c,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x6c, 0x61, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x12, 0x39, 0x0a, 0x19, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x76, 0x67, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x17, 0x61,
+	0x76, 0x67, 0x5f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x61, 0x76,
+	0x67, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x50, 0x68, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x53, 0x77, 0x61, 0x70, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x76, 0x67,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x22, 0x10, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x02,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0e,
+	0x6c, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x6e,
+	0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0b, 0x44, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44,
+	0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6c,
+	0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50,
+	0x61, 0x74, 0x68, 0x22, 0xc1, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x36, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x61, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x4c,
+	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x4c, 0x6e, 0x64, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x2a, 0x82, 0x01, 0x0a, 0x0e, 0x48, 0x74, 0x6c,
+	0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x48,
+	0x54, 0x4c, 0x43, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x54, 0x4c,
+	0x43, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x32,
+	0x57, 0x53, 0x48, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x4f, 0x55,
+	0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x50, 0x32, 0x57, 0x53, 0x48,
+	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x32, 0x54, 0x52, 0x10, 0x03, 0x2a, 0x25, 0x0a,
+	0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x4f,
+	0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x4f, 0x4f, 0x50, 0x5f,
+	0x49, 0x4e, 0x10, 0x01, 0x2a, 0x73, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x45, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x56,
+	0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x4c, 0x43, 0x5f,
+	0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xed, 0x01, 0x0a, 0x0d, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a,
+	0x1c, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12,
+	0x25, 0x0a, 0x21, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x56,
+	0x41, 0x4c, 0x55, 0x45, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41,
+	0x52, 0x59, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x06, 0x2a, 0x6b, 0x0a, 0x0a, 0x53, 0x77, 0x61,
+	0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x57, 0x41, 0x50, 0x5f,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x57, 0x41,
+	0x50, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53,
+	0x54, 0x55, 0x43, 0x4b, 0x10, 0x03, 0x2a, 0x7b, 0x0a, 0x12, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x1a,
+	0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23,
+	0x0a, 0x1f, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49,
+	0x4e, 0x10, 0x02, 0x2a, 0x80, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d,
+	0x55, 0x4e, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x53, 0x57, 0x41,
+	0x50, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x00, 0x12,
+	0x21, 0x0a, 0x1d, 0x55, 0x4e, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x5f,
+	0x53, 0x57, 0x41, 0x50, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x45,
+	0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x4e, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54,
+	0x45, 0x44, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x10, 0x02, 0x2a, 0x5e, 0x0a, 0x0c, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c,
+	0x61, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c,
+	0x41, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x2f, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x52, 0x45,
+	0x53, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x2a, 0xae, 0x05, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x22, 0x0a, 0x1e, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42,
+	0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x53, 0x10, 0x02, 0x12,
+	0x1e, 0x0a, 0x1a, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42,
+	0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x45, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x19, 0x0a, 0x15, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49,
+	0x4e, 0x5f, 0x46, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55,
+	0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x46,
+	0x45, 0x45, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x06, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50,
+	0x52, 0x45, 0x50, 0x41, 0x59, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x42,
+	0x41, 0x43, 0x4b, 0x4f, 0x46, 0x46, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54,
+	0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x0a, 0x12, 0x1c, 0x0a, 0x18, 0x41,
+	0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x51, 0x55, 0x49,
+	0x44, 0x49, 0x54, 0x59, 0x5f, 0x4f, 0x4b, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f,
+	0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0c, 0x12, 0x20,
+	0x0a, 0x1c, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x45,
+	0x45, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0d,
+	0x12, 0x1d, 0x0a, 0x19, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x50, 0x45, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x0e, 0x12,
+	0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x0f, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x55, 0x50, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x10, 0x12, 0x21, 0x0a,
+	0x1d, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x52,
+	0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x10, 0x11,
+	0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x49, 0x4e, 0x47, 0x10,
+	0x12, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x4f, 0x4f, 0x4c, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x13, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x52,
+	0x45, 0x41, 0x53, 0x45, 0x10, 0x14, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x58,
+	0x43, 0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x15, 0x2a, 0x59, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x45, 0x45, 0x5f, 0x44,
+	0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46,
+	0x45, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x45, 0x45,
+	0x50, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x4c, 0x4f, 0x4f,
+	0x50, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x01,
+	0x2a, 0x26, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0d, 0x0a, 0x09, 0x47, 0x4f, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x48, 0x45, 0x41, 0x50, 0x10, 0x01, 0x2a, 0x4a, 0x0a, 0x0f, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x42,
+	0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f,
+	0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x55, 0x44, 0x47,
+	0x45, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x4c, 0x41, 0x42,
+	0x45, 0x4c, 0x10, 0x01, 0x2a, 0x47, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50,
+	0x50, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x52, 0x41,
+	0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x43, 0x0a,
+	0x09, 0x46, 0x69, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49,
+	0x4c, 0x4c, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x02, 0x2a, 0x6a, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x41, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4c, 0x41,
+	0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4c,
+	0x41, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x5b,
+	0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54,
+	0x43, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54,
+	0x45, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x42, 0x0a, 0x0e, 0x53,
+	0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02, 0x2a,
+	0x80, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x6f, 0x70,
+	0x49, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x03, 0x2a, 0xbb, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x45, 0x52, 0x56,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x45, 0x52, 0x56, 0x45,
+	0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03,
+	0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x04,
+	0x2a, 0x53, 0x0a, 0x12, 0x4c, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x4e, 0x44, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x32, 0xd1, 0x21, 0x0a, 0x0a, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12,
+	0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x16,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x4f, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x63, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x44, 0x65, 0x6e,
+	0x79, 0x53, 0x77, 0x61, 0x70, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x6e, 0x79, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1f, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x52, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x49,
+	0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x6f, 0x70, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x4b, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x77, 0x65,
+	0x65, 0x70, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x26, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12,
+	0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x44, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x6c, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69,
+	0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x4e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x12, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61,
+	0x6e, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x51, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x42, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x16, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x26, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x60, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x23, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
+	0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_client_proto_rawDescOnce sync.Once
+	file_client_proto_rawDescData = file_client_proto_rawDesc
+)
+
+func file_client_proto_rawDescGZIP() []byte {
+	file_client_proto_rawDescOnce.Do(func() {
+		file_client_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_proto_rawDescData)
+	})
+	return file_client_proto_rawDescData
+}
+
+var file_client_proto_enumTypes = make([]protoimpl.EnumInfo, 22)
+var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
+var file_client_proto_goTypes = []interface{}{
+	(HtlcOutputType)(0),                    // 0: looprpc.HtlcOutputType
+	(SwapType)(0),                          // 1: looprpc.SwapType
+	(SwapState)(0),                         // 2: looprpc.SwapState
+	(FailureReason)(0),                     // 3: looprpc.FailureReason
+	(SwapHealth)(0),                        // 4: looprpc.SwapHealth
+	(SuggestionPriority)(0),                // 5: looprpc.SuggestionPriority
+	(UnrestrictedSwapMode)(0),              // 6: looprpc.UnrestrictedSwapMode
+	(CircularMode)(0),                      // 7: looprpc.CircularMode
+	(LiquidityRuleType)(0),                 // 8: looprpc.LiquidityRuleType
+	(AutoReason)(0),                        // 9: looprpc.AutoReason
+	(FeeDecision)(0),                       // 10: looprpc.FeeDecision
+	(DispatchSource)(0),                    // 11: looprpc.DispatchSource
+	(ProfileType)(0),                       // 12: looprpc.ProfileType
+	(BudgetNamespace)(0),                   // 13: looprpc.BudgetNamespace
+	(DrainState)(0),                        // 14: looprpc.DrainState
+	(FillState)(0),                         // 15: looprpc.FillState
+	(SwapPlanState)(0),                     // 16: looprpc.SwapPlanState
+	(PlanStepState)(0),                     // 17: looprpc.PlanStepState
+	(SwapPlanAction)(0),                    // 18: looprpc.SwapPlanAction
+	(ExternalLoopInState)(0),               // 19: looprpc.ExternalLoopInState
+	(ServerConnectionState)(0),             // 20: looprpc.ServerConnectionState
+	(LndConnectionState)(0),                // 21: looprpc.LndConnectionState
+	(*LoopOutRequest)(nil),                 // 22: looprpc.LoopOutRequest
+	(*LoopInRequest)(nil),                  // 23: looprpc.LoopInRequest
+	(*SwapResponse)(nil),                   // 24: looprpc.SwapResponse
+	(*HtlcAddress)(nil),                    // 25: looprpc.HtlcAddress
+	(*HtlcAddresses)(nil),                  // 26: looprpc.HtlcAddresses
+	(*MonitorRequest)(nil),                 // 27: looprpc.MonitorRequest
+	(*SwapStatus)(nil),                     // 28: looprpc.SwapStatus
+	(*ListSwapsRequest)(nil),               // 29: looprpc.ListSwapsRequest
+	(*ListSwapsResponse)(nil),              // 30: looprpc.ListSwapsResponse
+	(*SwapInfoRequest)(nil),                // 31: looprpc.SwapInfoRequest
+	(*RedactSwapRequest)(nil),              // 32: looprpc.RedactSwapRequest
+	(*RedactSwapResponse)(nil),             // 33: looprpc.RedactSwapResponse
+	(*StatusLinkRequest)(nil),              // 34: looprpc.StatusLinkRequest
+	(*StatusLinkResponse)(nil),             // 35: looprpc.StatusLinkResponse
+	(*TermsRequest)(nil),                   // 36: looprpc.TermsRequest
+	(*InTermsResponse)(nil),                // 37: looprpc.InTermsResponse
+	(*OutTermsResponse)(nil),               // 38: looprpc.OutTermsResponse
+	(*QuoteRequest)(nil),                   // 39: looprpc.QuoteRequest
+	(*InQuoteResponse)(nil),                // 40: looprpc.InQuoteResponse
+	(*OutQuoteResponse)(nil),               // 41: looprpc.OutQuoteResponse
+	(*ProbeRequest)(nil),                   // 42: looprpc.ProbeRequest
+	(*ProbeResponse)(nil),                  // 43: looprpc.ProbeResponse
+	(*TokensRequest)(nil),                  // 44: looprpc.TokensRequest
+	(*TokensResponse)(nil),                 // 45: looprpc.TokensResponse
+	(*LsatToken)(nil),                      // 46: looprpc.LsatToken
+	(*GetLiquidityParamsRequest)(nil),      // 47: looprpc.GetLiquidityParamsRequest
+	(*LiquidityParameters)(nil),            // 48: looprpc.LiquidityParameters
+	(*PeerWeight)(nil),                     // 49: looprpc.PeerWeight
+	(*LiquidityRule)(nil),                  // 50: looprpc.LiquidityRule
+	(*SetLiquidityParamsRequest)(nil),      // 51: looprpc.SetLiquidityParamsRequest
+	(*SetLiquidityParamsResponse)(nil),     // 52: looprpc.SetLiquidityParamsResponse
+	(*SuggestSwapsRequest)(nil),            // 53: looprpc.SuggestSwapsRequest
+	(*Disqualified)(nil),                   // 54: looprpc.Disqualified
+	(*SuggestSwapsResponse)(nil),           // 55: looprpc.SuggestSwapsResponse
+	(*QuoteSuggestionsRequest)(nil),        // 56: looprpc.QuoteSuggestionsRequest
+	(*SuggestionQuote)(nil),                // 57: looprpc.SuggestionQuote
+	(*QuoteSuggestionsResponse)(nil),       // 58: looprpc.QuoteSuggestionsResponse
+	(*ApplySuggestionsRequest)(nil),        // 59: looprpc.ApplySuggestionsRequest
+	(*AppliedSuggestion)(nil),              // 60: looprpc.AppliedSuggestion
+	(*ApplySuggestionsResponse)(nil),       // 61: looprpc.ApplySuggestionsResponse
+	(*ParameterAdviceRequest)(nil),         // 62: looprpc.ParameterAdviceRequest
+	(*ParameterAdvice)(nil),                // 63: looprpc.ParameterAdvice
+	(*ParameterAdviceResponse)(nil),        // 64: looprpc.ParameterAdviceResponse
+	(*CircularRebalance)(nil),              // 65: looprpc.CircularRebalance
+	(*CircularComparison)(nil),             // 66: looprpc.CircularComparison
+	(*ChannelQuote)(nil),                   // 67: looprpc.ChannelQuote
+	(*ChannelComparison)(nil),              // 68: looprpc.ChannelComparison
+	(*LiquiditySummaryRequest)(nil),        // 69: looprpc.LiquiditySummaryRequest
+	(*LiquiditySummary)(nil),               // 70: looprpc.LiquiditySummary
+	(*LiquidityTarget)(nil),                // 71: looprpc.LiquidityTarget
+	(*SwapProofRequest)(nil),               // 72: looprpc.SwapProofRequest
+	(*SwapProof)(nil),                      // 73: looprpc.SwapProof
+	(*SwapProofTransaction)(nil),           // 74: looprpc.SwapProofTransaction
+	(*PreviewSweepRequest)(nil),            // 75: looprpc.PreviewSweepRequest
+	(*PreviewSweepResponse)(nil),           // 76: looprpc.PreviewSweepResponse
+	(*SweepInput)(nil),                     // 77: looprpc.SweepInput
+	(*SweepOutput)(nil),                    // 78: looprpc.SweepOutput
+	(*ListLiquiditySnapshotsRequest)(nil),  // 79: looprpc.ListLiquiditySnapshotsRequest
+	(*ListLiquiditySnapshotsResponse)(nil), // 80: looprpc.ListLiquiditySnapshotsResponse
+	(*LiquiditySnapshot)(nil),              // 81: looprpc.LiquiditySnapshot
+	(*ListFeeRecordsRequest)(nil),          // 82: looprpc.ListFeeRecordsRequest
+	(*ListFeeRecordsResponse)(nil),         // 83: looprpc.ListFeeRecordsResponse
+	(*FeeRecord)(nil),                      // 84: looprpc.FeeRecord
+	(*ListDispatchRecordsRequest)(nil),     // 85: looprpc.ListDispatchRecordsRequest
+	(*ListDispatchRecordsResponse)(nil),    // 86: looprpc.ListDispatchRecordsResponse
+	(*DispatchRecord)(nil),                 // 87: looprpc.DispatchRecord
+	(*ChannelBalanceSnapshot)(nil),         // 88: looprpc.ChannelBalanceSnapshot
+	(*DebugLevelRequest)(nil),              // 89: looprpc.DebugLevelRequest
+	(*DebugLevelResponse)(nil),             // 90: looprpc.DebugLevelResponse
+	(*ReloadConfigRequest)(nil),            // 91: looprpc.ReloadConfigRequest
+	(*ReloadConfigResponse)(nil),           // 92: looprpc.ReloadConfigResponse
+	(*BakeTenantMacaroonRequest)(nil),      // 93: looprpc.BakeTenantMacaroonRequest
+	(*BakeTenantMacaroonResponse)(nil),     // 94: looprpc.BakeTenantMacaroonResponse
+	(*SnapshotMissionControlRequest)(nil),  // 95: looprpc.SnapshotMissionControlRequest
+	(*MissionControlSnapshot)(nil),         // 96: looprpc.MissionControlSnapshot
+	(*ResetMissionControlRequest)(nil),     // 97: looprpc.ResetMissionControlRequest
+	(*ResetMissionControlResponse)(nil),    // 98: looprpc.ResetMissionControlResponse
+	(*RestoreMissionControlRequest)(nil),   // 99: looprpc.RestoreMissionControlRequest
+	(*CaptureProfileRequest)(nil),          // 100: looprpc.CaptureProfileRequest
+	(*CaptureProfileResponse)(nil),         // 101: looprpc.CaptureProfileResponse
+	(*SwapReservation)(nil),                // 102: looprpc.SwapReservation
+	(*ConfirmReservationRequest)(nil),      // 103: looprpc.ConfirmReservationRequest
+	(*ListPendingApprovalsRequest)(nil),    // 104: looprpc.ListPendingApprovalsRequest
+	(*ListPendingApprovalsResponse)(nil),   // 105: looprpc.ListPendingApprovalsResponse
+	(*ApproveSwapRequest)(nil),             // 106: looprpc.ApproveSwapRequest
+	(*DenySwapRequest)(nil),                // 107: looprpc.DenySwapRequest
+	(*DenySwapResponse)(nil),               // 108: looprpc.DenySwapResponse
+	(*ListSwapDenialsRequest)(nil),         // 109: looprpc.ListSwapDenialsRequest
+	(*ListSwapDenialsResponse)(nil),        // 110: looprpc.ListSwapDenialsResponse
+	(*SwapDenial)(nil),                     // 111: looprpc.SwapDenial
+	(*SwapTemplate)(nil),                   // 112: looprpc.SwapTemplate
+	(*SetSwapTemplateRequest)(nil),         // 113: looprpc.SetSwapTemplateRequest
+	(*SetSwapTemplateResponse)(nil),        // 114: looprpc.SetSwapTemplateResponse
+	(*ListSwapTemplatesRequest)(nil),       // 115: looprpc.ListSwapTemplatesRequest
+	(*ListSwapTemplatesResponse)(nil),      // 116: looprpc.ListSwapTemplatesResponse
+	(*DeleteSwapTemplateRequest)(nil),      // 117: looprpc.DeleteSwapTemplateRequest
+	(*DeleteSwapTemplateResponse)(nil),     // 118: looprpc.DeleteSwapTemplateResponse
+	(*ResolveSwapTemplateRequest)(nil),     // 119: looprpc.ResolveSwapTemplateRequest
+	(*ResolveSwapTemplateResponse)(nil),    // 120: looprpc.ResolveSwapTemplateResponse
+	(*FeeBudget)(nil),                      // 121: looprpc.FeeBudget
+	(*SetFeeBudgetRequest)(nil),            // 122: looprpc.SetFeeBudgetRequest
+	(*SetFeeBudgetResponse)(nil),           // 123: looprpc.SetFeeBudgetResponse
+	(*ListFeeBudgetsRequest)(nil),          // 124: looprpc.ListFeeBudgetsRequest
+	(*FeeBudgetStatus)(nil),                // 125: looprpc.FeeBudgetStatus
+	(*ListFeeBudgetsResponse)(nil),         // 126: looprpc.ListFeeBudgetsResponse
+	(*DeleteFeeBudgetRequest)(nil),         // 127: looprpc.DeleteFeeBudgetRequest
+	(*DeleteFeeBudgetResponse)(nil),        // 128: looprpc.DeleteFeeBudgetResponse
+	(*CostStatementRequest)(nil),           // 129: looprpc.CostStatementRequest
+	(*NamespaceCost)(nil),                  // 130: looprpc.NamespaceCost
+	(*CostStatement)(nil),                  // 131: looprpc.CostStatement
+	(*DrainChannelRequest)(nil),            // 132: looprpc.DrainChannelRequest
+	(*DrainUpdate)(nil),                    // 133: looprpc.DrainUpdate
+	(*FillChannelRequest)(nil),             // 134: looprpc.FillChannelRequest
+	(*FillUpdate)(nil),                     // 135: looprpc.FillUpdate
+	(*CreateSwapPlanRequest)(nil),          // 136: looprpc.CreateSwapPlanRequest
+	(*PlanStep)(nil),                       // 137: looprpc.PlanStep
+	(*SwapPlan)(nil),                       // 138: looprpc.SwapPlan
+	(*ListSwapPlansRequest)(nil),           // 139: looprpc.ListSwapPlansRequest
+	(*ListSwapPlansResponse)(nil),          // 140: looprpc.ListSwapPlansResponse
+	(*UpdateSwapPlanRequest)(nil),          // 141: looprpc.UpdateSwapPlanRequest
+	(*ExternalLoopInRequest)(nil),          // 142: looprpc.ExternalLoopInRequest
+	(*ExternalLoopInUpdate)(nil),           // 143: looprpc.ExternalLoopInUpdate
+	(*SwapStatsRequest)(nil),               // 144: looprpc.SwapStatsRequest
+	(*SwapStatsResponse)(nil),              // 145: looprpc.SwapStatsResponse
+	(*SwapSlaStats)(nil),                   // 146: looprpc.SwapSlaStats
+	(*SwapPhaseDuration)(nil),              // 147: looprpc.SwapPhaseDuration
+	(*GetInfoRequest)(nil),                 // 148: looprpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                // 149: looprpc.GetInfoResponse
+	(*DaemonPaths)(nil),                    // 150: looprpc.DaemonPaths
+	(*ServerConnection)(nil),               // 151: looprpc.ServerConnection
+	(*ServerConnectionEvent)(nil),          // 152: looprpc.ServerConnectionEvent
+	(*LndConnection)(nil),                  // 153: looprpc.LndConnection
+	(*LndConnectionEvent)(nil),             // 154: looprpc.LndConnectionEvent
+	(*StructuredServerMessage)(nil),        // 155: looprpc.StructuredServerMessage
+	(*RouteHint)(nil),                      // 156: looprpc.RouteHint
+}
+var file_client_proto_depIdxs = []int32{
+	155, // 0: looprpc.SwapResponse.structured_server_message:type_name -> looprpc.StructuredServerMessage
+	26,  // 1: looprpc.SwapResponse.htlc_addresses:type_name -> looprpc.HtlcAddresses
+	0,   // 2: looprpc.HtlcAddress.output_type:type_name -> looprpc.HtlcOutputType
+	25,  // 3: looprpc.HtlcAddresses.addresses:type_name -> looprpc.HtlcAddress
+	1,   // 4: looprpc.SwapStatus.type:type_name -> looprpc.SwapType
+	2,   // 5: looprpc.SwapStatus.state:type_name -> looprpc.SwapState
+	3,   // 6: looprpc.SwapStatus.failure_reason:type_name -> looprpc.FailureReason
+	155, // 7: looprpc.SwapStatus.structured_server_message:type_name -> looprpc.StructuredServerMessage
+	4,   // 8: looprpc.SwapStatus.health:type_name -> looprpc.SwapHealth
+	26,  // 9: looprpc.SwapStatus.htlc_addresses:type_name -> looprpc.HtlcAddresses
+	28,  // 10: looprpc.ListSwapsResponse.swaps:type_name -> looprpc.SwapStatus
+	156, // 11: looprpc.QuoteRequest.loop_in_route_hints:type_name -> looprpc.RouteHint
+	156, // 12: looprpc.ProbeRequest.route_hints:type_name -> looprpc.RouteHint
+	46,  // 13: looprpc.TokensResponse.tokens:type_name -> looprpc.LsatToken
+	50,  // 14: looprpc.LiquidityParameters.rules:type_name -> looprpc.LiquidityRule
+	6,   // 15: looprpc.LiquidityParameters.unrestricted_mode:type_name -> looprpc.UnrestrictedSwapMode
+	5,   // 16: looprpc.LiquidityParameters.priority:type_name -> looprpc.SuggestionPriority
+	49,  // 17: looprpc.LiquidityParameters.peer_weights:type_name -> looprpc.PeerWeight
+	7,   // 18: looprpc.LiquidityParameters.circular_mode:type_name -> looprpc.CircularMode
+	8,   // 19: looprpc.LiquidityRule.type:type_name -> looprpc.LiquidityRuleType
+	48,  // 20: looprpc.SetLiquidityParamsRequest.parameters:type_name -> looprpc.LiquidityParameters
+	50,  // 21: looprpc.SuggestSwapsRequest.rules:type_name -> looprpc.LiquidityRule
+	9,   // 22: looprpc.Disqualified.reason:type_name -> looprpc.AutoReason
+	22,  // 23: looprpc.SuggestSwapsResponse.loop_out:type_name -> looprpc.LoopOutRequest
+	54,  // 24: looprpc.SuggestSwapsResponse.disqualified:type_name -> looprpc.Disqualified
+	22,  // 25: looprpc.SuggestSwapsResponse.budget_elided:type_name -> looprpc.LoopOutRequest
+	66,  // 26: looprpc.SuggestSwapsResponse.circular_comparisons:type_name -> looprpc.CircularComparison
+	65,  // 27: looprpc.SuggestSwapsResponse.circular_rebalances:type_name -> looprpc.CircularRebalance
+	68,  // 28: looprpc.SuggestSwapsResponse.channel_comparisons:type_name -> looprpc.ChannelComparison
+	22,  // 29: looprpc.QuoteSuggestionsRequest.loop_out:type_name -> looprpc.LoopOutRequest
+	22,  // 30: looprpc.SuggestionQuote.loop_out:type_name -> looprpc.LoopOutRequest
+	57,  // 31: looprpc.QuoteSuggestionsResponse.quotes:type_name -> looprpc.SuggestionQuote
+	22,  // 32: looprpc.AppliedSuggestion.loop_out:type_name -> looprpc.LoopOutRequest
+	60,  // 33: looprpc.ApplySuggestionsResponse.swaps:type_name -> looprpc.AppliedSuggestion
+	63,  // 34: looprpc.ParameterAdviceResponse.advice:type_name -> looprpc.ParameterAdvice
+	22,  // 35: looprpc.CircularComparison.loop_out:type_name -> looprpc.LoopOutRequest
+	65,  // 36: looprpc.CircularComparison.rebalance:type_name -> looprpc.CircularRebalance
+	22,  // 37: looprpc.ChannelComparison.loop_out:type_name -> looprpc.LoopOutRequest
+	67,  // 38: looprpc.ChannelComparison.quotes:type_name -> looprpc.ChannelQuote
+	71,  // 39: looprpc.LiquiditySummary.targets:type_name -> looprpc.LiquidityTarget
+	1,   // 40: looprpc.SwapProof.type:type_name -> looprpc.SwapType
+	2,   // 41: looprpc.SwapProof.state:type_name -> looprpc.SwapState
+	3,   // 42: looprpc.SwapProof.failure_reason:type_name -> looprpc.FailureReason
+	74,  // 43: looprpc.SwapProof.transactions:type_name -> looprpc.SwapProofTransaction
+	77,  // 44: looprpc.PreviewSweepResponse.inputs:type_name -> looprpc.SweepInput
+	78,  // 45: looprpc.PreviewSweepResponse.outputs:type_name -> looprpc.SweepOutput
+	81,  // 46: looprpc.ListLiquiditySnapshotsResponse.snapshots:type_name -> looprpc.LiquiditySnapshot
+	88,  // 47: looprpc.LiquiditySnapshot.channels:type_name -> looprpc.ChannelBalanceSnapshot
+	84,  // 48: looprpc.ListFeeRecordsResponse.records:type_name -> looprpc.FeeRecord
+	10,  // 49: looprpc.FeeRecord.decision:type_name -> looprpc.FeeDecision
+	87,  // 50: looprpc.ListDispatchRecordsResponse.records:type_name -> looprpc.DispatchRecord
+	11,  // 51: looprpc.DispatchRecord.source:type_name -> looprpc.DispatchSource
+	50,  // 52: looprpc.DispatchRecord.rules:type_name -> looprpc.LiquidityRule
+	88,  // 53: looprpc.DispatchRecord.channels:type_name -> looprpc.ChannelBalanceSnapshot
+	12,  // 54: looprpc.CaptureProfileRequest.profile_types:type_name -> looprpc.ProfileType
+	1,   // 55: looprpc.SwapReservation.type:type_name -> looprpc.SwapType
+	155, // 56: looprpc.SwapReservation.structured_server_message:type_name -> looprpc.StructuredServerMessage
+	26,  // 57: looprpc.SwapReservation.htlc_addresses:type_name -> looprpc.HtlcAddresses
+	102, // 58: looprpc.ListPendingApprovalsResponse.approvals:type_name -> looprpc.SwapReservation
+	111, // 59: looprpc.ListSwapDenialsResponse.denials:type_name -> looprpc.SwapDenial
+	1,   // 60: looprpc.SwapDenial.type:type_name -> looprpc.SwapType
+	1,   // 61: looprpc.SwapTemplate.type:type_name -> looprpc.SwapType
+	112, // 62: looprpc.SetSwapTemplateRequest.template:type_name -> looprpc.SwapTemplate
+	112, // 63: looprpc.ListSwapTemplatesResponse.templates:type_name -> looprpc.SwapTemplate
+	112, // 64: looprpc.ResolveSwapTemplateResponse.template:type_name -> looprpc.SwapTemplate
+	13,  // 65: looprpc.FeeBudget.namespace:type_name -> looprpc.BudgetNamespace
+	121, // 66: looprpc.SetFeeBudgetRequest.budget:type_name -> looprpc.FeeBudget
+	121, // 67: looprpc.FeeBudgetStatus.budget:type_name -> looprpc.FeeBudget
+	125, // 68: looprpc.ListFeeBudgetsResponse.budgets:type_name -> looprpc.FeeBudgetStatus
+	13,  // 69: looprpc.DeleteFeeBudgetRequest.namespace:type_name -> looprpc.BudgetNamespace
+	13,  // 70: looprpc.CostStatementRequest.group_by:type_name -> looprpc.BudgetNamespace
+	130, // 71: looprpc.CostStatement.costs:type_name -> looprpc.NamespaceCost
+	14,  // 72: looprpc.DrainUpdate.state:type_name -> looprpc.DrainState
+	28,  // 73: looprpc.DrainUpdate.swap:type_name -> looprpc.SwapStatus
+	15,  // 74: looprpc.FillUpdate.state:type_name -> looprpc.FillState
+	28,  // 75: looprpc.FillUpdate.swap:type_name -> looprpc.SwapStatus
+	1,   // 76: looprpc.CreateSwapPlanRequest.type:type_name -> looprpc.SwapType
+	17,  // 77: looprpc.PlanStep.state:type_name -> looprpc.PlanStepState
+	1,   // 78: looprpc.SwapPlan.type:type_name -> looprpc.SwapType
+	16,  // 79: looprpc.SwapPlan.state:type_name -> looprpc.SwapPlanState
+	137, // 80: looprpc.SwapPlan.steps:type_name -> looprpc.PlanStep
+	138, // 81: looprpc.ListSwapPlansResponse.plans:type_name -> looprpc.SwapPlan
+	18,  // 82: looprpc.UpdateSwapPlanRequest.action:type_name -> looprpc.SwapPlanAction
+	19,  // 83: looprpc.ExternalLoopInUpdate.state:type_name -> looprpc.ExternalLoopInState
+	28,  // 84: looprpc.ExternalLoopInUpdate.swap:type_name -> looprpc.SwapStatus
+	146, // 85: looprpc.SwapStatsResponse.sla_stats:type_name -> looprpc.SwapSlaStats
+	1,   // 86: looprpc.SwapSlaStats.type:type_name -> looprpc.SwapType
+	147, // 87: looprpc.SwapSlaStats.phases:type_name -> looprpc.SwapPhaseDuration
+	151, // 88: looprpc.GetInfoResponse.server_connection:type_name -> looprpc.ServerConnection
+	153, // 89: looprpc.GetInfoResponse.lnd_connection:type_name -> looprpc.LndConnection
+	150, // 90: looprpc.GetInfoResponse.paths:type_name -> looprpc.DaemonPaths
+	20,  // 91: looprpc.ServerConnection.state:type_name -> looprpc.ServerConnectionState
+	152, // 92: looprpc.ServerConnection.events:type_name -> looprpc.ServerConnectionEvent
+	20,  // 93: looprpc.ServerConnectionEvent.state:type_name -> looprpc.ServerConnectionState
+	21,  // 94: looprpc.LndConnection.state:type_name -> looprpc.LndConnectionState
+	154, // 95: looprpc.LndConnection.events:type_name -> looprpc.LndConnectionEvent
+	21,  // 96: looprpc.LndConnectionEvent.state:type_name -> looprpc.LndConnectionState
+	22,  // 97: looprpc.SwapClient.LoopOut:input_type -> looprpc.LoopOutRequest
+	23,  // 98: looprpc.SwapClient.LoopIn:input_type -> looprpc.LoopInRequest
+	22,  // 99: looprpc.SwapClient.ReserveLoopOut:input_type -> looprpc.LoopOutRequest
+	23,  // 100: looprpc.SwapClient.ReserveLoopIn:input_type -> looprpc.LoopInRequest
+	103, // 101: looprpc.SwapClient.ConfirmReservation:input_type -> looprpc.ConfirmReservationRequest
+	104, // 102: looprpc.SwapClient.ListPendingApprovals:input_type -> looprpc.ListPendingApprovalsRequest
+	106, // 103: looprpc.SwapClient.ApproveSwap:input_type -> looprpc.ApproveSwapRequest
+	107, // 104: looprpc.SwapClient.DenySwap:input_type -> looprpc.DenySwapRequest
+	109, // 105: looprpc.SwapClient.ListSwapDenials:input_type -> looprpc.ListSwapDenialsRequest
+	27,  // 106: looprpc.SwapClient.Monitor:input_type -> looprpc.MonitorRequest
+	29,  // 107: looprpc.SwapClient.ListSwaps:input_type -> looprpc.ListSwapsRequest
+	31,  // 108: looprpc.SwapClient.SwapInfo:input_type -> looprpc.SwapInfoRequest
+	32,  // 109: looprpc.SwapClient.RedactSwap:input_type -> looprpc.RedactSwapRequest
+	34,  // 110: looprpc.SwapClient.GetStatusLink:input_type -> looprpc.StatusLinkRequest
+	148, // 111: looprpc.SwapClient.GetInfo:input_type -> looprpc.GetInfoRequest
+	144, // 112: looprpc.SwapClient.GetSwapStats:input_type -> looprpc.SwapStatsRequest
+	36,  // 113: looprpc.SwapClient.LoopOutTerms:input_type -> looprpc.TermsRequest
+	39,  // 114: looprpc.SwapClient.LoopOutQuote:input_type -> looprpc.QuoteRequest
+	36,  // 115: looprpc.SwapClient.GetLoopInTerms:input_type -> looprpc.TermsRequest
+	39,  // 116: looprpc.SwapClient.GetLoopInQuote:input_type -> looprpc.QuoteRequest
+	42,  // 117: looprpc.SwapClient.Probe:input_type -> looprpc.ProbeRequest
+	44,  // 118: looprpc.SwapClient.GetLsatTokens:input_type -> looprpc.TokensRequest
+	47,  // 119: looprpc.SwapClient.GetLiquidityParams:input_type -> looprpc.GetLiquidityParamsRequest
+	51,  // 120: looprpc.SwapClient.SetLiquidityParams:input_type -> looprpc.SetLiquidityParamsRequest
+	53,  // 121: looprpc.SwapClient.SuggestSwaps:input_type -> looprpc.SuggestSwapsRequest
+	56,  // 122: looprpc.SwapClient.QuoteSuggestions:input_type -> looprpc.QuoteSuggestionsRequest
+	59,  // 123: looprpc.SwapClient.ApplySuggestions:input_type -> looprpc.ApplySuggestionsRequest
+	62,  // 124: looprpc.SwapClient.GetParameterAdvice:input_type -> looprpc.ParameterAdviceRequest
+	72,  // 125: looprpc.SwapClient.GetSwapProof:input_type -> looprpc.SwapProofRequest
+	75,  // 126: looprpc.SwapClient.PreviewSweep:input_type -> looprpc.PreviewSweepRequest
+	69,  // 127: looprpc.SwapClient.GetLiquiditySummary:input_type -> looprpc.LiquiditySummaryRequest
+	79,  // 128: looprpc.SwapClient.ListLiquiditySnapshots:input_type -> looprpc.ListLiquiditySnapshotsRequest
+	82,  // 129: looprpc.SwapClient.ListFeeRecords:input_type -> looprpc.ListFeeRecordsRequest
+	85,  // 130: looprpc.SwapClient.ListDispatchRecords:input_type -> looprpc.ListDispatchRecordsRequest
+	89,  // 131: looprpc.SwapClient.DebugLevel:input_type -> looprpc.DebugLevelRequest
+	100, // 132: looprpc.SwapClient.CaptureProfile:input_type -> looprpc.CaptureProfileRequest
+	113, // 133: looprpc.SwapClient.SetSwapTemplate:input_type -> looprpc.SetSwapTemplateRequest
+	115, // 134: looprpc.SwapClient.ListSwapTemplates:input_type -> looprpc.ListSwapTemplatesRequest
+	117, // 135: looprpc.SwapClient.DeleteSwapTemplate:input_type -> looprpc.DeleteSwapTemplateRequest
+	119, // 136: looprpc.SwapClient.ResolveSwapTemplate:input_type -> looprpc.ResolveSwapTemplateRequest
+	122, // 137: looprpc.SwapClient.SetFeeBudget:input_type -> looprpc.SetFeeBudgetRequest
+	124, // 138: looprpc.SwapClient.ListFeeBudgets:input_type -> looprpc.ListFeeBudgetsRequest
+	127, // 139: looprpc.SwapClient.DeleteFeeBudget:input_type -> looprpc.DeleteFeeBudgetRequest
+	129, // 140: looprpc.SwapClient.GetCostStatement:input_type -> looprpc.CostStatementRequest
+	132, // 141: looprpc.SwapClient.DrainChannel:input_type -> looprpc.DrainChannelRequest
+	134, // 142: looprpc.SwapClient.FillChannel:input_type -> looprpc.FillChannelRequest
+	136, // 143: looprpc.SwapClient.CreateSwapPlan:input_type -> looprpc.CreateSwapPlanRequest
+	139, // 144: looprpc.SwapClient.ListSwapPlans:input_type -> looprpc.ListSwapPlansRequest
+	141, // 145: looprpc.SwapClient.UpdateSwapPlan:input_type -> looprpc.UpdateSwapPlanRequest
+	142, // 146: looprpc.SwapClient.ExternalLoopIn:input_type -> looprpc.ExternalLoopInRequest
+	91,  // 147: looprpc.SwapClient.ReloadConfig:input_type -> looprpc.ReloadConfigRequest
+	93,  // 148: looprpc.SwapClient.BakeTenantMacaroon:input_type -> looprpc.BakeTenantMacaroonRequest
+	95,  // 149: looprpc.SwapClient.SnapshotMissionControl:input_type -> looprpc.SnapshotMissionControlRequest
+	97,  // 150: looprpc.SwapClient.ResetMissionControl:input_type -> looprpc.ResetMissionControlRequest
+	99,  // 151: looprpc.SwapClient.RestoreMissionControl:input_type -> looprpc.RestoreMissionControlRequest
+	24,  // 152: looprpc.SwapClient.LoopOut:output_type -> looprpc.SwapResponse
+	24,  // 153: looprpc.SwapClient.LoopIn:output_type -> looprpc.SwapResponse
+	102, // 154: looprpc.SwapClient.ReserveLoopOut:output_type -> looprpc.SwapReservation
+	102, // 155: looprpc.SwapClient.ReserveLoopIn:output_type -> looprpc.SwapReservation
+	24,  // 156: looprpc.SwapClient.ConfirmReservation:output_type -> looprpc.SwapResponse
+	105, // 157: looprpc.SwapClient.ListPendingApprovals:output_type -> looprpc.ListPendingApprovalsResponse
+	24,  // 158: looprpc.SwapClient.ApproveSwap:output_type -> looprpc.SwapResponse
+	108, // 159: looprpc.SwapClient.DenySwap:output_type -> looprpc.DenySwapResponse
+	110, // 160: looprpc.SwapClient.ListSwapDenials:output_type -> looprpc.ListSwapDenialsResponse
+	28,  // 161: looprpc.SwapClient.Monitor:output_type -> looprpc.SwapStatus
+	30,  // 162: looprpc.SwapClient.ListSwaps:output_type -> looprpc.ListSwapsResponse
+	28,  // 163: looprpc.SwapClient.SwapInfo:output_type -> looprpc.SwapStatus
+	33,  // 164: looprpc.SwapClient.RedactSwap:output_type -> looprpc.RedactSwapResponse
+	35,  // 165: looprpc.SwapClient.GetStatusLink:output_type -> looprpc.StatusLinkResponse
+	149, // 166: looprpc.SwapClient.GetInfo:output_type -> looprpc.GetInfoResponse
+	145, // 167: looprpc.SwapClient.GetSwapStats:output_type -> looprpc.SwapStatsResponse
+	38,  // 168: looprpc.SwapClient.LoopOutTerms:output_type -> looprpc.OutTermsResponse
+	41,  // 169: looprpc.SwapClient.LoopOutQuote:output_type -> looprpc.OutQuoteResponse
+	37,  // 170: looprpc.SwapClient.GetLoopInTerms:output_type -> looprpc.InTermsResponse
+	40,  // 171: looprpc.SwapClient.GetLoopInQuote:output_type -> looprpc.InQuoteResponse
+	43,  // 172: looprpc.SwapClient.Probe:output_type -> looprpc.ProbeResponse
+	45,  // 173: looprpc.SwapClient.GetLsatTokens:output_type -> looprpc.TokensResponse
+	48,  // 174: looprpc.SwapClient.GetLiquidityParams:output_type -> looprpc.LiquidityParameters
+	52,  // 175: looprpc.SwapClient.SetLiquidityParams:output_type -> looprpc.SetLiquidityParamsResponse
+	55,  // 176: looprpc.SwapClient.SuggestSwaps:output_type -> looprpc.SuggestSwapsResponse
+	58,  // 177: looprpc.SwapClient.QuoteSuggestions:output_type -> looprpc.QuoteSuggestionsResponse
+	61,  // 178: looprpc.SwapClient.ApplySuggestions:output_type -> looprpc.ApplySuggestionsResponse
+	64,  // 179: looprpc.SwapClient.GetParameterAdvice:output_type -> looprpc.ParameterAdviceResponse
+	73,  // 180: looprpc.SwapClient.GetSwapProof:output_type -> looprpc.SwapProof
+	76,  // 181: looprpc.SwapClient.PreviewSweep:output_type -> looprpc.PreviewSweepResponse
+	70,  // 182: looprpc.SwapClient.GetLiquiditySummary:output_type -> looprpc.LiquiditySummary
+	80,  // 183: looprpc.SwapClient.ListLiquiditySnapshots:output_type -> looprpc.ListLiquiditySnapshotsResponse
+	83,  // 184: looprpc.SwapClient.ListFeeRecords:output_type -> looprpc.ListFeeRecordsResponse
+	86,  // 185: looprpc.SwapClient.ListDispatchRecords:output_type -> looprpc.ListDispatchRecordsResponse
+	90,  // 186: looprpc.SwapClient.DebugLevel:output_type -> looprpc.DebugLevelResponse
+	101, // 187: looprpc.SwapClient.CaptureProfile:output_type -> looprpc.CaptureProfileResponse
+	114, // 188: looprpc.SwapClient.SetSwapTemplate:output_type -> looprpc.SetSwapTemplateResponse
+	116, // 189: looprpc.SwapClient.ListSwapTemplates:output_type -> looprpc.ListSwapTemplatesResponse
+	118, // 190: looprpc.SwapClient.DeleteSwapTemplate:output_type -> looprpc.DeleteSwapTemplateResponse
+	120, // 191: looprpc.SwapClient.ResolveSwapTemplate:output_type -> looprpc.ResolveSwapTemplateResponse
+	123, // 192: looprpc.SwapClient.SetFeeBudget:output_type -> looprpc.SetFeeBudgetResponse
+	126, // 193: looprpc.SwapClient.ListFeeBudgets:output_type -> looprpc.ListFeeBudgetsResponse
+	128, // 194: looprpc.SwapClient.DeleteFeeBudget:output_type -> looprpc.DeleteFeeBudgetResponse
+	131, // 195: looprpc.SwapClient.GetCostStatement:output_type -> looprpc.CostStatement
+	133, // 196: looprpc.SwapClient.DrainChannel:output_type -> looprpc.DrainUpdate
+	135, // 197: looprpc.SwapClient.FillChannel:output_type -> looprpc.FillUpdate
+	138, // 198: looprpc.SwapClient.CreateSwapPlan:output_type -> looprpc.SwapPlan
+	140, // 199: looprpc.SwapClient.ListSwapPlans:output_type -> looprpc.ListSwapPlansResponse
+	138, // 200: looprpc.SwapClient.UpdateSwapPlan:output_type -> looprpc.SwapPlan
+	143, // 201: looprpc.SwapClient.ExternalLoopIn:output_type -> looprpc.ExternalLoopInUpdate
+	92,  // 202: looprpc.SwapClient.ReloadConfig:output_type -> looprpc.ReloadConfigResponse
+	94,  // 203: looprpc.SwapClient.BakeTenantMacaroon:output_type -> looprpc.BakeTenantMacaroonResponse
+	96,  // 204: looprpc.SwapClient.SnapshotMissionControl:output_type -> looprpc.MissionControlSnapshot
+	98,  // 205: looprpc.SwapClient.ResetMissionControl:output_type -> looprpc.ResetMissionControlResponse
+	96,  // 206: looprpc.SwapClient.RestoreMissionControl:output_type -> looprpc.MissionControlSnapshot
+	152, // [152:207] is the sub-list for method output_type
+	97,  // [97:152] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
+}
+
+func init() { file_client_proto_init() }
+func file_client_proto_init() {
+	if File_client_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_client_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoopOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoopInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HtlcAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HtlcAddresses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedactSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedactSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TermsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InTermsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutTermsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsatToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLiquidityParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerWeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLiquidityParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLiquidityParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Disqualified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteSuggestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestionQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteSuggestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySuggestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppliedSuggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySuggestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParameterAdviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParameterAdvice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParameterAdviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircularRebalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircularComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquiditySummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquiditySummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapProofTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewSweepRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewSweepResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLiquiditySnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLiquiditySnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquiditySnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeeRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeeRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDispatchRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDispatchRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelBalanceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeTenantMacaroonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeTenantMacaroonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissionControlSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapReservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingApprovalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingApprovalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenySwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenySwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapDenialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapDenialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapDenial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSwapTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSwapTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSwapTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSwapTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveSwapTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveSwapTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeeBudgetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeeBudgetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeeBudgetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeBudgetStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeeBudgetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SuggestSwapsResponse.ProtoReflect.Descriptor instead.
-func (*SuggestSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *SuggestSwapsResponse) GetLoopOut() []*LoopOutRequest {
-	if x != nil {
-		return x.LoopOut
-	}
-	return nil
-}
-
-func (x *SuggestSwapsResponse) GetDisqualified() []*Disqualified {
-	if x != nil {
-		return x.Disqualified
-	}
-	return nil
-}
-
-var File_client_proto protoreflect.FileDescriptor
-
-var file_client_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x04, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x2f,
-	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61,
-	0x78, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12,
-	0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53,
-	0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
-	0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x12, 0x22, 0x0a, 0x0d,
-	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e,
-	0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2a,
-	0x0a, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f,
-	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77,
-	0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x85, 0x02, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
-	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
-	0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x74, 0x6c, 0x63, 0x12,
-	0x28, 0x0a, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x68, 0x74, 0x6c, 0x63, 0x43,
-	0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xe9, 0x01,
-	0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a,
-	0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x70,
-	0x32, 0x77, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x32, 0x77,
-	0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb4, 0x04, 0x0a, 0x0a,
-	0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x12, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0e,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
-	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25,
-	0x0a, 0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x32,
-	0x77, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x70, 0x32,
-	0x77, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x73, 0x74,
-	0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x73, 0x74, 0x5f,
-	0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x63, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
-	0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73,
-	0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x21, 0x0a, 0x0f, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x65, 0x72,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x49, 0x6e, 0x54,
-	0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f,
-	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d,
-	0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01,
-	0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04,
-	0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x4f,
-	0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61,
-	0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73,
-	0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74,
-	0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x74, 0x76,
-	0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x74,
-	0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d,
-	0x61, 0x78, 0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x01, 0x10,
-	0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08,
-	0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0x8e, 0x02, 0x0a, 0x0c, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x74,
-	0x6c, 0x63, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x27,
-	0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68,
-	0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
-	0x4c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x41, 0x0a, 0x13, 0x6c, 0x6f, 0x6f, 0x70, 0x5f,
-	0x69, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x49,
-	0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74,
-	0x12, 0x2f, 0x0a, 0x14, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
-	0x68, 0x74, 0x6c, 0x63, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x65, 0x65, 0x53, 0x61,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xf3, 0x01,
-	0x0a, 0x10, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65,
-	0x65, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x61,
-	0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72,
-	0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x68, 0x74,
-	0x6c, 0x63, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68, 0x74, 0x6c, 0x63, 0x53, 0x77, 0x65, 0x65,
-	0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x5f,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74,
-	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c,
-	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x22, 0x70, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70,
-	0x12, 0x33, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x48, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x09, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x61, 0x63, 0x61,
-	0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65,
-	0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74,
-	0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f,
-	0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x4d,
-	0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xe0, 0x05, 0x0a, 0x13, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70,
-	0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12,
-	0x3d, 0x0a, 0x1c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x27,
-	0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70,
-	0x70, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
-	0x70, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72,
-	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
-	0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
-	0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65,
-	0x5f, 0x70, 0x70, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50,
-	0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50,
-	0x70, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79,
-	0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50,
-	0x72, 0x65, 0x70, 0x61, 0x79, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
-	0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x2e, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f,
-	0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x66, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x61,
-	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73,
-	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
-	0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x61,
-	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d,
-	0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69,
-	0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
-	0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
-	0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12,
-	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
-	0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f,
-	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
-	0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x65,
-	0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77,
-	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x0c, 0x44, 0x69,
-	0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x85,
-	0x01, 0x0a, 0x14, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f,
-	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x64,
-	0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x71,
-	0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61,
-	0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2a, 0x25, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x73, 0x0a,
-	0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e,
-	0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x45,
-	0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48,
-	0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
-	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a,
-	0x0f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44,
-	0x10, 0x05, 0x2a, 0xed, 0x01, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f,
-	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1b, 0x0a,
-	0x17, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x4f, 0x46, 0x46, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x41,
-	0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
-	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x41, 0x49, 0x4c,
-	0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46,
-	0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x04, 0x12,
-	0x1c, 0x0a, 0x18, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x10, 0x05, 0x12, 0x23, 0x0a,
-	0x1f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54,
-	0x10, 0x06, 0x2a, 0x2f, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52,
-	0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c,
-	0x44, 0x10, 0x01, 0x2a, 0xa6, 0x03, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x41,
-	0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45,
-	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
-	0x57, 0x45, 0x45, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x53, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x41,
-	0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45,
-	0x54, 0x5f, 0x45, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x41,
-	0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c,
-	0x49, 0x47, 0x48, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x05,
-	0x12, 0x19, 0x0a, 0x15, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x4d, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x41,
-	0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41,
-	0x59, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4f,
-	0x46, 0x46, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
-	0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x09, 0x12, 0x17,
-	0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f,
-	0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x0a, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x4f, 0x5f,
-	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59,
-	0x5f, 0x4f, 0x4b, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45,
-	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x55,
-	0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55,
-	0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x4e,
-	0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0d, 0x32, 0xc2, 0x07, 0x0a,
-	0x0a, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x4c,
-	0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
-	0x12, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x39, 0x0a, 0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
-	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
-	0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f,
-	0x70, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x54, 0x65,
-	0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x4c,
-	0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12,
-	0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x15, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
-	0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53,
-	0x77, 0x61, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x6f,
-	0x6f, 0x70, 0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
-}
-
-var (
-	file_client_proto_rawDescOnce sync.Once
-	file_client_proto_rawDescData = file_client_proto_rawDesc
-)
-
-func file_client_proto_rawDescGZIP() []byte {
-	file_client_proto_rawDescOnce.Do(func() {
-		file_client_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_proto_rawDescData)
-	})
-	return file_client_proto_rawDescData
-}
-
-var file_client_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_client_proto_goTypes = []interface{}{
-	(SwapType)(0),                      // 0: looprpc.SwapType
-	(SwapState)(0),                     // 1: looprpc.SwapState
-	(FailureReason)(0),                 // 2: looprpc.FailureReason
-	(LiquidityRuleType)(0),             // 3: looprpc.LiquidityRuleType
-	(AutoReason)(0),                    // 4: looprpc.AutoReason
-	(*LoopOutRequest)(nil),             // 5: looprpc.LoopOutRequest
-	(*LoopInRequest)(nil),              // 6: looprpc.LoopInRequest
-	(*SwapResponse)(nil),               // 7: looprpc.SwapResponse
-	(*MonitorRequest)(nil),             // 8: looprpc.MonitorRequest
-	(*SwapStatus)(nil),                 // 9: looprpc.SwapStatus
-	(*ListSwapsRequest)(nil),           // 10: looprpc.ListSwapsRequest
-	(*ListSwapsResponse)(nil),          // 11: looprpc.ListSwapsResponse
-	(*SwapInfoRequest)(nil),            // 12: looprpc.SwapInfoRequest
-	(*TermsRequest)(nil),               // 13: looprpc.TermsRequest
-	(*InTermsResponse)(nil),            // 14: looprpc.InTermsResponse
-	(*OutTermsResponse)(nil),           // 15: looprpc.OutTermsResponse
-	(*QuoteRequest)(nil),               // 16: looprpc.QuoteRequest
-	(*InQuoteResponse)(nil),            // 17: looprpc.InQuoteResponse
-	(*OutQuoteResponse)(nil),           // 18: looprpc.OutQuoteResponse
-	(*ProbeRequest)(nil),               // 19: looprpc.ProbeRequest
-	(*ProbeResponse)(nil),              // 20: looprpc.ProbeResponse
-	(*TokensRequest)(nil),              // 21: looprpc.TokensRequest
-	(*TokensResponse)(nil),             // 22: looprpc.TokensResponse
-	(*LsatToken)(nil),                  // 23: looprpc.LsatToken
-	(*GetLiquidityParamsRequest)(nil),  // 24: looprpc.GetLiquidityParamsRequest
-	(*LiquidityParameters)(nil),        // 25: looprpc.LiquidityParameters
-	(*LiquidityRule)(nil),              // 26: looprpc.LiquidityRule
-	(*SetLiquidityParamsRequest)(nil),  // 27: looprpc.SetLiquidityParamsRequest
-	(*SetLiquidityParamsResponse)(nil), // 28: looprpc.SetLiquidityParamsResponse
-	(*SuggestSwapsRequest)(nil),        // 29: looprpc.SuggestSwapsRequest
-	(*Disqualified)(nil),               // 30: looprpc.Disqualified
-	(*SuggestSwapsResponse)(nil),       // 31: looprpc.SuggestSwapsResponse
-	(*RouteHint)(nil),                  // 32: looprpc.RouteHint
-}
-var file_client_proto_depIdxs = []int32{
-	0,  // 0: looprpc.SwapStatus.type:type_name -> looprpc.SwapType
-	1,  // 1: looprpc.SwapStatus.state:type_name -> looprpc.SwapState
-	2,  // 2: looprpc.SwapStatus.failure_reason:type_name -> looprpc.FailureReason
-	9,  // 3: looprpc.ListSwapsResponse.swaps:type_name -> looprpc.SwapStatus
-	32, // 4: looprpc.QuoteRequest.loop_in_route_hints:type_name -> looprpc.RouteHint
-	32, // 5: looprpc.ProbeRequest.route_hints:type_name -> looprpc.RouteHint
-	23, // 6: looprpc.TokensResponse.tokens:type_name -> looprpc.LsatToken
-	26, // 7: looprpc.LiquidityParameters.rules:type_name -> looprpc.LiquidityRule
-	3,  // 8: looprpc.LiquidityRule.type:type_name -> looprpc.LiquidityRuleType
-	25, // 9: looprpc.SetLiquidityParamsRequest.parameters:type_name -> looprpc.LiquidityParameters
-	4,  // 10: looprpc.Disqualified.reason:type_name -> looprpc.AutoReason
-	5,  // 11: looprpc.SuggestSwapsResponse.loop_out:type_name -> looprpc.LoopOutRequest
-	30, // 12: looprpc.SuggestSwapsResponse.disqualified:type_name -> looprpc.Disqualified
-	5,  // 13: looprpc.SwapClient.LoopOut:input_type -> looprpc.LoopOutRequest
-	6,  // 14: looprpc.SwapClient.LoopIn:input_type -> looprpc.LoopInRequest
-	8,  // 15: looprpc.SwapClient.Monitor:input_type -> looprpc.MonitorRequest
-	10, // 16: looprpc.SwapClient.ListSwaps:input_type -> looprpc.ListSwapsRequest
-	12, // 17: looprpc.SwapClient.SwapInfo:input_type -> looprpc.SwapInfoRequest
-	13, // 18: looprpc.SwapClient.LoopOutTerms:input_type -> looprpc.TermsRequest
-	16, // 19: looprpc.SwapClient.LoopOutQuote:input_type -> looprpc.QuoteRequest
-	13, // 20: looprpc.SwapClient.GetLoopInTerms:input_type -> looprpc.TermsRequest
-	16, // 21: looprpc.SwapClient.GetLoopInQuote:input_type -> looprpc.QuoteRequest
-	19, // 22: looprpc.SwapClient.Probe:input_type -> looprpc.ProbeRequest
-	21, // 23: looprpc.SwapClient.GetLsatTokens:input_type -> looprpc.TokensRequest
-	24, // 24: looprpc.SwapClient.GetLiquidityParams:input_type -> looprpc.GetLiquidityParamsRequest
-	27, // 25: looprpc.SwapClient.SetLiquidityParams:input_type -> looprpc.SetLiquidityParamsRequest
-	29, // 26: looprpc.SwapClient.SuggestSwaps:input_type -> looprpc.SuggestSwapsRequest
-	7,  // 27: looprpc.SwapClient.LoopOut:output_type -> looprpc.SwapResponse
-	7,  // 28: looprpc.SwapClient.LoopIn:output_type -> looprpc.SwapResponse
-	9,  // 29: looprpc.SwapClient.Monitor:output_type -> looprpc.SwapStatus
-	11, // 30: looprpc.SwapClient.ListSwaps:output_type -> looprpc.ListSwapsResponse
-	9,  // 31: looprpc.SwapClient.SwapInfo:output_type -> looprpc.SwapStatus
-	15, // 32: looprpc.SwapClient.LoopOutTerms:output_type -> looprpc.OutTermsResponse
-	18, // 33: looprpc.SwapClient.LoopOutQuote:output_type -> looprpc.OutQuoteResponse
-	14, // 34: looprpc.SwapClient.GetLoopInTerms:output_type -> looprpc.InTermsResponse
-	17, // 35: looprpc.SwapClient.GetLoopInQuote:output_type -> looprpc.InQuoteResponse
-	20, // 36: looprpc.SwapClient.Probe:output_type -> looprpc.ProbeResponse
-	22, // 37: looprpc.SwapClient.GetLsatTokens:output_type -> looprpc.TokensResponse
-	25, // 38: looprpc.SwapClient.GetLiquidityParams:output_type -> looprpc.LiquidityParameters
-	28, // 39: looprpc.SwapClient.SetLiquidityParams:output_type -> looprpc.SetLiquidityParamsResponse
-	31, // 40: looprpc.SwapClient.SuggestSwaps:output_type -> looprpc.SuggestSwapsResponse
-	27, // [27:41] is the sub-list for method output_type
-	13, // [13:27] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
-}
-
-func init() { file_client_proto_init() }
-func file_client_proto_init() {
-	if File_client_proto != nil {
-		return
-	}
-	file_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_client_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoopOutRequest); i {
+		file_client_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFeeBudgetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3181,8 +15398,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoopInRequest); i {
+		file_client_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFeeBudgetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3193,8 +15410,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapResponse); i {
+		file_client_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CostStatementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3205,8 +15422,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorRequest); i {
+		file_client_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceCost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3217,8 +15434,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapStatus); i {
+		file_client_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CostStatement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3229,8 +15446,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsRequest); i {
+		file_client_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3241,8 +15458,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsResponse); i {
+		file_client_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3253,8 +15470,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapInfoRequest); i {
+		file_client_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3265,8 +15482,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TermsRequest); i {
+		file_client_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3277,8 +15494,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InTermsResponse); i {
+		file_client_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSwapPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3289,8 +15506,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutTermsResponse); i {
+		file_client_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3301,8 +15518,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteRequest); i {
+		file_client_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapPlan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3313,8 +15530,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InQuoteResponse); i {
+		file_client_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapPlansRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3325,8 +15542,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutQuoteResponse); i {
+		file_client_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapPlansResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3337,8 +15554,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProbeRequest); i {
+		file_client_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSwapPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3349,8 +15566,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProbeResponse); i {
+		file_client_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalLoopInRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3361,8 +15578,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensRequest); i {
+		file_client_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalLoopInUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3373,8 +15590,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensResponse); i {
+		file_client_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3385,8 +15602,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsatToken); i {
+		file_client_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3397,8 +15614,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLiquidityParamsRequest); i {
+		file_client_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapSlaStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3409,8 +15626,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LiquidityParameters); i {
+		file_client_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapPhaseDuration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3421,8 +15638,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LiquidityRule); i {
+		file_client_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3433,8 +15650,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLiquidityParamsRequest); i {
+		file_client_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3445,8 +15662,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLiquidityParamsResponse); i {
+		file_client_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DaemonPaths); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3457,8 +15674,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestSwapsRequest); i {
+		file_client_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConnection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3469,8 +15686,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Disqualified); i {
+		file_client_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConnectionEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3481,8 +15698,20 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestSwapsResponse); i {
+		file_client_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LndConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LndConnectionEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,8 +15728,8 @@ func file_client_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_client_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   27,
+			NumEnums:      22,
+			NumMessages:   133,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
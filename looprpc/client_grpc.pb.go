@@ -30,6 +30,40 @@ type SwapClientClient interface {
 	//point onwards, progress can be tracked via the SwapStatus stream
 	//that is returned from Monitor().
 	LoopIn(ctx context.Context, in *LoopInRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	// loop: `out --reserve`
+	//ReserveLoopOut sets up a loop out swap with the swap server and validates
+	//it, returning the swap's final fees and htlc details without executing
+	//it. The swap is only executed once it is confirmed with
+	//ConfirmReservation before the reservation expires.
+	ReserveLoopOut(ctx context.Context, in *LoopOutRequest, opts ...grpc.CallOption) (*SwapReservation, error)
+	// loop: `in --reserve`
+	//ReserveLoopIn sets up a loop in swap with the swap server and validates
+	//it, returning the swap's final fees and htlc details without executing
+	//it. The swap is only executed once it is confirmed with
+	//ConfirmReservation before the reservation expires.
+	ReserveLoopIn(ctx context.Context, in *LoopInRequest, opts ...grpc.CallOption) (*SwapReservation, error)
+	// loop: `confirmswap`
+	//ConfirmReservation executes a swap that was reserved with ReserveLoopOut
+	//or ReserveLoopIn. From that point onwards, progress can be tracked via the
+	//SwapStatus stream that is returned from Monitor().
+	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	// loop: `approvals list`
+	//ListPendingApprovals returns all swaps that exceed the daemon's approval
+	//threshold and are awaiting approval.
+	ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error)
+	// loop: `approvals approve`
+	//ApproveSwap executes a swap that is awaiting approval. From that point
+	//onwards, progress can be tracked via the SwapStatus stream that is
+	//returned from Monitor().
+	ApproveSwap(ctx context.Context, in *ApproveSwapRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	// loop: `approvals deny`
+	//DenySwap cancels a swap that is awaiting approval, and records who denied
+	//it and why.
+	DenySwap(ctx context.Context, in *DenySwapRequest, opts ...grpc.CallOption) (*DenySwapResponse, error)
+	// loop: `approvals denials`
+	//ListSwapDenials returns the swaps that exceeded the daemon's approval
+	//threshold, but were denied or expired instead of being approved.
+	ListSwapDenials(ctx context.Context, in *ListSwapDenialsRequest, opts ...grpc.CallOption) (*ListSwapDenialsResponse, error)
 	// loop: `monitor`
 	//Monitor will return a stream of swap updates for currently active swaps.
 	Monitor(ctx context.Context, in *MonitorRequest, opts ...grpc.CallOption) (SwapClient_MonitorClient, error)
@@ -40,6 +74,30 @@ type SwapClientClient interface {
 	// loop: `swapinfo`
 	//SwapInfo returns all known details about a single swap.
 	SwapInfo(ctx context.Context, in *SwapInfoRequest, opts ...grpc.CallOption) (*SwapStatus, error)
+	// loop: `redact`
+	//RedactSwap removes the metadata that may identify the people behind a swap
+	//in a final state, for operators with data retention policies. The label,
+	//request id and approver identity of the swap are removed, while its
+	//amounts, fees, transactions and tenant are kept. Labels that loopd set
+	//itself, such as those of automatically dispatched swaps, are kept.
+	RedactSwap(ctx context.Context, in *RedactSwapRequest, opts ...grpc.CallOption) (*RedactSwapResponse, error)
+	// loop: `statuslink`
+	//GetStatusLink mints a signed, expiring token that grants read-only access
+	//to the status of a single swap over the REST proxy, without a macaroon.
+	//The token is used in the path returned, which may be shared with anyone
+	//who should be able to follow the swap. Only the swap's state, amount,
+	//timing, health and htlc transaction are exposed through the link. All
+	//links are revoked by deleting the statuslink.key file in loopd's data
+	//directory and restarting loopd.
+	GetStatusLink(ctx context.Context, in *StatusLinkRequest, opts ...grpc.CallOption) (*StatusLinkResponse, error)
+	// loop: `getinfo`
+	//GetInfo returns basic information about the daemon, including the health
+	//of its connection to the swap server.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// loop: `stats`
+	//GetSwapStats returns the number of swaps that succeeded and failed, and
+	//the cumulative amount of prepays that were lost to failed swaps.
+	GetSwapStats(ctx context.Context, in *SwapStatsRequest, opts ...grpc.CallOption) (*SwapStatsResponse, error)
 	// loop: `terms`
 	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
 	LoopOutTerms(ctx context.Context, in *TermsRequest, opts ...grpc.CallOption) (*OutTermsResponse, error)
@@ -77,6 +135,166 @@ type SwapClientClient interface {
 	//Note that only loop out suggestions are currently supported.
 	//[EXPERIMENTAL]: endpoint is subject to change.
 	SuggestSwaps(ctx context.Context, in *SuggestSwapsRequest, opts ...grpc.CallOption) (*SuggestSwapsResponse, error)
+	// loop: `quotesuggestions`
+	//QuoteSuggestions returns the current quote for each loop out in a bundle of
+	//suggested swaps, along with the total amount and fees of the bundle, so
+	//that the cost of a batch can be reviewed before it is dispatched. If no
+	//loop outs are provided, the loop outs that are currently suggested are
+	//quoted.
+	QuoteSuggestions(ctx context.Context, in *QuoteSuggestionsRequest, opts ...grpc.CallOption) (*QuoteSuggestionsResponse, error)
+	// loop: `applysuggestions`
+	//ApplySuggestions dispatches a selected subset of the current loop out
+	//suggestions, with the same parameters that autoloop would dispatch them
+	//with. Suggestions are selected by their index in the output of
+	//SuggestSwaps, or by the channels that they use. Suggestions can only be
+	//applied while autoloop is disabled.
+	ApplySuggestions(ctx context.Context, in *ApplySuggestionsRequest, opts ...grpc.CallOption) (*ApplySuggestionsResponse, error)
+	// loop: `paramadvice`
+	//GetParameterAdvice analyzes the outcomes of recent swaps and the chain fee
+	//estimates that were recorded at fee decisions, and returns recommended
+	//changes to the liquidity manager's parameters. Advice is never applied
+	//automatically.
+	GetParameterAdvice(ctx context.Context, in *ParameterAdviceRequest, opts ...grpc.CallOption) (*ParameterAdviceResponse, error)
+	// loop: `swapproof`
+	//GetSwapProof returns all of the data that is required to independently
+	//verify a completed swap on chain and off chain. This includes the swap
+	//invoices, preimage, htlc script and the on chain transactions that our
+	//wallet published for the swap, which contain our signatures.
+	GetSwapProof(ctx context.Context, in *SwapProofRequest, opts ...grpc.CallOption) (*SwapProof, error)
+	// loop: `previewsweep`
+	//PreviewSweep returns the transaction that a pending loop out would sweep
+	//its htlc with if it swept now, along with its fee, weight and fee rate.
+	//Nothing is published, and the state of the swap is left unchanged.
+	PreviewSweep(ctx context.Context, in *PreviewSweepRequest, opts ...grpc.CallOption) (*PreviewSweepResponse, error)
+	// loop: `liquiditysummary`
+	//GetLiquiditySummary returns a summary of the node's liquidity, including
+	//funds that are in transit due to swaps.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetLiquiditySummary(ctx context.Context, in *LiquiditySummaryRequest, opts ...grpc.CallOption) (*LiquiditySummary, error)
+	// loop: `liquiditysnapshots`
+	//ListLiquiditySnapshots returns the snapshots of the node's balances that
+	//have been recorded within a time range.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListLiquiditySnapshots(ctx context.Context, in *ListLiquiditySnapshotsRequest, opts ...grpc.CallOption) (*ListLiquiditySnapshotsResponse, error)
+	// loop: `feerecords`
+	//ListFeeRecords returns the chain fee estimates that loopd observed when it
+	//made fee sensitive decisions within a time range, along with the fee rates
+	//that the resulting transactions confirmed with.
+	ListFeeRecords(ctx context.Context, in *ListFeeRecordsRequest, opts ...grpc.CallOption) (*ListFeeRecordsResponse, error)
+	// loop: `dispatchrecords`
+	//ListDispatchRecords returns a record of each suggested swap that the
+	//liquidity manager dispatched within a time range, linking the swap to the
+	//rules that it was suggested for and to the suggestion that was made.
+	ListDispatchRecords(ctx context.Context, in *ListDispatchRecordsRequest, opts ...grpc.CallOption) (*ListDispatchRecordsResponse, error)
+	// loop: `debuglevel`
+	//DebugLevel sets the log level of all subsystems, or of individual
+	//subsystems, at runtime. It may also be used to list the subsystems that
+	//are available.
+	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// loop: `captureprofile`
+	//CaptureProfile writes snapshots of the daemon's goroutines and heap to
+	//files in its data directory, so that a running daemon can be inspected
+	//without enabling its profiling listener.
+	CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*CaptureProfileResponse, error)
+	// loop: `template set`
+	//SetSwapTemplate stores a named swap configuration, replacing any existing
+	//template with the same name. Templates can be dispatched by name with
+	//`loop run`.
+	SetSwapTemplate(ctx context.Context, in *SetSwapTemplateRequest, opts ...grpc.CallOption) (*SetSwapTemplateResponse, error)
+	// loop: `template list`
+	//ListSwapTemplates returns all stored swap templates.
+	ListSwapTemplates(ctx context.Context, in *ListSwapTemplatesRequest, opts ...grpc.CallOption) (*ListSwapTemplatesResponse, error)
+	// loop: `template delete`
+	//DeleteSwapTemplate deletes the swap template with the name provided.
+	DeleteSwapTemplate(ctx context.Context, in *DeleteSwapTemplateRequest, opts ...grpc.CallOption) (*DeleteSwapTemplateResponse, error)
+	// loop: `run`
+	//ResolveSwapTemplate returns the swap template with the name provided, with
+	//the amount of templates that set a target local balance calculated from
+	//our current channel balances.
+	ResolveSwapTemplate(ctx context.Context, in *ResolveSwapTemplateRequest, opts ...grpc.CallOption) (*ResolveSwapTemplateResponse, error)
+	// loop: `budget set`
+	//SetFeeBudget caps the fees that the swaps of a tenant or label may spend,
+	//replacing any existing budget for the same tenant or label. Swaps whose
+	//maximum fees would exceed a budget that applies to them are rejected.
+	SetFeeBudget(ctx context.Context, in *SetFeeBudgetRequest, opts ...grpc.CallOption) (*SetFeeBudgetResponse, error)
+	// loop: `budget list`
+	//ListFeeBudgets returns all fee budgets, along with the fees that have been
+	//spent from them.
+	ListFeeBudgets(ctx context.Context, in *ListFeeBudgetsRequest, opts ...grpc.CallOption) (*ListFeeBudgetsResponse, error)
+	// loop: `budget delete`
+	//DeleteFeeBudget deletes the fee budget of a tenant or label.
+	DeleteFeeBudget(ctx context.Context, in *DeleteFeeBudgetRequest, opts ...grpc.CallOption) (*DeleteFeeBudgetResponse, error)
+	// loop: `budget statement`
+	//GetCostStatement returns the amounts and costs of swaps, aggregated by
+	//tenant or label. In multi-tenant mode, tenants only receive the costs of
+	//their own swaps.
+	GetCostStatement(ctx context.Context, in *CostStatementRequest, opts ...grpc.CallOption) (*CostStatement, error)
+	// loop: `drain`
+	//DrainChannel loops out as much of the local balance of a channel as
+	//possible, dispatching one swap at a time until the channel cannot carry
+	//another swap. If requested, the channel is then closed cooperatively if its
+	//local balance has fallen below a threshold. An update is streamed after
+	//each swap completes. If the stream is cancelled, no further swaps are
+	//dispatched, but a swap that is in flight continues.
+	DrainChannel(ctx context.Context, in *DrainChannelRequest, opts ...grpc.CallOption) (SwapClient_DrainChannelClient, error)
+	// loop: `fill`
+	//FillChannel brings the balance of a channel to a target split between its
+	//local and remote sides, looping out over the channel while its local
+	//balance is above the target and looping in through its peer while it is
+	//below. If a channel point is provided instead of a channel id, the channel
+	//is waited for until it is open and active, so that a channel can be filled
+	//as soon as it is opened. An update is streamed after each swap completes.
+	//If the stream is cancelled, no further swaps are dispatched, but a swap
+	//that is in flight continues.
+	FillChannel(ctx context.Context, in *FillChannelRequest, opts ...grpc.CallOption) (SwapClient_FillChannelClient, error)
+	// loop: `plan create`
+	//CreateSwapPlan splits a liquidity change that is too large for a single
+	//swap into a sequence of swaps that are dispatched over time. The number of
+	//swaps is chosen to minimize the fees that are quoted for them while
+	//keeping them within the plan's fee budget. Plans are created paused unless
+	//they are started on creation.
+	CreateSwapPlan(ctx context.Context, in *CreateSwapPlanRequest, opts ...grpc.CallOption) (*SwapPlan, error)
+	// loop: `plan list`
+	//ListSwapPlans returns all swap plans along with the state of their steps.
+	ListSwapPlans(ctx context.Context, in *ListSwapPlansRequest, opts ...grpc.CallOption) (*ListSwapPlansResponse, error)
+	// loop: `plan resume`, `plan pause`, `plan cancel`
+	//UpdateSwapPlan resumes, pauses or cancels a swap plan. Pausing or
+	//cancelling a plan stops further steps from being dispatched, but a swap
+	//that is in flight continues. Resuming a failed plan retries its failed
+	//step.
+	UpdateSwapPlan(ctx context.Context, in *UpdateSwapPlanRequest, opts ...grpc.CallOption) (*SwapPlan, error)
+	// loop: `externalin`
+	//ExternalLoopIn initiates a loop in swap that is funded by an external
+	//wallet. It first streams the htlc address and exact amount that the wallet
+	//must pay, along with a BIP21 payment uri that may be shown as a QR code. It
+	//then streams an update when the payment confirms on chain, and a final
+	//update when the swap completes or fails.
+	ExternalLoopIn(ctx context.Context, in *ExternalLoopInRequest, opts ...grpc.CallOption) (SwapClient_ExternalLoopInClient, error)
+	// loop: `reloadconfig`
+	//ReloadConfig reads the daemon's configuration file and command line
+	//flags again, and applies the options that can be changed without a
+	//restart. Other options are left unchanged until the daemon is restarted.
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	// loop: `tenant bake`
+	//BakeTenantMacaroon bakes a macaroon for a new tenant in multi-tenant
+	//mode. Every macaroon that is baked has a new identity, and is therefore a
+	//separate tenant that is restricted to the swap, quote and terms RPCs.
+	//This call is only available to the operator.
+	BakeTenantMacaroon(ctx context.Context, in *BakeTenantMacaroonRequest, opts ...grpc.CallOption) (*BakeTenantMacaroonResponse, error)
+	// loop: `missioncontrol snapshot`
+	//SnapshotMissionControl saves a snapshot of lnd's mission control, which
+	//can be restored with RestoreMissionControl. Any existing snapshot is
+	//replaced.
+	SnapshotMissionControl(ctx context.Context, in *SnapshotMissionControlRequest, opts ...grpc.CallOption) (*MissionControlSnapshot, error)
+	// loop: `missioncontrol reset`
+	//ResetMissionControl clears all of lnd's mission control state, so that
+	//past payment results no longer affect the routes that lnd tries.
+	ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error)
+	// loop: `missioncontrol restore`
+	//RestoreMissionControl resets lnd's mission control and restores the
+	//snapshot saved by SnapshotMissionControl or before a batch of automated
+	//swaps was dispatched.
+	RestoreMissionControl(ctx context.Context, in *RestoreMissionControlRequest, opts ...grpc.CallOption) (*MissionControlSnapshot, error)
 }
 
 type swapClientClient struct {
@@ -105,6 +323,69 @@ func (c *swapClientClient) LoopIn(ctx context.Context, in *LoopInRequest, opts .
 	return out, nil
 }
 
+func (c *swapClientClient) ReserveLoopOut(ctx context.Context, in *LoopOutRequest, opts ...grpc.CallOption) (*SwapReservation, error) {
+	out := new(SwapReservation)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ReserveLoopOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ReserveLoopIn(ctx context.Context, in *LoopInRequest, opts ...grpc.CallOption) (*SwapReservation, error) {
+	out := new(SwapReservation)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ReserveLoopIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*SwapResponse, error) {
+	out := new(SwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ConfirmReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error) {
+	out := new(ListPendingApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListPendingApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ApproveSwap(ctx context.Context, in *ApproveSwapRequest, opts ...grpc.CallOption) (*SwapResponse, error) {
+	out := new(SwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ApproveSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) DenySwap(ctx context.Context, in *DenySwapRequest, opts ...grpc.CallOption) (*DenySwapResponse, error) {
+	out := new(DenySwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/DenySwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListSwapDenials(ctx context.Context, in *ListSwapDenialsRequest, opts ...grpc.CallOption) (*ListSwapDenialsResponse, error) {
+	out := new(ListSwapDenialsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListSwapDenials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) Monitor(ctx context.Context, in *MonitorRequest, opts ...grpc.CallOption) (SwapClient_MonitorClient, error) {
 	stream, err := c.cc.NewStream(ctx, &SwapClient_ServiceDesc.Streams[0], "/looprpc.SwapClient/Monitor", opts...)
 	if err != nil {
@@ -155,6 +436,42 @@ func (c *swapClientClient) SwapInfo(ctx context.Context, in *SwapInfoRequest, op
 	return out, nil
 }
 
+func (c *swapClientClient) RedactSwap(ctx context.Context, in *RedactSwapRequest, opts ...grpc.CallOption) (*RedactSwapResponse, error) {
+	out := new(RedactSwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RedactSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetStatusLink(ctx context.Context, in *StatusLinkRequest, opts ...grpc.CallOption) (*StatusLinkResponse, error) {
+	out := new(StatusLinkResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetStatusLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetSwapStats(ctx context.Context, in *SwapStatsRequest, opts ...grpc.CallOption) (*SwapStatsResponse, error) {
+	out := new(SwapStatsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetSwapStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) LoopOutTerms(ctx context.Context, in *TermsRequest, opts ...grpc.CallOption) (*OutTermsResponse, error) {
 	out := new(OutTermsResponse)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/LoopOutTerms", in, out, opts...)
@@ -236,382 +553,1809 @@ func (c *swapClientClient) SuggestSwaps(ctx context.Context, in *SuggestSwapsReq
 	return out, nil
 }
 
-// SwapClientServer is the server API for SwapClient service.
-// All implementations must embed UnimplementedSwapClientServer
-// for forward compatibility
-type SwapClientServer interface {
-	// loop: `out`
-	//LoopOut initiates an loop out swap with the given parameters. The call
-	//returns after the swap has been set up with the swap server. From that
-	//point onwards, progress can be tracked via the SwapStatus stream that is
-	//returned from Monitor().
-	LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error)
-	// loop: `in`
-	//LoopIn initiates a loop in swap with the given parameters. The call
-	//returns after the swap has been set up with the swap server. From that
-	//point onwards, progress can be tracked via the SwapStatus stream
-	//that is returned from Monitor().
-	LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error)
-	// loop: `monitor`
-	//Monitor will return a stream of swap updates for currently active swaps.
-	Monitor(*MonitorRequest, SwapClient_MonitorServer) error
-	// loop: `listswaps`
-	//ListSwaps returns a list of all currently known swaps and their current
-	//status.
-	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
-	// loop: `swapinfo`
-	//SwapInfo returns all known details about a single swap.
-	SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error)
-	// loop: `terms`
-	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
-	LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error)
-	// loop: `quote`
-	//LoopOutQuote returns a quote for a loop out swap with the provided
-	//parameters.
-	LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error)
-	// loop: `terms`
-	//GetTerms returns the terms that the server enforces for swaps.
-	GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error)
-	// loop: `quote`
-	//GetQuote returns a quote for a swap with the provided parameters.
-	GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error)
-	//
-	//Probe asks he sever to probe the route to us to have a better upfront
-	//estimate about routing fees when loopin-in.
-	Probe(context.Context, *ProbeRequest) (*ProbeResponse, error)
-	// loop: `listauth`
-	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
-	GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error)
-	// loop: `getparams`
-	//GetLiquidityParams gets the parameters that the daemon's liquidity manager
-	//is currently configured with. This may be nil if nothing is configured.
-	//[EXPERIMENTAL]: endpoint is subject to change.
-	GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error)
-	// loop: `setparams`
-	//SetLiquidityParams sets a new set of parameters for the daemon's liquidity
-	//manager. Note that the full set of parameters must be provided, because
-	//this call fully overwrites our existing parameters.
-	//[EXPERIMENTAL]: endpoint is subject to change.
-	SetLiquidityParams(context.Context, *SetLiquidityParamsRequest) (*SetLiquidityParamsResponse, error)
-	// loop: `suggestswaps`
-	//SuggestSwaps returns a list of recommended swaps based on the current
-	//state of your node's channels and it's liquidity manager parameters.
-	//Note that only loop out suggestions are currently supported.
-	//[EXPERIMENTAL]: endpoint is subject to change.
-	SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error)
-	mustEmbedUnimplementedSwapClientServer()
+func (c *swapClientClient) QuoteSuggestions(ctx context.Context, in *QuoteSuggestionsRequest, opts ...grpc.CallOption) (*QuoteSuggestionsResponse, error) {
+	out := new(QuoteSuggestionsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/QuoteSuggestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSwapClientServer must be embedded to have forward compatible implementations.
-type UnimplementedSwapClientServer struct {
+func (c *swapClientClient) ApplySuggestions(ctx context.Context, in *ApplySuggestionsRequest, opts ...grpc.CallOption) (*ApplySuggestionsResponse, error) {
+	out := new(ApplySuggestionsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ApplySuggestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedSwapClientServer) LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopOut not implemented")
+func (c *swapClientClient) GetParameterAdvice(ctx context.Context, in *ParameterAdviceRequest, opts ...grpc.CallOption) (*ParameterAdviceResponse, error) {
+	out := new(ParameterAdviceResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetParameterAdvice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopIn not implemented")
+
+func (c *swapClientClient) GetSwapProof(ctx context.Context, in *SwapProofRequest, opts ...grpc.CallOption) (*SwapProof, error) {
+	out := new(SwapProof)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetSwapProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) Monitor(*MonitorRequest, SwapClient_MonitorServer) error {
-	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
+
+func (c *swapClientClient) PreviewSweep(ctx context.Context, in *PreviewSweepRequest, opts ...grpc.CallOption) (*PreviewSweepResponse, error) {
+	out := new(PreviewSweepResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/PreviewSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSwaps not implemented")
+
+func (c *swapClientClient) GetLiquiditySummary(ctx context.Context, in *LiquiditySummaryRequest, opts ...grpc.CallOption) (*LiquiditySummary, error) {
+	out := new(LiquiditySummary)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetLiquiditySummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapInfo not implemented")
+
+func (c *swapClientClient) ListLiquiditySnapshots(ctx context.Context, in *ListLiquiditySnapshotsRequest, opts ...grpc.CallOption) (*ListLiquiditySnapshotsResponse, error) {
+	out := new(ListLiquiditySnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListLiquiditySnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopOutTerms not implemented")
+
+func (c *swapClientClient) ListFeeRecords(ctx context.Context, in *ListFeeRecordsRequest, opts ...grpc.CallOption) (*ListFeeRecordsResponse, error) {
+	out := new(ListFeeRecordsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListFeeRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopOutQuote not implemented")
+
+func (c *swapClientClient) ListDispatchRecords(ctx context.Context, in *ListDispatchRecordsRequest, opts ...grpc.CallOption) (*ListDispatchRecordsResponse, error) {
+	out := new(ListDispatchRecordsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListDispatchRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInTerms not implemented")
+
+func (c *swapClientClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
+	out := new(DebugLevelResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/DebugLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInQuote not implemented")
+
+func (c *swapClientClient) CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*CaptureProfileResponse, error) {
+	out := new(CaptureProfileResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CaptureProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) Probe(context.Context, *ProbeRequest) (*ProbeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
+
+func (c *swapClientClient) SetSwapTemplate(ctx context.Context, in *SetSwapTemplateRequest, opts ...grpc.CallOption) (*SetSwapTemplateResponse, error) {
+	out := new(SetSwapTemplateResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SetSwapTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLsatTokens not implemented")
+
+func (c *swapClientClient) ListSwapTemplates(ctx context.Context, in *ListSwapTemplatesRequest, opts ...grpc.CallOption) (*ListSwapTemplatesResponse, error) {
+	out := new(ListSwapTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListSwapTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityParams not implemented")
+
+func (c *swapClientClient) DeleteSwapTemplate(ctx context.Context, in *DeleteSwapTemplateRequest, opts ...grpc.CallOption) (*DeleteSwapTemplateResponse, error) {
+	out := new(DeleteSwapTemplateResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/DeleteSwapTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) SetLiquidityParams(context.Context, *SetLiquidityParamsRequest) (*SetLiquidityParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLiquidityParams not implemented")
+
+func (c *swapClientClient) ResolveSwapTemplate(ctx context.Context, in *ResolveSwapTemplateRequest, opts ...grpc.CallOption) (*ResolveSwapTemplateResponse, error) {
+	out := new(ResolveSwapTemplateResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ResolveSwapTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuggestSwaps not implemented")
+
+func (c *swapClientClient) SetFeeBudget(ctx context.Context, in *SetFeeBudgetRequest, opts ...grpc.CallOption) (*SetFeeBudgetResponse, error) {
+	out := new(SetFeeBudgetResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SetFeeBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) mustEmbedUnimplementedSwapClientServer() {}
 
-// UnsafeSwapClientServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SwapClientServer will
-// result in compilation errors.
-type UnsafeSwapClientServer interface {
-	mustEmbedUnimplementedSwapClientServer()
+func (c *swapClientClient) ListFeeBudgets(ctx context.Context, in *ListFeeBudgetsRequest, opts ...grpc.CallOption) (*ListFeeBudgetsResponse, error) {
+	out := new(ListFeeBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListFeeBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterSwapClientServer(s grpc.ServiceRegistrar, srv SwapClientServer) {
-	s.RegisterService(&SwapClient_ServiceDesc, srv)
+func (c *swapClientClient) DeleteFeeBudget(ctx context.Context, in *DeleteFeeBudgetRequest, opts ...grpc.CallOption) (*DeleteFeeBudgetResponse, error) {
+	out := new(DeleteFeeBudgetResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/DeleteFeeBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetCostStatement(ctx context.Context, in *CostStatementRequest, opts ...grpc.CallOption) (*CostStatement, error) {
+	out := new(CostStatement)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetCostStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) DrainChannel(ctx context.Context, in *DrainChannelRequest, opts ...grpc.CallOption) (SwapClient_DrainChannelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SwapClient_ServiceDesc.Streams[1], "/looprpc.SwapClient/DrainChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &swapClientDrainChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SwapClient_DrainChannelClient interface {
+	Recv() (*DrainUpdate, error)
+	grpc.ClientStream
+}
+
+type swapClientDrainChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *swapClientDrainChannelClient) Recv() (*DrainUpdate, error) {
+	m := new(DrainUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *swapClientClient) FillChannel(ctx context.Context, in *FillChannelRequest, opts ...grpc.CallOption) (SwapClient_FillChannelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SwapClient_ServiceDesc.Streams[2], "/looprpc.SwapClient/FillChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &swapClientFillChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SwapClient_FillChannelClient interface {
+	Recv() (*FillUpdate, error)
+	grpc.ClientStream
+}
+
+type swapClientFillChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *swapClientFillChannelClient) Recv() (*FillUpdate, error) {
+	m := new(FillUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *swapClientClient) CreateSwapPlan(ctx context.Context, in *CreateSwapPlanRequest, opts ...grpc.CallOption) (*SwapPlan, error) {
+	out := new(SwapPlan)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CreateSwapPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListSwapPlans(ctx context.Context, in *ListSwapPlansRequest, opts ...grpc.CallOption) (*ListSwapPlansResponse, error) {
+	out := new(ListSwapPlansResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListSwapPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) UpdateSwapPlan(ctx context.Context, in *UpdateSwapPlanRequest, opts ...grpc.CallOption) (*SwapPlan, error) {
+	out := new(SwapPlan)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/UpdateSwapPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ExternalLoopIn(ctx context.Context, in *ExternalLoopInRequest, opts ...grpc.CallOption) (SwapClient_ExternalLoopInClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SwapClient_ServiceDesc.Streams[3], "/looprpc.SwapClient/ExternalLoopIn", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &swapClientExternalLoopInClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SwapClient_ExternalLoopInClient interface {
+	Recv() (*ExternalLoopInUpdate, error)
+	grpc.ClientStream
+}
+
+type swapClientExternalLoopInClient struct {
+	grpc.ClientStream
+}
+
+func (x *swapClientExternalLoopInClient) Recv() (*ExternalLoopInUpdate, error) {
+	m := new(ExternalLoopInUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *swapClientClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) BakeTenantMacaroon(ctx context.Context, in *BakeTenantMacaroonRequest, opts ...grpc.CallOption) (*BakeTenantMacaroonResponse, error) {
+	out := new(BakeTenantMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/BakeTenantMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) SnapshotMissionControl(ctx context.Context, in *SnapshotMissionControlRequest, opts ...grpc.CallOption) (*MissionControlSnapshot, error) {
+	out := new(MissionControlSnapshot)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SnapshotMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error) {
+	out := new(ResetMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ResetMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RestoreMissionControl(ctx context.Context, in *RestoreMissionControlRequest, opts ...grpc.CallOption) (*MissionControlSnapshot, error) {
+	out := new(MissionControlSnapshot)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RestoreMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SwapClientServer is the server API for SwapClient service.
+// All implementations must embed UnimplementedSwapClientServer
+// for forward compatibility
+type SwapClientServer interface {
+	// loop: `out`
+	//LoopOut initiates an loop out swap with the given parameters. The call
+	//returns after the swap has been set up with the swap server. From that
+	//point onwards, progress can be tracked via the SwapStatus stream that is
+	//returned from Monitor().
+	LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error)
+	// loop: `in`
+	//LoopIn initiates a loop in swap with the given parameters. The call
+	//returns after the swap has been set up with the swap server. From that
+	//point onwards, progress can be tracked via the SwapStatus stream
+	//that is returned from Monitor().
+	LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error)
+	// loop: `out --reserve`
+	//ReserveLoopOut sets up a loop out swap with the swap server and validates
+	//it, returning the swap's final fees and htlc details without executing
+	//it. The swap is only executed once it is confirmed with
+	//ConfirmReservation before the reservation expires.
+	ReserveLoopOut(context.Context, *LoopOutRequest) (*SwapReservation, error)
+	// loop: `in --reserve`
+	//ReserveLoopIn sets up a loop in swap with the swap server and validates
+	//it, returning the swap's final fees and htlc details without executing
+	//it. The swap is only executed once it is confirmed with
+	//ConfirmReservation before the reservation expires.
+	ReserveLoopIn(context.Context, *LoopInRequest) (*SwapReservation, error)
+	// loop: `confirmswap`
+	//ConfirmReservation executes a swap that was reserved with ReserveLoopOut
+	//or ReserveLoopIn. From that point onwards, progress can be tracked via the
+	//SwapStatus stream that is returned from Monitor().
+	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*SwapResponse, error)
+	// loop: `approvals list`
+	//ListPendingApprovals returns all swaps that exceed the daemon's approval
+	//threshold and are awaiting approval.
+	ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error)
+	// loop: `approvals approve`
+	//ApproveSwap executes a swap that is awaiting approval. From that point
+	//onwards, progress can be tracked via the SwapStatus stream that is
+	//returned from Monitor().
+	ApproveSwap(context.Context, *ApproveSwapRequest) (*SwapResponse, error)
+	// loop: `approvals deny`
+	//DenySwap cancels a swap that is awaiting approval, and records who denied
+	//it and why.
+	DenySwap(context.Context, *DenySwapRequest) (*DenySwapResponse, error)
+	// loop: `approvals denials`
+	//ListSwapDenials returns the swaps that exceeded the daemon's approval
+	//threshold, but were denied or expired instead of being approved.
+	ListSwapDenials(context.Context, *ListSwapDenialsRequest) (*ListSwapDenialsResponse, error)
+	// loop: `monitor`
+	//Monitor will return a stream of swap updates for currently active swaps.
+	Monitor(*MonitorRequest, SwapClient_MonitorServer) error
+	// loop: `listswaps`
+	//ListSwaps returns a list of all currently known swaps and their current
+	//status.
+	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
+	// loop: `swapinfo`
+	//SwapInfo returns all known details about a single swap.
+	SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error)
+	// loop: `redact`
+	//RedactSwap removes the metadata that may identify the people behind a swap
+	//in a final state, for operators with data retention policies. The label,
+	//request id and approver identity of the swap are removed, while its
+	//amounts, fees, transactions and tenant are kept. Labels that loopd set
+	//itself, such as those of automatically dispatched swaps, are kept.
+	RedactSwap(context.Context, *RedactSwapRequest) (*RedactSwapResponse, error)
+	// loop: `statuslink`
+	//GetStatusLink mints a signed, expiring token that grants read-only access
+	//to the status of a single swap over the REST proxy, without a macaroon.
+	//The token is used in the path returned, which may be shared with anyone
+	//who should be able to follow the swap. Only the swap's state, amount,
+	//timing, health and htlc transaction are exposed through the link. All
+	//links are revoked by deleting the statuslink.key file in loopd's data
+	//directory and restarting loopd.
+	GetStatusLink(context.Context, *StatusLinkRequest) (*StatusLinkResponse, error)
+	// loop: `getinfo`
+	//GetInfo returns basic information about the daemon, including the health
+	//of its connection to the swap server.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// loop: `stats`
+	//GetSwapStats returns the number of swaps that succeeded and failed, and
+	//the cumulative amount of prepays that were lost to failed swaps.
+	GetSwapStats(context.Context, *SwapStatsRequest) (*SwapStatsResponse, error)
+	// loop: `terms`
+	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
+	LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error)
+	// loop: `quote`
+	//LoopOutQuote returns a quote for a loop out swap with the provided
+	//parameters.
+	LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error)
+	// loop: `terms`
+	//GetTerms returns the terms that the server enforces for swaps.
+	GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error)
+	// loop: `quote`
+	//GetQuote returns a quote for a swap with the provided parameters.
+	GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error)
+	//
+	//Probe asks he sever to probe the route to us to have a better upfront
+	//estimate about routing fees when loopin-in.
+	Probe(context.Context, *ProbeRequest) (*ProbeResponse, error)
+	// loop: `listauth`
+	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
+	GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error)
+	// loop: `getparams`
+	//GetLiquidityParams gets the parameters that the daemon's liquidity manager
+	//is currently configured with. This may be nil if nothing is configured.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error)
+	// loop: `setparams`
+	//SetLiquidityParams sets a new set of parameters for the daemon's liquidity
+	//manager. Note that the full set of parameters must be provided, because
+	//this call fully overwrites our existing parameters.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SetLiquidityParams(context.Context, *SetLiquidityParamsRequest) (*SetLiquidityParamsResponse, error)
+	// loop: `suggestswaps`
+	//SuggestSwaps returns a list of recommended swaps based on the current
+	//state of your node's channels and it's liquidity manager parameters.
+	//Note that only loop out suggestions are currently supported.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error)
+	// loop: `quotesuggestions`
+	//QuoteSuggestions returns the current quote for each loop out in a bundle of
+	//suggested swaps, along with the total amount and fees of the bundle, so
+	//that the cost of a batch can be reviewed before it is dispatched. If no
+	//loop outs are provided, the loop outs that are currently suggested are
+	//quoted.
+	QuoteSuggestions(context.Context, *QuoteSuggestionsRequest) (*QuoteSuggestionsResponse, error)
+	// loop: `applysuggestions`
+	//ApplySuggestions dispatches a selected subset of the current loop out
+	//suggestions, with the same parameters that autoloop would dispatch them
+	//with. Suggestions are selected by their index in the output of
+	//SuggestSwaps, or by the channels that they use. Suggestions can only be
+	//applied while autoloop is disabled.
+	ApplySuggestions(context.Context, *ApplySuggestionsRequest) (*ApplySuggestionsResponse, error)
+	// loop: `paramadvice`
+	//GetParameterAdvice analyzes the outcomes of recent swaps and the chain fee
+	//estimates that were recorded at fee decisions, and returns recommended
+	//changes to the liquidity manager's parameters. Advice is never applied
+	//automatically.
+	GetParameterAdvice(context.Context, *ParameterAdviceRequest) (*ParameterAdviceResponse, error)
+	// loop: `swapproof`
+	//GetSwapProof returns all of the data that is required to independently
+	//verify a completed swap on chain and off chain. This includes the swap
+	//invoices, preimage, htlc script and the on chain transactions that our
+	//wallet published for the swap, which contain our signatures.
+	GetSwapProof(context.Context, *SwapProofRequest) (*SwapProof, error)
+	// loop: `previewsweep`
+	//PreviewSweep returns the transaction that a pending loop out would sweep
+	//its htlc with if it swept now, along with its fee, weight and fee rate.
+	//Nothing is published, and the state of the swap is left unchanged.
+	PreviewSweep(context.Context, *PreviewSweepRequest) (*PreviewSweepResponse, error)
+	// loop: `liquiditysummary`
+	//GetLiquiditySummary returns a summary of the node's liquidity, including
+	//funds that are in transit due to swaps.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetLiquiditySummary(context.Context, *LiquiditySummaryRequest) (*LiquiditySummary, error)
+	// loop: `liquiditysnapshots`
+	//ListLiquiditySnapshots returns the snapshots of the node's balances that
+	//have been recorded within a time range.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListLiquiditySnapshots(context.Context, *ListLiquiditySnapshotsRequest) (*ListLiquiditySnapshotsResponse, error)
+	// loop: `feerecords`
+	//ListFeeRecords returns the chain fee estimates that loopd observed when it
+	//made fee sensitive decisions within a time range, along with the fee rates
+	//that the resulting transactions confirmed with.
+	ListFeeRecords(context.Context, *ListFeeRecordsRequest) (*ListFeeRecordsResponse, error)
+	// loop: `dispatchrecords`
+	//ListDispatchRecords returns a record of each suggested swap that the
+	//liquidity manager dispatched within a time range, linking the swap to the
+	//rules that it was suggested for and to the suggestion that was made.
+	ListDispatchRecords(context.Context, *ListDispatchRecordsRequest) (*ListDispatchRecordsResponse, error)
+	// loop: `debuglevel`
+	//DebugLevel sets the log level of all subsystems, or of individual
+	//subsystems, at runtime. It may also be used to list the subsystems that
+	//are available.
+	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
+	// loop: `captureprofile`
+	//CaptureProfile writes snapshots of the daemon's goroutines and heap to
+	//files in its data directory, so that a running daemon can be inspected
+	//without enabling its profiling listener.
+	CaptureProfile(context.Context, *CaptureProfileRequest) (*CaptureProfileResponse, error)
+	// loop: `template set`
+	//SetSwapTemplate stores a named swap configuration, replacing any existing
+	//template with the same name. Templates can be dispatched by name with
+	//`loop run`.
+	SetSwapTemplate(context.Context, *SetSwapTemplateRequest) (*SetSwapTemplateResponse, error)
+	// loop: `template list`
+	//ListSwapTemplates returns all stored swap templates.
+	ListSwapTemplates(context.Context, *ListSwapTemplatesRequest) (*ListSwapTemplatesResponse, error)
+	// loop: `template delete`
+	//DeleteSwapTemplate deletes the swap template with the name provided.
+	DeleteSwapTemplate(context.Context, *DeleteSwapTemplateRequest) (*DeleteSwapTemplateResponse, error)
+	// loop: `run`
+	//ResolveSwapTemplate returns the swap template with the name provided, with
+	//the amount of templates that set a target local balance calculated from
+	//our current channel balances.
+	ResolveSwapTemplate(context.Context, *ResolveSwapTemplateRequest) (*ResolveSwapTemplateResponse, error)
+	// loop: `budget set`
+	//SetFeeBudget caps the fees that the swaps of a tenant or label may spend,
+	//replacing any existing budget for the same tenant or label. Swaps whose
+	//maximum fees would exceed a budget that applies to them are rejected.
+	SetFeeBudget(context.Context, *SetFeeBudgetRequest) (*SetFeeBudgetResponse, error)
+	// loop: `budget list`
+	//ListFeeBudgets returns all fee budgets, along with the fees that have been
+	//spent from them.
+	ListFeeBudgets(context.Context, *ListFeeBudgetsRequest) (*ListFeeBudgetsResponse, error)
+	// loop: `budget delete`
+	//DeleteFeeBudget deletes the fee budget of a tenant or label.
+	DeleteFeeBudget(context.Context, *DeleteFeeBudgetRequest) (*DeleteFeeBudgetResponse, error)
+	// loop: `budget statement`
+	//GetCostStatement returns the amounts and costs of swaps, aggregated by
+	//tenant or label. In multi-tenant mode, tenants only receive the costs of
+	//their own swaps.
+	GetCostStatement(context.Context, *CostStatementRequest) (*CostStatement, error)
+	// loop: `drain`
+	//DrainChannel loops out as much of the local balance of a channel as
+	//possible, dispatching one swap at a time until the channel cannot carry
+	//another swap. If requested, the channel is then closed cooperatively if its
+	//local balance has fallen below a threshold. An update is streamed after
+	//each swap completes. If the stream is cancelled, no further swaps are
+	//dispatched, but a swap that is in flight continues.
+	DrainChannel(*DrainChannelRequest, SwapClient_DrainChannelServer) error
+	// loop: `fill`
+	//FillChannel brings the balance of a channel to a target split between its
+	//local and remote sides, looping out over the channel while its local
+	//balance is above the target and looping in through its peer while it is
+	//below. If a channel point is provided instead of a channel id, the channel
+	//is waited for until it is open and active, so that a channel can be filled
+	//as soon as it is opened. An update is streamed after each swap completes.
+	//If the stream is cancelled, no further swaps are dispatched, but a swap
+	//that is in flight continues.
+	FillChannel(*FillChannelRequest, SwapClient_FillChannelServer) error
+	// loop: `plan create`
+	//CreateSwapPlan splits a liquidity change that is too large for a single
+	//swap into a sequence of swaps that are dispatched over time. The number of
+	//swaps is chosen to minimize the fees that are quoted for them while
+	//keeping them within the plan's fee budget. Plans are created paused unless
+	//they are started on creation.
+	CreateSwapPlan(context.Context, *CreateSwapPlanRequest) (*SwapPlan, error)
+	// loop: `plan list`
+	//ListSwapPlans returns all swap plans along with the state of their steps.
+	ListSwapPlans(context.Context, *ListSwapPlansRequest) (*ListSwapPlansResponse, error)
+	// loop: `plan resume`, `plan pause`, `plan cancel`
+	//UpdateSwapPlan resumes, pauses or cancels a swap plan. Pausing or
+	//cancelling a plan stops further steps from being dispatched, but a swap
+	//that is in flight continues. Resuming a failed plan retries its failed
+	//step.
+	UpdateSwapPlan(context.Context, *UpdateSwapPlanRequest) (*SwapPlan, error)
+	// loop: `externalin`
+	//ExternalLoopIn initiates a loop in swap that is funded by an external
+	//wallet. It first streams the htlc address and exact amount that the wallet
+	//must pay, along with a BIP21 payment uri that may be shown as a QR code. It
+	//then streams an update when the payment confirms on chain, and a final
+	//update when the swap completes or fails.
+	ExternalLoopIn(*ExternalLoopInRequest, SwapClient_ExternalLoopInServer) error
+	// loop: `reloadconfig`
+	//ReloadConfig reads the daemon's configuration file and command line
+	//flags again, and applies the options that can be changed without a
+	//restart. Other options are left unchanged until the daemon is restarted.
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	// loop: `tenant bake`
+	//BakeTenantMacaroon bakes a macaroon for a new tenant in multi-tenant
+	//mode. Every macaroon that is baked has a new identity, and is therefore a
+	//separate tenant that is restricted to the swap, quote and terms RPCs.
+	//This call is only available to the operator.
+	BakeTenantMacaroon(context.Context, *BakeTenantMacaroonRequest) (*BakeTenantMacaroonResponse, error)
+	// loop: `missioncontrol snapshot`
+	//SnapshotMissionControl saves a snapshot of lnd's mission control, which
+	//can be restored with RestoreMissionControl. Any existing snapshot is
+	//replaced.
+	SnapshotMissionControl(context.Context, *SnapshotMissionControlRequest) (*MissionControlSnapshot, error)
+	// loop: `missioncontrol reset`
+	//ResetMissionControl clears all of lnd's mission control state, so that
+	//past payment results no longer affect the routes that lnd tries.
+	ResetMissionControl(context.Context, *ResetMissionControlRequest) (*ResetMissionControlResponse, error)
+	// loop: `missioncontrol restore`
+	//RestoreMissionControl resets lnd's mission control and restores the
+	//snapshot saved by SnapshotMissionControl or before a batch of automated
+	//swaps was dispatched.
+	RestoreMissionControl(context.Context, *RestoreMissionControlRequest) (*MissionControlSnapshot, error)
+	mustEmbedUnimplementedSwapClientServer()
+}
+
+// UnimplementedSwapClientServer must be embedded to have forward compatible implementations.
+type UnimplementedSwapClientServer struct {
+}
+
+func (UnimplementedSwapClientServer) LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopOut not implemented")
+}
+func (UnimplementedSwapClientServer) LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopIn not implemented")
+}
+func (UnimplementedSwapClientServer) ReserveLoopOut(context.Context, *LoopOutRequest) (*SwapReservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveLoopOut not implemented")
+}
+func (UnimplementedSwapClientServer) ReserveLoopIn(context.Context, *LoopInRequest) (*SwapReservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveLoopIn not implemented")
+}
+func (UnimplementedSwapClientServer) ConfirmReservation(context.Context, *ConfirmReservationRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+}
+func (UnimplementedSwapClientServer) ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingApprovals not implemented")
+}
+func (UnimplementedSwapClientServer) ApproveSwap(context.Context, *ApproveSwapRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSwap not implemented")
+}
+func (UnimplementedSwapClientServer) DenySwap(context.Context, *DenySwapRequest) (*DenySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenySwap not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwapDenials(context.Context, *ListSwapDenialsRequest) (*ListSwapDenialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapDenials not implemented")
+}
+func (UnimplementedSwapClientServer) Monitor(*MonitorRequest, SwapClient_MonitorServer) error {
+	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapInfo not implemented")
+}
+func (UnimplementedSwapClientServer) RedactSwap(context.Context, *RedactSwapRequest) (*RedactSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedactSwap not implemented")
+}
+func (UnimplementedSwapClientServer) GetStatusLink(context.Context, *StatusLinkRequest) (*StatusLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusLink not implemented")
+}
+func (UnimplementedSwapClientServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (UnimplementedSwapClientServer) GetSwapStats(context.Context, *SwapStatsRequest) (*SwapStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapStats not implemented")
+}
+func (UnimplementedSwapClientServer) LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopOutTerms not implemented")
+}
+func (UnimplementedSwapClientServer) LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopOutQuote not implemented")
+}
+func (UnimplementedSwapClientServer) GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInTerms not implemented")
+}
+func (UnimplementedSwapClientServer) GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInQuote not implemented")
+}
+func (UnimplementedSwapClientServer) Probe(context.Context, *ProbeRequest) (*ProbeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
+}
+func (UnimplementedSwapClientServer) GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLsatTokens not implemented")
+}
+func (UnimplementedSwapClientServer) GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityParams not implemented")
+}
+func (UnimplementedSwapClientServer) SetLiquidityParams(context.Context, *SetLiquidityParamsRequest) (*SetLiquidityParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLiquidityParams not implemented")
+}
+func (UnimplementedSwapClientServer) SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) QuoteSuggestions(context.Context, *QuoteSuggestionsRequest) (*QuoteSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSuggestions not implemented")
+}
+func (UnimplementedSwapClientServer) ApplySuggestions(context.Context, *ApplySuggestionsRequest) (*ApplySuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySuggestions not implemented")
+}
+func (UnimplementedSwapClientServer) GetParameterAdvice(context.Context, *ParameterAdviceRequest) (*ParameterAdviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParameterAdvice not implemented")
+}
+func (UnimplementedSwapClientServer) GetSwapProof(context.Context, *SwapProofRequest) (*SwapProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapProof not implemented")
+}
+func (UnimplementedSwapClientServer) PreviewSweep(context.Context, *PreviewSweepRequest) (*PreviewSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSweep not implemented")
+}
+func (UnimplementedSwapClientServer) GetLiquiditySummary(context.Context, *LiquiditySummaryRequest) (*LiquiditySummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquiditySummary not implemented")
+}
+func (UnimplementedSwapClientServer) ListLiquiditySnapshots(context.Context, *ListLiquiditySnapshotsRequest) (*ListLiquiditySnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLiquiditySnapshots not implemented")
+}
+func (UnimplementedSwapClientServer) ListFeeRecords(context.Context, *ListFeeRecordsRequest) (*ListFeeRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeRecords not implemented")
+}
+func (UnimplementedSwapClientServer) ListDispatchRecords(context.Context, *ListDispatchRecordsRequest) (*ListDispatchRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDispatchRecords not implemented")
+}
+func (UnimplementedSwapClientServer) DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugLevel not implemented")
+}
+func (UnimplementedSwapClientServer) CaptureProfile(context.Context, *CaptureProfileRequest) (*CaptureProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureProfile not implemented")
+}
+func (UnimplementedSwapClientServer) SetSwapTemplate(context.Context, *SetSwapTemplateRequest) (*SetSwapTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapTemplate not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwapTemplates(context.Context, *ListSwapTemplatesRequest) (*ListSwapTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapTemplates not implemented")
+}
+func (UnimplementedSwapClientServer) DeleteSwapTemplate(context.Context, *DeleteSwapTemplateRequest) (*DeleteSwapTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSwapTemplate not implemented")
+}
+func (UnimplementedSwapClientServer) ResolveSwapTemplate(context.Context, *ResolveSwapTemplateRequest) (*ResolveSwapTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSwapTemplate not implemented")
+}
+func (UnimplementedSwapClientServer) SetFeeBudget(context.Context, *SetFeeBudgetRequest) (*SetFeeBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeBudget not implemented")
+}
+func (UnimplementedSwapClientServer) ListFeeBudgets(context.Context, *ListFeeBudgetsRequest) (*ListFeeBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeBudgets not implemented")
+}
+func (UnimplementedSwapClientServer) DeleteFeeBudget(context.Context, *DeleteFeeBudgetRequest) (*DeleteFeeBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeBudget not implemented")
+}
+func (UnimplementedSwapClientServer) GetCostStatement(context.Context, *CostStatementRequest) (*CostStatement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostStatement not implemented")
+}
+func (UnimplementedSwapClientServer) DrainChannel(*DrainChannelRequest, SwapClient_DrainChannelServer) error {
+	return status.Errorf(codes.Unimplemented, "method DrainChannel not implemented")
+}
+func (UnimplementedSwapClientServer) FillChannel(*FillChannelRequest, SwapClient_FillChannelServer) error {
+	return status.Errorf(codes.Unimplemented, "method FillChannel not implemented")
+}
+func (UnimplementedSwapClientServer) CreateSwapPlan(context.Context, *CreateSwapPlanRequest) (*SwapPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwapPlan not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwapPlans(context.Context, *ListSwapPlansRequest) (*ListSwapPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapPlans not implemented")
+}
+func (UnimplementedSwapClientServer) UpdateSwapPlan(context.Context, *UpdateSwapPlanRequest) (*SwapPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwapPlan not implemented")
+}
+func (UnimplementedSwapClientServer) ExternalLoopIn(*ExternalLoopInRequest, SwapClient_ExternalLoopInServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExternalLoopIn not implemented")
+}
+func (UnimplementedSwapClientServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedSwapClientServer) BakeTenantMacaroon(context.Context, *BakeTenantMacaroonRequest) (*BakeTenantMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BakeTenantMacaroon not implemented")
+}
+func (UnimplementedSwapClientServer) SnapshotMissionControl(context.Context, *SnapshotMissionControlRequest) (*MissionControlSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotMissionControl not implemented")
+}
+func (UnimplementedSwapClientServer) ResetMissionControl(context.Context, *ResetMissionControlRequest) (*ResetMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMissionControl not implemented")
+}
+func (UnimplementedSwapClientServer) RestoreMissionControl(context.Context, *RestoreMissionControlRequest) (*MissionControlSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMissionControl not implemented")
+}
+func (UnimplementedSwapClientServer) mustEmbedUnimplementedSwapClientServer() {}
+
+// UnsafeSwapClientServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SwapClientServer will
+// result in compilation errors.
+type UnsafeSwapClientServer interface {
+	mustEmbedUnimplementedSwapClientServer()
+}
+
+func RegisterSwapClientServer(s grpc.ServiceRegistrar, srv SwapClientServer) {
+	s.RegisterService(&SwapClient_ServiceDesc, srv)
+}
+
+func _SwapClient_LoopOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoopOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopOut(ctx, req.(*LoopOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_LoopIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoopInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopIn(ctx, req.(*LoopInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ReserveLoopOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoopOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ReserveLoopOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ReserveLoopOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ReserveLoopOut(ctx, req.(*LoopOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ReserveLoopIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoopInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ReserveLoopIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ReserveLoopIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ReserveLoopIn(ctx, req.(*LoopInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ConfirmReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListPendingApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListPendingApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListPendingApprovals(ctx, req.(*ListPendingApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ApproveSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ApproveSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ApproveSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ApproveSwap(ctx, req.(*ApproveSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_DenySwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).DenySwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/DenySwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).DenySwap(ctx, req.(*DenySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListSwapDenials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapDenialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListSwapDenials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListSwapDenials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListSwapDenials(ctx, req.(*ListSwapDenialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapClientServer).Monitor(m, &swapClientMonitorServer{stream})
+}
+
+type SwapClient_MonitorServer interface {
+	Send(*SwapStatus) error
+	grpc.ServerStream
+}
+
+type swapClientMonitorServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapClientMonitorServer) Send(m *SwapStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SwapClient_ListSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListSwaps(ctx, req.(*ListSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SwapInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SwapInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SwapInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SwapInfo(ctx, req.(*SwapInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_RedactSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedactSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).RedactSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/RedactSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).RedactSwap(ctx, req.(*RedactSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetStatusLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetStatusLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetStatusLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetStatusLink(ctx, req.(*StatusLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetSwapStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetSwapStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetSwapStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetSwapStats(ctx, req.(*SwapStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_LoopOutTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopOutTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopOutTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopOutTerms(ctx, req.(*TermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_LoopOutQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopOutQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopOutQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopOutQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLoopInTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLoopInTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLoopInTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLoopInTerms(ctx, req.(*TermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLoopInQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLoopInQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLoopInQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLoopInQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_Probe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).Probe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/Probe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).Probe(ctx, req.(*ProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLsatTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLsatTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLsatTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLsatTokens(ctx, req.(*TokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidityParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLiquidityParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLiquidityParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLiquidityParams(ctx, req.(*GetLiquidityParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLiquidityParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SetLiquidityParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SetLiquidityParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SetLiquidityParams(ctx, req.(*SetLiquidityParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SuggestSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SuggestSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SuggestSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SuggestSwaps(ctx, req.(*SuggestSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_QuoteSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).QuoteSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/QuoteSuggestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).QuoteSuggestions(ctx, req.(*QuoteSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ApplySuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ApplySuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ApplySuggestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ApplySuggestions(ctx, req.(*ApplySuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetParameterAdvice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParameterAdviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetParameterAdvice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetParameterAdvice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetParameterAdvice(ctx, req.(*ParameterAdviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetSwapProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetSwapProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetSwapProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetSwapProof(ctx, req.(*SwapProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_PreviewSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).PreviewSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/PreviewSweep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).PreviewSweep(ctx, req.(*PreviewSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLiquiditySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquiditySummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLiquiditySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLiquiditySummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLiquiditySummary(ctx, req.(*LiquiditySummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListLiquiditySnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLiquiditySnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListLiquiditySnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListLiquiditySnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListLiquiditySnapshots(ctx, req.(*ListLiquiditySnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListFeeRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListFeeRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListFeeRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListFeeRecords(ctx, req.(*ListFeeRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListDispatchRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDispatchRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListDispatchRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListDispatchRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListDispatchRecords(ctx, req.(*ListDispatchRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).DebugLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/DebugLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoopOutRequest)
+func _SwapClient_CaptureProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopOut(ctx, in)
+		return srv.(SwapClientServer).CaptureProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopOut",
+		FullMethod: "/looprpc.SwapClient/CaptureProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopOut(ctx, req.(*LoopOutRequest))
+		return srv.(SwapClientServer).CaptureProfile(ctx, req.(*CaptureProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoopInRequest)
+func _SwapClient_SetSwapTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSwapTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopIn(ctx, in)
+		return srv.(SwapClientServer).SetSwapTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopIn",
+		FullMethod: "/looprpc.SwapClient/SetSwapTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopIn(ctx, req.(*LoopInRequest))
+		return srv.(SwapClientServer).SetSwapTemplate(ctx, req.(*SetSwapTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(MonitorRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _SwapClient_ListSwapTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(SwapClientServer).Monitor(m, &swapClientMonitorServer{stream})
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListSwapTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListSwapTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListSwapTemplates(ctx, req.(*ListSwapTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type SwapClient_MonitorServer interface {
-	Send(*SwapStatus) error
-	grpc.ServerStream
+func _SwapClient_DeleteSwapTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSwapTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).DeleteSwapTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/DeleteSwapTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).DeleteSwapTemplate(ctx, req.(*DeleteSwapTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type swapClientMonitorServer struct {
-	grpc.ServerStream
+func _SwapClient_ResolveSwapTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSwapTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ResolveSwapTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ResolveSwapTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ResolveSwapTemplate(ctx, req.(*ResolveSwapTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *swapClientMonitorServer) Send(m *SwapStatus) error {
-	return x.ServerStream.SendMsg(m)
+func _SwapClient_SetFeeBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeeBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SetFeeBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SetFeeBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SetFeeBudget(ctx, req.(*SetFeeBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_ListSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSwapsRequest)
+func _SwapClient_ListFeeBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeBudgetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).ListSwaps(ctx, in)
+		return srv.(SwapClientServer).ListFeeBudgets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/ListSwaps",
+		FullMethod: "/looprpc.SwapClient/ListFeeBudgets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).ListSwaps(ctx, req.(*ListSwapsRequest))
+		return srv.(SwapClientServer).ListFeeBudgets(ctx, req.(*ListFeeBudgetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_SwapInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SwapInfoRequest)
+func _SwapClient_DeleteFeeBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeeBudgetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).SwapInfo(ctx, in)
+		return srv.(SwapClientServer).DeleteFeeBudget(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/SwapInfo",
+		FullMethod: "/looprpc.SwapClient/DeleteFeeBudget",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).SwapInfo(ctx, req.(*SwapInfoRequest))
+		return srv.(SwapClientServer).DeleteFeeBudget(ctx, req.(*DeleteFeeBudgetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopOutTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TermsRequest)
+func _SwapClient_GetCostStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CostStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopOutTerms(ctx, in)
+		return srv.(SwapClientServer).GetCostStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopOutTerms",
+		FullMethod: "/looprpc.SwapClient/GetCostStatement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopOutTerms(ctx, req.(*TermsRequest))
+		return srv.(SwapClientServer).GetCostStatement(ctx, req.(*CostStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopOutQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuoteRequest)
+func _SwapClient_DrainChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DrainChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapClientServer).DrainChannel(m, &swapClientDrainChannelServer{stream})
+}
+
+type SwapClient_DrainChannelServer interface {
+	Send(*DrainUpdate) error
+	grpc.ServerStream
+}
+
+type swapClientDrainChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapClientDrainChannelServer) Send(m *DrainUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SwapClient_FillChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FillChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapClientServer).FillChannel(m, &swapClientFillChannelServer{stream})
+}
+
+type SwapClient_FillChannelServer interface {
+	Send(*FillUpdate) error
+	grpc.ServerStream
+}
+
+type swapClientFillChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapClientFillChannelServer) Send(m *FillUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SwapClient_CreateSwapPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSwapPlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopOutQuote(ctx, in)
+		return srv.(SwapClientServer).CreateSwapPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopOutQuote",
+		FullMethod: "/looprpc.SwapClient/CreateSwapPlan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopOutQuote(ctx, req.(*QuoteRequest))
+		return srv.(SwapClientServer).CreateSwapPlan(ctx, req.(*CreateSwapPlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLoopInTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TermsRequest)
+func _SwapClient_ListSwapPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapPlansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLoopInTerms(ctx, in)
+		return srv.(SwapClientServer).ListSwapPlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLoopInTerms",
+		FullMethod: "/looprpc.SwapClient/ListSwapPlans",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLoopInTerms(ctx, req.(*TermsRequest))
+		return srv.(SwapClientServer).ListSwapPlans(ctx, req.(*ListSwapPlansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLoopInQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuoteRequest)
+func _SwapClient_UpdateSwapPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSwapPlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLoopInQuote(ctx, in)
+		return srv.(SwapClientServer).UpdateSwapPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLoopInQuote",
+		FullMethod: "/looprpc.SwapClient/UpdateSwapPlan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLoopInQuote(ctx, req.(*QuoteRequest))
+		return srv.(SwapClientServer).UpdateSwapPlan(ctx, req.(*UpdateSwapPlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_Probe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProbeRequest)
+func _SwapClient_ExternalLoopIn_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExternalLoopInRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapClientServer).ExternalLoopIn(m, &swapClientExternalLoopInServer{stream})
+}
+
+type SwapClient_ExternalLoopInServer interface {
+	Send(*ExternalLoopInUpdate) error
+	grpc.ServerStream
+}
+
+type swapClientExternalLoopInServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapClientExternalLoopInServer) Send(m *ExternalLoopInUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SwapClient_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).Probe(ctx, in)
+		return srv.(SwapClientServer).ReloadConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/Probe",
+		FullMethod: "/looprpc.SwapClient/ReloadConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).Probe(ctx, req.(*ProbeRequest))
+		return srv.(SwapClientServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLsatTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TokensRequest)
+func _SwapClient_BakeTenantMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeTenantMacaroonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLsatTokens(ctx, in)
+		return srv.(SwapClientServer).BakeTenantMacaroon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLsatTokens",
+		FullMethod: "/looprpc.SwapClient/BakeTenantMacaroon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLsatTokens(ctx, req.(*TokensRequest))
+		return srv.(SwapClientServer).BakeTenantMacaroon(ctx, req.(*BakeTenantMacaroonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLiquidityParamsRequest)
+func _SwapClient_SnapshotMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotMissionControlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLiquidityParams(ctx, in)
+		return srv.(SwapClientServer).SnapshotMissionControl(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLiquidityParams",
+		FullMethod: "/looprpc.SwapClient/SnapshotMissionControl",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLiquidityParams(ctx, req.(*GetLiquidityParamsRequest))
+		return srv.(SwapClientServer).SnapshotMissionControl(ctx, req.(*SnapshotMissionControlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_SetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLiquidityParamsRequest)
+func _SwapClient_ResetMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetMissionControlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).SetLiquidityParams(ctx, in)
+		return srv.(SwapClientServer).ResetMissionControl(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/SetLiquidityParams",
+		FullMethod: "/looprpc.SwapClient/ResetMissionControl",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).SetLiquidityParams(ctx, req.(*SetLiquidityParamsRequest))
+		return srv.(SwapClientServer).ResetMissionControl(ctx, req.(*ResetMissionControlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_SuggestSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuggestSwapsRequest)
+func _SwapClient_RestoreMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMissionControlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).SuggestSwaps(ctx, in)
+		return srv.(SwapClientServer).RestoreMissionControl(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/SuggestSwaps",
+		FullMethod: "/looprpc.SwapClient/RestoreMissionControl",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).SuggestSwaps(ctx, req.(*SuggestSwapsRequest))
+		return srv.(SwapClientServer).RestoreMissionControl(ctx, req.(*RestoreMissionControlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -631,6 +2375,34 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoopIn",
 			Handler:    _SwapClient_LoopIn_Handler,
 		},
+		{
+			MethodName: "ReserveLoopOut",
+			Handler:    _SwapClient_ReserveLoopOut_Handler,
+		},
+		{
+			MethodName: "ReserveLoopIn",
+			Handler:    _SwapClient_ReserveLoopIn_Handler,
+		},
+		{
+			MethodName: "ConfirmReservation",
+			Handler:    _SwapClient_ConfirmReservation_Handler,
+		},
+		{
+			MethodName: "ListPendingApprovals",
+			Handler:    _SwapClient_ListPendingApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveSwap",
+			Handler:    _SwapClient_ApproveSwap_Handler,
+		},
+		{
+			MethodName: "DenySwap",
+			Handler:    _SwapClient_DenySwap_Handler,
+		},
+		{
+			MethodName: "ListSwapDenials",
+			Handler:    _SwapClient_ListSwapDenials_Handler,
+		},
 		{
 			MethodName: "ListSwaps",
 			Handler:    _SwapClient_ListSwaps_Handler,
@@ -639,6 +2411,22 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapInfo",
 			Handler:    _SwapClient_SwapInfo_Handler,
 		},
+		{
+			MethodName: "RedactSwap",
+			Handler:    _SwapClient_RedactSwap_Handler,
+		},
+		{
+			MethodName: "GetStatusLink",
+			Handler:    _SwapClient_GetStatusLink_Handler,
+		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _SwapClient_GetInfo_Handler,
+		},
+		{
+			MethodName: "GetSwapStats",
+			Handler:    _SwapClient_GetSwapStats_Handler,
+		},
 		{
 			MethodName: "LoopOutTerms",
 			Handler:    _SwapClient_LoopOutTerms_Handler,
@@ -675,6 +2463,114 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SuggestSwaps",
 			Handler:    _SwapClient_SuggestSwaps_Handler,
 		},
+		{
+			MethodName: "QuoteSuggestions",
+			Handler:    _SwapClient_QuoteSuggestions_Handler,
+		},
+		{
+			MethodName: "ApplySuggestions",
+			Handler:    _SwapClient_ApplySuggestions_Handler,
+		},
+		{
+			MethodName: "GetParameterAdvice",
+			Handler:    _SwapClient_GetParameterAdvice_Handler,
+		},
+		{
+			MethodName: "GetSwapProof",
+			Handler:    _SwapClient_GetSwapProof_Handler,
+		},
+		{
+			MethodName: "PreviewSweep",
+			Handler:    _SwapClient_PreviewSweep_Handler,
+		},
+		{
+			MethodName: "GetLiquiditySummary",
+			Handler:    _SwapClient_GetLiquiditySummary_Handler,
+		},
+		{
+			MethodName: "ListLiquiditySnapshots",
+			Handler:    _SwapClient_ListLiquiditySnapshots_Handler,
+		},
+		{
+			MethodName: "ListFeeRecords",
+			Handler:    _SwapClient_ListFeeRecords_Handler,
+		},
+		{
+			MethodName: "ListDispatchRecords",
+			Handler:    _SwapClient_ListDispatchRecords_Handler,
+		},
+		{
+			MethodName: "DebugLevel",
+			Handler:    _SwapClient_DebugLevel_Handler,
+		},
+		{
+			MethodName: "CaptureProfile",
+			Handler:    _SwapClient_CaptureProfile_Handler,
+		},
+		{
+			MethodName: "SetSwapTemplate",
+			Handler:    _SwapClient_SetSwapTemplate_Handler,
+		},
+		{
+			MethodName: "ListSwapTemplates",
+			Handler:    _SwapClient_ListSwapTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteSwapTemplate",
+			Handler:    _SwapClient_DeleteSwapTemplate_Handler,
+		},
+		{
+			MethodName: "ResolveSwapTemplate",
+			Handler:    _SwapClient_ResolveSwapTemplate_Handler,
+		},
+		{
+			MethodName: "SetFeeBudget",
+			Handler:    _SwapClient_SetFeeBudget_Handler,
+		},
+		{
+			MethodName: "ListFeeBudgets",
+			Handler:    _SwapClient_ListFeeBudgets_Handler,
+		},
+		{
+			MethodName: "DeleteFeeBudget",
+			Handler:    _SwapClient_DeleteFeeBudget_Handler,
+		},
+		{
+			MethodName: "GetCostStatement",
+			Handler:    _SwapClient_GetCostStatement_Handler,
+		},
+		{
+			MethodName: "CreateSwapPlan",
+			Handler:    _SwapClient_CreateSwapPlan_Handler,
+		},
+		{
+			MethodName: "ListSwapPlans",
+			Handler:    _SwapClient_ListSwapPlans_Handler,
+		},
+		{
+			MethodName: "UpdateSwapPlan",
+			Handler:    _SwapClient_UpdateSwapPlan_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _SwapClient_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "BakeTenantMacaroon",
+			Handler:    _SwapClient_BakeTenantMacaroon_Handler,
+		},
+		{
+			MethodName: "SnapshotMissionControl",
+			Handler:    _SwapClient_SnapshotMissionControl_Handler,
+		},
+		{
+			MethodName: "ResetMissionControl",
+			Handler:    _SwapClient_ResetMissionControl_Handler,
+		},
+		{
+			MethodName: "RestoreMissionControl",
+			Handler:    _SwapClient_RestoreMissionControl_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -682,6 +2578,21 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SwapClient_Monitor_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DrainChannel",
+			Handler:       _SwapClient_DrainChannel_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FillChannel",
+			Handler:       _SwapClient_FillChannel_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExternalLoopIn",
+			Handler:       _SwapClient_ExternalLoopIn_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "client.proto",
 }
@@ -23,6 +23,29 @@ type DebugClient interface {
 	//mainnet. This endpoint ticks our autoloop timer, triggering automated
 	//dispatch of a swap if one is suggested.
 	ForceAutoLoop(ctx context.Context, in *ForceAutoLoopRequest, opts ...grpc.CallOption) (*ForceAutoLoopResponse, error)
+	//
+	//InjectServerFault is intended for *testing purposes only* and will not work
+	//on mainnet. This endpoint delays or fails calls to a swap server method,
+	//replacing any fault that was injected for the method.
+	InjectServerFault(ctx context.Context, in *InjectServerFaultRequest, opts ...grpc.CallOption) (*InjectServerFaultResponse, error)
+	//
+	//DropConfirmations is intended for *testing purposes only* and will not work
+	//on mainnet. This endpoint drops the next confirmation notifications that
+	//lnd delivers, so that the transactions appear never to confirm.
+	DropConfirmations(ctx context.Context, in *DropConfirmationsRequest, opts ...grpc.CallOption) (*DropConfirmationsResponse, error)
+	//
+	//SimulateReorg is intended for *testing purposes only* and will not work on
+	//mainnet. This endpoint notifies every block subscriber of a block below the
+	//tip that it was last notified of, as if the chain had reorged back to it.
+	SimulateReorg(ctx context.Context, in *SimulateReorgRequest, opts ...grpc.CallOption) (*SimulateReorgResponse, error)
+	//
+	//ListFaults is intended for *testing purposes only* and will not work on
+	//mainnet. This endpoint lists the faults that are currently injected.
+	ListFaults(ctx context.Context, in *ListFaultsRequest, opts ...grpc.CallOption) (*ListFaultsResponse, error)
+	//
+	//ClearFaults is intended for *testing purposes only* and will not work on
+	//mainnet. This endpoint removes all of the faults that are injected.
+	ClearFaults(ctx context.Context, in *ClearFaultsRequest, opts ...grpc.CallOption) (*ClearFaultsResponse, error)
 }
 
 type debugClient struct {
@@ -42,6 +65,51 @@ func (c *debugClient) ForceAutoLoop(ctx context.Context, in *ForceAutoLoopReques
 	return out, nil
 }
 
+func (c *debugClient) InjectServerFault(ctx context.Context, in *InjectServerFaultRequest, opts ...grpc.CallOption) (*InjectServerFaultResponse, error) {
+	out := new(InjectServerFaultResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.Debug/InjectServerFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) DropConfirmations(ctx context.Context, in *DropConfirmationsRequest, opts ...grpc.CallOption) (*DropConfirmationsResponse, error) {
+	out := new(DropConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.Debug/DropConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SimulateReorg(ctx context.Context, in *SimulateReorgRequest, opts ...grpc.CallOption) (*SimulateReorgResponse, error) {
+	out := new(SimulateReorgResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.Debug/SimulateReorg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListFaults(ctx context.Context, in *ListFaultsRequest, opts ...grpc.CallOption) (*ListFaultsResponse, error) {
+	out := new(ListFaultsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.Debug/ListFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ClearFaults(ctx context.Context, in *ClearFaultsRequest, opts ...grpc.CallOption) (*ClearFaultsResponse, error) {
+	out := new(ClearFaultsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.Debug/ClearFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 // All implementations must embed UnimplementedDebugServer
 // for forward compatibility
@@ -51,6 +119,29 @@ type DebugServer interface {
 	//mainnet. This endpoint ticks our autoloop timer, triggering automated
 	//dispatch of a swap if one is suggested.
 	ForceAutoLoop(context.Context, *ForceAutoLoopRequest) (*ForceAutoLoopResponse, error)
+	//
+	//InjectServerFault is intended for *testing purposes only* and will not work
+	//on mainnet. This endpoint delays or fails calls to a swap server method,
+	//replacing any fault that was injected for the method.
+	InjectServerFault(context.Context, *InjectServerFaultRequest) (*InjectServerFaultResponse, error)
+	//
+	//DropConfirmations is intended for *testing purposes only* and will not work
+	//on mainnet. This endpoint drops the next confirmation notifications that
+	//lnd delivers, so that the transactions appear never to confirm.
+	DropConfirmations(context.Context, *DropConfirmationsRequest) (*DropConfirmationsResponse, error)
+	//
+	//SimulateReorg is intended for *testing purposes only* and will not work on
+	//mainnet. This endpoint notifies every block subscriber of a block below the
+	//tip that it was last notified of, as if the chain had reorged back to it.
+	SimulateReorg(context.Context, *SimulateReorgRequest) (*SimulateReorgResponse, error)
+	//
+	//ListFaults is intended for *testing purposes only* and will not work on
+	//mainnet. This endpoint lists the faults that are currently injected.
+	ListFaults(context.Context, *ListFaultsRequest) (*ListFaultsResponse, error)
+	//
+	//ClearFaults is intended for *testing purposes only* and will not work on
+	//mainnet. This endpoint removes all of the faults that are injected.
+	ClearFaults(context.Context, *ClearFaultsRequest) (*ClearFaultsResponse, error)
 	mustEmbedUnimplementedDebugServer()
 }
 
@@ -61,6 +152,21 @@ type UnimplementedDebugServer struct {
 func (UnimplementedDebugServer) ForceAutoLoop(context.Context, *ForceAutoLoopRequest) (*ForceAutoLoopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceAutoLoop not implemented")
 }
+func (UnimplementedDebugServer) InjectServerFault(context.Context, *InjectServerFaultRequest) (*InjectServerFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectServerFault not implemented")
+}
+func (UnimplementedDebugServer) DropConfirmations(context.Context, *DropConfirmationsRequest) (*DropConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropConfirmations not implemented")
+}
+func (UnimplementedDebugServer) SimulateReorg(context.Context, *SimulateReorgRequest) (*SimulateReorgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateReorg not implemented")
+}
+func (UnimplementedDebugServer) ListFaults(context.Context, *ListFaultsRequest) (*ListFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFaults not implemented")
+}
+func (UnimplementedDebugServer) ClearFaults(context.Context, *ClearFaultsRequest) (*ClearFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearFaults not implemented")
+}
 func (UnimplementedDebugServer) mustEmbedUnimplementedDebugServer() {}
 
 // UnsafeDebugServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +198,96 @@ func _Debug_ForceAutoLoop_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_InjectServerFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InjectServerFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).InjectServerFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.Debug/InjectServerFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).InjectServerFault(ctx, req.(*InjectServerFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_DropConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).DropConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.Debug/DropConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).DropConfirmations(ctx, req.(*DropConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SimulateReorg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateReorgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SimulateReorg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.Debug/SimulateReorg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SimulateReorg(ctx, req.(*SimulateReorgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.Debug/ListFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListFaults(ctx, req.(*ListFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ClearFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ClearFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.Debug/ClearFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ClearFaults(ctx, req.(*ClearFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Debug_ServiceDesc is the grpc.ServiceDesc for Debug service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +299,26 @@ var Debug_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceAutoLoop",
 			Handler:    _Debug_ForceAutoLoop_Handler,
 		},
+		{
+			MethodName: "InjectServerFault",
+			Handler:    _Debug_InjectServerFault_Handler,
+		},
+		{
+			MethodName: "DropConfirmations",
+			Handler:    _Debug_DropConfirmations_Handler,
+		},
+		{
+			MethodName: "SimulateReorg",
+			Handler:    _Debug_SimulateReorg_Handler,
+		},
+		{
+			MethodName: "ListFaults",
+			Handler:    _Debug_ListFaults_Handler,
+		},
+		{
+			MethodName: "ClearFaults",
+			Handler:    _Debug_ClearFaults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "debug.proto",
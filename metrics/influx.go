@@ -0,0 +1,217 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultFlushInterval is the default interval at which we push
+	// buffered points to InfluxDB.
+	DefaultFlushInterval = time.Second * 10
+
+	// maxBufferedPoints is the maximum number of points that we buffer
+	// while InfluxDB is unreachable. Once this limit is reached, we drop
+	// our oldest points to bound our memory use.
+	maxBufferedPoints = 10000
+
+	// writeTimeout is the amount of time we allow for a single write to
+	// InfluxDB.
+	writeTimeout = time.Second * 30
+)
+
+// ErrNoInfluxURL is returned when an influx exporter is created without a
+// server URL.
+var ErrNoInfluxURL = errors.New("influx url required")
+
+// InfluxConfig contains the information required to push points to an
+// InfluxDB server.
+type InfluxConfig struct {
+	// URL is the base URL of the InfluxDB server.
+	URL string
+
+	// Token is the API token used to authenticate with the server.
+	Token string
+
+	// Org is the organization that our bucket belongs to.
+	Org string
+
+	// Bucket is the bucket that points are written to.
+	Bucket string
+
+	// FlushInterval is the interval at which we push buffered points to
+	// the server.
+	FlushInterval time.Duration
+}
+
+// InfluxExporter buffers points and periodically pushes them to an InfluxDB
+// server using its v2 write api.
+type InfluxExporter struct {
+	cfg      *InfluxConfig
+	writeURL string
+	client   *http.Client
+
+	// points contains the points that we have not yet written.
+	points []*Point
+
+	pointsLock sync.Mutex
+}
+
+// NewInfluxExporter creates an exporter that writes to the InfluxDB server
+// provided.
+func NewInfluxExporter(cfg *InfluxConfig) (*InfluxExporter, error) {
+	if cfg.URL == "" {
+		return nil, ErrNoInfluxURL
+	}
+
+	base, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("org", cfg.Org)
+	query.Set("bucket", cfg.Bucket)
+	query.Set("precision", "ns")
+
+	base.Path += "/api/v2/write"
+	base.RawQuery = query.Encode()
+
+	return &InfluxExporter{
+		cfg:      cfg,
+		writeURL: base.String(),
+		client: &http.Client{
+			Timeout: writeTimeout,
+		},
+	}, nil
+}
+
+// Record adds a point to our buffer, to be written on our next flush. This
+// call does not block on the server.
+func (i *InfluxExporter) Record(point *Point) {
+	i.pointsLock.Lock()
+	defer i.pointsLock.Unlock()
+
+	if len(i.points) >= maxBufferedPoints {
+		log.Warnf("Metrics buffer full, dropping oldest point")
+		i.points = i.points[1:]
+	}
+
+	i.points = append(i.points, point)
+}
+
+// Run periodically flushes our buffered points until the context provided is
+// cancelled.
+func (i *InfluxExporter) Run(ctx context.Context) error {
+	flushTicker := time.NewTicker(i.cfg.FlushInterval)
+	defer flushTicker.Stop()
+
+	for {
+		select {
+		case <-flushTicker.C:
+			if err := i.flush(ctx); err != nil {
+				log.Errorf("Metrics flush failed: %v", err)
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// flush writes all of our buffered points to the server. If the write fails,
+// the points are kept so that we can retry them on our next flush.
+func (i *InfluxExporter) flush(ctx context.Context) error {
+	i.pointsLock.Lock()
+	points := i.points
+	i.points = nil
+	i.pointsLock.Unlock()
+
+	if len(points) == 0 {
+		return nil
+	}
+
+	var (
+		body  bytes.Buffer
+		valid []*Point
+	)
+
+	for _, point := range points {
+		line, err := point.lineProtocol()
+		if err != nil {
+			// We drop invalid points rather than retrying them,
+			// because they will never be accepted.
+			log.Errorf("Dropping invalid metrics point: %v", err)
+			continue
+		}
+
+		body.WriteString(line)
+		body.WriteString("\n")
+		valid = append(valid, point)
+	}
+
+	if len(valid) == 0 {
+		return nil
+	}
+
+	if err := i.write(ctx, &body); err != nil {
+		i.requeue(valid)
+		return err
+	}
+
+	log.Debugf("Wrote %v metrics points", len(valid))
+
+	return nil
+}
+
+// write posts a set of encoded points to the server.
+func (i *InfluxExporter) write(ctx context.Context, body io.Reader) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, i.writeURL, body,
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if i.cfg.Token != "" {
+		req.Header.Set("Authorization", "Token "+i.cfg.Token)
+	}
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("influx write failed: %v: %s", resp.Status,
+			msg)
+	}
+
+	return nil
+}
+
+// requeue adds points that we failed to write back to the front of our
+// buffer, dropping the oldest points if we exceed our buffer limit.
+func (i *InfluxExporter) requeue(points []*Point) {
+	i.pointsLock.Lock()
+	defer i.pointsLock.Unlock()
+
+	i.points = append(points, i.points...)
+
+	if excess := len(i.points) - maxBufferedPoints; excess > 0 {
+		log.Warnf("Metrics buffer full, dropping %v oldest points",
+			excess)
+		i.points = i.points[excess:]
+	}
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestInfluxFlush tests writing of buffered points to an InfluxDB server, and
+// retrying of points when a write fails.
+func TestInfluxFlush(t *testing.T) {
+	var (
+		status   = http.StatusInternalServerError
+		requests = make(chan *http.Request, 1)
+		bodies   = make(chan string, 1)
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			requests <- r
+			bodies <- string(body)
+
+			w.WriteHeader(status)
+		},
+	))
+	defer server.Close()
+
+	exporter, err := NewInfluxExporter(&InfluxConfig{
+		URL:    server.URL,
+		Token:  "token",
+		Org:    "org",
+		Bucket: "bucket",
+	})
+	require.NoError(t, err)
+
+	// An empty buffer should not result in any writes.
+	require.NoError(t, exporter.flush(context.Background()))
+	require.Len(t, requests, 0)
+
+	exporter.Record(&Point{
+		Measurement: "swap",
+		Fields: map[string]interface{}{
+			"amount": int64(100),
+		},
+		Time: time.Unix(0, 1),
+	})
+
+	// Our first write fails, so we expect our point to be kept.
+	require.Error(t, exporter.flush(context.Background()))
+
+	req := <-requests
+	require.Equal(t, "/api/v2/write", req.URL.Path)
+	require.Equal(t, "org", req.URL.Query().Get("org"))
+	require.Equal(t, "bucket", req.URL.Query().Get("bucket"))
+	require.Equal(t, "Token token", req.Header.Get("Authorization"))
+	require.Equal(t, "swap amount=100i 1\n", <-bodies)
+	require.Len(t, exporter.points, 1)
+
+	// Once the server accepts our write, our buffer should be emptied.
+	status = http.StatusNoContent
+	require.NoError(t, exporter.flush(context.Background()))
+
+	<-requests
+	require.Equal(t, "swap amount=100i 1\n", <-bodies)
+	require.Len(t, exporter.points, 0)
+}
+
+// TestInfluxBufferLimit tests that we drop our oldest points once our buffer
+// is full.
+func TestInfluxBufferLimit(t *testing.T) {
+	exporter, err := NewInfluxExporter(&InfluxConfig{
+		URL: "http://localhost",
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < maxBufferedPoints+1; i++ {
+		exporter.Record(&Point{Time: time.Unix(0, int64(i))})
+	}
+
+	require.Len(t, exporter.points, maxBufferedPoints)
+	require.Equal(t, time.Unix(0, 1), exporter.points[0].Time)
+}
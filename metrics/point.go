@@ -0,0 +1,106 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// measurementEscaper escapes the characters that have special meaning
+	// in a measurement name.
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+
+	// keyEscaper escapes the characters that have special meaning in tag
+	// keys, tag values and field keys.
+	keyEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+)
+
+// Point is a single data point in a time series.
+type Point struct {
+	// Measurement is the name of the series that the point belongs to.
+	Measurement string
+
+	// Tags contains the indexed labels of the point, which can be used to
+	// group or filter points.
+	Tags map[string]string
+
+	// Fields contains the values recorded by the point. Values must be
+	// int64 or float64.
+	Fields map[string]interface{}
+
+	// Time is the time at which the values were recorded.
+	Time time.Time
+}
+
+// sortedKeys returns the keys of a map in lexicographic order.
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// lineProtocol encodes a point in the InfluxDB line protocol. Tags and fields
+// are written in key order so that encoding is deterministic. Tags with empty
+// values are omitted, because the line protocol does not allow them.
+func (p *Point) lineProtocol() (string, error) {
+	if len(p.Fields) == 0 {
+		return "", fmt.Errorf("point %v has no fields", p.Measurement)
+	}
+
+	var b strings.Builder
+	b.WriteString(measurementEscaper.Replace(p.Measurement))
+
+	for _, key := range sortedKeys(p.Tags) {
+		value := p.Tags[key]
+		if value == "" {
+			continue
+		}
+
+		b.WriteString(",")
+		b.WriteString(keyEscaper.Replace(key))
+		b.WriteString("=")
+		b.WriteString(keyEscaper.Replace(value))
+	}
+
+	fieldKeys := make([]string, 0, len(p.Fields))
+	for key := range p.Fields {
+		fieldKeys = append(fieldKeys, key)
+	}
+	sort.Strings(fieldKeys)
+
+	for i, key := range fieldKeys {
+		if i == 0 {
+			b.WriteString(" ")
+		} else {
+			b.WriteString(",")
+		}
+
+		b.WriteString(keyEscaper.Replace(key))
+		b.WriteString("=")
+
+		switch value := p.Fields[key].(type) {
+		case int64:
+			b.WriteString(strconv.FormatInt(value, 10))
+			b.WriteString("i")
+
+		case float64:
+			b.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+
+		default:
+			return "", fmt.Errorf("field %v has unsupported type: "+
+				"%T", key, value)
+		}
+	}
+
+	b.WriteString(" ")
+	b.WriteString(strconv.FormatInt(p.Time.UnixNano(), 10))
+
+	return b.String(), nil
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLineProtocol tests encoding of points in the InfluxDB line protocol.
+func TestLineProtocol(t *testing.T) {
+	timestamp := time.Unix(0, 1000)
+
+	tests := []struct {
+		name     string
+		point    *Point
+		expected string
+		err      bool
+	}{
+		{
+			name: "no fields",
+			point: &Point{
+				Measurement: "swap",
+				Time:        timestamp,
+			},
+			err: true,
+		},
+		{
+			name: "unsupported field",
+			point: &Point{
+				Measurement: "swap",
+				Fields: map[string]interface{}{
+					"amount": "100",
+				},
+				Time: timestamp,
+			},
+			err: true,
+		},
+		{
+			name: "sorted tags and fields",
+			point: &Point{
+				Measurement: "swap",
+				Tags: map[string]string{
+					"type": "loop_out",
+					"peer": "02aa",
+				},
+				Fields: map[string]interface{}{
+					"latency": 1.5,
+					"amount":  int64(100),
+				},
+				Time: timestamp,
+			},
+			expected: "swap,peer=02aa,type=loop_out " +
+				"amount=100i,latency=1.5 1000",
+		},
+		{
+			name: "empty tag omitted",
+			point: &Point{
+				Measurement: "swap",
+				Tags: map[string]string{
+					"peer": "",
+				},
+				Fields: map[string]interface{}{
+					"amount": int64(100),
+				},
+				Time: timestamp,
+			},
+			expected: "swap amount=100i 1000",
+		},
+		{
+			name: "escaped characters",
+			point: &Point{
+				Measurement: "swap stats",
+				Tags: map[string]string{
+					"a,b": "c=d e",
+				},
+				Fields: map[string]interface{}{
+					"total fee": int64(1),
+				},
+				Time: timestamp,
+			},
+			expected: `swap\ stats,a\,b=c\=d\ e total\ fee=1i 1000`,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			line, err := testCase.point.lineProtocol()
+			if testCase.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, line)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package loop
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+)
+
+// PaymentPacing limits the rate at which a large payment takes up our
+// outbound liquidity, so that it does not starve the htlcs that we forward
+// for others while it is dispatched.
+type PaymentPacing struct {
+	// MaxShardSize is the largest amount that a single shard of the
+	// payment may carry.
+	MaxShardSize btcutil.Amount
+
+	// ShardDelay is the delay between dispatching the shards of the
+	// payment. If zero, lnd dispatches all shards at once.
+	ShardDelay time.Duration
+}
+
+// PaymentRequest is a request to pay an invoice with options that the version
+// of lndclient that we use does not expose.
+type PaymentRequest struct {
+	lndclient.SendPaymentRequest
+
+	// Amp pays the invoice with an atomic multi-path payment (AMP). AMP
+	// payments are split into shards whose preimages are derived from a
+	// secret chosen by the sender, so lnd can spread them over more routes
+	// than a regular multi-path payment that is tied to a single payment
+	// hash.
+	Amp bool
+
+	// CustomRecords are custom TLV records that are sent to the recipient
+	// of the payment.
+	CustomRecords map[uint64][]byte
+
+	// Pacing limits the size and rate of the payment's shards. If nil,
+	// shards are not limited.
+	Pacing *PaymentPacing
+}
+
+// Payer dispatches payments with lnd that use options that lndclient does not
+// expose.
+type Payer interface {
+	// SendPayment pays the invoice in the request provided, and returns
+	// channels that deliver updates of the payment's status and any error
+	// that ends the payment's tracking.
+	SendPayment(ctx context.Context, req PaymentRequest) (
+		chan lndclient.PaymentStatus, chan error, error)
+}
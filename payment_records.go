@@ -0,0 +1,195 @@
+package loop
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/record"
+)
+
+// ParamPaymentRecord identifies a custom record that the server requested
+// for the payments of a loop out swap.
+const ParamPaymentRecord = "payment record"
+
+// PaymentRecordType is the type of a custom TLV record that we attach to the
+// swap or prepay payment of a loop out, so that the server can act on it.
+type PaymentRecordType uint64
+
+const (
+	// PaymentRecordBatchHint identifies the batch that the server may
+	// publish the swap's htlc in. Its value is an opaque identifier of up
+	// to 32 bytes that the server chooses.
+	PaymentRecordBatchHint PaymentRecordType = 1819242352
+
+	// PaymentRecordPriority flags that the server should prioritize the
+	// swap's htlc. Its value is a single byte holding the priority level.
+	PaymentRecordPriority PaymentRecordType = 1819242353
+)
+
+// String returns the name of a payment record type.
+func (t PaymentRecordType) String() string {
+	spec, ok := knownPaymentRecords[t]
+	if !ok {
+		return fmt.Sprintf("unknown(%d)", uint64(t))
+	}
+
+	return spec.name
+}
+
+// paymentRecordSpec describes a payment record type that we know of.
+type paymentRecordSpec struct {
+	// name is a human-readable name for the record type.
+	name string
+
+	// maxSize is the largest value that the record type may have.
+	maxSize int
+}
+
+// knownPaymentRecords is the registry of record types that we attach to swap
+// payments if the server requests them. We advertise these types to the
+// server, and drop any other records that it requests.
+var knownPaymentRecords = map[PaymentRecordType]paymentRecordSpec{
+	PaymentRecordBatchHint: {
+		name:    "batch hint",
+		maxSize: 32,
+	},
+	PaymentRecordPriority: {
+		name:    "priority",
+		maxSize: 1,
+	},
+}
+
+// supportedPaymentRecords returns the record types in our registry, in
+// ascending order.
+func supportedPaymentRecords() []uint64 {
+	types := make([]uint64, 0, len(knownPaymentRecords))
+	for recordType := range knownPaymentRecords {
+		types = append(types, uint64(recordType))
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+
+	return types
+}
+
+// paymentRecordTypes returns the custom record types that we offer to attach
+// to the payments of new loop outs. Custom records can only be sent by our
+// payer, so we do not offer any records without one.
+func (s *Client) paymentRecordTypes() []uint64 {
+	if s.executor.payer == nil {
+		return nil
+	}
+
+	return supportedPaymentRecords()
+}
+
+// validatePaymentRecord checks that a record requested by the server is of a
+// type that we advertised, and that its value is valid for the type.
+func validatePaymentRecord(supported []uint64,
+	rec loopdb.PaymentRecord) *ServerParamError {
+
+	recordType := PaymentRecordType(rec.Type)
+
+	if rec.Type < record.CustomTypeStart {
+		return anomalousParam(
+			ParamPaymentRecord, "type %v is not a custom record "+
+				"type", rec.Type,
+		)
+	}
+
+	var advertised bool
+	for _, supportedType := range supported {
+		if supportedType == rec.Type {
+			advertised = true
+			break
+		}
+	}
+
+	if !advertised {
+		return anomalousParam(
+			ParamPaymentRecord, "%v was not advertised",
+			recordType,
+		)
+	}
+
+	spec := knownPaymentRecords[recordType]
+	if len(rec.Value) == 0 || len(rec.Value) > spec.maxSize {
+		return anomalousParam(
+			ParamPaymentRecord, "%v value has length %v, expected "+
+				"1-%v", recordType, len(rec.Value), spec.maxSize,
+		)
+	}
+
+	return nil
+}
+
+// filterPaymentRecords returns the records that the server requested that we
+// attach to a swap's payments. Records that we did not advertise, have
+// invalid values or duplicate an earlier record for the same payment are
+// dropped, and reported as anomalies.
+func filterPaymentRecords(validator *serverValidator, hash lntypes.Hash,
+	supported []uint64, records []loopdb.PaymentRecord) (
+	[]loopdb.PaymentRecord, error) {
+
+	type paymentRecordKey struct {
+		recordType uint64
+		prepay     bool
+	}
+
+	var (
+		filtered []loopdb.PaymentRecord
+		seen     = make(map[paymentRecordKey]bool)
+	)
+
+	for _, rec := range records {
+		anomaly := validatePaymentRecord(supported, rec)
+
+		key := paymentRecordKey{
+			recordType: rec.Type,
+			prepay:     rec.Prepay,
+		}
+		if anomaly == nil && seen[key] {
+			anomaly = anomalousParam(
+				ParamPaymentRecord, "duplicate %v",
+				PaymentRecordType(rec.Type),
+			)
+		}
+
+		if anomaly != nil {
+			if err := validator.anomaly(hash, anomaly); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		seen[key] = true
+		filtered = append(filtered, rec)
+	}
+
+	return filtered, nil
+}
+
+// paymentRecords returns the custom records of a swap that are attached to
+// either its prepay or its swap payment, keyed by type.
+func paymentRecords(records []loopdb.PaymentRecord,
+	prepay bool) map[uint64][]byte {
+
+	var customRecords map[uint64][]byte
+	for _, rec := range records {
+		if rec.Prepay != prepay {
+			continue
+		}
+
+		if customRecords == nil {
+			customRecords = make(map[uint64][]byte)
+		}
+		customRecords[rec.Type] = rec.Value
+	}
+
+	return customRecords
+}
@@ -0,0 +1,136 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFilterPaymentRecords tests filtering of the custom records that the
+// server requests for a swap's payments.
+func TestFilterPaymentRecords(t *testing.T) {
+	batchHint := loopdb.PaymentRecord{
+		Type:  uint64(PaymentRecordBatchHint),
+		Value: []byte{1, 2, 3},
+	}
+	priority := loopdb.PaymentRecord{
+		Type:   uint64(PaymentRecordPriority),
+		Value:  []byte{1},
+		Prepay: true,
+	}
+
+	tests := []struct {
+		name      string
+		supported []uint64
+		records   []loopdb.PaymentRecord
+		strict    bool
+		filtered  []loopdb.PaymentRecord
+		err       error
+	}{
+		{
+			name:      "known records",
+			supported: supportedPaymentRecords(),
+			records: []loopdb.PaymentRecord{
+				batchHint, priority,
+			},
+			filtered: []loopdb.PaymentRecord{
+				batchHint, priority,
+			},
+		},
+		{
+			name: "not advertised",
+			records: []loopdb.PaymentRecord{
+				batchHint,
+			},
+			filtered: nil,
+		},
+		{
+			name: "not advertised, strict",
+			records: []loopdb.PaymentRecord{
+				batchHint,
+			},
+			strict: true,
+			err: anomalousParam(
+				ParamPaymentRecord, "batch hint was not "+
+					"advertised",
+			),
+		},
+		{
+			name:      "not custom",
+			supported: []uint64{100},
+			records: []loopdb.PaymentRecord{
+				{
+					Type:  100,
+					Value: []byte{1},
+				},
+			},
+			filtered: nil,
+		},
+		{
+			name:      "value too large",
+			supported: supportedPaymentRecords(),
+			records: []loopdb.PaymentRecord{
+				batchHint,
+				{
+					Type:  uint64(PaymentRecordPriority),
+					Value: []byte{1, 2},
+				},
+			},
+			filtered: []loopdb.PaymentRecord{
+				batchHint,
+			},
+		},
+		{
+			name:      "duplicate record",
+			supported: supportedPaymentRecords(),
+			records: []loopdb.PaymentRecord{
+				batchHint, batchHint,
+			},
+			filtered: []loopdb.PaymentRecord{
+				batchHint,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			filtered, err := filterPaymentRecords(
+				newServerValidator(testCase.strict),
+				lntypes.Hash{1}, testCase.supported,
+				testCase.records,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.filtered, filtered)
+		})
+	}
+}
+
+// TestPaymentRecords tests splitting of a swap's records between its swap and
+// prepay payments.
+func TestPaymentRecords(t *testing.T) {
+	records := []loopdb.PaymentRecord{
+		{
+			Type:  uint64(PaymentRecordBatchHint),
+			Value: []byte{1, 2, 3},
+		},
+		{
+			Type:   uint64(PaymentRecordPriority),
+			Value:  []byte{1},
+			Prepay: true,
+		},
+	}
+
+	require.Equal(t, map[uint64][]byte{
+		uint64(PaymentRecordBatchHint): {1, 2, 3},
+	}, paymentRecords(records, false))
+
+	require.Equal(t, map[uint64][]byte{
+		uint64(PaymentRecordPriority): {1},
+	}, paymentRecords(records, true))
+
+	require.Nil(t, paymentRecords(nil, false))
+}
@@ -0,0 +1,646 @@
+// Package qrcode encodes short payloads, such as payment uris, as QR codes
+// that can be rendered in a terminal. Only byte mode data with low error
+// correction is supported, in QR versions 1 to 10, which holds payloads of up
+// to 271 bytes.
+package qrcode
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// maxVersion is the largest QR version that we encode.
+	maxVersion = 10
+
+	// quietZone is the number of light modules that surround a code when
+	// it is rendered.
+	quietZone = 2
+
+	// formatECL is the format information value of the low error
+	// correction level.
+	formatECL = 1
+
+	// modeByte is the mode indicator of byte mode data.
+	modeByte = 4
+)
+
+// ErrTooLong is returned when a payload does not fit in the largest QR
+// version that we support.
+var ErrTooLong = errors.New("data too long for qr code")
+
+// blockSpec describes the error correction blocks of a QR version at the low
+// error correction level.
+type blockSpec struct {
+	// ecPerBlock is the number of error correction codewords of each
+	// block.
+	ecPerBlock int
+
+	// blocks1 is the number of blocks in the first group, which each
+	// hold data1 data codewords.
+	blocks1, data1 int
+
+	// blocks2 is the number of blocks in the second group, which each
+	// hold data2 data codewords.
+	blocks2, data2 int
+}
+
+// dataCodewords returns the total number of data codewords of the version.
+func (b blockSpec) dataCodewords() int {
+	return b.blocks1*b.data1 + b.blocks2*b.data2
+}
+
+var (
+	// blockSpecs holds the block structure of versions 1 to 10, indexed
+	// by version.
+	blockSpecs = [maxVersion + 1]blockSpec{
+		1:  {7, 1, 19, 0, 0},
+		2:  {10, 1, 34, 0, 0},
+		3:  {15, 1, 55, 0, 0},
+		4:  {20, 1, 80, 0, 0},
+		5:  {26, 1, 108, 0, 0},
+		6:  {18, 2, 68, 0, 0},
+		7:  {20, 2, 78, 0, 0},
+		8:  {24, 2, 97, 0, 0},
+		9:  {30, 2, 116, 0, 0},
+		10: {18, 2, 68, 2, 69},
+	}
+
+	// alignmentPositions holds the row and column coordinates of the
+	// alignment patterns of versions 1 to 10, indexed by version.
+	alignmentPositions = [maxVersion + 1][]int{
+		2:  {6, 18},
+		3:  {6, 22},
+		4:  {6, 26},
+		5:  {6, 30},
+		6:  {6, 34},
+		7:  {6, 22, 38},
+		8:  {6, 24, 42},
+		9:  {6, 26, 46},
+		10: {6, 28, 50},
+	}
+)
+
+// Code is an encoded QR code.
+type Code struct {
+	// Size is the number of modules on each side of the code.
+	Size int
+
+	// modules holds the color of each module, indexed by row and column.
+	// Dark modules are true.
+	modules [][]bool
+
+	// function marks the modules that belong to function patterns, which
+	// are not masked.
+	function [][]bool
+}
+
+// Dark returns whether the module at the column and row provided is dark.
+func (c *Code) Dark(x, y int) bool {
+	return c.modules[y][x]
+}
+
+// Encode encodes the data provided as a QR code with low error correction,
+// using the smallest version that holds it.
+func Encode(data []byte) (*Code, error) {
+	version := 0
+	for v := 1; v <= maxVersion; v++ {
+		if len(data)*8+headerBits(v) <= blockSpecs[v].dataCodewords()*8 {
+			version = v
+			break
+		}
+	}
+
+	if version == 0 {
+		return nil, ErrTooLong
+	}
+
+	codewords := addErrorCorrection(version, dataCodewords(version, data))
+
+	// We draw the code once for each mask, and pick the mask with the
+	// lowest penalty.
+	var (
+		best        *Code
+		bestPenalty int
+	)
+	for mask := 0; mask < 8; mask++ {
+		code := newCode(version)
+		code.drawFunctionPatterns(version, mask)
+		code.drawCodewords(codewords)
+		code.applyMask(mask)
+
+		penalty := code.penalty()
+		if best == nil || penalty < bestPenalty {
+			best = code
+			bestPenalty = penalty
+		}
+	}
+
+	return best, nil
+}
+
+// headerBits returns the number of bits that the mode indicator and
+// character count of byte mode data take up in the version provided.
+func headerBits(version int) int {
+	if version < 10 {
+		return 4 + 8
+	}
+
+	return 4 + 16
+}
+
+// bitBuffer accumulates the bits of a code's data.
+type bitBuffer struct {
+	bytes []byte
+	len   int
+}
+
+// put appends the lowest count bits of value, most significant bit first.
+func (b *bitBuffer) put(value, count int) {
+	for i := count - 1; i >= 0; i-- {
+		if b.len%8 == 0 {
+			b.bytes = append(b.bytes, 0)
+		}
+
+		if (value>>uint(i))&1 == 1 {
+			b.bytes[b.len/8] |= 0x80 >> uint(b.len%8)
+		}
+		b.len++
+	}
+}
+
+// dataCodewords encodes the data provided in byte mode and pads it to the
+// data capacity of the version provided.
+func dataCodewords(version int, data []byte) []byte {
+	capacity := blockSpecs[version].dataCodewords()
+
+	var buf bitBuffer
+	buf.put(modeByte, 4)
+	buf.put(len(data), headerBits(version)-4)
+	for _, b := range data {
+		buf.put(int(b), 8)
+	}
+
+	// Add a terminator of up to four zero bits, and pad to a byte
+	// boundary.
+	terminator := capacity*8 - buf.len
+	if terminator > 4 {
+		terminator = 4
+	}
+	buf.put(0, terminator)
+	buf.put(0, (8-buf.len%8)%8)
+
+	// Fill the remaining capacity with alternating pad bytes.
+	for pad := 0xec; len(buf.bytes) < capacity; pad ^= 0xec ^ 0x11 {
+		buf.bytes = append(buf.bytes, byte(pad))
+	}
+
+	return buf.bytes
+}
+
+// addErrorCorrection splits the data codewords provided into the blocks of
+// the version provided, computes the error correction codewords of each
+// block and returns the interleaved codewords of all blocks.
+func addErrorCorrection(version int, data []byte) []byte {
+	spec := blockSpecs[version]
+	divisor := rsDivisor(spec.ecPerBlock)
+
+	var dataBlocks, ecBlocks [][]byte
+	addBlocks := func(count, size int) {
+		for i := 0; i < count; i++ {
+			block := data[:size]
+			data = data[size:]
+
+			dataBlocks = append(dataBlocks, block)
+			ecBlocks = append(ecBlocks, rsRemainder(block, divisor))
+		}
+	}
+	addBlocks(spec.blocks1, spec.data1)
+	addBlocks(spec.blocks2, spec.data2)
+
+	var result []byte
+	interleave := func(blocks [][]byte) {
+		for i := 0; ; i++ {
+			added := false
+			for _, block := range blocks {
+				if i < len(block) {
+					result = append(result, block[i])
+					added = true
+				}
+			}
+
+			if !added {
+				return
+			}
+		}
+	}
+	interleave(dataBlocks)
+	interleave(ecBlocks)
+
+	return result
+}
+
+// gfMultiply multiplies two elements of the galois field GF(2^8) that QR
+// codes use, modulo x^8 + x^4 + x^3 + x^2 + 1.
+func gfMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11d)
+		z ^= ((int(y) >> uint(i)) & 1) * int(x)
+	}
+
+	return byte(z)
+}
+
+// rsDivisor returns the coefficients of the reed-solomon generator
+// polynomial of the degree provided, excluding the leading coefficient.
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	// Multiply the polynomial by (x - r^i) for i in [0, degree), where r
+	// is the generator element 0x02.
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+// rsRemainder returns the reed-solomon error correction codewords of the
+// data provided.
+func rsRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+
+		for i, coef := range divisor {
+			result[i] ^= gfMultiply(coef, factor)
+		}
+	}
+
+	return result
+}
+
+// newCode returns an empty code of the version provided.
+func newCode(version int) *Code {
+	size := version*4 + 17
+
+	code := &Code{
+		Size:     size,
+		modules:  make([][]bool, size),
+		function: make([][]bool, size),
+	}
+	for i := 0; i < size; i++ {
+		code.modules[i] = make([]bool, size)
+		code.function[i] = make([]bool, size)
+	}
+
+	return code
+}
+
+// setFunction sets the module at the column and row provided as part of a
+// function pattern.
+func (c *Code) setFunction(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+// drawFunctionPatterns draws the finder, timing and alignment patterns of the
+// code, along with its format and version information.
+func (c *Code) drawFunctionPatterns(version, mask int) {
+	for i := 0; i < c.Size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+
+	c.drawFinder(3, 3)
+	c.drawFinder(c.Size-4, 3)
+	c.drawFinder(3, c.Size-4)
+
+	positions := alignmentPositions[version]
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// Alignment patterns are not drawn over the finder
+			// patterns.
+			if (i == 0 && j == 0) || (i == 0 && j == last) ||
+				(i == last && j == 0) {
+
+				continue
+			}
+
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					c.setFunction(
+						x+dx, y+dy,
+						maxAbs(dx, dy) != 1,
+					)
+				}
+			}
+		}
+	}
+
+	c.drawFormat(mask)
+	c.drawVersion(version)
+}
+
+// drawFinder draws a finder pattern and its separator centered on the column
+// and row provided.
+func (c *Code) drawFinder(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= c.Size || yy < 0 || yy >= c.Size {
+				continue
+			}
+
+			dist := maxAbs(dx, dy)
+			c.setFunction(xx, yy, dist != 2 && dist != 4)
+		}
+	}
+}
+
+// drawFormat draws both copies of the code's format information, which holds
+// its error correction level and mask.
+func (c *Code) drawFormat(mask int) {
+	data := formatECL<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	bit := func(i int) bool {
+		return (bits>>uint(i))&1 == 1
+	}
+
+	// Draw the first copy around the top left finder.
+	for i := 0; i <= 5; i++ {
+		c.setFunction(8, i, bit(i))
+	}
+	c.setFunction(8, 7, bit(6))
+	c.setFunction(8, 8, bit(7))
+	c.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(i))
+	}
+
+	// Draw the second copy next to the other two finders.
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(i))
+	}
+
+	// The module above the bottom left finder is always dark.
+	c.setFunction(8, c.Size-8, true)
+}
+
+// drawVersion draws both copies of the code's version information, which is
+// only present from version 7 onwards.
+func (c *Code) drawVersion(version int) {
+	if version < 7 {
+		return
+	}
+
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1f25)
+	}
+	bits := version<<12 | rem
+
+	for i := 0; i < 18; i++ {
+		dark := (bits>>uint(i))&1 == 1
+		a, b := c.Size-11+i%3, i/3
+
+		c.setFunction(a, b, dark)
+		c.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords places the codewords provided in the modules that are not
+// part of a function pattern, in the zigzag order that QR codes use.
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		// The vertical timing pattern is skipped.
+		if right == 6 {
+			right = 5
+		}
+
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+
+				y := vert
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vert
+				}
+
+				if c.function[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+
+				c.modules[y][x] =
+					(codewords[i/8]>>uint(7-i%8))&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+// applyMask inverts the modules that are not part of function patterns and
+// are selected by the mask provided.
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.function[y][x] {
+				continue
+			}
+
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+
+			if invert {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the code with the penalty rules that QR encoders use to
+// pick a mask. Codes with lower penalties are easier to scan.
+func (c *Code) penalty() int {
+	var (
+		result int
+		dark   int
+	)
+
+	// finderLike are the module sequences that resemble a finder
+	// pattern.
+	finderLike := [][]bool{
+		{true, false, true, true, true, false, true, false, false,
+			false, false},
+		{false, false, false, false, true, false, true, true, true,
+			false, true},
+	}
+
+	for i := 0; i < c.Size; i++ {
+		row := make([]bool, c.Size)
+		col := make([]bool, c.Size)
+		for j := 0; j < c.Size; j++ {
+			row[j] = c.modules[i][j]
+			col[j] = c.modules[j][i]
+
+			if row[j] {
+				dark++
+			}
+		}
+
+		for _, line := range [][]bool{row, col} {
+			// Penalize runs of five or more modules of the same
+			// color.
+			run := 1
+			for j := 1; j <= len(line); j++ {
+				if j < len(line) && line[j] == line[j-1] {
+					run++
+					continue
+				}
+
+				if run >= 5 {
+					result += run - 2
+				}
+				run = 1
+			}
+
+			// Penalize patterns that resemble a finder.
+			for j := 0; j+len(finderLike[0]) <= len(line); j++ {
+				for _, pattern := range finderLike {
+					if matches(line[j:], pattern) {
+						result += 40
+					}
+				}
+			}
+		}
+	}
+
+	// Penalize two by two blocks of the same color.
+	for y := 0; y < c.Size-1; y++ {
+		for x := 0; x < c.Size-1; x++ {
+			color := c.modules[y][x]
+			if color == c.modules[y][x+1] &&
+				color == c.modules[y+1][x] &&
+				color == c.modules[y+1][x+1] {
+
+				result += 3
+			}
+		}
+	}
+
+	// Penalize codes whose share of dark modules is far from half, in
+	// steps of five percent.
+	total := c.Size * c.Size
+	deviation := dark*20 - total*10
+	if deviation < 0 {
+		deviation = -deviation
+	}
+	result += deviation / total * 10
+
+	return result
+}
+
+// matches returns whether the line provided starts with the pattern provided.
+func matches(line, pattern []bool) bool {
+	for i, module := range pattern {
+		if line[i] != module {
+			return false
+		}
+	}
+
+	return true
+}
+
+// maxAbs returns the larger absolute value of the two values provided.
+func maxAbs(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// Terminal renders the code with unicode block characters, two rows of
+// modules per line of text. Light modules are drawn as filled blocks, so that
+// the code scans on terminals with a dark background.
+func (c *Code) Terminal() string {
+	light := func(x, y int) bool {
+		x, y = x-quietZone, y-quietZone
+		if x < 0 || x >= c.Size || y < 0 || y >= c.Size {
+			return true
+		}
+
+		return !c.modules[y][x]
+	}
+
+	var (
+		sb    strings.Builder
+		width = c.Size + 2*quietZone
+	)
+	for y := 0; y < width; y += 2 {
+		for x := 0; x < width; x++ {
+			top := light(x, y)
+
+			// The last line only holds one row of modules if the
+			// width is odd.
+			bottom := y+1 < width && light(x, y+1)
+
+			switch {
+			case top && bottom:
+				sb.WriteString("█")
+			case top:
+				sb.WriteString("▀")
+			case bottom:
+				sb.WriteString("▄")
+			default:
+				sb.WriteString(" ")
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,121 @@
+package qrcode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestReedSolomon tests our error correction codewords against the codewords
+// of a well known version 1 example.
+func TestReedSolomon(t *testing.T) {
+	data := []byte{
+		32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17,
+		236, 17,
+	}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	require.Equal(t, expected, rsRemainder(data, rsDivisor(10)))
+}
+
+// TestEncodeVersion tests that data is encoded in the smallest version that
+// holds it.
+func TestEncodeVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		length  int
+		size    int
+		tooLong bool
+	}{
+		{
+			name:   "empty",
+			length: 0,
+			size:   21,
+		},
+		{
+			name:   "full version 1",
+			length: 17,
+			size:   21,
+		},
+		{
+			name:   "version 2",
+			length: 18,
+			size:   25,
+		},
+		{
+			name:   "full version 9",
+			length: 230,
+			size:   53,
+		},
+		{
+			name:   "full version 10",
+			length: 271,
+			size:   57,
+		},
+		{
+			name:    "too long",
+			length:  272,
+			tooLong: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			data := bytes.Repeat([]byte{'a'}, testCase.length)
+
+			code, err := Encode(data)
+			if testCase.tooLong {
+				require.Equal(t, ErrTooLong, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.size, code.Size)
+		})
+	}
+}
+
+// TestEncodePatterns tests that encoded codes hold their finder patterns and
+// the dark module that every code has.
+func TestEncodePatterns(t *testing.T) {
+	code, err := Encode([]byte("bitcoin:bc1qw508d6qejxtdg4y5r3zarvary0c5" +
+		"xw7kv8f3t4?amount=0.0025"))
+	require.NoError(t, err)
+
+	for _, corner := range [][2]int{
+		{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7},
+	} {
+		x, y := corner[0], corner[1]
+
+		// The outer ring and center of each finder are dark, and the
+		// ring between them is light.
+		require.True(t, code.Dark(x, y))
+		require.True(t, code.Dark(x+6, y+6))
+		require.False(t, code.Dark(x+1, y+1))
+		require.True(t, code.Dark(x+3, y+3))
+	}
+
+	require.True(t, code.Dark(8, code.Size-8))
+}
+
+// TestTerminal tests the dimensions of codes that are rendered for a
+// terminal.
+func TestTerminal(t *testing.T) {
+	code, err := Encode([]byte("loop"))
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(code.Terminal(), "\n"), "\n")
+
+	// Each line holds two rows of modules, including our quiet zone.
+	width := code.Size + 2*quietZone
+	require.Len(t, lines, (width+1)/2)
+
+	for _, line := range lines {
+		require.Equal(t, width, utf8.RuneCountInString(line))
+	}
+}
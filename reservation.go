@@ -0,0 +1,718 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// DefaultReservationTTL is the amount of time that a reserved swap may
+	// be confirmed within. We keep this short, because the server's quote
+	// and the on-chain fee environment the reservation was made in may be
+	// outdated soon after.
+	DefaultReservationTTL = 10 * time.Minute
+
+	// ErrReservationNotFound is returned when a reservation is confirmed
+	// that does not exist, or has already been confirmed.
+	ErrReservationNotFound = errors.New("swap reservation not found")
+
+	// ErrReservationExpired is returned when a reservation is confirmed
+	// after its expiry.
+	ErrReservationExpired = errors.New("swap reservation expired")
+
+	// ErrApprovalRequired is returned when a reservation that requires
+	// approval is confirmed, or a reservation that does not require
+	// approval is approved.
+	ErrApprovalRequired = errors.New("swap reservation must be approved")
+
+	// ErrSelfApproval is returned when a swap is approved by the same
+	// credential that requested it.
+	ErrSelfApproval = errors.New("swaps cannot be approved by their " +
+		"requester")
+)
+
+// SwapReservation describes a swap that has been agreed with the server, but
+// which will only be executed once it is confirmed.
+type SwapReservation struct {
+	// SwapHash is the hash identifying the swap.
+	SwapHash lntypes.Hash
+
+	// SwapType is the type of the reserved swap.
+	SwapType swap.Type
+
+	// Amount is the amount of the swap.
+	Amount btcutil.Amount
+
+	// SwapFee is the final fee charged by the server for the swap.
+	SwapFee btcutil.Amount
+
+	// PrepayAmount is the amount of the prepay invoice that is paid to the
+	// server. It is only set for loop out swaps, and is included in the
+	// swap fee.
+	PrepayAmount btcutil.Amount
+
+	// MaxMinerFee is the maximum on-chain fee that we will pay for the
+	// swap.
+	MaxMinerFee btcutil.Amount
+
+	// CltvExpiry is the expiry height of the swap htlc.
+	CltvExpiry int32
+
+	// HtlcAddressP2WSH is the native segwit address of the swap htlc.
+	HtlcAddressP2WSH btcutil.Address
+
+	// HtlcAddressNP2WSH is the nested segwit address of the swap htlc. It
+	// is only set for loop in swaps with an external htlc.
+	HtlcAddressNP2WSH btcutil.Address
+
+	// Expiry is the time after which the reservation can no longer be
+	// confirmed.
+	Expiry time.Time
+
+	// ServerMessage is the human-readable message received from the loop
+	// server.
+	ServerMessage string
+
+	// StructuredServerMessage is the structured message received from the
+	// loop server.
+	StructuredServerMessage *loopdb.ServerMessage
+
+	// RequiresApproval indicates that the swap can only be executed by
+	// approving it, rather than confirming it.
+	RequiresApproval bool
+
+	// Requester identifies the credential that requested a swap that
+	// requires approval. It may not approve the swap itself.
+	Requester string
+}
+
+// pendingReservation is a swap that has been reserved, but not yet confirmed.
+type pendingReservation struct {
+	// info describes the reservation.
+	info *SwapReservation
+
+	// swap is the swap that is executed once the reservation is
+	// confirmed.
+	swap genericSwap
+
+	// persist stores the swap, so that it will be resumed on restart.
+	persist func() error
+
+	// contract is the contract of the swap that is stored when the
+	// reservation is confirmed.
+	contract *loopdb.SwapContract
+
+	// release releases any resources held for the swap when the
+	// reservation expires. It may be nil.
+	release func(ctx context.Context) error
+
+	// overrideVolumeLimits indicates that the swap may exceed our volume
+	// limits when it is confirmed.
+	overrideVolumeLimits bool
+
+	// maxFees is the maximum fees that the swap may spend, which count
+	// towards the fee budgets of its tenant and label when it is
+	// confirmed.
+	maxFees btcutil.Amount
+
+	// walletFunds is the amount that a loop in may spend from our wallet,
+	// which is reserved when it is confirmed.
+	walletFunds btcutil.Amount
+}
+
+// ReserveLoopOut initiates a loop out swap with the server and validates it,
+// but does not execute it until ConfirmReservation is called with its hash
+// within DefaultReservationTTL.
+func (s *Client) ReserveLoopOut(globalCtx context.Context,
+	request *OutRequest) (*SwapReservation, error) {
+
+	return s.reserveLoopOut(
+		globalCtx, request, DefaultReservationTTL, false, "",
+	)
+}
+
+// RequestLoopOutApproval initiates a loop out swap with the server and
+// validates it, but does not execute it until ApproveSwap is called with its
+// hash within the timeout provided, by a different credential than the
+// requester's.
+func (s *Client) RequestLoopOutApproval(globalCtx context.Context,
+	request *OutRequest, requester string,
+	timeout time.Duration) (*SwapReservation, error) {
+
+	return s.reserveLoopOut(globalCtx, request, timeout, true, requester)
+}
+
+// reserveLoopOut initiates and validates a loop out swap, and adds it to our
+// set of reservations.
+func (s *Client) reserveLoopOut(globalCtx context.Context, request *OutRequest,
+	ttl time.Duration, requiresApproval bool,
+	requester string) (*SwapReservation, error) {
+
+	log.Infof("Reserve loop out %v to %v (channels: %v, requires "+
+		"approval: %v)", request.Amount, request.DestAddr,
+		request.OutgoingChanSet, requiresApproval,
+	)
+
+	s.pruneReservations(globalCtx)
+
+	initiationHeight, err := s.prepareLoopOut(globalCtx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// We check our volume limits and fee budgets when the swap is
+	// reserved, so that we don't set up swaps that can't be confirmed.
+	// They are checked again on confirmation, since other swaps may have
+	// been created since.
+	releaseVolume, err := s.reserveVolume(
+		request.Amount, request.OverrideVolumeLimits,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseVolume()
+
+	releaseFees, err := s.reserveFees(
+		request.Tenant, request.Label, maxLoopOutFees(request),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFees()
+
+	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+	swapCfg.serverAddress = s.ServerAddress
+	swapCfg.validator = s.validator
+	swapCfg.amountTiers = s.AmountTiers
+	swapCfg.paymentRecords = s.paymentRecordTypes()
+	initResult, err := initLoopOutSwap(
+		globalCtx, swapCfg, initiationHeight, request,
+	)
+	if err != nil {
+		return nil, err
+	}
+	swap := initResult.swap
+
+	return s.addReservation(globalCtx, &pendingReservation{
+		info: &SwapReservation{
+			SwapHash:         swap.hash,
+			SwapType:         swap.swapType,
+			Amount:           request.Amount,
+			SwapFee:          initResult.swapFee,
+			PrepayAmount:     initResult.prepayAmount,
+			MaxMinerFee:      request.MaxMinerFee,
+			CltvExpiry:       request.Expiry,
+			HtlcAddressP2WSH: swap.htlc.Address,
+			ServerMessage:    initResult.serverMessage,
+			RequiresApproval: requiresApproval,
+			Requester:        requester,
+
+			StructuredServerMessage: initResult.structuredMessage,
+		},
+		swap:     swap,
+		persist:  swap.persist,
+		contract: &swap.SwapContract,
+
+		overrideVolumeLimits: request.OverrideVolumeLimits,
+		maxFees:              maxLoopOutFees(request),
+	}, ttl)
+}
+
+// ReserveLoopIn initiates a loop in swap with the server and validates it,
+// but does not execute it until ConfirmReservation is called with its hash
+// within DefaultReservationTTL.
+func (s *Client) ReserveLoopIn(globalCtx context.Context,
+	request *LoopInRequest) (*SwapReservation, error) {
+
+	return s.reserveLoopIn(
+		globalCtx, request, DefaultReservationTTL, false, "",
+	)
+}
+
+// RequestLoopInApproval initiates a loop in swap with the server and
+// validates it, but does not execute it until ApproveSwap is called with its
+// hash within the timeout provided, by a different credential than the
+// requester's.
+func (s *Client) RequestLoopInApproval(globalCtx context.Context,
+	request *LoopInRequest, requester string,
+	timeout time.Duration) (*SwapReservation, error) {
+
+	return s.reserveLoopIn(globalCtx, request, timeout, true, requester)
+}
+
+// reserveLoopIn initiates and validates a loop in swap, and adds it to our set
+// of reservations.
+func (s *Client) reserveLoopIn(globalCtx context.Context,
+	request *LoopInRequest, ttl time.Duration, requiresApproval bool,
+	requester string) (*SwapReservation, error) {
+
+	log.Infof("Reserve loop in %v (last hop: %v, requires approval: %v)",
+		request.Amount, request.LastHop, requiresApproval)
+
+	s.pruneReservations(globalCtx)
+
+	if err := s.waitForInitialized(globalCtx); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateHtlcFunding(request); err != nil {
+		return nil, err
+	}
+
+	releaseVolume, err := s.reserveVolume(
+		request.Amount, request.OverrideVolumeLimits,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseVolume()
+
+	releaseFees, err := s.reserveFees(
+		request.Tenant, request.Label, maxLoopInFees(request),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFees()
+
+	// We check that our wallet can fund the loop in now so that we fail
+	// fast, but only reserve its funds once the swap is confirmed.
+	walletFunds := loopInWalletFunds(request)
+	releaseFunds, err := s.reserveWalletFunds(globalCtx, walletFunds)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFunds()
+
+	initiationHeight := s.executor.height()
+	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+	swapCfg.serverAddress = s.ServerAddress
+	swapCfg.validator = s.validator
+	swapCfg.amountTiers = s.AmountTiers
+	initResult, err := initLoopInSwap(
+		globalCtx, swapCfg, initiationHeight, request,
+	)
+	if err != nil {
+		return nil, err
+	}
+	swap := initResult.swap
+
+	// If the reservation expires, we cancel the swap invoice that we
+	// created so that the server cannot pay it.
+	cancelInvoice := func(ctx context.Context) error {
+		return s.lndServices.Invoices.CancelInvoice(ctx, swap.hash)
+	}
+
+	info := &SwapReservation{
+		SwapHash:         swap.hash,
+		SwapType:         swap.swapType,
+		Amount:           request.Amount,
+		SwapFee:          initResult.swapFee,
+		MaxMinerFee:      request.MaxMinerFee,
+		CltvExpiry:       swap.CltvExpiry,
+		HtlcAddressP2WSH: swap.htlcP2WSH.Address,
+		ServerMessage:    initResult.serverMessage,
+		RequiresApproval: requiresApproval,
+		Requester:        requester,
+
+		StructuredServerMessage: initResult.structuredMessage,
+	}
+
+	if request.ExternalHtlc {
+		info.HtlcAddressNP2WSH = swap.htlcNP2WSH.Address
+	}
+
+	return s.addReservation(globalCtx, &pendingReservation{
+		info:     info,
+		swap:     swap,
+		persist:  swap.persist,
+		contract: &swap.SwapContract,
+		release:  cancelInvoice,
+
+		overrideVolumeLimits: request.OverrideVolumeLimits,
+		maxFees:              maxLoopInFees(request),
+		walletFunds:          walletFunds,
+	}, ttl)
+}
+
+// ConfirmReservation persists and executes the reserved swap with the hash
+// provided. Each reservation may only be confirmed once, and reservations
+// that require approval must be approved instead.
+func (s *Client) ConfirmReservation(ctx context.Context,
+	hash lntypes.Hash) (*SwapReservation, error) {
+
+	return s.executeReservation(ctx, hash, nil)
+}
+
+// ApproveSwap persists and executes the swap with the hash provided, which
+// must be awaiting approval. The identities of the swap's requester and
+// approver are recorded with the swap, and must differ.
+func (s *Client) ApproveSwap(ctx context.Context, hash lntypes.Hash,
+	approver string) (*SwapReservation, error) {
+
+	if len(approver) > loopdb.MaxApproverLength {
+		return nil, loopdb.ErrApproverTooLong
+	}
+
+	return s.executeReservation(ctx, hash, &loopdb.SwapApproval{
+		Approver: approver,
+		Time:     time.Now(),
+	})
+}
+
+// DenySwap removes the swap with the hash provided, which must be awaiting
+// approval, and releases its resources. The denial is stored with the
+// denier's identity and the reason given.
+func (s *Client) DenySwap(ctx context.Context, hash lntypes.Hash, denier,
+	reason string) error {
+
+	if len(denier) > loopdb.MaxApproverLength {
+		return loopdb.ErrApproverTooLong
+	}
+
+	if len(reason) > loopdb.MaxDenialReasonLength {
+		return loopdb.ErrDenialReasonTooLong
+	}
+
+	reservation, err := s.takeReservation(hash, true, "")
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Swap %v denied by %v: %v", hash, denier, reason)
+
+	denial := newSwapDenial(reservation.info, time.Now())
+	denial.Denier = denier
+	denial.Reason = reason
+	s.releaseReservation(ctx, reservation, denial)
+
+	return nil
+}
+
+// SwapDenials returns the swaps that required approval, but were denied or
+// expired instead, in chronological order.
+func (s *Client) SwapDenials() ([]*loopdb.SwapDenial, error) {
+	return s.Store.FetchSwapDenials()
+}
+
+// PendingApprovals returns all the swaps that are awaiting approval.
+func (s *Client) PendingApprovals(ctx context.Context) []*SwapReservation {
+	s.pruneReservations(ctx)
+
+	s.reservationsLock.Lock()
+	defer s.reservationsLock.Unlock()
+
+	var pending []*SwapReservation
+	for _, reservation := range s.reservations {
+		if reservation.info.RequiresApproval {
+			pending = append(pending, reservation.info)
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Expiry.Before(pending[j].Expiry)
+	})
+
+	return pending
+}
+
+// takeReservation removes the reservation with the hash provided from our set
+// of reservations, provided that its approval requirement matches. If an
+// approver is provided, it must not be the requester of the swap.
+func (s *Client) takeReservation(hash lntypes.Hash, approval bool,
+	approver string) (*pendingReservation, error) {
+
+	s.reservationsLock.Lock()
+	defer s.reservationsLock.Unlock()
+
+	reservation, ok := s.reservations[hash]
+	if !ok {
+		return nil, ErrReservationNotFound
+	}
+
+	if reservation.info.RequiresApproval != approval {
+		return nil, ErrApprovalRequired
+	}
+
+	// We leave the reservation in place if it is approved by its
+	// requester, so that it can still be approved by someone else.
+	if approval && approver == reservation.info.Requester {
+		return nil, ErrSelfApproval
+	}
+
+	delete(s.reservations, hash)
+
+	return reservation, nil
+}
+
+// executeReservation persists and executes a reserved swap. Swaps that
+// require approval must be executed with an approval, which is stored with
+// the swap.
+func (s *Client) executeReservation(ctx context.Context, hash lntypes.Hash,
+	approval *loopdb.SwapApproval) (*SwapReservation, error) {
+
+	var approver string
+	if approval != nil {
+		approver = approval.Approver
+	}
+
+	reservation, err := s.takeReservation(hash, approval != nil, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Now().After(reservation.info.Expiry) {
+		s.releaseReservation(
+			ctx, reservation, newExpiredDenial(reservation.info),
+		)
+		return nil, ErrReservationExpired
+	}
+
+	if approval != nil {
+		log.Infof("Swap %v approved by %v", hash, approval.Approver)
+	} else {
+		log.Infof("Confirming reserved swap %v", hash)
+	}
+
+	releaseVolume, err := s.reserveVolume(
+		reservation.info.Amount, reservation.overrideVolumeLimits,
+	)
+	if err != nil {
+		s.releaseReservation(ctx, reservation, nil)
+		return nil, err
+	}
+	defer releaseVolume()
+
+	releaseFees, err := s.reserveFees(
+		reservation.contract.Tenant, reservation.contract.Label,
+		reservation.maxFees,
+	)
+	if err != nil {
+		s.releaseReservation(ctx, reservation, nil)
+		return nil, err
+	}
+	defer releaseFees()
+
+	releaseFunds, err := s.reserveWalletFunds(
+		ctx, reservation.walletFunds,
+	)
+	if err != nil {
+		s.releaseReservation(ctx, reservation, nil)
+		return nil, err
+	}
+	defer releaseFunds()
+
+	if approval != nil {
+		approval.Requester = reservation.info.Requester
+	}
+
+	reservation.contract.Approval = approval
+	if err := reservation.persist(); err != nil {
+		s.releaseReservation(ctx, reservation, nil)
+		return nil, err
+	}
+
+	// Now that the swap is stored, it no longer needs to be released if
+	// we restart.
+	if reservation.info.RequiresApproval {
+		err := s.Store.DeletePendingApproval(hash)
+		if err != nil {
+			log.Errorf("Could not remove approved swap %v from "+
+				"pending approvals: %v", hash, err)
+		}
+	}
+
+	// Post swap to the main loop.
+	s.executor.initiateSwap(ctx, reservation.swap)
+
+	return reservation.info, nil
+}
+
+// addReservation sets the expiry of a reservation and adds it to our set of
+// pending reservations. Reservations are only held in memory, so reservations
+// that require approval are also stored as pending approvals, which lets us
+// release them if we restart before they are approved.
+func (s *Client) addReservation(ctx context.Context,
+	reservation *pendingReservation,
+	ttl time.Duration) (*SwapReservation, error) {
+
+	reservation.info.Expiry = time.Now().Add(ttl)
+
+	if reservation.info.RequiresApproval {
+		err := s.Store.PutPendingApproval(&loopdb.PendingApproval{
+			Hash:      reservation.info.SwapHash,
+			SwapType:  reservation.info.SwapType,
+			Amount:    reservation.info.Amount,
+			Expiry:    reservation.info.Expiry,
+			Requester: reservation.info.Requester,
+		})
+		if err != nil {
+			s.releaseReservation(ctx, reservation, nil)
+			return nil, err
+		}
+	}
+
+	s.reservationsLock.Lock()
+	s.reservations[reservation.info.SwapHash] = reservation
+	s.reservationsLock.Unlock()
+
+	return reservation.info, nil
+}
+
+// pruneReservations removes all expired reservations and releases their
+// resources.
+func (s *Client) pruneReservations(ctx context.Context) {
+	now := time.Now()
+
+	var expired []*pendingReservation
+
+	s.reservationsLock.Lock()
+	for hash, reservation := range s.reservations {
+		if now.After(reservation.info.Expiry) {
+			expired = append(expired, reservation)
+			delete(s.reservations, hash)
+		}
+	}
+	s.reservationsLock.Unlock()
+
+	for _, reservation := range expired {
+		s.releaseReservation(
+			ctx, reservation, newExpiredDenial(reservation.info),
+		)
+	}
+}
+
+// newSwapDenial returns a denial of the reserved swap provided at the time
+// provided.
+func newSwapDenial(info *SwapReservation, t time.Time) *loopdb.SwapDenial {
+	return &loopdb.SwapDenial{
+		Hash:      info.SwapHash,
+		SwapType:  info.SwapType,
+		Amount:    info.Amount,
+		Requester: info.Requester,
+		Time:      t,
+	}
+}
+
+// newExpiredDenial returns a denial of a reserved swap that was not approved
+// before its expiry.
+func newExpiredDenial(info *SwapReservation) *loopdb.SwapDenial {
+	denial := newSwapDenial(info, info.Expiry)
+	denial.Expired = true
+
+	return denial
+}
+
+// releaseReservation releases the resources held by a reservation that will
+// not be executed. If the reservation required approval, the denial provided
+// is stored, or the reservation is just removed from our pending approvals
+// if it is nil.
+func (s *Client) releaseReservation(ctx context.Context,
+	reservation *pendingReservation, denial *loopdb.SwapDenial) {
+
+	hash := reservation.info.SwapHash
+
+	if reservation.release != nil {
+		if err := reservation.release(ctx); err != nil {
+			log.Errorf("Could not release reserved swap %v: %v",
+				hash, err)
+		}
+	}
+
+	if !reservation.info.RequiresApproval {
+		return
+	}
+
+	if denial != nil {
+		if err := s.Store.CreateSwapDenial(denial); err != nil {
+			log.Errorf("Could not store denial of swap %v: %v",
+				hash, err)
+		}
+
+		return
+	}
+
+	if err := s.Store.DeletePendingApproval(hash); err != nil {
+		log.Errorf("Could not remove swap %v from pending approvals: "+
+			"%v", hash, err)
+	}
+}
+
+// expirePendingApprovals releases the swaps that were awaiting approval when
+// we last shut down. Their reservations were only held in memory, so they can
+// no longer be approved and are recorded as expired. We cancel the invoices of
+// loop ins so that the server cannot pay them, unless the swap was stored
+// because it was approved just before we shut down.
+func (s *Client) expirePendingApprovals(ctx context.Context,
+	approvals []*loopdb.PendingApproval) error {
+
+	if len(approvals) == 0 {
+		return nil
+	}
+
+	loopIns, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return err
+	}
+
+	loopOuts, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return err
+	}
+
+	stored := make(map[lntypes.Hash]bool, len(loopIns)+len(loopOuts))
+	for _, loopIn := range loopIns {
+		stored[loopIn.Hash] = true
+	}
+	for _, loopOut := range loopOuts {
+		stored[loopOut.Hash] = true
+	}
+
+	now := time.Now()
+	for _, approval := range approvals {
+		// The swap was approved, but we shut down before we removed
+		// it from our pending approvals.
+		if stored[approval.Hash] {
+			err := s.Store.DeletePendingApproval(approval.Hash)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		log.Infof("Swap %v was awaiting approval on shutdown, expiring",
+			approval.Hash)
+
+		if approval.SwapType == swap.TypeIn {
+			err := s.lndServices.Invoices.CancelInvoice(
+				ctx, approval.Hash,
+			)
+			if err != nil {
+				log.Errorf("Could not cancel invoice of swap "+
+					"%v: %v", approval.Hash, err)
+			}
+		}
+
+		err := s.Store.CreateSwapDenial(&loopdb.SwapDenial{
+			Hash:      approval.Hash,
+			SwapType:  approval.SwapType,
+			Amount:    approval.Amount,
+			Requester: approval.Requester,
+			Expired:   true,
+			Time:      now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package loop
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// resumeParallelism is the number of pending swaps that we restore from the
+// store concurrently when we start.
+const resumeParallelism = 8
+
+// pendingSwap is a pending swap that was read from the store and is waiting
+// to be resumed.
+type pendingSwap struct {
+	// urgent is true if the swap has to sweep its htlc before it expires,
+	// which is the case for loop outs that have revealed their preimage.
+	urgent bool
+
+	// expiry is the height at which the swap's htlc expires.
+	expiry int32
+
+	// resume restores the swap so that it can be executed.
+	resume func() (genericSwap, error)
+}
+
+// orderPendingSwaps orders our pending swaps so that the most time critical
+// swaps are resumed first. Urgent swaps are resumed before all others, and
+// swaps are otherwise resumed in order of their expiry.
+func orderPendingSwaps(swaps []*pendingSwap) {
+	sort.SliceStable(swaps, func(i, j int) bool {
+		if swaps[i].urgent != swaps[j].urgent {
+			return swaps[i].urgent
+		}
+
+		return swaps[i].expiry < swaps[j].expiry
+	})
+}
+
+// resumeSwaps restarts all pending swaps from the provided list. Our swaps are
+// restored concurrently, and handed to our executor in order of how time
+// critical they are, so that a large number of pending swaps does not delay
+// our urgent sweeps. Swaps that share our wallet for the funding of their
+// htlcs are serialized by our executor.
+func (s *Client) resumeSwaps(ctx context.Context,
+	loopOutSwaps []*loopdb.LoopOut, loopInSwaps []*loopdb.LoopIn) {
+
+	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
+
+	var pending []*pendingSwap
+	for _, pend := range loopOutSwaps {
+		pend := pend
+
+		state := pend.State().State
+		if state.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		pending = append(pending, &pendingSwap{
+			urgent: state == loopdb.StatePreimageRevealed,
+			expiry: pend.Contract.CltvExpiry,
+			resume: func() (genericSwap, error) {
+				return resumeLoopOutSwap(ctx, swapCfg, pend)
+			},
+		})
+	}
+
+	for _, pend := range loopInSwaps {
+		pend := pend
+
+		if pend.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		pending = append(pending, &pendingSwap{
+			expiry: pend.Contract.CltvExpiry,
+			resume: func() (genericSwap, error) {
+				return resumeLoopInSwap(ctx, swapCfg, pend)
+			},
+		})
+	}
+
+	orderPendingSwaps(pending)
+
+	// Restore our swaps with bounded parallelism, taking them in order so
+	// that our most urgent swaps are restored first.
+	var (
+		resumed = make([]genericSwap, len(pending))
+		done    = make([]chan struct{}, len(pending))
+		slots   = make(chan struct{}, resumeParallelism)
+		wg      sync.WaitGroup
+	)
+
+	for i := range pending {
+		done[i] = make(chan struct{})
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for i, pend := range pending {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			wg.Add(1)
+			go func(i int, pend *pendingSwap) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				defer close(done[i])
+
+				swap, err := pend.resume()
+				if err != nil {
+					log.Errorf("resuming swap: %v", err)
+					return
+				}
+
+				resumed[i] = swap
+			}(i, pend)
+		}
+	}()
+	defer wg.Wait()
+
+	// Hand our swaps to our executor in order as they are restored.
+	for i := range pending {
+		select {
+		case <-done[i]:
+		case <-ctx.Done():
+			return
+		}
+
+		if resumed[i] == nil {
+			continue
+		}
+
+		s.executor.initiateSwap(ctx, resumed[i])
+	}
+}
@@ -0,0 +1,32 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrderPendingSwaps tests that our pending swaps are resumed in order of
+// how time critical they are.
+func TestOrderPendingSwaps(t *testing.T) {
+	var (
+		late       = &pendingSwap{expiry: 300}
+		early      = &pendingSwap{expiry: 100}
+		urgentLate = &pendingSwap{urgent: true, expiry: 400}
+		urgent     = &pendingSwap{urgent: true, expiry: 200}
+		sameExpiry = &pendingSwap{expiry: 100}
+	)
+
+	swaps := []*pendingSwap{late, early, urgentLate, urgent, sameExpiry}
+	orderPendingSwaps(swaps)
+
+	// Swaps with the same urgency and expiry keep their order, so we
+	// compare our swaps by identity rather than by value.
+	expected := []*pendingSwap{
+		urgent, urgentLate, early, sameExpiry, late,
+	}
+	require.Len(t, swaps, len(expected))
+	for i, swap := range expected {
+		require.Same(t, swap, swaps[i])
+	}
+}
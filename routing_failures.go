@@ -0,0 +1,178 @@
+package loop
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// MissionControl provides access to lnd's mission control, which lnd uses to
+// decide which routes to try when it makes a payment.
+type MissionControl interface {
+	// ImportFailures imports the routing failures provided into mission
+	// control, so that lnd avoids the failed node pairs when it routes
+	// subsequent payments.
+	ImportFailures(ctx context.Context,
+		failures []loopdb.RoutingFailure) error
+}
+
+// routingHints records the node pairs that our off-chain swap payments failed
+// to route over, and feeds them into lnd's mission control before we make
+// later swap payments. A nil set of routing hints is valid, and records and
+// applies nothing.
+type routingHints struct {
+	// self is our node's pubkey, which is the first node in the routes
+	// of our payments.
+	self route.Vertex
+
+	store          loopdb.SwapStore
+	missionControl MissionControl
+
+	// period is the amount of time that a routing failure is applied to
+	// later swap payments for.
+	period time.Duration
+
+	now func() time.Time
+}
+
+// newRoutingHints creates a set of routing hints that are applied for the
+// period provided. If we have no mission control or the period is zero, nil
+// is returned.
+func newRoutingHints(self route.Vertex, store loopdb.SwapStore,
+	missionControl MissionControl, period time.Duration) *routingHints {
+
+	if missionControl == nil || period <= 0 {
+		return nil
+	}
+
+	return &routingHints{
+		self:           self,
+		store:          store,
+		missionControl: missionControl,
+		period:         period,
+		now:            time.Now,
+	}
+}
+
+// record stores the node pairs that the failed htlcs of a payment could not
+// be forwarded over, and removes failures that we no longer need.
+func (r *routingHints) record(htlcs []*lndclient.HtlcAttempt) error {
+	if r == nil {
+		return nil
+	}
+
+	now := r.now()
+
+	failures := failedPairs(r.self, htlcs, now)
+	if len(failures) > 0 {
+		if err := r.store.PutRoutingFailures(failures); err != nil {
+			return err
+		}
+	}
+
+	return r.store.PruneRoutingFailures(now.Add(-r.period))
+}
+
+// apply imports the routing failures that occurred within our period into
+// lnd's mission control. The failures are imported as if they just occurred,
+// so that lnd does not stop avoiding the failed pairs before our period is
+// over.
+func (r *routingHints) apply(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+
+	now := r.now()
+
+	failures, err := r.store.FetchRoutingFailures(now.Add(-r.period))
+	if err != nil {
+		return err
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	for i := range failures {
+		failures[i].Time = now
+	}
+
+	return r.missionControl.ImportFailures(ctx, failures)
+}
+
+// failedPairs returns the node pairs that the failed htlcs provided could not
+// be forwarded over. Only failures that indicate that a channel could not
+// forward the htlc are included, because other failures (such as incorrect
+// payment details at the final node) are not specific to the route taken.
+func failedPairs(self route.Vertex, htlcs []*lndclient.HtlcAttempt,
+	now time.Time) []loopdb.RoutingFailure {
+
+	var failures []loopdb.RoutingFailure
+	for _, htlc := range htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_FAILED {
+			continue
+		}
+
+		if htlc.Route == nil || htlc.Failure == nil {
+			continue
+		}
+
+		switch htlc.Failure.Code {
+		case lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+			lnrpc.Failure_UNKNOWN_NEXT_PEER,
+			lnrpc.Failure_CHANNEL_DISABLED,
+			lnrpc.Failure_PERMANENT_CHANNEL_FAILURE:
+
+		default:
+			continue
+		}
+
+		// A failure index of zero indicates that our own node failed
+		// to forward the htlc to the first hop, and an index of i
+		// indicates that the node at hop i failed to forward it to the
+		// next hop. An index equal to the number of hops is a failure
+		// reported by the final node, which has no pair to avoid.
+		hops := htlc.Route.Hops
+		failureIdx := int(htlc.Failure.FailureSourceIndex)
+		if failureIdx >= len(hops) {
+			continue
+		}
+
+		from := self
+		if failureIdx > 0 {
+			vertex, err := route.NewVertexFromStr(
+				hops[failureIdx-1].PubKey,
+			)
+			if err != nil {
+				log.Warnf("Invalid hop pubkey: %v", err)
+				continue
+			}
+			from = vertex
+		}
+
+		to, err := route.NewVertexFromStr(hops[failureIdx].PubKey)
+		if err != nil {
+			log.Warnf("Invalid hop pubkey: %v", err)
+			continue
+		}
+
+		amount := lnwire.MilliSatoshi(hops[failureIdx].AmtToForwardMsat)
+		if amount == 0 {
+			continue
+		}
+
+		failures = append(failures, loopdb.RoutingFailure{
+			From:   from,
+			To:     to,
+			Amount: amount,
+			Time:   now,
+		})
+	}
+
+	return failures
+}
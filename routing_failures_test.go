@@ -0,0 +1,217 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	routingSelf = route.Vertex{1}
+	routingHop1 = route.Vertex{2}
+	routingHop2 = route.Vertex{3}
+)
+
+// failedHtlc returns a failed htlc attempt over a two hop route that failed
+// at the index provided.
+func failedHtlc(code lnrpc.Failure_FailureCode,
+	idx uint32) *lndclient.HtlcAttempt {
+
+	return &lndclient.HtlcAttempt{
+		Status: lnrpc.HTLCAttempt_FAILED,
+		Route: &lnrpc.Route{
+			Hops: []*lnrpc.Hop{
+				{
+					PubKey:           routingHop1.String(),
+					AmtToForwardMsat: 2000,
+				},
+				{
+					PubKey:           routingHop2.String(),
+					AmtToForwardMsat: 1000,
+				},
+			},
+		},
+		Failure: &lndclient.HtlcFailure{
+			Code:               code,
+			FailureSourceIndex: idx,
+		},
+	}
+}
+
+// TestFailedPairs tests extraction of the node pairs that htlcs failed to be
+// forwarded over.
+func TestFailedPairs(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	tests := []struct {
+		name     string
+		htlcs    []*lndclient.HtlcAttempt
+		expected []loopdb.RoutingFailure
+	}{
+		{
+			name: "in flight htlc",
+			htlcs: []*lndclient.HtlcAttempt{
+				{
+					Status: lnrpc.HTLCAttempt_IN_FLIGHT,
+				},
+			},
+		},
+		{
+			name: "failed at our node",
+			htlcs: []*lndclient.HtlcAttempt{
+				failedHtlc(
+					lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+					0,
+				),
+			},
+			expected: []loopdb.RoutingFailure{
+				{
+					From:   routingSelf,
+					To:     routingHop1,
+					Amount: 2000,
+					Time:   now,
+				},
+			},
+		},
+		{
+			name: "failed at intermediate hop",
+			htlcs: []*lndclient.HtlcAttempt{
+				failedHtlc(lnrpc.Failure_UNKNOWN_NEXT_PEER, 1),
+			},
+			expected: []loopdb.RoutingFailure{
+				{
+					From:   routingHop1,
+					To:     routingHop2,
+					Amount: 1000,
+					Time:   now,
+				},
+			},
+		},
+		{
+			name: "failed at final node",
+			htlcs: []*lndclient.HtlcAttempt{
+				failedHtlc(
+					lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+					2,
+				),
+			},
+		},
+		{
+			name: "not a channel failure",
+			htlcs: []*lndclient.HtlcAttempt{
+				failedHtlc(
+					lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS,
+					1,
+				),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			failures := failedPairs(routingSelf, testCase.htlcs, now)
+			require.Equal(t, testCase.expected, failures)
+		})
+	}
+}
+
+// mockFailureStore stores routing failures in memory.
+type mockFailureStore struct {
+	loopdb.SwapStore
+
+	failures []loopdb.RoutingFailure
+	pruned   time.Time
+}
+
+func (m *mockFailureStore) PutRoutingFailures(
+	failures []loopdb.RoutingFailure) error {
+
+	m.failures = append(m.failures, failures...)
+	return nil
+}
+
+func (m *mockFailureStore) FetchRoutingFailures(since time.Time) (
+	[]loopdb.RoutingFailure, error) {
+
+	var failures []loopdb.RoutingFailure
+	for _, failure := range m.failures {
+		if !failure.Time.Before(since) {
+			failures = append(failures, failure)
+		}
+	}
+
+	return failures, nil
+}
+
+func (m *mockFailureStore) PruneRoutingFailures(before time.Time) error {
+	m.pruned = before
+	return nil
+}
+
+// mockMissionControl records the failures that are imported into it.
+type mockMissionControl struct {
+	imported []loopdb.RoutingFailure
+}
+
+func (m *mockMissionControl) ImportFailures(_ context.Context,
+	failures []loopdb.RoutingFailure) error {
+
+	m.imported = append(m.imported, failures...)
+	return nil
+}
+
+// TestRoutingHints tests that routing failures are imported into mission
+// control for the configured period after they occurred.
+func TestRoutingHints(t *testing.T) {
+	// A nil set of hints should do nothing.
+	var hints *routingHints
+	require.NoError(t, hints.record(nil))
+	require.NoError(t, hints.apply(context.Background()))
+
+	store := &mockFailureStore{}
+	missionControl := &mockMissionControl{}
+
+	require.Nil(t, newRoutingHints(routingSelf, store, nil, time.Hour))
+	require.Nil(t, newRoutingHints(routingSelf, store, missionControl, 0))
+
+	hints = newRoutingHints(routingSelf, store, missionControl, time.Hour)
+
+	now := time.Unix(10000, 0)
+	hints.now = func() time.Time {
+		return now
+	}
+
+	err := hints.record([]*lndclient.HtlcAttempt{
+		failedHtlc(lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE, 1),
+	})
+	require.NoError(t, err)
+	require.Len(t, store.failures, 1)
+	require.Equal(t, now.Add(-time.Hour), store.pruned)
+
+	// Within our period, the failure should be imported as if it just
+	// occurred.
+	now = now.Add(time.Minute * 30)
+	require.NoError(t, hints.apply(context.Background()))
+	require.Equal(t, []loopdb.RoutingFailure{
+		{
+			From:   routingHop1,
+			To:     routingHop2,
+			Amount: 1000,
+			Time:   now,
+		},
+	}, missionControl.imported)
+
+	// Once our period has passed, nothing should be imported.
+	missionControl.imported = nil
+	now = now.Add(time.Hour)
+	require.NoError(t, hints.apply(context.Background()))
+	require.Empty(t, missionControl.imported)
+}
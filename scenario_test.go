@@ -0,0 +1,114 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// TestLoopOutScenarios runs our loop out lifecycle scenarios.
+func TestLoopOutScenarios(t *testing.T) {
+	// sweepFlow is the sequence of events in which the htlc confirms and
+	// is swept, and the server settles our payments.
+	sweepFlow := []scenarioStep{
+		confirmHtlc{},
+		prepayResult{},
+		publishSweep{},
+		swapPaymentResult{},
+		confirmSweep{},
+	}
+
+	scenarios := []*loopOutScenario{
+		{
+			name:       "success",
+			steps:      sweepFlow,
+			finalState: loopdb.StateSuccess,
+		},
+		{
+			name: "reorg before htlc confirms",
+			steps: append(
+				[]scenarioStep{reorg{depth: 3}}, sweepFlow...,
+			),
+			finalState: loopdb.StateSuccess,
+		},
+		{
+			name: "htlc not confirmed before expiry",
+			steps: []scenarioStep{
+				prepayResult{},
+				swapPaymentResult{},
+				expireLoopOut{},
+			},
+			finalState: loopdb.StateFailTimeout,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+
+		t.Run(scenario.name, func(t *testing.T) {
+			runLoopOutScenario(t, scenario)
+		})
+	}
+}
+
+// TestLoopInScenarios runs our loop in lifecycle scenarios.
+func TestLoopInScenarios(t *testing.T) {
+	// Our wallet has to fund the swap amount, since our test request does
+	// not allow any miner fees.
+	balance := btcutil.Amount(100000)
+
+	scenarios := []*loopInScenario{
+		{
+			name:          "success",
+			walletBalance: balance,
+			steps: []scenarioStep{
+				confirmLoopInHtlc{},
+				settleInvoice{},
+				serverSweep{},
+			},
+			finalState: loopdb.StateSuccess,
+		},
+		{
+			name:          "insufficient wallet balance",
+			walletBalance: testLoopInRequest.Amount - 1,
+			requestErr: &InsufficientFundsError{
+				Required:  testLoopInRequest.Amount,
+				Available: testLoopInRequest.Amount - 1,
+			},
+		},
+		{
+			name:          "htlc times out",
+			walletBalance: balance,
+			steps: []scenarioStep{
+				confirmLoopInHtlc{},
+				publishTimeout{},
+				confirmTimeout{},
+			},
+			finalState: loopdb.StateFailTimeout,
+		},
+		{
+			// If our chain reorgs below the htlc's expiry after we
+			// published our timeout tx, we cannot republish it until
+			// the htlc has expired again.
+			name:          "reorg after timeout published",
+			walletBalance: balance,
+			steps: []scenarioStep{
+				confirmLoopInHtlc{},
+				publishTimeout{},
+				reorg{depth: 1},
+				publishTimeout{},
+				confirmTimeout{},
+			},
+			finalState: loopdb.StateFailTimeout,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+
+		t.Run(scenario.name, func(t *testing.T) {
+			runLoopInScenario(t, scenario)
+		})
+	}
+}
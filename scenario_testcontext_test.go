@@ -0,0 +1,402 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// loopOutScenario declaratively describes the lifecycle of a loop out: the
+// payment results and chain events that follow the swap's creation, and the
+// state that the swap must end in. This allows new flows through our swap
+// state machine to be covered without choreographing our mocks by hand.
+type loopOutScenario struct {
+	// name is the name of the scenario.
+	name string
+
+	// steps are the events that happen after the swap is started, in
+	// order. When the swap is started, it has been stored, dispatched
+	// its off chain payments and registered for confirmation of its
+	// htlc.
+	steps []scenarioStep
+
+	// finalState is the state that the swap is expected to end in once
+	// all of its steps have run.
+	finalState loopdb.SwapState
+}
+
+// loopInScenario declaratively describes the lifecycle of a loop in: the
+// funds that our wallet starts out with, the chain and invoice events that
+// follow the swap's creation, and the state that the swap must end in.
+type loopInScenario struct {
+	// name is the name of the scenario.
+	name string
+
+	// walletBalance is the confirmed balance of our wallet when the swap
+	// is requested.
+	walletBalance btcutil.Amount
+
+	// requestErr is the error that the swap request is expected to fail
+	// with. If it is set, the swap is never started so no steps may be
+	// provided.
+	requestErr error
+
+	// steps are the events that happen after the swap is started, in
+	// order. When the swap is started, it has been stored, published its
+	// htlc and registered for confirmation of its htlc.
+	steps []scenarioStep
+
+	// finalState is the state that the swap is expected to end in once
+	// all of its steps have run.
+	finalState loopdb.SwapState
+}
+
+// scenarioStep is a single event in the lifecycle of a swap.
+type scenarioStep interface {
+	// run executes the step against a running scenario.
+	run(s *scenarioRun)
+}
+
+// scenarioRun holds the state of a scenario as its steps run.
+type scenarioRun struct {
+	*testContext
+
+	// hash is the hash of the swap.
+	hash lntypes.Hash
+
+	// amount is the amount of the swap.
+	amount btcutil.Amount
+
+	// signalSwapPayment signals the result of the swap payment of a loop
+	// out.
+	signalSwapPayment func(error)
+
+	// signalPrepayment signals the result of the prepayment of a loop
+	// out.
+	signalPrepayment func(error)
+
+	// confIntent is the registration for confirmation of a loop out's
+	// htlc.
+	confIntent *test.ConfRegistration
+
+	// htlcOutpoint is the outpoint of a loop out's htlc, set once it
+	// confirms.
+	htlcOutpoint *wire.OutPoint
+
+	// sweepTx is a loop out's sweep, set once it is published.
+	sweepTx *wire.MsgTx
+
+	// htlcTx is the htlc that a loop in published.
+	htlcTx *wire.MsgTx
+
+	// invoice is a loop in's subscription to its swap invoice, set once
+	// its htlc confirms.
+	invoice *test.SingleInvoiceSubscription
+
+	// timeoutTx is the last timeout tx that a loop in published.
+	timeoutTx *wire.MsgTx
+}
+
+// runLoopOutScenario runs a loop out scenario against our mock server and
+// simulated chain.
+func runLoopOutScenario(t *testing.T, scenario *loopOutScenario) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	info, err := ctx.swapClient.LoopOut(context.Background(), testRequest)
+	require.NoError(t, err)
+
+	ctx.assertStored()
+	ctx.assertStatus(loopdb.StateInitiated)
+
+	run := &scenarioRun{
+		testContext:       ctx,
+		hash:              info.SwapHash,
+		amount:            testRequest.Amount,
+		signalSwapPayment: ctx.AssertPaid(swapInvoiceDesc),
+		signalPrepayment:  ctx.AssertPaid(prepayInvoiceDesc),
+	}
+	run.confIntent = ctx.AssertRegisterConf(false, defaultConfirmations)
+
+	for _, step := range scenario.steps {
+		step.run(run)
+	}
+
+	ctx.assertStatus(scenario.finalState)
+	ctx.assertStoreFinished(scenario.finalState)
+
+	ctx.finish()
+}
+
+// runLoopInScenario runs a loop in scenario against our mock server and
+// simulated chain.
+func runLoopInScenario(t *testing.T, scenario *loopInScenario) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	ctx.Lnd.WalletBalance = lndclient.WalletBalance{
+		Confirmed: scenario.walletBalance,
+	}
+
+	req := testLoopInRequest
+	info, err := ctx.swapClient.LoopIn(context.Background(), &req)
+	if scenario.requestErr != nil {
+		require.Empty(t, scenario.steps, "steps for failed request")
+		require.Equal(t, scenario.requestErr, err)
+
+		ctx.finish()
+		return
+	}
+	require.NoError(t, err)
+
+	ctx.store.assertLoopInStored()
+	ctx.assertSwapStatus(swap.TypeIn, loopdb.StateInitiated)
+
+	// Our htlc is published from our wallet once the swap is stored, and
+	// then written again with the htlc tx's hash.
+	ctx.assertSwapStatus(swap.TypeIn, loopdb.StateHtlcPublished)
+	ctx.store.assertLoopInState(loopdb.StateHtlcPublished)
+
+	htlcTx := <-ctx.Lnd.SendOutputsChannel
+
+	state := ctx.store.assertLoopInState(loopdb.StateHtlcPublished)
+	require.Equal(t, htlcTx.TxHash(), *state.HtlcTxHash)
+
+	// We watch for confirmation of both of our htlc's output types.
+	<-ctx.Lnd.RegisterConfChannel
+	<-ctx.Lnd.RegisterConfChannel
+
+	run := &scenarioRun{
+		testContext: ctx,
+		hash:        info.SwapHash,
+		amount:      req.Amount,
+		htlcTx:      &htlcTx,
+	}
+
+	for _, step := range scenario.steps {
+		step.run(run)
+	}
+
+	ctx.assertSwapStatus(swap.TypeIn, scenario.finalState)
+	ctx.store.assertLoopInState(scenario.finalState)
+
+	ctx.finish()
+}
+
+// reorg is a step in which the chain reorganizes to a lower height.
+type reorg struct {
+	// depth is the number of blocks that are removed from our chain.
+	depth int32
+}
+
+// run notifies the swap of the new tip of our chain.
+func (r reorg) run(s *scenarioRun) {
+	s.notifyHeight(s.Lnd.Height - r.depth)
+}
+
+// confirmHtlc is a step in which the server's htlc confirms.
+type confirmHtlc struct{}
+
+// run publishes and confirms the swap's htlc.
+func (confirmHtlc) run(s *scenarioRun) {
+	outpoint := s.publishHtlc(s.confIntent.PkScript, s.amount)
+	s.htlcOutpoint = &outpoint
+}
+
+// prepayResult is a step in which the server settles or fails the prepayment.
+type prepayResult struct {
+	// err is the error that the payment fails with, if any.
+	err error
+}
+
+// run signals the result of the prepayment.
+func (p prepayResult) run(s *scenarioRun) {
+	s.signalPrepayment(p.err)
+}
+
+// swapPaymentResult is a step in which the server settles or fails the swap
+// payment.
+type swapPaymentResult struct {
+	// err is the error that the payment fails with, if any.
+	err error
+}
+
+// run signals the result of the swap payment.
+func (p swapPaymentResult) run(s *scenarioRun) {
+	s.signalSwapPayment(p.err)
+}
+
+// publishSweep is a step in which we reveal our preimage and publish the
+// sweep of the htlc, while our swap payment is still in flight.
+type publishSweep struct{}
+
+// run asserts that the swap sweeps its htlc with the preimage of the swap,
+// and pushes the preimage to the server.
+func (publishSweep) run(s *scenarioRun) {
+	require.NotNil(s.T, s.htlcOutpoint, "sweep before htlc confirmed")
+
+	s.AssertRegisterSpendNtfn(s.confIntent.PkScript)
+
+	// Respond to payment tracking with an in flight status so that our
+	// swap pushes its preimage to the server.
+	s.trackPayment(lnrpc.Payment_IN_FLIGHT)
+
+	// Tick our sweep timer and expect a signing request.
+	s.expiryChan <- testTime
+	<-s.Lnd.SignOutputRawChannel
+
+	s.assertStatus(loopdb.StatePreimageRevealed)
+	s.assertStorePreimageReveal()
+
+	sweepTx := s.ReceiveTx()
+	require.Equal(
+		s.T, s.htlcOutpoint.Hash,
+		sweepTx.TxIn[0].PreviousOutPoint.Hash,
+	)
+
+	// New swaps use v2 htlcs, which place the preimage first in the
+	// witness of the sweep.
+	preimage, err := lntypes.MakePreimage(sweepTx.TxIn[0].Witness[0])
+	require.NoError(s.T, err)
+	require.Equal(s.T, s.hash, preimage.Hash())
+
+	s.assertPreimagePush(preimage)
+	s.sweepTx = sweepTx
+}
+
+// confirmSweep is a step in which the sweep of the htlc confirms.
+type confirmSweep struct{}
+
+// run notifies the swap that its htlc was spent by its sweep.
+func (confirmSweep) run(s *scenarioRun) {
+	require.NotNil(s.T, s.sweepTx, "sweep confirmed before published")
+
+	s.NotifySpend(s.sweepTx, 0)
+}
+
+// expireLoopOut is a step in which our chain reaches a height at which a loop
+// out can no longer safely reveal its preimage.
+type expireLoopOut struct{}
+
+// run notifies the swap of the first block past its last reveal height.
+func (expireLoopOut) run(s *scenarioRun) {
+	contract := s.store.loopOutSwaps[s.hash]
+	s.notifyHeight(contract.CltvExpiry - MinLoopOutPreimageRevealDelta + 1)
+}
+
+// confirmLoopInHtlc is a step in which the htlc that a loop in published
+// confirms.
+type confirmLoopInHtlc struct{}
+
+// run confirms the swap's htlc and asserts that the swap starts to watch
+// for its spend and for updates to its swap invoice.
+func (confirmLoopInHtlc) run(s *scenarioRun) {
+	s.Lnd.ConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: s.htlcTx,
+	}
+
+	<-s.Lnd.RegisterSpendChannel
+
+	s.invoice = <-s.Lnd.SingleInvoiceSubcribeChannel
+	require.Equal(s.T, s.hash, s.invoice.Hash)
+}
+
+// updateInvoice sends an update for a loop in's swap invoice, closing its
+// subscription as lndclient would if the invoice reached a final state.
+func (s *scenarioRun) updateInvoice(amount btcutil.Amount,
+	state channeldb.ContractState) {
+
+	require.NotNil(s.T, s.invoice, "invoice update before subscription")
+
+	s.invoice.Update <- lndclient.InvoiceUpdate{
+		AmtPaid: amount,
+		State:   state,
+	}
+
+	if state == channeldb.ContractCanceled ||
+		state == channeldb.ContractSettled {
+
+		close(s.invoice.Update)
+		close(s.invoice.Err)
+	}
+}
+
+// settleInvoice is a step in which the server pays a loop in's swap invoice.
+type settleInvoice struct{}
+
+// run settles the swap invoice, less the swap fee.
+func (settleInvoice) run(s *scenarioRun) {
+	s.updateInvoice(
+		s.amount-testLoopInRequest.MaxSwapFee,
+		channeldb.ContractSettled,
+	)
+
+	s.assertSwapStatus(swap.TypeIn, loopdb.StateInvoiceSettled)
+	s.store.assertLoopInState(loopdb.StateInvoiceSettled)
+}
+
+// serverSweep is a step in which the server sweeps a loop in's htlc with the
+// preimage of the swap.
+type serverSweep struct{}
+
+// run notifies the swap that its htlc was spent by a success tx.
+func (serverSweep) run(s *scenarioRun) {
+	successTx := &wire.MsgTx{}
+	successTx.AddTxIn(&wire.TxIn{
+		Witness: [][]byte{{}, {}, {}},
+	})
+
+	s.NotifySpend(successTx, 0)
+}
+
+// publishTimeout is a step in which our chain reaches the expiry of a loop
+// in's htlc, so that we publish a timeout tx to reclaim our funds.
+type publishTimeout struct{}
+
+// run notifies the swap of its expiry height and asserts that a timeout tx
+// spending the htlc is signed and published.
+func (publishTimeout) run(s *scenarioRun) {
+	contract := s.store.loopInSwaps[s.hash]
+	s.notifyHeight(contract.CltvExpiry)
+
+	signReq := <-s.Lnd.SignOutputRawChannel
+	require.Equal(
+		s.T, s.htlcTx.TxOut[0].Value,
+		signReq.SignDescriptors[0].Output.Value,
+	)
+
+	timeoutTx := <-s.Lnd.TxPublishChannel
+	require.Equal(
+		s.T, s.htlcTx.TxHash(), timeoutTx.TxIn[0].PreviousOutPoint.Hash,
+	)
+
+	s.timeoutTx = timeoutTx
+}
+
+// confirmTimeout is a step in which a loop in's timeout tx confirms, after
+// which we cancel its swap invoice.
+type confirmTimeout struct{}
+
+// run notifies the swap that its htlc was spent by its timeout tx, and
+// asserts that the swap invoice is cancelled.
+func (confirmTimeout) run(s *scenarioRun) {
+	require.NotNil(s.T, s.timeoutTx, "timeout confirmed before published")
+
+	s.NotifySpend(s.timeoutTx, 0)
+
+	<-s.Lnd.FailInvoiceChannel
+	s.updateInvoice(0, channeldb.ContractCanceled)
+}
@@ -0,0 +1,237 @@
+package loop
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+)
+
+const (
+	// serverReconnectMaxDelay is the longest that we back off for between
+	// attempts to reconnect to the swap server.
+	serverReconnectMaxDelay = time.Minute
+
+	// serverConnectTimeout is the minimum amount of time that we give each
+	// attempt to connect to the swap server.
+	serverConnectTimeout = 20 * time.Second
+
+	// maxServerConnEvents is the number of server connection state changes
+	// that we keep a record of.
+	maxServerConnEvents = 20
+)
+
+// serverConnectParams are the parameters that our connection to the swap
+// server is established with. If the connection is lost, we reconnect with an
+// exponential backoff.
+var serverConnectParams = grpc.ConnectParams{
+	Backoff: backoff.Config{
+		BaseDelay:  backoff.DefaultConfig.BaseDelay,
+		Multiplier: backoff.DefaultConfig.Multiplier,
+		Jitter:     backoff.DefaultConfig.Jitter,
+		MaxDelay:   serverReconnectMaxDelay,
+	},
+	MinConnectTimeout: serverConnectTimeout,
+}
+
+// ServerConnState is the state of our connection to the swap server.
+type ServerConnState uint8
+
+const (
+	// ServerConnIdle indicates that we have no connection to the server,
+	// and will only connect once a request is made.
+	ServerConnIdle ServerConnState = iota
+
+	// ServerConnConnecting indicates that we are connecting to the server.
+	ServerConnConnecting
+
+	// ServerConnReady indicates that we are connected to the server.
+	ServerConnReady
+
+	// ServerConnTransientFailure indicates that our connection to the
+	// server failed, and that we will try to reconnect after backing off.
+	ServerConnTransientFailure
+
+	// ServerConnShutdown indicates that our connection to the server was
+	// closed.
+	ServerConnShutdown
+)
+
+// String returns a string representation of a server connection state.
+func (s ServerConnState) String() string {
+	switch s {
+	case ServerConnIdle:
+		return "Idle"
+
+	case ServerConnConnecting:
+		return "Connecting"
+
+	case ServerConnReady:
+		return "Ready"
+
+	case ServerConnTransientFailure:
+		return "TransientFailure"
+
+	case ServerConnShutdown:
+		return "Shutdown"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// serverConnState converts a gRPC connectivity state to a server connection
+// state.
+func serverConnState(state connectivity.State) ServerConnState {
+	switch state {
+	case connectivity.Connecting:
+		return ServerConnConnecting
+
+	case connectivity.Ready:
+		return ServerConnReady
+
+	case connectivity.TransientFailure:
+		return ServerConnTransientFailure
+
+	case connectivity.Shutdown:
+		return ServerConnShutdown
+
+	default:
+		return ServerConnIdle
+	}
+}
+
+// ServerConnEvent is a change in the state of our connection to the swap
+// server.
+type ServerConnEvent struct {
+	// State is the state that the connection changed to.
+	State ServerConnState
+
+	// Time is the time at which the connection changed state.
+	Time time.Time
+}
+
+// ServerConnInfo describes the health of our connection to the swap server.
+type ServerConnInfo struct {
+	// State is the current state of the connection.
+	State ServerConnState
+
+	// Since is the time at which the connection entered its current
+	// state.
+	Since time.Time
+
+	// Reconnects is the number of times that we reconnected to the server
+	// after our connection was lost.
+	Reconnects uint32
+
+	// Events holds the most recent changes in the connection's state,
+	// ordered from oldest to newest.
+	Events []ServerConnEvent
+}
+
+// connStateSource provides the state of a connection. It is implemented by
+// *grpc.ClientConn.
+type connStateSource interface {
+	// GetState returns the current state of the connection.
+	GetState() connectivity.State
+
+	// WaitForStateChange blocks until the connection's state differs from
+	// the state provided, returning false if the context is cancelled
+	// first.
+	WaitForStateChange(ctx context.Context,
+		state connectivity.State) bool
+}
+
+// serverConnMonitor tracks the state of our connection to the swap server.
+type serverConnMonitor struct {
+	conn connStateSource
+	now  func() time.Time
+
+	// connected is true once we have been connected to the server, so
+	// that we can tell reconnects apart from our first connection.
+	connected bool
+
+	info ServerConnInfo
+	lock sync.Mutex
+}
+
+// newServerConnMonitor creates a monitor for the connection provided.
+func newServerConnMonitor(conn connStateSource,
+	now func() time.Time) *serverConnMonitor {
+
+	return &serverConnMonitor{
+		conn: conn,
+		now:  now,
+	}
+}
+
+// run records every change in our connection's state until the context
+// provided is cancelled.
+func (m *serverConnMonitor) run(ctx context.Context) {
+	state := m.conn.GetState()
+	m.record(state)
+
+	for m.conn.WaitForStateChange(ctx, state) {
+		state = m.conn.GetState()
+		m.record(state)
+	}
+}
+
+// record updates our connection info with the state provided.
+func (m *serverConnMonitor) record(state connectivity.State) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	connState := serverConnState(state)
+	if len(m.info.Events) > 0 && m.info.State == connState {
+		return
+	}
+
+	switch connState {
+	case ServerConnReady:
+		if m.connected {
+			m.info.Reconnects++
+		}
+		m.connected = true
+
+		log.Infof("Swap server connection ready")
+
+	case ServerConnTransientFailure:
+		log.Warnf("Swap server connection failed, reconnecting")
+
+	default:
+		log.Debugf("Swap server connection state: %v", connState)
+	}
+
+	now := m.now()
+	m.info.State = connState
+	m.info.Since = now
+
+	m.info.Events = append(m.info.Events, ServerConnEvent{
+		State: connState,
+		Time:  now,
+	})
+	if len(m.info.Events) > maxServerConnEvents {
+		m.info.Events = m.info.Events[1:]
+	}
+}
+
+// getInfo returns a copy of our current connection info.
+func (m *serverConnMonitor) getInfo() ServerConnInfo {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	info := m.info
+	info.Events = make([]ServerConnEvent, len(m.info.Events))
+	copy(info.Events, m.info.Events)
+
+	return info
+}
+
+// ServerConnInfo returns the state of our connection to the swap server.
+func (s *Client) ServerConnInfo() ServerConnInfo {
+	return s.serverConn.getInfo()
+}
@@ -0,0 +1,75 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/connectivity"
+)
+
+// mockConnState is a connection that moves through a set of states each time
+// that it is waited on.
+type mockConnState struct {
+	states []connectivity.State
+}
+
+func (m *mockConnState) GetState() connectivity.State {
+	return m.states[0]
+}
+
+func (m *mockConnState) WaitForStateChange(_ context.Context,
+	_ connectivity.State) bool {
+
+	if len(m.states) == 1 {
+		return false
+	}
+
+	m.states = m.states[1:]
+	return true
+}
+
+// TestServerConnMonitor tests tracking of our server connection's state
+// changes and reconnects.
+func TestServerConnMonitor(t *testing.T) {
+	conn := &mockConnState{
+		states: []connectivity.State{
+			connectivity.Idle,
+			connectivity.Connecting,
+			connectivity.Ready,
+			connectivity.TransientFailure,
+			connectivity.Connecting,
+			connectivity.Ready,
+		},
+	}
+
+	now := time.Unix(1000, 0)
+	monitor := newServerConnMonitor(conn, func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	})
+
+	monitor.run(context.Background())
+
+	info := monitor.getInfo()
+	require.Equal(t, ServerConnReady, info.State)
+	require.Equal(t, time.Unix(1006, 0), info.Since)
+	require.Equal(t, uint32(1), info.Reconnects)
+	require.Len(t, info.Events, 6)
+	require.Equal(t, ServerConnTransientFailure, info.Events[3].State)
+
+	// Repeated states are not recorded as events.
+	monitor.record(connectivity.Ready)
+	require.Len(t, monitor.getInfo().Events, 6)
+
+	// We only keep a limited number of events.
+	for i := 0; i < maxServerConnEvents; i++ {
+		monitor.record(connectivity.TransientFailure)
+		monitor.record(connectivity.Ready)
+	}
+
+	info = monitor.getInfo()
+	require.Len(t, info.Events, maxServerConnEvents)
+	require.Equal(t, uint32(1+maxServerConnEvents), info.Reconnects)
+}
@@ -72,7 +72,7 @@ func newServerMock(lnd *test.LndMockServices) *serverMock {
 
 func (s *serverMock) NewLoopOutSwap(_ context.Context, swapHash lntypes.Hash,
 	amount btcutil.Amount, _ int32, _ [33]byte, _ time.Time,
-	_ string) (*newLoopOutResponse, error) {
+	_ string, _ []uint64) (*newLoopOutResponse, error) {
 
 	_, senderKey := test.CreateKey(100)
 
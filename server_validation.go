@@ -0,0 +1,188 @@
+package loop
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// ParamSwapInvoice identifies the swap invoice of a loop out swap.
+	ParamSwapInvoice = "swap invoice"
+
+	// ParamPrepayInvoice identifies the prepay invoice of a loop out
+	// swap.
+	ParamPrepayInvoice = "prepay invoice"
+
+	// ParamHtlcKey identifies the key that the server provided for a
+	// swap's htlc.
+	ParamHtlcKey = "htlc key"
+
+	// ParamHtlcExpiry identifies the expiry height of a swap's htlc.
+	ParamHtlcExpiry = "htlc expiry"
+
+	// ParamNodeKey identifies the node key that the server receives
+	// payments with.
+	ParamNodeKey = "node key"
+)
+
+// ServerParamError is returned when a parameter that the swap server provided
+// for a swap fails validation.
+type ServerParamError struct {
+	// Param identifies the parameter that failed validation.
+	Param string
+
+	// Reason describes why the parameter failed validation.
+	Reason string
+
+	// Anomaly is true if the parameter does not prevent the swap from
+	// completing safely, but is not expected from a correctly functioning
+	// server. Anomalies only fail swaps in strict mode.
+	Anomaly bool
+}
+
+// Error returns an error string for a server parameter error.
+func (e *ServerParamError) Error() string {
+	if e.Anomaly {
+		return fmt.Sprintf("anomalous server %v: %v", e.Param, e.Reason)
+	}
+
+	return fmt.Sprintf("invalid server %v: %v", e.Param, e.Reason)
+}
+
+// invalidParam creates an error for a server parameter that fails swaps
+// regardless of our validation mode.
+func invalidParam(param, format string, params ...interface{}) error {
+	return &ServerParamError{
+		Param:  param,
+		Reason: fmt.Sprintf(format, params...),
+	}
+}
+
+// anomalousParam creates an error for an unexpected server parameter.
+func anomalousParam(param, format string,
+	params ...interface{}) *ServerParamError {
+
+	return &ServerParamError{
+		Param:   param,
+		Reason:  fmt.Sprintf(format, params...),
+		Anomaly: true,
+	}
+}
+
+// serverValidator checks the anomalies in the parameters that the server
+// provides for swaps, and tracks the server's node key across our session.
+// A nil validator reports all anomalies as warnings.
+type serverValidator struct {
+	// strict indicates that swaps should fail on any anomaly.
+	strict bool
+
+	// nodeKey is the node key that the server first received payments
+	// with in this session, or nil if we have not seen it yet.
+	nodeKey *route.Vertex
+
+	mu sync.Mutex
+}
+
+// newServerValidator creates a validator which fails swaps on anomalies if
+// strict is set.
+func newServerValidator(strict bool) *serverValidator {
+	return &serverValidator{
+		strict: strict,
+	}
+}
+
+// anomaly handles an anomalous server parameter. The anomaly is returned as
+// an error in strict mode, and logged otherwise.
+func (v *serverValidator) anomaly(hash lntypes.Hash,
+	err *ServerParamError) error {
+
+	if v != nil && v.strict {
+		return err
+	}
+
+	log.Warnf("Swap %v: %v", hash, err)
+
+	return nil
+}
+
+// checkNodeKey checks that the server receives payments with the same node
+// key as it did earlier in our session.
+func (v *serverValidator) checkNodeKey(hash lntypes.Hash,
+	nodeKey route.Vertex) error {
+
+	if v == nil {
+		return nil
+	}
+
+	v.mu.Lock()
+	if v.nodeKey == nil {
+		v.nodeKey = &nodeKey
+	}
+	expected := *v.nodeKey
+	v.mu.Unlock()
+
+	if nodeKey == expected {
+		return nil
+	}
+
+	return v.anomaly(hash, anomalousParam(
+		ParamNodeKey, "%v differs from %v used earlier in session",
+		nodeKey, expected,
+	))
+}
+
+// validateServerKey checks that a htlc key provided by the server is a valid
+// public key, and that it differs from our own key for the htlc.
+func validateServerKey(serverKey, ourKey [33]byte) error {
+	if _, err := btcec.ParsePubKey(serverKey[:], btcec.S256()); err != nil {
+		return invalidParam(ParamHtlcKey, "%x: %v", serverKey, err)
+	}
+
+	if serverKey == ourKey {
+		return invalidParam(
+			ParamHtlcKey, "%x equals our key", serverKey,
+		)
+	}
+
+	return nil
+}
+
+// serverInvoice holds the fields of an invoice provided by the server that we
+// validate.
+type serverInvoice struct {
+	hash   lntypes.Hash
+	amount btcutil.Amount
+	payee  route.Vertex
+}
+
+// decodeServerInvoice decodes an invoice provided by the server, requiring
+// that it has an amount.
+func decodeServerInvoice(params *chaincfg.Params, param,
+	payReq string) (*serverInvoice, error) {
+
+	invoice, err := zpay32.Decode(payReq, params)
+	if err != nil {
+		return nil, invalidParam(param, "%v", err)
+	}
+
+	if invoice.MilliSat == nil {
+		return nil, invalidParam(param, "no amount in invoice")
+	}
+
+	if invoice.PaymentHash == nil || invoice.Destination == nil {
+		return nil, invalidParam(param, "incomplete invoice")
+	}
+
+	return &serverInvoice{
+		hash:   lntypes.Hash(*invoice.PaymentHash),
+		amount: invoice.MilliSat.ToSatoshis(),
+		payee:  route.NewVertex(invoice.Destination),
+	}, nil
+}
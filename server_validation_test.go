@@ -0,0 +1,318 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// signedInvoice creates an invoice for the hash and amount provided that is
+// signed by the key provided, so that its payee can be recovered.
+func signedInvoice(t *testing.T, key *btcec.PrivateKey, hash lntypes.Hash,
+	amt btcutil.Amount) string {
+
+	req, err := zpay32.NewInvoice(
+		&chaincfg.TestNet3Params, hash, testTime,
+		zpay32.Description("test"),
+		zpay32.Amount(lnwire.NewMSatFromSatoshis(amt)),
+	)
+	require.NoError(t, err)
+
+	payReq, err := req.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return btcec.SignCompact(btcec.S256(), key, hash, true)
+		},
+	})
+	require.NoError(t, err)
+
+	return payReq
+}
+
+// requireParamError asserts that an error is a server parameter error for the
+// parameter provided.
+func requireParamError(t *testing.T, err error, param string, anomaly bool) {
+	paramErr, ok := err.(*ServerParamError)
+	require.True(t, ok, "expected server param error, got: %v", err)
+	require.Equal(t, param, paramErr.Param)
+	require.Equal(t, anomaly, paramErr.Anomaly)
+}
+
+// TestValidateLoopOutContract tests validation of the parameters that the
+// server provides for loop out swaps.
+func TestValidateLoopOutContract(t *testing.T) {
+	var (
+		lnd = &lndclient.LndServices{
+			ChainParams: &chaincfg.TestNet3Params,
+		}
+
+		swapHash   = lntypes.Hash{1}
+		prepayHash = lntypes.Hash{2}
+		height     = int32(600)
+
+		serverNode, _ = test.CreateKey(1)
+		otherNode, _  = test.CreateKey(2)
+		_, serverKey  = test.CreateKey(3)
+		_, clientKey  = test.CreateKey(4)
+	)
+
+	var serverKeyBytes, clientKeyBytes [33]byte
+	copy(serverKeyBytes[:], serverKey.SerializeCompressed())
+	copy(clientKeyBytes[:], clientKey.SerializeCompressed())
+
+	request := func() *OutRequest {
+		return &OutRequest{
+			Amount:            50000,
+			MaxSwapFee:        1000,
+			MaxPrepayAmount:   100,
+			HtlcConfirmations: 1,
+			SweepConfTarget:   9,
+			Expiry:            height + 40,
+		}
+	}
+
+	response := func() *newLoopOutResponse {
+		return &newLoopOutResponse{
+			swapInvoice: signedInvoice(
+				t, serverNode, swapHash, 50900,
+			),
+			prepayInvoice: signedInvoice(
+				t, serverNode, prepayHash, 100,
+			),
+			senderKey: serverKeyBytes,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		request  func(*OutRequest)
+		response func(*newLoopOutResponse)
+		strict   bool
+		param    string
+		anomaly  bool
+	}{
+		{
+			name:   "valid",
+			strict: true,
+		},
+		{
+			name: "swap hash mismatch",
+			response: func(r *newLoopOutResponse) {
+				r.swapInvoice = signedInvoice(
+					t, serverNode, prepayHash, 50900,
+				)
+			},
+			param: ParamSwapInvoice,
+		},
+		{
+			name: "invalid htlc key",
+			response: func(r *newLoopOutResponse) {
+				r.senderKey = [33]byte{1}
+			},
+			param: ParamHtlcKey,
+		},
+		{
+			name: "htlc key equals ours",
+			response: func(r *newLoopOutResponse) {
+				r.senderKey = clientKeyBytes
+			},
+			param: ParamHtlcKey,
+		},
+		{
+			name: "prepay hash equals swap hash",
+			response: func(r *newLoopOutResponse) {
+				r.prepayInvoice = signedInvoice(
+					t, serverNode, swapHash, 100,
+				)
+			},
+			strict:  true,
+			param:   ParamPrepayInvoice,
+			anomaly: true,
+		},
+		{
+			name: "prepay payee differs",
+			response: func(r *newLoopOutResponse) {
+				r.prepayInvoice = signedInvoice(
+					t, otherNode, prepayHash, 100,
+				)
+			},
+			strict:  true,
+			param:   ParamPrepayInvoice,
+			anomaly: true,
+		},
+		{
+			name: "prepay payee differs, not strict",
+			response: func(r *newLoopOutResponse) {
+				r.prepayInvoice = signedInvoice(
+					t, otherNode, prepayHash, 100,
+				)
+			},
+		},
+		{
+			name: "expiry too close",
+			request: func(r *OutRequest) {
+				r.Expiry = height + 25
+			},
+			strict:  true,
+			param:   ParamHtlcExpiry,
+			anomaly: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			req, resp := request(), response()
+			if testCase.request != nil {
+				testCase.request(req)
+			}
+			if testCase.response != nil {
+				testCase.response(resp)
+			}
+
+			validator := newServerValidator(testCase.strict)
+			swapFee, prepay, err := validateLoopOutContract(
+				lnd, validator, height, req, swapHash,
+				clientKeyBytes, resp,
+			)
+
+			if testCase.param != "" {
+				requireParamError(
+					t, err, testCase.param,
+					testCase.anomaly,
+				)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, btcutil.Amount(1000), swapFee)
+			require.Equal(t, btcutil.Amount(100), prepay)
+		})
+	}
+}
+
+// TestServerValidatorNodeKey tests that changes in the node key that the
+// server receives payments with are only reported as errors in strict mode.
+func TestServerValidatorNodeKey(t *testing.T) {
+	var (
+		hash    = lntypes.Hash{1}
+		first   = route.Vertex{1}
+		changed = route.Vertex{2}
+	)
+
+	strict := newServerValidator(true)
+	require.NoError(t, strict.checkNodeKey(hash, first))
+	require.NoError(t, strict.checkNodeKey(hash, first))
+	requireParamError(
+		t, strict.checkNodeKey(hash, changed), ParamNodeKey, true,
+	)
+
+	lenient := newServerValidator(false)
+	require.NoError(t, lenient.checkNodeKey(hash, first))
+	require.NoError(t, lenient.checkNodeKey(hash, changed))
+
+	// A nil validator does not track node keys.
+	var none *serverValidator
+	require.NoError(t, none.checkNodeKey(hash, changed))
+}
+
+// TestValidateLoopInContract tests validation of the parameters that the
+// server provides for loop in swaps.
+func TestValidateLoopInContract(t *testing.T) {
+	var (
+		hash         = lntypes.Hash{1}
+		height       = int32(600)
+		_, serverKey = test.CreateKey(1)
+		_, clientKey = test.CreateKey(2)
+	)
+
+	var serverKeyBytes, clientKeyBytes [33]byte
+	copy(serverKeyBytes[:], serverKey.SerializeCompressed())
+	copy(clientKeyBytes[:], clientKey.SerializeCompressed())
+
+	tests := []struct {
+		name        string
+		expiry      int32
+		receiverKey [33]byte
+		strict      bool
+		err         error
+		param       string
+		anomaly     bool
+	}{
+		{
+			name:        "valid",
+			expiry:      height + 100,
+			receiverKey: serverKeyBytes,
+			strict:      true,
+		},
+		{
+			name:        "expiry too far",
+			expiry:      height + MaxLoopInAcceptDelta + 1,
+			receiverKey: serverKeyBytes,
+			err:         ErrExpiryTooFar,
+		},
+		{
+			name:        "expiry too close",
+			expiry:      height + MinLoopInPublishDelta - 1,
+			receiverKey: serverKeyBytes,
+			param:       ParamHtlcExpiry,
+		},
+		{
+			name:        "expiry before conf target",
+			expiry:      height + MinLoopInPublishDelta + 1,
+			receiverKey: serverKeyBytes,
+			strict:      true,
+			param:       ParamHtlcExpiry,
+			anomaly:     true,
+		},
+		{
+			name:        "expiry before conf target, not strict",
+			expiry:      height + MinLoopInPublishDelta + 1,
+			receiverKey: serverKeyBytes,
+		},
+		{
+			name:        "htlc key equals ours",
+			expiry:      height + 100,
+			receiverKey: clientKeyBytes,
+			param:       ParamHtlcKey,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateLoopInContract(
+				newServerValidator(testCase.strict), height,
+				&LoopInRequest{}, hash, clientKeyBytes,
+				&newLoopInResponse{
+					expiry:      testCase.expiry,
+					receiverKey: testCase.receiverKey,
+				},
+			)
+
+			switch {
+			case testCase.err != nil:
+				require.Equal(t, testCase.err, err)
+
+			case testCase.param != "":
+				requireParamError(
+					t, err, testCase.param,
+					testCase.anomaly,
+				)
+
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/bbolt"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // storeMock implements a mock client swap store.
@@ -22,6 +24,9 @@ type storeMock struct {
 	loopInStoreChan  chan loopdb.LoopInContract
 	loopInUpdateChan chan loopdb.SwapStateData
 
+	pendingApprovals map[lntypes.Hash]*loopdb.PendingApproval
+	swapDenials      []*loopdb.SwapDenial
+
 	t *testing.T
 }
 
@@ -37,7 +42,11 @@ func newStoreMock(t *testing.T) *storeMock {
 		loopInUpdateChan: make(chan loopdb.SwapStateData, 1),
 		loopInSwaps:      make(map[lntypes.Hash]*loopdb.LoopInContract),
 		loopInUpdates:    make(map[lntypes.Hash][]loopdb.SwapStateData),
-		t:                t,
+
+		pendingApprovals: make(
+			map[lntypes.Hash]*loopdb.PendingApproval,
+		),
+		t: t,
 	}
 }
 
@@ -69,6 +78,26 @@ func (s *storeMock) FetchLoopOutSwaps() ([]*loopdb.LoopOut, error) {
 	return result, nil
 }
 
+// FetchPendingLoopOuts returns the loop out swaps in the store that are not in
+// a final state.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchPendingLoopOuts() ([]*loopdb.LoopOut, error) {
+	swaps, err := s.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*loopdb.LoopOut
+	for _, swap := range swaps {
+		if swap.State().State.Type() == loopdb.StateTypePending {
+			pending = append(pending, swap)
+		}
+	}
+
+	return pending, nil
+}
+
 // CreateLoopOut adds an initiated swap to the store.
 //
 // NOTE: Part of the loopdb.SwapStore interface.
@@ -113,6 +142,26 @@ func (s *storeMock) FetchLoopInSwaps() ([]*loopdb.LoopIn, error) {
 	return result, nil
 }
 
+// FetchPendingLoopIns returns the loop in swaps in the store that are not in a
+// final state.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchPendingLoopIns() ([]*loopdb.LoopIn, error) {
+	swaps, err := s.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*loopdb.LoopIn
+	for _, swap := range swaps {
+		if swap.State().State.Type() == loopdb.StateTypePending {
+			pending = append(pending, swap)
+		}
+	}
+
+	return pending, nil
+}
+
 // CreateLoopIn adds an initiated loop in swap to the store.
 //
 // NOTE: Part of the loopdb.SwapStore interface.
@@ -171,6 +220,264 @@ func (s *storeMock) UpdateLoopIn(hash lntypes.Hash, time time.Time,
 	return nil
 }
 
+// UpdateLoopOuts stores a batch of loop out swap updates.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) UpdateLoopOuts(updates []*loopdb.SwapUpdate) error {
+	for _, update := range updates {
+		if _, ok := s.loopOutUpdates[update.Hash]; !ok {
+			return loopdb.ErrSwapNotFound
+		}
+	}
+
+	for _, update := range updates {
+		err := s.UpdateLoopOut(update.Hash, update.Time, update.State)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateLoopIns stores a batch of loop in swap updates.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) UpdateLoopIns(updates []*loopdb.SwapUpdate) error {
+	for _, update := range updates {
+		if _, ok := s.loopInUpdates[update.Hash]; !ok {
+			return loopdb.ErrSwapNotFound
+		}
+	}
+
+	for _, update := range updates {
+		err := s.UpdateLoopIn(update.Hash, update.Time, update.State)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FetchLoopOutStates returns the latest update of each loop out provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchLoopOutStates(hashes []lntypes.Hash) (
+	map[lntypes.Hash]*loopdb.LoopEvent, error) {
+
+	return latestMockUpdates(s.loopOutUpdates, hashes)
+}
+
+// FetchLoopInStates returns the latest update of each loop in provided.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchLoopInStates(hashes []lntypes.Hash) (
+	map[lntypes.Hash]*loopdb.LoopEvent, error) {
+
+	return latestMockUpdates(s.loopInUpdates, hashes)
+}
+
+// latestMockUpdates returns the latest of the updates provided for each hash.
+func latestMockUpdates(updates map[lntypes.Hash][]loopdb.SwapStateData,
+	hashes []lntypes.Hash) (map[lntypes.Hash]*loopdb.LoopEvent, error) {
+
+	latest := make(map[lntypes.Hash]*loopdb.LoopEvent, len(hashes))
+	for _, hash := range hashes {
+		swapUpdates, ok := updates[hash]
+		if !ok {
+			return nil, loopdb.ErrSwapNotFound
+		}
+
+		if len(swapUpdates) == 0 {
+			continue
+		}
+
+		latest[hash] = &loopdb.LoopEvent{
+			SwapStateData: swapUpdates[len(swapUpdates)-1],
+		}
+	}
+
+	return latest, nil
+}
+
+func (s *storeMock) CreateLiquiditySnapshot(
+	_ *loopdb.LiquiditySnapshot) error {
+
+	return nil
+}
+
+func (s *storeMock) FetchLiquiditySnapshots(_,
+	_ time.Time) ([]*loopdb.LiquiditySnapshot, error) {
+
+	return nil, nil
+}
+
+func (s *storeMock) PruneLiquiditySnapshots(_ time.Time) error {
+	return nil
+}
+
+func (s *storeMock) CreateFeeRecord(_ *loopdb.FeeRecord) error {
+	return nil
+}
+
+func (s *storeMock) SetRealizedFeeRate(_ lntypes.Hash, _ loopdb.FeeDecision,
+	_ chainfee.SatPerKWeight) error {
+
+	return nil
+}
+
+func (s *storeMock) FetchFeeRecords(_, _ time.Time) ([]*loopdb.FeeRecord,
+	error) {
+
+	return nil, nil
+}
+
+func (s *storeMock) CreateDispatchRecord(_ *loopdb.DispatchRecord) error {
+	return nil
+}
+
+func (s *storeMock) FetchDispatchRecords(_, _ time.Time) (
+	[]*loopdb.DispatchRecord, error) {
+
+	return nil, nil
+}
+
+func (s *storeMock) PutRoutingFailures(_ []loopdb.RoutingFailure) error {
+	return nil
+}
+
+func (s *storeMock) FetchRoutingFailures(_ time.Time) ([]loopdb.RoutingFailure,
+	error) {
+
+	return nil, nil
+}
+
+func (s *storeMock) PruneRoutingFailures(_ time.Time) error {
+	return nil
+}
+
+func (s *storeMock) PutSwapTemplate(_ *loopdb.SwapTemplate) error {
+	return nil
+}
+
+func (s *storeMock) FetchSwapTemplates() ([]*loopdb.SwapTemplate, error) {
+	return nil, nil
+}
+
+func (s *storeMock) DeleteSwapTemplate(_ string) error {
+	return nil
+}
+
+func (s *storeMock) PutFeeBudget(_ *loopdb.FeeBudget) error {
+	return nil
+}
+
+func (s *storeMock) FetchFeeBudgets() ([]*loopdb.FeeBudget, error) {
+	return nil, nil
+}
+
+func (s *storeMock) DeleteFeeBudget(_ loopdb.BudgetNamespace,
+	_ string) error {
+
+	return nil
+}
+
+func (s *storeMock) PutChannelPolicy(_ *loopdb.ChannelPolicy) error {
+	return nil
+}
+
+func (s *storeMock) FetchChannelPolicies() ([]*loopdb.ChannelPolicy, error) {
+	return nil, nil
+}
+
+func (s *storeMock) DeleteChannelPolicy(_ uint64) error {
+	return nil
+}
+
+func (s *storeMock) CreateSwapPlan(_ *loopdb.SwapPlan) error {
+	return nil
+}
+
+func (s *storeMock) UpdateSwapPlan(_ *loopdb.SwapPlan) error {
+	return nil
+}
+
+func (s *storeMock) FetchSwapPlans() ([]*loopdb.SwapPlan, error) {
+	return nil, nil
+}
+
+func (s *storeMock) UpdateExtension(_ string,
+	_ func(*bbolt.Bucket) error) error {
+
+	return errors.New("extensions not supported")
+}
+
+func (s *storeMock) ViewExtension(_ string,
+	_ func(*bbolt.Bucket) error) error {
+
+	return errors.New("extensions not supported")
+}
+
+func (s *storeMock) ImportSwaps(_ []*loopdb.LoopOut,
+	_ []*loopdb.LoopIn) error {
+
+	return nil
+}
+
+func (s *storeMock) RedactSwap(hash lntypes.Hash) error {
+	if contract, ok := s.loopOutSwaps[hash]; ok {
+		loopdb.RedactContract(&contract.SwapContract)
+		return nil
+	}
+
+	if contract, ok := s.loopInSwaps[hash]; ok {
+		loopdb.RedactContract(&contract.SwapContract)
+		return nil
+	}
+
+	return loopdb.ErrSwapNotFound
+}
+
+func (s *storeMock) PutPendingApproval(
+	approval *loopdb.PendingApproval) error {
+
+	s.pendingApprovals[approval.Hash] = approval
+	return nil
+}
+
+func (s *storeMock) FetchPendingApprovals() ([]*loopdb.PendingApproval,
+	error) {
+
+	approvals := make(
+		[]*loopdb.PendingApproval, 0, len(s.pendingApprovals),
+	)
+	for _, approval := range s.pendingApprovals {
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
+func (s *storeMock) DeletePendingApproval(hash lntypes.Hash) error {
+	delete(s.pendingApprovals, hash)
+	return nil
+}
+
+func (s *storeMock) CreateSwapDenial(denial *loopdb.SwapDenial) error {
+	s.swapDenials = append(s.swapDenials, denial)
+	delete(s.pendingApprovals, denial.Hash)
+	return nil
+}
+
+func (s *storeMock) FetchSwapDenials() ([]*loopdb.SwapDenial, error) {
+	return s.swapDenials, nil
+}
+
+func (s *storeMock) WriteReplica(_ string) error {
+	return nil
+}
+
 func (s *storeMock) Close() error {
 	return nil
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/swapfsm"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/lntypes"
 )
 
@@ -27,6 +29,18 @@ type swapKit struct {
 
 	swapType swap.Type
 
+	// traceSpan is the trace span for the swap's execution. It is nil if
+	// tracing is disabled or the swap is not executing.
+	traceSpan *tracing.Span
+
+	// stateSpan is the trace span for the swap's current state. It is nil
+	// if tracing is disabled or the swap has reached a final state.
+	stateSpan *tracing.Span
+
+	// stateSpanState is the state that our current state span was started
+	// for.
+	stateSpanState loopdb.SwapState
+
 	swapConfig
 }
 
@@ -37,6 +51,7 @@ func newSwapKit(hash lntypes.Hash, swapType swap.Type, cfg *swapConfig,
 		Hash:   hash,
 		Logger: log,
 	}
+	log.SetState(loopdb.StateInitiated)
 
 	return &swapKit{
 		swapConfig: *cfg,
@@ -77,6 +92,11 @@ func (s *swapKit) getHtlc(outputType swap.HtlcOutputType) (*swap.Htlc, error) {
 	)
 }
 
+// swapHash returns the hash that identifies the swap.
+func (s *swapKit) swapHash() lntypes.Hash {
+	return s.hash
+}
+
 // swapInfo constructs and returns a filled SwapInfo from
 // the swapKit.
 func (s *swapKit) swapInfo() *SwapInfo {
@@ -92,23 +112,96 @@ func (s *swapKit) swapInfo() *SwapInfo {
 	}
 }
 
+// traceState ends the trace span for the swap's previous state if the swap's
+// state has changed, and starts a span for its new state if the swap has not
+// yet reached a final state. State spans are children of the swap's execution
+// span, regardless of the context that the state change happened in.
+func (s *swapKit) traceState(ctx context.Context) {
+	if s.stateSpan != nil && s.stateSpanState == s.state {
+		return
+	}
+
+	s.stateSpan.End()
+	s.stateSpan = nil
+
+	if s.state.Type() != loopdb.StateTypePending {
+		return
+	}
+
+	_, s.stateSpan = tracing.Start(
+		tracing.ContextWithSpan(ctx, s.traceSpan), s.state.String(),
+	)
+	s.stateSpan.SetAttribute("swap.hash", s.hash.String())
+	s.stateSpanState = s.state
+}
+
+// startTrace places the context provided in the swap's trace and starts a
+// span for the swap's execution. The cleanup function returned ends the
+// span, along with the span for the swap's current state.
+func (s *swapKit) startTrace(ctx context.Context) (context.Context,
+	func(error)) {
+
+	ctx = tracing.WithTrace(ctx, tracing.SwapTraceID(s.hash))
+
+	name := "loop out"
+	if s.swapType == swap.TypeIn {
+		name = "loop in"
+	}
+
+	ctx, s.traceSpan = tracing.Start(ctx, name)
+	s.traceSpan.SetAttribute("swap.hash", s.hash.String())
+
+	return ctx, func(err error) {
+		s.stateSpan.End()
+		s.stateSpan = nil
+
+		s.traceSpan.RecordError(err)
+		s.traceSpan.End()
+		s.traceSpan = nil
+	}
+}
+
 type genericSwap interface {
 	execute(mainCtx context.Context, cfg *executeConfig,
 		height int32) error
+
+	// swapHash returns the hash that identifies the swap.
+	swapHash() lntypes.Hash
 }
 
 type swapConfig struct {
 	lnd    *lndclient.LndServices
 	store  loopdb.SwapStore
 	server swapServerClient
+
+	// serverAddress is the address of the swap server that new swaps are
+	// made with.
+	serverAddress string
+
+	// validator handles anomalies in the parameters that the server
+	// provides for new swaps. If nil, anomalies are logged.
+	validator *serverValidator
+
+	// amountTiers scales the confirmation requirements and fee limits of
+	// new swaps with their amount.
+	amountTiers AmountTiers
+	// paymentRecords are the custom record types that we can attach to
+	// the payments of new loop outs. If empty, the server may not request
+	// any records.
+	paymentRecords []uint64
+
+	// actions performs the side effects of our swaps' transitions on
+	// chain and with the server.
+	actions swapfsm.Actions
 }
 
 func newSwapConfig(lnd *lndclient.LndServices, store loopdb.SwapStore,
 	server swapServerClient) *swapConfig {
 
 	return &swapConfig{
-		lnd:    lnd,
-		store:  store,
-		server: server,
+		lnd:     lnd,
+		store:   store,
+		server:  server,
+		actions: newLndActions(lnd, server),
 	}
 }
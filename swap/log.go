@@ -2,46 +2,107 @@ package swap
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/loop/logging"
 	"github.com/lightningnetwork/lnd/lntypes"
 )
 
-// PrefixLog logs with a short swap hash prefix.
+// PrefixLog logs with a short swap hash prefix. If the underlying logger
+// supports structured logging, the full swap hash and the swap's state are
+// included as fields instead.
 type PrefixLog struct {
 	// Logger is the underlying based logger.
 	Logger btclog.Logger
 
 	// Hash is the hash the identifies the target swap.
 	Hash lntypes.Hash
+
+	// state is the swap's current state, as last set by SetState.
+	state string
+
+	stateLock sync.Mutex
+}
+
+// SetState sets the swap state that is included in structured log entries.
+func (s *PrefixLog) SetState(state fmt.Stringer) {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+
+	s.state = state.String()
+}
+
+// logger returns the logger that we log with, and the format string to use
+// with it. Structured loggers are given the swap's fields, while other
+// loggers have the short swap hash prepended to the format string.
+func (s *PrefixLog) logger(format string) (btclog.Logger, string) {
+	if _, ok := s.Logger.(logging.FieldLogger); !ok {
+		return s.Logger, fmt.Sprintf("%v %s", ShortHash(&s.Hash), format)
+	}
+
+	s.stateLock.Lock()
+	fields := logging.Fields{
+		logging.FieldSwapID: s.Hash.String(),
+		logging.FieldState:  s.state,
+	}
+	s.stateLock.Unlock()
+
+	return logging.WithFields(s.Logger, fields), format
+}
+
+// Debugf formats message according to format specifier and writes to
+// log with LevelDebug.
+func (s *PrefixLog) Debugf(format string, params ...interface{}) {
+	logger, format := s.logger(format)
+	logger.Debugf(format, params...)
 }
 
 // Infof formats message according to format specifier and writes to
 // log with LevelInfo.
 func (s *PrefixLog) Infof(format string, params ...interface{}) {
-	s.Logger.Infof(
-		fmt.Sprintf("%v %s", ShortHash(&s.Hash), format),
-		params...,
-	)
+	logger, format := s.logger(format)
+	logger.Infof(format, params...)
 }
 
 // Warnf formats message according to format specifier and writes to
 // to log with LevelError.
 func (s *PrefixLog) Warnf(format string, params ...interface{}) {
-	s.Logger.Warnf(
-		fmt.Sprintf("%v %s", ShortHash(&s.Hash), format),
-		params...,
-	)
+	logger, format := s.logger(format)
+	logger.Warnf(format, params...)
 }
 
 // Errorf formats message according to format specifier and writes to
 // to log with LevelError.
 func (s *PrefixLog) Errorf(format string, params ...interface{}) {
-	s.Logger.Errorf(
-		fmt.Sprintf("%v %s", ShortHash(&s.Hash), format),
-		params...,
+	logger, format := s.logger(format)
+	logger.Errorf(format, params...)
+}
+
+// throttledf logs a message at the level provided, unless the same message
+// was logged for our swap recently. Swap hashes are unique, so messages are
+// not throttled per subsystem.
+func (s *PrefixLog) throttledf(level btclog.Level, format string,
+	params ...interface{}) {
+
+	logger, prefixed := s.logger(format)
+	logging.DefaultThrottle.Logf(
+		logger, level, "", s.Hash.String(), prefixed, params...,
 	)
+}
+
+// ThrottledInfof formats message according to format specifier and writes
+// to log with LevelInfo, unless the message was logged for the swap recently.
+// It should be used for messages that repeat on every block.
+func (s *PrefixLog) ThrottledInfof(format string, params ...interface{}) {
+	s.throttledf(btclog.LevelInfo, format, params...)
+}
 
+// ThrottledWarnf formats message according to format specifier and writes
+// to log with LevelWarn, unless the message was logged for the swap recently.
+// It should be used for messages that repeat on every block.
+func (s *PrefixLog) ThrottledWarnf(format string, params ...interface{}) {
+	s.throttledf(btclog.LevelWarn, format, params...)
 }
 
 // ShortHash returns a shortened version of the hash suitable for use in
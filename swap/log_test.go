@@ -0,0 +1,58 @@
+package swap
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/loop/logging"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// testState is a swap state used for testing.
+type testState string
+
+// String returns the string representation of a test state.
+func (s testState) String() string {
+	return string(s)
+}
+
+// TestPrefixLog tests that swap logs are prefixed with the short swap hash by
+// plain loggers, and include the swap's hash and state as fields when logged
+// by structured loggers.
+func TestPrefixLog(t *testing.T) {
+	hash := lntypes.Hash{1, 2, 3}
+
+	var textBuf bytes.Buffer
+	textLog := &PrefixLog{
+		Logger: btclog.NewBackend(&textBuf).Logger("LOOP"),
+		Hash:   hash,
+	}
+	textLog.Infof("swap %v", "update")
+
+	require.Contains(
+		t, textBuf.String(), ShortHash(&hash)+" swap update",
+	)
+
+	var jsonBuf bytes.Buffer
+	jsonLog := &PrefixLog{
+		Logger: logging.NewJSONBackend(&jsonBuf).Logger("LOOP", nil),
+		Hash:   hash,
+	}
+	jsonLog.SetState(testState("Initiated"))
+	jsonLog.Infof("swap %v", "update")
+
+	var entry map[string]string
+	err := json.Unmarshal(
+		[]byte(strings.TrimSpace(jsonBuf.String())), &entry,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "swap update", entry[logging.FieldMessage])
+	require.Equal(t, hash.String(), entry[logging.FieldSwapID])
+	require.Equal(t, "Initiated", entry[logging.FieldState])
+	require.Equal(t, "LOOP", entry[logging.FieldSubsystem])
+}
@@ -0,0 +1,60 @@
+package loop
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swapfsm"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// lndActions performs the side effects of our swaps' transitions with lnd and
+// the swap server.
+type lndActions struct {
+	lnd    *lndclient.LndServices
+	server swapServerClient
+}
+
+var _ swapfsm.Actions = (*lndActions)(nil)
+
+// newLndActions returns the actions that our swaps perform with the lnd node
+// and server provided.
+func newLndActions(lnd *lndclient.LndServices,
+	server swapServerClient) *lndActions {
+
+	return &lndActions{
+		lnd:    lnd,
+		server: server,
+	}
+}
+
+// SendOutputs funds and publishes a transaction paying to the outputs provided
+// from lnd's wallet.
+func (a *lndActions) SendOutputs(ctx context.Context, outputs []*wire.TxOut,
+	feeRate chainfee.SatPerKWeight, label string) (*wire.MsgTx, error) {
+
+	return a.lnd.WalletKit.SendOutputs(ctx, outputs, feeRate, label)
+}
+
+// PublishTx publishes a transaction through lnd's wallet.
+func (a *lndActions) PublishTx(ctx context.Context, tx *wire.MsgTx,
+	label string) error {
+
+	return a.lnd.WalletKit.PublishTransaction(ctx, tx, label)
+}
+
+// CancelInvoice cancels an invoice in lnd.
+func (a *lndActions) CancelInvoice(ctx context.Context,
+	hash lntypes.Hash) error {
+
+	return a.lnd.Invoices.CancelInvoice(ctx, hash)
+}
+
+// PushPreimage pushes a loop out's preimage to the server.
+func (a *lndActions) PushPreimage(ctx context.Context,
+	preimage lntypes.Preimage) error {
+
+	return a.server.PushLoopOutPreimage(ctx, preimage)
+}
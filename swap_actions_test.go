@@ -0,0 +1,167 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// mockActions records the side effects that a swap performs, so that its
+// transitions can be driven without lnd or the server.
+type mockActions struct {
+	published []*wire.MsgTx
+	cancelled []lntypes.Hash
+	pushed    []lntypes.Preimage
+}
+
+// SendOutputs records a transaction paying to the outputs provided.
+func (m *mockActions) SendOutputs(_ context.Context, outputs []*wire.TxOut,
+	_ chainfee.SatPerKWeight, _ string) (*wire.MsgTx, error) {
+
+	tx := &wire.MsgTx{TxOut: outputs}
+	m.published = append(m.published, tx)
+
+	return tx, nil
+}
+
+// PublishTx records the transaction provided.
+func (m *mockActions) PublishTx(_ context.Context, tx *wire.MsgTx,
+	_ string) error {
+
+	m.published = append(m.published, tx)
+
+	return nil
+}
+
+// CancelInvoice records the invoice cancelled.
+func (m *mockActions) CancelInvoice(_ context.Context,
+	hash lntypes.Hash) error {
+
+	m.cancelled = append(m.cancelled, hash)
+
+	return nil
+}
+
+// PushPreimage records the preimage pushed.
+func (m *mockActions) PushPreimage(_ context.Context,
+	preimage lntypes.Preimage) error {
+
+	m.pushed = append(m.pushed, preimage)
+
+	return nil
+}
+
+// TestLoopInHtlcSpendActions tests that a loop in only cancels its swap
+// invoice when its htlc is spent by our timeout tx.
+func TestLoopInHtlcSpendActions(t *testing.T) {
+	hash := testPreimage.Hash()
+
+	var senderKey, receiverKey [33]byte
+	_, senderPubKey := test.CreateKey(1)
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+	_, receiverPubKey := test.CreateKey(2)
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	htlc, err := swap.NewHtlc(
+		swap.HtlcV2, 700, senderKey, receiverKey, hash,
+		swap.HtlcP2WSH, &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	const htlcValue = btcutil.Amount(50000)
+
+	tests := []struct {
+		name      string
+		state     loopdb.SwapState
+		witness   wire.TxWitness
+		expected  loopdb.SwapState
+		cancelled []lntypes.Hash
+	}{
+		{
+			name:     "server sweeps htlc",
+			state:    loopdb.StateInvoiceSettled,
+			witness:  wire.TxWitness{{}, {}, {}},
+			expected: loopdb.StateSuccess,
+		},
+		{
+			name:      "htlc times out",
+			state:     loopdb.StateHtlcPublished,
+			witness:   htlc.GenTimeoutWitness([]byte{1}),
+			expected:  loopdb.StateFailTimeout,
+			cancelled: []lntypes.Hash{hash},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			actions := &mockActions{}
+			cfg := &swapConfig{
+				store:   newStoreMock(t),
+				actions: actions,
+			}
+
+			s := &loopInSwap{
+				swapKit: *newSwapKit(
+					hash, swap.TypeIn, cfg,
+					&loopdb.SwapContract{},
+				),
+				htlc: htlc,
+			}
+			s.state = testCase.state
+
+			spendTx := &wire.MsgTx{}
+			spendTx.AddTxIn(&wire.TxIn{
+				Witness: testCase.witness,
+			})
+			spendTx.AddTxOut(&wire.TxOut{
+				Value: int64(htlcValue - 500),
+			})
+
+			err := s.processHtlcSpend(
+				context.Background(), &chainntnfs.SpendDetail{
+					SpendingTx: spendTx,
+				}, htlcValue, 500,
+			)
+			require.NoError(t, err)
+
+			require.Equal(t, testCase.expected, s.state)
+			require.Equal(t, testCase.cancelled, actions.cancelled)
+		})
+	}
+}
+
+// TestLoopOutPushPreimageActions tests that a loop out only pushes its
+// preimage to the server once it has revealed it on chain.
+func TestLoopOutPushPreimageActions(t *testing.T) {
+	actions := &mockActions{}
+	cfg := &swapConfig{
+		actions: actions,
+	}
+
+	s := &loopOutSwap{
+		swapKit: *newSwapKit(
+			testPreimage.Hash(), swap.TypeOut, cfg,
+			&loopdb.SwapContract{},
+		),
+	}
+	s.Preimage = testPreimage
+
+	s.pushPreimage(context.Background())
+	require.Empty(t, actions.pushed)
+
+	s.state = loopdb.StatePreimageRevealed
+	s.pushPreimage(context.Background())
+	require.Equal(t, []lntypes.Preimage{testPreimage}, actions.pushed)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -64,7 +65,8 @@ type swapServerClient interface {
 	NewLoopOutSwap(ctx context.Context,
 		swapHash lntypes.Hash, amount btcutil.Amount, expiry int32,
 		receiverKey [33]byte, swapPublicationDeadline time.Time,
-		initiator string) (*newLoopOutResponse, error)
+		initiator string, paymentRecords []uint64) (*newLoopOutResponse,
+		error)
 
 	PushLoopOutPreimage(ctx context.Context,
 		preimage lntypes.Preimage) error
@@ -92,12 +94,27 @@ type grpcSwapServerClient struct {
 	server looprpc.SwapServerClient
 	conn   *grpc.ClientConn
 
+	// monitor tracks the state of our connection to the server until
+	// stopMonitor is called.
+	monitor     *serverConnMonitor
+	stopMonitor func()
+
+	// agentName is the agent name that we send in our user agent. If
+	// empty, the package level AgentName is used.
+	agentName string
+
+	// initiator is the initiator that we send in the user agent of swaps
+	// that are requested without one.
+	initiator string
+
 	wg sync.WaitGroup
 }
 
 // stop sends the signal for the server's goroutines to shutdown and waits for
 // them to complete.
 func (s *grpcSwapServerClient) stop() {
+	s.stopMonitor()
+
 	if err := s.conn.Close(); err != nil {
 		log.Warnf("could not close connection: %v", err)
 	}
@@ -118,7 +135,7 @@ func newSwapServerClient(cfg *ClientConfig, lsatStore lsat.Store) (
 	)
 	serverConn, err := getSwapServerConn(
 		cfg.ServerAddress, cfg.ProxyAddress, cfg.SwapServerNoTLS,
-		cfg.TLSPathServer, clientInterceptor,
+		cfg.TLSPathServer, clientInterceptor, cfg.ServerDialOptions...,
 	)
 	if err != nil {
 		return nil, err
@@ -126,10 +143,40 @@ func newSwapServerClient(cfg *ClientConfig, lsatStore lsat.Store) (
 
 	server := looprpc.NewSwapServerClient(serverConn)
 
-	return &grpcSwapServerClient{
-		conn:   serverConn,
-		server: server,
-	}, nil
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &grpcSwapServerClient{
+		conn:        serverConn,
+		server:      server,
+		monitor:     newServerConnMonitor(serverConn, time.Now),
+		stopMonitor: cancel,
+		agentName:   cfg.AgentName,
+		initiator:   cfg.Initiator,
+	}
+
+	client.wg.Add(1)
+	go func() {
+		defer client.wg.Done()
+
+		client.monitor.run(ctx)
+	}()
+
+	return client, nil
+}
+
+// userAgent returns the user agent that we send to the server for a swap with
+// the initiator provided, falling back to our default initiator if the swap
+// does not have one.
+func (s *grpcSwapServerClient) userAgent(initiator string) string {
+	if strings.TrimSpace(initiator) == "" {
+		initiator = s.initiator
+	}
+
+	agentName := s.agentName
+	if agentName == "" {
+		agentName = AgentName
+	}
+
+	return userAgent(agentName, initiator)
 }
 
 func (s *grpcSwapServerClient) GetLoopOutTerms(ctx context.Context) (
@@ -315,7 +362,8 @@ func (s *grpcSwapServerClient) Probe(ctx context.Context, amt btcutil.Amount,
 func (s *grpcSwapServerClient) NewLoopOutSwap(ctx context.Context,
 	swapHash lntypes.Hash, amount btcutil.Amount, expiry int32,
 	receiverKey [33]byte, swapPublicationDeadline time.Time,
-	initiator string) (*newLoopOutResponse, error) {
+	initiator string, paymentRecords []uint64) (*newLoopOutResponse,
+	error) {
 
 	rpcCtx, rpcCancel := context.WithTimeout(ctx, globalCallTimeout)
 	defer rpcCancel()
@@ -327,7 +375,8 @@ func (s *grpcSwapServerClient) NewLoopOutSwap(ctx context.Context,
 			SwapPublicationDeadline: swapPublicationDeadline.Unix(),
 			ProtocolVersion:         loopdb.CurrentRPCProtocolVersion,
 			Expiry:                  expiry,
-			UserAgent:               UserAgent(initiator),
+			UserAgent:               s.userAgent(initiator),
+			SupportedPaymentRecords: paymentRecords,
 		},
 	)
 	if err != nil {
@@ -348,6 +397,12 @@ func (s *grpcSwapServerClient) NewLoopOutSwap(ctx context.Context,
 		prepayInvoice: swapResp.PrepayInvoice,
 		senderKey:     senderKey,
 		serverMessage: swapResp.ServerMessage,
+		structuredMessage: unmarshallServerMessage(
+			swapResp.StructuredMessage,
+		),
+		paymentRecords: unmarshallPaymentRecords(
+			swapResp.PaymentRecords,
+		),
 	}, nil
 }
 
@@ -383,7 +438,7 @@ func (s *grpcSwapServerClient) NewLoopInSwap(ctx context.Context,
 		SwapInvoice:     swapInvoice,
 		ProtocolVersion: loopdb.CurrentRPCProtocolVersion,
 		ProbeInvoice:    probeInvoice,
-		UserAgent:       UserAgent(initiator),
+		UserAgent:       s.userAgent(initiator),
 	}
 	if lastHop != nil {
 		req.LastHop = lastHop[:]
@@ -407,6 +462,9 @@ func (s *grpcSwapServerClient) NewLoopInSwap(ctx context.Context,
 		receiverKey:   receiverKey,
 		expiry:        swapResp.Expiry,
 		serverMessage: swapResp.ServerMessage,
+		structuredMessage: unmarshallServerMessage(
+			swapResp.StructuredMessage,
+		),
 	}, nil
 }
 
@@ -650,14 +708,16 @@ func rpcRouteCancel(details *outCancelDetails) (
 
 // getSwapServerConn returns a connection to the swap server. A non-empty
 // proxyAddr indicates that a SOCKS proxy found at the address should be used to
-// establish the connection.
+// establish the connection. Any extra options provided are added to the
+// options that the connection is dialed with.
 func getSwapServerConn(address, proxyAddress string, insecure bool,
-	tlsPath string, interceptor *lsat.ClientInterceptor) (*grpc.ClientConn,
-	error) {
+	tlsPath string, interceptor *lsat.ClientInterceptor,
+	extraOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
 
 	// Create a dial options array.
 	opts := []grpc.DialOption{
-		grpc.WithUnaryInterceptor(
+		grpc.WithChainUnaryInterceptor(
+			tracing.UnaryClientInterceptor,
 			interceptor.UnaryInterceptor,
 		),
 		grpc.WithStreamInterceptor(
@@ -700,6 +760,10 @@ func getSwapServerConn(address, proxyAddress string, insecure bool,
 		opts = append(opts, grpc.WithContextDialer(torDialer))
 	}
 
+	// Reconnect with an exponential backoff if our connection is lost.
+	opts = append(opts, grpc.WithConnectParams(serverConnectParams))
+	opts = append(opts, extraOpts...)
+
 	conn, err := grpc.Dial(address, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to RPC server: %v",
@@ -721,15 +785,59 @@ func isErrConClosing(err error) bool {
 	return strings.Contains(err.Error(), "transport is closing")
 }
 
+// unmarshallServerMessage converts a structured server message to our
+// internal representation, returning nil if no message was provided.
+func unmarshallServerMessage(
+	msg *looprpc.StructuredServerMessage) *loopdb.ServerMessage {
+
+	if msg == nil || msg.Code == "" {
+		return nil
+	}
+
+	params := make(map[string]string, len(msg.Params))
+	for key, value := range msg.Params {
+		params[key] = value
+	}
+
+	return &loopdb.ServerMessage{
+		Code:   msg.Code,
+		Params: params,
+	}
+}
+
+// unmarshallPaymentRecords converts the custom records that the server
+// requested for a swap's payments to our internal representation.
+func unmarshallPaymentRecords(
+	records []*looprpc.PaymentRecord) []loopdb.PaymentRecord {
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	paymentRecords := make([]loopdb.PaymentRecord, len(records))
+	for i, record := range records {
+		paymentRecords[i] = loopdb.PaymentRecord{
+			Type:   record.Type,
+			Value:  record.Value,
+			Prepay: record.Prepay,
+		}
+	}
+
+	return paymentRecords
+}
+
 type newLoopOutResponse struct {
-	swapInvoice   string
-	prepayInvoice string
-	senderKey     [33]byte
-	serverMessage string
+	swapInvoice       string
+	prepayInvoice     string
+	senderKey         [33]byte
+	serverMessage     string
+	structuredMessage *loopdb.ServerMessage
+	paymentRecords    []loopdb.PaymentRecord
 }
 
 type newLoopInResponse struct {
-	receiverKey   [33]byte
-	expiry        int32
-	serverMessage string
+	receiverKey       [33]byte
+	expiry            int32
+	serverMessage     string
+	structuredMessage *loopdb.ServerMessage
 }
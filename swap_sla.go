@@ -0,0 +1,41 @@
+package loop
+
+import (
+	"time"
+)
+
+// expectedBlockTime is the average time between blocks that we use to
+// estimate how long the on chain steps of a swap take.
+const expectedBlockTime = 10 * time.Minute
+
+// expectedLoopOutDuration estimates the time that a loop out swap initiated
+// at the time provided takes to complete. The server may wait until the
+// swap's publication deadline before it publishes the htlc, which then needs
+// to reach our required number of confirmations before our sweep confirms
+// within its confirmation target.
+func expectedLoopOutDuration(initiation, publicationDeadline time.Time,
+	htlcConfs uint32, sweepConfTarget int32) time.Duration {
+
+	var publicationDelay time.Duration
+	if publicationDeadline.After(initiation) {
+		publicationDelay = publicationDeadline.Sub(initiation)
+	}
+
+	blocks := int64(htlcConfs) + int64(sweepConfTarget)
+
+	return publicationDelay + time.Duration(blocks)*expectedBlockTime
+}
+
+// expectedLoopInDuration estimates the time that a loop in swap takes to
+// complete, which is dominated by the confirmation of its htlc. We can't
+// know when an external htlc will be published, so we assume that it is
+// published with our default confirmation target.
+func expectedLoopInDuration(htlcConfTarget int32,
+	externalHtlc bool) time.Duration {
+
+	if externalHtlc || htlcConfTarget == 0 {
+		htlcConfTarget = DefaultHtlcConfTarget
+	}
+
+	return time.Duration(htlcConfTarget) * expectedBlockTime
+}
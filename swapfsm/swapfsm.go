@@ -0,0 +1,257 @@
+// Package swapfsm describes the loop out and loop in state machines as
+// explicit transition tables. Stepping a machine is deterministic: the next
+// state of a swap only depends on its current state and the event that
+// occurred, so that the machines can be tested exhaustively and reused by
+// experimental swap variants. The machines only compute states: the swaps
+// that step through them perform the side effects of their transitions, such
+// as publishing transactions or notifying the server, through the Actions
+// that they are provided with.
+package swapfsm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ErrInvalidTransition is returned when an event occurs in a state that it is
+// not expected in.
+var ErrInvalidTransition = errors.New("invalid state transition")
+
+// Event is an occurrence that advances the state of a swap.
+type Event uint8
+
+const (
+	// EventHtlcPublished occurs when the client publishes the htlc of a
+	// loop in, or learns that an external wallet will publish it.
+	EventHtlcPublished Event = iota
+
+	// EventInvoiceSettled occurs when the server pays the swap invoice of
+	// a loop in.
+	EventInvoiceSettled
+
+	// EventPreimageRevealed occurs when the client first attempts to
+	// publish the sweep of a loop out's htlc, revealing its preimage.
+	EventPreimageRevealed
+
+	// EventOffchainFailed occurs when one of the off-chain payments of a
+	// loop out fails.
+	EventOffchainFailed
+
+	// EventExpired occurs when it is too late to continue a swap: the
+	// preimage of a loop out can no longer be revealed safely, or the
+	// htlc of a loop in can no longer be published safely.
+	EventExpired
+
+	// EventHtlcValueMismatch occurs when the confirmed htlc of a swap
+	// does not hold the amount of the swap.
+	EventHtlcValueMismatch
+
+	// EventHtlcSuccess occurs when the htlc of a swap is spent through
+	// its success path.
+	EventHtlcSuccess
+
+	// EventHtlcTimeout occurs when the htlc of a swap is spent through
+	// its timeout path.
+	EventHtlcTimeout
+
+	// EventTemporaryFailure occurs when a swap cannot progress because of
+	// an internal error. It may occur in any state, because the swap's
+	// last state may not have been persisted when the error occurred.
+	EventTemporaryFailure
+)
+
+// String returns a string representation of the event.
+func (e Event) String() string {
+	switch e {
+	case EventHtlcPublished:
+		return "HtlcPublished"
+
+	case EventInvoiceSettled:
+		return "InvoiceSettled"
+
+	case EventPreimageRevealed:
+		return "PreimageRevealed"
+
+	case EventOffchainFailed:
+		return "OffchainFailed"
+
+	case EventExpired:
+		return "Expired"
+
+	case EventHtlcValueMismatch:
+		return "HtlcValueMismatch"
+
+	case EventHtlcSuccess:
+		return "HtlcSuccess"
+
+	case EventHtlcTimeout:
+		return "HtlcTimeout"
+
+	case EventTemporaryFailure:
+		return "TemporaryFailure"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// Events returns all of the events that may occur in a swap.
+func Events() []Event {
+	return []Event{
+		EventHtlcPublished, EventInvoiceSettled, EventPreimageRevealed,
+		EventOffchainFailed, EventExpired, EventHtlcValueMismatch,
+		EventHtlcSuccess, EventHtlcTimeout, EventTemporaryFailure,
+	}
+}
+
+// Transitions maps each state of a machine to the states that the events
+// which may occur in it lead to.
+type Transitions map[loopdb.SwapState]map[Event]loopdb.SwapState
+
+// Machine is a swap state machine.
+type Machine struct {
+	name        string
+	transitions Transitions
+}
+
+// NewMachine creates a state machine with the transitions provided. Temporary
+// failures are added to every state of the machine.
+func NewMachine(name string, transitions Transitions) *Machine {
+	machine := &Machine{
+		name:        name,
+		transitions: make(Transitions, len(transitions)),
+	}
+
+	for state, events := range transitions {
+		machine.transitions[state] = make(
+			map[Event]loopdb.SwapState, len(events)+1,
+		)
+
+		for event, next := range events {
+			machine.transitions[state][event] = next
+		}
+
+		machine.transitions[state][EventTemporaryFailure] =
+			loopdb.StateFailTemporary
+	}
+
+	return machine
+}
+
+// String returns the name of the machine.
+func (m *Machine) String() string {
+	return m.name
+}
+
+// States returns the states that the machine has transitions for.
+func (m *Machine) States() []loopdb.SwapState {
+	states := make([]loopdb.SwapState, 0, len(m.transitions))
+	for state := range m.transitions {
+		states = append(states, state)
+	}
+
+	return states
+}
+
+// Step returns the state that the event provided leads to from the state
+// provided. ErrInvalidTransition is returned if the event is not expected in
+// the state.
+func (m *Machine) Step(state loopdb.SwapState,
+	event Event) (loopdb.SwapState, error) {
+
+	next, ok := m.transitions[state][event]
+	if !ok {
+		return state, fmt.Errorf("%w: %v swap in state %v: %v",
+			ErrInvalidTransition, m.name, state, event)
+	}
+
+	return next, nil
+}
+
+// pendingLoopOut holds the transitions of a loop out that has not yet
+// revealed its preimage.
+var pendingLoopOut = map[Event]loopdb.SwapState{
+	EventPreimageRevealed:  loopdb.StatePreimageRevealed,
+	EventOffchainFailed:    loopdb.StateFailOffchainPayments,
+	EventExpired:           loopdb.StateFailTimeout,
+	EventHtlcValueMismatch: loopdb.StateFailInsufficientValue,
+	EventHtlcSuccess:       loopdb.StateSuccess,
+	EventHtlcTimeout:       loopdb.StateFailSweepTimeout,
+}
+
+// LoopOut is the state machine of loop out swaps. A loop out that resumes
+// from a temporary failure is treated like one that has not yet revealed its
+// preimage.
+var LoopOut = NewMachine("loop out", Transitions{
+	loopdb.StateInitiated: pendingLoopOut,
+	loopdb.StatePreimageRevealed: {
+		EventPreimageRevealed: loopdb.StatePreimageRevealed,
+		EventHtlcSuccess:      loopdb.StateSuccess,
+		EventHtlcTimeout:      loopdb.StateFailSweepTimeout,
+	},
+	loopdb.StateFailTemporary:         pendingLoopOut,
+	loopdb.StateSuccess:               nil,
+	loopdb.StateFailOffchainPayments:  nil,
+	loopdb.StateFailTimeout:           nil,
+	loopdb.StateFailSweepTimeout:      nil,
+	loopdb.StateFailInsufficientValue: nil,
+})
+
+// publishedLoopIn holds the transitions of a loop in whose htlc has been
+// published.
+var publishedLoopIn = map[Event]loopdb.SwapState{
+	EventInvoiceSettled:    loopdb.StateInvoiceSettled,
+	EventHtlcValueMismatch: loopdb.StateFailIncorrectHtlcAmt,
+	EventHtlcSuccess:       loopdb.StateSuccess,
+	EventHtlcTimeout:       loopdb.StateFailTimeout,
+}
+
+// LoopIn is the state machine of loop in swaps. A loop in that resumes from a
+// temporary failure is treated like one whose htlc has been published.
+var LoopIn = NewMachine("loop in", Transitions{
+	loopdb.StateInitiated: {
+		EventHtlcPublished: loopdb.StateHtlcPublished,
+		EventExpired:       loopdb.StateFailTimeout,
+	},
+	loopdb.StateHtlcPublished: publishedLoopIn,
+	loopdb.StateInvoiceSettled: {
+		EventHtlcValueMismatch: loopdb.StateFailIncorrectHtlcAmt,
+		EventHtlcSuccess:       loopdb.StateSuccess,
+		EventHtlcTimeout:       loopdb.StateFailTimeout,
+	},
+	loopdb.StateFailTemporary:        publishedLoopIn,
+	loopdb.StateSuccess:              nil,
+	loopdb.StateFailTimeout:          nil,
+	loopdb.StateFailIncorrectHtlcAmt: nil,
+})
+
+// Actions performs the side effects of a swap's transitions on chain, with
+// our lnd node and with the server. Swaps are provided with an implementation
+// of Actions, so that their transitions can be driven without a chain backend
+// or server.
+type Actions interface {
+	// SendOutputs funds and publishes a transaction that pays to the
+	// outputs provided from our wallet, such as the htlc of a loop in.
+	SendOutputs(ctx context.Context, outputs []*wire.TxOut,
+		feeRate chainfee.SatPerKWeight, label string) (*wire.MsgTx,
+		error)
+
+	// PublishTx publishes a transaction that spends the htlc of a swap,
+	// such as the sweep of a loop out or the timeout tx of a loop in.
+	PublishTx(ctx context.Context, tx *wire.MsgTx, label string) error
+
+	// CancelInvoice cancels the swap invoice of a loop in once it has
+	// reclaimed its htlc through the timeout path.
+	CancelInvoice(ctx context.Context, hash lntypes.Hash) error
+
+	// PushPreimage reveals the preimage of a loop out to the server once
+	// it has been revealed on chain, so that the server can settle our
+	// swap payment without waiting for our sweep to confirm.
+	PushPreimage(ctx context.Context, preimage lntypes.Preimage) error
+}
@@ -0,0 +1,150 @@
+package swapfsm
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStep tests stepping the loop out and loop in machines.
+func TestStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		machine  *Machine
+		state    loopdb.SwapState
+		event    Event
+		expected loopdb.SwapState
+		err      error
+	}{
+		{
+			name:     "loop out reveals preimage",
+			machine:  LoopOut,
+			state:    loopdb.StateInitiated,
+			event:    EventPreimageRevealed,
+			expected: loopdb.StatePreimageRevealed,
+		},
+		{
+			name:     "loop out swept",
+			machine:  LoopOut,
+			state:    loopdb.StatePreimageRevealed,
+			event:    EventHtlcSuccess,
+			expected: loopdb.StateSuccess,
+		},
+		{
+			name:     "loop out payment fails after reveal",
+			machine:  LoopOut,
+			state:    loopdb.StatePreimageRevealed,
+			event:    EventOffchainFailed,
+			expected: loopdb.StatePreimageRevealed,
+			err:      ErrInvalidTransition,
+		},
+		{
+			name:     "loop out resumed after temporary failure",
+			machine:  LoopOut,
+			state:    loopdb.StateFailTemporary,
+			event:    EventExpired,
+			expected: loopdb.StateFailTimeout,
+		},
+		{
+			name:     "loop in published",
+			machine:  LoopIn,
+			state:    loopdb.StateInitiated,
+			event:    EventHtlcPublished,
+			expected: loopdb.StateHtlcPublished,
+		},
+		{
+			name:     "loop in timed out",
+			machine:  LoopIn,
+			state:    loopdb.StateInvoiceSettled,
+			event:    EventHtlcTimeout,
+			expected: loopdb.StateFailTimeout,
+		},
+		{
+			name:     "loop in does not reveal preimages",
+			machine:  LoopIn,
+			state:    loopdb.StateHtlcPublished,
+			event:    EventPreimageRevealed,
+			expected: loopdb.StateHtlcPublished,
+			err:      ErrInvalidTransition,
+		},
+		{
+			name:     "loop in succeeded",
+			machine:  LoopIn,
+			state:    loopdb.StateSuccess,
+			event:    EventHtlcTimeout,
+			expected: loopdb.StateSuccess,
+			err:      ErrInvalidTransition,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			state, err := test.machine.Step(test.state, test.event)
+			require.True(t, errors.Is(err, test.err))
+			require.Equal(t, test.expected, state)
+		})
+	}
+}
+
+// TestMachineProperties tests properties that hold for every transition of
+// our machines: final states can only be left through temporary failures, and
+// random walks from the initial state only visit the machine's states.
+func TestMachineProperties(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, machine := range []*Machine{LoopOut, LoopIn} {
+		states := make(map[loopdb.SwapState]bool)
+		for _, state := range machine.States() {
+			states[state] = true
+		}
+
+		for state := range states {
+			for _, event := range Events() {
+				next, err := machine.Step(state, event)
+				if err != nil {
+					require.Equal(t, state, next)
+					continue
+				}
+
+				require.True(t, states[next], "%v: %v -> %v",
+					machine, state, next)
+
+				if event == EventTemporaryFailure {
+					require.Equal(
+						t, loopdb.StateFailTemporary,
+						next,
+					)
+					continue
+				}
+
+				require.Equal(
+					t, loopdb.StateTypePending,
+					state.Type(), "%v: %v left final "+
+						"state %v", machine, event,
+					state,
+				)
+			}
+		}
+
+		for i := 0; i < 100; i++ {
+			state := loopdb.StateInitiated
+
+			for j := 0; j < 10; j++ {
+				events := Events()
+				event := events[rng.Intn(len(events))]
+
+				next, err := machine.Step(state, event)
+				if err == nil {
+					state = next
+				}
+
+				require.True(t, states[state])
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package sweep
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ErrUneconomicalOutput is returned when the output of a sweep would be worth
+// less than our economical threshold at the fee rate that the sweep pays.
+type ErrUneconomicalOutput struct {
+	// Value is the value of the sweep's output.
+	Value btcutil.Amount
+
+	// Threshold is the smallest output value that we create at the
+	// sweep's fee rate.
+	Threshold btcutil.Amount
+
+	// FeeRate is the fee rate that the sweep pays.
+	FeeRate chainfee.SatPerKWeight
+}
+
+// Error returns an error string for an uneconomical output.
+func (e *ErrUneconomicalOutput) Error() string {
+	return fmt.Sprintf("sweep output of %v is below the economical "+
+		"threshold of %v at %v sat/vbyte, wait for lower fees or "+
+		"batch the sweep with other spends", e.Value, e.Threshold,
+		int64(e.FeeRate.FeePerKVByte()/1000))
+}
+
+// dustLimit returns the smallest value that an output to the address
+// provided may have to be relayed by default bitcoin core nodes, and the
+// weight of the input that later spends it.
+func dustLimit(addr btcutil.Address) (btcutil.Amount, int, error) {
+	var estimate input.TxWeightEstimator
+	baseWeight := estimate.Weight()
+
+	var dust btcutil.Amount
+	switch addr.(type) {
+	case *btcutil.AddressWitnessScriptHash:
+		// We don't know the witness script of the output, so we use
+		// the smallest witness input as a lower bound.
+		estimate.AddWitnessInput(input.P2WKHWitnessSize)
+		dust = 330
+
+	case *btcutil.AddressWitnessPubKeyHash:
+		estimate.AddP2WKHInput()
+		dust = 294
+
+	case *btcutil.AddressScriptHash:
+		// We assume that script hash outputs are nested p2wkh, which
+		// is the only script hash type that lnd's wallet creates.
+		estimate.AddNestedP2WKHInput()
+		dust = 540
+
+	case *btcutil.AddressPubKeyHash:
+		estimate.AddP2PKHInput()
+		dust = 546
+
+	default:
+		return 0, 0, fmt.Errorf("unknown address type %T", addr)
+	}
+
+	return dust, estimate.Weight() - baseWeight, nil
+}
+
+// CheckOutput returns an *ErrUneconomicalOutput if the output of the sweep tx
+// provided, which pays the fee provided, is below our economical threshold.
+// An output is uneconomical if it is dust, below our configured minimum
+// output value, or would cost more than it is worth to spend at the sweep's
+// fee rate.
+func (s *Sweeper) CheckOutput(tx *wire.MsgTx, fee btcutil.Amount,
+	destAddr btcutil.Address) error {
+
+	if len(tx.TxOut) != 1 {
+		return fmt.Errorf("expected one sweep output, got %v",
+			len(tx.TxOut))
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	feeRate := chainfee.SatPerKWeight(int64(fee) * 1000 / weight)
+
+	threshold, spendWeight, err := dustLimit(destAddr)
+	if err != nil {
+		return err
+	}
+
+	if s.MinOutputValue > threshold {
+		threshold = s.MinOutputValue
+	}
+
+	spendFee := feeRate.FeeForWeight(int64(spendWeight))
+	if spendFee > threshold {
+		threshold = spendFee
+	}
+
+	value := btcutil.Amount(tx.TxOut[0].Value)
+	if value < threshold {
+		return &ErrUneconomicalOutput{
+			Value:     value,
+			Threshold: threshold,
+			FeeRate:   feeRate,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckOutput tests that sweep outputs below our economical threshold are
+// refused.
+func TestCheckOutput(t *testing.T) {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	// sweepTx returns a sweep tx with the output value provided.
+	sweepTx := func(value btcutil.Amount) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{
+			Witness: wire.TxWitness{make([]byte, 72)},
+		})
+		tx.AddTxOut(&wire.TxOut{
+			PkScript: pkScript,
+			Value:    int64(value),
+		})
+
+		return tx
+	}
+
+	// Get the fee that pays 1000 sat/kw for our tx. The fee rate does not
+	// depend on the output value, because it does not change our weight.
+	weight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(sweepTx(0)),
+	)
+	fee := btcutil.Amount(weight)
+
+	tests := []struct {
+		name         string
+		minValue     btcutil.Amount
+		value        btcutil.Amount
+		fee          btcutil.Amount
+		uneconomical bool
+	}{
+		{
+			name:  "above threshold",
+			value: 10000,
+			fee:   fee,
+		},
+		{
+			name:         "dust",
+			value:        293,
+			fee:          0,
+			uneconomical: true,
+		},
+		{
+			name:         "below minimum value",
+			minValue:     10000,
+			value:        9999,
+			fee:          fee,
+			uneconomical: true,
+		},
+		{
+			// At 4000 sat/kw, spending our p2wkh output costs more
+			// than it is worth.
+			name:         "costs more than worth to spend",
+			value:        300,
+			fee:          fee * 4,
+			uneconomical: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			sweeper := &Sweeper{
+				MinOutputValue: testCase.minValue,
+			}
+
+			err := sweeper.CheckOutput(
+				sweepTx(testCase.value), testCase.fee, addr,
+			)
+			if !testCase.uneconomical {
+				require.NoError(t, err)
+				return
+			}
+
+			require.IsType(t, &ErrUneconomicalOutput{}, err)
+		})
+	}
+}
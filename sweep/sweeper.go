@@ -2,21 +2,71 @@ package sweep
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"math/big"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/htlcweight"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/tracing"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
+// maxLockTimeDelta is the maximum number of blocks that the lock time of a
+// sweep is set in the past by when we match wallet behaviour.
+const maxLockTimeDelta = 100
+
 // Sweeper creates htlc sweep txes.
 type Sweeper struct {
 	Lnd *lndclient.LndServices
+
+	// MatchWallet sets the nSequence and nLockTime values of our sweeps to
+	// those used by common wallets, rather than fixed values that identify
+	// loop sweeps.
+	MatchWallet bool
+
+	// MinOutputValue is the smallest output value that our sweeps may
+	// create, in addition to the dust limit and the value required to
+	// economically spend the output at the sweep's fee rate.
+	MinOutputValue btcutil.Amount
+}
+
+// LockTime returns the lock time to use for a sweep at the height provided. If
+// we match wallet behaviour, we follow bitcoin core's anti fee sniping and set
+// the lock time up to 100 blocks in the past for one in ten sweeps. This must
+// not be used for transactions that require a minimum lock time.
+func (s *Sweeper) LockTime(height int32) (int32, error) {
+	if !s.MatchWallet {
+		return height, nil
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(10))
+	if err != nil {
+		return 0, err
+	}
+
+	if n.Int64() != 0 {
+		return height, nil
+	}
+
+	delta, err := rand.Int(rand.Reader, big.NewInt(maxLockTimeDelta))
+	if err != nil {
+		return 0, err
+	}
+
+	lockTime := height - int32(delta.Int64())
+	if lockTime < 0 {
+		return 0, nil
+	}
+
+	return lockTime, nil
 }
 
 // CreateSweepTx creates an htlc sweep tx.
@@ -28,11 +78,21 @@ func (s *Sweeper) CreateSweepTx(
 	amount, fee btcutil.Amount,
 	destAddr btcutil.Address) (*wire.MsgTx, error) {
 
+	globalCtx, span := tracing.Start(globalCtx, "create sweep tx")
+	defer span.End()
+
 	// Compose tx.
 	sweepTx := wire.NewMsgTx(2)
 
 	sweepTx.LockTime = uint32(height)
 
+	// A sequence of zero is rarely used by wallets, so we signal
+	// replaceability like most wallets do if our htlc does not require a
+	// relative lock time. This sequence still enables our lock time.
+	if s.MatchWallet && sequence == 0 {
+		sequence = wire.MaxTxInSequenceNum - 2
+	}
+
 	// Add HTLC input.
 	sweepTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: htlcOutpoint,
@@ -74,6 +134,7 @@ func (s *Sweeper) CreateSweepTx(
 		globalCtx, sweepTx, []*lndclient.SignDescriptor{&signDesc},
 	)
 	if err != nil {
+		span.RecordError(err)
 		return nil, fmt.Errorf("signing: %v", err)
 	}
 	sig := rawSigs[0]
@@ -95,29 +156,40 @@ func (s *Sweeper) GetSweepFee(ctx context.Context,
 	destAddr btcutil.Address, sweepConfTarget int32) (
 	btcutil.Amount, error) {
 
+	fee, _, err := s.EstimateSweepFee(
+		ctx, addInputEstimate, destAddr, sweepConfTarget,
+	)
+
+	return fee, err
+}
+
+// EstimateSweepFee calculates the required tx fee to spend to the destination
+// address provided, along with the fee rate that lnd estimated for the
+// confirmation target provided. It takes a function that is expected to add
+// the weight of the input to the weight estimator.
+func (s *Sweeper) EstimateSweepFee(ctx context.Context,
+	addInputEstimate func(*input.TxWeightEstimator),
+	destAddr btcutil.Address, sweepConfTarget int32) (
+	btcutil.Amount, chainfee.SatPerKWeight, error) {
+
 	// Get fee estimate from lnd.
+	ctx, span := tracing.Start(ctx, "estimate sweep fee")
 	feeRate, err := s.Lnd.WalletKit.EstimateFee(ctx, sweepConfTarget)
+	span.RecordError(err)
+	span.End()
+
 	if err != nil {
-		return 0, fmt.Errorf("estimate fee: %v", err)
+		return 0, 0, fmt.Errorf("estimate fee: %v", err)
 	}
 
 	// Calculate weight for this tx.
 	var weightEstimate input.TxWeightEstimator
-	switch destAddr.(type) {
-	case *btcutil.AddressWitnessScriptHash:
-		weightEstimate.AddP2WSHOutput()
-	case *btcutil.AddressWitnessPubKeyHash:
-		weightEstimate.AddP2WKHOutput()
-	case *btcutil.AddressScriptHash:
-		weightEstimate.AddP2SHOutput()
-	case *btcutil.AddressPubKeyHash:
-		weightEstimate.AddP2PKHOutput()
-	default:
-		return 0, fmt.Errorf("unknown address type %T", destAddr)
+	if err := htlcweight.AddOutput(&weightEstimate, destAddr); err != nil {
+		return 0, 0, err
 	}
 
 	addInputEstimate(&weightEstimate)
 	weight := weightEstimate.Weight()
 
-	return feeRate.FeeForWeight(int64(weight)), nil
+	return feeRate.FeeForWeight(int64(weight)), feeRate, nil
 }
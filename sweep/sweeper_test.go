@@ -0,0 +1,30 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLockTime tests that sweep lock times are only set in the past when we
+// match wallet behaviour, and never by more than our maximum delta.
+func TestLockTime(t *testing.T) {
+	sweeper := &Sweeper{}
+
+	lockTime, err := sweeper.LockTime(1000)
+	require.NoError(t, err)
+	require.Equal(t, int32(1000), lockTime)
+
+	sweeper.MatchWallet = true
+	for i := 0; i < 100; i++ {
+		lockTime, err := sweeper.LockTime(1000)
+		require.NoError(t, err)
+		require.True(t, lockTime <= 1000)
+		require.True(t, lockTime > 1000-maxLockTimeDelta)
+
+		// Our lock time should never be negative.
+		lockTime, err = sweeper.LockTime(1)
+		require.NoError(t, err)
+		require.True(t, lockTime >= 0)
+	}
+}
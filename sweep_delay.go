@@ -0,0 +1,69 @@
+package loop
+
+import (
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// newSweepDelay returns a function that picks a random delay of up to the
+// maximum provided, or nil if the maximum is not positive.
+func newSweepDelay(max time.Duration) func() (time.Duration, error) {
+	if max <= 0 {
+		return nil
+	}
+
+	return func() (time.Duration, error) {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(max)+1))
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(n.Int64()), nil
+	}
+}
+
+// canDelaySweep returns a boolean that indicates whether we still have enough
+// time left to delay our first sweep. Once our preimage is revealed, or we get
+// within the number of blocks where we start to use the default sweep
+// confirmation target of the point where we can no longer safely reveal our
+// preimage, we sweep without delay.
+func (s *loopOutSwap) canDelaySweep() bool {
+	if s.sweepDelay == nil || s.state == loopdb.StatePreimageRevealed {
+		return false
+	}
+
+	blocksToLastReveal := s.CltvExpiry - s.height -
+		MinLoopOutPreimageRevealDelta
+
+	return blocksToLastReveal > DefaultSweepConfTargetDelta
+}
+
+// initialSweepDelay returns the random amount of time that we wait before we
+// first try to sweep our htlc, so that the time that our preimage is revealed
+// on chain does not trivially correlate with the time that our htlc was
+// confirmed or our off chain payment settled.
+func (s *loopOutSwap) initialSweepDelay() (time.Duration, error) {
+	if !s.canDelaySweep() {
+		return 0, nil
+	}
+
+	return s.sweepDelay()
+}
+
+// remainingSweepDelay returns the amount of time left until the time provided
+// at which we planned to sweep, or zero if we can no longer delay our sweep.
+func (s *loopOutSwap) remainingSweepDelay(sweepTime time.Time) time.Duration {
+	if !s.canDelaySweep() {
+		return 0
+	}
+
+	remaining := time.Until(sweepTime)
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
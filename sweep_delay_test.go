@@ -0,0 +1,78 @@
+package loop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSweepDelay tests that our first sweep is only delayed while our preimage
+// is not revealed and we are not close to the swap's expiry.
+func TestSweepDelay(t *testing.T) {
+	require.Nil(t, newSweepDelay(0))
+
+	delay := newSweepDelay(time.Second)
+	for i := 0; i < 10; i++ {
+		d, err := delay()
+		require.NoError(t, err)
+		require.True(t, d >= 0 && d <= time.Second)
+	}
+
+	// The furthest height from our expiry at which we no longer delay our
+	// sweep.
+	lastHeight := MinLoopOutPreimageRevealDelta + DefaultSweepConfTargetDelta
+
+	tests := []struct {
+		name     string
+		state    loopdb.SwapState
+		height   int32
+		expected time.Duration
+	}{
+		{
+			name:     "delay applied",
+			state:    loopdb.StateInitiated,
+			height:   lastHeight + 1,
+			expected: time.Minute,
+		},
+		{
+			name:   "preimage revealed",
+			state:  loopdb.StatePreimageRevealed,
+			height: lastHeight + 1,
+		},
+		{
+			name:   "close to expiry",
+			state:  loopdb.StateInitiated,
+			height: lastHeight,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &loopOutSwap{
+				executeConfig: executeConfig{
+					sweepDelay: func() (time.Duration,
+						error) {
+
+						return time.Minute, nil
+					},
+				},
+			}
+			s.CltvExpiry = testCase.height * 2
+			s.height = testCase.height
+			s.state = testCase.state
+
+			delay, err := s.initialSweepDelay()
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, delay)
+
+			remaining := s.remainingSweepDelay(
+				time.Now().Add(time.Hour),
+			)
+			require.Equal(t, testCase.expected != 0, remaining > 0)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ErrSweepNotReady is returned when a sweep is previewed for a swap that is
+// not waiting to sweep its htlc, either because the htlc has not confirmed
+// yet or because the swap is not a pending loop out.
+var ErrSweepNotReady = errors.New("swap is not waiting to sweep its htlc")
+
+// SweepPreview is the transaction that a loop out would sweep its htlc with if
+// it swept now.
+type SweepPreview struct {
+	// Tx is the sweep transaction, with its witnesses removed so that
+	// the preview does not reveal our preimage. It is nil if we would not
+	// create a sweep at all, because the fee required exceeds our maximum
+	// miner fee before our preimage is revealed.
+	Tx *wire.MsgTx
+
+	// HtlcValue is the value of the htlc that the transaction spends.
+	HtlcValue btcutil.Amount
+
+	// Fee is the fee that the transaction pays.
+	Fee btcutil.Amount
+
+	// RequiredFee is the fee that is required to confirm the transaction
+	// within our confirmation target, which may exceed our maximum miner
+	// fee.
+	RequiredFee btcutil.Amount
+
+	// Weight is the weight of the signed transaction.
+	Weight int64
+
+	// FeeRate is the fee rate of the signed transaction.
+	FeeRate chainfee.SatPerKWeight
+
+	// ConfTarget is the confirmation target that the fee was estimated
+	// for.
+	ConfTarget int32
+
+	// Publish is true if the swap would publish the transaction.
+	Publish bool
+
+	// Reason explains why the swap would not publish the transaction.
+	Reason string
+}
+
+// previewRequest is a request for a preview of the sweep of a swap.
+type previewRequest struct {
+	ctx      context.Context
+	response chan previewResponse
+}
+
+// previewResponse is the sweep preview that a swap responds with.
+type previewResponse struct {
+	preview *SweepPreview
+	err     error
+}
+
+// sweepPreviewer receives the preview requests of a single swap.
+type sweepPreviewer struct {
+	requests chan *previewRequest
+
+	// done is closed once the swap no longer receives requests.
+	done chan struct{}
+}
+
+// sweepPreviews routes sweep preview requests to the swaps that are waiting
+// to sweep their htlcs. Each swap computes its own preview, so that previews
+// are computed with exactly the state that the swap sweeps with.
+type sweepPreviews struct {
+	swaps map[lntypes.Hash]*sweepPreviewer
+	sync.Mutex
+}
+
+// newSweepPreviews creates an empty set of sweep previewers.
+func newSweepPreviews() *sweepPreviews {
+	return &sweepPreviews{
+		swaps: make(map[lntypes.Hash]*sweepPreviewer),
+	}
+}
+
+// register returns the channel that the swap with the hash provided receives
+// preview requests on until the function returned is called. It is safe to
+// call on a nil set of previewers, in which case the channel returned never
+// receives requests.
+func (p *sweepPreviews) register(hash lntypes.Hash) (<-chan *previewRequest,
+	func()) {
+
+	if p == nil {
+		return nil, func() {}
+	}
+
+	previewer := &sweepPreviewer{
+		requests: make(chan *previewRequest),
+		done:     make(chan struct{}),
+	}
+
+	p.Lock()
+	p.swaps[hash] = previewer
+	p.Unlock()
+
+	unregister := func() {
+		p.Lock()
+		if p.swaps[hash] == previewer {
+			delete(p.swaps, hash)
+		}
+		p.Unlock()
+
+		close(previewer.done)
+	}
+
+	return previewer.requests, unregister
+}
+
+// preview requests a preview of the sweep of the swap with the hash provided.
+func (p *sweepPreviews) preview(ctx context.Context,
+	hash lntypes.Hash) (*SweepPreview, error) {
+
+	p.Lock()
+	previewer, ok := p.swaps[hash]
+	p.Unlock()
+
+	if !ok {
+		return nil, ErrSweepNotReady
+	}
+
+	request := &previewRequest{
+		ctx:      ctx,
+		response: make(chan previewResponse, 1),
+	}
+
+	select {
+	case previewer.requests <- request:
+
+	case <-previewer.done:
+		return nil, ErrSweepNotReady
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case response := <-request.response:
+		return response.preview, response.err
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// PreviewSweep returns the transaction that the pending loop out with the
+// hash provided would sweep its htlc with if it swept now. Nothing is
+// published, and the swap's state is left unchanged.
+func (s *Client) PreviewSweep(ctx context.Context,
+	hash lntypes.Hash) (*SweepPreview, error) {
+
+	return s.sweepPreviews.preview(ctx, hash)
+}
+
+// previewSweep creates the sweep that we would publish for our htlc at the
+// current height and fee rate, and reports whether we would publish it.
+func (s *loopOutSwap) previewSweep(ctx context.Context,
+	htlcOutpoint wire.OutPoint, htlcValue btcutil.Amount) (*SweepPreview,
+	error) {
+
+	attempt, err := s.createSweep(ctx, htlcOutpoint, htlcValue)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &SweepPreview{
+		HtlcValue:   htlcValue,
+		Fee:         attempt.fee,
+		RequiredFee: attempt.requiredFee,
+		ConfTarget:  attempt.confTarget,
+		Publish:     true,
+	}
+
+	preimageRevealed := s.state == loopdb.StatePreimageRevealed
+	blocksToLastReveal := s.CltvExpiry - s.height -
+		MinLoopOutPreimageRevealDelta
+
+	switch {
+	case blocksToLastReveal <= 0 && !preimageRevealed:
+		preview.Publish = false
+		preview.Reason = fmt.Sprintf("preimage can no longer be "+
+			"safely revealed, htlc expires at height %v",
+			s.CltvExpiry)
+
+	case attempt.tx == nil:
+		preview.Publish = false
+		preview.Reason = fmt.Sprintf("required fee %v exceeds max "+
+			"miner fee %v", attempt.requiredFee, s.MaxMinerFee)
+
+	case attempt.uneconomical != nil:
+		preview.Publish = false
+		preview.Reason = attempt.uneconomical.Error()
+	}
+
+	if attempt.tx == nil {
+		return preview, nil
+	}
+
+	preview.Weight = blockchain.GetTransactionWeight(
+		btcutil.NewTx(attempt.tx),
+	)
+	preview.FeeRate = chainfee.SatPerKWeight(
+		int64(attempt.fee) * 1000 / preview.Weight,
+	)
+
+	// Our success witness holds our preimage, which we don't reveal
+	// before we publish our sweep.
+	preview.Tx = attempt.tx.Copy()
+	for _, txIn := range preview.Tx.TxIn {
+		txIn.Witness = nil
+	}
+
+	return preview, nil
+}
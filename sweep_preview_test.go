@@ -0,0 +1,49 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSweepPreviews tests routing sweep preview requests to the swaps that
+// are waiting to sweep.
+func TestSweepPreviews(t *testing.T) {
+	ctx := context.Background()
+	previews := newSweepPreviews()
+	hash := lntypes.Hash{1}
+
+	// Swaps that are not waiting to sweep can't be previewed.
+	_, err := previews.preview(ctx, hash)
+	require.Equal(t, ErrSweepNotReady, err)
+
+	requests, unregister := previews.register(hash)
+
+	expected := &SweepPreview{
+		Fee:     100,
+		Publish: true,
+	}
+
+	go func() {
+		request := <-requests
+		request.response <- previewResponse{preview: expected}
+	}()
+
+	preview, err := previews.preview(ctx, hash)
+	require.NoError(t, err)
+	require.Equal(t, expected, preview)
+
+	// Once the swap stops waiting, its sweep can no longer be previewed.
+	unregister()
+
+	_, err = previews.preview(ctx, hash)
+	require.Equal(t, ErrSweepNotReady, err)
+
+	// Registering on a nil set of previewers is a no-op.
+	var nilPreviews *sweepPreviews
+	requests, unregister = nilPreviews.register(hash)
+	require.Nil(t, requests)
+	unregister()
+}
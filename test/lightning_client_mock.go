@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"golang.org/x/net/context"
 )
@@ -59,6 +60,14 @@ func (h *mockLightningClient) ConfirmedWalletBalance(ctx context.Context) (
 	return 1000000, nil
 }
 
+// WalletBalance returns the mock's wallet balance.
+func (h *mockLightningClient) WalletBalance(_ context.Context) (
+	*lndclient.WalletBalance, error) {
+
+	balance := h.lnd.WalletBalance
+	return &balance, nil
+}
+
 func (h *mockLightningClient) GetInfo(ctx context.Context) (*lndclient.Info,
 	error) {
 
@@ -71,6 +80,7 @@ func (h *mockLightningClient) GetInfo(ctx context.Context) (*lndclient.Info,
 	return &lndclient.Info{
 		BlockHeight:    600,
 		IdentityPubkey: pubKey,
+		Network:        h.lnd.Network,
 		Uris:           []string{h.lnd.NodePubkey + "@127.0.0.1:9735"},
 	}, nil
 }
@@ -194,6 +204,14 @@ func (h *mockLightningClient) ClosedChannels(_ context.Context) ([]lndclient.Clo
 	return h.lnd.ClosedChannels, nil
 }
 
+// PendingChannels returns the mock's set of pending channels.
+func (h *mockLightningClient) PendingChannels(_ context.Context) (
+	*lndclient.PendingChannels, error) {
+
+	pending := h.lnd.PendingChannels
+	return &pending, nil
+}
+
 // ForwardingHistory returns the mock's set of forwarding events.
 func (h *mockLightningClient) ForwardingHistory(_ context.Context,
 	_ lndclient.ForwardingHistoryRequest) (*lndclient.ForwardingHistoryResponse,
@@ -230,6 +248,18 @@ func (h *mockLightningClient) ListPayments(_ context.Context,
 	}, nil
 }
 
+// GetNodeInfo returns the node info that our mock has for the pubkey provided.
+func (h *mockLightningClient) GetNodeInfo(_ context.Context,
+	pubkey route.Vertex, _ bool) (*lndclient.NodeInfo, error) {
+
+	info, ok := h.lnd.NodeInfos[pubkey]
+	if !ok {
+		return nil, fmt.Errorf("node: %v not found", pubkey)
+	}
+
+	return info, nil
+}
+
 // ChannelBackup retrieves the backup for a particular channel. The
 // backup is returned as an encrypted chanbackup.Single payload.
 func (h *mockLightningClient) ChannelBackup(context.Context, wire.OutPoint) ([]byte, error) {
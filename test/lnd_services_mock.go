@@ -12,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -66,6 +67,7 @@ func NewMockLnd() *LndMockServices {
 		epochChannel:       make(chan int32),
 		Height:             testStartingHeight,
 		NodePubkey:         testNodePubkey,
+		Network:            "testnet",
 		Signature:          testSignature,
 		SignatureMsg:       testSignatureMsg,
 		Invoices:           make(map[lntypes.Hash]*lndclient.Invoice),
@@ -156,6 +158,9 @@ type LndMockServices struct {
 	Signature    []byte
 	SignatureMsg string
 
+	// Network is the network that the node reports that it runs on.
+	Network string
+
 	Transactions []lndclient.Transaction
 	Sweeps       []string
 
@@ -165,9 +170,15 @@ type LndMockServices struct {
 
 	Channels         []lndclient.ChannelInfo
 	ClosedChannels   []lndclient.ClosedChannel
+	PendingChannels  lndclient.PendingChannels
+	WalletBalance    lndclient.WalletBalance
 	ForwardingEvents []lndclient.ForwardingEvent
 	Payments         []lndclient.Payment
 
+	// NodeInfos is a set of node info that the mock returns on node info
+	// lookups, keyed by node pubkey.
+	NodeInfos map[route.Vertex]*lndclient.NodeInfo
+
 	WaitForFinished func()
 
 	lock sync.Mutex
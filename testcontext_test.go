@@ -46,6 +46,7 @@ func newSwapClient(config *clientConfig) *Client {
 	}
 
 	lndServices := config.LndServices
+	subscriptions := newSwapSubscriptions()
 
 	executor := newExecutor(&executorConfig{
 		lnd:               lndServices,
@@ -53,6 +54,8 @@ func newSwapClient(config *clientConfig) *Client {
 		sweeper:           sweeper,
 		createExpiryTimer: config.CreateExpiryTimer,
 		cancelSwap:        config.Server.CancelLoopOutSwap,
+		subscriptions:     subscriptions,
+		server:            config.Server,
 	})
 
 	return &Client{
@@ -62,6 +65,11 @@ func newSwapClient(config *clientConfig) *Client {
 		sweeper:      sweeper,
 		executor:     executor,
 		resumeReady:  make(chan struct{}),
+		reservations: make(map[lntypes.Hash]*pendingReservation),
+
+		pendingVolume:      make(map[uint64]swapVolume),
+		pendingWalletFunds: make(map[uint64]btcutil.Amount),
+		subscriptions:      subscriptions,
 	}
 }
 
@@ -185,13 +193,22 @@ func (ctx *testContext) assertStoreFinished(expectedResult loopdb.SwapState) {
 }
 
 func (ctx *testContext) assertStatus(expectedState loopdb.SwapState) {
+	ctx.T.Helper()
+
+	ctx.assertSwapStatus(swap.TypeOut, expectedState)
+}
+
+// assertSwapStatus asserts that we receive a status update for a swap of the
+// type provided in the state provided, skipping any other updates.
+func (ctx *testContext) assertSwapStatus(swapType swap.Type,
+	expectedState loopdb.SwapState) {
 
 	ctx.T.Helper()
 
 	for {
 		select {
 		case update := <-ctx.statusChan:
-			if update.SwapType != swap.TypeOut {
+			if update.SwapType != swapType {
 				continue
 			}
 
@@ -205,11 +222,10 @@ func (ctx *testContext) assertStatus(expectedState loopdb.SwapState) {
 	}
 }
 
-func (ctx *testContext) publishHtlc(script []byte,
-	amt btcutil.Amount) wire.OutPoint {
-
-	// Create the htlc tx.
-	htlcTx := wire.MsgTx{}
+// newHtlcTx creates the htlc tx that publishHtlc publishes for the script and
+// amount provided.
+func newHtlcTx(script []byte, amt btcutil.Amount) *wire.MsgTx {
+	htlcTx := &wire.MsgTx{}
 	htlcTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: wire.OutPoint{},
 	})
@@ -218,12 +234,19 @@ func (ctx *testContext) publishHtlc(script []byte,
 		Value:    int64(amt),
 	})
 
+	return htlcTx
+}
+
+func (ctx *testContext) publishHtlc(script []byte,
+	amt btcutil.Amount) wire.OutPoint {
+
+	htlcTx := newHtlcTx(script, amt)
 	htlcTxHash := htlcTx.TxHash()
 
 	// Signal client that script has been published.
 	select {
 	case ctx.Lnd.ConfChannel <- &chainntnfs.TxConfirmation{
-		Tx: &htlcTx,
+		Tx: htlcTx,
 	}:
 	case <-time.After(test.Timeout):
 		ctx.T.Fatalf("htlc confirmed not consumed")
@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// Exporter is the interface implemented by backends that our completed spans
+// are sent to.
+type Exporter interface {
+	// ExportSpan exports a completed span. This call must not block.
+	ExportSpan(span *SpanData)
+}
+
+var (
+	// exporter is the exporter that completed spans are sent to. If it is
+	// nil, tracing is disabled.
+	exporter Exporter
+
+	exporterLock sync.RWMutex
+)
+
+// SetExporter sets the exporter that completed spans are sent to. Passing a
+// nil exporter disables tracing.
+func SetExporter(e Exporter) {
+	exporterLock.Lock()
+	defer exporterLock.Unlock()
+
+	exporter = e
+}
+
+// getExporter returns our current exporter.
+func getExporter() Exporter {
+	exporterLock.RLock()
+	defer exporterLock.RUnlock()
+
+	return exporter
+}
+
+// UnaryClientInterceptor is a grpc client interceptor that records a span for
+// each unary call, and propagates our trace to the server.
+func UnaryClientInterceptor(ctx context.Context, method string, req,
+	reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption) error {
+
+	ctx, span := Start(ctx, method)
+	defer span.End()
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	span.RecordError(err)
+
+	return err
+}
@@ -0,0 +1,298 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultFlushInterval is the default interval at which we push
+	// completed spans to our collector.
+	DefaultFlushInterval = time.Second * 5
+
+	// maxBufferedSpans is the maximum number of spans that we buffer while
+	// our collector is unreachable. Once this limit is reached, we drop
+	// new spans to bound our memory use.
+	maxBufferedSpans = 10000
+
+	// exportTimeout is the amount of time we allow for a single export to
+	// our collector.
+	exportTimeout = time.Second * 30
+
+	// statusCodeError is the otlp status code for a failed span.
+	statusCodeError = 2
+
+	// scopeName is the instrumentation scope that we report our spans
+	// under.
+	scopeName = "github.com/lightninglabs/loop"
+)
+
+// ErrNoEndpoint is returned when an otlp exporter is created without a
+// collector endpoint.
+var ErrNoEndpoint = errors.New("otlp endpoint required")
+
+// OTLPConfig contains the information required to export spans to an
+// OpenTelemetry collector.
+type OTLPConfig struct {
+	// Endpoint is the base URL of the collector's otlp/http receiver.
+	Endpoint string
+
+	// ServiceName is the service name that our spans are reported under.
+	ServiceName string
+
+	// FlushInterval is the interval at which we push completed spans to
+	// the collector.
+	FlushInterval time.Duration
+}
+
+// OTLPExporter buffers completed spans and periodically pushes them to an
+// OpenTelemetry collector using the otlp/http json encoding.
+type OTLPExporter struct {
+	cfg       *OTLPConfig
+	tracesURL string
+	client    *http.Client
+
+	// spans contains the completed spans that we have not yet exported.
+	spans []*SpanData
+
+	spansLock sync.Mutex
+}
+
+// A compile time assertion to ensure that OTLPExporter satisfies the Exporter
+// interface.
+var _ Exporter = (*OTLPExporter)(nil)
+
+// NewOTLPExporter creates an exporter that pushes spans to the collector
+// provided.
+func NewOTLPExporter(cfg *OTLPConfig) (*OTLPExporter, error) {
+	if cfg.Endpoint == "" {
+		return nil, ErrNoEndpoint
+	}
+
+	return &OTLPExporter{
+		cfg:       cfg,
+		tracesURL: strings.TrimSuffix(cfg.Endpoint, "/") + "/v1/traces",
+		client: &http.Client{
+			Timeout: exportTimeout,
+		},
+	}, nil
+}
+
+// ExportSpan adds a completed span to our buffer, to be exported on our next
+// flush.
+//
+// NOTE: This is part of the Exporter interface.
+func (o *OTLPExporter) ExportSpan(span *SpanData) {
+	o.spansLock.Lock()
+	defer o.spansLock.Unlock()
+
+	if len(o.spans) >= maxBufferedSpans {
+		log.Warnf("Span buffer full, dropping span: %v", span.Name)
+		return
+	}
+
+	o.spans = append(o.spans, span)
+}
+
+// Run periodically flushes our completed spans until the context provided is
+// cancelled.
+func (o *OTLPExporter) Run(ctx context.Context) error {
+	flushTicker := time.NewTicker(o.cfg.FlushInterval)
+	defer flushTicker.Stop()
+
+	for {
+		select {
+		case <-flushTicker.C:
+			if err := o.flush(ctx); err != nil {
+				log.Errorf("Span export failed: %v", err)
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// flush exports all of our buffered spans. Unlike metrics, spans are not
+// retried if the export fails, because a collector that is unavailable for a
+// long time would otherwise cause us to resend large batches.
+func (o *OTLPExporter) flush(ctx context.Context) error {
+	o.spansLock.Lock()
+	spans := o.spans
+	o.spans = nil
+	o.spansLock.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(o.newRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, o.tracesURL, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("otlp export failed: %v: %s", resp.Status,
+			msg)
+	}
+
+	log.Debugf("Exported %v spans", len(spans))
+
+	return nil
+}
+
+// The types below mirror the json encoding of the otlp
+// ExportTraceServiceRequest message.
+type (
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		ParentSpanID      string          `json:"parentSpanId,omitempty"`
+		Name              string          `json:"name"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes,omitempty"`
+		Status            *otlpStatus     `json:"status,omitempty"`
+	}
+
+	otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+
+	otlpValue struct {
+		StringValue string `json:"stringValue"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// newRequest creates an otlp export request for a set of spans.
+func (o *OTLPExporter) newRequest(spans []*SpanData) *otlpRequest {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, span := range spans {
+		otlpSpans[i] = newOTLPSpan(span)
+	}
+
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: newAttributes(
+						map[string]string{
+							"service.name": o.cfg.ServiceName,
+						},
+					),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{
+							Name: scopeName,
+						},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+// newOTLPSpan converts a span to its otlp representation.
+func newOTLPSpan(span *SpanData) otlpSpan {
+	otlp := otlpSpan{
+		TraceID: span.TraceID.String(),
+		SpanID:  span.SpanID.String(),
+		Name:    span.Name,
+		StartTimeUnixNano: strconv.FormatInt(
+			span.Start.UnixNano(), 10,
+		),
+		EndTimeUnixNano: strconv.FormatInt(span.End.UnixNano(), 10),
+		Attributes:      newAttributes(span.Attributes),
+	}
+
+	if span.ParentID != (SpanID{}) {
+		otlp.ParentSpanID = span.ParentID.String()
+	}
+
+	if span.Err != nil {
+		otlp.Status = &otlpStatus{
+			Code:    statusCodeError,
+			Message: span.Err.Error(),
+		}
+	}
+
+	return otlp
+}
+
+// newAttributes converts a set of attributes to their otlp representation,
+// sorted by key.
+func newAttributes(attributes map[string]string) []otlpAttribute {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	otlp := make([]otlpAttribute, len(keys))
+	for i, key := range keys {
+		otlp[i] = otlpAttribute{
+			Key: key,
+			Value: otlpValue{
+				StringValue: attributes[key],
+			},
+		}
+	}
+
+	return otlp
+}
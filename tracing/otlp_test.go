@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestOTLPExport tests export of spans to an otlp/http collector.
+func TestOTLPExport(t *testing.T) {
+	requests := make(chan *otlpRequest, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/v1/traces", r.URL.Path)
+
+			req := &otlpRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			requests <- req
+		},
+	))
+	defer server.Close()
+
+	exporter, err := NewOTLPExporter(&OTLPConfig{
+		Endpoint:    server.URL + "/",
+		ServiceName: "loopd",
+	})
+	require.NoError(t, err)
+
+	// An empty buffer should not result in any exports.
+	require.NoError(t, exporter.flush(context.Background()))
+	require.Len(t, requests, 0)
+
+	span := &SpanData{
+		TraceID:    TraceID{1},
+		SpanID:     SpanID{2},
+		ParentID:   SpanID{3},
+		Name:       "span",
+		Start:      time.Unix(0, 10),
+		End:        time.Unix(0, 20),
+		Attributes: map[string]string{"b": "2", "a": "1"},
+		Err:        errors.New("failed"),
+	}
+	exporter.ExportSpan(span)
+
+	require.NoError(t, exporter.flush(context.Background()))
+	require.Len(t, exporter.spans, 0)
+
+	expected := &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpAttribute{
+						{
+							Key: "service.name",
+							Value: otlpValue{
+								StringValue: "loopd",
+							},
+						},
+					},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{
+							Name: scopeName,
+						},
+						Spans: []otlpSpan{
+							newOTLPSpan(span),
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, <-requests)
+
+	otlp := newOTLPSpan(span)
+	require.Equal(t, "01000000000000000000000000000000", otlp.TraceID)
+	require.Equal(t, "0200000000000000", otlp.SpanID)
+	require.Equal(t, "0300000000000000", otlp.ParentSpanID)
+	require.Equal(t, "10", otlp.StartTimeUnixNano)
+	require.Equal(t, "20", otlp.EndTimeUnixNano)
+	require.Equal(t, "a", otlp.Attributes[0].Key)
+	require.Equal(t, &otlpStatus{
+		Code:    statusCodeError,
+		Message: "failed",
+	}, otlp.Status)
+}
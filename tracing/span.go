@@ -0,0 +1,246 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc/metadata"
+)
+
+// traceparentHeader is the W3C trace context header that we use to propagate
+// our trace to the servers that we call.
+const traceparentHeader = "traceparent"
+
+// TraceID uniquely identifies a trace.
+type TraceID [16]byte
+
+// String returns the hex encoding of a trace ID.
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// SpanID uniquely identifies a span within a trace.
+type SpanID [8]byte
+
+// String returns the hex encoding of a span ID.
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// SwapTraceID returns the trace ID that we use for all spans belonging to a
+// swap. The ID is derived from the swap hash so that a swap's spans are
+// grouped in a single trace, even if the swap is resumed after a restart.
+func SwapTraceID(hash lntypes.Hash) TraceID {
+	var id TraceID
+	copy(id[:], hash[:])
+
+	return id
+}
+
+// spanContext identifies the span that new spans should be children of.
+type spanContext struct {
+	traceID TraceID
+	spanID  SpanID
+}
+
+// spanContextKey is the context key that we store our current span context
+// under.
+type spanContextKey struct{}
+
+// WithTrace returns a context that places all spans started from it in the
+// trace provided. If tracing is disabled, the context is returned unchanged.
+func WithTrace(ctx context.Context, traceID TraceID) context.Context {
+	if getExporter() == nil {
+		return ctx
+	}
+
+	return withSpanContext(ctx, spanContext{traceID: traceID})
+}
+
+// withSpanContext stores a span context in a context, and adds a traceparent
+// header to its outgoing grpc metadata so that servers that we call can link
+// their spans to ours.
+func withSpanContext(ctx context.Context, sc spanContext) context.Context {
+	ctx = context.WithValue(ctx, spanContextKey{}, sc)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	// The traceparent header does not allow an all zero parent id, so we
+	// only propagate our trace once we have a span within it.
+	if sc.spanID == (SpanID{}) {
+		delete(md, traceparentHeader)
+	} else {
+		md.Set(traceparentHeader, fmt.Sprintf(
+			"00-%v-%v-01", sc.traceID, sc.spanID,
+		))
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// Span records the duration of a single operation.
+type Span struct {
+	exporter Exporter
+
+	// data contains the information recorded for the span. It is exported
+	// once the span ends.
+	data SpanData
+
+	ended bool
+
+	lock sync.Mutex
+}
+
+// SpanData contains the information recorded for a span.
+type SpanData struct {
+	// TraceID is the trace that the span belongs to.
+	TraceID TraceID
+
+	// SpanID identifies the span.
+	SpanID SpanID
+
+	// ParentID identifies the span's parent. It is zero for spans that do
+	// not have a parent.
+	ParentID SpanID
+
+	// Name describes the operation that the span records.
+	Name string
+
+	// Start is the time that the operation started.
+	Start time.Time
+
+	// End is the time that the operation completed.
+	End time.Time
+
+	// Attributes contains additional information about the operation.
+	Attributes map[string]string
+
+	// Err is the error that the operation failed with, if any.
+	Err error
+}
+
+// Start starts a span that is a child of the span stored in the context
+// provided, or the root of a new trace if the context has no span. If tracing
+// is disabled, a nil span is returned, which is safe to use.
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	exporter := getExporter()
+	if exporter == nil {
+		return ctx, nil
+	}
+
+	parent, ok := ctx.Value(spanContextKey{}).(spanContext)
+	if !ok {
+		parent.traceID = randomTraceID()
+	}
+
+	span := &Span{
+		exporter: exporter,
+		data: SpanData{
+			TraceID:    parent.traceID,
+			SpanID:     randomSpanID(),
+			ParentID:   parent.spanID,
+			Name:       name,
+			Start:      time.Now(),
+			Attributes: make(map[string]string),
+		},
+	}
+
+	return ContextWithSpan(ctx, span), span
+}
+
+// ContextWithSpan returns a context that places spans started from it as
+// children of the span provided. If the span is nil, the context is returned
+// unchanged.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+
+	return withSpanContext(ctx, spanContext{
+		traceID: span.data.TraceID,
+		spanID:  span.data.SpanID,
+	})
+}
+
+// SetAttribute adds an attribute to a span. Attributes set after the span
+// has ended are ignored.
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ended {
+		return
+	}
+
+	s.data.Attributes[key] = value
+}
+
+// RecordError marks a span as failed if the error provided is non-nil.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ended {
+		return
+	}
+
+	s.data.Err = err
+}
+
+// End completes a span and exports it. Calls after the first have no effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	if s.ended {
+		s.lock.Unlock()
+		return
+	}
+
+	s.ended = true
+	s.data.End = time.Now()
+	data := s.data
+	s.lock.Unlock()
+
+	s.exporter.ExportSpan(&data)
+}
+
+// randomTraceID returns a random trace ID.
+func randomTraceID() TraceID {
+	var id TraceID
+	if _, err := rand.Read(id[:]); err != nil {
+		log.Errorf("Could not generate trace id: %v", err)
+	}
+
+	return id
+}
+
+// randomSpanID returns a random span ID.
+func randomSpanID() SpanID {
+	var id SpanID
+	if _, err := rand.Read(id[:]); err != nil {
+		log.Errorf("Could not generate span id: %v", err)
+	}
+
+	return id
+}
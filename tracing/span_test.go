@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockExporter records the spans that are exported to it.
+type mockExporter struct {
+	spans []*SpanData
+	lock  sync.Mutex
+}
+
+// ExportSpan records a span.
+func (m *mockExporter) ExportSpan(span *SpanData) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.spans = append(m.spans, span)
+}
+
+// TestDisabled tests that we do not record spans when tracing is disabled.
+func TestDisabled(t *testing.T) {
+	SetExporter(nil)
+
+	ctx := context.Background()
+	spanCtx, span := Start(ctx, "span")
+	require.Nil(t, span)
+	require.Equal(t, ctx, spanCtx)
+
+	// Using a nil span should not panic.
+	span.SetAttribute("key", "value")
+	span.RecordError(errors.New("error"))
+	span.End()
+}
+
+// TestSpans tests creation of nested spans within a swap's trace, and
+// propagation of the trace in outgoing grpc metadata.
+func TestSpans(t *testing.T) {
+	exporter := &mockExporter{}
+	SetExporter(exporter)
+	defer SetExporter(nil)
+
+	hash := lntypes.Hash{1, 2, 3}
+	traceID := SwapTraceID(hash)
+
+	// Before we start a span, we should not propagate our trace.
+	ctx := WithTrace(context.Background(), traceID)
+	_, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	require.Len(t, md.Get(traceparentHeader), 0)
+
+	rootCtx, root := Start(ctx, "root")
+	root.SetAttribute("swap.hash", hash.String())
+
+	_, child := Start(rootCtx, "child")
+	childErr := errors.New("child failed")
+	child.RecordError(childErr)
+	child.End()
+
+	// Our root span's context should carry its traceparent.
+	md, _ = metadata.FromOutgoingContext(rootCtx)
+	require.Equal(t, []string{
+		fmt.Sprintf("00-%v-%v-01", traceID, root.data.SpanID),
+	}, md.Get(traceparentHeader))
+
+	root.End()
+
+	// Ending a span a second time should have no effect.
+	root.End()
+
+	require.Len(t, exporter.spans, 2)
+
+	childData := exporter.spans[0]
+	require.Equal(t, "child", childData.Name)
+	require.Equal(t, traceID, childData.TraceID)
+	require.Equal(t, root.data.SpanID, childData.ParentID)
+	require.Equal(t, childErr, childData.Err)
+
+	rootData := exporter.spans[1]
+	require.Equal(t, "root", rootData.Name)
+	require.Equal(t, traceID, rootData.TraceID)
+	require.Equal(t, SpanID{}, rootData.ParentID)
+	require.Equal(t, map[string]string{
+		"swap.hash": hash.String(),
+	}, rootData.Attributes)
+	require.False(t, rootData.End.Before(rootData.Start))
+}
@@ -7,6 +7,7 @@ package loop
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
@@ -36,6 +37,11 @@ const (
 	// defaultAgentName is the default name of the software that is added as
 	// the first part of the user agent string.
 	defaultAgentName = "loopd"
+
+	// maxAgentNameLength is the maximum length of an agent name, which
+	// leaves room for the version, commit and initiator in the user agent
+	// string.
+	maxAgentNameLength = 32
 )
 
 // AgentName stores the name of the software that is added as the first part of
@@ -52,9 +58,36 @@ func Version() string {
 	return fmt.Sprintf("%s commit=%s", semanticVersion(), Commit)
 }
 
+// ValidateAgentName checks that an agent name is not empty, only contains
+// characters from the semantic versioning alphabet and leaves room for the
+// rest of the user agent string.
+func ValidateAgentName(name string) error {
+	if name == "" {
+		return errors.New("agent name must be set")
+	}
+
+	if len(name) > maxAgentNameLength {
+		return fmt.Errorf("agent name may be at most %v characters",
+			maxAgentNameLength)
+	}
+
+	if normalizeVerString(name, semanticAlphabet) != name {
+		return fmt.Errorf("agent name may only contain characters "+
+			"from: %v", semanticAlphabet)
+	}
+
+	return nil
+}
+
 // UserAgent returns the full user agent string that identifies the software
 // that is submitting swaps to the loop server.
 func UserAgent(initiator string) string {
+	return userAgent(AgentName, initiator)
+}
+
+// userAgent returns the user agent string for the agent name and initiator
+// provided.
+func userAgent(agentName, initiator string) string {
 	// We'll only allow "safe" characters in the initiator portion of the
 	// user agent string and spaces only if surrounded by other characters.
 	initiatorAlphabet := semanticAlphabet + ". "
@@ -74,7 +107,7 @@ func UserAgent(initiator string) string {
 
 	// Assemble full string, including the commit hash of current build.
 	return fmt.Sprintf(
-		"%s/v%s/commit=%s%s", AgentName, semanticVersion(), Commit,
+		"%s/v%s/commit=%s%s", agentName, semanticVersion(), Commit,
 		cleanInitiator,
 	)
 }
@@ -0,0 +1,40 @@
+package loop
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestUserAgent tests assembly of the user agent that we send to the server,
+// and validation of the agent names that it may be configured with.
+func TestUserAgent(t *testing.T) {
+	require.NoError(t, ValidateAgentName("litd"))
+	require.Error(t, ValidateAgentName(""))
+	require.Error(t, ValidateAgentName("lit d"))
+	require.Error(t, ValidateAgentName(strings.Repeat("a", 33)))
+
+	// Without any configuration, we use the package level agent name and
+	// only add an initiator if the swap has one.
+	client := &grpcSwapServerClient{}
+	require.True(t, strings.HasPrefix(client.userAgent(""), AgentName+"/v"))
+	require.NotContains(t, client.userAgent(""), "initiator")
+	require.True(t, strings.HasSuffix(
+		client.userAgent("loop-cli"), ",initiator=loop-cli",
+	))
+
+	// A configured initiator is used for swaps that don't set one, and a
+	// configured agent name replaces the package level one.
+	client = &grpcSwapServerClient{
+		agentName: "platform",
+		initiator: "platform-ui",
+	}
+	require.True(t, strings.HasPrefix(client.userAgent(""), "platform/v"))
+	require.True(t, strings.HasSuffix(
+		client.userAgent(" "), ",initiator=platform-ui",
+	))
+	require.True(t, strings.HasSuffix(
+		client.userAgent("autoloop"), ",initiator=autoloop",
+	))
+}
@@ -0,0 +1,189 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	// volumeLimitDay is the rolling period that daily volume limits are
+	// enforced over.
+	volumeLimitDay = time.Hour * 24
+
+	// volumeLimitWeek is the rolling period that weekly volume limits are
+	// enforced over.
+	volumeLimitWeek = volumeLimitDay * 7
+)
+
+// ErrVolumeLimitExceeded is returned when a swap is requested that would
+// exceed our volume limits.
+var ErrVolumeLimitExceeded = errors.New("swap volume limit exceeded")
+
+// VolumeLimits caps the total amount and number of swaps that may be initiated
+// over rolling periods, covering both manually requested and automated swaps.
+// All swaps initiated within a period count towards its limits, regardless of
+// their outcome. Limits that are zero are not enforced.
+type VolumeLimits struct {
+	// DailyAmount is the maximum total amount of swaps that may be
+	// initiated in the past day.
+	DailyAmount btcutil.Amount
+
+	// WeeklyAmount is the maximum total amount of swaps that may be
+	// initiated in the past week.
+	WeeklyAmount btcutil.Amount
+
+	// DailySwaps is the maximum number of swaps that may be initiated in
+	// the past day.
+	DailySwaps uint32
+
+	// WeeklySwaps is the maximum number of swaps that may be initiated in
+	// the past week.
+	WeeklySwaps uint32
+}
+
+// enabled returns a boolean indicating whether any limit is set.
+func (v *VolumeLimits) enabled() bool {
+	return v != nil && (v.DailyAmount != 0 || v.WeeklyAmount != 0 ||
+		v.DailySwaps != 0 || v.WeeklySwaps != 0)
+}
+
+// swapVolume is the amount of a swap and the time it was initiated at.
+type swapVolume struct {
+	initiated time.Time
+	amount    btcutil.Amount
+}
+
+// checkVolumeLimits checks whether initiating a swap with the amount provided
+// now would exceed our limits, given the swaps that have already been
+// initiated.
+func checkVolumeLimits(limits *VolumeLimits, swaps []swapVolume,
+	now time.Time, amount btcutil.Amount) error {
+
+	checkPeriod := func(period time.Duration, name string,
+		maxAmount btcutil.Amount, maxCount uint32) error {
+
+		total, count := amount, uint32(1)
+		for _, swap := range swaps {
+			if swap.initiated.Before(now.Add(-period)) {
+				continue
+			}
+
+			total += swap.amount
+			count++
+		}
+
+		if maxAmount != 0 && total > maxAmount {
+			return fmt.Errorf("%w: %v swap volume of %v would "+
+				"exceed limit of %v", ErrVolumeLimitExceeded,
+				name, total, maxAmount)
+		}
+
+		if maxCount != 0 && count > maxCount {
+			return fmt.Errorf("%w: %v swap count of %v would "+
+				"exceed limit of %v", ErrVolumeLimitExceeded,
+				name, count, maxCount)
+		}
+
+		return nil
+	}
+
+	err := checkPeriod(
+		volumeLimitDay, "daily", limits.DailyAmount, limits.DailySwaps,
+	)
+	if err != nil {
+		return err
+	}
+
+	return checkPeriod(
+		volumeLimitWeek, "weekly", limits.WeeklyAmount,
+		limits.WeeklySwaps,
+	)
+}
+
+// reserveVolume checks that a swap with the amount provided does not exceed
+// our volume limits, and counts it towards them until the release function
+// that is returned is called. The swap should be persisted before it is
+// released, so that it is counted from our store from then on.
+func (s *Client) reserveVolume(amount btcutil.Amount,
+	override bool) (func(), error) {
+
+	s.volumeLock.Lock()
+	defer s.volumeLock.Unlock()
+
+	if override || !s.VolumeLimits.enabled() {
+		return func() {}, nil
+	}
+
+	swaps, err := s.storedVolume()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pending := range s.pendingVolume {
+		swaps = append(swaps, pending)
+	}
+
+	now := time.Now()
+	err = checkVolumeLimits(s.VolumeLimits, swaps, now, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	id := s.nextVolumeID
+	s.nextVolumeID++
+
+	s.pendingVolume[id] = swapVolume{
+		initiated: now,
+		amount:    amount,
+	}
+
+	return func() {
+		s.volumeLock.Lock()
+		delete(s.pendingVolume, id)
+		s.volumeLock.Unlock()
+	}, nil
+}
+
+// SetVolumeLimits replaces the volume limits that are enforced for new swaps.
+// Swaps that have already been initiated continue to count towards the new
+// limits.
+func (s *Client) SetVolumeLimits(limits *VolumeLimits) {
+	s.volumeLock.Lock()
+	defer s.volumeLock.Unlock()
+
+	s.VolumeLimits = limits
+}
+
+// storedVolume returns the initiation time and amount of all the swaps in our
+// store.
+func (s *Client) storedVolume() ([]swapVolume, error) {
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	swaps := make([]swapVolume, 0, len(loopOutSwaps)+len(loopInSwaps))
+	for _, swp := range loopOutSwaps {
+		swaps = append(swaps, swapVolume{
+			initiated: swp.Contract.InitiationTime,
+			amount:    swp.Contract.AmountRequested,
+		})
+	}
+
+	for _, swp := range loopInSwaps {
+		swaps = append(swaps, swapVolume{
+			initiated: swp.Contract.InitiationTime,
+			amount:    swp.Contract.AmountRequested,
+		})
+	}
+
+	return swaps, nil
+}
@@ -0,0 +1,99 @@
+package loop
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckVolumeLimits tests enforcement of our daily and weekly volume
+// limits.
+func TestCheckVolumeLimits(t *testing.T) {
+	now := time.Unix(1000000, 0)
+
+	swaps := []swapVolume{
+		{
+			// A swap that is outside of both periods.
+			initiated: now.Add(-volumeLimitWeek - time.Second),
+			amount:    1000,
+		},
+		{
+			// A swap that only counts towards our weekly limits.
+			initiated: now.Add(-volumeLimitDay * 2),
+			amount:    200,
+		},
+		{
+			initiated: now.Add(-time.Hour),
+			amount:    100,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		limits   VolumeLimits
+		amount   btcutil.Amount
+		exceeded bool
+	}{
+		{
+			name:   "no limits",
+			amount: 10000,
+		},
+		{
+			name: "within daily amount",
+			limits: VolumeLimits{
+				DailyAmount: 150,
+			},
+			amount: 50,
+		},
+		{
+			name: "daily amount exceeded",
+			limits: VolumeLimits{
+				DailyAmount: 150,
+			},
+			amount:   51,
+			exceeded: true,
+		},
+		{
+			name: "weekly amount exceeded",
+			limits: VolumeLimits{
+				DailyAmount:  1000,
+				WeeklyAmount: 350,
+			},
+			amount:   51,
+			exceeded: true,
+		},
+		{
+			name: "within daily count",
+			limits: VolumeLimits{
+				DailySwaps: 2,
+			},
+			amount: 1,
+		},
+		{
+			name: "weekly count exceeded",
+			limits: VolumeLimits{
+				DailySwaps:  3,
+				WeeklySwaps: 2,
+			},
+			amount:   1,
+			exceeded: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkVolumeLimits(
+				&testCase.limits, swaps, now, testCase.amount,
+			)
+			require.Equal(
+				t, testCase.exceeded,
+				errors.Is(err, ErrVolumeLimitExceeded),
+			)
+		})
+	}
+}
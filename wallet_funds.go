@@ -0,0 +1,137 @@
+package loop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// InsufficientFundsError is returned when a loop in is requested that lnd's
+// wallet cannot fund, because its confirmed balance does not cover the loop
+// in and the funds that are reserved for our other pending loop ins.
+type InsufficientFundsError struct {
+	// Required is the amount that the loop in's htlc may spend from the
+	// wallet, including its maximum miner fee.
+	Required btcutil.Amount
+
+	// Available is the wallet's confirmed balance.
+	Available btcutil.Amount
+
+	// Reserved is the amount that is reserved for other loop ins that
+	// have not yet published their htlcs.
+	Reserved btcutil.Amount
+}
+
+// Shortfall returns the amount that the wallet is short of funding the loop
+// in.
+func (e *InsufficientFundsError) Shortfall() btcutil.Amount {
+	return e.Required + e.Reserved - e.Available
+}
+
+// Error returns an error string for an insufficient funds error.
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient wallet funds for loop in: %v "+
+		"required, %v available, %v reserved by pending loop ins, "+
+		"short by %v", e.Required, e.Available, e.Reserved,
+		e.Shortfall())
+}
+
+// walletFundsRequired returns the amount that the htlc of a loop in may spend
+// from lnd's default wallet account, or zero if its htlc is not funded from
+// it.
+func walletFundsRequired(amount, maxMinerFee btcutil.Amount, external bool,
+	funding *loopdb.HtlcFunding) btcutil.Amount {
+
+	if external || (funding != nil && funding.Account != "") {
+		return 0
+	}
+
+	return amount + maxMinerFee
+}
+
+// loopInWalletFunds returns the amount that the htlc of the loop in requested
+// may spend from lnd's default wallet account.
+func loopInWalletFunds(request *LoopInRequest) btcutil.Amount {
+	return walletFundsRequired(
+		request.Amount, request.MaxMinerFee, request.ExternalHtlc,
+		request.HtlcFunding,
+	)
+}
+
+// storedWalletFunds returns the amount that is reserved for the loop ins in
+// our store that fund their htlcs from our wallet, but have not yet
+// published them.
+func (s *Client) storedWalletFunds() (btcutil.Amount, error) {
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return 0, err
+	}
+
+	var reserved btcutil.Amount
+	for _, swp := range loopInSwaps {
+		if swp.State().State != loopdb.StateInitiated {
+			continue
+		}
+
+		reserved += walletFundsRequired(
+			swp.Contract.AmountRequested, swp.Contract.MaxMinerFee,
+			swp.Contract.ExternalHtlc, swp.Contract.HtlcFunding,
+		)
+	}
+
+	return reserved, nil
+}
+
+// reserveWalletFunds checks that lnd's wallet can fund a loop in that
+// requires the amount provided, given the funds that are reserved for our
+// other pending loop ins, and reserves the amount until the release function
+// that is returned is called. The swap should be persisted before it is
+// released, so that its funds are reserved from our store until its htlc is
+// published.
+func (s *Client) reserveWalletFunds(ctx context.Context,
+	required btcutil.Amount) (func(), error) {
+
+	if required == 0 {
+		return func() {}, nil
+	}
+
+	// We hold our lock while we query our balance, so that concurrent
+	// loop ins cannot count the same coins.
+	s.volumeLock.Lock()
+	defer s.volumeLock.Unlock()
+
+	reserved, err := s.storedWalletFunds()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pending := range s.pendingWalletFunds {
+		reserved += pending
+	}
+
+	balance, err := s.lndServices.Client.WalletBalance(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get wallet balance: %v", err)
+	}
+
+	if required+reserved > balance.Confirmed {
+		return nil, &InsufficientFundsError{
+			Required:  required,
+			Available: balance.Confirmed,
+			Reserved:  reserved,
+		}
+	}
+
+	id := s.nextVolumeID
+	s.nextVolumeID++
+
+	s.pendingWalletFunds[id] = required
+
+	return func() {
+		s.volumeLock.Lock()
+		delete(s.pendingWalletFunds, id)
+		s.volumeLock.Unlock()
+	}, nil
+}
@@ -0,0 +1,102 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWalletFundsRequired tests which loop ins are funded from our wallet.
+func TestWalletFundsRequired(t *testing.T) {
+	require.Equal(
+		t, btcutil.Amount(1100),
+		walletFundsRequired(1000, 100, false, nil),
+	)
+	require.Zero(t, walletFundsRequired(1000, 100, true, nil))
+
+	// Loop ins that are funded with specific coins from our default
+	// account still spend our wallet's funds.
+	require.Equal(
+		t, btcutil.Amount(1100), walletFundsRequired(
+			1000, 100, false, &loopdb.HtlcFunding{},
+		),
+	)
+	require.Zero(t, walletFundsRequired(
+		1000, 100, false, &loopdb.HtlcFunding{Account: "cold"},
+	))
+}
+
+// TestReserveWalletFunds tests that loop ins cannot count the same wallet
+// funds as pending loop ins.
+func TestReserveWalletFunds(t *testing.T) {
+	ctx := context.Background()
+
+	lnd := test.NewMockLnd()
+	lnd.WalletBalance = lndclient.WalletBalance{
+		Confirmed: 10000,
+	}
+
+	store := newStoreMock(t)
+
+	// A loop in that has not yet published its htlc reserves funds, while
+	// external loop ins and loop ins that published their htlcs don't.
+	addLoopIn := func(hash lntypes.Hash, external bool,
+		state loopdb.SwapState) {
+
+		store.loopInSwaps[hash] = &loopdb.LoopInContract{
+			SwapContract: loopdb.SwapContract{
+				AmountRequested: 3000,
+				MaxMinerFee:     500,
+			},
+			ExternalHtlc: external,
+		}
+		store.loopInUpdates[hash] = []loopdb.SwapStateData{
+			{State: state},
+		}
+	}
+	addLoopIn(lntypes.Hash{1}, false, loopdb.StateInitiated)
+	addLoopIn(lntypes.Hash{2}, true, loopdb.StateInitiated)
+	addLoopIn(lntypes.Hash{3}, false, loopdb.StateHtlcPublished)
+
+	client := &Client{
+		lndServices: &lnd.LndServices,
+		clientConfig: clientConfig{
+			Store: store,
+		},
+		pendingWalletFunds: make(map[uint64]btcutil.Amount),
+	}
+
+	// Loop ins that are not funded from our wallet are not checked.
+	release, err := client.reserveWalletFunds(ctx, 0)
+	require.NoError(t, err)
+	release()
+
+	release, err = client.reserveWalletFunds(ctx, 4000)
+	require.NoError(t, err)
+
+	// While the first loop in is being created, its funds cannot be used
+	// by another loop in.
+	_, err = client.reserveWalletFunds(ctx, 4000)
+
+	var fundsErr *InsufficientFundsError
+	require.True(t, errors.As(err, &fundsErr))
+	require.Equal(t, &InsufficientFundsError{
+		Required:  4000,
+		Available: 10000,
+		Reserved:  7500,
+	}, fundsErr)
+	require.Equal(t, btcutil.Amount(1500), fundsErr.Shortfall())
+
+	release()
+
+	release, err = client.reserveWalletFunds(ctx, 4000)
+	require.NoError(t, err)
+	release()
+}